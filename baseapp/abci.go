@@ -253,6 +253,7 @@ func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 		Data:      result.Data,
 		Events:    sdk.MarkEventsToIndex(result.Events, app.indexEvents),
 		Priority:  priority,
+		Sender:    result.Sender,
 	}
 }
 
@@ -447,6 +448,12 @@ func (app *BaseApp) ListSnapshots(req abci.RequestListSnapshots) abci.ResponseLi
 	}
 
 	for _, snapshot := range snapshots {
+		// delta snapshots can only be restored on top of the state at their base
+		// height, so they aren't offered to state sync peers.
+		if snapshot.Format == snapshottypes.DeltaFormat {
+			continue
+		}
+
 		abciSnapshot, err := snapshot.ToABCI()
 		if err != nil {
 			app.logger.Error("failed to list snapshots", "err", err)
@@ -39,6 +39,11 @@ type (
 	// an older version of the software. In particular, if a module changed the substore key name
 	// (or removed a substore) between two versions of the software.
 	StoreLoader func(ms sdk.CommitMultiStore) error
+
+	// TxSenderFn returns the sender of a transaction as reported in the
+	// ResponseCheckTx. Tendermint's priority mempool keeps at most one
+	// transaction per non-empty sender.
+	TxSenderFn func(tx sdk.Tx) string
 )
 
 // BaseApp reflects the ABCI application implementation.
@@ -51,6 +56,7 @@ type BaseApp struct { // nolint: maligned
 
 	anteHandler sdk.AnteHandler // ante handler for fee and auth
 	postHandler sdk.AnteHandler // post handler, optional, e.g. for tips
+	txSender    TxSenderFn      // tx sender returned in CheckTx, optional
 
 	appStore
 	baseappVersions
@@ -621,7 +627,8 @@ func (app *BaseApp) cacheTxContext(ctx sdk.Context, txBytes []byte) (sdk.Context
 // if all messages get executed successfully and the execution mode is DeliverTx.
 // Note, gas execution info is always returned. A reference to a Result is
 // returned if the tx does not run out of gas and if all the messages are valid
-// and execute successfully. An error is returned otherwise.
+// and execute successfully. An error is returned otherwise. In (Re)CheckTx mode,
+// the Result holds the sender of the tx if a TxSenderFn is set.
 func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, priority int64, err error) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
@@ -755,6 +762,10 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 			// append the events in the order of occurrence
 			result.Events = append(anteEvents, result.Events...)
 		}
+
+		if (mode == runTxModeCheck || mode == runTxModeReCheck) && app.txSender != nil {
+			result.Sender = app.txSender(tx)
+		}
 	}
 
 	return gInfo, result, anteEvents, priority, err
@@ -928,6 +928,49 @@ func TestCheckTx(t *testing.T) {
 	require.Nil(t, storedBytes)
 }
 
+func TestCheckTxSender(t *testing.T) {
+	counterKey := []byte("counter-key")
+
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, counterKey)) }
+	senderOpt := func(bapp *BaseApp) {
+		bapp.SetTxSender(func(tx sdk.Tx) string {
+			return fmt.Sprintf("sender-%d", tx.(txTest).Counter)
+		})
+	}
+
+	app := setupBaseApp(t, anteOpt, senderOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	// the sender is taken from the tx decoded by runTx
+	decodes := 0
+	txDecoder := app.txDecoder
+	app.txDecoder = func(txBytes []byte) (sdk.Tx, error) {
+		decodes++
+		return txDecoder(txBytes)
+	}
+
+	codec := codec.NewLegacyAmino()
+	registerTestCodec(codec)
+
+	txBytes, err := codec.Marshal(newTxCounter(0, 0))
+	require.NoError(t, err)
+
+	r := app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+	require.True(t, r.IsOK(), fmt.Sprintf("%v", r))
+	require.Equal(t, "sender-0", r.Sender)
+	require.Equal(t, 1, decodes)
+
+	// a failing tx has no sender
+	tx := newTxCounter(1, 0)
+	tx.setFailOnAnte(true)
+	txBytes, err = codec.Marshal(tx)
+	require.NoError(t, err)
+
+	r = app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+	require.False(t, r.IsOK())
+	require.Empty(t, r.Sender)
+}
+
 // Test that successive DeliverTx can see each others' effects
 // on the store, both within and across blocks.
 func TestDeliverTx(t *testing.T) {
@@ -1764,6 +1807,10 @@ func TestListSnapshots(t *testing.T) {
 	app, err := setupBaseAppWithSnapshots(t, setupConfig)
 	require.NoError(t, err)
 
+	// delta snapshots aren't listed, as state sync peers can't restore them
+	_, err = app.snapshotManager.CreateDelta(5, 4)
+	require.NoError(t, err)
+
 	resp := app.ListSnapshots(abci.RequestListSnapshots{})
 	for _, s := range resp.Snapshots {
 		assert.NotEmpty(t, s.Hash)
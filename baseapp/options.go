@@ -171,6 +171,17 @@ func (app *BaseApp) SetPostHandler(ph sdk.AnteHandler) {
 	app.postHandler = ph
 }
 
+// SetTxSender sets the function used to populate the Sender field of the
+// ResponseCheckTx. See x/auth/ante.DefaultTxSender for its effect on the
+// priority mempool.
+func (app *BaseApp) SetTxSender(fn TxSenderFn) {
+	if app.sealed {
+		panic("SetTxSender() on sealed BaseApp")
+	}
+
+	app.txSender = fn
+}
+
 func (app *BaseApp) SetAddrPeerFilter(pf sdk.PeerFilter) {
 	if app.sealed {
 		panic("SetAddrPeerFilter() on sealed BaseApp")
@@ -3,6 +3,7 @@ package snapshot
 import (
 	"github.com/cosmos/cosmos-sdk/server"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	"github.com/spf13/cobra"
 )
 
@@ -20,6 +21,11 @@ func ExportSnapshotCmd(appCreator servertypes.AppCreator) *cobra.Command {
 				return err
 			}
 
+			baseHeight, err := cmd.Flags().GetUint64("base-height")
+			if err != nil {
+				return err
+			}
+
 			home := ctx.Config.RootDir
 			db, err := openDB(home, server.GetAppDBBackend(ctx.Viper))
 			if err != nil {
@@ -32,10 +38,16 @@ func ExportSnapshotCmd(appCreator servertypes.AppCreator) *cobra.Command {
 				height = app.CommitMultiStore().LastCommitID().Version
 			}
 
-			cmd.Printf("Exporting snapshot for height %d\n", height)
-
 			sm := app.SnapshotManager()
-			snapshot, err := sm.Create(uint64(height))
+
+			var snapshot *snapshottypes.Snapshot
+			if baseHeight > 0 {
+				cmd.Printf("Exporting delta snapshot for height %d, base height %d\n", height, baseHeight)
+				snapshot, err = sm.CreateDelta(uint64(height), baseHeight)
+			} else {
+				cmd.Printf("Exporting snapshot for height %d\n", height)
+				snapshot, err = sm.Create(uint64(height))
+			}
 			if err != nil {
 				return err
 			}
@@ -46,6 +58,7 @@ func ExportSnapshotCmd(appCreator servertypes.AppCreator) *cobra.Command {
 	}
 
 	cmd.Flags().Int64("height", 0, "Height to export, default to latest state height")
+	cmd.Flags().Uint64("base-height", 0, "Export a delta snapshot of the changes since the snapshot at this height, every height since then must not have been pruned")
 
 	return cmd
 }
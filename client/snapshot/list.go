@@ -22,6 +22,10 @@ var ListSnapshotsCmd = &cobra.Command{
 			return fmt.Errorf("failed to list snapshots: %w", err)
 		}
 		for _, snapshot := range snapshots {
+			if snapshot.Metadata.BaseHeight > 0 {
+				cmd.Println("height:", snapshot.Height, "format:", snapshot.Format, "chunks:", snapshot.Chunks, "base height:", snapshot.Metadata.BaseHeight)
+				continue
+			}
 			cmd.Println("height:", snapshot.Height, "format:", snapshot.Format, "chunks:", snapshot.Chunks)
 		}
 
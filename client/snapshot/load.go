@@ -68,7 +68,16 @@ func LoadArchiveCmd() *cobra.Command {
 			go func() {
 				defer close(quitChan)
 
-				savedSnapshot, err := snapshotStore.Save(snapshot.Height, snapshot.Format, chunks)
+				var savedSnapshot *snapshottypes.Snapshot
+				switch snapshot.Format {
+				case snapshottypes.DeltaFormat:
+					savedSnapshot, err = snapshotStore.SaveDelta(snapshot.Height, snapshot.Metadata.BaseHeight, snapshot.Metadata.AppHash, chunks)
+				case snapshottypes.ParallelFormat:
+					streams := splitStreams(chunks, snapshot.Metadata.StreamChunks)
+					savedSnapshot, err = snapshotStore.SaveStreams(snapshot.Height, snapshot.Format, streams)
+				default:
+					savedSnapshot, err = snapshotStore.Save(snapshot.Height, snapshot.Format, chunks)
+				}
 				if err != nil {
 					cmd.Println("failed to save snapshot", err)
 					return
@@ -111,3 +120,32 @@ func LoadArchiveCmd() *cobra.Command {
 		},
 	}
 }
+
+// splitStreams splits the chunks of a snapshot into its chunk streams, given the number of chunks
+// of each stream. Chunks beyond the last stream are discarded.
+func splitStreams(chunks <-chan io.ReadCloser, streamChunks []uint32) []<-chan io.ReadCloser {
+	chs := make([]chan io.ReadCloser, len(streamChunks))
+	streams := make([]<-chan io.ReadCloser, len(streamChunks))
+	for i := range streamChunks {
+		chs[i] = make(chan io.ReadCloser)
+		streams[i] = chs[i]
+	}
+
+	go func() {
+		for i, count := range streamChunks {
+			for j := uint32(0); j < count; j++ {
+				chunk, ok := <-chunks
+				if !ok {
+					break
+				}
+				chs[i] <- chunk
+			}
+			close(chs[i])
+		}
+		for chunk := range chunks {
+			_ = chunk.Close()
+		}
+	}()
+
+	return streams
+}
@@ -0,0 +1,181 @@
+package snapshot
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+const flagRestore = "restore"
+
+// VerifySnapshotCmd returns a command to verify the chunks of a local snapshot, and optionally
+// the app hash of the state it restores.
+func VerifySnapshotCmd(appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify <height> <format>",
+		Short: "Verify a local snapshot",
+		Long: `Verify a local snapshot by hashing every chunk and comparing the hashes with the snapshot metadata.
+With --restore, the snapshot is also restored into a scratch in-memory state, and the resulting app hash
+is compared with the commit info stored in the application database at the snapshot height.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := server.GetServerContextFromCmd(cmd)
+
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			format, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			restore, err := cmd.Flags().GetBool(flagRestore)
+			if err != nil {
+				return err
+			}
+
+			if !restore {
+				snapshotStore, err := server.GetSnapshotStore(ctx.Viper)
+				if err != nil {
+					return err
+				}
+				snapshot, err := snapshotStore.Get(height, uint32(format))
+				if err != nil {
+					return err
+				}
+				if snapshot == nil {
+					return fmt.Errorf("snapshot doesn't exist, height: %d, format: %d", height, format)
+				}
+
+				err = verifyChunks(snapshot, func(index uint32) ([]byte, error) {
+					chunk, err := snapshotStore.LoadChunk(height, uint32(format), index)
+					if err != nil || chunk == nil {
+						return nil, err
+					}
+					defer chunk.Close()
+					return io.ReadAll(chunk)
+				})
+				if err != nil {
+					return err
+				}
+
+				cmd.Printf("Snapshot at height %d, format %d verified, chunks %d\n", snapshot.Height, snapshot.Format, snapshot.Chunks)
+				return nil
+			}
+
+			// the stored commit info is loaded first, so a missing height fails before restoring.
+			commitInfo, err := storedCommitInfo(ctx.Config.RootDir, server.GetAppDBBackend(ctx.Viper), height)
+			if err != nil {
+				return err
+			}
+
+			app := appCreator(ctx.Logger, dbm.NewMemDB(), nil, ctx.Viper)
+			sm := app.SnapshotManager()
+
+			snapshots, err := sm.List()
+			if err != nil {
+				return err
+			}
+			var snapshot *snapshottypes.Snapshot
+			for _, s := range snapshots {
+				if s.Height == height && s.Format == uint32(format) {
+					snapshot = s
+					break
+				}
+			}
+			if snapshot == nil {
+				return fmt.Errorf("snapshot doesn't exist, height: %d, format: %d", height, format)
+			}
+
+			err = verifyChunks(snapshot, func(index uint32) ([]byte, error) {
+				return sm.LoadChunk(height, uint32(format), index)
+			})
+			if err != nil {
+				return err
+			}
+
+			if err := sm.RestoreLocalSnapshot(height, uint32(format)); err != nil {
+				return fmt.Errorf("failed to restore snapshot: %w", err)
+			}
+
+			commitID := app.CommitMultiStore().LastCommitID()
+			if commitID.Version != commitInfo.Version || !bytes.Equal(commitID.Hash, commitInfo.Hash()) {
+				return fmt.Errorf("restored state at height %d has app hash %X, but the stored commit info at height %d has app hash %X",
+					commitID.Version, commitID.Hash, commitInfo.Version, commitInfo.Hash())
+			}
+
+			cmd.Printf("Snapshot at height %d, format %d verified, chunks %d, app hash %X\n",
+				snapshot.Height, snapshot.Format, snapshot.Chunks, commitID.Hash)
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool(flagRestore, false, "Restore the snapshot into a scratch in-memory state and compare its app hash with the stored commit info")
+
+	return cmd
+}
+
+// verifyChunks checks the hash of every chunk of a snapshot, loaded by index with loadChunk,
+// against the snapshot metadata, and the snapshot hash against the chunks.
+func verifyChunks(snapshot *snapshottypes.Snapshot, loadChunk func(index uint32) ([]byte, error)) error {
+	if uint32(len(snapshot.Metadata.ChunkHashes)) != snapshot.Chunks {
+		return fmt.Errorf("snapshot has %d chunk hashes, but %d chunks", len(snapshot.Metadata.ChunkHashes), snapshot.Chunks)
+	}
+
+	// the hash of a parallel snapshot is the hash of its chunk hashes, the hash of the other
+	// formats is the hash of the content of all its chunks.
+	snapshotHasher := sha256.New()
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := loadChunk(i)
+		if err != nil {
+			return fmt.Errorf("failed to load chunk %d: %w", i, err)
+		}
+		if chunk == nil {
+			return fmt.Errorf("chunk %d doesn't exist", i)
+		}
+
+		chunkHash := sha256.Sum256(chunk)
+		if !bytes.Equal(chunkHash[:], snapshot.Metadata.ChunkHashes[i]) {
+			return fmt.Errorf("chunk %d has hash %X, expected %X", i, chunkHash, snapshot.Metadata.ChunkHashes[i])
+		}
+
+		if snapshot.Format == snapshottypes.ParallelFormat {
+			snapshotHasher.Write(chunkHash[:])
+		} else {
+			snapshotHasher.Write(chunk)
+		}
+	}
+
+	if hash := snapshotHasher.Sum(nil); !bytes.Equal(hash, snapshot.Hash) {
+		return fmt.Errorf("snapshot has hash %X, expected %X", hash, snapshot.Hash)
+	}
+	return nil
+}
+
+// storedCommitInfo returns the commit info stored in the application database at height.
+func storedCommitInfo(home string, backendType dbm.BackendType, height uint64) (*storetypes.CommitInfo, error) {
+	db, err := openDB(home, backendType)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	commitInfo, err := rootmulti.NewStore(db, log.NewNopLogger()).GetCommitInfo(int64(height))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load commit info at height %d: %w", height, err)
+	}
+	return commitInfo, nil
+}
@@ -0,0 +1,17 @@
+package streaming
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// Cmd returns the streaming group command
+func Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "streaming",
+		Short: "Inspect local state streaming output",
+	}
+	cmd.AddCommand(
+		ReplayFilesCmd(),
+	)
+	return cmd
+}
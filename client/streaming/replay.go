@@ -0,0 +1,105 @@
+package streaming
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/store/streaming"
+	"github.com/cosmos/cosmos-sdk/store/streaming/file/reader"
+)
+
+const (
+	flagPrefix     = "prefix"
+	flagFromHeight = "from-height"
+	flagToHeight   = "to-height"
+)
+
+// replayedBlock is the JSON representation of a replayed block.
+type replayedBlock struct {
+	Height   int64             `json:"height"`
+	Metadata json.RawMessage   `json:"metadata,omitempty"`
+	Changes  []json.RawMessage `json:"changes"`
+}
+
+// ReplayFilesCmd returns a command to replay the files written by the file
+// streaming service as JSON, one block per line
+func ReplayFilesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay [dir]",
+		Short: "Replay the files written by the file streaming service as JSON, one block per line",
+		Long: `Replay the files written by the file streaming service as JSON, one block per line.
+The directory and prefix default to the streamers.file.write_dir and streamers.file.prefix
+app config values.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := server.GetServerContextFromCmd(cmd)
+
+			var dir string
+			if len(args) > 0 {
+				dir = args[0]
+			} else {
+				// relative path is based on node home directory.
+				dir = cast.ToString(ctx.Viper.Get(streaming.OptStreamersFileWriteDir))
+				if !path.IsAbs(dir) {
+					dir = path.Join(cast.ToString(ctx.Viper.Get(flags.FlagHome)), dir)
+				}
+			}
+
+			prefix := cast.ToString(ctx.Viper.Get(streaming.OptStreamersFilePrefix))
+			if cmd.Flags().Changed(flagPrefix) {
+				var err error
+				if prefix, err = cmd.Flags().GetString(flagPrefix); err != nil {
+					return err
+				}
+			}
+
+			fromHeight, err := cmd.Flags().GetInt64(flagFromHeight)
+			if err != nil {
+				return err
+			}
+			toHeight, err := cmd.Flags().GetInt64(flagToHeight)
+			if err != nil {
+				return err
+			}
+
+			cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+			return reader.NewReader(dir, prefix, cdc).Replay(fromHeight, toHeight, func(block *reader.Block) error {
+				out := replayedBlock{Height: block.Height, Changes: make([]json.RawMessage, len(block.Changes))}
+
+				if block.Metadata != nil {
+					if out.Metadata, err = cdc.MarshalJSON(block.Metadata); err != nil {
+						return err
+					}
+				}
+
+				for i := range block.Changes {
+					if out.Changes[i], err = cdc.MarshalJSON(&block.Changes[i]); err != nil {
+						return err
+					}
+				}
+
+				bz, err := json.Marshal(out)
+				if err != nil {
+					return err
+				}
+
+				_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+				return err
+			})
+		},
+	}
+
+	cmd.Flags().String(flagPrefix, "", "Prefix of the file names, defaults to the app config value")
+	cmd.Flags().Int64(flagFromHeight, 0, "Height of the first block to replay")
+	cmd.Flags().Int64(flagToHeight, 0, "Height of the last block to replay, 0 replays up to the last block")
+
+	return cmd
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 
@@ -36,6 +37,9 @@ const (
 
 	// FileStreamer defines the store streaming type for file streaming.
 	FileStreamer = "file"
+
+	// GRPCStreamer defines the store streaming type for gRPC streaming.
+	GRPCStreamer = "grpc"
 )
 
 // BaseConfig defines the server's basic configuration
@@ -200,6 +204,18 @@ type StateSyncConfig struct {
 	// SnapshotKeepRecent sets the number of recent state sync snapshots to keep.
 	// 0 keeps all snapshots.
 	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
+
+	// SnapshotDeltas sets the number of delta snapshots taken between two full
+	// state sync snapshots. 0 only takes full snapshots.
+	SnapshotDeltas uint32 `mapstructure:"snapshot-deltas"`
+
+	// SnapshotExportWorkers sets the number of stores and extensions exported
+	// concurrently. 0 takes sequential snapshots.
+	SnapshotExportWorkers uint32 `mapstructure:"snapshot-export-workers"`
+
+	// SnapshotRestoreWorkers sets the number of chunk streams of a parallel
+	// snapshot restored concurrently. 0 uses the number of CPUs.
+	SnapshotRestoreWorkers uint32 `mapstructure:"snapshot-restore-workers"`
 }
 
 type (
@@ -214,6 +230,7 @@ type (
 	// list defined by 'StoreConfig.Streamers'.
 	StreamersConfig struct {
 		File FileStreamerConfig `mapstructure:"file"`
+		GRPC GRPCStreamerConfig `mapstructure:"grpc"`
 	}
 
 	// FileStreamerConfig defines the file streaming configuration options.
@@ -230,6 +247,34 @@ type (
 		// Fsync specifies if calling fsync after writing the files, it slows down
 		// the commit, but don't lose data in face of system crash.
 		Fsync bool `mapstructure:"fsync"`
+		// Compression is the compression applied to the files: none, gzip or zstd.
+		Compression string `mapstructure:"compression"`
+		// MaxBlocksPerFile, MaxFileSize and MaxFileAge batch many blocks per file
+		// when any of them is non-zero, rotating the file once one is reached.
+		MaxBlocksPerFile uint64        `mapstructure:"max-blocks-per-file"`
+		MaxFileSize      int64         `mapstructure:"max-file-size"`
+		MaxFileAge       time.Duration `mapstructure:"max-file-age"`
+		// RetainBlocks prunes the files older than the most recent RetainBlocks
+		// blocks, 0 keeps all the files.
+		RetainBlocks uint64 `mapstructure:"retain-blocks"`
+	}
+
+	// GRPCStreamerConfig defines the gRPC streaming configuration options.
+	GRPCStreamerConfig struct {
+		Keys []string `mapstructure:"keys"`
+		// Address is the address of the consumer implementing the
+		// ABCIListenerService.
+		Address string `mapstructure:"address"`
+		// Async specifies if the messages are delivered from a background
+		// goroutine instead of blocking the ABCI methods.
+		Async bool `mapstructure:"async"`
+		// BufferSize is the size of the asynchronous delivery queue.
+		BufferSize int `mapstructure:"buffer-size"`
+		// Timeout bounds every request made to the consumer, 0 uses the default.
+		Timeout time.Duration `mapstructure:"timeout"`
+		// StopNodeOnError specifies if propagate the streamer errors to the consensus
+		// state machine, it's nesserary for data integrity of output.
+		StopNodeOnError bool `mapstructure:"stop-node-on-error"`
 	}
 )
 
@@ -325,8 +370,11 @@ func DefaultConfig() *Config {
 			Address: DefaultGRPCWebAddress,
 		},
 		StateSync: StateSyncConfig{
-			SnapshotInterval:   0,
-			SnapshotKeepRecent: 2,
+			SnapshotInterval:       0,
+			SnapshotKeepRecent:     2,
+			SnapshotDeltas:         0,
+			SnapshotExportWorkers:  0,
+			SnapshotRestoreWorkers: 0,
 		},
 		Store: StoreConfig{
 			Streamers: []string{},
@@ -339,7 +387,20 @@ func DefaultConfig() *Config {
 				StopNodeOnError: true,
 				// NOTICE: The default config doesn't protect the streamer data integrity
 				// in face of system crash.
-				Fsync: false,
+				Fsync:            false,
+				Compression:      "none",
+				MaxBlocksPerFile: 0,
+				MaxFileSize:      0,
+				MaxFileAge:       0,
+				RetainBlocks:     0,
+			},
+			GRPC: GRPCStreamerConfig{
+				Keys:            []string{"*"},
+				Address:         "",
+				Async:           false,
+				BufferSize:      1000,
+				Timeout:         5 * time.Second,
+				StopNodeOnError: true,
 			},
 		},
 	}
@@ -240,6 +240,20 @@ snapshot-interval = {{ .StateSync.SnapshotInterval }}
 # snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
 snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
 
+# snapshot-deltas specifies the number of delta snapshots taken between two full snapshots (0 to
+# only take full snapshots). A delta snapshot only holds the changes since the previous snapshot,
+# and requires the pruning settings to keep every height since that snapshot.
+snapshot-deltas = {{ .StateSync.SnapshotDeltas }}
+
+# snapshot-export-workers specifies the number of stores and extensions exported concurrently (0 to
+# take sequential snapshots). A positive value takes snapshots made of one chunk stream per store
+# and extension, which are identical whatever the number of workers.
+snapshot-export-workers = {{ .StateSync.SnapshotExportWorkers }}
+
+# snapshot-restore-workers specifies the number of chunk streams of a parallel snapshot restored
+# concurrently (0 to use the number of CPUs).
+snapshot-restore-workers = {{ .StateSync.SnapshotRestoreWorkers }}
+
 ###############################################################################
 ###                         Store / State Streaming                         ###
 ###############################################################################
@@ -262,6 +276,39 @@ stop-node-on-error = "{{ .Streamers.File.StopNodeOnError }}"
 
 # fsync specifies if call fsync after writing the files.
 fsync = "{{ .Streamers.File.Fsync }}"
+
+# compression applied to the files: none, gzip or zstd.
+compression = "{{ .Streamers.File.Compression }}"
+
+# max-blocks-per-file, max-file-size (in bytes) and max-file-age (in block time)
+# batch many blocks per file when any of them is set, the file is rotated once
+# one of the limits is reached. Otherwise, one file is written per block.
+max-blocks-per-file = {{ .Streamers.File.MaxBlocksPerFile }}
+max-file-size = {{ .Streamers.File.MaxFileSize }}
+max-file-age = "{{ .Streamers.File.MaxFileAge }}"
+
+# retain-blocks prunes the files older than the most recent retain-blocks blocks
+# (0 to keep all the files).
+retain-blocks = {{ .Streamers.File.RetainBlocks }}
+
+[streamers.grpc]
+keys = [{{ range .Streamers.GRPC.Keys }}{{ printf "%q, " . }}{{end}}]
+
+# address of the consumer implementing the ABCIListenerService, e.g. "localhost:9191".
+address = "{{ .Streamers.GRPC.Address }}"
+
+# async specifies if the messages are delivered in the background instead of
+# blocking the block processing until the consumer acknowledges them.
+async = "{{ .Streamers.GRPC.Async }}"
+
+# buffer-size is the number of messages queued in async mode, further messages are dropped.
+buffer-size = {{ .Streamers.GRPC.BufferSize }}
+
+# timeout bounds every request made to the consumer (0 uses the default of 5s).
+timeout = "{{ .Streamers.GRPC.Timeout }}"
+
+# stop-node-on-error specifies if propagate the grpc streamer errors to consensus state machine.
+stop-node-on-error = "{{ .Streamers.GRPC.StopNodeOnError }}"
 `
 
 var configTemplate *template.Template
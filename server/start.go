@@ -63,6 +63,9 @@ const (
 	// state sync-related flags
 	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
 	FlagStateSyncSnapshotKeepRecent = "state-sync.snapshot-keep-recent"
+	FlagStateSyncSnapshotDeltas     = "state-sync.snapshot-deltas"
+	FlagStateSyncExportWorkers      = "state-sync.snapshot-export-workers"
+	FlagStateSyncRestoreWorkers     = "state-sync.snapshot-restore-workers"
 
 	// api-related flags
 	FlagAPIEnable             = "api.enable"
@@ -192,6 +195,9 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 
 	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
+	cmd.Flags().Uint32(FlagStateSyncSnapshotDeltas, 0, "State sync delta snapshots between two full snapshots")
+	cmd.Flags().Uint32(FlagStateSyncExportWorkers, 0, "State sync snapshot stores exported concurrently (0 for sequential snapshots)")
+	cmd.Flags().Uint32(FlagStateSyncRestoreWorkers, 0, "State sync snapshot chunk streams restored concurrently (0 for the number of CPUs)")
 
 	cmd.Flags().Bool(FlagDisableIAVLFastNode, false, "Disable fast node for IAVL tree")
 
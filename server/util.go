@@ -450,6 +450,9 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		cast.ToUint64(appOpts.Get(FlagStateSyncSnapshotInterval)),
 		cast.ToUint32(appOpts.Get(FlagStateSyncSnapshotKeepRecent)),
 	)
+	snapshotOptions.DeltaSnapshots = cast.ToUint32(appOpts.Get(FlagStateSyncSnapshotDeltas))
+	snapshotOptions.ExportWorkers = cast.ToUint32(appOpts.Get(FlagStateSyncExportWorkers))
+	snapshotOptions.RestoreWorkers = cast.ToUint32(appOpts.Get(FlagStateSyncRestoreWorkers))
 
 	return []func(*baseapp.BaseApp){
 		baseapp.SetPruning(pruningOpts),
@@ -50,6 +50,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/capability"
 	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+	circuitkeeper "github.com/cosmos/cosmos-sdk/x/circuit/keeper"
+	circuitmodule "github.com/cosmos/cosmos-sdk/x/circuit/module"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	crisiskeeper "github.com/cosmos/cosmos-sdk/x/crisis/keeper"
 	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
@@ -57,6 +60,9 @@ import (
 	distrclient "github.com/cosmos/cosmos-sdk/x/distribution/client"
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
+	epochingkeeper "github.com/cosmos/cosmos-sdk/x/epoching/keeper"
+	epochingtypes "github.com/cosmos/cosmos-sdk/x/epoching/types"
 	"github.com/cosmos/cosmos-sdk/x/evidence"
 	evidencekeeper "github.com/cosmos/cosmos-sdk/x/evidence/keeper"
 	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
@@ -130,6 +136,8 @@ var (
 		groupmodule.AppModuleBasic{},
 		vesting.AppModuleBasic{},
 		nftmodule.AppModuleBasic{},
+		circuitmodule.AppModuleBasic{},
+		epoching.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -137,10 +145,12 @@ var (
 		authtypes.FeeCollectorName:     nil,
 		distrtypes.ModuleName:          nil,
 		minttypes.ModuleName:           {authtypes.Minter},
+		stakingtypes.ModuleName:        {authtypes.Minter, authtypes.Burner},
 		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		govtypes.ModuleName:            {authtypes.Burner},
 		nft.ModuleName:                 nil,
+		epochingtypes.ModuleName:       {authtypes.Staking},
 	}
 )
 
@@ -182,6 +192,8 @@ type SimApp struct {
 	FeeGrantKeeper   feegrantkeeper.Keeper
 	GroupKeeper      groupkeeper.Keeper
 	NFTKeeper        nftkeeper.Keeper
+	CircuitKeeper    circuitkeeper.Keeper
+	EpochingKeeper   epochingkeeper.Keeper
 
 	// the module manager
 	mm *module.Manager
@@ -191,6 +203,9 @@ type SimApp struct {
 
 	// module configurator
 	configurator module.Configurator
+
+	// signatures verified by the ante handler
+	sigVerificationCache *ante.SigVerificationCache
 }
 
 func init() {
@@ -222,7 +237,8 @@ func NewSimApp(
 		minttypes.StoreKey, distrtypes.StoreKey, slashingtypes.StoreKey,
 		govtypes.StoreKey, paramstypes.StoreKey, upgradetypes.StoreKey, feegrant.StoreKey,
 		evidencetypes.StoreKey, capabilitytypes.StoreKey,
-		authzkeeper.StoreKey, nftkeeper.StoreKey, group.StoreKey,
+		authzkeeper.StoreKey, nftkeeper.StoreKey, group.StoreKey, circuit.StoreKey,
+		epochingtypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
 	// NOTE: The testingkey is just mounted for testing purposes. Actual applications should
@@ -260,22 +276,28 @@ func NewSimApp(
 	app.AccountKeeper = authkeeper.NewAccountKeeper(
 		appCodec, keys[authtypes.StoreKey], app.GetSubspace(authtypes.ModuleName), authtypes.ProtoBaseAccount, maccPerms, sdk.Bech32MainPrefix,
 	)
+	// the governance module account is the authority allowed to update the
+	// params of the modules below
+	govAuthority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
 	app.BankKeeper = bankkeeper.NewBaseKeeper(
 		appCodec, keys[banktypes.StoreKey], app.AccountKeeper, app.GetSubspace(banktypes.ModuleName), app.ModuleAccountAddrs(),
+		govAuthority,
 	)
 	stakingKeeper := stakingkeeper.NewKeeper(
 		appCodec, keys[stakingtypes.StoreKey], app.AccountKeeper, app.BankKeeper, app.GetSubspace(stakingtypes.ModuleName),
+		govAuthority,
 	)
 	app.MintKeeper = mintkeeper.NewKeeper(
 		appCodec, keys[minttypes.StoreKey], app.GetSubspace(minttypes.ModuleName), &stakingKeeper,
-		app.AccountKeeper, app.BankKeeper, authtypes.FeeCollectorName,
+		app.AccountKeeper, app.BankKeeper, authtypes.FeeCollectorName, govAuthority,
 	)
 	app.DistrKeeper = distrkeeper.NewKeeper(
 		appCodec, keys[distrtypes.StoreKey], app.GetSubspace(distrtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
-		&stakingKeeper, authtypes.FeeCollectorName,
+		&stakingKeeper, authtypes.FeeCollectorName, govAuthority,
 	)
 	app.SlashingKeeper = slashingkeeper.NewKeeper(
-		appCodec, keys[slashingtypes.StoreKey], &stakingKeeper, app.GetSubspace(slashingtypes.ModuleName),
+		appCodec, keys[slashingtypes.StoreKey], &stakingKeeper, app.GetSubspace(slashingtypes.ModuleName), govAuthority,
 	)
 	app.CrisisKeeper = crisiskeeper.NewKeeper(
 		app.GetSubspace(crisistypes.ModuleName), invCheckPeriod, app.BankKeeper, authtypes.FeeCollectorName,
@@ -317,7 +339,7 @@ func NewSimApp(
 	*/
 	govKeeper := govkeeper.NewKeeper(
 		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
-		&stakingKeeper, govRouter, app.MsgServiceRouter(), govConfig,
+		&stakingKeeper, app.DistrKeeper, govRouter, app.MsgServiceRouter(), govConfig, govAuthority,
 	)
 
 	app.GovKeeper = *govKeeper.SetHooks(
@@ -328,6 +350,21 @@ func NewSimApp(
 
 	app.NFTKeeper = nftkeeper.NewKeeper(keys[nftkeeper.StoreKey], appCodec, app.AccountKeeper, app.BankKeeper)
 
+	app.CircuitKeeper = circuitkeeper.NewKeeper(appCodec, keys[circuit.StoreKey], authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	// the raw staking messages are only rejected, so that they must go through
+	// the epoch queue of the epoching module, if the chain opts in
+	requireQueuedStakingMsgs := cast.ToBool(appOpts.Get(epoching.FlagRequireQueuedStakingMsgs))
+	if requireQueuedStakingMsgs {
+		app.MsgServiceRouter().SetCircuit(epoching.NewStakingMsgsCircuitBreaker(app.CircuitKeeper))
+	} else {
+		app.MsgServiceRouter().SetCircuit(app.CircuitKeeper)
+	}
+
+	app.EpochingKeeper = epochingkeeper.NewKeeper(
+		appCodec, keys[epochingtypes.StoreKey],
+		app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.MsgServiceRouter(), govAuthority,
+	)
+
 	// create evidence keeper with router
 	evidenceKeeper := evidencekeeper.NewKeeper(
 		appCodec, keys[evidencetypes.StoreKey], &app.StakingKeeper, app.SlashingKeeper,
@@ -349,7 +386,7 @@ func NewSimApp(
 			encodingConfig.TxConfig,
 		),
 		auth.NewAppModule(appCodec, app.AccountKeeper, authsims.RandomGenesisAccounts),
-		vesting.NewAppModule(app.AccountKeeper, app.BankKeeper),
+		vesting.NewAppModule(app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
 		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
 		crisis.NewAppModule(&app.CrisisKeeper, skipGenesisInvariants),
@@ -365,6 +402,8 @@ func NewSimApp(
 		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 		groupmodule.NewAppModule(appCodec, app.GroupKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 		nftmodule.NewAppModule(appCodec, app.NFTKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
+		circuitmodule.NewAppModule(appCodec, app.CircuitKeeper),
+		epoching.NewAppModule(appCodec, app.EpochingKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -377,15 +416,18 @@ func NewSimApp(
 		evidencetypes.ModuleName, stakingtypes.ModuleName,
 		authtypes.ModuleName, banktypes.ModuleName, govtypes.ModuleName, crisistypes.ModuleName, genutiltypes.ModuleName,
 		authz.ModuleName, feegrant.ModuleName, nft.ModuleName, group.ModuleName,
-		paramstypes.ModuleName, vestingtypes.ModuleName,
+		paramstypes.ModuleName, vestingtypes.ModuleName, circuit.ModuleName, epochingtypes.ModuleName,
 	)
+	// NOTE: the epoching module's endblocker must come before the staking one,
+	// so that the validator set updates of the queued messages are applied at
+	// the end of the epoch.
 	app.mm.SetOrderEndBlockers(
-		crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName,
+		crisistypes.ModuleName, govtypes.ModuleName, epochingtypes.ModuleName, stakingtypes.ModuleName,
 		capabilitytypes.ModuleName, authtypes.ModuleName, banktypes.ModuleName, distrtypes.ModuleName,
 		slashingtypes.ModuleName, minttypes.ModuleName,
 		genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName,
 		feegrant.ModuleName, nft.ModuleName, group.ModuleName,
-		paramstypes.ModuleName, upgradetypes.ModuleName, vestingtypes.ModuleName,
+		paramstypes.ModuleName, upgradetypes.ModuleName, vestingtypes.ModuleName, circuit.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -399,7 +441,7 @@ func NewSimApp(
 		slashingtypes.ModuleName, govtypes.ModuleName, minttypes.ModuleName, crisistypes.ModuleName,
 		genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName,
 		feegrant.ModuleName, nft.ModuleName, group.ModuleName,
-		paramstypes.ModuleName, upgradetypes.ModuleName, vestingtypes.ModuleName,
+		paramstypes.ModuleName, upgradetypes.ModuleName, vestingtypes.ModuleName, circuit.ModuleName, epochingtypes.ModuleName,
 	)
 
 	// Uncomment if you want to set a custom migration order here.
@@ -423,6 +465,9 @@ func NewSimApp(
 	// transactions
 	overrideModules := map[string]module.AppModuleSimulation{
 		authtypes.ModuleName: auth.NewAppModule(app.appCodec, app.AccountKeeper, authsims.RandomGenesisAccounts),
+		// the staking operations send their messages through the epoch queue,
+		// which works whether the raw staking messages are rejected or not
+		stakingtypes.ModuleName: epoching.NewQueuedSimulationModule(app.mm.Modules[stakingtypes.ModuleName].(module.AppModuleSimulation)),
 	}
 	app.sm = module.NewSimulationManagerFromAppModules(app.mm.Modules, overrideModules)
 
@@ -437,7 +482,7 @@ func NewSimApp(
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
-	app.setAnteHandler(encodingConfig.TxConfig)
+	app.setAnteHandler(encodingConfig.TxConfig, requireQueuedStakingMsgs)
 	// In v0.46, the SDK introduces _postHandlers_. PostHandlers are like
 	// antehandlers, but are run _after_ the `runMsgs` execution. They are also
 	// defined as a chain, and have the same signature as antehandlers.
@@ -462,21 +507,51 @@ func NewSimApp(
 	return app
 }
 
-func (app *SimApp) setAnteHandler(txConfig client.TxConfig) {
+// maxPrioritizedTxsPerSender is the number of transactions of a sender that
+// get their fee based priority in a block.
+const maxPrioritizedTxsPerSender = 16
+
+func (app *SimApp) setAnteHandler(txConfig client.TxConfig, requireQueuedStakingMsgs bool) {
+	// signatures verified in CheckTx are not verified again in DeliverTx
+	sigVerificationCache, err := ante.NewSigVerificationCache(ante.DefaultSigVerificationCacheSize)
+	if err != nil {
+		panic(err)
+	}
+	app.sigVerificationCache = sigVerificationCache
+
 	anteHandler, err := ante.NewAnteHandler(
 		ante.HandlerOptions{
-			AccountKeeper:   app.AccountKeeper,
-			BankKeeper:      app.BankKeeper,
-			SignModeHandler: txConfig.SignModeHandler(),
-			FeegrantKeeper:  app.FeeGrantKeeper,
-			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+			AccountKeeper:        app.AccountKeeper,
+			BankKeeper:           app.BankKeeper,
+			SignModeHandler:      txConfig.SignModeHandler(),
+			FeegrantKeeper:       app.FeeGrantKeeper,
+			SigGasConsumer:       ante.DefaultSigVerificationGasConsumer,
+			SigVerificationCache: sigVerificationCache,
+			// NOTE: no tx sender is reported to the mempool, so that a signer
+			// can have several pending transactions with Tendermint's priority
+			// mempool (v1), see ante.DefaultTxSender. Instead, the transactions
+			// of a sender past the first ones of a block get the lowest priority.
+			TxPriority: ante.NewSenderFairnessPriorityFn(
+				ante.NewFeePerGasPriorityFn(sdk.NewDecCoins(sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.OneInt()))),
+				maxPrioritizedTxsPerSender,
+			),
 		},
 	)
 	if err != nil {
 		panic(err)
 	}
 
-	app.SetAnteHandler(anteHandler)
+	if !requireQueuedStakingMsgs {
+		app.SetAnteHandler(anteHandler)
+		return
+	}
+
+	// the raw staking messages are rejected in CheckTx too, before any fee is
+	// paid
+	rejectStakingMsgs := epoching.NewRejectStakingMsgsDecorator()
+	app.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		return rejectStakingMsgs.AnteHandle(ctx, tx, simulate, anteHandler)
+	})
 }
 
 func (app *SimApp) setPostHandler() {
@@ -657,5 +732,12 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(govtypes.ModuleName).WithKeyTable(govv1.ParamKeyTable())
 	paramsKeeper.Subspace(crisistypes.ModuleName)
 
+	// these modules keep their params in their own store, the subspaces are
+	// only kept to migrate them.
+	paramsKeeper.SetMigrated(
+		banktypes.ModuleName, stakingtypes.ModuleName, minttypes.ModuleName,
+		distrtypes.ModuleName, slashingtypes.ModuleName, govtypes.ModuleName,
+	)
+
 	return paramsKeeper
 }
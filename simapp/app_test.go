@@ -2,8 +2,10 @@ package simapp
 
 import (
 	"encoding/json"
+	"math/rand"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -13,17 +15,23 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
 	"github.com/cosmos/cosmos-sdk/tests/mocks"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/capability"
+	circuitmodule "github.com/cosmos/cosmos-sdk/x/circuit/module"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
+	epochingtypes "github.com/cosmos/cosmos-sdk/x/epoching/types"
 	"github.com/cosmos/cosmos-sdk/x/evidence"
 	feegrantmodule "github.com/cosmos/cosmos-sdk/x/feegrant/module"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
@@ -128,8 +136,13 @@ func TestRunMigrations(t *testing.T) {
 			false, "", true, "no migration found for module bank from version 2 to version 3: not found", 0,
 		},
 		{
-			"can register 2->3 migration handler for x/bank, can run migration",
+			"can register 2->3 migration handler for x/bank, cannot run migration",
 			"bank", 2,
+			false, "", true, "no migration found for module bank from version 3 to version 4: not found", 0,
+		},
+		{
+			"can register 3->4 migration handler for x/bank, can run migration",
+			"bank", 3,
 			false, "", false, "", 1,
 		},
 		{
@@ -188,6 +201,8 @@ func TestRunMigrations(t *testing.T) {
 					"crisis":       crisis.AppModule{}.ConsensusVersion(),
 					"genutil":      genutil.AppModule{}.ConsensusVersion(),
 					"capability":   capability.AppModule{}.ConsensusVersion(),
+					"circuit":      circuitmodule.AppModule{}.ConsensusVersion(),
+					"epoching":     epoching.AppModule{}.ConsensusVersion(),
 				},
 			)
 			if tc.expRunErr {
@@ -266,3 +281,70 @@ func TestUpgradeStateOnGenesis(t *testing.T) {
 		require.Equal(t, vm[v], i.ConsensusVersion())
 	}
 }
+
+func TestEpochingEndBlockerRunsOncePerBlock(t *testing.T) {
+	app := Setup(t, false)
+
+	const epochLength = 3
+	epochEnds := 0
+	for height := app.LastBlockHeight() + 1; height <= 2*epochLength-1; height++ {
+		header := tmproto.Header{Height: height}
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+		if height == app.LastBlockHeight()+1 {
+			app.EpochingKeeper.SetParams(app.NewContext(false, header), epochingtypes.NewParams(epochLength))
+		}
+		res := app.EndBlock(abci.RequestEndBlock{Height: height})
+		app.Commit()
+
+		for _, event := range res.Events {
+			if event.Type == epochingtypes.EventTypeEpochEnd {
+				epochEnds++
+			}
+		}
+	}
+
+	// a single epoch boundary was crossed
+	require.Equal(t, 1, epochEnds)
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+	require.Equal(t, uint64(epochingtypes.DefaultEpochNumber+1), app.EpochingKeeper.GetEpochNumber(ctx))
+}
+
+func TestSigVerificationCacheSkipsDeliverTxVerification(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	app := SetupWithGenesisAccounts(t,
+		[]authtypes.GenesisAccount{authtypes.NewBaseAccount(addr, nil, 0, 0)},
+		banktypes.Balance{Address: addr.String(), Coins: coins},
+	)
+
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+	accNum := app.AccountKeeper.GetAccount(ctx, addr).GetAccountNumber()
+	txConfig := MakeTestEncodingConfig().TxConfig
+	tx, err := helpers.GenSignedMockTx(
+		rand.New(rand.NewSource(time.Now().UnixNano())),
+		txConfig,
+		[]sdk.Msg{banktypes.NewMsgSend(addr, addr, coins)},
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)),
+		helpers.DefaultGenTxGas,
+		"",
+		[]uint64{accNum},
+		[]uint64{0},
+		priv,
+	)
+	require.NoError(t, err)
+	txBytes, err := txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	checkRes := app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+	require.True(t, checkRes.IsOK(), checkRes.Log)
+	require.Equal(t, 1, app.sigVerificationCache.Len())
+	require.Equal(t, uint64(0), app.sigVerificationCache.Hits())
+
+	deliverRes := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, deliverRes.IsOK(), deliverRes.Log)
+
+	// the signature is verified in CheckTx and found in the cache in DeliverTx
+	require.Equal(t, uint64(1), app.sigVerificationCache.Misses())
+	require.Equal(t, uint64(1), app.sigVerificationCache.Hits())
+}
@@ -164,17 +164,19 @@ func genesisStateWithValSet(t *testing.T,
 		pkAny, err := codectypes.NewAnyWithValue(pk)
 		require.NoError(t, err)
 		validator := stakingtypes.Validator{
-			OperatorAddress:   sdk.ValAddress(val.Address).String(),
-			ConsensusPubkey:   pkAny,
-			Jailed:            false,
-			Status:            stakingtypes.Bonded,
-			Tokens:            bondAmt,
-			DelegatorShares:   sdk.OneDec(),
-			Description:       stakingtypes.Description{},
-			UnbondingHeight:   int64(0),
-			UnbondingTime:     time.Unix(0, 0).UTC(),
-			Commission:        stakingtypes.NewCommission(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
-			MinSelfDelegation: sdk.ZeroInt(),
+			OperatorAddress:     sdk.ValAddress(val.Address).String(),
+			ConsensusPubkey:     pkAny,
+			Jailed:              false,
+			Status:              stakingtypes.Bonded,
+			Tokens:              bondAmt,
+			DelegatorShares:     sdk.OneDec(),
+			Description:         stakingtypes.Description{},
+			UnbondingHeight:     int64(0),
+			UnbondingTime:       time.Unix(0, 0).UTC(),
+			Commission:          stakingtypes.NewCommission(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+			MinSelfDelegation:   sdk.ZeroInt(),
+			LiquidShares:        sdk.ZeroDec(),
+			ValidatorBondShares: sdk.ZeroDec(),
 		}
 		validators = append(validators, validator)
 		delegations = append(delegations, stakingtypes.NewDelegation(genAccs[0].GetAddress(), val.Address.Bytes(), sdk.OneDec()))
@@ -4,6 +4,8 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+	epochingtypes "github.com/cosmos/cosmos-sdk/x/epoching/types"
 	"github.com/cosmos/cosmos-sdk/x/group"
 	"github.com/cosmos/cosmos-sdk/x/nft"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
@@ -15,26 +17,47 @@ import (
 // when an application is migrating from Cosmos SDK version v0.45.x to v0.46.x.
 const UpgradeName = "v045-to-v046"
 
+// AddModulesUpgradeName defines the on-chain upgrade name for the sample simapp upgrade
+// adding the x/circuit and x/epoching module stores to a chain already running v0.46.x.
+const AddModulesUpgradeName = "v046-add-modules"
+
 func (app SimApp) RegisterUpgradeHandlers() {
-	app.UpgradeKeeper.SetUpgradeHandler(UpgradeName,
-		func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
-			return app.mm.RunMigrations(ctx, app.configurator, fromVM)
-		})
+	for _, name := range []string{UpgradeName, AddModulesUpgradeName} {
+		app.UpgradeKeeper.SetUpgradeHandler(name,
+			func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+				return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+			})
+	}
 
 	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
 	if err != nil {
 		panic(err)
 	}
 
-	if upgradeInfo.Name == UpgradeName && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
-		storeUpgrades := storetypes.StoreUpgrades{
+	if app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		return
+	}
+
+	var storeUpgrades storetypes.StoreUpgrades
+	switch upgradeInfo.Name {
+	case UpgradeName:
+		storeUpgrades = storetypes.StoreUpgrades{
 			Added: []string{
 				group.ModuleName,
 				nft.ModuleName,
 			},
 		}
-
-		// configure store loader that checks if version == upgradeHeight and applies store upgrades
-		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
+	case AddModulesUpgradeName:
+		storeUpgrades = storetypes.StoreUpgrades{
+			Added: []string{
+				circuit.StoreKey,
+				epochingtypes.StoreKey,
+			},
+		}
+	default:
+		return
 	}
+
+	// configure store loader that checks if version == upgradeHeight and applies store upgrades
+	app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
 }
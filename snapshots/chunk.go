@@ -55,10 +55,17 @@ func (w *ChunkWriter) Close() error {
 	return nil
 }
 
-// CloseWithError closes the writer and sends an error to the reader.
+// CloseWithError closes the writer and sends an error to the reader. If no chunk was written yet,
+// a chunk failing with the error is sent, so the reader doesn't mistake the failure for an empty
+// stream.
 func (w *ChunkWriter) CloseWithError(err error) {
 	if !w.closed {
 		w.closed = true
+		if w.pipe == nil {
+			pr, pw := io.Pipe()
+			_ = pw.CloseWithError(err) // CloseWithError always returns nil
+			w.ch <- pr
+		}
 		close(w.ch)
 		if w.pipe != nil {
 			_ = w.pipe.CloseWithError(err) // CloseWithError always returns nil
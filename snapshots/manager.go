@@ -13,6 +13,7 @@ import (
 
 	"github.com/tendermint/tendermint/libs/log"
 
+	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
 	"github.com/cosmos/cosmos-sdk/snapshots/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -157,6 +158,23 @@ func (m *Manager) GetSnapshotBlockRetentionHeights() int64 {
 
 // Create creates a snapshot and returns its metadata.
 func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
+	return m.create(height, 0, false)
+}
+
+// CreateDelta creates a delta snapshot holding the changes since the snapshot at baseHeight, and
+// returns its metadata. Every height since baseHeight must still be available in the multistore,
+// so the pruning must keep at least the heights since baseHeight, otherwise an error is returned.
+func (m *Manager) CreateDelta(height, baseHeight uint64) (*types.Snapshot, error) {
+	if baseHeight == 0 || baseHeight >= height {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "invalid delta snapshot base height %v for height %v", baseHeight, height)
+	}
+	return m.create(height, baseHeight, false)
+}
+
+// create creates a full snapshot, or a delta snapshot if baseHeight is not zero. If fallback is
+// set, a full snapshot is created when the delta snapshot fails, e.g. because a height since
+// baseHeight has been pruned, and the failure is logged.
+func (m *Manager) create(height, baseHeight uint64, fallback bool) (*types.Snapshot, error) {
 	if m == nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "no snapshot store configured")
 	}
@@ -178,16 +196,82 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 			"a more recent snapshot already exists at height %v", latest.Height)
 	}
 
+	if baseHeight > 0 {
+		snapshot, err := m.createDelta(height, baseHeight)
+		if err == nil || !fallback {
+			return snapshot, err
+		}
+
+		m.logger.Error("failed to create delta snapshot, creating full snapshot", "height", height, "base_height", baseHeight, "err", err)
+		if err := m.store.Delete(height, types.DeltaFormat); err != nil {
+			return nil, sdkerrors.Wrap(err, "failed to delete incomplete delta snapshot")
+		}
+	}
+
+	if m.opts.ExportWorkers > 0 {
+		if _, ok := m.multistore.(types.StoreSnapshotter); ok {
+			return m.createParallelSnapshot(height)
+		}
+	}
+
 	// Spawn goroutine to generate snapshot chunks and pass their io.ReadClosers through a channel
 	ch := make(chan io.ReadCloser)
-	go m.createSnapshot(height, ch)
+	go m.createSnapshot(height, 0, ch)
 
 	return m.store.Save(height, types.CurrentFormat, ch)
 }
 
+// createDelta creates a delta snapshot after the validations of request are done.
+func (m *Manager) createDelta(height, baseHeight uint64) (*types.Snapshot, error) {
+	deltaSnapshotter, ok := m.multistore.(types.DeltaSnapshotter)
+	if !ok {
+		return nil, sdkerrors.Wrap(types.ErrUnknownFormat, "multistore doesn't support delta snapshots")
+	}
+
+	// the heights more than KeepRecent heights below the latest one may have been pruned.
+	pruning := deltaSnapshotter.GetPruning()
+	latest := deltaSnapshotter.LatestVersion()
+	if pruning.Strategy != pruningtypes.PruningNothing && latest > int64(baseHeight) && uint64(latest)-baseHeight > pruning.KeepRecent {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic,
+			"delta snapshot requires every height since %v to be kept, but the pruning only keeps the %v most recent heights",
+			baseHeight, pruning.KeepRecent)
+	}
+
+	base, err := m.getSnapshot(baseHeight)
+	if err != nil {
+		return nil, err
+	}
+	if base == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no snapshot at base height %v", baseHeight)
+	}
+
+	appHash, err := deltaSnapshotter.AppHash(height)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "failed to get the app hash at height %v", height)
+	}
+
+	ch := make(chan io.ReadCloser)
+	go m.createSnapshot(height, baseHeight, ch)
+
+	return m.store.SaveDelta(height, baseHeight, appHash, ch)
+}
+
+// getSnapshot returns the snapshot at height in any format supported by the manager, or nil if
+// there is none.
+func (m *Manager) getSnapshot(height uint64) (*types.Snapshot, error) {
+	for _, format := range []uint32{types.CurrentFormat, types.ParallelFormat, types.DeltaFormat} {
+		snapshot, err := m.store.Get(height, format)
+		if err != nil || snapshot != nil {
+			return snapshot, err
+		}
+	}
+	return nil, nil
+}
+
 // createSnapshot do the heavy work of snapshotting after the validations of request are done
-// the produced chunks are written to the channel.
-func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
+// the produced chunks are written to the channel. A delta snapshot of the multistore is written
+// if baseHeight is not zero, extensions are always snapshotted in full.
+func (m *Manager) createSnapshot(height, baseHeight uint64, ch chan<- io.ReadCloser) {
 	streamWriter := NewStreamWriter(ch)
 	if streamWriter == nil {
 		return
@@ -198,7 +282,13 @@ func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
 		}
 	}()
 
-	if err := m.multistore.Snapshot(height, streamWriter); err != nil {
+	var err error
+	if baseHeight > 0 {
+		err = m.multistore.(types.DeltaSnapshotter).SnapshotDelta(baseHeight, height, streamWriter)
+	} else {
+		err = m.multistore.Snapshot(height, streamWriter)
+	}
+	if err != nil {
 		streamWriter.CloseWithError(err)
 		return
 	}
@@ -255,7 +345,9 @@ func (m *Manager) Prune(retain uint32) (uint64, error) {
 }
 
 // Restore begins an async snapshot restoration, mirroring ABCI OfferSnapshot. Chunks must be fed
-// via RestoreChunk() until the restore is complete or a chunk fails.
+// via RestoreChunk() until the restore is complete or a chunk fails. A delta snapshot can only be
+// restored on top of the state at its base height, so a chain of delta snapshots is restored by
+// restoring its base snapshot followed by each delta in turn.
 func (m *Manager) Restore(snapshot types.Snapshot) error {
 	if snapshot.Chunks == 0 {
 		return sdkerrors.Wrap(types.ErrInvalidMetadata, "no chunks")
@@ -269,7 +361,13 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	defer m.mtx.Unlock()
 
 	// check multistore supported format preemptive
-	if snapshot.Format != types.CurrentFormat {
+	switch snapshot.Format {
+	case types.CurrentFormat, types.DeltaFormat:
+	case types.ParallelFormat:
+		if _, ok := m.multistore.(types.StoreSnapshotter); !ok {
+			return sdkerrors.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
+		}
+	default:
 		return sdkerrors.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
 	}
 	if snapshot.Height == 0 {
@@ -279,6 +377,16 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 		return sdkerrors.Wrapf(types.ErrInvalidMetadata,
 			"snapshot height %v cannot exceed %v", snapshot.Height, int64(math.MaxInt64))
 	}
+	if snapshot.Format == types.DeltaFormat {
+		if err := m.validateDelta(snapshot); err != nil {
+			return err
+		}
+	}
+	if snapshot.Format == types.ParallelFormat {
+		if err := validateStreams(snapshot); err != nil {
+			return err
+		}
+	}
 
 	err := m.beginLocked(opRestore)
 	if err != nil {
@@ -294,10 +402,13 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 		return sdkerrors.Wrapf(err, "failed to create snapshot directory %q", dir)
 	}
 
-	chChunks := m.loadChunkStream(snapshot.Height, snapshot.Format, chChunkIDs)
-
 	go func() {
-		err := m.doRestoreSnapshot(snapshot, chChunks)
+		var err error
+		if snapshot.Format == types.ParallelFormat {
+			err = m.doRestoreStreams(snapshot, chChunkIDs)
+		} else {
+			err = m.doRestoreSnapshot(snapshot, m.loadChunkStream(snapshot.Height, snapshot.Format, chChunkIDs))
+		}
 		chDone <- restoreDone{
 			complete: err == nil,
 			err:      err,
@@ -312,6 +423,28 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	return nil
 }
 
+// validateDelta checks the delta snapshot can be restored on top of the current state.
+func (m *Manager) validateDelta(snapshot types.Snapshot) error {
+	deltaSnapshotter, ok := m.multistore.(types.DeltaSnapshotter)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
+	}
+
+	baseHeight := snapshot.Metadata.BaseHeight
+	if baseHeight == 0 || baseHeight >= snapshot.Height {
+		return sdkerrors.Wrapf(types.ErrInvalidMetadata,
+			"invalid delta snapshot base height %v for height %v", baseHeight, snapshot.Height)
+	}
+	if latest := deltaSnapshotter.LatestVersion(); latest != int64(baseHeight) {
+		return sdkerrors.Wrapf(types.ErrInvalidMetadata,
+			"delta snapshot is based on height %v, but the state is at height %v", baseHeight, latest)
+	}
+	if len(snapshot.Metadata.AppHash) == 0 {
+		return sdkerrors.Wrap(types.ErrInvalidMetadata, "delta snapshot has no app hash")
+	}
+	return nil
+}
+
 func (m *Manager) loadChunkStream(height uint64, format uint32, chunkIDs <-chan uint32) <-chan io.ReadCloser {
 	chunks := make(chan io.ReadCloser, chunkBufferSize)
 	go func() {
@@ -343,7 +476,16 @@ func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.
 	}
 	defer streamReader.Close()
 
-	next, err := m.multistore.Restore(snapshot.Height, snapshot.Format, streamReader)
+	var next types.SnapshotItem
+	if snapshot.Format == types.DeltaFormat {
+		deltaSnapshotter, ok := m.multistore.(types.DeltaSnapshotter)
+		if !ok {
+			return sdkerrors.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
+		}
+		next, err = deltaSnapshotter.RestoreDelta(snapshot.Metadata.BaseHeight, snapshot.Height, snapshot.Metadata.AppHash, streamReader)
+	} else {
+		next, err = m.multistore.Restore(snapshot.Height, snapshot.Format, streamReader)
+	}
 	if err != nil {
 		return sdkerrors.Wrap(err, "multistore restore")
 	}
@@ -435,9 +577,11 @@ func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
 	return false, nil
 }
 
-// RestoreLocalSnapshot restores app state from a local snapshot.
+// RestoreLocalSnapshot restores app state from a local snapshot. For a delta snapshot, the chain
+// of snapshots it is based on is restored first, starting from a full snapshot or from the
+// current state if it is at the base height of one of the deltas.
 func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
-	snapshot, ch, err := m.store.Load(height, format)
+	snapshot, err := m.store.Get(height, format)
 	if err != nil {
 		return err
 	}
@@ -446,6 +590,11 @@ func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
 		return fmt.Errorf("snapshot doesn't exist, height: %d, format: %d", height, format)
 	}
 
+	chain, err := m.restoreChain(snapshot)
+	if err != nil {
+		return err
+	}
+
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
 
@@ -455,7 +604,51 @@ func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
 	}
 	defer m.endLocked()
 
-	return m.doRestoreSnapshot(*snapshot, ch)
+	for _, snapshot := range chain {
+		if snapshot.Format == types.ParallelFormat {
+			if err := m.doRestoreStreams(*snapshot, chunkIDs(snapshot.Chunks)); err != nil {
+				return sdkerrors.Wrapf(err, "restore snapshot at height %v", snapshot.Height)
+			}
+			continue
+		}
+
+		_, ch, err := m.store.Load(snapshot.Height, snapshot.Format)
+		if err != nil {
+			return err
+		}
+
+		if err := m.doRestoreSnapshot(*snapshot, ch); err != nil {
+			DrainChunks(ch)
+			return sdkerrors.Wrapf(err, "restore snapshot at height %v", snapshot.Height)
+		}
+	}
+
+	return nil
+}
+
+// restoreChain returns the snapshots to restore in order to restore snapshot.
+func (m *Manager) restoreChain(snapshot *types.Snapshot) ([]*types.Snapshot, error) {
+	var latest int64
+	if deltaSnapshotter, ok := m.multistore.(types.DeltaSnapshotter); ok {
+		latest = deltaSnapshotter.LatestVersion()
+	}
+
+	chain := []*types.Snapshot{snapshot}
+	for snapshot.Format == types.DeltaFormat && int64(snapshot.Metadata.BaseHeight) != latest {
+		base, err := m.getSnapshot(snapshot.Metadata.BaseHeight)
+		if err != nil {
+			return nil, err
+		}
+		if base == nil {
+			return nil, fmt.Errorf("base snapshot of delta snapshot at height %d doesn't exist, height: %d",
+				snapshot.Height, snapshot.Metadata.BaseHeight)
+		}
+
+		snapshot = base
+		chain = append([]*types.Snapshot{snapshot}, chain...)
+	}
+
+	return chain, nil
 }
 
 // sortedExtensionNames sort extension names for deterministic iteration.
@@ -505,13 +698,13 @@ func (m *Manager) snapshot(height int64) {
 		return
 	}
 
-	snapshot, err := m.Create(uint64(height))
+	snapshot, err := m.create(uint64(height), m.deltaBaseHeight(uint64(height)), true)
 	if err != nil {
 		m.logger.Error("failed to create state snapshot", "height", height, "err", err)
 		return
 	}
 
-	m.logger.Info("completed state snapshot", "height", height, "format", snapshot.Format)
+	m.logger.Info("completed state snapshot", "height", height, "format", snapshot.Format, "base_height", snapshot.Metadata.BaseHeight)
 
 	if m.opts.KeepRecent > 0 {
 		m.logger.Debug("pruning state snapshots")
@@ -525,3 +718,37 @@ func (m *Manager) snapshot(height int64) {
 		m.logger.Debug("pruned state snapshots", "pruned", pruned)
 	}
 }
+
+// deltaBaseHeight returns the height of the snapshot the snapshot at height should be a delta of,
+// or 0 if a full snapshot should be taken. A delta snapshot is taken when the previous snapshot
+// was taken at the previous interval, unless the configured number of delta snapshots have been
+// taken since the last full snapshot. Delta snapshots require the pruning to keep every height
+// since the previous snapshot, otherwise create falls back to a full snapshot.
+func (m *Manager) deltaBaseHeight(height uint64) uint64 {
+	if m.opts.DeltaSnapshots == 0 {
+		return 0
+	}
+	if _, ok := m.multistore.(types.DeltaSnapshotter); !ok {
+		return 0
+	}
+
+	latest, err := m.store.GetLatest()
+	if err != nil || latest == nil || latest.Height+m.opts.Interval != height {
+		return 0
+	}
+
+	deltas := uint32(0)
+	for snapshot := latest; snapshot.Format == types.DeltaFormat; {
+		deltas++
+		if deltas >= m.opts.DeltaSnapshots {
+			return 0
+		}
+
+		snapshot, err = m.getSnapshot(snapshot.Metadata.BaseHeight)
+		if err != nil || snapshot == nil {
+			return 0
+		}
+	}
+
+	return latest.Height
+}
@@ -154,6 +154,16 @@ func TestManager_Restore(t *testing.T) {
 	require.Error(t, err)
 	require.ErrorIs(t, err, types.ErrUnknownFormat)
 
+	// Restore errors on delta snapshots if the multistore doesn't support them
+	err = manager.Restore(types.Snapshot{
+		Height:   3,
+		Format:   types.DeltaFormat,
+		Hash:     []byte{1, 2, 3},
+		Chunks:   uint32(len(chunks)),
+		Metadata: types.Metadata{ChunkHashes: checksums(chunks), BaseHeight: 2},
+	})
+	require.ErrorIs(t, err, types.ErrUnknownFormat)
+
 	// Restore errors on no chunks
 	err = manager.Restore(types.Snapshot{Height: 3, Format: types.CurrentFormat, Hash: []byte{1, 2, 3}})
 	require.Error(t, err)
@@ -0,0 +1,332 @@
+package snapshots
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"sync"
+
+	protoio "github.com/gogo/protobuf/io"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/snapshots/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// createParallelSnapshot creates a ParallelFormat snapshot, exporting each store and extension
+// into its own chunk stream, after the validations of request are done. Up to ExportWorkers
+// streams are generated concurrently, the output doesn't depend on the number of workers.
+func (m *Manager) createParallelSnapshot(height uint64) (*types.Snapshot, error) {
+	storeSnapshotter, ok := m.multistore.(types.StoreSnapshotter)
+	if !ok {
+		return nil, sdkerrors.Wrap(types.ErrUnknownFormat, "multistore doesn't support parallel snapshots")
+	}
+
+	names, err := storeSnapshotter.SnapshotStoreNames()
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := make([]func(protoWriter protoio.Writer) error, 0, len(names)+len(m.extensions))
+	for _, name := range names {
+		name := name
+		jobs = append(jobs, func(protoWriter protoio.Writer) error {
+			return storeSnapshotter.SnapshotStore(height, name, protoWriter)
+		})
+	}
+	for _, name := range m.sortedExtensionNames() {
+		name, extension := name, m.extensions[name]
+		jobs = append(jobs, func(protoWriter protoio.Writer) error {
+			// write extension metadata
+			err := protoWriter.WriteMsg(&types.SnapshotItem{
+				Item: &types.SnapshotItem_Extension{
+					Extension: &types.SnapshotExtensionMeta{
+						Name:   name,
+						Format: extension.SnapshotFormat(),
+					},
+				},
+			})
+			if err != nil {
+				return err
+			}
+			return extension.Snapshot(height, protoWriter)
+		})
+	}
+
+	chs := make([]chan io.ReadCloser, len(jobs))
+	streams := make([]<-chan io.ReadCloser, len(jobs))
+	for i := range jobs {
+		chs[i] = make(chan io.ReadCloser)
+		streams[i] = chs[i]
+	}
+
+	go runWorkers(int(m.opts.ExportWorkers), len(jobs), func(i int) {
+		writeStream(chs[i], jobs[i])
+	})
+
+	return m.store.SaveStreams(height, types.ParallelFormat, streams)
+}
+
+// writeStream writes the items written by job into a chunk stream.
+func writeStream(ch chan<- io.ReadCloser, job func(protoWriter protoio.Writer) error) {
+	streamWriter := NewStreamWriter(ch)
+	if streamWriter == nil {
+		return
+	}
+
+	if err := job(streamWriter); err != nil {
+		streamWriter.CloseWithError(err)
+		return
+	}
+	if err := streamWriter.Close(); err != nil {
+		streamWriter.CloseWithError(err)
+	}
+}
+
+// runWorkers calls fn for the indexes [0, n) in order, from up to workers goroutines, and waits
+// for all the calls to complete. A non-positive workers runs one goroutine per index.
+func runWorkers(workers, n int, fn func(i int)) {
+	if workers <= 0 || workers > n {
+		workers = n
+	}
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				fn(i)
+			}
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+}
+
+// validateStreams checks the chunk streams of a ParallelFormat snapshot match its chunks.
+func validateStreams(snapshot types.Snapshot) error {
+	if len(snapshot.Metadata.StreamChunks) == 0 {
+		return sdkerrors.Wrap(types.ErrInvalidMetadata, "no chunk streams")
+	}
+
+	chunks := uint64(0)
+	for _, streamChunks := range snapshot.Metadata.StreamChunks {
+		if streamChunks == 0 {
+			return sdkerrors.Wrap(types.ErrInvalidMetadata, "empty chunk stream")
+		}
+		chunks += uint64(streamChunks)
+	}
+	if chunks != uint64(snapshot.Chunks) {
+		return sdkerrors.Wrapf(types.ErrInvalidMetadata, "snapshot has %v chunks in streams, but %v chunks",
+			chunks, snapshot.Chunks)
+	}
+	return nil
+}
+
+// doRestoreStreams restores a ParallelFormat snapshot, given the IDs of its chunks in order, after
+// preliminary checks on request have passed. Up to RestoreWorkers chunk streams are restored
+// concurrently as soon as their chunks are available. The stores are restored first, the
+// extensions are restored once the multistore restore is complete.
+func (m *Manager) doRestoreStreams(snapshot types.Snapshot, chChunkIDs <-chan uint32) error {
+	storeSnapshotter, ok := m.multistore.(types.StoreSnapshotter)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
+	}
+	if err := validateStreams(snapshot); err != nil {
+		return err
+	}
+
+	dir := m.store.pathSnapshot(snapshot.Height, snapshot.Format)
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return sdkerrors.Wrapf(err, "failed to create snapshot directory %q", dir)
+	}
+
+	counts := snapshot.Metadata.StreamChunks
+	streams := make([]chan uint32, len(counts))
+	// ready[i] is closed once stream i is known not to be a store stream, or once its stores
+	// are restored.
+	ready := make([]chan struct{}, len(counts))
+	for i, count := range counts {
+		streams[i] = make(chan uint32, count)
+		ready[i] = make(chan struct{})
+	}
+
+	var (
+		mtx       sync.Mutex
+		firstErr  error
+		quit      = make(chan struct{})
+		finished  bool
+		finishErr error
+	)
+	fail := func(err error) {
+		mtx.Lock()
+		defer mtx.Unlock()
+		if firstErr == nil {
+			firstErr = err
+			close(quit)
+		}
+	}
+	finish := func() error {
+		mtx.Lock()
+		defer mtx.Unlock()
+		if !finished {
+			finished = true
+			finishErr = storeSnapshotter.FinishRestore(snapshot.Height)
+		}
+		return finishErr
+	}
+
+	// dispatch the chunk IDs to their stream, the stream channels are buffered to hold all the
+	// chunks of the stream.
+	go func() {
+		stream, sent := 0, uint32(0)
+		defer func() {
+			for ; stream < len(streams); stream++ {
+				close(streams[stream])
+			}
+		}()
+		for {
+			select {
+			case <-quit:
+				return
+			case chunkID, ok := <-chChunkIDs:
+				if !ok {
+					return
+				}
+				streams[stream] <- chunkID
+				sent++
+				if sent == counts[stream] {
+					close(streams[stream])
+					stream, sent = stream+1, 0
+				}
+				if stream == len(streams) {
+					return
+				}
+			}
+		}
+	}()
+
+	restoreStream := func(i int, streamReader protoio.Reader) error {
+		var item types.SnapshotItem
+		if err := streamReader.ReadMsg(&item); err != nil {
+			return sdkerrors.Wrap(err, "invalid protobuf message")
+		}
+
+		var (
+			next types.SnapshotItem
+			err  error
+		)
+		switch {
+		case item.GetStore() != nil:
+			next, err = storeSnapshotter.RestoreStores(snapshot.Height, &peekedReader{Reader: streamReader, item: &item})
+			if err != nil {
+				return sdkerrors.Wrap(err, "multistore restore")
+			}
+			mtx.Lock()
+			outOfOrder := finished
+			mtx.Unlock()
+			if outOfOrder {
+				return sdkerrors.Wrap(sdkerrors.ErrLogic, "store stream after extension stream")
+			}
+			close(ready[i])
+
+		case item.GetExtension() != nil:
+			close(ready[i])
+			for j := 0; j < i; j++ {
+				select {
+				case <-ready[j]:
+				case <-quit:
+					return nil
+				}
+			}
+			if err := finish(); err != nil {
+				return err
+			}
+
+			metadata := item.GetExtension()
+			extension, ok := m.extensions[metadata.Name]
+			if !ok {
+				return sdkerrors.Wrapf(sdkerrors.ErrLogic, "unknown extension snapshotter %s", metadata.Name)
+			}
+			if !IsFormatSupported(extension, metadata.Format) {
+				return sdkerrors.Wrapf(types.ErrUnknownFormat, "format %v for extension %s", metadata.Format, metadata.Name)
+			}
+			next, err = extension.Restore(snapshot.Height, metadata.Format, streamReader)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "extension %s restore", metadata.Name)
+			}
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "unknown snapshot item %T", item.Item)
+		}
+
+		if next.Item != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "unexpected snapshot item %T at the end of stream %d", next.Item, i)
+		}
+		return nil
+	}
+
+	workers := int(m.opts.RestoreWorkers)
+	if workers == 0 {
+		workers = runtime.NumCPU()
+	}
+	runWorkers(workers, len(streams), func(i int) {
+		chunks := m.loadChunkStream(snapshot.Height, snapshot.Format, streams[i])
+		streamReader, err := NewStreamReader(chunks)
+		if err != nil {
+			fail(sdkerrors.Wrapf(err, "stream %d", i))
+			DrainChunks(chunks)
+			return
+		}
+
+		if err := restoreStream(i, streamReader); err != nil {
+			fail(sdkerrors.Wrapf(err, "stream %d", i))
+		}
+		// closing the reader drains the stream, which ends early once a restore failed.
+		_ = streamReader.Close()
+	})
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return finish()
+}
+
+// peekedReader is a protoio.Reader returning an item already read from the underlying reader
+// before the following items.
+type peekedReader struct {
+	protoio.Reader
+	item *types.SnapshotItem
+}
+
+// ReadMsg implements protoio.Reader.
+func (r *peekedReader) ReadMsg(msg proto.Message) error {
+	if r.item == nil {
+		return r.Reader.ReadMsg(msg)
+	}
+
+	item, ok := msg.(*types.SnapshotItem)
+	if !ok {
+		return fmt.Errorf("unexpected message type %T", msg)
+	}
+	*item = *r.item
+	r.item = nil
+	return nil
+}
+
+// chunkIDs returns a closed channel holding the IDs of the given number of chunks in order.
+func chunkIDs(chunks uint32) <-chan uint32 {
+	ch := make(chan uint32, chunks)
+	for i := uint32(0); i < chunks; i++ {
+		ch <- i
+	}
+	close(ch)
+	return ch
+}
@@ -181,7 +181,8 @@ func (s *Store) loadChunkFile(height uint64, format, chunk uint32) (io.ReadClose
 	return os.Open(path)
 }
 
-// Prune removes old snapshots. The given number of most recent heights (regardless of format) are retained.
+// Prune removes old snapshots. The given number of most recent heights (regardless of format) are retained,
+// along with the snapshots the retained delta snapshots are based on.
 func (s *Store) Prune(retain uint32) (uint64, error) {
 	iter, err := s.db.ReverseIterator(encodeKey(0, 0), encodeKey(uint64(math.MaxUint64), math.MaxUint32))
 	if err != nil {
@@ -192,13 +193,26 @@ func (s *Store) Prune(retain uint32) (uint64, error) {
 	pruned := uint64(0)
 	prunedHeights := make(map[uint64]bool)
 	skip := make(map[uint64]bool)
+	// bases holds the base heights of the retained delta snapshots, which must be kept for the
+	// deltas to remain restorable.
+	bases := make(map[uint64]bool)
 	for ; iter.Valid(); iter.Next() {
 		height, format, err := decodeKey(iter.Key())
 		if err != nil {
 			return 0, sdkerrors.Wrap(err, "failed to prune snapshots")
 		}
-		if skip[height] || uint32(len(skip)) < retain {
+		retained := skip[height] || uint32(len(skip)) < retain
+		if retained {
 			skip[height] = true
+		}
+		if retained || bases[height] {
+			snapshot := &types.Snapshot{}
+			if err := proto.Unmarshal(iter.Value(), snapshot); err != nil {
+				return 0, sdkerrors.Wrap(err, "failed to decode snapshot metadata")
+			}
+			if snapshot.Metadata.BaseHeight > 0 {
+				bases[snapshot.Metadata.BaseHeight] = true
+			}
 			continue
 		}
 		err = s.Delete(height, format)
@@ -224,8 +238,131 @@ func (s *Store) Prune(retain uint32) (uint64, error) {
 // Save saves a snapshot to disk, returning it.
 func (s *Store) Save(
 	height uint64, format uint32, chunks <-chan io.ReadCloser,
+) (*types.Snapshot, error) {
+	return s.save(height, format, types.Metadata{}, chunks)
+}
+
+// SaveDelta saves a delta snapshot of the changes since the snapshot at baseHeight to disk,
+// returning it. appHash is the app hash of the state at height.
+func (s *Store) SaveDelta(
+	height, baseHeight uint64, appHash []byte, chunks <-chan io.ReadCloser,
+) (*types.Snapshot, error) {
+	if baseHeight == 0 || baseHeight >= height {
+		DrainChunks(chunks)
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "invalid delta snapshot base height %v for height %v", baseHeight, height)
+	}
+	if len(appHash) == 0 {
+		DrainChunks(chunks)
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "delta snapshot at height %v has no app hash", height)
+	}
+	return s.save(height, types.DeltaFormat, types.Metadata{BaseHeight: baseHeight, AppHash: appHash}, chunks)
+}
+
+func (s *Store) save(
+	height uint64, format uint32, metadata types.Metadata, chunks <-chan io.ReadCloser,
 ) (*types.Snapshot, error) {
 	defer DrainChunks(chunks)
+	done, err := s.beginSave(height, format)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	snapshot := &types.Snapshot{
+		Height:   height,
+		Format:   format,
+		Metadata: metadata,
+	}
+	index := uint32(0)
+	snapshotHasher := sha256.New()
+	chunkHasher := sha256.New()
+	for chunkBody := range chunks {
+		defer chunkBody.Close() //nolint:staticcheck
+		dir := s.pathSnapshot(height, format)
+		err = os.MkdirAll(dir, 0o755)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to create snapshot directory %q", dir)
+		}
+
+		if err := s.saveChunk(chunkBody, index, snapshot, chunkHasher, snapshotHasher); err != nil {
+			return nil, err
+		}
+		index++
+	}
+	snapshot.Chunks = index
+	snapshot.Hash = snapshotHasher.Sum(nil)
+	return snapshot, s.saveSnapshot(snapshot)
+}
+
+// SaveStreams saves a snapshot made of independent chunk streams to disk, returning it. The
+// streams are saved concurrently, and the chunks of each stream follow the chunks of the previous
+// streams in the snapshot. The snapshot hash is the SHA-256 hash of the chunk hashes.
+func (s *Store) SaveStreams(
+	height uint64, format uint32, streams []<-chan io.ReadCloser,
+) (*types.Snapshot, error) {
+	defer func() {
+		for _, chunks := range streams {
+			DrainChunks(chunks)
+		}
+	}()
+	done, err := s.beginSave(height, format)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	// each stream is saved into its own staging directory, and its chunks are then moved to
+	// their position in the snapshot.
+	stagingDir := filepath.Join(s.pathSnapshot(height, format), "streams")
+	defer os.RemoveAll(stagingDir)
+
+	var (
+		wg          sync.WaitGroup
+		chunkHashes = make([][][]byte, len(streams))
+		errs        = make([]error, len(streams))
+	)
+	for i, chunks := range streams {
+		wg.Add(1)
+		go func(i int, chunks <-chan io.ReadCloser) {
+			defer wg.Done()
+			defer DrainChunks(chunks)
+			chunkHashes[i], errs[i] = saveStream(chunks, filepath.Join(stagingDir, strconv.Itoa(i)))
+		}(i, chunks)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to save snapshot stream %d", i)
+		}
+	}
+
+	snapshot := &types.Snapshot{
+		Height: height,
+		Format: format,
+	}
+	index := uint32(0)
+	snapshotHasher := sha256.New()
+	for i, hashes := range chunkHashes {
+		for j, chunkHash := range hashes {
+			err := os.Rename(filepath.Join(stagingDir, strconv.Itoa(i), strconv.Itoa(j)), s.PathChunk(height, format, index))
+			if err != nil {
+				return nil, sdkerrors.Wrapf(err, "failed to move snapshot chunk %d", index)
+			}
+			snapshotHasher.Write(chunkHash)
+			snapshot.Metadata.ChunkHashes = append(snapshot.Metadata.ChunkHashes, chunkHash)
+			index++
+		}
+		snapshot.Metadata.StreamChunks = append(snapshot.Metadata.StreamChunks, uint32(len(hashes)))
+	}
+	snapshot.Chunks = index
+	snapshot.Hash = snapshotHasher.Sum(nil)
+	return snapshot, s.saveSnapshot(snapshot)
+}
+
+// beginSave checks a snapshot can be saved at height and format, and marks the height as being
+// saved until the returned function is called.
+func (s *Store) beginSave(height uint64, format uint32) (func(), error) {
 	if height == 0 {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "snapshot height cannot be 0")
 	}
@@ -238,44 +375,59 @@ func (s *Store) Save(
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrConflict,
 			"a snapshot for height %v is already being saved", height)
 	}
-	defer func() {
+	done := func() {
 		s.mtx.Lock()
 		delete(s.saving, height)
 		s.mtx.Unlock()
-	}()
+	}
 
 	exists, err := s.db.Has(encodeKey(height, format))
 	if err != nil {
+		done()
 		return nil, err
 	}
 	if exists {
+		done()
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrConflict,
 			"snapshot already exists for height %v format %v", height, format)
 	}
 
-	snapshot := &types.Snapshot{
-		Height: height,
-		Format: format,
+	return done, nil
+}
+
+// saveStream saves the chunks of a stream as files in dir, named by their index in the stream,
+// and returns their hashes.
+func saveStream(chunks <-chan io.ReadCloser, dir string) ([][]byte, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, sdkerrors.Wrapf(err, "failed to create snapshot directory %q", dir)
 	}
-	index := uint32(0)
-	snapshotHasher := sha256.New()
+
+	hashes := [][]byte{}
 	chunkHasher := sha256.New()
 	for chunkBody := range chunks {
-		defer chunkBody.Close() //nolint:staticcheck
-		dir := s.pathSnapshot(height, format)
-		err = os.MkdirAll(dir, 0o755)
-		if err != nil {
-			return nil, sdkerrors.Wrapf(err, "failed to create snapshot directory %q", dir)
-		}
+		path := filepath.Join(dir, strconv.Itoa(len(hashes)))
+		err := func() error {
+			defer chunkBody.Close()
 
-		if err := s.saveChunk(chunkBody, index, snapshot, chunkHasher, snapshotHasher); err != nil {
+			chunkFile, err := os.Create(path)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "failed to create snapshot chunk file %q", path)
+			}
+			defer chunkFile.Close()
+
+			chunkHasher.Reset()
+			if _, err := io.Copy(io.MultiWriter(chunkFile, chunkHasher), chunkBody); err != nil {
+				return sdkerrors.Wrapf(err, "failed to generate snapshot chunk %d", len(hashes))
+			}
+			return chunkFile.Close()
+		}()
+		if err != nil {
 			return nil, err
 		}
-		index++
+		hashes = append(hashes, chunkHasher.Sum(nil))
 	}
-	snapshot.Chunks = index
-	snapshot.Hash = snapshotHasher.Sum(nil)
-	return snapshot, s.saveSnapshot(snapshot)
+
+	return hashes, nil
 }
 
 // saveChunk saves the given chunkBody with the given index to its appropriate path on disk.
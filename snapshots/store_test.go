@@ -270,6 +270,45 @@ func TestStore_Prune(t *testing.T) {
 	assert.Empty(t, snapshots)
 }
 
+func TestStore_PruneDeltas(t *testing.T) {
+	store := setupStore(t)
+
+	// 4 and 5 are a chain of deltas based on the snapshot at height 3
+	_, err := store.SaveDelta(4, 3, []byte{4}, makeChunks([][]byte{{4, 3, 0}}))
+	require.NoError(t, err)
+	_, err = store.SaveDelta(5, 4, []byte{5}, makeChunks([][]byte{{5, 3, 0}}))
+	require.NoError(t, err)
+
+	// Pruning until the last height should keep the snapshots the delta is based on
+	pruned, err := store.Prune(1)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, pruned)
+
+	snapshots, err := store.List()
+	require.NoError(t, err)
+	require.Len(t, snapshots, 3)
+	for i, height := range []uint64{5, 4, 3} {
+		assert.Equal(t, height, snapshots[i].Height)
+	}
+	assert.EqualValues(t, 4, snapshots[0].Metadata.BaseHeight)
+	assert.EqualValues(t, 3, snapshots[1].Metadata.BaseHeight)
+	assert.EqualValues(t, 0, snapshots[2].Metadata.BaseHeight)
+	assert.Equal(t, []byte{5}, snapshots[0].Metadata.AppHash)
+
+	// A delta can't be based on a later height
+	_, err = store.SaveDelta(6, 6, []byte{6}, makeChunks([][]byte{{6, 3, 0}}))
+	require.Error(t, err)
+
+	// A delta must have an app hash
+	_, err = store.SaveDelta(6, 5, nil, makeChunks([][]byte{{6, 3, 0}}))
+	require.Error(t, err)
+
+	// Pruning all heights should also be fine
+	pruned, err = store.Prune(0)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, pruned)
+}
+
 func TestStore_Save(t *testing.T) {
 	store := setupStore(t)
 	// Saving a snapshot should work
@@ -339,3 +378,53 @@ func TestStore_Save(t *testing.T) {
 	require.NoError(t, err)
 	close(ch)
 }
+
+func TestStore_SaveStreams(t *testing.T) {
+	store := setupStore(t)
+	// Saving a snapshot should work, and the chunks of each stream follow the previous streams
+	snapshot, err := store.SaveStreams(4, types.ParallelFormat, []<-chan io.ReadCloser{
+		makeChunks([][]byte{{1}, {2}}),
+		makeChunks([][]byte{{3}}),
+	})
+	require.NoError(t, err)
+	chunkHashes := checksums([][]byte{{1}, {2}, {3}})
+	assert.Equal(t, &types.Snapshot{
+		Height: 4,
+		Format: types.ParallelFormat,
+		Chunks: 3,
+		Hash:   hash(chunkHashes),
+		Metadata: types.Metadata{
+			ChunkHashes:  chunkHashes,
+			StreamChunks: []uint32{2, 1},
+		},
+	}, snapshot)
+	loaded, chunks, err := store.Load(snapshot.Height, snapshot.Format)
+	require.NoError(t, err)
+	assert.Equal(t, snapshot, loaded)
+	assert.Equal(t, [][]byte{{1}, {2}, {3}}, readChunks(chunks))
+
+	// Saving an existing snapshot should error
+	_, err = store.SaveStreams(4, types.ParallelFormat, []<-chan io.ReadCloser{makeChunks([][]byte{{1}})})
+	require.Error(t, err)
+
+	// Saving a snapshot should error if a chunk reader of any stream returns an error, and it
+	// should empty out every stream
+	someErr := errors.New("boom")
+	pr, pw := io.Pipe()
+	err = pw.CloseWithError(someErr)
+	require.NoError(t, err)
+
+	ch := make(chan io.ReadCloser, 2)
+	ch <- pr
+	ch <- io.NopCloser(bytes.NewBuffer([]byte{0xff}))
+	close(ch)
+	other := makeChunks([][]byte{{1}, {2}})
+
+	_, err = store.SaveStreams(5, types.ParallelFormat, []<-chan io.ReadCloser{other, ch})
+	require.ErrorIs(t, err, someErr)
+	assert.Empty(t, ch)
+	assert.Empty(t, other)
+	snapshot, err = store.Get(5, types.ParallelFormat)
+	require.NoError(t, err)
+	assert.Nil(t, snapshot)
+}
@@ -16,4 +16,8 @@ var (
 
 	// ErrInvalidSnapshotVersion is returned when the snapshot version is invalid
 	ErrInvalidSnapshotVersion = errors.New("invalid snapshot version")
+
+	// ErrStateHashMismatch is returned when the state restored from a delta snapshot doesn't
+	// match the recorded hash.
+	ErrStateHashMismatch = errors.New("state hash verification failed")
 )
@@ -4,3 +4,14 @@ package types
 // must be identical across all nodes for a given height, so this must be bumped when the binary
 // snapshot output changes.
 const CurrentFormat uint32 = 2
+
+// DeltaFormat is the format of delta snapshots, which only hold the IAVL changes between the
+// snapshot at Metadata.BaseHeight and the snapshot height. A delta snapshot can only be restored
+// on top of the state at its base height.
+const DeltaFormat uint32 = 3
+
+// ParallelFormat is the format of snapshots made of independent chunk streams, one for each store
+// followed by one for each extension, which are exported and restored concurrently. The number of
+// chunks of each stream is recorded in Metadata.StreamChunks, and the snapshot hash is the SHA-256
+// hash of the chunk hashes.
+const ParallelFormat uint32 = 4
@@ -8,6 +8,21 @@ type SnapshotOptions struct {
 
 	// KeepRecent defines how many snapshots to keep in heights.
 	KeepRecent uint32
+
+	// DeltaSnapshots defines how many delta snapshots are taken between two full snapshots,
+	// 0 only takes full snapshots. A delta snapshot replays every height since the previous
+	// snapshot, so the pruning must keep at least Interval recent heights: when a height has
+	// been pruned, the failure is logged and a full snapshot is taken instead.
+	DeltaSnapshots uint32
+
+	// ExportWorkers defines how many stores and extensions are exported concurrently. 0 takes
+	// snapshots in the sequential CurrentFormat, a positive value takes snapshots in the
+	// ParallelFormat, whose output doesn't depend on the number of workers.
+	ExportWorkers uint32
+
+	// RestoreWorkers defines how many chunk streams of a ParallelFormat snapshot are restored
+	// concurrently, 0 uses the number of CPUs.
+	RestoreWorkers uint32
 }
 
 func NewSnapshotOptions(interval uint64, keepRecent uint32) SnapshotOptions {
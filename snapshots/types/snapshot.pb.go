@@ -103,6 +103,21 @@ func (m *Snapshot) GetMetadata() Metadata {
 // Metadata contains SDK-specific snapshot metadata.
 type Metadata struct {
 	ChunkHashes [][]byte `protobuf:"bytes,1,rep,name=chunk_hashes,json=chunkHashes,proto3" json:"chunk_hashes,omitempty"`
+	// base_height is the height of the snapshot a delta snapshot applies to, it
+	// is 0 for full snapshots.
+	//
+	// Since: cosmos-sdk 0.47
+	BaseHeight uint64 `protobuf:"varint,2,opt,name=base_height,json=baseHeight,proto3" json:"base_height,omitempty"`
+	// stream_chunks holds the number of chunks of each independent chunk stream
+	// of a snapshot of the parallel format, in snapshot order.
+	//
+	// Since: cosmos-sdk 0.47
+	StreamChunks []uint32 `protobuf:"varint,3,rep,packed,name=stream_chunks,json=streamChunks,proto3" json:"stream_chunks,omitempty"`
+	// app_hash is the app hash of the state at the height of a delta snapshot,
+	// the restored state is verified against it.
+	//
+	// Since: cosmos-sdk 0.47
+	AppHash []byte `protobuf:"bytes,4,opt,name=app_hash,json=appHash,proto3" json:"app_hash,omitempty"`
 }
 
 func (m *Metadata) Reset()         { *m = Metadata{} }
@@ -145,6 +160,27 @@ func (m *Metadata) GetChunkHashes() [][]byte {
 	return nil
 }
 
+func (m *Metadata) GetBaseHeight() uint64 {
+	if m != nil {
+		return m.BaseHeight
+	}
+	return 0
+}
+
+func (m *Metadata) GetStreamChunks() []uint32 {
+	if m != nil {
+		return m.StreamChunks
+	}
+	return nil
+}
+
+func (m *Metadata) GetAppHash() []byte {
+	if m != nil {
+		return m.AppHash
+	}
+	return nil
+}
+
 // SnapshotItem is an item contained in a rootmulti.Store snapshot.
 //
 // Since: cosmos-sdk 0.46
@@ -158,6 +194,8 @@ type SnapshotItem struct {
 	//	*SnapshotItem_ExtensionPayload
 	//	*SnapshotItem_KV
 	//	*SnapshotItem_Schema
+	//	*SnapshotItem_IAVLChange
+	//	*SnapshotItem_IAVLVersion
 	Item isSnapshotItem_Item `protobuf_oneof:"item"`
 }
 
@@ -218,6 +256,12 @@ type SnapshotItem_KV struct {
 type SnapshotItem_Schema struct {
 	Schema *SnapshotSchema `protobuf:"bytes,6,opt,name=schema,proto3,oneof" json:"schema,omitempty"`
 }
+type SnapshotItem_IAVLChange struct {
+	IAVLChange *SnapshotIAVLChangeItem `protobuf:"bytes,7,opt,name=iavl_change,json=iavlChange,proto3,oneof" json:"iavl_change,omitempty"`
+}
+type SnapshotItem_IAVLVersion struct {
+	IAVLVersion *SnapshotIAVLVersionItem `protobuf:"bytes,8,opt,name=iavl_version,json=iavlVersion,proto3,oneof" json:"iavl_version,omitempty"`
+}
 
 func (*SnapshotItem_Store) isSnapshotItem_Item()            {}
 func (*SnapshotItem_IAVL) isSnapshotItem_Item()             {}
@@ -225,6 +269,8 @@ func (*SnapshotItem_Extension) isSnapshotItem_Item()        {}
 func (*SnapshotItem_ExtensionPayload) isSnapshotItem_Item() {}
 func (*SnapshotItem_KV) isSnapshotItem_Item()               {}
 func (*SnapshotItem_Schema) isSnapshotItem_Item()           {}
+func (*SnapshotItem_IAVLChange) isSnapshotItem_Item()       {}
+func (*SnapshotItem_IAVLVersion) isSnapshotItem_Item()      {}
 
 func (m *SnapshotItem) GetItem() isSnapshotItem_Item {
 	if m != nil {
@@ -275,6 +321,20 @@ func (m *SnapshotItem) GetSchema() *SnapshotSchema {
 	return nil
 }
 
+func (m *SnapshotItem) GetIAVLChange() *SnapshotIAVLChangeItem {
+	if x, ok := m.GetItem().(*SnapshotItem_IAVLChange); ok {
+		return x.IAVLChange
+	}
+	return nil
+}
+
+func (m *SnapshotItem) GetIAVLVersion() *SnapshotIAVLVersionItem {
+	if x, ok := m.GetItem().(*SnapshotItem_IAVLVersion); ok {
+		return x.IAVLVersion
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*SnapshotItem) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -284,6 +344,8 @@ func (*SnapshotItem) XXX_OneofWrappers() []interface{} {
 		(*SnapshotItem_ExtensionPayload)(nil),
 		(*SnapshotItem_KV)(nil),
 		(*SnapshotItem_Schema)(nil),
+		(*SnapshotItem_IAVLChange)(nil),
+		(*SnapshotItem_IAVLVersion)(nil),
 	}
 }
 
@@ -611,6 +673,126 @@ func (m *SnapshotSchema) GetKeys() [][]byte {
 	return nil
 }
 
+// SnapshotIAVLChangeItem is a change of an IAVL store in a delta snapshot.
+//
+// Since: cosmos-sdk 0.47
+type SnapshotIAVLChangeItem struct {
+	Key    []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value  []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Delete bool   `protobuf:"varint,3,opt,name=delete,proto3" json:"delete,omitempty"`
+}
+
+func (m *SnapshotIAVLChangeItem) Reset()         { *m = SnapshotIAVLChangeItem{} }
+func (m *SnapshotIAVLChangeItem) String() string { return proto.CompactTextString(m) }
+func (*SnapshotIAVLChangeItem) ProtoMessage()    {}
+func (*SnapshotIAVLChangeItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd7a3c9b0a19e1ee, []int{9}
+}
+func (m *SnapshotIAVLChangeItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotIAVLChangeItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotIAVLChangeItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SnapshotIAVLChangeItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotIAVLChangeItem.Merge(m, src)
+}
+func (m *SnapshotIAVLChangeItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotIAVLChangeItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotIAVLChangeItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotIAVLChangeItem proto.InternalMessageInfo
+
+func (m *SnapshotIAVLChangeItem) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *SnapshotIAVLChangeItem) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *SnapshotIAVLChangeItem) GetDelete() bool {
+	if m != nil {
+		return m.Delete
+	}
+	return false
+}
+
+// SnapshotIAVLVersionItem closes a version of an IAVL store in a delta
+// snapshot with its resulting root hash. The first version item of a store is
+// the base version the changes apply to.
+//
+// Since: cosmos-sdk 0.47
+type SnapshotIAVLVersionItem struct {
+	Version int64  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Hash    []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *SnapshotIAVLVersionItem) Reset()         { *m = SnapshotIAVLVersionItem{} }
+func (m *SnapshotIAVLVersionItem) String() string { return proto.CompactTextString(m) }
+func (*SnapshotIAVLVersionItem) ProtoMessage()    {}
+func (*SnapshotIAVLVersionItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd7a3c9b0a19e1ee, []int{10}
+}
+func (m *SnapshotIAVLVersionItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotIAVLVersionItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotIAVLVersionItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SnapshotIAVLVersionItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotIAVLVersionItem.Merge(m, src)
+}
+func (m *SnapshotIAVLVersionItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotIAVLVersionItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotIAVLVersionItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotIAVLVersionItem proto.InternalMessageInfo
+
+func (m *SnapshotIAVLVersionItem) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *SnapshotIAVLVersionItem) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Snapshot)(nil), "cosmos.base.snapshots.v1beta1.Snapshot")
 	proto.RegisterType((*Metadata)(nil), "cosmos.base.snapshots.v1beta1.Metadata")
@@ -621,6 +803,8 @@ func init() {
 	proto.RegisterType((*SnapshotExtensionPayload)(nil), "cosmos.base.snapshots.v1beta1.SnapshotExtensionPayload")
 	proto.RegisterType((*SnapshotKVItem)(nil), "cosmos.base.snapshots.v1beta1.SnapshotKVItem")
 	proto.RegisterType((*SnapshotSchema)(nil), "cosmos.base.snapshots.v1beta1.SnapshotSchema")
+	proto.RegisterType((*SnapshotIAVLChangeItem)(nil), "cosmos.base.snapshots.v1beta1.SnapshotIAVLChangeItem")
+	proto.RegisterType((*SnapshotIAVLVersionItem)(nil), "cosmos.base.snapshots.v1beta1.SnapshotIAVLVersionItem")
 }
 
 func init() {
@@ -628,44 +812,53 @@ func init() {
 }
 
 var fileDescriptor_dd7a3c9b0a19e1ee = []byte{
-	// 581 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0xb5, 0x13, 0xc7, 0xa4, 0x63, 0x83, 0xda, 0x55, 0x41, 0x16, 0x12, 0x6e, 0xb0, 0x90, 0x9a,
-	0x43, 0x6b, 0xd3, 0x50, 0x09, 0xae, 0x04, 0x41, 0x5d, 0x15, 0x04, 0xda, 0xa0, 0x1c, 0xb8, 0x54,
-	0x9b, 0x64, 0x1b, 0x47, 0x8e, 0xb3, 0x51, 0x76, 0x63, 0x91, 0x23, 0x7f, 0xc0, 0xaf, 0xf0, 0x17,
-	0x3d, 0xf6, 0xc8, 0x29, 0x42, 0xc9, 0x8f, 0xa0, 0x5d, 0xdb, 0x69, 0x29, 0x2d, 0xa4, 0xa7, 0xcc,
-	0x4c, 0xde, 0x7b, 0x9e, 0x9d, 0xb7, 0xb3, 0xb0, 0xd7, 0x65, 0x3c, 0x61, 0x3c, 0xe8, 0x10, 0x4e,
-	0x03, 0x3e, 0x22, 0x63, 0x1e, 0x31, 0xc1, 0x83, 0xf4, 0xa0, 0x43, 0x05, 0x39, 0x58, 0x55, 0xfc,
-	0xf1, 0x84, 0x09, 0x86, 0x9e, 0x64, 0x68, 0x5f, 0xa2, 0xfd, 0x15, 0xda, 0xcf, 0xd1, 0x8f, 0xb7,
-	0xfb, 0xac, 0xcf, 0x14, 0x32, 0x90, 0x51, 0x46, 0xf2, 0x7e, 0xe8, 0x50, 0x6d, 0xe5, 0x58, 0xf4,
-	0x08, 0xcc, 0x88, 0x0e, 0xfa, 0x91, 0x70, 0xf4, 0x9a, 0x5e, 0x37, 0x70, 0x9e, 0xc9, 0xfa, 0x19,
-	0x9b, 0x24, 0x44, 0x38, 0xa5, 0x9a, 0x5e, 0xbf, 0x8f, 0xf3, 0x4c, 0xd6, 0xbb, 0xd1, 0x74, 0x14,
-	0x73, 0xa7, 0x9c, 0xd5, 0xb3, 0x0c, 0x21, 0x30, 0x22, 0xc2, 0x23, 0xc7, 0xa8, 0xe9, 0x75, 0x1b,
-	0xab, 0x18, 0x1d, 0x43, 0x35, 0xa1, 0x82, 0xf4, 0x88, 0x20, 0x4e, 0xa5, 0xa6, 0xd7, 0xad, 0xc6,
-	0xae, 0xff, 0xcf, 0x86, 0xfd, 0x0f, 0x39, 0xbc, 0x69, 0x9c, 0xcf, 0x77, 0x34, 0xbc, 0xa2, 0x7b,
-	0xfb, 0x50, 0x2d, 0xfe, 0x43, 0x4f, 0xc1, 0x56, 0x1f, 0x3d, 0x95, 0x1f, 0xa1, 0xdc, 0xd1, 0x6b,
-	0xe5, 0xba, 0x8d, 0x2d, 0x55, 0x0b, 0x55, 0xc9, 0xfb, 0x66, 0x80, 0x5d, 0x1c, 0xf1, 0x58, 0xd0,
-	0x04, 0x85, 0x50, 0xe1, 0x82, 0x4d, 0xa8, 0x3a, 0xa5, 0xd5, 0x78, 0xfe, 0x9f, 0x3e, 0x0a, 0x6e,
-	0x4b, 0x72, 0xa4, 0x40, 0xa8, 0xe1, 0x4c, 0x00, 0x7d, 0x04, 0x63, 0x40, 0xd2, 0xa1, 0x1a, 0x8b,
-	0xd5, 0x08, 0xd6, 0x14, 0x3a, 0x7e, 0xdd, 0x7e, 0x2f, 0x75, 0x9a, 0xd5, 0xc5, 0x7c, 0xc7, 0x90,
-	0x59, 0xa8, 0x61, 0x25, 0x84, 0x3e, 0xc3, 0x06, 0xfd, 0x2a, 0xe8, 0x88, 0x0f, 0xd8, 0x48, 0x0d,
-	0xd5, 0x6a, 0x1c, 0xae, 0xa9, 0xfa, 0xb6, 0xe0, 0xc9, 0xd9, 0x84, 0x1a, 0xbe, 0x14, 0x42, 0x67,
-	0xb0, 0xb5, 0x4a, 0x4e, 0xc7, 0x64, 0x36, 0x64, 0xa4, 0xa7, 0xcc, 0xb1, 0x1a, 0x2f, 0xef, 0xaa,
-	0xfe, 0x29, 0xa3, 0x87, 0x1a, 0xde, 0xa4, 0xd7, 0x6a, 0xe8, 0x08, 0x4a, 0x71, 0x9a, 0xbb, 0xbb,
-	0xbf, 0xa6, 0xf0, 0x49, 0x5b, 0x8d, 0xc2, 0x5c, 0xcc, 0x77, 0x4a, 0x27, 0xed, 0x50, 0xc3, 0xa5,
-	0x38, 0x45, 0x47, 0x60, 0xf2, 0x6e, 0x44, 0x13, 0xe2, 0x98, 0x77, 0x12, 0x6b, 0x29, 0x52, 0xa8,
-	0xe1, 0x9c, 0xde, 0x34, 0xc1, 0x18, 0x08, 0x9a, 0x78, 0xbb, 0xb0, 0xf5, 0x97, 0x8d, 0xf2, 0x9a,
-	0x8e, 0x48, 0x92, 0x5d, 0x83, 0x0d, 0xac, 0x62, 0x6f, 0x08, 0x9b, 0xd7, 0x6d, 0x42, 0x9b, 0x50,
-	0x8e, 0xe9, 0x4c, 0xc1, 0x6c, 0x2c, 0x43, 0xb4, 0x0d, 0x95, 0x94, 0x0c, 0xa7, 0x54, 0x19, 0x6f,
-	0xe3, 0x2c, 0x41, 0x0e, 0xdc, 0x4b, 0xe9, 0x64, 0x65, 0x5d, 0x19, 0x17, 0xe9, 0x95, 0xc5, 0x92,
-	0x53, 0xaf, 0x14, 0x8b, 0xe5, 0xbd, 0x81, 0x87, 0x37, 0xda, 0x77, 0x53, 0x6b, 0xb7, 0x6d, 0xa1,
-	0x77, 0x08, 0xce, 0x6d, 0x2e, 0xc9, 0x96, 0x0a, 0xbf, 0xb3, 0xf6, 0x8b, 0xd4, 0x7b, 0x05, 0x0f,
-	0xfe, 0xb4, 0x60, 0xdd, 0x63, 0x7a, 0xcf, 0x2e, 0x99, 0xd9, 0xbc, 0x65, 0xb7, 0x31, 0x9d, 0x15,
-	0xcb, 0xa7, 0xe2, 0xe6, 0xbb, 0xf3, 0x85, 0xab, 0x5f, 0x2c, 0x5c, 0xfd, 0xd7, 0xc2, 0xd5, 0xbf,
-	0x2f, 0x5d, 0xed, 0x62, 0xe9, 0x6a, 0x3f, 0x97, 0xae, 0xf6, 0x65, 0xaf, 0x3f, 0x10, 0xd1, 0xb4,
-	0xe3, 0x77, 0x59, 0x12, 0xe4, 0x0f, 0x5c, 0xf6, 0xb3, 0xcf, 0x7b, 0xf1, 0x95, 0x67, 0x4e, 0xcc,
-	0xc6, 0x94, 0x77, 0x4c, 0xf5, 0x4e, 0xbd, 0xf8, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x70, 0xb0, 0xd0,
-	0xad, 0x0c, 0x05, 0x00, 0x00,
+	// 732 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xdd, 0x6e, 0x12, 0x41,
+	0x14, 0xde, 0xe5, 0xaf, 0xf4, 0xec, 0xb6, 0xb6, 0x93, 0x5a, 0x57, 0x13, 0x01, 0x57, 0x93, 0x72,
+	0xd1, 0x2e, 0x16, 0xeb, 0xcf, 0xad, 0x34, 0xda, 0x6d, 0xaa, 0xd1, 0x4c, 0x0d, 0x31, 0xde, 0x90,
+	0x01, 0xa6, 0x2c, 0x81, 0x65, 0x37, 0xcc, 0x94, 0xc8, 0x4b, 0x18, 0x5f, 0xc5, 0xb7, 0xe8, 0x65,
+	0x2f, 0xf5, 0x86, 0x98, 0xed, 0x8b, 0x98, 0x99, 0xd9, 0xa5, 0xb4, 0xb6, 0x0a, 0x57, 0xcc, 0xf9,
+	0x38, 0xe7, 0x3b, 0x3f, 0xf3, 0xed, 0x19, 0xd8, 0x6e, 0x05, 0xcc, 0x0f, 0x58, 0xa5, 0x49, 0x18,
+	0xad, 0xb0, 0x01, 0x09, 0x99, 0x17, 0x70, 0x56, 0x19, 0xed, 0x36, 0x29, 0x27, 0xbb, 0x53, 0xc4,
+	0x09, 0x87, 0x01, 0x0f, 0xd0, 0x43, 0xe5, 0xed, 0x08, 0x6f, 0x67, 0xea, 0xed, 0xc4, 0xde, 0x0f,
+	0x36, 0x3a, 0x41, 0x27, 0x90, 0x9e, 0x15, 0x71, 0x52, 0x41, 0xf6, 0x0f, 0x1d, 0xf2, 0xc7, 0xb1,
+	0x2f, 0xda, 0x84, 0x9c, 0x47, 0xbb, 0x1d, 0x8f, 0x5b, 0x7a, 0x49, 0x2f, 0x67, 0x70, 0x6c, 0x09,
+	0xfc, 0x24, 0x18, 0xfa, 0x84, 0x5b, 0xa9, 0x92, 0x5e, 0x5e, 0xc1, 0xb1, 0x25, 0xf0, 0x96, 0x77,
+	0x3a, 0xe8, 0x31, 0x2b, 0xad, 0x70, 0x65, 0x21, 0x04, 0x19, 0x8f, 0x30, 0xcf, 0xca, 0x94, 0xf4,
+	0xb2, 0x89, 0xe5, 0x19, 0x1d, 0x42, 0xde, 0xa7, 0x9c, 0xb4, 0x09, 0x27, 0x56, 0xb6, 0xa4, 0x97,
+	0x8d, 0xea, 0x96, 0xf3, 0xcf, 0x82, 0x9d, 0xf7, 0xb1, 0x7b, 0x2d, 0x73, 0x36, 0x29, 0x6a, 0x78,
+	0x1a, 0x6e, 0x7f, 0xd3, 0x21, 0x9f, 0xfc, 0x89, 0x1e, 0x81, 0x29, 0xb3, 0x36, 0x44, 0x16, 0xca,
+	0x2c, 0xbd, 0x94, 0x2e, 0x9b, 0xd8, 0x90, 0x98, 0x2b, 0x21, 0x54, 0x04, 0x43, 0xa4, 0x68, 0xc4,
+	0xbd, 0xa5, 0x64, 0x6f, 0x20, 0x20, 0x57, 0xf5, 0xf7, 0x18, 0x56, 0x18, 0x1f, 0x52, 0xe2, 0x37,
+	0xa6, 0xed, 0xa4, 0xcb, 0x2b, 0xd8, 0x54, 0xe0, 0xbe, 0x6a, 0xea, 0x3e, 0xe4, 0x49, 0x18, 0x36,
+	0x66, 0x1a, 0x5b, 0x22, 0x61, 0x28, 0x52, 0xd8, 0xbf, 0xb2, 0x60, 0x26, 0x43, 0x3c, 0xe4, 0xd4,
+	0x47, 0x2e, 0x64, 0x19, 0x0f, 0x86, 0x54, 0xce, 0xd1, 0xa8, 0x3e, 0xfd, 0x4f, 0xa7, 0x49, 0xec,
+	0xb1, 0x88, 0x11, 0x04, 0xae, 0x86, 0x15, 0x01, 0xfa, 0x00, 0x99, 0x2e, 0x19, 0xf5, 0x65, 0xd1,
+	0x46, 0xb5, 0x32, 0x27, 0xd1, 0xe1, 0xeb, 0xfa, 0x3b, 0xc1, 0x53, 0xcb, 0x47, 0x93, 0x62, 0x46,
+	0x58, 0xae, 0x86, 0x25, 0x11, 0xfa, 0x04, 0xcb, 0xf4, 0x2b, 0xa7, 0x03, 0xd6, 0x0d, 0x06, 0xf2,
+	0xda, 0x8c, 0xea, 0xde, 0x9c, 0xac, 0x6f, 0x92, 0x38, 0x31, 0x7c, 0x57, 0xc3, 0x97, 0x44, 0xe8,
+	0x04, 0xd6, 0xa7, 0x46, 0x23, 0x24, 0xe3, 0x7e, 0x40, 0xda, 0x72, 0x4a, 0x46, 0xf5, 0xe5, 0xa2,
+	0xec, 0x1f, 0x55, 0xb8, 0xab, 0xe1, 0x35, 0x7a, 0x0d, 0x43, 0x07, 0x90, 0xea, 0x8d, 0x62, 0xfd,
+	0xec, 0xcc, 0x49, 0x7c, 0x54, 0x97, 0xa3, 0xc8, 0x45, 0x93, 0x62, 0xea, 0xa8, 0xee, 0x6a, 0x38,
+	0xd5, 0x1b, 0xa1, 0x03, 0xc8, 0xb1, 0x96, 0x47, 0x7d, 0x62, 0xe5, 0x16, 0x22, 0x3b, 0x96, 0x41,
+	0xae, 0x86, 0xe3, 0x70, 0xe4, 0x81, 0x21, 0xe6, 0xda, 0x68, 0x79, 0x64, 0xd0, 0xa1, 0xd6, 0x92,
+	0x64, 0x7b, 0xbe, 0xc0, 0x3d, 0xed, 0xcb, 0x40, 0x59, 0xe2, 0x6a, 0x34, 0x29, 0xc2, 0x25, 0xe6,
+	0x6a, 0x18, 0x04, 0xb7, 0xb2, 0x50, 0x1f, 0x4c, 0x99, 0x69, 0x44, 0x87, 0xf2, 0xf2, 0xf2, 0x32,
+	0xd5, 0x8b, 0x05, 0x52, 0xd5, 0x55, 0xa4, 0xcc, 0x75, 0x27, 0x9a, 0x14, 0x8d, 0x19, 0xd0, 0xd5,
+	0xb0, 0x6c, 0x24, 0x36, 0x6b, 0x39, 0xc8, 0x74, 0x39, 0xf5, 0xed, 0x2d, 0x58, 0xff, 0x4b, 0x9e,
+	0xe2, 0x03, 0x1f, 0x10, 0x5f, 0xc9, 0x7b, 0x19, 0xcb, 0xb3, 0xdd, 0x87, 0xb5, 0xeb, 0xf2, 0x43,
+	0x6b, 0x90, 0xee, 0xd1, 0xb1, 0x74, 0x33, 0xb1, 0x38, 0xa2, 0x0d, 0xc8, 0x8e, 0x48, 0xff, 0x94,
+	0x4a, 0x41, 0x9b, 0x58, 0x19, 0xc8, 0x82, 0xa5, 0xa4, 0x2b, 0x21, 0xc9, 0x34, 0x4e, 0xcc, 0x99,
+	0x95, 0x24, 0xd4, 0x94, 0x4d, 0x56, 0x92, 0xbd, 0x0f, 0x77, 0x6f, 0x94, 0xe5, 0x4d, 0xa5, 0xdd,
+	0xb6, 0xbf, 0xec, 0x3d, 0xb0, 0x6e, 0x53, 0x9f, 0x28, 0x29, 0xd1, 0xb1, 0x2a, 0x3f, 0x31, 0xed,
+	0x57, 0xb0, 0x7a, 0x55, 0x5a, 0xf3, 0xb6, 0x69, 0x3f, 0x81, 0xd5, 0xab, 0x3a, 0x12, 0xd5, 0xf6,
+	0xe8, 0x38, 0xd9, 0x5a, 0xf2, 0x6c, 0x7f, 0x86, 0xcd, 0x9b, 0xf5, 0x31, 0xf7, 0x38, 0x37, 0x21,
+	0xd7, 0xa6, 0x7d, 0xca, 0xa9, 0x9c, 0x66, 0x1e, 0xc7, 0x96, 0x7d, 0x00, 0xf7, 0x6e, 0x91, 0xc3,
+	0xec, 0x0d, 0xe8, 0x57, 0x6f, 0x20, 0x59, 0xe6, 0xa9, 0xcb, 0x65, 0x5e, 0x7b, 0x7b, 0x16, 0x15,
+	0xf4, 0xf3, 0xa8, 0xa0, 0xff, 0x8e, 0x0a, 0xfa, 0xf7, 0x8b, 0x82, 0x76, 0x7e, 0x51, 0xd0, 0x7e,
+	0x5e, 0x14, 0xb4, 0x2f, 0xdb, 0x9d, 0x2e, 0xf7, 0x4e, 0x9b, 0x4e, 0x2b, 0xf0, 0x2b, 0xf1, 0xeb,
+	0xa5, 0x7e, 0x76, 0x58, 0xbb, 0x37, 0xf3, 0x86, 0xf1, 0x71, 0x48, 0x59, 0x33, 0x27, 0x1f, 0xa1,
+	0x67, 0x7f, 0x06, 0x00, 0xae, 0x1b, 0xec, 0xd6, 0xe9, 0x06, 0x00, 0x00,
 }
 
 func (m *Snapshot) Marshal() (dAtA []byte, err error) {
@@ -743,6 +936,36 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AppHash) > 0 {
+		i -= len(m.AppHash)
+		copy(dAtA[i:], m.AppHash)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.AppHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.StreamChunks) > 0 {
+		dAtA3 := make([]byte, len(m.StreamChunks)*10)
+		var j2 int
+		for _, num := range m.StreamChunks {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintSnapshot(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BaseHeight != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.BaseHeight))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.ChunkHashes) > 0 {
 		for iNdEx := len(m.ChunkHashes) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ChunkHashes[iNdEx])
@@ -913,6 +1136,48 @@ func (m *SnapshotItem_Schema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *SnapshotItem_IAVLChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotItem_IAVLChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.IAVLChange != nil {
+		{
+			size, err := m.IAVLChange.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSnapshot(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *SnapshotItem_IAVLVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotItem_IAVLVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.IAVLVersion != nil {
+		{
+			size, err := m.IAVLVersion.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSnapshot(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	return len(dAtA) - i, nil
+}
 func (m *SnapshotStoreItem) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1124,6 +1389,88 @@ func (m *SnapshotSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SnapshotIAVLChangeItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotIAVLChangeItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotIAVLChangeItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Delete {
+		i--
+		if m.Delete {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SnapshotIAVLVersionItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotIAVLVersionItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotIAVLVersionItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Version != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSnapshot(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSnapshot(v)
 	base := offset
@@ -1171,6 +1518,20 @@ func (m *Metadata) Size() (n int) {
 			n += 1 + l + sovSnapshot(uint64(l))
 		}
 	}
+	if m.BaseHeight != 0 {
+		n += 1 + sovSnapshot(uint64(m.BaseHeight))
+	}
+	if len(m.StreamChunks) > 0 {
+		l = 0
+		for _, e := range m.StreamChunks {
+			l += sovSnapshot(uint64(e))
+		}
+		n += 1 + sovSnapshot(uint64(l)) + l
+	}
+	l = len(m.AppHash)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
 	return n
 }
 
@@ -1258,6 +1619,30 @@ func (m *SnapshotItem_Schema) Size() (n int) {
 	}
 	return n
 }
+func (m *SnapshotItem_IAVLChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IAVLChange != nil {
+		l = m.IAVLChange.Size()
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	return n
+}
+func (m *SnapshotItem_IAVLVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IAVLVersion != nil {
+		l = m.IAVLVersion.Size()
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	return n
+}
 func (m *SnapshotStoreItem) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1355,11 +1740,47 @@ func (m *SnapshotSchema) Size() (n int) {
 	return n
 }
 
-func sovSnapshot(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *SnapshotIAVLChangeItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	if m.Delete {
+		n += 2
+	}
+	return n
 }
-func sozSnapshot(x uint64) (n int) {
-	return sovSnapshot(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *SnapshotIAVLVersionItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovSnapshot(uint64(m.Version))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	return n
+}
+
+func sovSnapshot(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSnapshot(x uint64) (n int) {
+	return sovSnapshot(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *Snapshot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -1596,6 +2017,135 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			m.ChunkHashes = append(m.ChunkHashes, make([]byte, postIndex-iNdEx))
 			copy(m.ChunkHashes[len(m.ChunkHashes)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseHeight", wireType)
+			}
+			m.BaseHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSnapshot
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.StreamChunks = append(m.StreamChunks, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSnapshot
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSnapshot
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthSnapshot
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.StreamChunks) == 0 {
+					m.StreamChunks = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSnapshot
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.StreamChunks = append(m.StreamChunks, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamChunks", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppHash = append(m.AppHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.AppHash == nil {
+				m.AppHash = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSnapshot(dAtA[iNdEx:])
@@ -1856,6 +2406,76 @@ func (m *SnapshotItem) Unmarshal(dAtA []byte) error {
 			}
 			m.Item = &SnapshotItem_Schema{v}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IAVLChange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SnapshotIAVLChangeItem{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Item = &SnapshotItem_IAVLChange{v}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IAVLVersion", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SnapshotIAVLVersionItem{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Item = &SnapshotItem_IAVLVersion{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSnapshot(dAtA[iNdEx:])
@@ -2500,6 +3120,247 @@ func (m *SnapshotSchema) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SnapshotIAVLChangeItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotIAVLChangeItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotIAVLChangeItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Delete = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SnapshotIAVLVersionItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotIAVLVersionItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotIAVLVersionItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSnapshot(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
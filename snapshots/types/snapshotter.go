@@ -2,6 +2,8 @@ package types
 
 import (
 	protoio "github.com/gogo/protobuf/io"
+
+	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
 )
 
 // Snapshotter is something that can create and restore snapshots, consisting of streamed binary
@@ -28,6 +30,51 @@ type Snapshotter interface {
 	Restore(height uint64, format uint32, protoReader protoio.Reader) (SnapshotItem, error)
 }
 
+// DeltaSnapshotter is a Snapshotter which can also create and restore delta snapshots, holding
+// only the changes between two heights.
+type DeltaSnapshotter interface {
+	Snapshotter
+
+	// LatestVersion returns the latest committed height, which a delta snapshot must be based on
+	// to be restored.
+	LatestVersion() int64
+
+	// GetPruning returns the pruning options. A delta snapshot can only be taken if every height
+	// since its base height is still stored.
+	GetPruning() pruningtypes.PruningOptions
+
+	// AppHash returns the app hash committed at height, which is recorded in the metadata of a
+	// delta snapshot.
+	AppHash(height uint64) ([]byte, error)
+
+	// SnapshotDelta writes the changes between baseHeight and height into the protobuf writer.
+	SnapshotDelta(baseHeight, height uint64, protoWriter protoio.Writer) error
+
+	// RestoreDelta applies the changes of a delta snapshot on top of the state at baseHeight,
+	// verifying the state hashes along the way and the app hash of the restored state against
+	// appHash. It returns the next snapshot item.
+	RestoreDelta(baseHeight, height uint64, appHash []byte, protoReader protoio.Reader) (SnapshotItem, error)
+}
+
+// StoreSnapshotter is a Snapshotter which can snapshot and restore each of its stores
+// independently, allowing them to be processed concurrently.
+type StoreSnapshotter interface {
+	Snapshotter
+
+	// SnapshotStoreNames returns the names of the stores included in a snapshot, in snapshot order.
+	SnapshotStoreNames() ([]string, error)
+
+	// SnapshotStore writes the snapshot items of a single store into the protobuf writer.
+	SnapshotStore(height uint64, name string, protoWriter protoio.Writer) error
+
+	// RestoreStores restores the stores of a stream of snapshot items, it can be called
+	// concurrently for streams holding different stores. It returns the next snapshot item.
+	RestoreStores(height uint64, protoReader protoio.Reader) (SnapshotItem, error)
+
+	// FinishRestore completes a restore once all the stores have been restored by RestoreStores.
+	FinishRestore(height uint64) error
+}
+
 // ExtensionSnapshotter is an extension Snapshotter that is appended to the snapshot stream.
 // ExtensionSnapshotter has an unique name and manages it's own internal formats.
 type ExtensionSnapshotter interface {
@@ -281,6 +281,16 @@ func (st *Store) Export(version int64) (*iavl.Exporter, error) {
 	return tree.Export()
 }
 
+// TraverseStateChanges calls fn with the changes of every stored version in
+// [startVersion, endVersion), each compared to its predecessor.
+func (st *Store) TraverseStateChanges(startVersion, endVersion int64, fn func(version int64, changeSet *iavl.ChangeSet) error) error {
+	tree, err := st.tree.GetImmutable(endVersion - 1)
+	if err != nil {
+		return fmt.Errorf("iavl traversal failed for version %v: %w", endVersion-1, err)
+	}
+	return tree.TraverseStateChanges(startVersion, endVersion, fn)
+}
+
 // Import imports an IAVL tree at the given version, returning an iavl.Importer for importing.
 func (st *Store) Import(version int64) (*iavl.Importer, error) {
 	tree, ok := st.tree.(*iavl.MutableTree)
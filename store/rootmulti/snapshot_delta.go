@@ -0,0 +1,239 @@
+package rootmulti
+
+import (
+	"bytes"
+	"io"
+
+	iavltree "github.com/cosmos/iavl"
+	protoio "github.com/gogo/protobuf/io"
+
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ snapshottypes.DeltaSnapshotter = (*Store)(nil)
+
+// SnapshotDelta implements snapshottypes.DeltaSnapshotter. For each IAVL store, the snapshot
+// contains a SnapshotStoreItem, the SnapshotIAVLVersionItem of the base version, and then for
+// every version up to height its changes as SnapshotIAVLChangeItems followed by its
+// SnapshotIAVLVersionItem. Replaying the changes of each version in order reproduces the exact
+// same IAVL trees, so every version must still be stored: the delta fails if any version between
+// baseHeight and height has been pruned.
+func (rs *Store) SnapshotDelta(baseHeight, height uint64, protoWriter protoio.Writer) error {
+	if baseHeight == 0 || baseHeight >= height {
+		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "invalid delta snapshot base height %v for height %v", baseHeight, height)
+	}
+	if height > uint64(GetLatestVersion(rs.db)) {
+		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot snapshot future height %v", height)
+	}
+
+	stores, err := rs.snapshotStores()
+	if err != nil {
+		return err
+	}
+
+	for _, store := range stores {
+		base, err := store.GetImmutable(int64(baseHeight))
+		if err != nil {
+			return sdkerrors.Wrapf(err, "store %q has no base version %v", store.name, baseHeight)
+		}
+
+		err = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+			Item: &snapshottypes.SnapshotItem_Store{
+				Store: &snapshottypes.SnapshotStoreItem{
+					Name: store.name,
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := writeIAVLVersion(protoWriter, base.LastCommitID().Version, base.LastCommitID().Hash); err != nil {
+			return err
+		}
+
+		expected := int64(baseHeight) + 1
+		err = store.TraverseStateChanges(expected, int64(height)+1, func(version int64, changeSet *iavltree.ChangeSet) error {
+			if version != expected {
+				return sdkerrors.Wrapf(sdkerrors.ErrLogic, "store %q version %v has been pruned", store.name, expected)
+			}
+			expected++
+
+			for _, pair := range changeSet.Pairs {
+				err := protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+					Item: &snapshottypes.SnapshotItem_IAVLChange{
+						IAVLChange: &snapshottypes.SnapshotIAVLChangeItem{
+							Key:    pair.Key,
+							Value:  pair.Value,
+							Delete: pair.Delete,
+						},
+					},
+				})
+				if err != nil {
+					return err
+				}
+			}
+
+			tree, err := store.GetImmutable(version)
+			if err != nil {
+				return err
+			}
+
+			return writeIAVLVersion(protoWriter, version, tree.LastCommitID().Hash)
+		})
+		if err != nil {
+			return err
+		}
+
+		if expected != int64(height)+1 {
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "store %q version %v has been pruned", store.name, expected)
+		}
+	}
+
+	return nil
+}
+
+func writeIAVLVersion(protoWriter protoio.Writer, version int64, hash []byte) error {
+	return protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+		Item: &snapshottypes.SnapshotItem_IAVLVersion{
+			IAVLVersion: &snapshottypes.SnapshotIAVLVersionItem{
+				Version: version,
+				Hash:    hash,
+			},
+		},
+	})
+}
+
+// AppHash implements snapshottypes.DeltaSnapshotter.
+func (rs *Store) AppHash(height uint64) ([]byte, error) {
+	cInfo, err := getCommitInfo(rs.db, int64(height))
+	if err != nil {
+		return nil, err
+	}
+	return cInfo.Hash(), nil
+}
+
+// RestoreDelta implements snapshottypes.DeltaSnapshotter. The state must be at baseHeight, the
+// hash of every IAVL store is verified against the snapshot at the base version and after every
+// replayed version, and the resulting app hash against appHash, which must come from the trusted
+// snapshot metadata. The restored height is not committed to the multistore if the app hashes
+// differ. It returns the next snapshot item.
+func (rs *Store) RestoreDelta(
+	baseHeight, height uint64, appHash []byte, protoReader protoio.Reader,
+) (snapshottypes.SnapshotItem, error) {
+	if latest := rs.LatestVersion(); latest != int64(baseHeight) {
+		return snapshottypes.SnapshotItem{}, sdkerrors.Wrapf(snapshottypes.ErrInvalidMetadata,
+			"delta snapshot is based on height %v, but the state is at height %v", baseHeight, latest)
+	}
+
+	var (
+		store        *iavl.Store
+		storeName    string
+		baseVerified bool
+		restored     = make(map[string]bool)
+		snapshotItem snapshottypes.SnapshotItem
+	)
+
+	// finishStore checks the current store has been replayed up to height.
+	finishStore := func() error {
+		if store == nil {
+			return nil
+		}
+		if version := store.LastCommitID().Version; version != int64(height) {
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "store %q restored up to version %v, expected %v", storeName, version, height)
+		}
+		restored[storeName] = true
+		return nil
+	}
+
+loop:
+	for {
+		snapshotItem = snapshottypes.SnapshotItem{}
+		err := protoReader.ReadMsg(&snapshotItem)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(err, "invalid protobuf message")
+		}
+
+		switch item := snapshotItem.Item.(type) {
+		case *snapshottypes.SnapshotItem_Store:
+			if err := finishStore(); err != nil {
+				return snapshottypes.SnapshotItem{}, err
+			}
+			var ok bool
+			store, ok = rs.GetStoreByName(item.Store.Name).(*iavl.Store)
+			if !ok || store == nil {
+				return snapshottypes.SnapshotItem{}, sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot restore delta into non-IAVL store %q", item.Store.Name)
+			}
+			storeName = item.Store.Name
+			baseVerified = false
+
+		case *snapshottypes.SnapshotItem_IAVLVersion:
+			if store == nil {
+				return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(sdkerrors.ErrLogic, "received IAVL version item before store item")
+			}
+
+			commitID := store.LastCommitID()
+			if baseVerified {
+				commitID = store.Commit()
+			}
+			baseVerified = true
+
+			if commitID.Version != item.IAVLVersion.Version || !bytes.Equal(commitID.Hash, item.IAVLVersion.Hash) {
+				return snapshottypes.SnapshotItem{}, sdkerrors.Wrapf(snapshottypes.ErrStateHashMismatch,
+					"store %q version %v hash %X, expected version %v hash %X",
+					storeName, commitID.Version, commitID.Hash, item.IAVLVersion.Version, item.IAVLVersion.Hash)
+			}
+
+		case *snapshottypes.SnapshotItem_IAVLChange:
+			if store == nil || !baseVerified {
+				return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(sdkerrors.ErrLogic, "received IAVL change item before base version item")
+			}
+			if item.IAVLChange.Delete {
+				store.Delete(item.IAVLChange.Key)
+				continue
+			}
+			// Protobuf does not differentiate between []byte{} and nil, but IAVL doesn't allow
+			// nil values.
+			value := item.IAVLChange.Value
+			if value == nil {
+				value = []byte{}
+			}
+			store.Set(item.IAVLChange.Key, value)
+
+		default:
+			break loop
+		}
+	}
+
+	if err := finishStore(); err != nil {
+		return snapshottypes.SnapshotItem{}, err
+	}
+
+	stores, err := rs.snapshotStores()
+	if err != nil {
+		return snapshottypes.SnapshotItem{}, err
+	}
+	for _, store := range stores {
+		if !restored[store.name] {
+			return snapshottypes.SnapshotItem{}, sdkerrors.Wrapf(sdkerrors.ErrLogic, "delta snapshot is missing store %q", store.name)
+		}
+	}
+
+	// the changes were written to the underlying IAVL stores, bypassing the inter-block cache.
+	if rs.interBlockCache != nil {
+		rs.interBlockCache.Reset()
+	}
+
+	cInfo := rs.buildCommitInfo(int64(height))
+	if !bytes.Equal(cInfo.Hash(), appHash) {
+		return snapshottypes.SnapshotItem{}, sdkerrors.Wrapf(snapshottypes.ErrStateHashMismatch,
+			"restored app hash %X at height %v, expected %X", cInfo.Hash(), height, appHash)
+	}
+
+	rs.flushMetadata(rs.db, int64(height), cInfo)
+	return snapshotItem, rs.LoadLatestVersion()
+}
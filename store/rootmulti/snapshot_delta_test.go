@@ -0,0 +1,254 @@
+package rootmulti_test
+
+import (
+	"io"
+	"testing"
+
+	protoio "github.com/gogo/protobuf/io"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// tamperWriter changes the value of every IAVL change written to a delta snapshot.
+type tamperWriter struct {
+	protoio.Writer
+}
+
+func (w tamperWriter) WriteMsg(msg proto.Message) error {
+	if item, ok := msg.(*snapshottypes.SnapshotItem); ok && item.GetIAVLChange() != nil {
+		change := *item.GetIAVLChange()
+		change.Value = append([]byte{0xff}, change.Value...)
+		msg = &snapshottypes.SnapshotItem{Item: &snapshottypes.SnapshotItem_IAVLChange{IAVLChange: &change}}
+	}
+	return w.Writer.WriteMsg(msg)
+}
+
+func snapshotStream(t *testing.T, write func(protoio.Writer) error) *snapshots.StreamReader {
+	chunks := make(chan io.ReadCloser, 100)
+	go func() {
+		streamWriter := snapshots.NewStreamWriter(chunks)
+		require.NotNil(t, streamWriter)
+		defer streamWriter.Close()
+		require.NoError(t, write(streamWriter))
+	}()
+
+	streamReader, err := snapshots.NewStreamReader(chunks)
+	require.NoError(t, err)
+	return streamReader
+}
+
+func newMultiStoreRestoredAt(t *testing.T, source *rootmulti.Store, height uint64) *rootmulti.Store {
+	target := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+	_, err := target.Restore(height, snapshottypes.CurrentFormat, snapshotStream(t, func(w protoio.Writer) error {
+		return source.Snapshot(height, w)
+	}))
+	require.NoError(t, err)
+	return target
+}
+
+func assertMultiStoresEqual(t *testing.T, source, target *rootmulti.Store) {
+	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
+	for _, key := range source.StoreKeysByName() {
+		sourceStore := source.GetStoreByName(key.Name()).(types.CommitKVStore)
+		targetStore := target.GetStoreByName(key.Name()).(types.CommitKVStore)
+		if sourceStore.GetStoreType() == types.StoreTypeIAVL {
+			assertStoresEqual(t, sourceStore, targetStore, "store %q not equal", key.Name())
+		}
+	}
+}
+
+func TestMultistoreSnapshotDelta_Errors(t *testing.T) {
+	store := newMultiStoreWithMixedMountsAndBasicData(dbm.NewMemDB())
+
+	testcases := map[string]struct {
+		baseHeight uint64
+		height     uint64
+	}{
+		"0 base height":       {0, 3},
+		"base height above":   {3, 2},
+		"same heights":        {2, 2},
+		"unknown height":      {1, 9},
+		"unknown base height": {9, 10},
+	}
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := store.SnapshotDelta(tc.baseHeight, tc.height, nil)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestMultistoreSnapshotDeltaRestore(t *testing.T) {
+	source := newMultiStoreWithMixedMountsAndBasicData(dbm.NewMemDB())
+	require.EqualValues(t, 3, source.LastCommitID().Version)
+
+	target := newMultiStoreRestoredAt(t, source, 1)
+	require.EqualValues(t, 1, target.LastCommitID().Version)
+
+	dummyExtensionItem := snapshottypes.SnapshotItem{
+		Item: &snapshottypes.SnapshotItem_Extension{
+			Extension: &snapshottypes.SnapshotExtensionMeta{
+				Name:   "test",
+				Format: 1,
+			},
+		},
+	}
+
+	appHash, err := source.AppHash(3)
+	require.NoError(t, err)
+	require.Equal(t, source.LastCommitID().Hash, appHash)
+
+	nextItem, err := target.RestoreDelta(1, 3, appHash, snapshotStream(t, func(w protoio.Writer) error {
+		if err := source.SnapshotDelta(1, 3, w); err != nil {
+			return err
+		}
+		return w.WriteMsg(&dummyExtensionItem)
+	}))
+	require.NoError(t, err)
+	require.Equal(t, *dummyExtensionItem.GetExtension(), *nextItem.GetExtension())
+	assertMultiStoresEqual(t, source, target)
+
+	// the delta can't be restored on top of another height
+	_, err = target.RestoreDelta(1, 3, appHash, snapshotStream(t, func(w protoio.Writer) error {
+		return source.SnapshotDelta(1, 3, w)
+	}))
+	require.ErrorIs(t, err, snapshottypes.ErrInvalidMetadata)
+}
+
+func TestMultistoreSnapshotDeltaRestore_HashMismatch(t *testing.T) {
+	source := newMultiStoreWithMixedMountsAndBasicData(dbm.NewMemDB())
+
+	appHash, err := source.AppHash(3)
+	require.NoError(t, err)
+
+	// tampered changes
+	target := newMultiStoreRestoredAt(t, source, 1)
+	_, err = target.RestoreDelta(1, 3, appHash, snapshotStream(t, func(w protoio.Writer) error {
+		return source.SnapshotDelta(1, 3, tamperWriter{w})
+	}))
+	require.ErrorIs(t, err, snapshottypes.ErrStateHashMismatch)
+
+	// different base state
+	other := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+	other.GetStoreByName("iavl1").(types.CommitKVStore).Set([]byte("a"), []byte{2})
+	other.Commit()
+	_, err = other.RestoreDelta(1, 3, appHash, snapshotStream(t, func(w protoio.Writer) error {
+		return source.SnapshotDelta(1, 3, w)
+	}))
+	require.ErrorIs(t, err, snapshottypes.ErrStateHashMismatch)
+
+	// a consistent delta restoring another app hash than the trusted one
+	target = newMultiStoreRestoredAt(t, source, 1)
+	_, err = target.RestoreDelta(1, 3, []byte("untrusted"), snapshotStream(t, func(w protoio.Writer) error {
+		return source.SnapshotDelta(1, 3, w)
+	}))
+	require.ErrorIs(t, err, snapshottypes.ErrStateHashMismatch)
+	require.EqualValues(t, 1, target.LatestVersion())
+}
+
+func TestMultistoreSnapshotDelta_Manager(t *testing.T) {
+	source := newMultiStoreWithMixedMountsAndBasicData(dbm.NewMemDB())
+	snapshotStore, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+
+	opts := snapshottypes.SnapshotOptions{Interval: 1, DeltaSnapshots: 1}
+	manager := snapshots.NewManager(snapshotStore, opts, source, nil, log.NewNopLogger())
+
+	// a delta is taken after every full snapshot
+	for height := int64(1); height <= 3; height++ {
+		manager.SnapshotIfApplicable(height)
+	}
+	list, err := manager.List()
+	require.NoError(t, err)
+	require.Len(t, list, 3)
+	full, delta := list[2], list[1]
+	assert.Equal(t, snapshottypes.CurrentFormat, full.Format)
+	assert.EqualValues(t, 1, full.Height)
+	assert.Equal(t, snapshottypes.DeltaFormat, delta.Format)
+	assert.EqualValues(t, 2, delta.Height)
+	assert.EqualValues(t, 1, delta.Metadata.BaseHeight)
+	appHash, err := source.AppHash(2)
+	require.NoError(t, err)
+	assert.Equal(t, appHash, delta.Metadata.AppHash)
+	assert.Equal(t, snapshottypes.CurrentFormat, list[0].Format)
+
+	// a delta requires an existing base snapshot
+	_, err = manager.CreateDelta(4, 3)
+	require.Error(t, err)
+
+	t.Run("restore local chain", func(t *testing.T) {
+		snapshotStore, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+		require.NoError(t, err)
+		manager := snapshots.NewManager(snapshotStore, snapshottypes.SnapshotOptions{}, source, nil, log.NewNopLogger())
+
+		_, err = manager.Create(1)
+		require.NoError(t, err)
+		_, err = manager.CreateDelta(2, 1)
+		require.NoError(t, err)
+		_, err = manager.CreateDelta(3, 2)
+		require.NoError(t, err)
+
+		target := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+		targetManager := snapshots.NewManager(snapshotStore, snapshottypes.SnapshotOptions{}, target, nil, log.NewNopLogger())
+		require.NoError(t, targetManager.RestoreLocalSnapshot(3, snapshottypes.DeltaFormat))
+		assertMultiStoresEqual(t, source, target)
+	})
+
+	t.Run("pruned heights", func(t *testing.T) {
+		snapshotStore, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+		require.NoError(t, err)
+		manager := snapshots.NewManager(snapshotStore, snapshottypes.SnapshotOptions{Interval: 1, DeltaSnapshots: 1}, source, nil, log.NewNopLogger())
+
+		source.SetPruning(pruningtypes.NewCustomPruningOptions(1, 10))
+		defer source.SetPruning(pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+
+		// the heights since the base height may have been pruned
+		_, err = manager.Create(1)
+		require.NoError(t, err)
+		_, err = manager.CreateDelta(2, 1)
+		require.ErrorContains(t, err, "pruning")
+
+		// the periodic snapshots fall back to full snapshots
+		manager.SnapshotIfApplicable(2)
+		snapshot, err := snapshotStore.GetLatest()
+		require.NoError(t, err)
+		assert.EqualValues(t, 2, snapshot.Height)
+		assert.Equal(t, snapshottypes.CurrentFormat, snapshot.Format)
+	})
+
+	t.Run("restore chunks", func(t *testing.T) {
+		target := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+		targetStore, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+		require.NoError(t, err)
+		targetManager := snapshots.NewManager(targetStore, snapshottypes.SnapshotOptions{}, target, nil, log.NewNopLogger())
+
+		// the delta can't be restored on an empty state
+		require.ErrorIs(t, targetManager.Restore(*delta), snapshottypes.ErrInvalidMetadata)
+
+		for _, snapshot := range []*snapshottypes.Snapshot{full, delta} {
+			require.NoError(t, targetManager.Restore(*snapshot))
+			for i := uint32(0); i < snapshot.Chunks; i++ {
+				chunk, err := manager.LoadChunk(snapshot.Height, snapshot.Format, i)
+				require.NoError(t, err)
+				done, err := targetManager.RestoreChunk(chunk)
+				require.NoError(t, err)
+				require.Equal(t, i == snapshot.Chunks-1, done)
+			}
+		}
+		assert.EqualValues(t, 2, target.LastCommitID().Version)
+
+		saved, err := targetStore.Get(delta.Height, delta.Format)
+		require.NoError(t, err)
+		assert.Equal(t, delta, saved)
+	})
+}
@@ -0,0 +1,173 @@
+package rootmulti_test
+
+import (
+	"io"
+	"testing"
+
+	protoio "github.com/gogo/protobuf/io"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+)
+
+// payloadExtension is an extension snapshotter holding a list of payloads.
+type payloadExtension struct {
+	name     string
+	payloads [][]byte
+}
+
+func (e *payloadExtension) SnapshotName() string {
+	return e.name
+}
+
+func (e *payloadExtension) SnapshotFormat() uint32 {
+	return 1
+}
+
+func (e *payloadExtension) SupportedFormats() []uint32 {
+	return []uint32{1}
+}
+
+func (e *payloadExtension) PruneSnapshotHeight(height int64) {}
+
+func (e *payloadExtension) SetSnapshotInterval(snapshotInterval uint64) {}
+
+func (e *payloadExtension) Snapshot(height uint64, protoWriter protoio.Writer) error {
+	for _, payload := range e.payloads {
+		if err := snapshottypes.WriteExtensionItem(protoWriter, payload); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *payloadExtension) Restore(
+	height uint64, format uint32, protoReader protoio.Reader,
+) (snapshottypes.SnapshotItem, error) {
+	for {
+		var item snapshottypes.SnapshotItem
+		err := protoReader.ReadMsg(&item)
+		if err == io.EOF {
+			return snapshottypes.SnapshotItem{}, nil
+		} else if err != nil {
+			return snapshottypes.SnapshotItem{}, err
+		}
+		payload := item.GetExtensionPayload()
+		if payload == nil {
+			return item, nil
+		}
+		e.payloads = append(e.payloads, payload.Payload)
+	}
+}
+
+func newParallelManager(
+	t *testing.T, multistore snapshottypes.Snapshotter, opts snapshottypes.SnapshotOptions, extensions ...*payloadExtension,
+) (*snapshots.Manager, *snapshots.Store) {
+	snapshotStore, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	manager := snapshots.NewManager(snapshotStore, opts, multistore, nil, log.NewNopLogger())
+	for _, extension := range extensions {
+		require.NoError(t, manager.RegisterExtensions(extension))
+	}
+	return manager, snapshotStore
+}
+
+func TestMultistoreSnapshotParallel_Manager(t *testing.T) {
+	source := newMultiStoreWithMixedMountsAndBasicData(dbm.NewMemDB())
+	extensions := func() []*payloadExtension {
+		return []*payloadExtension{
+			{name: "b", payloads: [][]byte{{2}, {3}}},
+			{name: "a", payloads: [][]byte{{1}}},
+		}
+	}
+
+	// the snapshot doesn't depend on the number of workers
+	var snapshot *snapshottypes.Snapshot
+	for _, workers := range []uint32{1, 2, 8} {
+		manager, _ := newParallelManager(t, source, snapshottypes.SnapshotOptions{ExportWorkers: workers}, extensions()...)
+		created, err := manager.Create(3)
+		require.NoError(t, err)
+		assert.Equal(t, snapshottypes.ParallelFormat, created.Format)
+		// one stream per IAVL store and per extension
+		assert.Len(t, created.Metadata.StreamChunks, 5)
+		if snapshot != nil {
+			assert.Equal(t, snapshot, created)
+		}
+		snapshot = created
+	}
+
+	manager, snapshotStore := newParallelManager(t, source, snapshottypes.SnapshotOptions{ExportWorkers: 4}, extensions()...)
+	_, err := manager.Create(3)
+	require.NoError(t, err)
+
+	t.Run("restore chunks", func(t *testing.T) {
+		for _, workers := range []uint32{0, 1, 3} {
+			target := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+			targetExtensions := []*payloadExtension{{name: "a"}, {name: "b"}}
+			targetManager, targetStore := newParallelManager(t, target,
+				snapshottypes.SnapshotOptions{RestoreWorkers: workers}, targetExtensions...)
+
+			require.NoError(t, targetManager.Restore(*snapshot))
+			for i := uint32(0); i < snapshot.Chunks; i++ {
+				chunk, err := manager.LoadChunk(snapshot.Height, snapshot.Format, i)
+				require.NoError(t, err)
+				done, err := targetManager.RestoreChunk(chunk)
+				require.NoError(t, err)
+				require.Equal(t, i == snapshot.Chunks-1, done)
+			}
+			assertMultiStoresEqual(t, source, target)
+			assert.Equal(t, [][]byte{{1}}, targetExtensions[0].payloads)
+			assert.Equal(t, [][]byte{{2}, {3}}, targetExtensions[1].payloads)
+
+			saved, err := targetStore.Get(snapshot.Height, snapshot.Format)
+			require.NoError(t, err)
+			assert.Equal(t, snapshot, saved)
+		}
+	})
+
+	t.Run("restore local snapshot", func(t *testing.T) {
+		target := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+		targetExtensions := []*payloadExtension{{name: "a"}, {name: "b"}}
+		targetManager := snapshots.NewManager(snapshotStore, snapshottypes.SnapshotOptions{RestoreWorkers: 2}, target, nil, log.NewNopLogger())
+		for _, extension := range targetExtensions {
+			require.NoError(t, targetManager.RegisterExtensions(extension))
+		}
+
+		require.NoError(t, targetManager.RestoreLocalSnapshot(snapshot.Height, snapshot.Format))
+		assertMultiStoresEqual(t, source, target)
+		assert.Equal(t, [][]byte{{1}}, targetExtensions[0].payloads)
+		assert.Equal(t, [][]byte{{2}, {3}}, targetExtensions[1].payloads)
+	})
+
+	t.Run("unknown extension", func(t *testing.T) {
+		target := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+		targetManager, _ := newParallelManager(t, target, snapshottypes.SnapshotOptions{}, &payloadExtension{name: "a"})
+
+		require.NoError(t, targetManager.Restore(*snapshot))
+		var err error
+		for i := uint32(0); i < snapshot.Chunks && err == nil; i++ {
+			var chunk []byte
+			chunk, err = manager.LoadChunk(snapshot.Height, snapshot.Format, i)
+			require.NoError(t, err)
+			_, err = targetManager.RestoreChunk(chunk)
+		}
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unknown extension snapshotter b")
+	})
+
+	t.Run("invalid streams", func(t *testing.T) {
+		target := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+		targetManager, _ := newParallelManager(t, target, snapshottypes.SnapshotOptions{})
+
+		invalid := *snapshot
+		invalid.Metadata.StreamChunks = append([]uint32{1}, snapshot.Metadata.StreamChunks...)
+		require.ErrorIs(t, targetManager.Restore(invalid), snapshottypes.ErrInvalidMetadata)
+
+		invalid.Metadata.StreamChunks = nil
+		require.ErrorIs(t, targetManager.Restore(invalid), snapshottypes.ErrInvalidMetadata)
+	})
+}
@@ -64,8 +64,9 @@ type Store struct {
 }
 
 var (
-	_ types.CommitMultiStore = (*Store)(nil)
-	_ types.Queryable        = (*Store)(nil)
+	_ types.CommitMultiStore         = (*Store)(nil)
+	_ types.Queryable                = (*Store)(nil)
+	_ snapshottypes.StoreSnapshotter = (*Store)(nil)
 )
 
 // NewStore returns a reference to a new Store object with the provided DB. The
@@ -408,6 +409,11 @@ func (rs *Store) LastCommitID() types.CommitID {
 	return rs.lastCommitInfo.CommitID()
 }
 
+// GetCommitInfo returns the commit info stored for the given version.
+func (rs *Store) GetCommitInfo(ver int64) (*types.CommitInfo, error) {
+	return getCommitInfo(rs.db, ver)
+}
+
 // Commit implements Committer/CommitStore.
 func (rs *Store) Commit() types.CommitID {
 	var previousHeight, version int64
@@ -745,80 +751,141 @@ func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
 		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot snapshot future height %v", height)
 	}
 
-	// Collect stores to snapshot (only IAVL stores are supported)
-	type namedStore struct {
-		*iavl.Store
-		name string
-	}
-	stores := []namedStore{}
-	for key := range rs.stores {
-		switch store := rs.GetCommitKVStore(key).(type) {
-		case *iavl.Store:
-			stores = append(stores, namedStore{name: key.Name(), Store: store})
-		case *transient.Store, *mem.Store:
-			// Non-persisted stores shouldn't be snapshotted
-			continue
-		default:
-			return sdkerrors.Wrapf(sdkerrors.ErrLogic,
-				"don't know how to snapshot store %q of type %T", key.Name(), store)
-		}
+	stores, err := rs.snapshotStores()
+	if err != nil {
+		return err
 	}
-	sort.Slice(stores, func(i, j int) bool {
-		return strings.Compare(stores[i].name, stores[j].name) == -1
-	})
 
 	// Export each IAVL store. Stores are serialized as a stream of SnapshotItem Protobuf
 	// messages. The first item contains a SnapshotStore with store metadata (i.e. name),
 	// and the following messages contain a SnapshotNode (i.e. an ExportNode). Store changes
 	// are demarcated by new SnapshotStore items.
 	for _, store := range stores {
-		exporter, err := store.Export(int64(height))
-		if err != nil {
+		if err := snapshotStore(store, height, protoWriter); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SnapshotStoreNames implements snapshottypes.StoreSnapshotter.
+func (rs *Store) SnapshotStoreNames() ([]string, error) {
+	stores, err := rs.snapshotStores()
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, len(stores))
+	for i, store := range stores {
+		names[i] = store.name
+	}
+	return names, nil
+}
+
+// SnapshotStore implements snapshottypes.StoreSnapshotter. The store is serialized as in Snapshot.
+func (rs *Store) SnapshotStore(height uint64, name string, protoWriter protoio.Writer) error {
+	if height == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrLogic, "cannot snapshot height 0")
+	}
+	if height > uint64(GetLatestVersion(rs.db)) {
+		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot snapshot future height %v", height)
+	}
+
+	store, ok := rs.GetStoreByName(name).(*iavl.Store)
+	if !ok || store == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot snapshot non-IAVL store %q", name)
+	}
+
+	return snapshotStore(namedStore{Store: store, name: name}, height, protoWriter)
+}
+
+// snapshotStore writes the SnapshotStoreItem of an IAVL store followed by its exported nodes.
+func snapshotStore(store namedStore, height uint64, protoWriter protoio.Writer) error {
+	exporter, err := store.Export(int64(height))
+	if err != nil {
+		return err
+	}
+	defer exporter.Close()
+	err = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+		Item: &snapshottypes.SnapshotItem_Store{
+			Store: &snapshottypes.SnapshotStoreItem{
+				Name: store.name,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		node, err := exporter.Next()
+		if err == iavltree.ExportDone {
+			break
+		} else if err != nil {
 			return err
 		}
-		defer exporter.Close()
 		err = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
-			Item: &snapshottypes.SnapshotItem_Store{
-				Store: &snapshottypes.SnapshotStoreItem{
-					Name: store.name,
+			Item: &snapshottypes.SnapshotItem_IAVL{
+				IAVL: &snapshottypes.SnapshotIAVLItem{
+					Key:     node.Key,
+					Value:   node.Value,
+					Height:  int32(node.Height),
+					Version: node.Version,
 				},
 			},
 		})
 		if err != nil {
 			return err
 		}
-
-		for {
-			node, err := exporter.Next()
-			if err == iavltree.ExportDone {
-				break
-			} else if err != nil {
-				return err
-			}
-			err = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
-				Item: &snapshottypes.SnapshotItem_IAVL{
-					IAVL: &snapshottypes.SnapshotIAVLItem{
-						Key:     node.Key,
-						Value:   node.Value,
-						Height:  int32(node.Height),
-						Version: node.Version,
-					},
-				},
-			})
-			if err != nil {
-				return err
-			}
-		}
-		exporter.Close()
 	}
 
 	return nil
 }
 
+// namedStore is an IAVL store with its name.
+type namedStore struct {
+	*iavl.Store
+	name string
+}
+
+// snapshotStores collects the stores to snapshot (only IAVL stores are supported), sorted by name.
+func (rs *Store) snapshotStores() ([]namedStore, error) {
+	stores := []namedStore{}
+	for key := range rs.stores {
+		switch store := rs.GetCommitKVStore(key).(type) {
+		case *iavl.Store:
+			stores = append(stores, namedStore{name: key.Name(), Store: store})
+		case *transient.Store, *mem.Store:
+			// Non-persisted stores shouldn't be snapshotted
+			continue
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic,
+				"don't know how to snapshot store %q of type %T", key.Name(), store)
+		}
+	}
+	sort.Slice(stores, func(i, j int) bool {
+		return strings.Compare(stores[i].name, stores[j].name) == -1
+	})
+	return stores, nil
+}
+
 // Restore implements snapshottypes.Snapshotter.
 // returns next snapshot item and error.
 func (rs *Store) Restore(
 	height uint64, format uint32, protoReader protoio.Reader,
+) (snapshottypes.SnapshotItem, error) {
+	snapshotItem, err := rs.RestoreStores(height, protoReader)
+	if err != nil {
+		return snapshottypes.SnapshotItem{}, err
+	}
+
+	return snapshotItem, rs.FinishRestore(height)
+}
+
+// RestoreStores implements snapshottypes.StoreSnapshotter.
+func (rs *Store) RestoreStores(
+	height uint64, protoReader protoio.Reader,
 ) (snapshottypes.SnapshotItem, error) {
 	// Import nodes into stores. The first item is expected to be a SnapshotItem containing
 	// a SnapshotStoreItem, telling us which store to import into. The following items will contain
@@ -894,8 +961,13 @@ loop:
 		importer.Close()
 	}
 
+	return snapshotItem, nil
+}
+
+// FinishRestore implements snapshottypes.StoreSnapshotter.
+func (rs *Store) FinishRestore(height uint64) error {
 	rs.flushMetadata(rs.db, int64(height), rs.buildCommitInfo(int64(height)))
-	return snapshotItem, rs.LoadLatestVersion()
+	return rs.LoadLatestVersion()
 }
 
 func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (types.CommitKVStore, error) {
@@ -315,6 +315,26 @@ func TestMultistoreLoadWithUpgrade(t *testing.T) {
 	checkContains(t, ci.StoreInfos, []string{"store1", "restore2", "store4"})
 }
 
+func TestGetCommitInfo(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, store.LoadLatestVersion())
+
+	s1, _ := store.GetStoreByName("store1").(types.KVStore)
+	s1.Set([]byte("key"), []byte("value"))
+	commitID := store.Commit()
+
+	ci, err := store.GetCommitInfo(1)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), ci.Version)
+	require.Equal(t, commitID, ci.CommitID())
+	checkContains(t, ci.StoreInfos, []string{"store1", "store2", "store3"})
+
+	// no commit info is stored for versions not committed yet
+	_, err = store.GetCommitInfo(2)
+	require.Error(t, err)
+}
+
 func TestParsePath(t *testing.T) {
 	_, _, err := parsePath("foo")
 	require.Error(t, err)
@@ -12,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	serverTypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	grpcstreaming "github.com/cosmos/cosmos-sdk/store/streaming/grpc"
 	"github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -27,6 +28,7 @@ type ServiceType int
 const (
 	Unknown ServiceType = iota
 	File
+	GRPC
 )
 
 // Streaming option keys
@@ -36,6 +38,17 @@ const (
 	OptStreamersFileOutputMetadata  = "streamers.file.output-metadata"
 	OptStreamersFileStopNodeOnError = "streamers.file.stop-node-on-error"
 	OptStreamersFileFsync           = "streamers.file.fsync"
+	OptStreamersFileCompression     = "streamers.file.compression"
+	OptStreamersFileMaxBlocks       = "streamers.file.max-blocks-per-file"
+	OptStreamersFileMaxSize         = "streamers.file.max-file-size"
+	OptStreamersFileMaxAge          = "streamers.file.max-file-age"
+	OptStreamersFileRetainBlocks    = "streamers.file.retain-blocks"
+
+	OptStreamersGRPCAddress         = "streamers.grpc.address"
+	OptStreamersGRPCAsync           = "streamers.grpc.async"
+	OptStreamersGRPCBufferSize      = "streamers.grpc.buffer-size"
+	OptStreamersGRPCTimeout         = "streamers.grpc.timeout"
+	OptStreamersGRPCStopNodeOnError = "streamers.grpc.stop-node-on-error"
 
 	OptStoreStreamers = "store.streamers"
 )
@@ -47,6 +60,9 @@ func ServiceTypeFromString(name string) ServiceType {
 	case "file", "f":
 		return File
 
+	case "grpc", "g":
+		return GRPC
+
 	default:
 		return Unknown
 	}
@@ -58,6 +74,9 @@ func (sst ServiceType) String() string {
 	case File:
 		return "file"
 
+	case GRPC:
+		return "grpc"
+
 	default:
 		return "unknown"
 	}
@@ -67,6 +86,7 @@ func (sst ServiceType) String() string {
 // streaming.ServiceConstructors types.
 var ServiceConstructorLookupTable = map[ServiceType]ServiceConstructor{
 	File: NewFileStreamingService,
+	GRPC: NewGRPCStreamingService,
 }
 
 // NewServiceConstructor returns the streaming.ServiceConstructor corresponding
@@ -98,6 +118,27 @@ func NewFileStreamingService(
 	stopNodeOnErr := cast.ToBool(opts.Get(OptStreamersFileStopNodeOnError))
 	fsync := cast.ToBool(opts.Get(OptStreamersFileFsync))
 
+	compression, err := file.ParseCompression(cast.ToString(opts.Get(OptStreamersFileCompression)))
+	if err != nil {
+		return nil, err
+	}
+
+	fileOpts := file.Options{
+		OutputMetadata:   outputMetadata,
+		StopNodeOnErr:    stopNodeOnErr,
+		Fsync:            fsync,
+		Compression:      compression,
+		MaxBlocksPerFile: cast.ToUint64(opts.Get(OptStreamersFileMaxBlocks)),
+		MaxFileSize:      cast.ToInt64(opts.Get(OptStreamersFileMaxSize)),
+		RetainBlocks:     cast.ToUint64(opts.Get(OptStreamersFileRetainBlocks)),
+	}
+
+	if v := opts.Get(OptStreamersFileMaxAge); v != nil {
+		if fileOpts.MaxFileAge, err = cast.ToDurationE(v); err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", OptStreamersFileMaxAge, err)
+		}
+	}
+
 	// relative path is based on node home directory.
 	if !path.IsAbs(fileDir) {
 		fileDir = path.Join(homePath, fileDir)
@@ -110,7 +151,33 @@ func NewFileStreamingService(
 		}
 	}
 
-	return file.NewStreamingService(fileDir, filePrefix, keys, marshaller, outputMetadata, stopNodeOnErr, fsync)
+	return file.NewStreamingServiceWithOptions(fileDir, filePrefix, keys, marshaller, fileOpts)
+}
+
+// NewGRPCStreamingService is the streaming.ServiceConstructor function for
+// creating a gRPC StreamingService.
+func NewGRPCStreamingService(
+	opts serverTypes.AppOptions,
+	keys []types.StoreKey,
+	_ codec.BinaryCodec,
+) (baseapp.StreamingService, error) {
+	cfg := grpcstreaming.Config{
+		Address:       cast.ToString(opts.Get(OptStreamersGRPCAddress)),
+		Async:         cast.ToBool(opts.Get(OptStreamersGRPCAsync)),
+		BufferSize:    cast.ToInt(opts.Get(OptStreamersGRPCBufferSize)),
+		StopNodeOnErr: cast.ToBool(opts.Get(OptStreamersGRPCStopNodeOnError)),
+	}
+
+	if v := opts.Get(OptStreamersGRPCTimeout); v != nil {
+		timeout, err := cast.ToDurationE(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", OptStreamersGRPCTimeout, err)
+		}
+
+		cfg.Timeout = timeout
+	}
+
+	return grpcstreaming.NewStreamingService(cfg, keys)
 }
 
 // LoadStreamingServices is a function for loading StreamingServices onto the
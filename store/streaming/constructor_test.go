@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/store/streaming"
 	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	grpcstreaming "github.com/cosmos/cosmos-sdk/store/streaming/grpc"
 	"github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -21,9 +22,13 @@ import (
 type fakeOptions struct{}
 
 func (f *fakeOptions) Get(key string) interface{} {
-	if key == "streamers.file.write_dir" {
+	switch key {
+	case "streamers.file.write_dir":
 		return "data/file_streamer"
-
+	case "streamers.grpc.address":
+		return "localhost:9191"
+	case "streamers.grpc.timeout":
+		return "5s"
 	}
 	return nil
 }
@@ -54,6 +59,24 @@ func TestStreamingServiceConstructor(t *testing.T) {
 	}
 }
 
+func TestGRPCStreamingServiceConstructor(t *testing.T) {
+	constructor, err := streaming.NewServiceConstructor("grpc")
+	require.Nil(t, err)
+
+	serv, err := constructor(mockOptions, mockKeys, testMarshaller)
+	require.Nil(t, err)
+	require.IsType(t, &grpcstreaming.StreamingService{}, serv)
+	listeners := serv.Listeners()
+	for _, key := range mockKeys {
+		_, ok := listeners[key]
+		require.True(t, ok)
+	}
+	require.NoError(t, serv.Close())
+
+	_, err = constructor(simapp.EmptyAppOptions{}, mockKeys, testMarshaller)
+	require.Error(t, err)
+}
+
 func TestLoadStreamingServices(t *testing.T) {
 	db := dbm.NewMemDB()
 	encCdc := simapp.MakeTestEncodingConfig()
@@ -0,0 +1,128 @@
+package file
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// Compression enumerates the compression algorithms applied to the files
+// written by the StreamingService.
+type Compression int
+
+const (
+	CompressionNone Compression = iota
+	CompressionGzip
+	CompressionZstd
+)
+
+// ParseCompression returns the Compression corresponding to the provided name.
+// An empty name is equivalent to "none".
+func ParseCompression(name string) (Compression, error) {
+	switch strings.ToLower(name) {
+	case "", "none":
+		return CompressionNone, nil
+
+	case "gzip", "gz":
+		return CompressionGzip, nil
+
+	case "zstd", "zst":
+		return CompressionZstd, nil
+
+	default:
+		return CompressionNone, fmt.Errorf("unrecognized compression %s", name)
+	}
+}
+
+// String returns the name of the Compression.
+func (c Compression) String() string {
+	switch c {
+	case CompressionGzip:
+		return "gzip"
+
+	case CompressionZstd:
+		return "zstd"
+
+	default:
+		return "none"
+	}
+}
+
+// Extension returns the file name extension, including the leading dot, of
+// the files compressed with c.
+func (c Compression) Extension() string {
+	switch c {
+	case CompressionGzip:
+		return ".gz"
+
+	case CompressionZstd:
+		return ".zst"
+
+	default:
+		return ""
+	}
+}
+
+// compressionFromExtension returns the Compression of a file name and the
+// file name stripped from its compression extension.
+func compressionFromExtension(name string) (Compression, string) {
+	for _, c := range []Compression{CompressionGzip, CompressionZstd} {
+		if strings.HasSuffix(name, c.Extension()) {
+			return c, strings.TrimSuffix(name, c.Extension())
+		}
+	}
+
+	return CompressionNone, name
+}
+
+// compressWriter is a compressing io.WriteCloser which can be flushed to make
+// all the data written so far decodable.
+type compressWriter interface {
+	io.WriteCloser
+	Flush() error
+}
+
+// newCompressWriter wraps w with the compression algorithm c. Closing the
+// returned writer does not close w.
+func newCompressWriter(w io.Writer, c Compression) (compressWriter, error) {
+	switch c {
+	case CompressionGzip:
+		return gzip.NewWriter(w), nil
+
+	case CompressionZstd:
+		return zstd.NewWriter(w)
+
+	default:
+		return nopCompressWriter{w}, nil
+	}
+}
+
+type nopCompressWriter struct {
+	io.Writer
+}
+
+func (nopCompressWriter) Flush() error { return nil }
+func (nopCompressWriter) Close() error { return nil }
+
+// NewDecompressReader wraps r with the decompression algorithm c. Closing the
+// returned reader does not close r.
+func NewDecompressReader(r io.Reader, c Compression) (io.ReadCloser, error) {
+	switch c {
+	case CompressionGzip:
+		return gzip.NewReader(r)
+
+	case CompressionZstd:
+		dec, err := zstd.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+
+		return dec.IOReadCloser(), nil
+
+	default:
+		return io.NopCloser(r), nil
+	}
+}
@@ -0,0 +1,268 @@
+package file
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// FileKind enumerates the kinds of files written by the StreamingService.
+type FileKind int
+
+const (
+	// FileKindMeta is a single block BlockMetadata file.
+	FileKindMeta FileKind = iota
+	// FileKindData is a single block state changes file.
+	FileKindData
+	// FileKindSegment is a file batching the metadata and state changes of
+	// consecutive blocks.
+	FileKindSegment
+)
+
+var (
+	blockFileRegexp   = regexp.MustCompile(`^block-(\d+)-(meta|data)$`)
+	segmentFileRegexp = regexp.MustCompile(`^blocks-(\d+)(?:-(\d+))?\.seg$`)
+)
+
+// FileInfo describes a file written by the StreamingService, as parsed from
+// its name.
+type FileInfo struct {
+	Name        string
+	Kind        FileKind
+	Compression Compression
+	StartHeight int64
+	// EndHeight is the height of the last block in the file. It is only known
+	// for complete files.
+	EndHeight int64
+	// Complete is false for a segment which is still being written, or which
+	// was left over by a node that did not shut down gracefully.
+	Complete bool
+}
+
+// ParseFileName parses the name of a file written by a StreamingService
+// configured with prefix. It returns false if the file was not written by such
+// a StreamingService.
+func ParseFileName(prefix, name string) (FileInfo, bool) {
+	info := FileInfo{Name: name}
+
+	if prefix != "" {
+		if !strings.HasPrefix(name, prefix+"-") {
+			return info, false
+		}
+		name = strings.TrimPrefix(name, prefix+"-")
+	}
+
+	info.Compression, name = compressionFromExtension(name)
+
+	if m := blockFileRegexp.FindStringSubmatch(name); m != nil {
+		height, err := strconv.ParseInt(m[1], 10, 64)
+		if err != nil {
+			return info, false
+		}
+
+		info.Kind = FileKindData
+		if m[2] == "meta" {
+			info.Kind = FileKindMeta
+		}
+
+		info.StartHeight, info.EndHeight, info.Complete = height, height, true
+		return info, true
+	}
+
+	if m := segmentFileRegexp.FindStringSubmatch(name); m != nil {
+		start, err := strconv.ParseInt(m[1], 10, 64)
+		if err != nil {
+			return info, false
+		}
+
+		info.Kind = FileKindSegment
+		info.StartHeight = start
+
+		if m[2] != "" {
+			end, err := strconv.ParseInt(m[2], 10, 64)
+			if err != nil || end < start {
+				return info, false
+			}
+
+			info.EndHeight, info.Complete = end, true
+		}
+
+		return info, true
+	}
+
+	return info, false
+}
+
+// ListFiles returns the files written by a StreamingService configured with
+// prefix in dir, sorted by start height and kind.
+func ListFiles(dir, prefix string) ([]FileInfo, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var infos []FileInfo
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		if info, ok := ParseFileName(prefix, entry.Name()); ok {
+			infos = append(infos, info)
+		}
+	}
+
+	sort.SliceStable(infos, func(i, j int) bool {
+		if infos[i].StartHeight != infos[j].StartHeight {
+			return infos[i].StartHeight < infos[j].StartHeight
+		}
+
+		return infos[i].Kind < infos[j].Kind
+	})
+
+	return infos, nil
+}
+
+func blockFileName(prefix string, height int64, kind string, c Compression) string {
+	return withPrefix(prefix, fmt.Sprintf("block-%d-%s%s", height, kind, c.Extension()))
+}
+
+func segmentFileName(prefix string, start, end int64, c Compression) string {
+	if end < start {
+		return withPrefix(prefix, fmt.Sprintf("blocks-%d.seg%s", start, c.Extension()))
+	}
+
+	return withPrefix(prefix, fmt.Sprintf("blocks-%d-%d.seg%s", start, end, c.Extension()))
+}
+
+func withPrefix(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+
+	return fmt.Sprintf("%s-%s", prefix, name)
+}
+
+// countingWriter counts the bytes written to the underlying writer.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.n += int64(n)
+	return n, err
+}
+
+// segmentWriter writes consecutive blocks to a single, optionally compressed,
+// segment file. Each block is encoded as its 8 bytes big endian height,
+// followed by the length-prefixed BlockMetadata and the length-prefixed state
+// changes, using the same 8 bytes big endian length prefix as the single block
+// files. An empty metadata is written when metadata output is disabled.
+type segmentWriter struct {
+	dir         string
+	prefix      string
+	compression Compression
+
+	file    *os.File
+	counter *countingWriter
+	writer  compressWriter
+
+	startHeight int64
+	endHeight   int64
+	startTime   time.Time
+	blocks      uint64
+}
+
+func openSegment(dir, prefix string, c Compression, startHeight int64, startTime time.Time) (*segmentWriter, error) {
+	name := path.Join(dir, segmentFileName(prefix, startHeight, startHeight-1, c))
+
+	f, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "open file failed: %s", name)
+	}
+
+	counter := &countingWriter{w: f}
+	w, err := newCompressWriter(counter, c)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	return &segmentWriter{
+		dir:         dir,
+		prefix:      prefix,
+		compression: c,
+		file:        f,
+		counter:     counter,
+		writer:      w,
+		startHeight: startHeight,
+		endHeight:   startHeight - 1,
+		startTime:   startTime,
+	}, nil
+}
+
+// writeBlock appends a block to the segment. The compressed stream is flushed
+// after every block so the file is decodable up to the last written block.
+func (sw *segmentWriter) writeBlock(height int64, meta, data []byte, fsync bool) error {
+	for _, bz := range [][]byte{
+		sdk.Uint64ToBigEndian(uint64(height)),
+		sdk.Uint64ToBigEndian(uint64(len(meta))), meta,
+		sdk.Uint64ToBigEndian(uint64(len(data))), data,
+	} {
+		if _, err := sw.writer.Write(bz); err != nil {
+			return sdkerrors.Wrapf(err, "write block data failed: %s", sw.file.Name())
+		}
+	}
+
+	if err := sw.writer.Flush(); err != nil {
+		return sdkerrors.Wrapf(err, "flush failed: %s", sw.file.Name())
+	}
+
+	if fsync {
+		if err := sw.file.Sync(); err != nil {
+			return sdkerrors.Wrapf(err, "fsync failed: %s", sw.file.Name())
+		}
+	}
+
+	sw.endHeight = height
+	sw.blocks++
+
+	return nil
+}
+
+// size returns the number of bytes written to the segment file so far.
+func (sw *segmentWriter) size() int64 {
+	return sw.counter.n
+}
+
+// close finishes the segment and renames it to its complete name, which
+// includes the height of its last block. An empty segment is removed.
+func (sw *segmentWriter) close() error {
+	name := sw.file.Name()
+
+	if err := sw.writer.Close(); err != nil {
+		sw.file.Close()
+		return sdkerrors.Wrapf(err, "close compressor failed: %s", name)
+	}
+
+	if err := sw.file.Close(); err != nil {
+		return sdkerrors.Wrapf(err, "close file failed: %s", name)
+	}
+
+	if sw.blocks == 0 {
+		return os.Remove(name)
+	}
+
+	return os.Rename(name, path.Join(sw.dir, segmentFileName(sw.prefix, sw.startHeight, sw.endHeight, sw.compression)))
+}
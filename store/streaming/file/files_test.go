@@ -0,0 +1,36 @@
+package file
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFileName(t *testing.T) {
+	testCases := []struct {
+		prefix   string
+		name     string
+		expected FileInfo
+		ok       bool
+	}{
+		{"", "block-10-meta", FileInfo{Kind: FileKindMeta, StartHeight: 10, EndHeight: 10, Complete: true}, true},
+		{"", "block-10-data.gz", FileInfo{Kind: FileKindData, Compression: CompressionGzip, StartHeight: 10, EndHeight: 10, Complete: true}, true},
+		{"pre", "pre-block-10-data.zst", FileInfo{Kind: FileKindData, Compression: CompressionZstd, StartHeight: 10, EndHeight: 10, Complete: true}, true},
+		{"pre", "pre-blocks-5-9.seg", FileInfo{Kind: FileKindSegment, StartHeight: 5, EndHeight: 9, Complete: true}, true},
+		{"pre", "pre-blocks-5.seg.zst", FileInfo{Kind: FileKindSegment, Compression: CompressionZstd, StartHeight: 5}, true},
+		{"", "pre-block-10-meta", FileInfo{}, false},
+		{"pre", "block-10-meta", FileInfo{}, false},
+		{"other", "pre-block-10-meta", FileInfo{}, false},
+		{"", "blocks-9-5.seg", FileInfo{}, false},
+		{"", "block-10-meta.tmp", FileInfo{}, false},
+	}
+
+	for _, tc := range testCases {
+		info, ok := ParseFileName(tc.prefix, tc.name)
+		require.Equal(t, tc.ok, ok, tc.name)
+		if tc.ok {
+			tc.expected.Name = tc.name
+			require.Equal(t, tc.expected, info, tc.name)
+		}
+	}
+}
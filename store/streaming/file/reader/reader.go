@@ -0,0 +1,331 @@
+// Package reader replays the files written by the file StreamingService back
+// into StoreKVPair and ABCI types.
+package reader
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// Block holds the data streamed for a single block.
+type Block struct {
+	Height int64
+	// Metadata holds the ABCI requests and responses of the block, it is nil
+	// if the StreamingService did not output the metadata.
+	Metadata *types.BlockMetadata
+	// Changes holds the state changes of the block, in the order they were
+	// written.
+	Changes []types.StoreKVPair
+}
+
+// Reader reads the files written by a file StreamingService.
+type Reader struct {
+	dir    string
+	prefix string
+	cdc    codec.BinaryCodec
+}
+
+// NewReader returns a Reader for the files written in dir by a StreamingService
+// configured with prefix.
+func NewReader(dir, prefix string, cdc codec.BinaryCodec) *Reader {
+	return &Reader{dir: dir, prefix: prefix, cdc: cdc}
+}
+
+// Replay calls fn for every block between fromHeight and toHeight included, in
+// the order they were written. A zero toHeight replays up to the last block.
+// Returning an error from fn stops the replay and returns the error.
+func (r *Reader) Replay(fromHeight, toHeight int64, fn func(*Block) error) error {
+	infos, err := file.ListFiles(r.dir, r.prefix)
+	if err != nil {
+		return err
+	}
+
+	inRange := func(height int64) bool {
+		return height >= fromHeight && (toHeight == 0 || height <= toHeight)
+	}
+
+	// the meta file of a block is listed right before its data file.
+	var pending *Block
+	flush := func() error {
+		if pending == nil {
+			return nil
+		}
+
+		block := pending
+		pending = nil
+		return fn(block)
+	}
+
+	for _, info := range infos {
+		if toHeight != 0 && info.StartHeight > toHeight {
+			break
+		}
+
+		if info.Complete && info.EndHeight < fromHeight {
+			continue
+		}
+
+		switch info.Kind {
+		case file.FileKindMeta:
+			if err := flush(); err != nil {
+				return err
+			}
+
+			if !inRange(info.StartHeight) {
+				continue
+			}
+
+			bz, err := r.readBlockFile(info)
+			if err != nil {
+				return err
+			}
+
+			meta := &types.BlockMetadata{}
+			if err := r.cdc.Unmarshal(bz, meta); err != nil {
+				return fmt.Errorf("failed to decode %s: %w", info.Name, err)
+			}
+
+			pending = &Block{Height: info.StartHeight, Metadata: meta}
+
+		case file.FileKindData:
+			if pending != nil && pending.Height != info.StartHeight {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+
+			if !inRange(info.StartHeight) {
+				continue
+			}
+
+			bz, err := r.readBlockFile(info)
+			if err != nil {
+				return err
+			}
+
+			changes, err := DecodeChanges(r.cdc, bz)
+			if err != nil {
+				return fmt.Errorf("failed to decode %s: %w", info.Name, err)
+			}
+
+			if pending == nil {
+				pending = &Block{Height: info.StartHeight}
+			}
+			pending.Changes = changes
+
+			if err := flush(); err != nil {
+				return err
+			}
+
+		case file.FileKindSegment:
+			if err := flush(); err != nil {
+				return err
+			}
+
+			// a segment being written or left over by a crash may end with an
+			// incomplete block, which is ignored.
+			if err := r.readSegment(info, !info.Complete, func(block *Block) error {
+				if !inRange(block.Height) {
+					return nil
+				}
+
+				return fn(block)
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	return flush()
+}
+
+// readBlockFile returns the content of a single block file, checking it
+// against its length prefix.
+func (r *Reader) readBlockFile(info file.FileInfo) ([]byte, error) {
+	f, err := os.Open(path.Join(r.dir, info.Name))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	dr, err := file.NewDecompressReader(bufio.NewReader(f), info.Compression)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress %s: %w", info.Name, err)
+	}
+	defer dr.Close()
+
+	bz, err := readLengthPrefixed(dr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", info.Name, err)
+	}
+
+	return bz, nil
+}
+
+// readSegment calls fn for every block of a segment file. If allowTruncated is
+// set, an incomplete trailing block ends the segment instead of failing.
+func (r *Reader) readSegment(info file.FileInfo, allowTruncated bool, fn func(*Block) error) error {
+	f, err := os.Open(path.Join(r.dir, info.Name))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	dr, err := file.NewDecompressReader(bufio.NewReader(f), info.Compression)
+	if err != nil {
+		if allowTruncated && isTruncated(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to decompress %s: %w", info.Name, err)
+	}
+	defer dr.Close()
+
+	err = ReadSegment(r.cdc, dr, func(block *Block) error {
+		if info.Complete && block.Height > info.EndHeight {
+			return io.EOF
+		}
+
+		return fn(block)
+	})
+
+	switch {
+	case err == nil, errors.Is(err, io.EOF):
+		return nil
+
+	case allowTruncated && isTruncated(err):
+		return nil
+
+	default:
+		return fmt.Errorf("failed to read %s: %w", info.Name, err)
+	}
+}
+
+// ReadSegment calls fn for every block encoded in the decompressed segment
+// stream rd. It stops at the end of the stream or at the first error returned
+// by fn.
+func ReadSegment(cdc codec.BinaryCodec, rd io.Reader, fn func(*Block) error) error {
+	var heightBz [8]byte
+
+	for {
+		if _, err := io.ReadFull(rd, heightBz[:]); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+
+		block := &Block{Height: int64(binary.BigEndian.Uint64(heightBz[:]))}
+
+		meta, err := readLengthPrefixed(rd)
+		if err != nil {
+			return unexpectedEOF(err)
+		}
+
+		if len(meta) > 0 {
+			block.Metadata = &types.BlockMetadata{}
+			if err := cdc.Unmarshal(meta, block.Metadata); err != nil {
+				return err
+			}
+		}
+
+		data, err := readLengthPrefixed(rd)
+		if err != nil {
+			return unexpectedEOF(err)
+		}
+
+		if block.Changes, err = DecodeChanges(cdc, data); err != nil {
+			return err
+		}
+
+		if err := fn(block); err != nil {
+			return err
+		}
+	}
+}
+
+// DecodeChanges decodes the length-prefixed StoreKVPairs written to the data
+// files.
+func DecodeChanges(cdc codec.BinaryCodec, bz []byte) ([]types.StoreKVPair, error) {
+	var changes []types.StoreKVPair
+
+	rd := bytes.NewReader(bz)
+	for rd.Len() > 0 {
+		size, err := binary.ReadUvarint(rd)
+		if err != nil {
+			return nil, err
+		}
+
+		if size > uint64(rd.Len()) {
+			return nil, io.ErrUnexpectedEOF
+		}
+
+		pairBz := make([]byte, size)
+		if _, err := io.ReadFull(rd, pairBz); err != nil {
+			return nil, err
+		}
+
+		var pair types.StoreKVPair
+		if err := cdc.Unmarshal(pairBz, &pair); err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, pair)
+	}
+
+	return changes, nil
+}
+
+// readLengthPrefixed reads a payload prefixed with its 8 bytes big endian
+// length.
+func readLengthPrefixed(rd io.Reader) ([]byte, error) {
+	var sizeBz [8]byte
+	if _, err := io.ReadFull(rd, sizeBz[:]); err != nil {
+		return nil, err
+	}
+
+	size := binary.BigEndian.Uint64(sizeBz[:])
+	bz := make([]byte, 0, minUint64(size, 1<<20))
+
+	buf := bytes.NewBuffer(bz)
+	n, err := io.CopyN(buf, rd, int64(size))
+	if err != nil {
+		if err == io.EOF && uint64(n) < size {
+			return nil, io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+
+	return err
+}
+
+// isTruncated returns true if err denotes a stream ending in the middle of a
+// block, or of a compressed frame.
+func isTruncated(err error) bool {
+	return errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF)
+}
+
+func minUint64(a, b uint64) uint64 {
+	if a < b {
+		return a
+	}
+
+	return b
+}
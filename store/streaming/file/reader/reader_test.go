@@ -0,0 +1,212 @@
+package reader_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	"github.com/cosmos/cosmos-sdk/store/streaming/file/reader"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	testCodec    = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	testStoreKey = sdk.NewKVStoreKey("mockStore")
+	testPrefix   = "testPrefix"
+	genesisTime  = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// streamBlocks streams the blocks [from, to] with one state change and one
+// transaction each, and a block time of one minute.
+func streamBlocks(t *testing.T, svc *file.StreamingService, from, to int64) {
+	ctx := context.Background()
+	listener := svc.Listeners()[testStoreKey][0]
+
+	for height := from; height <= to; height++ {
+		header := tmproto.Header{Height: height, Time: genesisTime.Add(time.Duration(height) * time.Minute)}
+		require.NoError(t, svc.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: header}, abci.ResponseBeginBlock{}))
+		listener.OnWrite(testStoreKey, []byte(fmt.Sprintf("key%d", height)), []byte("value"), false)
+		require.NoError(t, svc.ListenDeliverTx(ctx, abci.RequestDeliverTx{Tx: []byte{byte(height)}}, abci.ResponseDeliverTx{}))
+		require.NoError(t, svc.ListenEndBlock(ctx, abci.RequestEndBlock{Height: height}, abci.ResponseEndBlock{}))
+		require.NoError(t, svc.ListenCommit(ctx, abci.ResponseCommit{}))
+	}
+}
+
+func replay(t *testing.T, dir string, from, to int64) []*reader.Block {
+	var blocks []*reader.Block
+	require.NoError(t, reader.NewReader(dir, testPrefix, testCodec).Replay(from, to, func(block *reader.Block) error {
+		blocks = append(blocks, block)
+		return nil
+	}))
+
+	return blocks
+}
+
+func requireBlocks(t *testing.T, blocks []*reader.Block, from, to int64, withMetadata bool) {
+	require.Len(t, blocks, int(to-from+1))
+
+	for i, block := range blocks {
+		height := from + int64(i)
+		require.Equal(t, height, block.Height)
+		require.Equal(t, []types.StoreKVPair{
+			{StoreKey: testStoreKey.Name(), Key: []byte(fmt.Sprintf("key%d", height)), Value: []byte("value")},
+		}, block.Changes)
+
+		if !withMetadata {
+			require.Nil(t, block.Metadata)
+			continue
+		}
+
+		require.NotNil(t, block.Metadata)
+		require.Equal(t, height, block.Metadata.RequestBeginBlock.Header.Height)
+		require.Len(t, block.Metadata.DeliverTxs, 1)
+		require.Equal(t, []byte{byte(height)}, block.Metadata.DeliverTxs[0].Request.Tx)
+	}
+}
+
+func listFiles(t *testing.T, dir string) []file.FileInfo {
+	infos, err := file.ListFiles(dir, testPrefix)
+	require.NoError(t, err)
+	return infos
+}
+
+func TestReplay(t *testing.T) {
+	testCases := map[string]struct {
+		opts          file.Options
+		expectedFiles int
+	}{
+		"one file per block": {
+			opts:          file.Options{OutputMetadata: true},
+			expectedFiles: 20,
+		},
+		"one file per block, gzip": {
+			opts:          file.Options{OutputMetadata: true, Compression: file.CompressionGzip},
+			expectedFiles: 20,
+		},
+		"one data file per block, no metadata": {
+			opts:          file.Options{},
+			expectedFiles: 10,
+		},
+		"batched by blocks": {
+			opts:          file.Options{OutputMetadata: true, MaxBlocksPerFile: 4},
+			expectedFiles: 3,
+		},
+		"batched by blocks, zstd": {
+			opts:          file.Options{OutputMetadata: true, Fsync: true, Compression: file.CompressionZstd, MaxBlocksPerFile: 5},
+			expectedFiles: 2,
+		},
+		"batched by block time, gzip": {
+			opts:          file.Options{Compression: file.CompressionGzip, MaxFileAge: 3 * time.Minute},
+			expectedFiles: 4,
+		},
+		"batched by size": {
+			opts:          file.Options{MaxFileSize: 1},
+			expectedFiles: 10,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+
+			svc, err := file.NewStreamingServiceWithOptions(dir, testPrefix, []types.StoreKey{testStoreKey}, testCodec, tc.opts)
+			require.NoError(t, err)
+
+			streamBlocks(t, svc, 1, 10)
+			require.NoError(t, svc.Close())
+
+			infos := listFiles(t, dir)
+			require.Len(t, infos, tc.expectedFiles)
+			for _, info := range infos {
+				require.True(t, info.Complete)
+				require.Equal(t, tc.opts.Compression, info.Compression)
+			}
+
+			requireBlocks(t, replay(t, dir, 0, 0), 1, 10, tc.opts.OutputMetadata)
+			requireBlocks(t, replay(t, dir, 3, 7), 3, 7, tc.opts.OutputMetadata)
+		})
+	}
+}
+
+func TestReplayIncompleteSegment(t *testing.T) {
+	dir := t.TempDir()
+
+	svc, err := file.NewStreamingServiceWithOptions(dir, testPrefix, []types.StoreKey{testStoreKey}, testCodec, file.Options{
+		OutputMetadata:   true,
+		Compression:      file.CompressionZstd,
+		MaxBlocksPerFile: 100,
+	})
+	require.NoError(t, err)
+
+	// the segment being written is readable up to its last block
+	streamBlocks(t, svc, 1, 5)
+	infos := listFiles(t, dir)
+	require.Len(t, infos, 1)
+	require.False(t, infos[0].Complete)
+	requireBlocks(t, replay(t, dir, 0, 0), 1, 5, true)
+
+	// a truncated trailing block is ignored
+	name := filepath.Join(dir, infos[0].Name)
+	fi, err := os.Stat(name)
+	require.NoError(t, err)
+	require.NoError(t, os.Truncate(name, fi.Size()-3))
+
+	blocks := replay(t, dir, 0, 0)
+	require.NotEmpty(t, blocks)
+	requireBlocks(t, blocks, 1, int64(len(blocks)), true)
+}
+
+func TestRetainBlocks(t *testing.T) {
+	t.Run("one file per block", func(t *testing.T) {
+		dir := t.TempDir()
+
+		svc, err := file.NewStreamingServiceWithOptions(dir, testPrefix, []types.StoreKey{testStoreKey}, testCodec, file.Options{
+			OutputMetadata: true,
+			RetainBlocks:   3,
+		})
+		require.NoError(t, err)
+
+		streamBlocks(t, svc, 1, 10)
+		require.Len(t, listFiles(t, dir), 6)
+		requireBlocks(t, replay(t, dir, 0, 0), 8, 10, true)
+
+		// files left over by a previous run are pruned at startup
+		svc, err = file.NewStreamingServiceWithOptions(dir, testPrefix, []types.StoreKey{testStoreKey}, testCodec, file.Options{
+			RetainBlocks: 1,
+		})
+		require.NoError(t, err)
+
+		streamBlocks(t, svc, 11, 11)
+		requireBlocks(t, replay(t, dir, 0, 0), 11, 11, false)
+	})
+
+	t.Run("batched", func(t *testing.T) {
+		dir := t.TempDir()
+
+		svc, err := file.NewStreamingServiceWithOptions(dir, testPrefix, []types.StoreKey{testStoreKey}, testCodec, file.Options{
+			MaxBlocksPerFile: 3,
+			RetainBlocks:     4,
+		})
+		require.NoError(t, err)
+
+		streamBlocks(t, svc, 1, 10)
+		require.NoError(t, svc.Close())
+
+		// segments [1, 3] and [4, 6] are older than the 4 most recent blocks
+		infos := listFiles(t, dir)
+		require.Len(t, infos, 2)
+		require.Equal(t, int64(7), infos[0].StartHeight)
+		requireBlocks(t, replay(t, dir, 0, 0), 7, 10, false)
+	})
+}
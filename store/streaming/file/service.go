@@ -9,6 +9,7 @@ import (
 	"path"
 	"sort"
 	"sync"
+	"time"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
@@ -43,6 +44,43 @@ type StreamingService struct {
 	// fsync, if true, will execute file Sync to make sure the data is persisted
 	// onto disk, otherwise there is a risk of data loss during any crash.
 	fsync bool
+
+	compression      Compression
+	maxBlocksPerFile uint64
+	maxFileSize      int64
+	maxFileAge       time.Duration
+	retainBlocks     uint64
+
+	currentBlockTime time.Time
+	segment          *segmentWriter // the segment being written in batching mode
+	fullPrune        bool           // whether the next pruning must scan the write directory
+}
+
+// Options defines the optional behaviour of a StreamingService.
+type Options struct {
+	// OutputMetadata, if true, writes the BlockMetadata of every block.
+	OutputMetadata bool
+	// StopNodeOnErr, if true, propagates the errors to the consensus state
+	// machine during ABCI Commit.
+	StopNodeOnErr bool
+	// Fsync, if true, syncs the files after every block.
+	Fsync bool
+
+	// Compression is the compression algorithm applied to the files.
+	Compression Compression
+
+	// MaxBlocksPerFile, MaxFileSize and MaxFileAge enable batching when any of
+	// them is non-zero: the blocks are appended to a segment file which is
+	// rotated once it holds MaxBlocksPerFile blocks, reaches MaxFileSize bytes
+	// or spans more than MaxFileAge of block time. Otherwise, one meta and one
+	// data file are written per block.
+	MaxBlocksPerFile uint64
+	MaxFileSize      int64
+	MaxFileAge       time.Duration
+
+	// RetainBlocks, if non-zero, prunes the files only holding blocks older
+	// than the RetainBlocks most recent ones.
+	RetainBlocks uint64
 }
 
 func NewStreamingService(
@@ -51,6 +89,25 @@ func NewStreamingService(
 	cdc codec.BinaryCodec,
 	outputMetadata, stopNodeOnErr, fsync bool,
 ) (*StreamingService, error) {
+	return NewStreamingServiceWithOptions(writeDir, filePrefix, storeKeys, cdc, Options{
+		OutputMetadata: outputMetadata,
+		StopNodeOnErr:  stopNodeOnErr,
+		Fsync:          fsync,
+	})
+}
+
+// NewStreamingServiceWithOptions creates a StreamingService writing the files
+// into writeDir, configured with opts.
+func NewStreamingServiceWithOptions(
+	writeDir, filePrefix string,
+	storeKeys []types.StoreKey,
+	cdc codec.BinaryCodec,
+	opts Options,
+) (*StreamingService, error) {
+	if opts.MaxFileSize < 0 || opts.MaxFileAge < 0 {
+		return nil, fmt.Errorf("file rotation limits cannot be negative")
+	}
+
 	// sort storeKeys for deterministic output
 	sort.SliceStable(storeKeys, func(i, j int) bool {
 		return storeKeys[i].Name() < storeKeys[j].Name()
@@ -70,13 +127,19 @@ func NewStreamingService(
 	}
 
 	return &StreamingService{
-		storeListeners: listeners,
-		filePrefix:     filePrefix,
-		writeDir:       writeDir,
-		codec:          cdc,
-		outputMetadata: outputMetadata,
-		stopNodeOnErr:  stopNodeOnErr,
-		fsync:          fsync,
+		storeListeners:   listeners,
+		filePrefix:       filePrefix,
+		writeDir:         writeDir,
+		codec:            cdc,
+		outputMetadata:   opts.OutputMetadata,
+		stopNodeOnErr:    opts.StopNodeOnErr,
+		fsync:            opts.Fsync,
+		compression:      opts.Compression,
+		maxBlocksPerFile: opts.MaxBlocksPerFile,
+		maxFileSize:      opts.MaxFileSize,
+		maxFileAge:       opts.MaxFileAge,
+		retainBlocks:     opts.RetainBlocks,
+		fullPrune:        true,
 	}, nil
 }
 
@@ -97,9 +160,11 @@ func (fss *StreamingService) Listeners() map[types.StoreKey][]types.WriteListene
 // not written to file until ListenCommit is executed and outputMetadata is set,
 // after which it will be reset again on the next block.
 func (fss *StreamingService) ListenBeginBlock(ctx context.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	fss.blockMetadata = types.BlockMetadata{}
 	fss.blockMetadata.RequestBeginBlock = &req
 	fss.blockMetadata.ResponseBeginBlock = &res
 	fss.currentBlockNumber = req.Header.Height
+	fss.currentBlockTime = req.Header.Time
 	return nil
 }
 
@@ -142,33 +207,144 @@ func (fss *StreamingService) ListenCommit(ctx context.Context, res abci.Response
 func (fss *StreamingService) doListenCommit(ctx context.Context, res abci.ResponseCommit) (err error) {
 	fss.blockMetadata.ResponseCommit = &res
 
-	// Write to target files, the file size is written at the beginning, which can
-	// be used to detect completeness.
-	metaFileName := fmt.Sprintf("block-%d-meta", fss.currentBlockNumber)
-	dataFileName := fmt.Sprintf("block-%d-data", fss.currentBlockNumber)
+	var meta []byte
+	if fss.outputMetadata {
+		if meta, err = fss.codec.Marshal(&fss.blockMetadata); err != nil {
+			return err
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := fss.writeBlockData(&buf); err != nil {
+		return err
+	}
 
-	if fss.filePrefix != "" {
-		metaFileName = fmt.Sprintf("%s-%s", fss.filePrefix, metaFileName)
-		dataFileName = fmt.Sprintf("%s-%s", fss.filePrefix, dataFileName)
+	if fss.batching() {
+		err = fss.writeSegmentBlock(meta, buf.Bytes())
+	} else {
+		err = fss.writeBlockFiles(meta, buf.Bytes())
 	}
 
+	if err != nil {
+		return err
+	}
+
+	if fss.retainBlocks > 0 {
+		return fss.prune()
+	}
+
+	return nil
+}
+
+// batching returns true if the blocks are batched into segment files.
+func (fss *StreamingService) batching() bool {
+	return fss.maxBlocksPerFile > 0 || fss.maxFileSize > 0 || fss.maxFileAge > 0
+}
+
+// writeBlockFiles writes the metadata and the state changes of the current
+// block to their own files. The file size is written at the beginning, which
+// can be used to detect completeness.
+func (fss *StreamingService) writeBlockFiles(meta, data []byte) error {
 	if fss.outputMetadata {
-		bz, err := fss.codec.Marshal(&fss.blockMetadata)
-		if err != nil {
+		metaFileName := blockFileName(fss.filePrefix, fss.currentBlockNumber, "meta", fss.compression)
+		if err := writeLengthPrefixedFile(path.Join(fss.writeDir, metaFileName), meta, fss.compression, fss.fsync); err != nil {
 			return err
 		}
+	}
 
-		if err := writeLengthPrefixedFile(path.Join(fss.writeDir, metaFileName), bz, fss.fsync); err != nil {
+	dataFileName := blockFileName(fss.filePrefix, fss.currentBlockNumber, "data", fss.compression)
+	return writeLengthPrefixedFile(path.Join(fss.writeDir, dataFileName), data, fss.compression, fss.fsync)
+}
+
+// writeSegmentBlock appends the metadata and the state changes of the current
+// block to the current segment, rotating it when any of the limits is reached.
+func (fss *StreamingService) writeSegmentBlock(meta, data []byte) error {
+	if fss.segment != nil && fss.maxFileAge > 0 && fss.currentBlockTime.Sub(fss.segment.startTime) >= fss.maxFileAge {
+		if err := fss.closeSegment(); err != nil {
 			return err
 		}
 	}
 
-	var buf bytes.Buffer
-	if err := fss.writeBlockData(&buf); err != nil {
+	if fss.segment == nil {
+		segment, err := openSegment(fss.writeDir, fss.filePrefix, fss.compression, fss.currentBlockNumber, fss.currentBlockTime)
+		if err != nil {
+			return err
+		}
+
+		fss.segment = segment
+	}
+
+	if err := fss.segment.writeBlock(fss.currentBlockNumber, meta, data, fss.fsync); err != nil {
+		// finish the segment with the blocks successfully written so far, the
+		// next block is written to a new segment.
+		_ = fss.closeSegment()
+		return err
+	}
+
+	if (fss.maxBlocksPerFile > 0 && fss.segment.blocks >= fss.maxBlocksPerFile) ||
+		(fss.maxFileSize > 0 && fss.segment.size() >= fss.maxFileSize) {
+		return fss.closeSegment()
+	}
+
+	return nil
+}
+
+func (fss *StreamingService) closeSegment() error {
+	segment := fss.segment
+	fss.segment = nil
+
+	return segment.close()
+}
+
+// prune removes the files only holding blocks older than the retainBlocks most
+// recent ones. When writing one file per block, the write directory is only
+// scanned at startup, afterwards the files of the expired height are removed.
+func (fss *StreamingService) prune() error {
+	retainHeight := fss.currentBlockNumber - int64(fss.retainBlocks)
+	if retainHeight <= 0 {
+		return nil
+	}
+
+	if !fss.fullPrune && !fss.batching() {
+		for _, kind := range []string{"meta", "data"} {
+			name := path.Join(fss.writeDir, blockFileName(fss.filePrefix, retainHeight, kind, fss.compression))
+			if err := os.Remove(name); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	infos, err := ListFiles(fss.writeDir, fss.filePrefix)
+	if err != nil {
 		return err
 	}
 
-	return writeLengthPrefixedFile(path.Join(fss.writeDir, dataFileName), buf.Bytes(), fss.fsync)
+	for i, info := range infos {
+		if fss.segment != nil && info.Name == path.Base(fss.segment.file.Name()) {
+			continue
+		}
+
+		endHeight := info.EndHeight
+		if !info.Complete {
+			// the last block of a left over segment is bounded by the start of
+			// the following file.
+			if i+1 == len(infos) {
+				continue
+			}
+			endHeight = infos[i+1].StartHeight - 1
+		}
+
+		if endHeight <= retainHeight {
+			if err := os.Remove(path.Join(fss.writeDir, info.Name)); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+	}
+
+	fss.fullPrune = false
+	return nil
 }
 
 func (fss *StreamingService) writeBlockData(writer io.Writer) error {
@@ -193,8 +369,15 @@ func (fss *StreamingService) writeBlockData(writer io.Writer) error {
 // Stream satisfies the StreamingService interface. It performs a no-op.
 func (fss *StreamingService) Stream(wg *sync.WaitGroup) error { return nil }
 
-// Close satisfies the StreamingService interface. It performs a no-op.
-func (fss *StreamingService) Close() error { return nil }
+// Close satisfies the StreamingService interface. It finishes the segment being
+// written, if any.
+func (fss *StreamingService) Close() error {
+	if fss.segment == nil {
+		return nil
+	}
+
+	return fss.closeSegment()
+}
 
 // isDirWriteable checks if dir is writable by writing and removing a file
 // to dir. It returns nil if dir is writable. We have to do this as there is no
@@ -208,7 +391,7 @@ func isDirWriteable(dir string) error {
 	return os.Remove(f)
 }
 
-func writeLengthPrefixedFile(path string, data []byte, fsync bool) (err error) {
+func writeLengthPrefixedFile(path string, data []byte, compression Compression, fsync bool) (err error) {
 	var f *os.File
 	f, err = os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
 	if err != nil {
@@ -222,16 +405,25 @@ func writeLengthPrefixedFile(path string, data []byte, fsync bool) (err error) {
 		}
 	}()
 
-	_, err = f.Write(sdk.Uint64ToBigEndian(uint64(len(data))))
+	w, err := newCompressWriter(f, compression)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(sdk.Uint64ToBigEndian(uint64(len(data))))
 	if err != nil {
 		return sdkerrors.Wrapf(err, "write length prefix failed: %s", path)
 	}
 
-	_, err = f.Write(data)
+	_, err = w.Write(data)
 	if err != nil {
 		return sdkerrors.Wrapf(err, "write block data failed: %s", path)
 	}
 
+	if err = w.Close(); err != nil {
+		return sdkerrors.Wrapf(err, "close compressor failed: %s", path)
+	}
+
 	if fsync {
 		err = f.Sync()
 		if err != nil {
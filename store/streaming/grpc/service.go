@@ -0,0 +1,389 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var _ baseapp.StreamingService = &StreamingService{}
+
+// ErrServiceClosed is returned when a message is pushed to a StreamingService
+// that has already been closed.
+var ErrServiceClosed = errors.New("grpc streaming service is closed")
+
+// ErrQueueFull is returned when a message is dropped because the asynchronous
+// delivery queue stayed full for the whole timeout.
+var ErrQueueFull = errors.New("grpc streaming service queue is full")
+
+const (
+	// DefaultBufferSize is the default number of messages the asynchronous
+	// delivery queue can hold before new messages are dropped.
+	DefaultBufferSize = 1000
+
+	// DefaultTimeout is the default timeout of every RPC made to the consumer.
+	DefaultTimeout = 5 * time.Second
+)
+
+// Config defines the configuration of a gRPC StreamingService.
+type Config struct {
+	// Address is the target address of the consumer's ABCIListenerService.
+	Address string
+
+	// Async, if true, queues the messages and delivers them from a background
+	// goroutine started by Stream, otherwise every ABCI hook blocks until the
+	// consumer acknowledged the message.
+	Async bool
+
+	// BufferSize is the size of the asynchronous delivery queue. It is ignored
+	// in synchronous mode. The messages pushed while the queue is full are
+	// dropped, unless StopNodeOnErr is set.
+	BufferSize int
+
+	// Timeout bounds every RPC made to the consumer. A zero value uses
+	// DefaultTimeout.
+	Timeout time.Duration
+
+	// StopNodeOnErr, if true, will panic and stop the node when a message could
+	// not be delivered. In asynchronous mode a full queue blocks the ABCI hook
+	// for up to Timeout, after which the hook returns ErrQueueFull, and the
+	// delivery errors are surfaced on the next ABCI Commit. Otherwise, any
+	// errors are ignored which could yield data loss in the streamed output.
+	StopNodeOnErr bool
+}
+
+// StreamingService is a concrete implementation of StreamingService that
+// pushes ABCI messages and state changes to an out-of-process consumer over
+// gRPC.
+type StreamingService struct {
+	storeListeners []*types.MemoryListener // a series of KVStore listeners for each KVStore
+	conn           *grpc.ClientConn
+	client         ABCIListenerServiceClient
+	cfg            Config
+
+	currentBlockNumber int64
+
+	// queue holds the pending deliveries in asynchronous mode, it is drained
+	// by the background goroutine once done is closed.
+	queue chan func(context.Context) error
+	done  chan struct{}
+
+	mtx       sync.Mutex // guards closed and streaming
+	closed    bool
+	streaming bool
+
+	errMtx  sync.Mutex
+	lastErr error // first asynchronous delivery error not yet surfaced
+}
+
+// NewStreamingService creates a StreamingService connected to the consumer at
+// cfg.Address. The connection is established lazily so the consumer does not
+// have to be running when the node starts.
+func NewStreamingService(cfg Config, storeKeys []types.StoreKey) (*StreamingService, error) {
+	if cfg.Address == "" {
+		return nil, errors.New("grpc streaming service address cannot be empty")
+	}
+
+	conn, err := grpc.Dial(cfg.Address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial grpc streaming consumer %s: %w", cfg.Address, err)
+	}
+
+	svc := newStreamingService(cfg, storeKeys, NewABCIListenerServiceClient(conn))
+	svc.conn = conn
+
+	return svc, nil
+}
+
+func newStreamingService(cfg Config, storeKeys []types.StoreKey, client ABCIListenerServiceClient) *StreamingService {
+	// sort storeKeys for deterministic output
+	sort.SliceStable(storeKeys, func(i, j int) bool {
+		return storeKeys[i].Name() < storeKeys[j].Name()
+	})
+
+	listeners := make([]*types.MemoryListener, len(storeKeys))
+	for i, key := range storeKeys {
+		listeners[i] = types.NewMemoryListener(key)
+	}
+
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = DefaultTimeout
+	}
+
+	svc := &StreamingService{
+		storeListeners: listeners,
+		client:         client,
+		cfg:            cfg,
+	}
+
+	if cfg.Async {
+		bufferSize := cfg.BufferSize
+		if bufferSize <= 0 {
+			bufferSize = DefaultBufferSize
+		}
+
+		svc.queue = make(chan func(context.Context) error, bufferSize)
+		svc.done = make(chan struct{})
+	}
+
+	return svc
+}
+
+// Listeners satisfies the StreamingService interface. It returns the
+// StreamingService's underlying WriteListeners. Use for registering the
+// underlying WriteListeners with the BaseApp.
+func (gss *StreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	listeners := make(map[types.StoreKey][]types.WriteListener, len(gss.storeListeners))
+	for _, listener := range gss.storeListeners {
+		listeners[listener.StoreKey()] = []types.WriteListener{listener}
+	}
+
+	return listeners
+}
+
+// ListenBeginBlock satisfies the ABCIListener interface. It pushes the received
+// BeginBlock request and response to the consumer.
+func (gss *StreamingService) ListenBeginBlock(ctx context.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	gss.currentBlockNumber = req.Header.Height
+	msg := &ListenBeginBlockRequest{BlockHeight: gss.currentBlockNumber, Req: &req, Res: &res}
+
+	return gss.deliver(ctx, func(ctx context.Context) error {
+		_, err := gss.client.ListenBeginBlock(ctx, msg)
+		return err
+	})
+}
+
+// ListenDeliverTx satisfies the ABCIListener interface. It pushes the received
+// DeliverTx request and response to the consumer.
+func (gss *StreamingService) ListenDeliverTx(ctx context.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	msg := &ListenDeliverTxRequest{BlockHeight: gss.currentBlockNumber, Req: &req, Res: &res}
+
+	return gss.deliver(ctx, func(ctx context.Context) error {
+		_, err := gss.client.ListenDeliverTx(ctx, msg)
+		return err
+	})
+}
+
+// ListenEndBlock satisfies the ABCIListener interface. It pushes the received
+// EndBlock request and response to the consumer.
+func (gss *StreamingService) ListenEndBlock(ctx context.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	msg := &ListenEndBlockRequest{BlockHeight: gss.currentBlockNumber, Req: &req, Res: &res}
+
+	return gss.deliver(ctx, func(ctx context.Context) error {
+		_, err := gss.client.ListenEndBlock(ctx, msg)
+		return err
+	})
+}
+
+// ListenCommit satisfies the ABCIListener interface. It pushes the Commit
+// response together with all the state changes accumulated by the store
+// listeners during the block to the consumer.
+func (gss *StreamingService) ListenCommit(ctx context.Context, res abci.ResponseCommit) error {
+	var changeSet []*types.StoreKVPair
+	for _, listener := range gss.storeListeners {
+		cache := listener.PopStateCache()
+		for i := range cache {
+			changeSet = append(changeSet, &cache[i])
+		}
+	}
+
+	msg := &ListenCommitRequest{BlockHeight: gss.currentBlockNumber, Res: &res, ChangeSet: changeSet}
+	if err := gss.deliver(ctx, func(ctx context.Context) error {
+		_, err := gss.client.ListenCommit(ctx, msg)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	// surface any error from the asynchronous deliveries of this or previous
+	// blocks, once per block.
+	if gss.cfg.Async && gss.cfg.StopNodeOnErr {
+		return gss.popError()
+	}
+
+	return nil
+}
+
+// deliver sends the message directly in synchronous mode, or enqueues it in
+// asynchronous mode. A message that doesn't fit in the queue is dropped when
+// stopNodeOnErr is not set, so that the ABCI methods never block on a slow
+// consumer. Otherwise deliver waits up to the configured timeout for room in
+// the queue and returns ErrQueueFull, so that no message is silently lost.
+func (gss *StreamingService) deliver(ctx context.Context, send func(context.Context) error) error {
+	if gss.cfg.Async {
+		gss.mtx.Lock()
+		closed := gss.closed
+		gss.mtx.Unlock()
+
+		if closed {
+			return gss.handleErr(ErrServiceClosed)
+		}
+
+		select {
+		case gss.queue <- send:
+			return nil
+		default:
+		}
+
+		if !gss.cfg.StopNodeOnErr {
+			return nil
+		}
+
+		timer := time.NewTimer(gss.cfg.Timeout)
+		defer timer.Stop()
+
+		select {
+		case gss.queue <- send:
+			return nil
+		case <-timer.C:
+			return ErrQueueFull
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return gss.handleErr(gss.send(ctx, send))
+}
+
+// send executes a single RPC, bounded by the configured timeout.
+func (gss *StreamingService) send(ctx context.Context, send func(context.Context) error) error {
+	ctx, cancel := context.WithTimeout(ctx, gss.cfg.Timeout)
+	defer cancel()
+
+	return send(ctx)
+}
+
+// sendQueued executes a queued RPC and records its error.
+func (gss *StreamingService) sendQueued(send func(context.Context) error) {
+	if err := gss.send(context.Background(), send); err != nil {
+		gss.recordError(err)
+	}
+}
+
+// handleErr returns err when stopNodeOnErr is set and drops it otherwise.
+func (gss *StreamingService) handleErr(err error) error {
+	if err != nil && gss.cfg.StopNodeOnErr {
+		return err
+	}
+
+	return nil
+}
+
+func (gss *StreamingService) recordError(err error) {
+	gss.errMtx.Lock()
+	defer gss.errMtx.Unlock()
+
+	if gss.lastErr == nil {
+		gss.lastErr = err
+	}
+}
+
+func (gss *StreamingService) popError() error {
+	gss.errMtx.Lock()
+	defer gss.errMtx.Unlock()
+
+	err := gss.lastErr
+	gss.lastErr = nil
+
+	return err
+}
+
+// Stream satisfies the StreamingService interface. In asynchronous mode it
+// starts the background goroutine delivering the queued messages in order, it
+// performs a no-op otherwise.
+func (gss *StreamingService) Stream(wg *sync.WaitGroup) error {
+	if !gss.cfg.Async {
+		return nil
+	}
+
+	gss.mtx.Lock()
+	defer gss.mtx.Unlock()
+
+	if gss.streaming || gss.closed {
+		return nil
+	}
+	gss.streaming = true
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		for {
+			select {
+			case send := <-gss.queue:
+				gss.sendQueued(send)
+
+			case <-gss.done:
+				// deliver the pending messages, then release the connection.
+				gss.drainQueue()
+				gss.closeConn()
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+// Close satisfies the StreamingService interface. It stops accepting new
+// messages and closes the connection to the consumer. In asynchronous mode the
+// pending messages are delivered first, callers can wait on the WaitGroup
+// passed to Stream for the queue to be drained. If Stream was never called,
+// Close delivers the pending messages itself and, when stopNodeOnErr is set,
+// returns the first delivery error.
+func (gss *StreamingService) Close() error {
+	gss.mtx.Lock()
+	if gss.closed {
+		gss.mtx.Unlock()
+		return nil
+	}
+	gss.closed = true
+	streaming := gss.streaming
+	gss.mtx.Unlock()
+
+	if gss.queue == nil {
+		return gss.closeConn()
+	}
+
+	close(gss.done)
+	if streaming {
+		return nil
+	}
+
+	gss.drainQueue()
+	if err := gss.closeConn(); err != nil {
+		return err
+	}
+
+	return gss.handleErr(gss.popError())
+}
+
+// drainQueue delivers the queued messages until the queue is empty.
+func (gss *StreamingService) drainQueue() {
+	for {
+		select {
+		case send := <-gss.queue:
+			gss.sendQueued(send)
+		default:
+			return
+		}
+	}
+}
+
+func (gss *StreamingService) closeConn() error {
+	if gss.conn == nil {
+		return nil
+	}
+
+	return gss.conn.Close()
+}
@@ -0,0 +1,282 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	mockStoreKey1 = sdk.NewKVStoreKey("mockStore1")
+	mockStoreKey2 = sdk.NewKVStoreKey("mockStore2")
+
+	testBeginBlockReq = abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}}
+	testEndBlockReq   = abci.RequestEndBlock{Height: 1}
+	testDeliverTxReq  = abci.RequestDeliverTx{Tx: []byte{1, 2, 3}}
+	testDeliverTxRes  = abci.ResponseDeliverTx{Code: 1, Info: "mockInfo"}
+	testCommitRes     = abci.ResponseCommit{Data: []byte{1}}
+)
+
+// mockConsumer is an ABCIListenerService recording every received message.
+type mockConsumer struct {
+	mtx  sync.Mutex
+	fail bool
+
+	beginBlocks []*ListenBeginBlockRequest
+	deliverTxs  []*ListenDeliverTxRequest
+	endBlocks   []*ListenEndBlockRequest
+	commits     []*ListenCommitRequest
+}
+
+func (c *mockConsumer) ListenBeginBlock(_ context.Context, req *ListenBeginBlockRequest) (*ListenBeginBlockResponse, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if c.fail {
+		return nil, errors.New("consumer failure")
+	}
+	c.beginBlocks = append(c.beginBlocks, req)
+	return &ListenBeginBlockResponse{}, nil
+}
+
+func (c *mockConsumer) ListenDeliverTx(_ context.Context, req *ListenDeliverTxRequest) (*ListenDeliverTxResponse, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if c.fail {
+		return nil, errors.New("consumer failure")
+	}
+	c.deliverTxs = append(c.deliverTxs, req)
+	return &ListenDeliverTxResponse{}, nil
+}
+
+func (c *mockConsumer) ListenEndBlock(_ context.Context, req *ListenEndBlockRequest) (*ListenEndBlockResponse, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if c.fail {
+		return nil, errors.New("consumer failure")
+	}
+	c.endBlocks = append(c.endBlocks, req)
+	return &ListenEndBlockResponse{}, nil
+}
+
+func (c *mockConsumer) ListenCommit(_ context.Context, req *ListenCommitRequest) (*ListenCommitResponse, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if c.fail {
+		return nil, errors.New("consumer failure")
+	}
+	c.commits = append(c.commits, req)
+	return &ListenCommitResponse{}, nil
+}
+
+func startConsumer(t *testing.T) (*mockConsumer, string) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	consumer := &mockConsumer{}
+	srv := grpc.NewServer()
+	RegisterABCIListenerServiceServer(srv, consumer)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	return consumer, lis.Addr().String()
+}
+
+func streamBlock(t *testing.T, svc *StreamingService) {
+	ctx := context.Background()
+	listeners := svc.Listeners()
+
+	require.NoError(t, svc.ListenBeginBlock(ctx, testBeginBlockReq, abci.ResponseBeginBlock{}))
+	listeners[mockStoreKey1][0].OnWrite(mockStoreKey1, []byte("key1"), []byte("value1"), false)
+	listeners[mockStoreKey2][0].OnWrite(mockStoreKey2, []byte("key2"), nil, true)
+	require.NoError(t, svc.ListenDeliverTx(ctx, testDeliverTxReq, testDeliverTxRes))
+	require.NoError(t, svc.ListenEndBlock(ctx, testEndBlockReq, abci.ResponseEndBlock{}))
+	require.NoError(t, svc.ListenCommit(ctx, testCommitRes))
+}
+
+func requireBlockStreamed(t *testing.T, consumer *mockConsumer) {
+	consumer.mtx.Lock()
+	defer consumer.mtx.Unlock()
+
+	require.Len(t, consumer.beginBlocks, 1)
+	require.Equal(t, int64(1), consumer.beginBlocks[0].BlockHeight)
+	require.Len(t, consumer.deliverTxs, 1)
+	require.Equal(t, testDeliverTxReq.Tx, consumer.deliverTxs[0].Req.Tx)
+	require.Equal(t, testDeliverTxRes.Info, consumer.deliverTxs[0].Res.Info)
+	require.Len(t, consumer.endBlocks, 1)
+	require.Len(t, consumer.commits, 1)
+
+	commit := consumer.commits[0]
+	require.Equal(t, int64(1), commit.BlockHeight)
+	require.Equal(t, testCommitRes.Data, commit.Res.Data)
+	require.Equal(t, []*types.StoreKVPair{
+		{StoreKey: mockStoreKey1.Name(), Key: []byte("key1"), Value: []byte("value1")},
+		{StoreKey: mockStoreKey2.Name(), Delete: true, Key: []byte("key2")},
+	}, commit.ChangeSet)
+}
+
+func TestStreamingServiceSync(t *testing.T) {
+	consumer, addr := startConsumer(t)
+
+	svc, err := NewStreamingService(Config{Address: addr, Timeout: 5 * time.Second}, []types.StoreKey{mockStoreKey2, mockStoreKey1})
+	require.NoError(t, err)
+
+	wg := new(sync.WaitGroup)
+	require.NoError(t, svc.Stream(wg))
+
+	streamBlock(t, svc)
+	requireBlockStreamed(t, consumer)
+
+	require.NoError(t, svc.Close())
+	wg.Wait()
+}
+
+func TestStreamingServiceAsync(t *testing.T) {
+	consumer, addr := startConsumer(t)
+
+	svc, err := NewStreamingService(Config{Address: addr, Async: true, StopNodeOnErr: true}, []types.StoreKey{mockStoreKey1, mockStoreKey2})
+	require.NoError(t, err)
+
+	wg := new(sync.WaitGroup)
+	require.NoError(t, svc.Stream(wg))
+
+	streamBlock(t, svc)
+
+	// closing drains the pending deliveries
+	require.NoError(t, svc.Close())
+	wg.Wait()
+	requireBlockStreamed(t, consumer)
+
+	// the service no longer accepts messages once closed
+	require.ErrorIs(t, svc.ListenCommit(context.Background(), testCommitRes), ErrServiceClosed)
+}
+
+func TestStreamingServiceStopNodeOnErr(t *testing.T) {
+	consumer, addr := startConsumer(t)
+	consumer.fail = true
+	ctx := context.Background()
+
+	// errors are dropped unless stopNodeOnErr is set
+	svc, err := NewStreamingService(Config{Address: addr}, []types.StoreKey{mockStoreKey1})
+	require.NoError(t, err)
+	require.NoError(t, svc.ListenBeginBlock(ctx, testBeginBlockReq, abci.ResponseBeginBlock{}))
+	require.NoError(t, svc.Close())
+
+	svc, err = NewStreamingService(Config{Address: addr, StopNodeOnErr: true}, []types.StoreKey{mockStoreKey1})
+	require.NoError(t, err)
+	require.Error(t, svc.ListenBeginBlock(ctx, testBeginBlockReq, abci.ResponseBeginBlock{}))
+	require.NoError(t, svc.Close())
+
+	// asynchronous errors are surfaced on the next commit
+	svc, err = NewStreamingService(Config{Address: addr, Async: true, StopNodeOnErr: true}, []types.StoreKey{mockStoreKey1})
+	require.NoError(t, err)
+
+	wg := new(sync.WaitGroup)
+	require.NoError(t, svc.Stream(wg))
+	require.NoError(t, svc.ListenBeginBlock(ctx, testBeginBlockReq, abci.ResponseBeginBlock{}))
+	require.Eventually(t, func() bool {
+		return svc.ListenCommit(ctx, testCommitRes) != nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, svc.Close())
+	wg.Wait()
+}
+
+func TestStreamingServiceQueueFull(t *testing.T) {
+	consumer, addr := startConsumer(t)
+
+	svc, err := NewStreamingService(Config{Address: addr, Async: true, BufferSize: 1, StopNodeOnErr: true}, []types.StoreKey{mockStoreKey1, mockStoreKey2})
+	require.NoError(t, err)
+	require.Equal(t, DefaultTimeout, svc.cfg.Timeout)
+
+	// the hooks block on the full queue until the consumer goroutine starts,
+	// no message is lost
+	wg := new(sync.WaitGroup)
+	streamErr := make(chan error, 1)
+	time.AfterFunc(100*time.Millisecond, func() { streamErr <- svc.Stream(wg) })
+	streamBlock(t, svc)
+	require.NoError(t, <-streamErr)
+
+	require.NoError(t, svc.Close())
+	wg.Wait()
+	requireBlockStreamed(t, consumer)
+}
+
+func TestStreamingServiceQueueFullTimeout(t *testing.T) {
+	consumer, addr := startConsumer(t)
+	ctx := context.Background()
+
+	// without stopNodeOnErr the messages pushed to a full queue are dropped
+	svc, err := NewStreamingService(Config{Address: addr, Async: true, BufferSize: 1}, []types.StoreKey{mockStoreKey1})
+	require.NoError(t, err)
+	require.NoError(t, svc.ListenBeginBlock(ctx, testBeginBlockReq, abci.ResponseBeginBlock{}))
+	require.NoError(t, svc.ListenDeliverTx(ctx, testDeliverTxReq, testDeliverTxRes))
+	require.NoError(t, svc.ListenCommit(ctx, testCommitRes))
+
+	// with stopNodeOnErr the hook returns the error once the timeout expires
+	svc2, err := NewStreamingService(Config{Address: addr, Async: true, BufferSize: 1, Timeout: 50 * time.Millisecond, StopNodeOnErr: true}, []types.StoreKey{mockStoreKey1})
+	require.NoError(t, err)
+	require.NoError(t, svc2.ListenBeginBlock(ctx, testBeginBlockReq, abci.ResponseBeginBlock{}))
+	require.ErrorIs(t, svc2.ListenDeliverTx(ctx, testDeliverTxReq, testDeliverTxRes), ErrQueueFull)
+
+	// closing a full queue doesn't block and delivers the pending messages
+	for _, svc := range []*StreamingService{svc, svc2} {
+		wg := new(sync.WaitGroup)
+		require.NoError(t, svc.Stream(wg))
+		require.NoError(t, svc.Close())
+		wg.Wait()
+	}
+
+	consumer.mtx.Lock()
+	defer consumer.mtx.Unlock()
+	require.Len(t, consumer.beginBlocks, 2)
+	require.Empty(t, consumer.deliverTxs)
+	require.Empty(t, consumer.commits)
+}
+
+func TestStreamingServiceCloseWithoutStream(t *testing.T) {
+	consumer, addr := startConsumer(t)
+
+	// the messages queued before Stream is called are delivered by Close
+	svc, err := NewStreamingService(Config{Address: addr, Async: true, StopNodeOnErr: true}, []types.StoreKey{mockStoreKey1, mockStoreKey2})
+	require.NoError(t, err)
+	streamBlock(t, svc)
+	require.NoError(t, svc.Close())
+	requireBlockStreamed(t, consumer)
+
+	// Stream no longer starts once closed
+	wg := new(sync.WaitGroup)
+	require.NoError(t, svc.Stream(wg))
+	wg.Wait()
+
+	// their delivery errors are returned when stopNodeOnErr is set
+	consumer.mtx.Lock()
+	consumer.fail = true
+	consumer.mtx.Unlock()
+	ctx := context.Background()
+	for _, stopNodeOnErr := range []bool{false, true} {
+		svc, err = NewStreamingService(Config{Address: addr, Async: true, StopNodeOnErr: stopNodeOnErr}, []types.StoreKey{mockStoreKey1})
+		require.NoError(t, err)
+		require.NoError(t, svc.ListenBeginBlock(ctx, testBeginBlockReq, abci.ResponseBeginBlock{}))
+		if stopNodeOnErr {
+			require.Error(t, svc.Close())
+		} else {
+			require.NoError(t, svc.Close())
+		}
+	}
+}
+
+func TestNewStreamingServiceEmptyAddress(t *testing.T) {
+	_, err := NewStreamingService(Config{}, []types.StoreKey{mockStoreKey1})
+	require.Error(t, err)
+}
@@ -336,6 +336,10 @@ type Result struct {
 	//
 	// Since: cosmos-sdk 0.46
 	MsgResponses []*types.Any `protobuf:"bytes,4,rep,name=msg_responses,json=msgResponses,proto3" json:"msg_responses,omitempty"`
+	// sender is the sender of the transaction as reported to the mempool. It is
+	// only set in CheckTx, when the application is configured with a tx sender
+	// function.
+	Sender string `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
 func (m *Result) Reset()      { *m = Result{} }
@@ -637,64 +641,65 @@ func init() {
 }
 
 var fileDescriptor_4e37629bc7eb0df8 = []byte{
-	// 909 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0xda, 0xdb, 0x75, 0x3c, 0x8e, 0x29, 0x1a, 0x45, 0xe9, 0xa4, 0x80, 0x6d, 0xdc, 0x22,
-	0x59, 0x48, 0xac, 0xd5, 0xb4, 0x42, 0xb4, 0xa7, 0xd6, 0xe1, 0x2b, 0x52, 0xcb, 0x61, 0xe3, 0x0a,
-	0x89, 0x8b, 0x35, 0xb6, 0xa7, 0xe3, 0x55, 0xbd, 0x3b, 0xd6, 0xce, 0x6c, 0xb2, 0xb9, 0x71, 0x83,
-	0x23, 0x27, 0xce, 0x5c, 0xe1, 0x2f, 0xe9, 0x81, 0x43, 0x8e, 0x3d, 0x54, 0x01, 0x92, 0x1b, 0x7f,
-	0x05, 0x7a, 0x6f, 0xc6, 0x1f, 0x25, 0x75, 0xd5, 0x93, 0xdf, 0xfc, 0xde, 0x87, 0xdf, 0xfb, 0xbd,
-	0xdf, 0xce, 0x90, 0x5b, 0x63, 0xa5, 0x13, 0xa5, 0x7b, 0x23, 0xae, 0x45, 0x8f, 0x8f, 0xc6, 0x71,
-	0xef, 0xf8, 0xce, 0x48, 0x18, 0x7e, 0x07, 0x0f, 0xe1, 0x3c, 0x53, 0x46, 0x51, 0x66, 0x83, 0x42,
-	0x08, 0x0a, 0x11, 0x77, 0x41, 0x37, 0x77, 0xa4, 0x92, 0x0a, 0x83, 0x7a, 0x60, 0xd9, 0xf8, 0x9b,
-	0x1f, 0x18, 0x91, 0x4e, 0x44, 0x96, 0xc4, 0xa9, 0xb1, 0x35, 0xcd, 0xe9, 0x5c, 0x68, 0xe7, 0xdc,
-	0x93, 0x4a, 0xc9, 0x99, 0xe8, 0xe1, 0x69, 0x94, 0x3f, 0xeb, 0xf1, 0xf4, 0xd4, 0xba, 0x3a, 0x7f,
-	0x56, 0x08, 0x19, 0x14, 0x91, 0xd0, 0x73, 0x95, 0x6a, 0x41, 0x77, 0x49, 0x30, 0x15, 0xb1, 0x9c,
-	0x1a, 0xe6, 0xb5, 0xbd, 0x6e, 0x25, 0x72, 0x27, 0xda, 0x21, 0x81, 0x29, 0xa6, 0x5c, 0x4f, 0x59,
-	0xb9, 0xed, 0x75, 0x6b, 0x7d, 0x72, 0x71, 0xde, 0x0a, 0x06, 0xc5, 0xb7, 0x5c, 0x4f, 0x23, 0xe7,
-	0xa1, 0x1f, 0x92, 0xda, 0x58, 0x4d, 0x84, 0x9e, 0xf3, 0xb1, 0x60, 0x15, 0x08, 0x8b, 0x56, 0x00,
-	0xa5, 0xc4, 0x87, 0x03, 0xf3, 0xdb, 0x5e, 0xb7, 0x11, 0xa1, 0x0d, 0xd8, 0x84, 0x1b, 0xce, 0xae,
-	0x61, 0x30, 0xda, 0xf4, 0x06, 0xa9, 0x66, 0xfc, 0x64, 0x38, 0x53, 0x92, 0x05, 0x08, 0x07, 0x19,
-	0x3f, 0x79, 0xac, 0x24, 0x7d, 0x4a, 0xfc, 0x99, 0x92, 0x9a, 0x55, 0xdb, 0x95, 0x6e, 0x7d, 0xbf,
-	0x1b, 0x6e, 0x22, 0x28, 0x7c, 0xd4, 0x3f, 0x38, 0x7c, 0x22, 0xb4, 0xe6, 0x52, 0x3c, 0x56, 0xb2,
-	0x7f, 0xe3, 0xc5, 0x79, 0xab, 0xf4, 0xc7, 0x5f, 0xad, 0xeb, 0xaf, 0xe3, 0x3a, 0xc2, 0x72, 0xd0,
-	0x43, 0x9c, 0x3e, 0x53, 0x6c, 0xcb, 0xf6, 0x00, 0x36, 0xfd, 0x88, 0x10, 0xc9, 0xf5, 0xf0, 0x84,
-	0xa7, 0x46, 0x4c, 0x58, 0x0d, 0x99, 0xa8, 0x49, 0xae, 0xbf, 0x47, 0x80, 0xee, 0x91, 0x2d, 0x70,
-	0xe7, 0x5a, 0x4c, 0x18, 0x41, 0x67, 0x55, 0x72, 0xfd, 0x54, 0x8b, 0x09, 0xbd, 0x4d, 0xca, 0xa6,
-	0x60, 0xf5, 0xb6, 0xd7, 0xad, 0xef, 0xef, 0x84, 0x96, 0xf6, 0x70, 0x41, 0x7b, 0xf8, 0x28, 0x3d,
-	0x8d, 0xca, 0xa6, 0x00, 0xa6, 0x4c, 0x9c, 0x08, 0x6d, 0x78, 0x32, 0x67, 0xdb, 0x96, 0xa9, 0x25,
-	0x40, 0xef, 0x91, 0x40, 0x1c, 0x8b, 0xd4, 0x68, 0xd6, 0xc0, 0x51, 0x77, 0xc3, 0xd5, 0x6e, 0xed,
-	0xa4, 0x5f, 0x81, 0xbb, 0xef, 0xc3, 0x60, 0x91, 0x8b, 0x7d, 0xe0, 0xff, 0xfc, 0x5b, 0xab, 0xd4,
-	0xf9, 0xdd, 0x23, 0xef, 0xbd, 0x3e, 0x27, 0xfd, 0x94, 0xd4, 0x12, 0x2d, 0x87, 0x71, 0x3a, 0x11,
-	0x05, 0x6e, 0xb5, 0xd1, 0x6f, 0xfc, 0x7b, 0xde, 0x5a, 0x81, 0xd1, 0x56, 0xa2, 0xe5, 0x21, 0x58,
-	0xf4, 0x7d, 0x52, 0x01, 0xe2, 0x71, 0xc7, 0x11, 0x98, 0xf4, 0x68, 0xd9, 0x4c, 0x05, 0x9b, 0xf9,
-	0x64, 0x33, 0xef, 0x47, 0x26, 0x8b, 0x53, 0x69, 0x7b, 0xdb, 0x71, 0xa4, 0x6f, 0xaf, 0x81, 0x7a,
-	0xd5, 0xeb, 0x8f, 0xaf, 0xda, 0x5e, 0x27, 0x23, 0xf5, 0x35, 0x2f, 0x2c, 0x02, 0x34, 0x8b, 0x2d,
-	0xd6, 0x22, 0xb4, 0xe9, 0x21, 0x21, 0xdc, 0x98, 0x2c, 0x1e, 0xe5, 0x46, 0x68, 0x56, 0xc6, 0x0e,
-	0x6e, 0xbd, 0x65, 0xf3, 0x8b, 0x58, 0xc7, 0xcd, 0x5a, 0xb2, 0xfb, 0xcf, 0xbb, 0xa4, 0xb6, 0x0c,
-	0x82, 0x69, 0x9f, 0x8b, 0x53, 0xf7, 0x87, 0x60, 0xd2, 0x1d, 0x72, 0xed, 0x98, 0xcf, 0x72, 0xe1,
-	0x18, 0xb0, 0x87, 0xce, 0x01, 0xa9, 0x7e, 0xc3, 0xf5, 0xe1, 0x55, 0x65, 0x40, 0xa6, 0xbf, 0x49,
-	0x19, 0x65, 0x74, 0x2e, 0x94, 0x01, 0x9b, 0x09, 0x22, 0xa1, 0xf3, 0x99, 0xa1, 0xbb, 0x4e, 0xf6,
-	0x90, 0xbe, 0xdd, 0x2f, 0x33, 0xcf, 0x49, 0xff, 0x2a, 0xfb, 0xf7, 0xfe, 0xc7, 0xfe, 0x3b, 0x49,
-	0x81, 0xde, 0x27, 0x0d, 0x58, 0x6e, 0xe6, 0x3e, 0x6a, 0xcd, 0x7c, 0x4c, 0x7e, 0xb3, 0x1e, 0xb7,
-	0x13, 0x2d, 0x17, 0x9f, 0xff, 0x42, 0x45, 0xbf, 0x7a, 0x84, 0x1e, 0xc5, 0x49, 0x3e, 0xe3, 0x26,
-	0x56, 0xe9, 0xf2, 0x72, 0xf8, 0xda, 0x4e, 0x87, 0x9f, 0x8b, 0x87, 0x12, 0xff, 0x78, 0xf3, 0x2e,
-	0x1c, 0x63, 0xfd, 0x2d, 0x68, 0xed, 0xec, 0xbc, 0xe5, 0x21, 0x15, 0x48, 0xe2, 0x17, 0x24, 0xc8,
-	0x90, 0x09, 0x1c, 0xb5, 0xbe, 0xdf, 0xde, 0x5c, 0xc5, 0x32, 0x16, 0xb9, 0xf8, 0xce, 0x43, 0x52,
-	0x7d, 0xa2, 0xe5, 0x97, 0x40, 0xd6, 0x1e, 0x01, 0xd9, 0x0e, 0xd7, 0x24, 0x53, 0x4d, 0xb4, 0x1c,
-	0x80, 0x6a, 0x16, 0xd7, 0x0a, 0x54, 0xdf, 0xb6, 0xdc, 0x3e, 0x08, 0x60, 0xfd, 0xcc, 0xeb, 0xfc,
-	0xe4, 0x91, 0xda, 0xa0, 0x58, 0x14, 0xb9, 0xbf, 0xdc, 0x44, 0xe5, 0xed, 0xd3, 0xb8, 0x84, 0xb5,
-	0x65, 0x5d, 0x21, 0xb9, 0xfc, 0xee, 0x24, 0xa3, 0x14, 0x5f, 0x79, 0xe4, 0xfa, 0x91, 0xe0, 0xd9,
-	0x78, 0x3a, 0x28, 0xb4, 0x53, 0x46, 0x8b, 0xd4, 0x8d, 0x32, 0x7c, 0x36, 0x1c, 0xab, 0x3c, 0x35,
-	0x4e, 0x5f, 0x04, 0xa1, 0x03, 0x40, 0x40, 0xa0, 0xd6, 0x65, 0xd5, 0x65, 0x0f, 0x90, 0x36, 0xe7,
-	0x52, 0x0c, 0xd3, 0x3c, 0x19, 0x89, 0x0c, 0xef, 0x5e, 0x3f, 0x22, 0x00, 0x7d, 0x87, 0x08, 0xc8,
-	0x16, 0x03, 0xb0, 0x12, 0x5e, 0xc1, 0x7e, 0x54, 0x03, 0x64, 0x00, 0x00, 0x54, 0x9d, 0xc5, 0x49,
-	0x6c, 0xf0, 0x22, 0xf6, 0x23, 0x7b, 0xa0, 0x9f, 0x93, 0x8a, 0x29, 0x34, 0x0b, 0x70, 0xae, 0xdb,
-	0x9b, 0xb9, 0x59, 0x3d, 0x1f, 0x11, 0x24, 0xd8, 0xf1, 0xfa, 0x0f, 0x5f, 0xfe, 0xd3, 0x2c, 0xbd,
-	0xb8, 0x68, 0x7a, 0x67, 0x17, 0x4d, 0xef, 0xef, 0x8b, 0xa6, 0xf7, 0xcb, 0x65, 0xb3, 0x74, 0x76,
-	0xd9, 0x2c, 0xbd, 0xbc, 0x6c, 0x96, 0x7e, 0xe8, 0xc8, 0xd8, 0x4c, 0xf3, 0x51, 0x38, 0x56, 0x49,
-	0xcf, 0x3d, 0x87, 0xf6, 0xe7, 0x33, 0x3d, 0x79, 0x6e, 0xdf, 0xae, 0x51, 0x80, 0x14, 0xde, 0xfd,
-	0x2f, 0x00, 0x00, 0xff, 0xff, 0xa7, 0xf1, 0x8e, 0x98, 0x30, 0x07, 0x00, 0x00,
+	// 918 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x3d, 0x6f, 0x1b, 0x47,
+	0x10, 0xe5, 0x92, 0xe7, 0xa3, 0x38, 0x14, 0xe3, 0x60, 0x21, 0xc8, 0x2b, 0x27, 0x21, 0x19, 0xda,
+	0x01, 0x88, 0x00, 0x39, 0xc2, 0xb2, 0x11, 0xc4, 0xae, 0x6c, 0x2a, 0x5f, 0x02, 0xec, 0x14, 0x27,
+	0x1a, 0x01, 0xd2, 0x10, 0x4b, 0x72, 0xbd, 0x3c, 0x98, 0x77, 0x4b, 0xdc, 0x2e, 0x25, 0xb2, 0x4b,
+	0x97, 0x94, 0xa9, 0x52, 0xa7, 0x4d, 0xfe, 0x45, 0x3a, 0x17, 0x29, 0x54, 0xba, 0x30, 0x94, 0x44,
+	0xea, 0xf2, 0x2b, 0x82, 0x9d, 0x5d, 0x8a, 0xb4, 0x05, 0x1a, 0xae, 0x6e, 0xe6, 0xcd, 0xec, 0xdc,
+	0xcc, 0x9b, 0xb7, 0x77, 0x70, 0x6b, 0xa8, 0x74, 0xaa, 0x74, 0x67, 0xc0, 0xb5, 0xe8, 0xf0, 0xc1,
+	0x30, 0xe9, 0x1c, 0xdf, 0x19, 0x08, 0xc3, 0xef, 0xa0, 0x13, 0x4d, 0x73, 0x65, 0x14, 0x65, 0x2e,
+	0x29, 0xb2, 0x49, 0x11, 0xe2, 0x3e, 0xe9, 0xe6, 0x8e, 0x54, 0x52, 0x61, 0x52, 0xc7, 0x5a, 0x2e,
+	0xff, 0xe6, 0x07, 0x46, 0x64, 0x23, 0x91, 0xa7, 0x49, 0x66, 0x5c, 0x4d, 0xb3, 0x98, 0x0a, 0xed,
+	0x83, 0x7b, 0x52, 0x29, 0x39, 0x11, 0x1d, 0xf4, 0x06, 0xb3, 0x67, 0x1d, 0x9e, 0x2d, 0x5c, 0xa8,
+	0xf5, 0x57, 0x09, 0xa0, 0x37, 0x8f, 0x85, 0x9e, 0xaa, 0x4c, 0x0b, 0xba, 0x0b, 0xe1, 0x58, 0x24,
+	0x72, 0x6c, 0x18, 0x69, 0x92, 0x76, 0x29, 0xf6, 0x1e, 0x6d, 0x41, 0x68, 0xe6, 0x63, 0xae, 0xc7,
+	0xac, 0xd8, 0x24, 0xed, 0x4a, 0x17, 0xce, 0xcf, 0x1a, 0x61, 0x6f, 0xfe, 0x2d, 0xd7, 0xe3, 0xd8,
+	0x47, 0xe8, 0x87, 0x50, 0x19, 0xaa, 0x91, 0xd0, 0x53, 0x3e, 0x14, 0xac, 0x64, 0xd3, 0xe2, 0x15,
+	0x40, 0x29, 0x04, 0xd6, 0x61, 0x41, 0x93, 0xb4, 0x6b, 0x31, 0xda, 0x16, 0x1b, 0x71, 0xc3, 0xd9,
+	0x35, 0x4c, 0x46, 0x9b, 0xde, 0x80, 0x72, 0xce, 0x4f, 0xfa, 0x13, 0x25, 0x59, 0x88, 0x70, 0x98,
+	0xf3, 0x93, 0xc7, 0x4a, 0xd2, 0xa7, 0x10, 0x4c, 0x94, 0xd4, 0xac, 0xdc, 0x2c, 0xb5, 0xab, 0xfb,
+	0xed, 0x68, 0x13, 0x41, 0xd1, 0xa3, 0xee, 0xc1, 0xe1, 0x13, 0xa1, 0x35, 0x97, 0xe2, 0xb1, 0x92,
+	0xdd, 0x1b, 0x2f, 0xce, 0x1a, 0x85, 0x3f, 0xfe, 0x6e, 0x5c, 0x7f, 0x1d, 0xd7, 0x31, 0x96, 0xb3,
+	0x3d, 0x24, 0xd9, 0x33, 0xc5, 0xb6, 0x5c, 0x0f, 0xd6, 0xa6, 0x1f, 0x01, 0x48, 0xae, 0xfb, 0x27,
+	0x3c, 0x33, 0x62, 0xc4, 0x2a, 0xc8, 0x44, 0x45, 0x72, 0xfd, 0x3d, 0x02, 0x74, 0x0f, 0xb6, 0x6c,
+	0x78, 0xa6, 0xc5, 0x88, 0x01, 0x06, 0xcb, 0x92, 0xeb, 0xa7, 0x5a, 0x8c, 0xe8, 0x6d, 0x28, 0x9a,
+	0x39, 0xab, 0x36, 0x49, 0xbb, 0xba, 0xbf, 0x13, 0x39, 0xda, 0xa3, 0x25, 0xed, 0xd1, 0xa3, 0x6c,
+	0x11, 0x17, 0xcd, 0xdc, 0x32, 0x65, 0x92, 0x54, 0x68, 0xc3, 0xd3, 0x29, 0xdb, 0x76, 0x4c, 0x5d,
+	0x02, 0xf4, 0x1e, 0x84, 0xe2, 0x58, 0x64, 0x46, 0xb3, 0x1a, 0x8e, 0xba, 0x1b, 0xad, 0x76, 0xeb,
+	0x26, 0xfd, 0xca, 0x86, 0xbb, 0x81, 0x1d, 0x2c, 0xf6, 0xb9, 0x0f, 0x82, 0x9f, 0x7f, 0x6b, 0x14,
+	0x5a, 0xbf, 0x13, 0x78, 0xef, 0xf5, 0x39, 0xe9, 0xa7, 0x50, 0x49, 0xb5, 0xec, 0x27, 0xd9, 0x48,
+	0xcc, 0x71, 0xab, 0xb5, 0x6e, 0xed, 0xbf, 0xb3, 0xc6, 0x0a, 0x8c, 0xb7, 0x52, 0x2d, 0x0f, 0xad,
+	0x45, 0xdf, 0x87, 0x92, 0x25, 0x1e, 0x77, 0x1c, 0x5b, 0x93, 0x1e, 0x5d, 0x36, 0x53, 0xc2, 0x66,
+	0x3e, 0xd9, 0xcc, 0xfb, 0x91, 0xc9, 0x93, 0x4c, 0xba, 0xde, 0x76, 0x3c, 0xe9, 0xdb, 0x6b, 0xa0,
+	0x5e, 0xf5, 0xfa, 0xe3, 0xab, 0x26, 0x69, 0xe5, 0x50, 0x5d, 0x8b, 0xda, 0x45, 0x58, 0xcd, 0x62,
+	0x8b, 0x95, 0x18, 0x6d, 0x7a, 0x08, 0xc0, 0x8d, 0xc9, 0x93, 0xc1, 0xcc, 0x08, 0xcd, 0x8a, 0xd8,
+	0xc1, 0xad, 0xb7, 0x6c, 0x7e, 0x99, 0xeb, 0xb9, 0x59, 0x3b, 0xec, 0xdf, 0x79, 0x17, 0x2a, 0x97,
+	0x49, 0x76, 0xda, 0xe7, 0x62, 0xe1, 0x5f, 0x68, 0x4d, 0xba, 0x03, 0xd7, 0x8e, 0xf9, 0x64, 0x26,
+	0x3c, 0x03, 0xce, 0x69, 0x1d, 0x40, 0xf9, 0x1b, 0xae, 0x0f, 0xaf, 0x2a, 0xc3, 0x9e, 0x0c, 0x36,
+	0x29, 0xa3, 0x88, 0xc1, 0xa5, 0x32, 0x5a, 0x7f, 0x12, 0x08, 0x63, 0xa1, 0x67, 0x13, 0x43, 0x77,
+	0xbd, 0xec, 0xed, 0xf1, 0xed, 0x6e, 0x91, 0x11, 0x2f, 0xfd, 0xab, 0xec, 0xdf, 0x7b, 0x83, 0xfd,
+	0x77, 0x92, 0x02, 0xbd, 0x0f, 0x35, 0xbb, 0xdc, 0xdc, 0x5f, 0x6a, 0xcd, 0x82, 0x66, 0x69, 0xa3,
+	0x1e, 0xb7, 0x53, 0x2d, 0x97, 0xd7, 0x5f, 0xdb, 0xfb, 0xaf, 0xf1, 0x0d, 0xfe, 0x4e, 0x7a, 0xcf,
+	0xab, 0xeb, 0x57, 0x02, 0xf4, 0x28, 0x49, 0x67, 0x13, 0x6e, 0x12, 0x95, 0x2d, 0x4f, 0xd1, 0xaf,
+	0xdd, 0xd4, 0x78, 0x8d, 0x08, 0x4a, 0xff, 0xe3, 0xcd, 0x3b, 0xf2, 0x4c, 0x76, 0xb7, 0x6c, 0xcb,
+	0xa7, 0x67, 0x0d, 0x82, 0x14, 0x21, 0xb9, 0x5f, 0x40, 0x98, 0x23, 0x43, 0x48, 0x41, 0x75, 0xbf,
+	0xb9, 0xb9, 0x8a, 0x63, 0x32, 0xf6, 0xf9, 0xad, 0x87, 0x50, 0x7e, 0xa2, 0xe5, 0x97, 0x96, 0xc4,
+	0x3d, 0xb0, 0x72, 0xee, 0xaf, 0x49, 0xa9, 0x9c, 0x6a, 0xd9, 0x5b, 0x4c, 0x57, 0x9f, 0x1b, 0x5b,
+	0x7d, 0xdb, 0x71, 0xfe, 0x20, 0xb4, 0xb2, 0x60, 0xa4, 0xf5, 0x13, 0x81, 0x4a, 0x6f, 0xbe, 0x2c,
+	0x72, 0xff, 0x72, 0x43, 0xa5, 0xb7, 0x4f, 0xe3, 0x0f, 0xac, 0x2d, 0xf1, 0x0a, 0xf9, 0xc5, 0x77,
+	0x25, 0xdf, 0x4b, 0xf4, 0x15, 0x81, 0xeb, 0x47, 0x82, 0xe7, 0xc3, 0x71, 0x6f, 0xae, 0xbd, 0x62,
+	0x1a, 0x50, 0x35, 0xca, 0xf0, 0x49, 0x7f, 0xa8, 0x66, 0x99, 0xf1, 0xba, 0x03, 0x84, 0x0e, 0x2c,
+	0x62, 0x85, 0xeb, 0x42, 0x4e, 0x75, 0xce, 0xb1, 0xc7, 0xa6, 0x5c, 0x8a, 0x7e, 0x36, 0x4b, 0x07,
+	0x22, 0xc7, 0x6f, 0x72, 0x10, 0x83, 0x85, 0xbe, 0x43, 0xc4, 0xca, 0x19, 0x13, 0xb0, 0x12, 0x7e,
+	0x9a, 0x83, 0xb8, 0x62, 0x91, 0x9e, 0x05, 0x6c, 0xd5, 0x49, 0x92, 0x26, 0x06, 0xc5, 0x10, 0xc4,
+	0xce, 0xa1, 0x9f, 0x43, 0xc9, 0xcc, 0x35, 0x0b, 0x71, 0xae, 0xdb, 0x9b, 0xb9, 0x59, 0xfd, 0x56,
+	0x62, 0x7b, 0xc0, 0x8d, 0xd7, 0x7d, 0xf8, 0xf2, 0xdf, 0x7a, 0xe1, 0xc5, 0x79, 0x9d, 0x9c, 0x9e,
+	0xd7, 0xc9, 0x3f, 0xe7, 0x75, 0xf2, 0xcb, 0x45, 0xbd, 0x70, 0x7a, 0x51, 0x2f, 0xbc, 0xbc, 0xa8,
+	0x17, 0x7e, 0x68, 0xc9, 0xc4, 0x8c, 0x67, 0x83, 0x68, 0xa8, 0xd2, 0x8e, 0xff, 0x4d, 0xba, 0xc7,
+	0x67, 0x7a, 0xf4, 0xdc, 0xfd, 0xd3, 0x06, 0x21, 0x52, 0x78, 0xf7, 0xff, 0x01, 0x00, 0x44, 0xaf,
+	0x60, 0x6a, 0x48, 0x07, 0x00, 0x00,
 }
 
 func (m *TxResponse) Marshal() (dAtA []byte, err error) {
@@ -1005,6 +1010,13 @@ func (m *Result) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintAbci(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.MsgResponses) > 0 {
 		for iNdEx := len(m.MsgResponses) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1416,6 +1428,10 @@ func (m *Result) Size() (n int) {
 			n += 1 + l + sovAbci(uint64(l))
 		}
 	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovAbci(uint64(l))
+	}
 	return n
 }
 
@@ -2639,6 +2655,38 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAbci
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAbci(dAtA[iNdEx:])
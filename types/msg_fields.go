@@ -0,0 +1,148 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MsgFieldValues returns the values of the field at the given dot separated path
+// (e.g. `amount.denom`) of the JSON representation of the Msg. The values of all
+// elements are returned for repeated fields. Only scalar (string, number or
+// boolean) fields are supported.
+func MsgFieldValues(msg Msg, path string) ([]string, error) {
+	bz, err := codec.ProtoMarshalJSON(msg, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return jsonFieldValues(bz, path)
+}
+
+// jsonFieldValues returns the values of the field at the given path of the
+// JSON representation of a Msg.
+func jsonFieldValues(bz []byte, path string) ([]string, error) {
+	var fields interface{}
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.UseNumber()
+	if err := decoder.Decode(&fields); err != nil {
+		return nil, err
+	}
+
+	return fieldValues(fields, strings.Split(path, "."), path)
+}
+
+// fieldValues returns the values of the field at the given path of a decoded
+// JSON value.
+func fieldValues(value interface{}, names []string, path string) ([]string, error) {
+	switch value := value.(type) {
+	case []interface{}:
+		var values []string
+		for _, element := range value {
+			elementValues, err := fieldValues(element, names, path)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, elementValues...)
+		}
+		return values, nil
+	case map[string]interface{}:
+		if len(names) == 0 {
+			return nil, fmt.Errorf("field %s is not a scalar", path)
+		}
+		field, ok := value[names[0]]
+		if !ok {
+			return nil, fmt.Errorf("field %s not found", path)
+		}
+		return fieldValues(field, names[1:], path)
+	case string, json.Number, bool:
+		if len(names) > 0 {
+			return nil, fmt.Errorf("field %s not found", path)
+		}
+		return []string{fmt.Sprint(value)}, nil
+	default:
+		return nil, fmt.Errorf("field %s is not a scalar", path)
+	}
+}
+
+const (
+	// msgFieldRestrictionGasCost is the gas consumed for every allowed value a
+	// field value is compared with.
+	msgFieldRestrictionGasCost = uint64(10)
+
+	// msgFieldRestrictionGasCostPerByte is the gas consumed for every byte of
+	// the JSON representation of the Msg, which is decoded for every check.
+	msgFieldRestrictionGasCostPerByte = uint64(1)
+)
+
+// ValidateBasic checks the restriction has a field and allowed values.
+func (r MsgFieldRestriction) ValidateBasic() error {
+	if r.Field == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("restricted field cannot be empty")
+	}
+	if len(r.AllowedValues) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("allowed values of field %s cannot be empty", r.Field)
+	}
+	return nil
+}
+
+// Check returns an error if a value of the restricted field of msg is not one
+// of the allowed values, or if the field has no value, e.g. an empty repeated
+// field. Gas is consumed for every byte of the marshalled msg, before it is
+// decoded, and for every allowed value compared.
+func (r MsgFieldRestriction) Check(ctx Context, msg Msg) error {
+	bz, err := codec.ProtoMarshalJSON(msg, nil)
+	if err != nil {
+		return err
+	}
+	ctx.GasMeter().ConsumeGas(uint64(len(bz))*msgFieldRestrictionGasCostPerByte, "msg field restriction")
+
+	values, err := jsonFieldValues(bz, r.Field)
+	if err != nil {
+		return err
+	}
+	if len(values) == 0 {
+		return fmt.Errorf("field %s has no value", r.Field)
+	}
+
+	for _, value := range values {
+		if !r.allows(ctx, value) {
+			return fmt.Errorf("value %s is not allowed for field %s", value, r.Field)
+		}
+	}
+	return nil
+}
+
+// allows returns whether the given value is one of the allowed values.
+func (r MsgFieldRestriction) allows(ctx Context, value string) bool {
+	for _, allowed := range r.AllowedValues {
+		ctx.GasMeter().ConsumeGas(msgFieldRestrictionGasCost, "msg field restriction")
+		if allowed == value {
+			return true
+		}
+	}
+	return false
+}
+
+// IsSubsetOf returns true if the restriction applies to the same field as the
+// parent restriction and allows none of the values the parent doesn't.
+func (r MsgFieldRestriction) IsSubsetOf(parent MsgFieldRestriction) bool {
+	if r.Field != parent.Field {
+		return false
+	}
+
+	allowed := make(map[string]bool, len(parent.AllowedValues))
+	for _, value := range parent.AllowedValues {
+		allowed[value] = true
+	}
+	for _, value := range r.AllowedValues {
+		if !allowed[value] {
+			return false
+		}
+	}
+	return true
+}
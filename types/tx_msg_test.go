@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -43,3 +44,26 @@ func (s *testMsgSuite) TestGetMsgFromTypeURL() {
 	s.Require().NoError(err)
 	s.Require().Equal(msg, result)
 }
+
+func (s *testMsgSuite) TestMsgFieldValues() {
+	msg := &testdata.TestMsg{Signers: []string{"a", "b"}}
+	values, err := sdk.MsgFieldValues(msg, "signers")
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"a", "b"}, values)
+
+	_, err = sdk.MsgFieldValues(msg, "signers.address")
+	s.Require().Error(err)
+	_, err = sdk.MsgFieldValues(msg, "recipient")
+	s.Require().Error(err)
+}
+
+func (s *testMsgSuite) TestMsgFieldRestrictionCheck() {
+	ctx := testutil.DefaultContext(sdk.NewKVStoreKey("test"), sdk.NewTransientStoreKey("transient_test"))
+	restriction := sdk.MsgFieldRestriction{Field: "signers", AllowedValues: []string{"a", "b"}}
+
+	s.Require().NoError(restriction.Check(ctx, &testdata.TestMsg{Signers: []string{"a", "b"}}))
+	s.Require().Error(restriction.Check(ctx, &testdata.TestMsg{Signers: []string{"a", "c"}}))
+
+	// an empty repeated field has no value to check and is rejected
+	s.Require().ErrorContains(restriction.Check(ctx, &testdata.TestMsg{}), "field signers has no value")
+}
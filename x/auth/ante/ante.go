@@ -17,6 +17,8 @@ type HandlerOptions struct {
 	SignModeHandler        authsigning.SignModeHandler
 	SigGasConsumer         func(meter sdk.GasMeter, sig signing.SignatureV2, params types.Params) error
 	TxFeeChecker           TxFeeChecker
+	TxPriority             TxPriorityFn
+	SigVerificationCache   *SigVerificationCache
 }
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
@@ -46,8 +48,9 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
 		NewValidateSigCountDecorator(options.AccountKeeper),
 		NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
-		NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
+		NewSigVerificationDecoratorWithCache(options.AccountKeeper, options.SignModeHandler, options.SigVerificationCache),
 		NewIncrementSequenceDecorator(options.AccountKeeper),
+		NewTxPriorityDecorator(options.TxPriority), // TxPriorityDecorator must be called after signature verification
 	}
 
 	return sdk.ChainAnteDecorators(anteDecorators...), nil
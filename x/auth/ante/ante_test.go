@@ -1146,3 +1146,44 @@ func (suite *AnteTestSuite) TestAnteHandlerReCheck() {
 	_, err = suite.anteHandler(suite.ctx, tx, false)
 	suite.Require().NotNil(err, "antehandler on recheck did not fail once feePayer no longer has sufficient funds")
 }
+
+func (suite *AnteTestSuite) TestAnteHandlerTxPriorityAfterSigVerification() {
+	suite.SetupTest(true) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	var prioritized []sdk.Tx
+	anteHandler, err := ante.NewAnteHandler(
+		ante.HandlerOptions{
+			AccountKeeper:   suite.app.AccountKeeper,
+			BankKeeper:      suite.app.BankKeeper,
+			SignModeHandler: suite.clientCtx.TxConfig.SignModeHandler(),
+			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+			TxPriority: func(_ sdk.Context, tx sdk.Tx) int64 {
+				prioritized = append(prioritized, tx)
+				return 1
+			},
+		},
+	)
+	suite.Require().NoError(err)
+
+	accounts := suite.CreateTestAccounts(2)
+	suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(accounts[0].acc.GetAddress())))
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	// a tx signed by another key than its signer's never reaches the
+	// TxPriorityFn, so it can't use up the signer's quota
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{accounts[1].priv}, []uint64{0}, []uint64{0}
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+	_, err = anteHandler(suite.ctx, tx, false)
+	suite.Require().Error(err)
+	suite.Require().Empty(prioritized)
+
+	privs = []cryptotypes.PrivKey{accounts[0].priv}
+	tx, err = suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+	newCtx, err := anteHandler(suite.ctx, tx, false)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]sdk.Tx{tx}, prioritized)
+	suite.Require().Equal(int64(1), newCtx.Priority())
+}
@@ -0,0 +1,240 @@
+package ante
+
+import (
+	"math"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Type URLs of the governance vote messages, used by the default vote lane.
+const (
+	MsgVoteV1beta1TypeURL         = "/cosmos.gov.v1beta1.MsgVote"
+	MsgVoteWeightedV1beta1TypeURL = "/cosmos.gov.v1beta1.MsgVoteWeighted"
+	MsgVoteV1TypeURL              = "/cosmos.gov.v1.MsgVote"
+	MsgVoteWeightedV1TypeURL      = "/cosmos.gov.v1.MsgVoteWeighted"
+)
+
+// TxPriorityFn computes the mempool priority of a transaction during CheckTx.
+// The returned value is set on the context and returned in ResponseCheckTx,
+// it is never used in DeliverTx and therefore does not need to be
+// deterministic across nodes.
+type TxPriorityFn func(ctx sdk.Context, tx sdk.Tx) int64
+
+// TxPriorityDecorator overrides the priority set by the TxFeeChecker with the
+// one computed by a TxPriorityFn. It is a no-op when the function is nil, in
+// DeliverTx and in simulation mode. It must run after the signature
+// verification, so that a stateful TxPriorityFn only sees the transactions
+// authorized by their signers.
+// CONTRACT: Tx must implement FeeTx interface to use TxPriorityDecorator
+type TxPriorityDecorator struct {
+	priorityFn TxPriorityFn
+}
+
+func NewTxPriorityDecorator(fn TxPriorityFn) TxPriorityDecorator {
+	return TxPriorityDecorator{
+		priorityFn: fn,
+	}
+}
+
+func (tpd TxPriorityDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if tpd.priorityFn == nil || simulate || !ctx.IsCheckTx() {
+		return next(ctx, tx, simulate)
+	}
+
+	if _, ok := tx.(sdk.FeeTx); !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	return next(ctx.WithPriority(tpd.priorityFn(ctx, tx)), tx, simulate)
+}
+
+// NewFeePerGasPriorityFn returns a TxPriorityFn that prioritizes transactions by
+// the value of their fee per unit of gas. Fee coins of every denomination are
+// converted into a common unit using denomWeights, which holds the value of a
+// single unit of each accepted denom, so that fees paid in several denoms are
+// summed rather than compared by their smallest component. Fee coins whose
+// denom has no weight do not contribute to the priority.
+//
+// Fees paid through a fee grant count like any other fee. Tips are not taken
+// into account, as they are paid to the fee payer and not to the validators.
+func NewFeePerGasPriorityFn(denomWeights sdk.DecCoins) TxPriorityFn {
+	return func(ctx sdk.Context, tx sdk.Tx) int64 {
+		feeTx, ok := tx.(sdk.FeeTx)
+		if !ok || feeTx.GetGas() == 0 {
+			return 0
+		}
+
+		value := sdk.ZeroDec()
+		for _, c := range feeTx.GetFee() {
+			weight := denomWeights.AmountOf(c.Denom)
+			if !weight.IsPositive() {
+				continue
+			}
+			value = value.Add(weight.MulInt(c.Amount))
+		}
+
+		perGas := value.QuoInt64(int64(feeTx.GetGas())).TruncateInt()
+		if !perGas.IsInt64() {
+			return math.MaxInt64
+		}
+
+		return perGas.Int64()
+	}
+}
+
+// MsgLane groups Msg types that are given a fixed priority boost over the
+// regular fee based priority.
+type MsgLane struct {
+	// Name identifies the lane, it is only used for logging and debugging.
+	Name string
+	// MsgTypeURLs is the set of Msg type URLs admitted in the lane.
+	MsgTypeURLs []string
+	// Priority is added to the base priority of the txs in the lane.
+	Priority int64
+}
+
+// NewVoteLane returns a MsgLane for governance votes, extended with the given
+// Msg type URLs, e.g. the ones of the oracle vote messages of the chain.
+func NewVoteLane(priority int64, extraTypeURLs ...string) MsgLane {
+	typeURLs := []string{
+		MsgVoteV1beta1TypeURL,
+		MsgVoteWeightedV1beta1TypeURL,
+		MsgVoteV1TypeURL,
+		MsgVoteWeightedV1TypeURL,
+	}
+
+	return MsgLane{
+		Name:        "vote",
+		MsgTypeURLs: append(typeURLs, extraTypeURLs...),
+		Priority:    priority,
+	}
+}
+
+// NewMsgLanePriorityFn returns a TxPriorityFn that adds the priority of the
+// first lane, in the given order, that admits every Msg of a transaction to
+// the priority computed by base. A tx that mixes Msgs of several lanes, or
+// that contains a Msg outside of any lane, only gets the base priority so that
+// lanes cannot be abused to boost arbitrary messages.
+func NewMsgLanePriorityFn(base TxPriorityFn, lanes ...MsgLane) TxPriorityFn {
+	laneSets := make([]map[string]struct{}, len(lanes))
+	for i, lane := range lanes {
+		laneSets[i] = make(map[string]struct{}, len(lane.MsgTypeURLs))
+		for _, typeURL := range lane.MsgTypeURLs {
+			laneSets[i][typeURL] = struct{}{}
+		}
+	}
+
+	return func(ctx sdk.Context, tx sdk.Tx) int64 {
+		priority := base(ctx, tx)
+
+		msgs := tx.GetMsgs()
+		if len(msgs) == 0 {
+			return priority
+		}
+
+		for i, set := range laneSets {
+			admitted := true
+			for _, msg := range msgs {
+				if _, ok := set[sdk.MsgTypeURL(msg)]; !ok {
+					admitted = false
+					break
+				}
+			}
+
+			if admitted {
+				return addPriority(priority, lanes[i].Priority)
+			}
+		}
+
+		return priority
+	}
+}
+
+// DefaultTxSender returns the first signer of the first message of a
+// transaction, that is the account whose sequence the transaction uses. It can
+// be set as the baseapp.TxSenderFn reporting the sender to the mempool.
+//
+// Tendermint's priority mempool (v1) keeps at most one transaction per sender,
+// so with DefaultTxSender each signer can only have one pending transaction at
+// a time: a following transaction of the same signer is rejected until the
+// first one is committed. This bounds the mempool space a single account can
+// use, at the cost of throughput for the accounts sending several transactions
+// per block. The fee payer and fee granter are not used, as they can pay for
+// the transactions of many accounts. The other mempool versions ignore the
+// sender.
+func DefaultTxSender(tx sdk.Tx) string {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return ""
+	}
+
+	signers := msgs[0].GetSigners()
+	if len(signers) == 0 {
+		return ""
+	}
+
+	return signers[0].String()
+}
+
+// NewSenderFairnessPriorityFn returns a TxPriorityFn that limits the number of
+// transactions of a single sender, identified by DefaultTxSender, that receive
+// the priority computed by base at a given block height. Once a sender has
+// been checked more than maxTxsPerBlock times, its following transactions
+// get the lowest priority, so that they are the first to be evicted by the
+// priority mempool. The counters are reset every time the CheckTx state moves
+// to a new height. The transactions rechecked after each block are not
+// counted, so that they don't use up the quota of the new transactions.
+func NewSenderFairnessPriorityFn(base TxPriorityFn, maxTxsPerBlock uint64) TxPriorityFn {
+	counter := &senderTxCounter{
+		counts: make(map[string]uint64),
+	}
+
+	return func(ctx sdk.Context, tx sdk.Tx) int64 {
+		priority := base(ctx, tx)
+
+		sender := DefaultTxSender(tx)
+		if sender == "" || ctx.IsReCheckTx() {
+			return priority
+		}
+
+		if counter.increment(ctx.BlockHeight(), sender) > maxTxsPerBlock {
+			return 0
+		}
+
+		return priority
+	}
+}
+
+// senderTxCounter counts the transactions checked per sender at a height.
+type senderTxCounter struct {
+	mtx    sync.Mutex
+	height int64
+	counts map[string]uint64
+}
+
+func (c *senderTxCounter) increment(height int64, sender string) uint64 {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if height != c.height {
+		c.height = height
+		c.counts = make(map[string]uint64)
+	}
+
+	c.counts[sender]++
+	return c.counts[sender]
+}
+
+// addPriority adds two priorities, saturating at the int64 bounds.
+func addPriority(a, b int64) int64 {
+	switch {
+	case b > 0 && a > math.MaxInt64-b:
+		return math.MaxInt64
+	case b < 0 && a < math.MinInt64-b:
+		return math.MinInt64
+	default:
+		return a + b
+	}
+}
@@ -0,0 +1,172 @@
+package ante_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+type priorityTestTx struct {
+	msgs  []sdk.Msg
+	fee   sdk.Coins
+	gas   uint64
+	payer sdk.AccAddress
+}
+
+func (tx priorityTestTx) GetMsgs() []sdk.Msg           { return tx.msgs }
+func (tx priorityTestTx) ValidateBasic() error         { return nil }
+func (tx priorityTestTx) GetGas() uint64               { return tx.gas }
+func (tx priorityTestTx) GetFee() sdk.Coins            { return tx.fee }
+func (tx priorityTestTx) FeePayer() sdk.AccAddress     { return tx.payer }
+func (tx priorityTestTx) FeeGranter() sdk.AccAddress   { return nil }
+func (tx priorityTestTx) GetMemo() string              { return "" }
+func (tx priorityTestTx) GetTimeoutHeight() uint64     { return 0 }
+func (tx priorityTestTx) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{tx.payer} }
+
+func TestFeePerGasPriorityFn(t *testing.T) {
+	_, _, addr := testdata.KeyTestPubAddr()
+	ctx := sdk.Context{}
+
+	fn := ante.NewFeePerGasPriorityFn(sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("uatom", sdk.OneDec()),
+		sdk.NewDecCoinFromDec("uosmo", sdk.NewDecWithPrec(5, 1)),
+	))
+
+	testCases := []struct {
+		name     string
+		fee      sdk.Coins
+		gas      uint64
+		expected int64
+	}{
+		{"single denom", sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000)), 100, 10},
+		{"weighted denom", sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1000)), 100, 5},
+		{"multi denom fees are summed", sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000), sdk.NewInt64Coin("uosmo", 1000)), 100, 15},
+		{"unknown denom is ignored", sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000), sdk.NewInt64Coin("foo", 100000)), 100, 10},
+		{"zero gas", sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000)), 0, 0},
+		{"saturates", sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewIntFromUint64(math.MaxUint64).MulRaw(10))), 1, math.MaxInt64},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tx := priorityTestTx{fee: tc.fee, gas: tc.gas, payer: addr}
+			require.Equal(t, tc.expected, fn(ctx, tx))
+		})
+	}
+}
+
+func TestMsgLanePriorityFn(t *testing.T) {
+	_, _, addr := testdata.KeyTestPubAddr()
+	ctx := sdk.Context{}
+
+	base := func(sdk.Context, sdk.Tx) int64 { return 10 }
+	testMsgTypeURL := sdk.MsgTypeURL(testdata.NewTestMsg(addr))
+	fn := ante.NewMsgLanePriorityFn(base,
+		ante.NewVoteLane(1000, testMsgTypeURL),
+		ante.MsgLane{Name: "max", MsgTypeURLs: []string{sdk.MsgTypeURL(&testdata.MsgCreateDog{})}, Priority: math.MaxInt64},
+	)
+
+	// all msgs in the vote lane
+	tx := priorityTestTx{msgs: []sdk.Msg{testdata.NewTestMsg(addr), testdata.NewTestMsg(addr)}, payer: addr}
+	require.Equal(t, int64(1010), fn(ctx, tx))
+
+	// the priority saturates
+	tx = priorityTestTx{msgs: []sdk.Msg{&testdata.MsgCreateDog{}}, payer: addr}
+	require.Equal(t, int64(math.MaxInt64), fn(ctx, tx))
+
+	// msgs from different lanes only get the base priority
+	tx = priorityTestTx{msgs: []sdk.Msg{testdata.NewTestMsg(addr), &testdata.MsgCreateDog{}}, payer: addr}
+	require.Equal(t, int64(10), fn(ctx, tx))
+}
+
+func TestDefaultTxSender(t *testing.T) {
+	signer, other := sdk.AccAddress("signer______________"), sdk.AccAddress("other_______________")
+
+	// the sender is the first signer of the first msg
+	tx := priorityTestTx{msgs: []sdk.Msg{testdata.NewTestMsg(signer, other), testdata.NewTestMsg(other)}, payer: other}
+	require.Equal(t, signer.String(), ante.DefaultTxSender(tx))
+
+	// a tx without signers has no sender
+	require.Empty(t, ante.DefaultTxSender(priorityTestTx{payer: other}))
+	require.Empty(t, ante.DefaultTxSender(priorityTestTx{msgs: []sdk.Msg{testdata.NewTestMsg()}, payer: other}))
+}
+
+func TestSenderFairnessPriorityFn(t *testing.T) {
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	ctx := sdk.NewContext(nil, tmproto.Header{Height: 1}, true, nil)
+
+	base := func(sdk.Context, sdk.Tx) int64 { return 10 }
+	fn := ante.NewSenderFairnessPriorityFn(base, 2)
+
+	tx1 := priorityTestTx{msgs: []sdk.Msg{testdata.NewTestMsg(addr1)}, payer: addr1}
+	tx2 := priorityTestTx{msgs: []sdk.Msg{testdata.NewTestMsg(addr2)}, payer: addr2}
+
+	require.Equal(t, int64(10), fn(ctx, tx1))
+	require.Equal(t, int64(10), fn(ctx, tx1))
+	require.Equal(t, int64(0), fn(ctx, tx1))
+	require.Equal(t, int64(10), fn(ctx, tx2))
+
+	// counters are reset at the next height, and the rechecked txs are not
+	// counted
+	ctx = ctx.WithBlockHeight(2)
+	recheckCtx := ctx.WithIsReCheckTx(true)
+	for i := 0; i < 3; i++ {
+		require.Equal(t, int64(10), fn(recheckCtx, tx1))
+	}
+	require.Equal(t, int64(10), fn(ctx, tx1))
+	require.Equal(t, int64(10), fn(ctx, tx1))
+	require.Equal(t, int64(0), fn(ctx, tx1))
+
+	// the sender is the first signer like in DefaultTxSender, a fee
+	// payer paying for the txs of another signer uses up the signer's count
+	_, _, payer := testdata.KeyTestPubAddr()
+	ctx = ctx.WithBlockHeight(3)
+	tx3 := priorityTestTx{msgs: []sdk.Msg{testdata.NewTestMsg(addr1)}, payer: payer}
+	require.Equal(t, int64(10), fn(ctx, tx3))
+	require.Equal(t, int64(10), fn(ctx, tx1))
+	require.Equal(t, int64(0), fn(ctx, tx3))
+	require.Equal(t, int64(10), fn(ctx, priorityTestTx{msgs: []sdk.Msg{testdata.NewTestMsg(payer)}, payer: payer}))
+
+	// txs without signer are not counted
+	for i := 0; i < 3; i++ {
+		require.Equal(t, int64(10), fn(ctx, priorityTestTx{payer: payer}))
+	}
+}
+
+func TestTxPriorityDecorator(t *testing.T) {
+	_, _, addr := testdata.KeyTestPubAddr()
+	ctx := sdk.NewContext(nil, tmproto.Header{Height: 1}, true, nil).WithPriority(1)
+
+	fn := func(sdk.Context, sdk.Tx) int64 { return 42 }
+	tx := priorityTestTx{payer: addr}
+
+	var priority int64
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		priority = ctx.Priority()
+		return ctx, nil
+	}
+
+	_, err := ante.NewTxPriorityDecorator(fn).AnteHandle(ctx, tx, false, next)
+	require.NoError(t, err)
+	require.Equal(t, int64(42), priority)
+
+	// the priority is left untouched without a TxPriorityFn, in DeliverTx
+	// and in simulation mode
+	_, err = ante.NewTxPriorityDecorator(nil).AnteHandle(ctx, tx, false, next)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), priority)
+
+	_, err = ante.NewTxPriorityDecorator(fn).AnteHandle(ctx.WithIsCheckTx(false), tx, false, next)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), priority)
+
+	_, err = ante.NewTxPriorityDecorator(fn).AnteHandle(ctx, tx, true, next)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), priority)
+}
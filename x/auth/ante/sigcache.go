@@ -0,0 +1,93 @@
+package ante
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"sync/atomic"
+
+	lru "github.com/hashicorp/golang-lru"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// DefaultSigVerificationCacheSize is the default number of verified signatures
+// kept by a SigVerificationCache.
+const DefaultSigVerificationCacheSize = 20_000
+
+// SigVerificationCache is a bounded, concurrency-safe cache of successfully
+// verified (sign bytes, pubkey, signature) tuples. It lets the
+// SigVerificationDecorator skip verifying again in DeliverTx a signature that
+// was already verified in CheckTx.
+//
+// A tuple that verified once verifies forever, hence a cache hit is always
+// equivalent to a successful verification and the cache does not affect
+// consensus. Gas is consumed by the SigGasConsumeDecorator regardless of the
+// cache, so the gas used by a tx is the same with or without it.
+type SigVerificationCache struct {
+	cache *lru.Cache
+
+	hits, misses uint64
+}
+
+// NewSigVerificationCache returns a SigVerificationCache holding at most size
+// entries, evicting the least recently used ones.
+func NewSigVerificationCache(size int) (*SigVerificationCache, error) {
+	cache, err := lru.New(size)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signature verification cache: %w", err)
+	}
+
+	return &SigVerificationCache{cache: cache}, nil
+}
+
+// Contains reports whether the signature of signBytes by pubKey has already
+// been verified.
+func (c *SigVerificationCache) Contains(signBytes []byte, pubKey cryptotypes.PubKey, sig []byte) bool {
+	if c.cache.Contains(sigCacheKey(signBytes, pubKey, sig)) {
+		atomic.AddUint64(&c.hits, 1)
+		telemetry.IncrCounter(1, "ante", "sig_verification_cache", "hit")
+		return true
+	}
+
+	atomic.AddUint64(&c.misses, 1)
+	telemetry.IncrCounter(1, "ante", "sig_verification_cache", "miss")
+	return false
+}
+
+// Add records a successfully verified signature.
+func (c *SigVerificationCache) Add(signBytes []byte, pubKey cryptotypes.PubKey, sig []byte) {
+	c.cache.Add(sigCacheKey(signBytes, pubKey, sig), struct{}{})
+}
+
+// Len returns the number of cached signatures.
+func (c *SigVerificationCache) Len() int {
+	return c.cache.Len()
+}
+
+// Hits returns the number of lookups that found a cached signature.
+func (c *SigVerificationCache) Hits() uint64 {
+	return atomic.LoadUint64(&c.hits)
+}
+
+// Misses returns the number of lookups that did not find a cached signature.
+func (c *SigVerificationCache) Misses() uint64 {
+	return atomic.LoadUint64(&c.misses)
+}
+
+// sigCacheKey hashes the sign bytes, the pubkey type and bytes and the
+// signature, each one prefixed by its length to avoid ambiguous encodings.
+func sigCacheKey(signBytes []byte, pubKey cryptotypes.PubKey, sig []byte) [sha256.Size]byte {
+	h := sha256.New()
+	for _, bz := range [][]byte{signBytes, []byte(pubKey.Type()), pubKey.Bytes(), sig} {
+		var l [8]byte
+		binary.BigEndian.PutUint64(l[:], uint64(len(bz)))
+		h.Write(l[:])
+		h.Write(bz)
+	}
+
+	var key [sha256.Size]byte
+	copy(key[:], h.Sum(nil))
+	return key
+}
@@ -196,18 +196,28 @@ func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 
 // Verify all signatures for a tx and return an error if any are invalid. Note,
 // the SigVerificationDecorator will not check signatures on ReCheck.
+// If a SigVerificationCache is set, single signatures verified once are not
+// verified again, e.g. in DeliverTx after CheckTx.
 //
 // CONTRACT: Pubkeys are set in context for all signers before this decorator runs
 // CONTRACT: Tx must implement SigVerifiableTx interface
 type SigVerificationDecorator struct {
 	ak              AccountKeeper
 	signModeHandler authsigning.SignModeHandler
+	sigCache        *SigVerificationCache
 }
 
 func NewSigVerificationDecorator(ak AccountKeeper, signModeHandler authsigning.SignModeHandler) SigVerificationDecorator {
+	return NewSigVerificationDecoratorWithCache(ak, signModeHandler, nil)
+}
+
+// NewSigVerificationDecoratorWithCache returns a SigVerificationDecorator
+// caching verified signatures in sigCache, which may be nil.
+func NewSigVerificationDecoratorWithCache(ak AccountKeeper, signModeHandler authsigning.SignModeHandler, sigCache *SigVerificationCache) SigVerificationDecorator {
 	return SigVerificationDecorator{
 		ak:              ak,
 		signModeHandler: signModeHandler,
+		sigCache:        sigCache,
 	}
 }
 
@@ -289,7 +299,7 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 
 		// no need to verify signatures on recheck tx
 		if !simulate && !ctx.IsReCheckTx() {
-			err := authsigning.VerifySignature(pubKey, signerData, sig.Data, svd.signModeHandler, tx)
+			err := svd.verifySignature(pubKey, signerData, sig.Data, tx)
 			if err != nil {
 				var errMsg string
 				if OnlyLegacyAminoSigners(sig.Data) {
@@ -308,6 +318,31 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 	return next(ctx, tx, simulate)
 }
 
+// verifySignature verifies a signature, going through the signature cache for
+// single signatures. Multisignatures are always verified.
+func (svd SigVerificationDecorator) verifySignature(pubKey cryptotypes.PubKey, signerData authsigning.SignerData, sigData signing.SignatureData, tx sdk.Tx) error {
+	data, ok := sigData.(*signing.SingleSignatureData)
+	if svd.sigCache == nil || !ok {
+		return authsigning.VerifySignature(pubKey, signerData, sigData, svd.signModeHandler, tx)
+	}
+
+	signBytes, err := svd.signModeHandler.GetSignBytes(data.SignMode, signerData, tx)
+	if err != nil {
+		return err
+	}
+
+	if svd.sigCache.Contains(signBytes, pubKey, data.Signature) {
+		return nil
+	}
+
+	if !pubKey.VerifySignature(signBytes, data.Signature) {
+		return fmt.Errorf("unable to verify single signer signature")
+	}
+
+	svd.sigCache.Add(signBytes, pubKey, data.Signature)
+	return nil
+}
+
 // IncrementSequenceDecorator handles incrementing sequences of all signers.
 // Use the IncrementSequenceDecorator decorator to prevent replay attacks. Note,
 // there is need to execute IncrementSequenceDecorator on RecheckTx since
@@ -14,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
@@ -317,6 +318,69 @@ func (suite *AnteTestSuite) TestSigIntegration() {
 	suite.Require().Equal(initialSigCost*uint64(len(privs)), doubleCost-initialCost)
 }
 
+func (suite *AnteTestSuite) TestSigVerificationCache() {
+	suite.SetupTest(true) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+
+	privs := []cryptotypes.PrivKey{secp256k1.GenPrivKey(), secp256k1.GenPrivKey()}
+	msgs := make([]sdk.Msg, len(privs))
+	for i, priv := range privs {
+		addr := sdk.AccAddress(priv.PubKey().Address())
+		acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr)
+		suite.Require().NoError(acc.SetAccountNumber(uint64(i)))
+		suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+		msgs[i] = testdata.NewTestMsg(addr)
+	}
+	suite.Require().NoError(suite.txBuilder.SetMsgs(msgs...))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	tx, err := suite.CreateTestTx(privs, []uint64{0, 1}, []uint64{0, 0}, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	cache, err := ante.NewSigVerificationCache(10)
+	suite.Require().NoError(err)
+
+	spkd := ante.NewSetPubKeyDecorator(suite.app.AccountKeeper)
+	svgc := ante.NewSigGasConsumeDecorator(suite.app.AccountKeeper, ante.DefaultSigVerificationGasConsumer)
+	svd := ante.NewSigVerificationDecoratorWithCache(suite.app.AccountKeeper, suite.clientCtx.TxConfig.SignModeHandler(), cache)
+	antehandler := sdk.ChainAnteDecorators(spkd, svgc, svd)
+
+	// CheckTx verifies and caches the signatures
+	checkCtx, _ := suite.ctx.WithIsCheckTx(true).CacheContext()
+	checkCtx = checkCtx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	checkCtx, err = antehandler(checkCtx, tx, false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(len(privs), cache.Len())
+	suite.Require().Equal(uint64(len(privs)), cache.Misses())
+	suite.Require().Zero(cache.Hits())
+
+	// DeliverTx hits the cache and consumes the same amount of gas
+	deliverCtx, _ := suite.ctx.WithIsCheckTx(false).CacheContext()
+	deliverCtx = deliverCtx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	deliverCtx, err = antehandler(deliverCtx, tx, false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(len(privs), cache.Len())
+	suite.Require().Equal(uint64(len(privs)), cache.Hits())
+	suite.Require().Equal(checkCtx.GasMeter().GasConsumed(), deliverCtx.GasMeter().GasConsumed())
+
+	// an invalid signature is not accepted nor cached
+	txSigs, err := tx.GetSignaturesV2()
+	suite.Require().NoError(err)
+	badSig, err := privs[0].Sign([]byte("unrelated message"))
+	suite.Require().NoError(err)
+	txSigs[0].Data = &signing.SingleSignatureData{
+		SignMode:  suite.clientCtx.TxConfig.SignModeHandler().DefaultMode(),
+		Signature: badSig,
+	}
+	suite.Require().NoError(suite.txBuilder.SetSignatures(txSigs...))
+
+	_, err = antehandler(suite.ctx.WithIsCheckTx(true), suite.txBuilder.GetTx(), false)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	suite.Require().Equal(len(privs), cache.Len())
+}
+
 func (suite *AnteTestSuite) runSigDecorators(params types.Params, _ bool, privs ...cryptotypes.PrivKey) (sdk.Gas, error) {
 	suite.SetupTest(true) // setup
 	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
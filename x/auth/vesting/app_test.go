@@ -0,0 +1,60 @@
+package vesting_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestCreatePeriodicVestingAccountMergeSigners(t *testing.T) {
+	funderPriv, recipientPriv, otherPriv := secp256k1.GenPrivKey(), secp256k1.GenPrivKey(), secp256k1.GenPrivKey()
+	funder := sdk.AccAddress(funderPriv.PubKey().Address())
+	recipient := sdk.AccAddress(recipientPriv.PubKey().Address())
+	other := sdk.AccAddress(otherPriv.PubKey().Address())
+	coins := func(amt int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amt)) }
+
+	genAccs := []authtypes.GenesisAccount{
+		authtypes.NewBaseAccount(funder, nil, 0, 0),
+		authtypes.NewBaseAccount(other, nil, 1, 0),
+	}
+	app := simapp.SetupWithGenesisAccounts(t, genAccs,
+		banktypes.Balance{Address: funder.String(), Coins: coins(1000)},
+		banktypes.Balance{Address: other.String(), Coins: coins(1000)},
+	)
+	app.Commit()
+
+	txGen := simapp.MakeTestEncodingConfig().TxConfig
+	header := func() tmproto.Header { return tmproto.Header{Height: app.LastBlockHeight() + 1} }
+	periods := []types.Period{{Length: 100, Amount: coins(500)}}
+	startTime := int64(1)
+
+	msg := types.NewMsgCreatePeriodicVestingAccount(funder, recipient, startTime, periods)
+	_, _, err := simapp.SignCheckDeliver(t, txGen, app.BaseApp, header(), []sdk.Msg{msg}, "", []uint64{0}, []uint64{0}, true, true, funderPriv)
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewContext(true, tmproto.Header{})
+	recipientAccNum := app.AccountKeeper.GetAccount(ctx, recipient).GetAccountNumber()
+
+	// a third party cannot merge a schedule into the account of recipient
+	msg = types.NewMsgCreatePeriodicVestingAccount(other, recipient, startTime, periods)
+	msg.Merge = true
+	require.Equal(t, []sdk.AccAddress{other, recipient}, msg.GetSigners())
+	_, _, err = simapp.SignCheckDeliver(t, txGen, app.BaseApp, header(), []sdk.Msg{msg}, "", []uint64{1}, []uint64{0}, false, false, otherPriv)
+	require.Error(t, err)
+
+	// the merge goes through once recipient signs too
+	_, _, err = simapp.SignCheckDeliver(t, txGen, app.BaseApp, header(), []sdk.Msg{msg}, "", []uint64{1, recipientAccNum}, []uint64{0, 0}, true, true, otherPriv, recipientPriv)
+	require.NoError(t, err)
+
+	ctx = app.BaseApp.NewContext(true, tmproto.Header{})
+	acc := app.AccountKeeper.GetAccount(ctx, recipient).(*types.PeriodicVestingAccount)
+	require.Equal(t, coins(1000), acc.OriginalVesting)
+}
@@ -0,0 +1,213 @@
+package vesting_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+type MsgServerTestSuite struct {
+	suite.Suite
+
+	app       *simapp.SimApp
+	ctx       sdk.Context
+	msgServer types.MsgServer
+	addrs     []sdk.AccAddress
+}
+
+func (s *MsgServerTestSuite) SetupTest() {
+	s.app = simapp.Setup(s.T(), false)
+	s.ctx = s.app.BaseApp.NewContext(false, tmproto.Header{Time: time.Unix(1000, 0)})
+	s.msgServer = vesting.NewMsgServerImpl(s.app.AccountKeeper, s.app.BankKeeper, s.app.StakingKeeper)
+	s.addrs = simapp.AddTestAddrsIncremental(s.app, s.ctx, 2, sdk.NewInt(0))
+}
+
+func (s *MsgServerTestSuite) TestClawback() {
+	require := s.Require()
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	funder, other := s.addrs[0], s.addrs[1]
+	bondDenom := s.app.StakingKeeper.BondDenom(s.ctx)
+	coins := func(amt int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(bondDenom, amt)) }
+	require.NoError(testutil.FundAccount(s.app.BankKeeper, s.ctx, funder, coins(1000)))
+
+	addr := sdk.AccAddress("clawback_account_____")
+	periods := []types.Period{{Length: 100, Amount: coins(500)}, {Length: 100, Amount: coins(500)}}
+	_, err := s.msgServer.CreateClawbackVestingAccount(goCtx, types.NewMsgCreateClawbackVestingAccount(funder, addr, s.ctx.BlockTime().Unix(), periods))
+	require.NoError(err)
+	require.IsType(&types.ClawbackVestingAccount{}, s.app.AccountKeeper.GetAccount(s.ctx, addr))
+
+	// delegate 600 tokens and unbond 200 of them
+	validator := s.app.StakingKeeper.GetAllValidators(s.ctx)[0]
+	shares, err := s.app.StakingKeeper.Delegate(s.ctx, addr, sdk.NewInt(600), stakingtypes.Unbonded, validator, true)
+	require.NoError(err)
+	_, err = s.app.StakingKeeper.Undelegate(s.ctx, addr, validator.GetOperator(), shares.QuoInt64(3))
+	require.NoError(err)
+
+	// only the funder can claw back
+	_, err = s.msgServer.Clawback(goCtx, types.NewMsgClawback(other, addr, nil))
+	require.Error(err)
+
+	res, err := s.msgServer.Clawback(goCtx, types.NewMsgClawback(funder, addr, nil))
+	require.NoError(err)
+	require.Equal(coins(1000), res.Amount)
+
+	// the unbonded, unbonding and bonded tokens are transferred to the funder
+	require.Equal(coins(400), s.app.BankKeeper.GetAllBalances(s.ctx, funder))
+	require.Equal(sdk.NewInt(200), s.app.StakingKeeper.GetDelegatorUnbonding(s.ctx, funder))
+	require.Equal(sdk.NewInt(400), s.app.StakingKeeper.GetDelegatorBonded(s.ctx, funder))
+
+	require.True(s.app.BankKeeper.GetAllBalances(s.ctx, addr).IsZero())
+	require.True(s.app.StakingKeeper.GetDelegatorUnbonding(s.ctx, addr).IsZero())
+	require.True(s.app.StakingKeeper.GetDelegatorBonded(s.ctx, addr).IsZero())
+	acc := s.app.AccountKeeper.GetAccount(s.ctx, addr).(*types.ClawbackVestingAccount)
+	require.True(acc.OriginalVesting.IsZero())
+	require.True(acc.DelegatedFree.IsZero())
+	require.True(acc.DelegatedVesting.IsZero())
+	require.NoError(acc.Validate())
+}
+
+func (s *MsgServerTestSuite) TestClawbackVestedCoins() {
+	require := s.Require()
+	funder, dest := s.addrs[0], s.addrs[1]
+	bondDenom := s.app.StakingKeeper.BondDenom(s.ctx)
+	coins := func(amt int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(bondDenom, amt)) }
+	require.NoError(testutil.FundAccount(s.app.BankKeeper, s.ctx, funder, coins(1000)))
+
+	addr := sdk.AccAddress("clawback_account_____")
+	periods := []types.Period{{Length: 100, Amount: coins(500)}, {Length: 100, Amount: coins(500)}}
+	_, err := s.msgServer.CreateClawbackVestingAccount(sdk.WrapSDKContext(s.ctx), types.NewMsgCreateClawbackVestingAccount(funder, addr, s.ctx.BlockTime().Unix(), periods))
+	require.NoError(err)
+
+	// the vested coins are kept by the account
+	ctx := s.ctx.WithBlockTime(s.ctx.BlockTime().Add(150 * time.Second))
+	res, err := s.msgServer.Clawback(sdk.WrapSDKContext(ctx), types.NewMsgClawback(funder, addr, dest))
+	require.NoError(err)
+	require.Equal(coins(500), res.Amount)
+	require.Equal(coins(500), s.app.BankKeeper.GetAllBalances(ctx, dest))
+	require.Equal(coins(500), s.app.BankKeeper.SpendableCoins(ctx, addr))
+
+	// nothing is left to claw back
+	res, err = s.msgServer.Clawback(sdk.WrapSDKContext(ctx), types.NewMsgClawback(funder, addr, dest))
+	require.NoError(err)
+	require.True(res.Amount.IsZero())
+}
+
+func (s *MsgServerTestSuite) TestClawbackRedelegation() {
+	require := s.Require()
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	funder, operator := s.addrs[0], s.addrs[1]
+	bondDenom := s.app.StakingKeeper.BondDenom(s.ctx)
+	coins := func(amt int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(bondDenom, amt)) }
+	require.NoError(testutil.FundAccount(s.app.BankKeeper, s.ctx, funder, coins(1000)))
+	require.NoError(testutil.FundAccount(s.app.BankKeeper, s.ctx, operator, coins(100)))
+
+	addr := sdk.AccAddress("clawback_account_____")
+	periods := []types.Period{{Length: 100, Amount: coins(1000)}}
+	_, err := s.msgServer.CreateClawbackVestingAccount(goCtx, types.NewMsgCreateClawbackVestingAccount(funder, addr, s.ctx.BlockTime().Unix(), periods))
+	require.NoError(err)
+
+	// delegate 600 tokens and redelegate half of them to a new validator
+	srcVal := s.app.StakingKeeper.GetAllValidators(s.ctx)[0]
+	dstValAddr := sdk.ValAddress(operator)
+	teststaking.NewHelper(s.T(), s.ctx, s.app.StakingKeeper).CreateValidator(dstValAddr, ed25519.GenPrivKey().PubKey(), sdk.NewInt(100), true)
+	shares, err := s.app.StakingKeeper.Delegate(s.ctx, addr, sdk.NewInt(600), stakingtypes.Unbonded, srcVal, true)
+	require.NoError(err)
+	_, err = s.app.StakingKeeper.BeginRedelegation(s.ctx, addr, srcVal.GetOperator(), dstValAddr, shares.QuoInt64(2))
+	require.NoError(err)
+
+	res, err := s.msgServer.Clawback(goCtx, types.NewMsgClawback(funder, addr, nil))
+	require.NoError(err)
+	require.Equal(coins(1000), res.Amount)
+	require.Equal(sdk.NewInt(600), s.app.StakingKeeper.GetDelegatorBonded(s.ctx, funder))
+	require.True(s.app.StakingKeeper.GetDelegatorBonded(s.ctx, addr).IsZero())
+
+	// the redelegation in progress is moved along with the delegation, so that
+	// it remains slashable
+	_, found := s.app.StakingKeeper.GetRedelegation(s.ctx, addr, srcVal.GetOperator(), dstValAddr)
+	require.False(found)
+	red, found := s.app.StakingKeeper.GetRedelegation(s.ctx, funder, srcVal.GetOperator(), dstValAddr)
+	require.True(found)
+	require.Len(red.Entries, 1)
+	require.Equal(sdk.NewInt(300), red.Entries[0].InitialBalance)
+}
+
+func (s *MsgServerTestSuite) TestClawbackJailsValidator() {
+	require := s.Require()
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	funder := s.addrs[0]
+	bondDenom := s.app.StakingKeeper.BondDenom(s.ctx)
+	coins := func(amt int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(bondDenom, amt)) }
+	require.NoError(testutil.FundAccount(s.app.BankKeeper, s.ctx, funder, coins(1000)))
+
+	addr := sdk.AccAddress("clawback_account_____")
+	periods := []types.Period{{Length: 100, Amount: coins(1000)}}
+	_, err := s.msgServer.CreateClawbackVestingAccount(goCtx, types.NewMsgCreateClawbackVestingAccount(funder, addr, s.ctx.BlockTime().Unix(), periods))
+	require.NoError(err)
+
+	// the account operates a validator with a self-delegation of 600 tokens
+	valAddr := sdk.ValAddress(addr)
+	sh := teststaking.NewHelper(s.T(), s.ctx, s.app.StakingKeeper)
+	msg := sh.CreateValidatorMsg(valAddr, ed25519.GenPrivKey().PubKey(), sdk.NewInt(600))
+	msg.MinSelfDelegation = sdk.NewInt(500)
+	_, err = sh.CreateValidatorWithMsg(goCtx, msg)
+	require.NoError(err)
+
+	// clawing back the self-delegation jails the validator
+	res, err := s.msgServer.Clawback(goCtx, types.NewMsgClawback(funder, addr, nil))
+	require.NoError(err)
+	require.Equal(coins(1000), res.Amount)
+	require.Equal(sdk.NewInt(600), s.app.StakingKeeper.GetDelegatorBonded(s.ctx, funder))
+	sh.CheckValidator(valAddr, stakingtypes.Unbonded, true)
+}
+
+func (s *MsgServerTestSuite) TestCreatePeriodicVestingAccountMerge() {
+	require := s.Require()
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	funder, to := s.addrs[0], s.addrs[1]
+	bondDenom := s.app.StakingKeeper.BondDenom(s.ctx)
+	coins := func(amt int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(bondDenom, amt)) }
+	require.NoError(testutil.FundAccount(s.app.BankKeeper, s.ctx, funder, coins(1000)))
+
+	// the account of to is not a periodic vesting account
+	periods := []types.Period{{Length: 100, Amount: coins(500)}}
+	msg := types.NewMsgCreatePeriodicVestingAccount(funder, to, s.ctx.BlockTime().Unix(), periods)
+	msg.Merge = true
+	_, err := s.msgServer.CreatePeriodicVestingAccount(goCtx, msg)
+	require.Error(err)
+
+	addr := sdk.AccAddress("periodic_account_____")
+	msg = types.NewMsgCreatePeriodicVestingAccount(funder, addr, s.ctx.BlockTime().Unix(), periods)
+	_, err = s.msgServer.CreatePeriodicVestingAccount(goCtx, msg)
+	require.NoError(err)
+
+	// the account already exists
+	_, err = s.msgServer.CreatePeriodicVestingAccount(goCtx, msg)
+	require.Error(err)
+
+	msg = types.NewMsgCreatePeriodicVestingAccount(funder, addr, s.ctx.BlockTime().Unix()+50, periods)
+	msg.Merge = true
+	_, err = s.msgServer.CreatePeriodicVestingAccount(goCtx, msg)
+	require.NoError(err)
+
+	acc := s.app.AccountKeeper.GetAccount(s.ctx, addr).(*types.PeriodicVestingAccount)
+	require.NoError(acc.Validate())
+	require.Equal(coins(1000), acc.OriginalVesting)
+	require.Equal(s.ctx.BlockTime().Unix()+150, acc.EndTime)
+	require.Equal(coins(1000), s.app.BankKeeper.GetAllBalances(s.ctx, addr))
+	require.Equal(coins(500), acc.GetVestedCoins(s.ctx.BlockTime().Add(100*time.Second)))
+}
+
+func TestMsgServerTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgServerTestSuite))
+}
@@ -48,6 +48,47 @@ func (p Periods) TotalAmount() sdk.Coins {
 	return total
 }
 
+// DisjunctPeriods returns the union of the vesting schedule p starting at
+// startP and of the vesting schedule q starting at startQ, laid over a common
+// timeline. It returns the start time, end time and periods of the union.
+func DisjunctPeriods(startP, startQ int64, p, q Periods) (int64, int64, Periods) {
+	start := startP
+	if startQ < start {
+		start = startQ
+	}
+
+	var union Periods
+	timeP, timeQ, last := startP, startQ, start
+	i, j := 0, 0
+	for i < len(p) || j < len(q) {
+		var eventTime int64
+		var amount sdk.Coins
+
+		switch {
+		case j == len(q) || (i < len(p) && timeP+p[i].Length < timeQ+q[j].Length):
+			timeP += p[i].Length
+			eventTime, amount = timeP, p[i].Amount
+			i++
+		case i == len(p) || timeQ+q[j].Length < timeP+p[i].Length:
+			timeQ += q[j].Length
+			eventTime, amount = timeQ, q[j].Amount
+			j++
+		default:
+			// both schedules vest at the same time
+			timeP += p[i].Length
+			timeQ += q[j].Length
+			eventTime, amount = timeP, p[i].Amount.Add(q[j].Amount...)
+			i++
+			j++
+		}
+
+		union = append(union, Period{Length: eventTime - last, Amount: amount})
+		last = eventTime
+	}
+
+	return start, last, union
+}
+
 // String implements the fmt.Stringer interface
 func (p Periods) String() string {
 	periodsListString := make([]string, len(p))
@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"sigs.k8s.io/yaml"
@@ -18,6 +19,7 @@ var (
 	_ vestexported.VestingAccount = (*ContinuousVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*PeriodicVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*DelayedVestingAccount)(nil)
+	_ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
 )
 
 // Base Vesting Account
@@ -164,6 +166,7 @@ type vestingAccountYAML struct {
 	// custom fields based on concrete vesting type which can be omitted
 	StartTime      int64   `json:"start_time,omitempty"`
 	VestingPeriods Periods `json:"vesting_periods,omitempty"`
+	FunderAddress  string  `json:"funder_address,omitempty"`
 }
 
 func (bva BaseVestingAccount) String() string {
@@ -405,6 +408,26 @@ func (pva PeriodicVestingAccount) GetVestingPeriods() Periods {
 	return pva.VestingPeriods
 }
 
+// AddGrant merges a new vesting schedule of grantCoins starting at
+// grantStartTime into the account. The delegated coins are the coins currently
+// bonded or unbonding from the account, which may be lower than its tracked
+// delegated coins because of slashing. The delegated vesting and delegated
+// free coins are rebalanced so that the granted coins remain locked until they
+// vest.
+func (pva *PeriodicVestingAccount) AddGrant(blockTime time.Time, delegated sdk.Coins, grantStartTime int64, grantPeriods Periods, grantCoins sdk.Coins) {
+	// slashed coins are kept as delegated up to the coins which are still
+	// vesting, to remain consistent with TrackUndelegation
+	oldDelegated := pva.DelegatedVesting.Add(pva.DelegatedFree...)
+	slashed := oldDelegated.Sub(delegated.Min(oldDelegated)...)
+	newDelegated := delegated.Add(slashed.Min(pva.GetVestingCoins(blockTime))...)
+
+	pva.StartTime, pva.EndTime, pva.VestingPeriods = DisjunctPeriods(pva.StartTime, grantStartTime, pva.VestingPeriods, grantPeriods)
+	pva.OriginalVesting = pva.OriginalVesting.Add(grantCoins...)
+
+	pva.DelegatedVesting = newDelegated.Min(pva.GetVestingCoins(blockTime))
+	pva.DelegatedFree = newDelegated.Sub(pva.DelegatedVesting...)
+}
+
 // Validate checks for errors on the account fields
 func (pva PeriodicVestingAccount) Validate() error {
 	if pva.GetStartTime() >= pva.GetEndTime() {
@@ -457,6 +480,7 @@ func (pva PeriodicVestingAccount) MarshalYAML() (interface{}, error) {
 
 var (
 	_ vestexported.VestingAccount = (*DelayedVestingAccount)(nil)
+	_ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
 	_ authtypes.GenesisAccount    = (*DelayedVestingAccount)(nil)
 )
 
@@ -591,6 +615,203 @@ func (plva PermanentLockedAccount) String() string {
 	return out.(string)
 }
 
+// Clawback Vesting Account
+
+var (
+	_ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
+	_ authtypes.GenesisAccount    = (*ClawbackVestingAccount)(nil)
+)
+
+// NewClawbackVestingAccount returns a new ClawbackVestingAccount
+func NewClawbackVestingAccount(baseAcc *authtypes.BaseAccount, funder sdk.AccAddress, originalVesting sdk.Coins, startTime int64, periods Periods) *ClawbackVestingAccount {
+	endTime := startTime
+	for _, p := range periods {
+		endTime += p.Length
+	}
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		OriginalVesting: originalVesting,
+		EndTime:         endTime,
+	}
+
+	return &ClawbackVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+		FunderAddress:      funder.String(),
+		StartTime:          startTime,
+		VestingPeriods:     periods,
+	}
+}
+
+// GetVestedCoins returns the total number of vested coins. If no coins are vested,
+// nil is returned.
+func (cva ClawbackVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	var vestedCoins sdk.Coins
+
+	if blockTime.Unix() <= cva.StartTime {
+		return vestedCoins
+	} else if blockTime.Unix() >= cva.EndTime {
+		return cva.OriginalVesting
+	}
+
+	for _, period := range cva.vestedPeriods(blockTime.Unix()) {
+		vestedCoins = vestedCoins.Add(period.Amount...)
+	}
+
+	return vestedCoins
+}
+
+// GetVestingCoins returns the total number of vesting coins. If no coins are
+// vesting, nil is returned.
+func (cva ClawbackVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return cva.OriginalVesting.Sub(cva.GetVestedCoins(blockTime)...)
+}
+
+// LockedCoins returns the set of coins that are not spendable (i.e. locked),
+// defined as the vesting coins that are not delegated.
+func (cva ClawbackVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return cva.BaseVestingAccount.LockedCoinsFromVesting(cva.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (cva *ClawbackVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) {
+	cva.BaseVestingAccount.TrackDelegation(balance, cva.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the time when vesting starts for a clawback vesting
+// account.
+func (cva ClawbackVestingAccount) GetStartTime() int64 {
+	return cva.StartTime
+}
+
+// GetVestingPeriods returns vesting periods associated with clawback vesting account.
+func (cva ClawbackVestingAccount) GetVestingPeriods() Periods {
+	return cva.VestingPeriods
+}
+
+// GetFunder returns the address allowed to claw back the unvested coins.
+func (cva ClawbackVestingAccount) GetFunder() sdk.AccAddress {
+	funder, _ := sdk.AccAddressFromBech32(cva.FunderAddress)
+	return funder
+}
+
+// ComputeClawback removes the vesting periods which are not over at the given
+// time from the account, and returns the coins of these periods. The delegated
+// coins of the account are left as is, see UpdateDelegation.
+func (cva *ClawbackVestingAccount) ComputeClawback(clawbackTime int64) sdk.Coins {
+	vestedPeriods := cva.vestedPeriods(clawbackTime)
+
+	endTime := cva.StartTime
+	originalVesting := sdk.NewCoins()
+	for _, period := range vestedPeriods {
+		endTime += period.Length
+		originalVesting = originalVesting.Add(period.Amount...)
+	}
+
+	toClawBack := cva.OriginalVesting.Sub(originalVesting...)
+
+	cva.VestingPeriods = vestedPeriods
+	cva.OriginalVesting = originalVesting
+	cva.EndTime = endTime
+
+	return toClawBack
+}
+
+// UpdateDelegation updates the delegated vesting and delegated free coins of
+// the account after toClawBack coins have been removed from its vesting
+// schedule. The coins to claw back are taken from the unbonded coins first,
+// then from the unbonding and bonded ones. The encumbered coins are the coins
+// which are still vesting. It returns the coins to claw back, capped by the
+// total coins of the account.
+func (cva *ClawbackVestingAccount) UpdateDelegation(encumbered, toClawBack, bonded, unbonding, unbonded sdk.Coins) sdk.Coins {
+	delegated := bonded.Add(unbonding...)
+	oldDelegated := cva.DelegatedVesting.Add(cva.DelegatedFree...)
+
+	// slashed coins are never removed from the delegated coins of the account,
+	// so they are kept to remain consistent with TrackUndelegation
+	slashed := oldDelegated.Sub(delegated.Min(oldDelegated)...)
+	total := delegated.Add(unbonded...)
+	toClawBack = toClawBack.Min(total)
+
+	newDelegated := delegated.Min(total.Sub(toClawBack...)).Add(slashed...)
+	cva.DelegatedVesting = encumbered.Min(newDelegated)
+	cva.DelegatedFree = newDelegated.Sub(cva.DelegatedVesting...)
+
+	return toClawBack
+}
+
+// vestedPeriods returns the vesting periods which are over at the given time.
+func (cva ClawbackVestingAccount) vestedPeriods(blockTime int64) Periods {
+	// track the start time of the next period
+	currentPeriodStartTime := cva.StartTime
+
+	for i, period := range cva.VestingPeriods {
+		if blockTime-currentPeriodStartTime < period.Length {
+			return cva.VestingPeriods[:i]
+		}
+
+		// update the start time of the next period
+		currentPeriodStartTime += period.Length
+	}
+
+	return cva.VestingPeriods
+}
+
+// Validate checks for errors on the account fields
+func (cva ClawbackVestingAccount) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(cva.FunderAddress); err != nil {
+		return fmt.Errorf("invalid funder address: %w", err)
+	}
+	// the vesting schedule of an account which has been clawed back entirely
+	// is empty
+	if cva.GetStartTime() > cva.GetEndTime() {
+		return errors.New("vesting start-time cannot be before end-time")
+	}
+	endTime := cva.StartTime
+	originalVesting := sdk.NewCoins()
+	for _, p := range cva.VestingPeriods {
+		endTime += p.Length
+		originalVesting = originalVesting.Add(p.Amount...)
+	}
+	if endTime != cva.EndTime {
+		return errors.New("vesting end time does not match length of all vesting periods")
+	}
+	if !originalVesting.IsEqual(cva.OriginalVesting) {
+		return errors.New("original vesting coins does not match the sum of all coins in vesting periods")
+	}
+
+	return cva.BaseVestingAccount.Validate()
+}
+
+func (cva ClawbackVestingAccount) String() string {
+	out, _ := cva.MarshalYAML()
+	return out.(string)
+}
+
+// MarshalYAML returns the YAML representation of a ClawbackVestingAccount.
+func (cva ClawbackVestingAccount) MarshalYAML() (interface{}, error) {
+	accAddr, err := sdk.AccAddressFromBech32(cva.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	out := vestingAccountYAML{
+		Address:          accAddr,
+		AccountNumber:    cva.AccountNumber,
+		PubKey:           getPKString(cva),
+		Sequence:         cva.Sequence,
+		OriginalVesting:  cva.OriginalVesting,
+		DelegatedFree:    cva.DelegatedFree,
+		DelegatedVesting: cva.DelegatedVesting,
+		EndTime:          cva.EndTime,
+		StartTime:        cva.StartTime,
+		VestingPeriods:   cva.VestingPeriods,
+		FunderAddress:    cva.FunderAddress,
+	}
+	return marshalYaml(out)
+}
+
 type getPK interface {
 	GetPubKey() cryptotypes.PubKey
 }
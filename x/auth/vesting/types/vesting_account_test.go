@@ -548,6 +548,73 @@ func TestTrackUndelegationPeriodicVestingAcc(t *testing.T) {
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, pva.DelegatedVesting)
 }
 
+func TestDisjunctPeriods(t *testing.T) {
+	coins := func(amt int64) sdk.Coins { return sdk.Coins{sdk.NewInt64Coin(stakeDenom, amt)} }
+	p := types.Periods{{Length: 10, Amount: coins(1)}, {Length: 10, Amount: coins(2)}}
+	q := types.Periods{{Length: 5, Amount: coins(3)}, {Length: 10, Amount: coins(4)}, {Length: 10, Amount: coins(5)}}
+
+	// q starts 5 seconds after p, so that both vest at 110 and 120
+	start, end, union := types.DisjunctPeriods(100, 105, p, q)
+	require.Equal(t, int64(100), start)
+	require.Equal(t, int64(130), end)
+	require.Equal(t, types.Periods{
+		{Length: 10, Amount: coins(4)},
+		{Length: 10, Amount: coins(6)},
+		{Length: 10, Amount: coins(5)},
+	}, union)
+
+	// q starts before p
+	start, end, union = types.DisjunctPeriods(100, 90, p, q)
+	require.Equal(t, int64(90), start)
+	require.Equal(t, int64(120), end)
+	require.Equal(t, types.Periods{
+		{Length: 5, Amount: coins(3)},
+		{Length: 10, Amount: coins(4)},
+		{Length: 5, Amount: coins(1)},
+		{Length: 5, Amount: coins(5)},
+		{Length: 5, Amount: coins(2)},
+	}, union)
+	require.Equal(t, p.TotalAmount().Add(q.TotalAmount()...), union.TotalAmount())
+}
+
+func TestAddGrantPeriodicVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+	origCoins := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}
+
+	bacc, _ := initBaseAccount()
+	pva := types.NewPeriodicVestingAccount(bacc, origCoins, now.Unix(), periods)
+
+	// delegate 80 stake, of which 20 are slashed later on
+	pva.TrackDelegation(now, origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 80)})
+
+	// once the first period vested, grant 60 more stake over the next day
+	grantTime := now.Add(12 * time.Hour)
+	grantPeriods := types.Periods{
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 30)}},
+		types.Period{Length: int64(18 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 30)}},
+	}
+	grantCoins := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 60)}
+	pva.AddGrant(grantTime, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 60)}, grantTime.Unix(), grantPeriods, grantCoins)
+	require.NoError(t, pva.Validate())
+
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 160)}, pva.OriginalVesting)
+	require.Equal(t, now.Unix(), pva.StartTime)
+	require.Equal(t, grantTime.Add(24*time.Hour).Unix(), pva.EndTime)
+	require.Len(t, pva.VestingPeriods, 4)
+
+	// 110 stake are vesting, and the 20 slashed stake remain delegated
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 110)}, pva.GetVestingCoins(grantTime))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 80)}, pva.DelegatedVesting)
+	require.Nil(t, pva.DelegatedFree)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, pva.GetVestedCoins(grantTime.Add(time.Hour)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 80)}, pva.GetVestedCoins(grantTime.Add(6*time.Hour)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 130)}, pva.GetVestedCoins(grantTime.Add(12*time.Hour)))
+}
+
 func TestGetVestedCoinsPermLockedVestingAcc(t *testing.T) {
 	now := tmtime.Now()
 	endTime := now.Add(1000 * 24 * time.Hour)
@@ -677,6 +744,109 @@ func TestTrackUndelegationPermLockedVestingAcc(t *testing.T) {
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, plva.DelegatedVesting)
 }
 
+func TestGetVestedCoinsClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+
+	_, _, funder := testdata.KeyTestPubAddr()
+	bacc, origCoins := initBaseAccount()
+	cva := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), periods)
+	require.NoError(t, cva.Validate())
+
+	// require no coins vested during first vesting period
+	require.Nil(t, cva.GetVestedCoins(now))
+	require.Nil(t, cva.GetVestedCoins(now.Add(6*time.Hour)))
+	require.Equal(t, origCoins, cva.GetVestingCoins(now.Add(6*time.Hour)))
+
+	// require 75% of coins vested after period 2
+	require.Equal(t,
+		sdk.Coins{sdk.NewInt64Coin(feeDenom, 750), sdk.NewInt64Coin(stakeDenom, 75)},
+		cva.GetVestedCoins(now.Add(18*time.Hour)),
+	)
+
+	// require 100% of coins vested
+	require.Equal(t, origCoins, cva.GetVestedCoins(now.Add(48*time.Hour)))
+}
+
+func TestComputeClawbackClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+
+	_, _, funder := testdata.KeyTestPubAddr()
+	bacc, origCoins := initBaseAccount()
+
+	// claw back during the second period
+	cva := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), periods)
+	clawback := cva.ComputeClawback(now.Add(15 * time.Hour).Unix())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, clawback)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, cva.OriginalVesting)
+	require.Equal(t, periods[:1], types.Periods(cva.VestingPeriods))
+	require.Equal(t, now.Add(12*time.Hour).Unix(), cva.EndTime)
+	require.Nil(t, cva.GetVestingCoins(now.Add(15*time.Hour)))
+	require.NoError(t, cva.Validate())
+
+	// claw back before the start of the schedule
+	cva = types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), periods)
+	require.Equal(t, origCoins, cva.ComputeClawback(now.Unix()))
+	require.Empty(t, cva.OriginalVesting)
+	require.Empty(t, cva.VestingPeriods)
+	require.Equal(t, now.Unix(), cva.EndTime)
+	require.NoError(t, cva.Validate())
+
+	// nothing to claw back after the end of the schedule
+	cva = types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), periods)
+	require.True(t, cva.ComputeClawback(now.Add(48*time.Hour).Unix()).IsZero())
+	require.Equal(t, origCoins, cva.OriginalVesting)
+}
+
+func TestUpdateDelegationClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+	origCoins := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}
+
+	_, _, funder := testdata.KeyTestPubAddr()
+	bacc, _ := initBaseAccount()
+	cva := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), periods)
+
+	// delegate 80 stake, of which 10 are slashed later on
+	cva.TrackDelegation(now, origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 80)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 80)}, cva.DelegatedVesting)
+
+	toClawBack := cva.ComputeClawback(now.Add(12 * time.Hour).Unix())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, toClawBack)
+
+	// 20 stake are unbonded, 40 bonded and 30 unbonding
+	toClawBack = cva.UpdateDelegation(
+		cva.GetVestingCoins(now.Add(12*time.Hour)),
+		toClawBack,
+		sdk.Coins{sdk.NewInt64Coin(stakeDenom, 40)},
+		sdk.Coins{sdk.NewInt64Coin(stakeDenom, 30)},
+		sdk.Coins{sdk.NewInt64Coin(stakeDenom, 20)},
+	)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, toClawBack)
+
+	// 40 stake remain delegated after the clawback, plus the 10 slashed ones
+	require.Empty(t, cva.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.DelegatedFree)
+
+	// the clawback is capped by the coins of the account
+	cva = types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), periods)
+	toClawBack = cva.ComputeClawback(now.Unix())
+	toClawBack = cva.UpdateDelegation(nil, toClawBack, nil, nil, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 60)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 60)}, toClawBack)
+}
+
 func TestGenesisAccountValidate(t *testing.T) {
 	pubkey := secp256k1.GenPrivKey().PubKey()
 	addr := sdk.AccAddress(pubkey.Address())
@@ -830,6 +1000,26 @@ func (s *VestingAccountTestSuite) TestPermanentLockedAccountMarshal() {
 	require.NotNil(err)
 }
 
+func (s *VestingAccountTestSuite) TestClawbackVestingAccountMarshal() {
+	app := s.app
+	require := s.Require()
+	_, _, funder := testdata.KeyTestPubAddr()
+	baseAcc, coins := initBaseAccount()
+	acc := types.NewClawbackVestingAccount(baseAcc, funder, coins, time.Now().Unix(), types.Periods{types.Period{3600, coins}})
+
+	bz, err := app.AccountKeeper.MarshalAccount(acc)
+	require.Nil(err)
+
+	acc2, err := app.AccountKeeper.UnmarshalAccount(bz)
+	require.Nil(err)
+	require.IsType(&types.ClawbackVestingAccount{}, acc2)
+	require.Equal(acc.String(), acc2.String())
+
+	// error on bad bytes
+	_, err = app.AccountKeeper.UnmarshalAccount(bz[:len(bz)/2])
+	require.NotNil(err)
+}
+
 func initBaseAccount() (*authtypes.BaseAccount, sdk.Coins) {
 	_, _, addr := testdata.KeyTestPubAddr()
 	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
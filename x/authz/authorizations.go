@@ -24,6 +24,32 @@ type Authorization interface {
 	ValidateBasic() error
 }
 
+// SubAuthorization is an optional interface implemented by Authorizations which
+// can be sub-granted out of a parent Authorization for the same Msg.
+type SubAuthorization interface {
+	Authorization
+
+	// IsSubsetOf returns true if the authorization permits nothing the parent
+	// authorization doesn't.
+	IsSubsetOf(parent Authorization) bool
+}
+
+// IsSubAuthorization returns true if sub can be sub-granted out of parent. A
+// GenericAuthorization parent permits any authorization for the same Msg, any
+// other parent requires sub to implement SubAuthorization.
+func IsSubAuthorization(sub, parent Authorization) bool {
+	if sub.MsgTypeURL() != parent.MsgTypeURL() {
+		return false
+	}
+
+	if _, ok := parent.(*GenericAuthorization); ok {
+		return true
+	}
+
+	s, ok := sub.(SubAuthorization)
+	return ok && s.IsSubsetOf(parent)
+}
+
 // AcceptResponse instruments the controller of an authz message if the request is accepted
 // and if it should be updated or deleted.
 type AcceptResponse struct {
@@ -6,10 +6,12 @@ package authz
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
-	types "github.com/cosmos/cosmos-sdk/codec/types"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -69,21 +71,164 @@ func (m *GenericAuthorization) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenericAuthorization proto.InternalMessageInfo
 
+// UsageLimitedAuthorization gives the grantee permissions to execute the
+// provided method on behalf of the granter's account a limited number of times.
+type UsageLimitedAuthorization struct {
+	// Msg, identified by it's type URL, to grant permissions to execute
+	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// remaining_executions is the number of executions left. The authorization
+	// is deleted once it reaches zero.
+	RemainingExecutions uint64 `protobuf:"varint,2,opt,name=remaining_executions,json=remainingExecutions,proto3" json:"remaining_executions,omitempty"`
+}
+
+func (m *UsageLimitedAuthorization) Reset()         { *m = UsageLimitedAuthorization{} }
+func (m *UsageLimitedAuthorization) String() string { return proto.CompactTextString(m) }
+func (*UsageLimitedAuthorization) ProtoMessage()    {}
+func (*UsageLimitedAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{1}
+}
+func (m *UsageLimitedAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UsageLimitedAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UsageLimitedAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UsageLimitedAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UsageLimitedAuthorization.Merge(m, src)
+}
+func (m *UsageLimitedAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *UsageLimitedAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_UsageLimitedAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UsageLimitedAuthorization proto.InternalMessageInfo
+
+// RateLimitedAuthorization gives the grantee permissions to execute the
+// provided method on behalf of the granter's account a limited number of times
+// per period.
+type RateLimitedAuthorization struct {
+	// Msg, identified by it's type URL, to grant permissions to execute
+	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// max_executions is the number of executions allowed per period.
+	MaxExecutions uint64 `protobuf:"varint,2,opt,name=max_executions,json=maxExecutions,proto3" json:"max_executions,omitempty"`
+	// period is the length of the window in which at most max_executions
+	// executions are allowed.
+	Period time.Duration `protobuf:"bytes,3,opt,name=period,proto3,stdduration" json:"period"`
+	// window_start is the start of the current window. It is set by the first
+	// execution after the end of the previous window.
+	WindowStart *time.Time `protobuf:"bytes,4,opt,name=window_start,json=windowStart,proto3,stdtime" json:"window_start,omitempty"`
+	// executions is the number of executions in the current window.
+	Executions uint64 `protobuf:"varint,5,opt,name=executions,proto3" json:"executions,omitempty"`
+}
+
+func (m *RateLimitedAuthorization) Reset()         { *m = RateLimitedAuthorization{} }
+func (m *RateLimitedAuthorization) String() string { return proto.CompactTextString(m) }
+func (*RateLimitedAuthorization) ProtoMessage()    {}
+func (*RateLimitedAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{2}
+}
+func (m *RateLimitedAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitedAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitedAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitedAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitedAuthorization.Merge(m, src)
+}
+func (m *RateLimitedAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitedAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitedAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitedAuthorization proto.InternalMessageInfo
+
+// FieldRestrictedAuthorization gives the grantee permissions to execute the
+// provided method on behalf of the granter's account, provided that the fields
+// of the Msg only take the allowed values.
+type FieldRestrictedAuthorization struct {
+	// Msg, identified by it's type URL, to grant permissions to execute
+	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// restrictions are the restrictions which all apply to the Msg.
+	Restrictions []types.MsgFieldRestriction `protobuf:"bytes,2,rep,name=restrictions,proto3" json:"restrictions"`
+}
+
+func (m *FieldRestrictedAuthorization) Reset()         { *m = FieldRestrictedAuthorization{} }
+func (m *FieldRestrictedAuthorization) String() string { return proto.CompactTextString(m) }
+func (*FieldRestrictedAuthorization) ProtoMessage()    {}
+func (*FieldRestrictedAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{3}
+}
+func (m *FieldRestrictedAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FieldRestrictedAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FieldRestrictedAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FieldRestrictedAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldRestrictedAuthorization.Merge(m, src)
+}
+func (m *FieldRestrictedAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *FieldRestrictedAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldRestrictedAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldRestrictedAuthorization proto.InternalMessageInfo
+
 // Grant gives permissions to execute
 // the provide method with expiration time.
 type Grant struct {
-	Authorization *types.Any `protobuf:"bytes,1,opt,name=authorization,proto3" json:"authorization,omitempty"`
+	Authorization *types1.Any `protobuf:"bytes,1,opt,name=authorization,proto3" json:"authorization,omitempty"`
 	// time when the grant will expire and will be pruned. If null, then the grant
 	// doesn't have a time expiration (other conditions  in `authorization`
 	// may apply to invalidate the grant)
 	Expiration *time.Time `protobuf:"bytes,2,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+	// allow_sub_grant allows the grantee to sub-grant a narrower authorization
+	// for the same Msg on the granter's account to a third party.
+	AllowSubGrant bool `protobuf:"varint,3,opt,name=allow_sub_grant,json=allowSubGrant,proto3" json:"allow_sub_grant,omitempty"`
+	// sub_granter is the grantee of the parent grant this grant was sub-granted
+	// from. It is empty for grants issued by the granter itself.
+	SubGranter string `protobuf:"bytes,4,opt,name=sub_granter,json=subGranter,proto3" json:"sub_granter,omitempty"`
 }
 
 func (m *Grant) Reset()         { *m = Grant{} }
 func (m *Grant) String() string { return proto.CompactTextString(m) }
 func (*Grant) ProtoMessage()    {}
 func (*Grant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{1}
+	return fileDescriptor_544dc2e84b61c637, []int{4}
 }
 func (m *Grant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -115,17 +260,20 @@ var xxx_messageInfo_Grant proto.InternalMessageInfo
 // GrantAuthorization extends a grant with both the addresses of the grantee and granter.
 // It is used in genesis.proto and query.proto
 type GrantAuthorization struct {
-	Granter       string     `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
-	Grantee       string     `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
-	Authorization *types.Any `protobuf:"bytes,3,opt,name=authorization,proto3" json:"authorization,omitempty"`
-	Expiration    *time.Time `protobuf:"bytes,4,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+	Granter       string      `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	Grantee       string      `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	Authorization *types1.Any `protobuf:"bytes,3,opt,name=authorization,proto3" json:"authorization,omitempty"`
+	Expiration    *time.Time  `protobuf:"bytes,4,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+	// allow_sub_grant and sub_granter mirror the fields of the same name in Grant.
+	AllowSubGrant bool   `protobuf:"varint,5,opt,name=allow_sub_grant,json=allowSubGrant,proto3" json:"allow_sub_grant,omitempty"`
+	SubGranter    string `protobuf:"bytes,6,opt,name=sub_granter,json=subGranter,proto3" json:"sub_granter,omitempty"`
 }
 
 func (m *GrantAuthorization) Reset()         { *m = GrantAuthorization{} }
 func (m *GrantAuthorization) String() string { return proto.CompactTextString(m) }
 func (*GrantAuthorization) ProtoMessage()    {}
 func (*GrantAuthorization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{2}
+	return fileDescriptor_544dc2e84b61c637, []int{5}
 }
 func (m *GrantAuthorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -164,7 +312,7 @@ func (m *GrantQueueItem) Reset()         { *m = GrantQueueItem{} }
 func (m *GrantQueueItem) String() string { return proto.CompactTextString(m) }
 func (*GrantQueueItem) ProtoMessage()    {}
 func (*GrantQueueItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{3}
+	return fileDescriptor_544dc2e84b61c637, []int{6}
 }
 func (m *GrantQueueItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -195,6 +343,9 @@ var xxx_messageInfo_GrantQueueItem proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*GenericAuthorization)(nil), "cosmos.authz.v1beta1.GenericAuthorization")
+	proto.RegisterType((*UsageLimitedAuthorization)(nil), "cosmos.authz.v1beta1.UsageLimitedAuthorization")
+	proto.RegisterType((*RateLimitedAuthorization)(nil), "cosmos.authz.v1beta1.RateLimitedAuthorization")
+	proto.RegisterType((*FieldRestrictedAuthorization)(nil), "cosmos.authz.v1beta1.FieldRestrictedAuthorization")
 	proto.RegisterType((*Grant)(nil), "cosmos.authz.v1beta1.Grant")
 	proto.RegisterType((*GrantAuthorization)(nil), "cosmos.authz.v1beta1.GrantAuthorization")
 	proto.RegisterType((*GrantQueueItem)(nil), "cosmos.authz.v1beta1.GrantQueueItem")
@@ -203,34 +354,49 @@ func init() {
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/authz.proto", fileDescriptor_544dc2e84b61c637) }
 
 var fileDescriptor_544dc2e84b61c637 = []byte{
-	// 417 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x52, 0xbd, 0xae, 0xda, 0x30,
-	0x18, 0x8d, 0x81, 0xfe, 0x60, 0x44, 0xd5, 0x46, 0x19, 0x80, 0x21, 0xa0, 0xa8, 0x03, 0x0b, 0x89,
-	0xa0, 0x9d, 0xda, 0xa5, 0x44, 0x95, 0x50, 0x87, 0x0e, 0x4d, 0xe9, 0xd2, 0x05, 0x25, 0xe0, 0x9a,
-	0xa8, 0x38, 0x8e, 0x6c, 0xa7, 0x22, 0xbc, 0x43, 0x25, 0x1e, 0xa0, 0x8f, 0xc1, 0x43, 0xa0, 0x4e,
-	0xa8, 0x53, 0xa7, 0xfe, 0xc0, 0x8b, 0x5c, 0x61, 0x27, 0xba, 0xe4, 0x66, 0xb8, 0x57, 0xba, 0x53,
-	0xfc, 0x1d, 0x9f, 0x73, 0xfc, 0x7d, 0x27, 0x1f, 0xec, 0xcd, 0x29, 0x27, 0x94, 0x3b, 0x7e, 0x22,
-	0x96, 0x1b, 0xe7, 0xdb, 0x30, 0x40, 0xc2, 0x1f, 0xaa, 0xca, 0x8e, 0x19, 0x15, 0x54, 0x37, 0x14,
-	0xc3, 0x56, 0x58, 0xc6, 0xe8, 0xb4, 0x15, 0x3a, 0x93, 0x1c, 0x27, 0xa3, 0xc8, 0xa2, 0xd3, 0xc5,
-	0x94, 0xe2, 0x15, 0x72, 0x64, 0x15, 0x24, 0x5f, 0x1c, 0x11, 0x12, 0xc4, 0x85, 0x4f, 0xe2, 0x8c,
-	0x60, 0x60, 0x8a, 0xa9, 0x12, 0x9e, 0x4f, 0x19, 0xda, 0xbe, 0x29, 0xf3, 0xa3, 0x54, 0x5d, 0x59,
-	0xaf, 0xa1, 0x31, 0x41, 0x11, 0x62, 0xe1, 0x7c, 0x9c, 0x88, 0x25, 0x65, 0xe1, 0xc6, 0x17, 0x21,
-	0x8d, 0xf4, 0xa7, 0xb0, 0x4a, 0x38, 0x6e, 0x81, 0x1e, 0xe8, 0xd7, 0xbd, 0xf3, 0xf1, 0xd5, 0xb3,
-	0x9f, 0xbb, 0x41, 0xb3, 0x40, 0xb2, 0x7e, 0x00, 0xf8, 0x60, 0xc2, 0xfc, 0x48, 0xe8, 0xef, 0x61,
-	0xd3, 0xbf, 0xbc, 0x92, 0xc2, 0xc6, 0xc8, 0xb0, 0xd5, 0xcb, 0x76, 0xfe, 0xb2, 0x3d, 0x8e, 0x52,
-	0xb7, 0xec, 0xe4, 0x15, 0xd5, 0xfa, 0x5b, 0x08, 0xd1, 0x3a, 0x0e, 0x99, 0xf2, 0xaa, 0x48, 0xaf,
-	0x4e, 0xc9, 0x6b, 0x9a, 0x0f, 0xef, 0x3e, 0xde, 0xff, 0xe9, 0x82, 0xed, 0xdf, 0x2e, 0xf0, 0x2e,
-	0x74, 0xd6, 0xf7, 0x0a, 0xd4, 0x65, 0x7b, 0xc5, 0xd1, 0x46, 0xf0, 0x11, 0x3e, 0xa3, 0x88, 0xa9,
-	0xf1, 0xdc, 0xd6, 0xaf, 0xdd, 0x20, 0xff, 0x15, 0xe3, 0xc5, 0x82, 0x21, 0xce, 0x3f, 0x0a, 0x16,
-	0x46, 0xd8, 0xcb, 0x89, 0xd7, 0x1a, 0x24, 0xbb, 0xb9, 0x83, 0x06, 0x95, 0x33, 0xa9, 0xde, 0x2b,
-	0x93, 0x37, 0x85, 0x4c, 0x6a, 0xb7, 0x66, 0x52, 0x2b, 0xe5, 0xf1, 0x12, 0x3e, 0x91, 0x71, 0x7c,
-	0x48, 0x50, 0x82, 0xde, 0x09, 0x44, 0x74, 0x0b, 0x36, 0x09, 0xc7, 0x33, 0x91, 0xc6, 0x68, 0x96,
-	0xb0, 0x15, 0x6f, 0x81, 0x5e, 0xb5, 0x5f, 0xf7, 0x1a, 0x84, 0xe3, 0x69, 0x1a, 0xa3, 0x4f, 0x6c,
-	0xc5, 0x5d, 0x77, 0xff, 0xdf, 0xd4, 0xf6, 0x47, 0x13, 0x1c, 0x8e, 0x26, 0xf8, 0x77, 0x34, 0xc1,
-	0xf6, 0x64, 0x6a, 0x87, 0x93, 0xa9, 0xfd, 0x3e, 0x99, 0xda, 0xe7, 0xe7, 0x38, 0x14, 0xcb, 0x24,
-	0xb0, 0xe7, 0x94, 0x64, 0xab, 0x9a, 0x7d, 0x06, 0x7c, 0xf1, 0xd5, 0x59, 0xab, 0x75, 0x0f, 0x1e,
-	0xca, 0xfe, 0x5e, 0x5c, 0x05, 0x00, 0x00, 0xff, 0xff, 0xd3, 0xb3, 0xf3, 0x46, 0x13, 0x03, 0x00,
-	0x00,
+	// 663 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0x4f, 0x4f, 0x13, 0x41,
+	0x18, 0xc6, 0xbb, 0x6d, 0x41, 0x98, 0x52, 0xd4, 0xb5, 0x87, 0x2d, 0x31, 0x4b, 0xd3, 0xa0, 0xe9,
+	0x85, 0x6d, 0xa8, 0x5e, 0x94, 0x8b, 0x34, 0x28, 0x31, 0x91, 0x83, 0x0b, 0x5c, 0xbc, 0x34, 0xb3,
+	0xdd, 0x97, 0x61, 0x62, 0x77, 0xa7, 0x99, 0x99, 0x95, 0x96, 0xcf, 0xe0, 0x81, 0x9b, 0x7e, 0x10,
+	0x3e, 0x04, 0xf1, 0x44, 0x3c, 0x79, 0xf2, 0x0f, 0x7c, 0x01, 0xbf, 0x81, 0x66, 0x66, 0xa7, 0x85,
+	0x52, 0x62, 0x4b, 0x3c, 0x75, 0x66, 0xde, 0xe7, 0x7d, 0xde, 0x77, 0x7f, 0xef, 0x64, 0x8a, 0x2a,
+	0x6d, 0x26, 0x22, 0x26, 0xea, 0x38, 0x91, 0x07, 0x47, 0xf5, 0x0f, 0x6b, 0x01, 0x48, 0xbc, 0x96,
+	0xee, 0xbc, 0x2e, 0x67, 0x92, 0xd9, 0xa5, 0x54, 0xe1, 0xa5, 0x67, 0x46, 0xb1, 0x54, 0x4e, 0x4f,
+	0x5b, 0x5a, 0x53, 0x37, 0x12, 0xbd, 0x59, 0x5a, 0x26, 0x8c, 0x91, 0x0e, 0xd4, 0xf5, 0x2e, 0x48,
+	0xf6, 0xeb, 0x92, 0x46, 0x20, 0x24, 0x8e, 0xba, 0x46, 0xe0, 0x5e, 0x17, 0x84, 0x09, 0xc7, 0x92,
+	0xb2, 0xd8, 0xc4, 0x4b, 0x84, 0x11, 0x96, 0x1a, 0xab, 0x95, 0x39, 0x2d, 0x5f, 0xcf, 0xc2, 0x71,
+	0xdf, 0x84, 0x56, 0xcc, 0x47, 0x04, 0x58, 0xc0, 0xf0, 0x1b, 0x22, 0x41, 0x5a, 0xfb, 0x14, 0x3a,
+	0xa1, 0xe9, 0xab, 0xba, 0x8e, 0x4a, 0x5b, 0x10, 0x03, 0xa7, 0xed, 0x8d, 0x44, 0x1e, 0x30, 0x4e,
+	0x8f, 0x74, 0x51, 0xfb, 0x1e, 0xca, 0x45, 0x82, 0x38, 0x56, 0xc5, 0xaa, 0xcd, 0xfb, 0x6a, 0xf9,
+	0xfc, 0xfe, 0x97, 0x93, 0xd5, 0xe2, 0x88, 0xa8, 0x2a, 0x50, 0x79, 0x4f, 0x60, 0x02, 0x6f, 0x68,
+	0x44, 0x25, 0x84, 0x13, 0x1c, 0xec, 0x35, 0x54, 0xe2, 0x10, 0x61, 0x1a, 0xd3, 0x98, 0xb4, 0xa0,
+	0x07, 0xed, 0x44, 0x09, 0x85, 0x93, 0xad, 0x58, 0xb5, 0xbc, 0xff, 0x60, 0x18, 0x7b, 0x39, 0x0c,
+	0xdd, 0x54, 0xf4, 0x63, 0x16, 0x39, 0x3e, 0x96, 0xd3, 0x16, 0x7d, 0x84, 0x16, 0x23, 0xdc, 0x1b,
+	0x2f, 0x57, 0x8c, 0x70, 0xef, 0xb2, 0x90, 0xbd, 0x8e, 0x66, 0xbb, 0xc0, 0x29, 0x0b, 0x9d, 0x5c,
+	0xc5, 0xaa, 0x15, 0x1a, 0x65, 0x2f, 0x25, 0xeb, 0x0d, 0xc8, 0x7a, 0x9b, 0x66, 0x1e, 0xcd, 0xb9,
+	0xd3, 0xef, 0xcb, 0x99, 0xcf, 0x3f, 0x96, 0x2d, 0xdf, 0xa4, 0xd8, 0x5b, 0x68, 0xe1, 0x90, 0xc6,
+	0x21, 0x3b, 0x6c, 0x09, 0x89, 0xb9, 0x74, 0xf2, 0xda, 0x62, 0x69, 0xcc, 0x62, 0x77, 0x30, 0x73,
+	0xed, 0x61, 0x1d, 0x2b, 0x8f, 0x42, 0x9a, 0xb9, 0xa3, 0x12, 0x6d, 0x17, 0xa1, 0x2b, 0x8d, 0xce,
+	0xe8, 0x46, 0x11, 0xfc, 0x13, 0xc7, 0x27, 0x0b, 0x3d, 0x7c, 0xa5, 0x26, 0xea, 0x83, 0x90, 0x9c,
+	0xb6, 0xa7, 0x40, 0xe2, 0xa3, 0x05, 0x6e, 0xc4, 0x06, 0x48, 0xae, 0x56, 0x68, 0xd4, 0x3c, 0x73,
+	0x61, 0xd5, 0x85, 0x19, 0x5c, 0x69, 0x6f, 0x5b, 0x90, 0x11, 0x77, 0x05, 0x20, 0xaf, 0x00, 0xf8,
+	0x23, 0x1e, 0x37, 0x75, 0xf6, 0xc7, 0x42, 0x33, 0x5b, 0x1c, 0xc7, 0xd2, 0xde, 0x46, 0x45, 0x7c,
+	0x35, 0xa4, 0x9b, 0x29, 0x34, 0x4a, 0x63, 0x80, 0x36, 0xe2, 0x7e, 0x73, 0xdc, 0xc9, 0x1f, 0xcd,
+	0xb6, 0x37, 0x15, 0xa5, 0x2e, 0x4d, 0xc7, 0xe1, 0x64, 0x6f, 0x01, 0xfb, 0x4a, 0x9e, 0xfd, 0x18,
+	0xdd, 0xc5, 0x9d, 0x8e, 0x9a, 0x59, 0x12, 0xb4, 0x88, 0xea, 0x53, 0x8f, 0x7e, 0xce, 0x2f, 0xea,
+	0xe3, 0x9d, 0x24, 0x48, 0x9b, 0x7f, 0x86, 0x0a, 0x43, 0x05, 0x70, 0x3d, 0xdb, 0xf9, 0xa6, 0xf3,
+	0xf5, 0x64, 0x75, 0xf0, 0x06, 0x6c, 0x84, 0x21, 0x07, 0x21, 0x76, 0x24, 0xa7, 0x31, 0xf1, 0x91,
+	0x30, 0x89, 0xc0, 0xab, 0xbf, 0xb3, 0xc8, 0xd6, 0xeb, 0xd1, 0x89, 0x34, 0xd0, 0x9d, 0x81, 0x9b,
+	0x35, 0xc1, 0x6d, 0x20, 0xbc, 0xcc, 0x01, 0x27, 0x3b, 0x5d, 0x0e, 0x8c, 0x63, 0xcf, 0xfd, 0x17,
+	0xf6, 0x17, 0x23, 0xd8, 0x27, 0xdf, 0xf1, 0xfc, 0x34, 0xc8, 0x67, 0xa6, 0x40, 0x3e, 0x7b, 0x0b,
+	0xe4, 0x4f, 0xd1, 0xa2, 0x5e, 0xbe, 0x4d, 0x20, 0x81, 0xd7, 0x12, 0x22, 0xbb, 0x8a, 0x8a, 0xea,
+	0xd5, 0x93, 0xfd, 0x2e, 0xb4, 0x12, 0xde, 0x11, 0x8e, 0x55, 0xc9, 0xd5, 0xe6, 0xfd, 0x42, 0x24,
+	0xc8, 0x6e, 0xbf, 0x0b, 0x7b, 0xbc, 0x23, 0x9a, 0xcd, 0xd3, 0x5f, 0x6e, 0xe6, 0xf4, 0xdc, 0xb5,
+	0xce, 0xce, 0x5d, 0xeb, 0xe7, 0xb9, 0x6b, 0x1d, 0x5f, 0xb8, 0x99, 0xb3, 0x0b, 0x37, 0xf3, 0xed,
+	0xc2, 0xcd, 0xbc, 0x5b, 0x21, 0x54, 0x1e, 0x24, 0x81, 0xd7, 0x66, 0x91, 0x79, 0xd4, 0xcd, 0xcf,
+	0xaa, 0x08, 0xdf, 0xd7, 0x7b, 0xe9, 0x1f, 0x43, 0x30, 0xab, 0x11, 0x3c, 0xf9, 0x3b, 0x00, 0x09,
+	0x24, 0x82, 0x37, 0x3d, 0x06, 0x00, 0x00,
 }
 
 func (m *GenericAuthorization) Marshal() (dAtA []byte, err error) {
@@ -263,7 +429,7 @@ func (m *GenericAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Grant) Marshal() (dAtA []byte, err error) {
+func (m *UsageLimitedAuthorization) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -273,24 +439,178 @@ func (m *Grant) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Grant) MarshalTo(dAtA []byte) (int, error) {
+func (m *UsageLimitedAuthorization) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UsageLimitedAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Expiration != nil {
-		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+	if m.RemainingExecutions != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.RemainingExecutions))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitedAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitedAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitedAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Executions != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.Executions))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.WindowStart != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WindowStart, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WindowStart):])
 		if err1 != nil {
 			return 0, err1
 		}
 		i -= n1
 		i = encodeVarintAuthz(dAtA, i, uint64(n1))
 		i--
+		dAtA[i] = 0x22
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintAuthz(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if m.MaxExecutions != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.MaxExecutions))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FieldRestrictedAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FieldRestrictedAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FieldRestrictedAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Restrictions) > 0 {
+		for iNdEx := len(m.Restrictions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Restrictions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Grant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Grant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SubGranter) > 0 {
+		i -= len(m.SubGranter)
+		copy(dAtA[i:], m.SubGranter)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.SubGranter)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.AllowSubGrant {
+		i--
+		if m.AllowSubGrant {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Expiration != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintAuthz(dAtA, i, uint64(n3))
+		i--
 		dAtA[i] = 0x12
 	}
 	if m.Authorization != nil {
@@ -328,13 +648,30 @@ func (m *GrantAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SubGranter) > 0 {
+		i -= len(m.SubGranter)
+		copy(dAtA[i:], m.SubGranter)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.SubGranter)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.AllowSubGrant {
+		i--
+		if m.AllowSubGrant {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.Expiration != nil {
-		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
-		if err3 != nil {
-			return 0, err3
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err5 != nil {
+			return 0, err5
 		}
-		i -= n3
-		i = encodeVarintAuthz(dAtA, i, uint64(n3))
+		i -= n5
+		i = encodeVarintAuthz(dAtA, i, uint64(n5))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -423,6 +760,66 @@ func (m *GenericAuthorization) Size() (n int) {
 	return n
 }
 
+func (m *UsageLimitedAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.RemainingExecutions != 0 {
+		n += 1 + sovAuthz(uint64(m.RemainingExecutions))
+	}
+	return n
+}
+
+func (m *RateLimitedAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.MaxExecutions != 0 {
+		n += 1 + sovAuthz(uint64(m.MaxExecutions))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovAuthz(uint64(l))
+	if m.WindowStart != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.WindowStart)
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.Executions != 0 {
+		n += 1 + sovAuthz(uint64(m.Executions))
+	}
+	return n
+}
+
+func (m *FieldRestrictedAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.Restrictions) > 0 {
+		for _, e := range m.Restrictions {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Grant) Size() (n int) {
 	if m == nil {
 		return 0
@@ -437,6 +834,13 @@ func (m *Grant) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
 		n += 1 + l + sovAuthz(uint64(l))
 	}
+	if m.AllowSubGrant {
+		n += 2
+	}
+	l = len(m.SubGranter)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
 	return n
 }
 
@@ -462,6 +866,13 @@ func (m *GrantAuthorization) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
 		n += 1 + l + sovAuthz(uint64(l))
 	}
+	if m.AllowSubGrant {
+		n += 2
+	}
+	l = len(m.SubGranter)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
 	return n
 }
 
@@ -568,7 +979,7 @@ func (m *GenericAuthorization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Grant) Unmarshal(dAtA []byte) error {
+func (m *UsageLimitedAuthorization) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -591,15 +1002,421 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Grant: wiretype end group for non-group")
+			return fmt.Errorf("proto: UsageLimitedAuthorization: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Grant: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UsageLimitedAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authorization", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingExecutions", wireType)
+			}
+			m.RemainingExecutions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingExecutions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitedAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitedAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitedAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExecutions", wireType)
+			}
+			m.MaxExecutions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExecutions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WindowStart == nil {
+				m.WindowStart = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.WindowStart, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executions", wireType)
+			}
+			m.Executions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Executions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FieldRestrictedAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FieldRestrictedAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FieldRestrictedAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restrictions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Restrictions = append(m.Restrictions, types.MsgFieldRestriction{})
+			if err := m.Restrictions[len(m.Restrictions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Grant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Grant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Grant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorization", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -627,7 +1444,7 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Authorization == nil {
-				m.Authorization = &types.Any{}
+				m.Authorization = &types1.Any{}
 			}
 			if err := m.Authorization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -669,6 +1486,58 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowSubGrant", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowSubGrant = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubGranter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubGranter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthz(dAtA[iNdEx:])
@@ -813,7 +1682,7 @@ func (m *GrantAuthorization) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Authorization == nil {
-				m.Authorization = &types.Any{}
+				m.Authorization = &types1.Any{}
 			}
 			if err := m.Authorization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -855,6 +1724,58 @@ func (m *GrantAuthorization) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowSubGrant", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowSubGrant = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubGranter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubGranter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthz(dAtA[iNdEx:])
@@ -26,9 +26,17 @@ const (
 	FlagExpiration        = "expiration"
 	FlagAllowedValidators = "allowed-validators"
 	FlagDenyValidators    = "deny-validators"
+	FlagMaxExecutions     = "max-executions"
+	FlagPeriod            = "period"
+	FlagAllowedField      = "allowed-field"
+	FlagAllowSubGrant     = "allow-sub-grant"
+	FlagSubGrantOf        = "sub-grant-of"
 	delegate              = "delegate"
 	redelegate            = "redelegate"
 	unbond                = "unbond"
+	usageLimited          = "usage-limited"
+	rateLimited           = "rate-limited"
+	fieldRestricted       = "field-restricted"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -54,7 +62,7 @@ func GetTxCmd() *cobra.Command {
 // NewCmdGrantAuthorization returns a CLI command handler for creating a MsgGrant transaction.
 func NewCmdGrantAuthorization() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "grant <grantee> <authorization_type=\"send\"|\"generic\"|\"delegate\"|\"unbond\"|\"redelegate\"> --from <granter>",
+		Use:   "grant <grantee> <authorization_type=\"send\"|\"generic\"|\"delegate\"|\"unbond\"|\"redelegate\"|\"usage-limited\"|\"rate-limited\"|\"field-restricted\"> --from <granter>",
 		Short: "Grant authorization to an address",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`create a new grant authorization to an address to execute a transaction on your behalf:
@@ -62,7 +70,15 @@ func NewCmdGrantAuthorization() *cobra.Command {
 Examples:
  $ %s tx %s grant cosmos1skjw.. send %s --spend-limit=1000stake --from=cosmos1skl..
  $ %s tx %s grant cosmos1skjw.. generic --msg-type=/cosmos.gov.v1.MsgVote --from=cosmos1sk..
-	`, version.AppName, authz.ModuleName, bank.SendAuthorization{}.MsgTypeURL(), version.AppName, authz.ModuleName),
+ $ %s tx %s grant cosmos1skjw.. usage-limited --msg-type=/cosmos.gov.v1.MsgVote --max-executions=10 --from=cosmos1sk..
+ $ %s tx %s grant cosmos1skjw.. rate-limited --msg-type=/cosmos.gov.v1.MsgVote --max-executions=3 --period=24h --from=cosmos1sk..
+ $ %s tx %s grant cosmos1skjw.. field-restricted --msg-type=%s --allowed-field=to_address=cosmos1a..,cosmos1b.. --from=cosmos1sk..
+ $ %s tx %s grant cosmos1skjw.. send --spend-limit=1000stake --allow-sub-grant --from=cosmos1sk..
+ $ %s tx %s grant cosmos1bot.. send --spend-limit=100stake --sub-grant-of=cosmos1sk.. --from=cosmos1skjw..
+	`, version.AppName, authz.ModuleName, bank.SendAuthorization{}.MsgTypeURL(), version.AppName, authz.ModuleName,
+				version.AppName, authz.ModuleName, version.AppName, authz.ModuleName,
+				version.AppName, authz.ModuleName, bank.SendAuthorization{}.MsgTypeURL(),
+				version.AppName, authz.ModuleName, version.AppName, authz.ModuleName),
 		),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -101,6 +117,52 @@ Examples:
 				}
 
 				authorization = authz.NewGenericAuthorization(msgType)
+			case usageLimited:
+				msgType, err := cmd.Flags().GetString(FlagMsgType)
+				if err != nil {
+					return err
+				}
+
+				maxExecutions, err := cmd.Flags().GetUint64(FlagMaxExecutions)
+				if err != nil {
+					return err
+				}
+
+				authorization = authz.NewUsageLimitedAuthorization(msgType, maxExecutions)
+			case rateLimited:
+				msgType, err := cmd.Flags().GetString(FlagMsgType)
+				if err != nil {
+					return err
+				}
+
+				maxExecutions, err := cmd.Flags().GetUint64(FlagMaxExecutions)
+				if err != nil {
+					return err
+				}
+
+				period, err := cmd.Flags().GetDuration(FlagPeriod)
+				if err != nil {
+					return err
+				}
+
+				authorization = authz.NewRateLimitedAuthorization(msgType, maxExecutions, period)
+			case fieldRestricted:
+				msgType, err := cmd.Flags().GetString(FlagMsgType)
+				if err != nil {
+					return err
+				}
+
+				allowedFields, err := cmd.Flags().GetStringArray(FlagAllowedField)
+				if err != nil {
+					return err
+				}
+
+				restrictions, err := parseFieldRestrictions(allowedFields)
+				if err != nil {
+					return err
+				}
+
+				authorization = authz.NewFieldRestrictedAuthorization(msgType, restrictions)
 			case delegate, unbond, redelegate:
 				limit, err := cmd.Flags().GetString(FlagSpendLimit)
 				if err != nil {
@@ -171,10 +233,30 @@ Examples:
 				return err
 			}
 
+			allowSubGrant, err := cmd.Flags().GetBool(FlagAllowSubGrant)
+			if err != nil {
+				return err
+			}
+
+			rootGranter, err := getSubGrantOf(cmd)
+			if err != nil {
+				return err
+			}
+
+			if rootGranter != nil {
+				msg, err := authz.NewMsgSubGrant(rootGranter, clientCtx.GetFromAddress(), grantee, authorization, expire, allowSubGrant)
+				if err != nil {
+					return err
+				}
+
+				return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			}
+
 			msg, err := authz.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, expire)
 			if err != nil {
 				return err
 			}
+			msg.Grant.AllowSubGrant = allowSubGrant
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -185,9 +267,42 @@ Examples:
 	cmd.Flags().StringSlice(FlagAllowedValidators, []string{}, "Allowed validators addresses separated by ,")
 	cmd.Flags().StringSlice(FlagDenyValidators, []string{}, "Deny validators addresses separated by ,")
 	cmd.Flags().Int64(FlagExpiration, 0, "Expire time as Unix timestamp. Set zero (0) for no expiry. Default is 0.")
+	cmd.Flags().Uint64(FlagMaxExecutions, 0, "Maximum number of executions for usage and rate limited authorizations")
+	cmd.Flags().Duration(FlagPeriod, 0, "Period in which at most max-executions executions are allowed for rate limited authorizations")
+	cmd.Flags().StringArray(FlagAllowedField, []string{}, "Allowed values of a Msg field for field restricted authorizations, as <field>=<value>,<value>... (repeatable)")
+	cmd.Flags().Bool(FlagAllowSubGrant, false, "Allow the grantee to sub-grant a narrower authorization to a third party")
+	cmd.Flags().String(FlagSubGrantOf, "", "Sub-grant, out of the grant held by the signer, an authorization on the account of the given granter")
 	return cmd
 }
 
+// getSubGrantOf returns the granter of the sub-grant flag, or nil if the flag is not set.
+func getSubGrantOf(cmd *cobra.Command) (sdk.AccAddress, error) {
+	subGrantOf, err := cmd.Flags().GetString(FlagSubGrantOf)
+	if err != nil || subGrantOf == "" {
+		return nil, err
+	}
+
+	return sdk.AccAddressFromBech32(subGrantOf)
+}
+
+// parseFieldRestrictions parses field restrictions of the form
+// <field>=<value>,<value>...
+func parseFieldRestrictions(allowedFields []string) ([]sdk.MsgFieldRestriction, error) {
+	restrictions := make([]sdk.MsgFieldRestriction, len(allowedFields))
+	for i, allowedField := range allowedFields {
+		field, values, found := strings.Cut(allowedField, "=")
+		if !found || field == "" || values == "" {
+			return nil, fmt.Errorf("invalid allowed field %s, expected <field>=<value>,<value>", allowedField)
+		}
+
+		restrictions[i] = sdk.MsgFieldRestriction{
+			Field:         field,
+			AllowedValues: strings.Split(values, ","),
+		}
+	}
+	return restrictions, nil
+}
+
 func getExpireTime(cmd *cobra.Command) (*time.Time, error) {
 	exp, err := cmd.Flags().GetInt64(FlagExpiration)
 	if err != nil {
@@ -209,7 +324,9 @@ func NewCmdRevokeAuthorization() *cobra.Command {
 			fmt.Sprintf(`revoke authorization from a granter to a grantee:
 Example:
  $ %s tx %s revoke cosmos1skj.. %s --from=cosmos1skj..
-			`, version.AppName, authz.ModuleName, bank.SendAuthorization{}.MsgTypeURL()),
+ $ %s tx %s revoke cosmos1skj.. %s --sub-grant-of=cosmos1a.. --from=cosmos1skj..
+			`, version.AppName, authz.ModuleName, bank.SendAuthorization{}.MsgTypeURL(),
+				version.AppName, authz.ModuleName, bank.SendAuthorization{}.MsgTypeURL()),
 		),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -225,12 +342,24 @@ Example:
 
 			granter := clientCtx.GetFromAddress()
 			msgAuthorized := args[1]
+
+			rootGranter, err := getSubGrantOf(cmd)
+			if err != nil {
+				return err
+			}
+
+			if rootGranter != nil {
+				msg := authz.NewMsgRevokeSubGrant(rootGranter, granter, grantee, msgAuthorized)
+				return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+			}
+
 			msg := authz.NewMsgRevoke(granter, grantee, msgAuthorized)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagSubGrantOf, "", "Revoke a grant sub-granted by the signer on the account of the given granter")
 	return cmd
 }
 
@@ -15,9 +15,14 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgGrant{}, "cosmos-sdk/MsgGrant")
 	legacy.RegisterAminoMsg(cdc, &MsgRevoke{}, "cosmos-sdk/MsgRevoke")
 	legacy.RegisterAminoMsg(cdc, &MsgExec{}, "cosmos-sdk/MsgExec")
+	legacy.RegisterAminoMsg(cdc, &MsgSubGrant{}, "cosmos-sdk/MsgSubGrant")
+	legacy.RegisterAminoMsg(cdc, &MsgRevokeSubGrant{}, "cosmos-sdk/MsgRevokeSubGrant")
 
 	cdc.RegisterInterface((*Authorization)(nil), nil)
 	cdc.RegisterConcrete(&GenericAuthorization{}, "cosmos-sdk/GenericAuthorization", nil)
+	cdc.RegisterConcrete(&UsageLimitedAuthorization{}, "cosmos-sdk/UsageLimitedAuthorization", nil)
+	cdc.RegisterConcrete(&RateLimitedAuthorization{}, "cosmos-sdk/RateLimitedAuthorization", nil)
+	cdc.RegisterConcrete(&FieldRestrictedAuthorization{}, "cosmos-sdk/FieldRestrictedAuthorization", nil)
 }
 
 // RegisterInterfaces registers the interfaces types with the interface registry
@@ -26,12 +31,17 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgGrant{},
 		&MsgRevoke{},
 		&MsgExec{},
+		&MsgSubGrant{},
+		&MsgRevokeSubGrant{},
 	)
 
 	registry.RegisterInterface(
 		"cosmos.v1beta1.Authorization",
 		(*Authorization)(nil),
 		&GenericAuthorization{},
+		&UsageLimitedAuthorization{},
+		&RateLimitedAuthorization{},
+		&FieldRestrictedAuthorization{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, MsgServiceDesc())
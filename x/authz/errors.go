@@ -22,4 +22,6 @@ var (
 	ErrAuthorizationNumOfSigners = sdkerrors.Register(ModuleName, 9, "authorization can be given to msg with only one signer")
 	// ErrNegativeMaxTokens error if the max tokens is negative
 	ErrNegativeMaxTokens = sdkerrors.Register(ModuleName, 12, "max tokens should be positive")
+	// ErrInvalidSubGrant error if a sub-grant is not permitted by its parent grant
+	ErrInvalidSubGrant = sdkerrors.Register(ModuleName, 13, "invalid sub-grant")
 )
@@ -0,0 +1,73 @@
+package authz
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ SubAuthorization = &FieldRestrictedAuthorization{}
+
+// NewFieldRestrictedAuthorization creates a new FieldRestrictedAuthorization object.
+func NewFieldRestrictedAuthorization(msgTypeURL string, restrictions []sdk.MsgFieldRestriction) *FieldRestrictedAuthorization {
+	return &FieldRestrictedAuthorization{
+		Msg:          msgTypeURL,
+		Restrictions: restrictions,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a FieldRestrictedAuthorization) MsgTypeURL() string {
+	return a.Msg
+}
+
+// Accept implements Authorization.Accept.
+func (a FieldRestrictedAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (AcceptResponse, error) {
+	for _, restriction := range a.Restrictions {
+		if err := restriction.Check(ctx, msg); err != nil {
+			return AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap(err.Error())
+		}
+	}
+
+	return AcceptResponse{Accept: true}, nil
+}
+
+// IsSubsetOf implements SubAuthorization.IsSubsetOf. Every field restricted by
+// the parent must be restricted by the authorization to a subset of the
+// values allowed by the parent, and the authorization may restrict more fields.
+func (a FieldRestrictedAuthorization) IsSubsetOf(parent Authorization) bool {
+	p, ok := parent.(*FieldRestrictedAuthorization)
+	if !ok || a.Msg != p.Msg {
+		return false
+	}
+
+	restrictions := make(map[string]sdk.MsgFieldRestriction, len(a.Restrictions))
+	for _, restriction := range a.Restrictions {
+		restrictions[restriction.Field] = restriction
+	}
+	for _, parentRestriction := range p.Restrictions {
+		restriction, found := restrictions[parentRestriction.Field]
+		if !found || !restriction.IsSubsetOf(parentRestriction) {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a FieldRestrictedAuthorization) ValidateBasic() error {
+	if len(a.Restrictions) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("restrictions cannot be empty")
+	}
+
+	fields := make(map[string]bool, len(a.Restrictions))
+	for _, restriction := range a.Restrictions {
+		if err := restriction.ValidateBasic(); err != nil {
+			return err
+		}
+		if fields[restriction.Field] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate restriction for field %s", restriction.Field)
+		}
+		fields[restriction.Field] = true
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package authz_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestFieldRestrictedAuthorization(t *testing.T) {
+	ctx := testutil.DefaultContext(sdk.NewKVStoreKey("authz"), sdk.NewTransientStoreKey("transient_test"))
+	msgTypeURL := banktypes.SendAuthorization{}.MsgTypeURL()
+	from, to, other := sdk.AccAddress("from"), sdk.AccAddress("to"), sdk.AccAddress("other")
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+
+	require.Error(t, authz.NewFieldRestrictedAuthorization(msgTypeURL, nil).ValidateBasic())
+	require.Error(t, authz.NewFieldRestrictedAuthorization(msgTypeURL, []sdk.MsgFieldRestriction{{Field: "to_address"}}).ValidateBasic())
+	require.Error(t, authz.NewFieldRestrictedAuthorization(msgTypeURL, []sdk.MsgFieldRestriction{
+		{Field: "to_address", AllowedValues: []string{to.String()}},
+		{Field: "to_address", AllowedValues: []string{other.String()}},
+	}).ValidateBasic())
+
+	a := authz.NewFieldRestrictedAuthorization(msgTypeURL, []sdk.MsgFieldRestriction{
+		{Field: "to_address", AllowedValues: []string{to.String()}},
+		{Field: "amount.denom", AllowedValues: []string{"stake"}},
+	})
+	require.NoError(t, a.ValidateBasic())
+
+	testCases := []struct {
+		name   string
+		msg    sdk.Msg
+		expErr bool
+	}{
+		{"allowed recipient", banktypes.NewMsgSend(from, to, coins), false},
+		{"recipient not allowed", banktypes.NewMsgSend(from, other, coins), true},
+		{"denom not allowed", banktypes.NewMsgSend(from, to, coins.Add(sdk.NewInt64Coin("atom", 1))), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := a.Accept(ctx, tc.msg)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.True(t, resp.Accept)
+				require.False(t, resp.Delete)
+				require.Nil(t, resp.Updated)
+			}
+		})
+	}
+
+	t.Log("verify the marshalled msg is charged per byte for every restriction")
+	msg := banktypes.NewMsgSend(from, to, coins)
+	bz, err := codec.ProtoMarshalJSON(msg, nil)
+	require.NoError(t, err)
+	gasCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	_, err = a.Accept(gasCtx, msg)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, gasCtx.GasMeter().GasConsumed(), 2*uint64(len(bz)))
+
+	t.Log("verify restrictions on unknown and non scalar fields are rejected")
+	_, err = authz.NewFieldRestrictedAuthorization(msgTypeURL, []sdk.MsgFieldRestriction{
+		{Field: "recipient", AllowedValues: []string{to.String()}},
+	}).Accept(ctx, banktypes.NewMsgSend(from, to, coins))
+	require.Error(t, err)
+	_, err = authz.NewFieldRestrictedAuthorization(msgTypeURL, []sdk.MsgFieldRestriction{
+		{Field: "amount", AllowedValues: []string{"10stake"}},
+	}).Accept(ctx, banktypes.NewMsgSend(from, to, coins))
+	require.Error(t, err)
+}
+
+func TestFieldRestrictedAuthorizationIsSubsetOf(t *testing.T) {
+	msgTypeURL := banktypes.SendAuthorization{}.MsgTypeURL()
+	to, other := sdk.AccAddress("to").String(), sdk.AccAddress("other").String()
+	parent := authz.NewFieldRestrictedAuthorization(msgTypeURL, []sdk.MsgFieldRestriction{
+		{Field: "to_address", AllowedValues: []string{to, other}},
+	})
+
+	testCases := []struct {
+		name         string
+		restrictions []sdk.MsgFieldRestriction
+		expSubset    bool
+	}{
+		{"same restrictions", parent.Restrictions, true},
+		{"fewer values", []sdk.MsgFieldRestriction{{Field: "to_address", AllowedValues: []string{to}}}, true},
+		{"more fields", []sdk.MsgFieldRestriction{
+			{Field: "to_address", AllowedValues: []string{to}},
+			{Field: "amount.denom", AllowedValues: []string{"stake"}},
+		}, true},
+		{"value not allowed by the parent", []sdk.MsgFieldRestriction{{Field: "to_address", AllowedValues: []string{to, "third"}}}, false},
+		{"field restricted by the parent missing", []sdk.MsgFieldRestriction{{Field: "amount.denom", AllowedValues: []string{"stake"}}}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sub := authz.NewFieldRestrictedAuthorization(msgTypeURL, tc.restrictions)
+			require.Equal(t, tc.expSubset, authz.IsSubAuthorization(sub, parent))
+		})
+	}
+}
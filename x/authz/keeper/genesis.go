@@ -22,7 +22,14 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *authz.GenesisState) {
 			panic("expected authorization")
 		}
 
-		err := k.SaveGrant(ctx, grantee, granter, a, entry.Expiration)
+		grant, err := authz.NewGrant(now, a, entry.Expiration)
+		if err != nil {
+			panic(err)
+		}
+		grant.AllowSubGrant = entry.AllowSubGrant
+		grant.SubGranter = entry.SubGranter
+
+		err = k.saveGrant(ctx, grantee, granter, a.MsgTypeURL(), grant)
 		if err != nil {
 			panic(err)
 		}
@@ -38,6 +45,8 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *authz.GenesisState {
 			Grantee:       grantee.String(),
 			Expiration:    grant.Expiration,
 			Authorization: grant.Authorization,
+			AllowSubGrant: grant.AllowSubGrant,
+			SubGranter:    grant.SubGranter,
 		})
 		return false
 	})
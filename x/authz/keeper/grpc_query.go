@@ -120,6 +120,8 @@ func (k Keeper) GranterGrants(c context.Context, req *authz.QueryGranterGrantsRe
 			Grantee:       grantee.String(),
 			Authorization: any,
 			Expiration:    auth.Expiration,
+			AllowSubGrant: auth.AllowSubGrant,
+			SubGranter:    auth.SubGranter,
 		}, nil
 	}, func() *authz.Grant {
 		return &authz.Grant{}
@@ -169,6 +171,8 @@ func (k Keeper) GranteeGrants(c context.Context, req *authz.QueryGranteeGrantsRe
 			Expiration:    auth.Expiration,
 			Granter:       granter.String(),
 			Grantee:       grantee.String(),
+			AllowSubGrant: auth.AllowSubGrant,
+			SubGranter:    auth.SubGranter,
 		}, nil
 	}, func() *authz.Grant {
 		return &authz.Grant{}
@@ -24,6 +24,11 @@ import (
 // https://github.com/cosmos/cosmos-sdk/discussions/9072
 const gasCostPerIteration = uint64(20)
 
+// MaxSubGrantDepth is the maximum number of sub-grants in a chain leading from
+// a grant issued by a granter. It bounds the chain of grants checked by
+// MsgExec and the grants revoked along with a grant.
+const MaxSubGrantDepth = 8
+
 type Keeper struct {
 	storeKey   storetypes.StoreKey
 	cdc        codec.BinaryCodec
@@ -81,8 +86,39 @@ func (k Keeper) update(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccA
 	return nil
 }
 
+// acceptGrant calls Accept on the authorization of the grant to the grantee and
+// updates or deletes the grant accordingly.
+func (k Keeper) acceptGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, grant authz.Grant, msg sdk.Msg) error {
+	authorization, err := grant.GetAuthorization()
+	if err != nil {
+		return err
+	}
+
+	resp, err := authorization.Accept(ctx, msg)
+	if err != nil {
+		return err
+	}
+
+	if resp.Delete {
+		err = k.DeleteGrant(ctx, grantee, granter, sdk.MsgTypeURL(msg))
+	} else if resp.Updated != nil {
+		err = k.update(ctx, grantee, granter, resp.Updated)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !resp.Accept {
+		return sdkerrors.ErrUnauthorized
+	}
+
+	return nil
+}
+
 // DispatchActions attempts to execute the provided messages via authorization
-// grants from the message signer to the grantee.
+// grants from the message signer to the grantee. Grants sub-granted to the
+// grantee are only honoured if all grants they were sub-granted from accept the
+// messages too.
 func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []sdk.Msg) ([][]byte, error) {
 	results := make([][]byte, len(msgs))
 	now := ctx.BlockTime()
@@ -109,27 +145,30 @@ func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []
 				return nil, authz.ErrAuthorizationExpired
 			}
 
-			authorization, err := grant.GetAuthorization()
-			if err != nil {
+			if err := k.acceptGrant(ctx, grantee, granter, grant, msg); err != nil {
 				return nil, err
 			}
 
-			resp, err := authorization.Accept(ctx, msg)
-			if err != nil {
-				return nil, err
-			}
-
-			if resp.Delete {
-				err = k.DeleteGrant(ctx, grantee, granter, sdk.MsgTypeURL(msg))
-			} else if resp.Updated != nil {
-				err = k.update(ctx, grantee, granter, resp.Updated)
-			}
-			if err != nil {
-				return nil, err
-			}
-
-			if !resp.Accept {
-				return nil, sdkerrors.ErrUnauthorized
+			// Follow the chain of sub-grants back to the grant issued by the
+			// granter, every grant along the chain has to accept the msg.
+			for grant.SubGranter != "" {
+				subGranter, err := sdk.AccAddressFromBech32(grant.SubGranter)
+				if err != nil {
+					return nil, err
+				}
+
+				grant, found = k.getGrant(ctx, grantStoreKey(subGranter, granter, sdk.MsgTypeURL(msg)))
+				if !found || !grant.AllowSubGrant {
+					return nil, sdkerrors.Wrapf(authz.ErrNoAuthorizationFound, "no parent grant to %s allowing sub-grants", subGranter)
+				}
+
+				if grant.Expiration != nil && grant.Expiration.Before(now) {
+					return nil, authz.ErrAuthorizationExpired
+				}
+
+				if err := k.acceptGrant(ctx, subGranter, granter, grant, msg); err != nil {
+					return nil, err
+				}
 			}
 		}
 
@@ -163,29 +202,132 @@ func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []
 
 // SaveGrant method grants the provided authorization to the grantee on the granter's account
 // with the provided expiration time and insert authorization key into the grants queue. If there is an existing authorization grant for the
-// same `sdk.Msg` type, this grant overwrites that.
+// same `sdk.Msg` type, this grant overwrites that, revoking the grants sub-granted from it which don't fit into the new grant.
 func (k Keeper) SaveGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, authorization authz.Authorization, expiration *time.Time) error {
-	store := ctx.KVStore(k.storeKey)
+	grant, err := authz.NewGrant(ctx.BlockTime(), authorization, expiration)
+	if err != nil {
+		return err
+	}
+
+	return k.saveGrant(ctx, grantee, granter, authorization.MsgTypeURL(), grant)
+}
+
+// SaveSubGrant grants the provided authorization to the grantee on the granter's account
+// out of the grant held by the subGranter. The grant held by the subGranter must allow
+// sub-grants, and the new grant may neither permit more than it nor outlive it nor be
+// more than MaxSubGrantDepth sub-grants away from the granter's grant. If the
+// grantee already holds a grant for the same `sdk.Msg` type sub-granted by the subGranter,
+// this grant overwrites that.
+func (k Keeper) SaveSubGrant(ctx sdk.Context, grantee, subGranter, granter sdk.AccAddress, authorization authz.Authorization, expiration *time.Time, allowSubGrant bool) error {
 	msgType := authorization.MsgTypeURL()
-	skey := grantStoreKey(grantee, granter, msgType)
+	parent, found := k.getGrant(ctx, grantStoreKey(subGranter, granter, msgType))
+	if !found {
+		return sdkerrors.Wrapf(authz.ErrNoAuthorizationFound, "%s holds no grant for %s", subGranter, msgType)
+	}
+
+	if parent.Expiration != nil && parent.Expiration.Before(ctx.BlockTime()) {
+		return authz.ErrAuthorizationExpired
+	}
+
+	if err := checkSubGrant(parent, authorization, expiration); err != nil {
+		return err
+	}
+
+	depth, err := k.subGrantDepth(ctx, granter, msgType, parent)
+	if err != nil {
+		return err
+	}
+	if depth >= MaxSubGrantDepth {
+		return authz.ErrInvalidSubGrant.Wrapf("sub-grant chain can't be longer than %d", MaxSubGrantDepth)
+	}
+
+	if existing, found := k.getGrant(ctx, grantStoreKey(grantee, granter, msgType)); found && existing.SubGranter != subGranter.String() {
+		return authz.ErrInvalidSubGrant.Wrapf("%s already holds a grant for %s not issued by %s", grantee, msgType, subGranter)
+	}
 
 	grant, err := authz.NewGrant(ctx.BlockTime(), authorization, expiration)
 	if err != nil {
 		return err
 	}
+	grant.AllowSubGrant = allowSubGrant
+	grant.SubGranter = subGranter.String()
+
+	return k.saveGrant(ctx, grantee, granter, msgType, grant)
+}
+
+// subGrantDepth returns the number of sub-grants in the chain leading from the
+// grant issued by the granter to the provided grant.
+func (k Keeper) subGrantDepth(ctx sdk.Context, granter sdk.AccAddress, msgType string, grant authz.Grant) (int, error) {
+	depth := 0
+	for grant.SubGranter != "" {
+		subGranter, err := sdk.AccAddressFromBech32(grant.SubGranter)
+		if err != nil {
+			return 0, err
+		}
+
+		var found bool
+		grant, found = k.getGrant(ctx, grantStoreKey(subGranter, granter, msgType))
+		if !found {
+			return 0, sdkerrors.Wrapf(authz.ErrNoAuthorizationFound, "no parent grant to %s", subGranter)
+		}
+		depth++
+	}
+
+	return depth, nil
+}
+
+// checkSubGrant returns an error if the authorization with the provided expiration
+// can't be sub-granted out of the parent grant.
+func checkSubGrant(parent authz.Grant, authorization authz.Authorization, expiration *time.Time) error {
+	if !parent.AllowSubGrant {
+		return authz.ErrInvalidSubGrant.Wrap("parent grant does not allow sub-grants")
+	}
+
+	if parent.Expiration != nil && (expiration == nil || expiration.After(*parent.Expiration)) {
+		return authz.ErrInvalidSubGrant.Wrapf("expiration must not be after the parent grant expiration %v", parent.Expiration.Format(time.RFC3339))
+	}
+
+	parentAuthorization, err := parent.GetAuthorization()
+	if err != nil {
+		return err
+	}
+
+	_, genericParent := parentAuthorization.(*authz.GenericAuthorization)
+	if _, ok := authorization.(authz.SubAuthorization); !ok && !genericParent {
+		return authz.ErrInvalidSubGrant.Wrapf("%T can only be sub-granted out of a generic authorization", authorization)
+	}
+	if !authz.IsSubAuthorization(authorization, parentAuthorization) {
+		return authz.ErrInvalidSubGrant.Wrap("authorization exceeds the parent grant authorization")
+	}
+
+	return nil
+}
+
+// saveGrant stores the grant, keeping the grant queue and the sub-grant index
+// up to date.
+func (k Keeper) saveGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, msgType string, grant authz.Grant) error {
+	store := ctx.KVStore(k.storeKey)
+	skey := grantStoreKey(grantee, granter, msgType)
+	expiration := grant.Expiration
 
 	var oldExp *time.Time
-	if oldGrant, found := k.getGrant(ctx, skey); found {
+	oldGrant, overwritten := k.getGrant(ctx, skey)
+	if overwritten {
 		oldExp = oldGrant.Expiration
+		if oldGrant.SubGranter != "" && oldGrant.SubGranter != grant.SubGranter {
+			if err := k.deleteSubGrantIndex(ctx, grantee, granter, msgType, oldGrant.SubGranter); err != nil {
+				return err
+			}
+		}
 	}
 	if oldExp != nil && (expiration == nil || !oldExp.Equal(*expiration)) {
-		if err = k.removeFromGrantQueue(ctx, skey, granter, grantee, *oldExp); err != nil {
+		if err := k.removeFromGrantQueue(ctx, skey, granter, grantee, *oldExp); err != nil {
 			return err
 		}
 	}
 	// If the expiration didn't change, then we don't remove it and we should not insert again
 	if expiration != nil && (oldExp == nil || !oldExp.Equal(*expiration)) {
-		if err = k.insertIntoGrantQueue(ctx, granter, grantee, msgType, *expiration); err != nil {
+		if err := k.insertIntoGrantQueue(ctx, granter, grantee, msgType, *expiration); err != nil {
 			return err
 		}
 	}
@@ -193,15 +335,34 @@ func (k Keeper) SaveGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, auth
 	bz := k.cdc.MustMarshal(&grant)
 	store.Set(skey, bz)
 
-	return ctx.EventManager().EmitTypedEvent(&authz.EventGrant{
-		MsgTypeUrl: authorization.MsgTypeURL(),
+	if grant.SubGranter != "" {
+		subGranter, err := sdk.AccAddressFromBech32(grant.SubGranter)
+		if err != nil {
+			return err
+		}
+		store.Set(subGrantKey(granter, subGranter, grantee, msgType), []byte{0x01})
+	}
+
+	err := ctx.EventManager().EmitTypedEvent(&authz.EventGrant{
+		MsgTypeUrl: msgType,
 		Granter:    granter.String(),
 		Grantee:    grantee.String(),
 	})
+	if err != nil {
+		return err
+	}
+
+	if !overwritten {
+		return nil
+	}
+
+	// the grant may have been narrowed, so the grants sub-granted from it must
+	// still fit into it
+	return k.deleteInvalidSubGrants(ctx, grantee, granter, msgType, grant)
 }
 
 // DeleteGrant revokes any authorization for the provided message type granted to the grantee
-// by the granter.
+// by the granter. All grants sub-granted from it are revoked as well.
 func (k Keeper) DeleteGrant(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress, msgType string) error {
 	store := ctx.KVStore(k.storeKey)
 	skey := grantStoreKey(grantee, granter, msgType)
@@ -219,11 +380,111 @@ func (k Keeper) DeleteGrant(ctx sdk.Context, grantee sdk.AccAddress, granter sdk
 
 	store.Delete(skey)
 
-	return ctx.EventManager().EmitTypedEvent(&authz.EventRevoke{
+	if grant.SubGranter != "" {
+		if err := k.deleteSubGrantIndex(ctx, grantee, granter, msgType, grant.SubGranter); err != nil {
+			return err
+		}
+	}
+
+	err := ctx.EventManager().EmitTypedEvent(&authz.EventRevoke{
 		MsgTypeUrl: msgType,
 		Granter:    granter.String(),
 		Grantee:    grantee.String(),
 	})
+	if err != nil {
+		return err
+	}
+
+	return k.deleteSubGrants(ctx, grantee, granter, msgType)
+}
+
+// DeleteSubGrant revokes the authorization for the provided message type sub-granted to
+// the grantee by the subGranter on the granter's account, together with all grants
+// sub-granted from it.
+func (k Keeper) DeleteSubGrant(ctx sdk.Context, grantee, subGranter, granter sdk.AccAddress, msgType string) error {
+	grant, found := k.getGrant(ctx, grantStoreKey(grantee, granter, msgType))
+	if !found || grant.SubGranter != subGranter.String() {
+		return sdkerrors.Wrapf(authz.ErrNoAuthorizationFound, "%s has not sub-granted %s to %s", subGranter, msgType, grantee)
+	}
+
+	return k.DeleteGrant(ctx, grantee, granter, msgType)
+}
+
+// deleteSubGrants revokes all grants for the provided message type sub-granted by
+// the subGranter on the granter's account.
+func (k Keeper) deleteSubGrants(ctx sdk.Context, subGranter, granter sdk.AccAddress, msgType string) error {
+	store := ctx.KVStore(k.storeKey)
+	prefix := subGrantPrefixKey(granter, subGranter)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+
+	var grantees []sdk.AccAddress
+	for ; iter.Valid(); iter.Next() {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "sub-grants")
+
+		grantee, typeURL := parseSubGrantKey(prefix, iter.Key())
+		if typeURL == msgType {
+			grantees = append(grantees, grantee)
+		}
+	}
+	iter.Close()
+
+	for _, grantee := range grantees {
+		if err := k.DeleteGrant(ctx, grantee, granter, msgType); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteInvalidSubGrants revokes all grants for the provided message type sub-granted
+// by the subGranter on the granter's account which can't be sub-granted out of the
+// parent grant anymore.
+func (k Keeper) deleteInvalidSubGrants(ctx sdk.Context, subGranter, granter sdk.AccAddress, msgType string, parent authz.Grant) error {
+	store := ctx.KVStore(k.storeKey)
+	prefix := subGrantPrefixKey(granter, subGranter)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+
+	var grantees []sdk.AccAddress
+	for ; iter.Valid(); iter.Next() {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "sub-grants")
+
+		grantee, typeURL := parseSubGrantKey(prefix, iter.Key())
+		if typeURL != msgType {
+			continue
+		}
+
+		grant, found := k.getGrant(ctx, grantStoreKey(grantee, granter, msgType))
+		if !found {
+			continue
+		}
+
+		authorization, err := grant.GetAuthorization()
+		if err != nil || checkSubGrant(parent, authorization, grant.Expiration) != nil {
+			grantees = append(grantees, grantee)
+		}
+	}
+	iter.Close()
+
+	for _, grantee := range grantees {
+		if err := k.DeleteGrant(ctx, grantee, granter, msgType); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteSubGrantIndex removes the grant to the grantee from the index of grants
+// sub-granted by subGranter.
+func (k Keeper) deleteSubGrantIndex(ctx sdk.Context, grantee, granter sdk.AccAddress, msgType, subGranter string) error {
+	subGranterAddr, err := sdk.AccAddressFromBech32(subGranter)
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Delete(subGrantKey(granter, subGranterAddr, grantee, msgType))
+	return nil
 }
 
 // GetAuthorizations Returns list of `Authorizations` granted to the grantee by the granter.
@@ -373,28 +634,57 @@ func (keeper Keeper) removeFromGrantQueue(ctx sdk.Context, grantKey []byte, gran
 	return nil
 }
 
-// DequeueAndDeleteExpiredGrants deletes expired grants from the state and grant queue.
+// DequeueAndDeleteExpiredGrants deletes expired grants from the state and grant queue,
+// together with all grants sub-granted from them.
 func (k Keeper) DequeueAndDeleteExpiredGrants(ctx sdk.Context) error {
 	store := ctx.KVStore(k.storeKey)
 
+	// the queue keys are collected first, as deleting sub-grants updates the
+	// grant queue.
+	var queueKeys [][]byte
 	iterator := store.Iterator(GrantQueuePrefix, sdk.InclusiveEndBytes(GrantQueueTimePrefix(ctx.BlockTime())))
-	defer iterator.Close()
-
 	for ; iterator.Valid(); iterator.Next() {
+		queueKeys = append(queueKeys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, queueKey := range queueKeys {
+		// the queue item may have been updated by the deletion of sub-grants
+		// of a grant expired before.
+		bz := store.Get(queueKey)
+		if bz == nil {
+			continue
+		}
+
 		var queueItem authz.GrantQueueItem
-		if err := k.cdc.Unmarshal(iterator.Value(), &queueItem); err != nil {
+		if err := k.cdc.Unmarshal(bz, &queueItem); err != nil {
 			return err
 		}
 
-		_, granter, grantee, err := parseGrantQueueKey(iterator.Key())
+		_, granter, grantee, err := parseGrantQueueKey(queueKey)
 		if err != nil {
 			return err
 		}
 
-		store.Delete(iterator.Key())
+		store.Delete(queueKey)
 
 		for _, typeURL := range queueItem.MsgTypeUrls {
-			store.Delete(grantStoreKey(grantee, granter, typeURL))
+			skey := grantStoreKey(grantee, granter, typeURL)
+			grant, found := k.getGrant(ctx, skey)
+			if !found {
+				continue
+			}
+
+			if grant.SubGranter != "" {
+				if err := k.deleteSubGrantIndex(ctx, grantee, granter, typeURL, grant.SubGranter); err != nil {
+					return err
+				}
+			}
+			store.Delete(skey)
+
+			if err := k.deleteSubGrants(ctx, grantee, granter, typeURL); err != nil {
+				return err
+			}
 		}
 	}
 
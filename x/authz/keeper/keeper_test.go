@@ -12,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
@@ -320,6 +321,274 @@ func (s *TestSuite) TestDispatchedEvents() {
 	}
 }
 
+func (s *TestSuite) TestDispatchActionUsageLimited() {
+	require := s.Require()
+	app, addrs := s.app, s.addrs
+	granterAddr := addrs[0]
+	granteeAddr := addrs[1]
+	recipientAddr := addrs[2]
+	require.NoError(testutil.FundAccount(app.BankKeeper, s.ctx, granterAddr, coins1000))
+
+	msgs := []sdk.Msg{banktypes.NewMsgSend(granterAddr, recipientAddr, coins10)}
+	err := app.AuthzKeeper.SaveGrant(s.ctx, granteeAddr, granterAddr, authz.NewUsageLimitedAuthorization(bankSendAuthMsgType, 2), nil)
+	require.NoError(err)
+
+	_, err = app.AuthzKeeper.DispatchActions(s.ctx, granteeAddr, msgs)
+	require.NoError(err)
+	authorization, _ := app.AuthzKeeper.GetAuthorization(s.ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
+	require.Equal(uint64(1), authorization.(*authz.UsageLimitedAuthorization).RemainingExecutions)
+
+	// the grant is deleted after the last execution
+	_, err = app.AuthzKeeper.DispatchActions(s.ctx, granteeAddr, msgs)
+	require.NoError(err)
+	authorization, _ = app.AuthzKeeper.GetAuthorization(s.ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
+	require.Nil(authorization)
+	_, err = app.AuthzKeeper.DispatchActions(s.ctx, granteeAddr, msgs)
+	require.Error(err)
+}
+
+func (s *TestSuite) TestSubGrant() {
+	require := s.Require()
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	goCtx := sdk.WrapSDKContext(ctx)
+	custodyAddr, opsAddr, botAddr, bot2Addr, recipientAddr := addrs[0], addrs[1], addrs[2], addrs[3], addrs[4]
+	require.NoError(testutil.FundAccount(app.BankKeeper, ctx, custodyAddr, coins1000))
+	expire := ctx.BlockTime().AddDate(1, 0, 0)
+	sendAuthorization := func(amount int64) authz.Authorization {
+		return banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", amount)))
+	}
+	spendLimit := func(grantee sdk.AccAddress) sdk.Coins {
+		authorization, _ := app.AuthzKeeper.GetAuthorization(ctx, grantee, custodyAddr, bankSendAuthMsgType)
+		if authorization == nil {
+			return nil
+		}
+		return authorization.(*banktypes.SendAuthorization).SpendLimit
+	}
+
+	s.T().Log("verify sub-grants require a parent grant allowing them")
+	err := app.AuthzKeeper.SaveSubGrant(ctx, botAddr, opsAddr, custodyAddr, sendAuthorization(10), nil, false)
+	require.ErrorIs(err, authz.ErrNoAuthorizationFound)
+	require.NoError(app.AuthzKeeper.SaveGrant(ctx, opsAddr, custodyAddr, sendAuthorization(100), &expire))
+	err = app.AuthzKeeper.SaveSubGrant(ctx, botAddr, opsAddr, custodyAddr, sendAuthorization(10), &expire, false)
+	require.ErrorIs(err, authz.ErrInvalidSubGrant)
+
+	msg, err := authz.NewMsgGrant(custodyAddr, opsAddr, sendAuthorization(100), &expire)
+	require.NoError(err)
+	msg.Grant.AllowSubGrant = true
+	_, err = app.AuthzKeeper.Grant(goCtx, msg)
+	require.NoError(err)
+
+	s.T().Log("verify sub-grants must be narrower than the parent grant")
+	err = app.AuthzKeeper.SaveSubGrant(ctx, botAddr, opsAddr, custodyAddr, sendAuthorization(200), &expire, true)
+	require.ErrorIs(err, authz.ErrInvalidSubGrant)
+	err = app.AuthzKeeper.SaveSubGrant(ctx, botAddr, opsAddr, custodyAddr, sendAuthorization(50), nil, true)
+	require.ErrorIs(err, authz.ErrInvalidSubGrant)
+	later := expire.Add(time.Hour)
+	err = app.AuthzKeeper.SaveSubGrant(ctx, botAddr, opsAddr, custodyAddr, sendAuthorization(50), &later, true)
+	require.ErrorIs(err, authz.ErrInvalidSubGrant)
+	err = app.AuthzKeeper.SaveSubGrant(ctx, botAddr, opsAddr, custodyAddr, authz.NewGenericAuthorization(bankSendAuthMsgType), &expire, true)
+	require.ErrorIs(err, authz.ErrInvalidSubGrant)
+	require.ErrorContains(err, "can only be sub-granted out of a generic authorization")
+	err = app.AuthzKeeper.SaveSubGrant(ctx, botAddr, opsAddr, custodyAddr, sendAuthorization(50), &expire, true)
+	require.NoError(err)
+	err = app.AuthzKeeper.SaveSubGrant(ctx, bot2Addr, botAddr, custodyAddr, sendAuthorization(20), &expire, false)
+	require.NoError(err)
+	err = app.AuthzKeeper.SaveSubGrant(ctx, opsAddr, bot2Addr, custodyAddr, sendAuthorization(10), &expire, false)
+	require.ErrorIs(err, authz.ErrInvalidSubGrant)
+
+	s.T().Log("verify executions are charged along the whole chain")
+	_, err = app.AuthzKeeper.DispatchActions(ctx, botAddr, []sdk.Msg{banktypes.NewMsgSend(custodyAddr, recipientAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 30)))})
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 70)), spendLimit(opsAddr))
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 20)), spendLimit(botAddr))
+
+	_, err = app.AuthzKeeper.DispatchActions(ctx, bot2Addr, []sdk.Msg{banktypes.NewMsgSend(custodyAddr, recipientAddr, coins10)})
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 60)), spendLimit(opsAddr))
+	require.Equal(coins10, spendLimit(botAddr))
+	require.Equal(coins10, spendLimit(bot2Addr))
+
+	_, err = app.AuthzKeeper.DispatchActions(ctx, bot2Addr, []sdk.Msg{banktypes.NewMsgSend(custodyAddr, recipientAddr, coins10)})
+	require.NoError(err)
+	require.Nil(spendLimit(botAddr))
+	require.Nil(spendLimit(bot2Addr))
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), spendLimit(opsAddr))
+
+	s.T().Log("verify revoking a sub-grant revokes everything under it")
+	require.NoError(app.AuthzKeeper.SaveSubGrant(ctx, botAddr, opsAddr, custodyAddr, sendAuthorization(50), &expire, true))
+	require.NoError(app.AuthzKeeper.SaveSubGrant(ctx, bot2Addr, botAddr, custodyAddr, sendAuthorization(20), &expire, false))
+	_, err = app.AuthzKeeper.RevokeSubGrant(goCtx, &authz.MsgRevokeSubGrant{
+		Granter: custodyAddr.String(), SubGranter: botAddr.String(), Grantee: opsAddr.String(), MsgTypeUrl: bankSendAuthMsgType,
+	})
+	require.ErrorIs(err, authz.ErrNoAuthorizationFound)
+	_, err = app.AuthzKeeper.RevokeSubGrant(goCtx, &authz.MsgRevokeSubGrant{
+		Granter: custodyAddr.String(), SubGranter: opsAddr.String(), Grantee: botAddr.String(), MsgTypeUrl: bankSendAuthMsgType,
+	})
+	require.NoError(err)
+	require.Nil(spendLimit(botAddr))
+	require.Nil(spendLimit(bot2Addr))
+	require.NotNil(spendLimit(opsAddr))
+
+	s.T().Log("verify revoking the root grant revokes the whole tree")
+	require.NoError(app.AuthzKeeper.SaveSubGrant(ctx, botAddr, opsAddr, custodyAddr, sendAuthorization(50), &expire, true))
+	require.NoError(app.AuthzKeeper.SaveSubGrant(ctx, bot2Addr, botAddr, custodyAddr, sendAuthorization(20), &expire, false))
+	require.NoError(app.AuthzKeeper.DeleteGrant(ctx, opsAddr, custodyAddr, bankSendAuthMsgType))
+	require.Nil(spendLimit(opsAddr))
+	require.Nil(spendLimit(botAddr))
+	require.Nil(spendLimit(bot2Addr))
+}
+
+func (s *TestSuite) TestSubGrantDepth() {
+	require := s.Require()
+	app, ctx := s.app, s.ctx
+	custodyAddr, recipientAddr := s.addrs[0], s.addrs[1]
+	require.NoError(testutil.FundAccount(app.BankKeeper, ctx, custodyAddr, coins1000))
+	expire := ctx.BlockTime().AddDate(1, 0, 0)
+	sendAuthorization := banktypes.NewSendAuthorization(coins100)
+	authorization := func(grantee sdk.AccAddress) authz.Authorization {
+		authorization, _ := app.AuthzKeeper.GetAuthorization(ctx, grantee, custodyAddr, bankSendAuthMsgType)
+		return authorization
+	}
+
+	s.T().Log("verify a sub-grant chain can't be longer than the maximum depth")
+	chain := simapp.AddTestAddrsIncremental(app, ctx, keeper.MaxSubGrantDepth+2, sdk.ZeroInt())
+	msg, err := authz.NewMsgGrant(custodyAddr, chain[0], sendAuthorization, &expire)
+	require.NoError(err)
+	msg.Grant.AllowSubGrant = true
+	_, err = app.AuthzKeeper.Grant(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(err)
+	for i := 1; i <= keeper.MaxSubGrantDepth; i++ {
+		require.NoError(app.AuthzKeeper.SaveSubGrant(ctx, chain[i], chain[i-1], custodyAddr, sendAuthorization, &expire, true))
+	}
+	last := chain[keeper.MaxSubGrantDepth]
+	err = app.AuthzKeeper.SaveSubGrant(ctx, chain[keeper.MaxSubGrantDepth+1], last, custodyAddr, sendAuthorization, &expire, false)
+	require.ErrorIs(err, authz.ErrInvalidSubGrant)
+
+	s.T().Log("verify the grant at the maximum depth can be executed")
+	_, err = app.AuthzKeeper.DispatchActions(ctx, last, []sdk.Msg{banktypes.NewMsgSend(custodyAddr, recipientAddr, coins10)})
+	require.NoError(err)
+	for _, addr := range chain[:keeper.MaxSubGrantDepth+1] {
+		require.Equal(banktypes.NewSendAuthorization(coins100.Sub(coins10...)), authorization(addr))
+	}
+
+	s.T().Log("verify revoking the root grant revokes the whole chain")
+	require.NoError(app.AuthzKeeper.DeleteGrant(ctx, chain[0], custodyAddr, bankSendAuthMsgType))
+	for _, addr := range chain {
+		require.Nil(authorization(addr))
+	}
+}
+
+func (s *TestSuite) TestNarrowParentGrant() {
+	require := s.Require()
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	custodyAddr, opsAddr, botAddr, bot2Addr, recipientAddr := addrs[0], addrs[1], addrs[2], addrs[3], addrs[4]
+	require.NoError(testutil.FundAccount(app.BankKeeper, ctx, custodyAddr, coins1000))
+	expire := ctx.BlockTime().AddDate(1, 0, 0)
+	sendAuthorization := func(amount int64) authz.Authorization {
+		return banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", amount)))
+	}
+	grant := func(authorization authz.Authorization, expiration time.Time, allowSubGrant bool) {
+		msg, err := authz.NewMsgGrant(custodyAddr, opsAddr, authorization, &expiration)
+		require.NoError(err)
+		msg.Grant.AllowSubGrant = allowSubGrant
+		_, err = app.AuthzKeeper.Grant(sdk.WrapSDKContext(ctx), msg)
+		require.NoError(err)
+	}
+	authorization := func(grantee sdk.AccAddress) authz.Authorization {
+		authorization, _ := app.AuthzKeeper.GetAuthorization(ctx, grantee, custodyAddr, bankSendAuthMsgType)
+		return authorization
+	}
+	subGrant := func() {
+		require.NoError(app.AuthzKeeper.SaveSubGrant(ctx, botAddr, opsAddr, custodyAddr, sendAuthorization(50), &expire, true))
+		require.NoError(app.AuthzKeeper.SaveSubGrant(ctx, bot2Addr, botAddr, custodyAddr, sendAuthorization(20), &expire, false))
+	}
+
+	s.T().Log("verify sub-grants still fitting into the overwritten grant are kept")
+	grant(sendAuthorization(100), expire, true)
+	subGrant()
+	grant(sendAuthorization(60), expire, true)
+	require.NotNil(authorization(botAddr))
+	require.NotNil(authorization(bot2Addr))
+
+	s.T().Log("verify narrowing the spend limit revokes the sub-grants exceeding it")
+	grant(sendAuthorization(30), expire, true)
+	require.Equal(sendAuthorization(30), authorization(opsAddr))
+	require.Nil(authorization(botAddr))
+	require.Nil(authorization(bot2Addr))
+	_, err := app.AuthzKeeper.DispatchActions(ctx, botAddr, []sdk.Msg{banktypes.NewMsgSend(custodyAddr, recipientAddr, coins10)})
+	require.Error(err)
+
+	s.T().Log("verify shortening the expiration revokes the sub-grants outliving it")
+	grant(sendAuthorization(100), expire, true)
+	subGrant()
+	grant(sendAuthorization(100), expire.AddDate(0, -6, 0), true)
+	require.Nil(authorization(botAddr))
+	require.Nil(authorization(bot2Addr))
+
+	s.T().Log("verify disallowing sub-grants revokes them")
+	grant(sendAuthorization(100), expire, true)
+	subGrant()
+	grant(sendAuthorization(100), expire, false)
+	require.NotNil(authorization(opsAddr))
+	require.Nil(authorization(botAddr))
+	require.Nil(authorization(bot2Addr))
+
+	s.T().Log("verify narrowing a sub-grant revokes the grants sub-granted from it")
+	grant(sendAuthorization(100), expire, true)
+	subGrant()
+	require.NoError(app.AuthzKeeper.SaveSubGrant(ctx, botAddr, opsAddr, custodyAddr, sendAuthorization(10), &expire, true))
+	require.Equal(sendAuthorization(10), authorization(botAddr))
+	require.Nil(authorization(bot2Addr))
+}
+
+func (s *TestSuite) TestDequeueExpiredSubGrants() {
+	require := s.Require()
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	custodyAddr, opsAddr, botAddr, bot2Addr := addrs[0], addrs[1], addrs[2], addrs[3]
+	expire := ctx.BlockTime().AddDate(1, 0, 0)
+	sendAuthorization := func(amount int64) authz.Authorization {
+		return banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", amount)))
+	}
+	grant := func(expiration time.Time) {
+		msg, err := authz.NewMsgGrant(custodyAddr, opsAddr, sendAuthorization(100), &expiration)
+		require.NoError(err)
+		msg.Grant.AllowSubGrant = true
+		_, err = app.AuthzKeeper.Grant(sdk.WrapSDKContext(ctx), msg)
+		require.NoError(err)
+	}
+	authorization := func(ctx sdk.Context, grantee sdk.AccAddress) authz.Authorization {
+		authorization, _ := app.AuthzKeeper.GetAuthorization(ctx, grantee, custodyAddr, bankSendAuthMsgType)
+		return authorization
+	}
+
+	s.T().Log("verify sub-grants expiring with their parent grant are pruned")
+	grant(expire)
+	require.NoError(app.AuthzKeeper.SaveSubGrant(ctx, botAddr, opsAddr, custodyAddr, sendAuthorization(50), &expire, true))
+	require.NoError(app.AuthzKeeper.SaveSubGrant(ctx, bot2Addr, botAddr, custodyAddr, sendAuthorization(20), &expire, false))
+
+	expiredCtx := ctx.WithBlockTime(expire.Add(time.Second))
+	require.NoError(app.AuthzKeeper.DequeueAndDeleteExpiredGrants(expiredCtx))
+	require.Nil(authorization(expiredCtx, opsAddr))
+	require.Nil(authorization(expiredCtx, botAddr))
+	require.Nil(authorization(expiredCtx, bot2Addr))
+
+	s.T().Log("verify sub-grants outliving their parent grant are pruned with it")
+	grant(expire)
+	require.NoError(app.AuthzKeeper.SaveSubGrant(ctx, botAddr, opsAddr, custodyAddr, sendAuthorization(50), &expire, true))
+	require.NoError(app.AuthzKeeper.SaveSubGrant(ctx, bot2Addr, botAddr, custodyAddr, sendAuthorization(20), &expire, false))
+	earlier := expire.AddDate(0, -6, 0)
+	grant(earlier)
+
+	expiredCtx = ctx.WithBlockTime(earlier.Add(time.Second))
+	require.NoError(app.AuthzKeeper.DequeueAndDeleteExpiredGrants(expiredCtx))
+	require.Nil(authorization(expiredCtx, opsAddr))
+	require.Nil(authorization(expiredCtx, botAddr))
+	require.Nil(authorization(expiredCtx, bot2Addr))
+
+	// the grant queue holds no item for the pruned sub-grants anymore
+	require.NoError(app.AuthzKeeper.DequeueAndDeleteExpiredGrants(ctx.WithBlockTime(expire.Add(time.Second))))
+}
+
 func (s *TestSuite) TestDequeueAllGrantsQueue() {
 	require := s.Require()
 	app, addrs := s.app, s.addrs
@@ -15,9 +15,12 @@ import (
 //
 // - 0x01<grant_Bytes>: Grant
 // - 0x02<grant_expiration_Bytes>: GrantQueueItem
+//
+// - 0x03<granter_Bytes><subGranter_Bytes><grantee_Bytes><msgType_Bytes>: []byte{0x01}
 var (
 	GrantKey         = []byte{0x01} // prefix for each key
 	GrantQueuePrefix = []byte{0x02}
+	SubGrantPrefix   = []byte{0x03}
 )
 
 var lenTime = len(sdk.FormatTimeBytes(time.Now()))
@@ -97,3 +100,29 @@ func firstAddressFromGrantStoreKey(key []byte) sdk.AccAddress {
 	addrLen := key[0]
 	return sdk.AccAddress(key[1 : 1+addrLen])
 }
+
+// subGrantKey - return the sub-grant index key of the grant sub-granted by
+// subGranter to grantee on the granter's account.
+// Key format is:
+//
+//	0x03<granterAddressLen (1 Byte)><granterAddress_Bytes><subGranterAddressLen (1 Byte)><subGranterAddress_Bytes><granteeAddressLen (1 Byte)><granteeAddress_Bytes><msgType_Bytes>
+func subGrantKey(granter, subGranter, grantee sdk.AccAddress, msgType string) []byte {
+	m := conv.UnsafeStrToBytes(msgType)
+	return sdk.AppendLengthPrefixedBytes(subGrantPrefixKey(granter, subGranter), address.MustLengthPrefix(grantee), m)
+}
+
+// subGrantPrefixKey - return the prefix of all grants sub-granted by subGranter
+// on the granter's account.
+func subGrantPrefixKey(granter, subGranter sdk.AccAddress) []byte {
+	return sdk.AppendLengthPrefixedBytes(SubGrantPrefix, address.MustLengthPrefix(granter), address.MustLengthPrefix(subGranter))
+}
+
+// parseSubGrantKey split grantee address and msg type from a sub-grant index
+// key with the given prefix.
+func parseSubGrantKey(prefix, key []byte) (grantee sdk.AccAddress, msgType string) {
+	granteeAddrLen, granteeAddrLenEndIndex := sdk.ParseLengthPrefixedBytes(key, len(prefix), 1)
+	grantee, granteeAddrEndIndex := sdk.ParseLengthPrefixedBytes(key, granteeAddrLenEndIndex+1, int(granteeAddrLen[0]))
+
+	kv.AssertKeyAtLeastLength(key, granteeAddrEndIndex+1)
+	return grantee, conv.UnsafeBytesToStr(key[(granteeAddrEndIndex + 1):])
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"testing"
 	"time"
 
@@ -29,6 +30,18 @@ func TestGrantkey(t *testing.T) {
 	require.Equal(msgType1, msgType)
 }
 
+func TestSubGrantKey(t *testing.T) {
+	require := require.New(t)
+	subGranter := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	prefix := subGrantPrefixKey(granter, subGranter)
+	key := subGrantKey(granter, subGranter, grantee, msgType)
+	require.True(bytes.HasPrefix(key, prefix))
+
+	grantee1, msgType1 := parseSubGrantKey(prefix, key)
+	require.Equal(grantee, grantee1)
+	require.Equal(msgType, msgType1)
+}
+
 func TestGrantQueueKey(t *testing.T) {
 	blockTime := time.Now().UTC()
 	queueKey := GrantQueueKey(blockTime, granter, grantee)
@@ -40,7 +40,13 @@ func (k Keeper) Grant(goCtx context.Context, msg *authz.MsgGrant) (*authz.MsgGra
 		return nil, sdkerrors.ErrInvalidType.Wrapf("%s doesn't exist.", t)
 	}
 
-	err = k.SaveGrant(ctx, grantee, granter, authorization, msg.Grant.Expiration)
+	grant, err := authz.NewGrant(ctx.BlockTime(), authorization, msg.Grant.Expiration)
+	if err != nil {
+		return nil, err
+	}
+	grant.AllowSubGrant = msg.Grant.AllowSubGrant
+
+	err = k.saveGrant(ctx, grantee, granter, t, grant)
 	if err != nil {
 		return nil, err
 	}
@@ -68,6 +74,67 @@ func (k Keeper) Revoke(goCtx context.Context, msg *authz.MsgRevoke) (*authz.MsgR
 	return &authz.MsgRevokeResponse{}, nil
 }
 
+// SubGrant implements the MsgServer.SubGrant method.
+func (k Keeper) SubGrant(goCtx context.Context, msg *authz.MsgSubGrant) (*authz.MsgSubGrantResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	// create the account if it is not in account state
+	granteeAcc := k.authKeeper.GetAccount(ctx, grantee)
+	if granteeAcc == nil {
+		granteeAcc = k.authKeeper.NewAccountWithAddress(ctx, grantee)
+		k.authKeeper.SetAccount(ctx, granteeAcc)
+	}
+
+	subGranter, err := sdk.AccAddressFromBech32(msg.SubGranter)
+	if err != nil {
+		return nil, err
+	}
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	authorization, err := msg.GetAuthorization()
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.SaveSubGrant(ctx, grantee, subGranter, granter, authorization, msg.Grant.Expiration, msg.Grant.AllowSubGrant)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authz.MsgSubGrantResponse{}, nil
+}
+
+// RevokeSubGrant implements the MsgServer.RevokeSubGrant method.
+func (k Keeper) RevokeSubGrant(goCtx context.Context, msg *authz.MsgRevokeSubGrant) (*authz.MsgRevokeSubGrantResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+	subGranter, err := sdk.AccAddressFromBech32(msg.SubGranter)
+	if err != nil {
+		return nil, err
+	}
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.DeleteSubGrant(ctx, grantee, subGranter, granter, msg.MsgTypeUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authz.MsgRevokeSubGrantResponse{}, nil
+}
+
 // Exec implements the MsgServer.Exec method.
 func (k Keeper) Exec(goCtx context.Context, msg *authz.MsgExec) (*authz.MsgExecResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
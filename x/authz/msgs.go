@@ -17,14 +17,19 @@ var (
 	_ sdk.Msg = &MsgGrant{}
 	_ sdk.Msg = &MsgRevoke{}
 	_ sdk.Msg = &MsgExec{}
+	_ sdk.Msg = &MsgSubGrant{}
+	_ sdk.Msg = &MsgRevokeSubGrant{}
 
 	// For amino support.
 	_ legacytx.LegacyMsg = &MsgGrant{}
 	_ legacytx.LegacyMsg = &MsgRevoke{}
 	_ legacytx.LegacyMsg = &MsgExec{}
+	_ legacytx.LegacyMsg = &MsgSubGrant{}
+	_ legacytx.LegacyMsg = &MsgRevokeSubGrant{}
 
 	_ cdctypes.UnpackInterfacesMessage = &MsgGrant{}
 	_ cdctypes.UnpackInterfacesMessage = &MsgExec{}
+	_ cdctypes.UnpackInterfacesMessage = &MsgSubGrant{}
 )
 
 // NewMsgGrant creates a new MsgGrant
@@ -63,6 +68,10 @@ func (msg MsgGrant) ValidateBasic() error {
 	if granter.Equals(grantee) {
 		return ErrGranteeIsGranter
 	}
+
+	if msg.Grant.SubGranter != "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("sub-granter must be empty")
+	}
 	return msg.Grant.ValidateBasic()
 }
 
@@ -239,3 +248,146 @@ func (msg MsgExec) Route() string {
 func (msg MsgExec) GetSignBytes() []byte {
 	return sdk.MustSortJSON(authzcodec.ModuleCdc.MustMarshalJSON(&msg))
 }
+
+// NewMsgSubGrant creates a new MsgSubGrant
+//
+//nolint:interfacer
+func NewMsgSubGrant(granter, subGranter, grantee sdk.AccAddress, a Authorization, expiration *time.Time, allowSubGrant bool) (*MsgSubGrant, error) {
+	m := &MsgSubGrant{
+		Granter:    granter.String(),
+		SubGranter: subGranter.String(),
+		Grantee:    grantee.String(),
+		Grant:      Grant{Expiration: expiration, AllowSubGrant: allowSubGrant},
+	}
+	err := m.SetAuthorization(a)
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// GetSigners implements Msg
+func (msg MsgSubGrant) GetSigners() []sdk.AccAddress {
+	subGranter, _ := sdk.AccAddressFromBech32(msg.SubGranter)
+	return []sdk.AccAddress{subGranter}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgSubGrant) ValidateBasic() error {
+	if err := validateSubGrantAddresses(msg.Granter, msg.SubGranter, msg.Grantee); err != nil {
+		return err
+	}
+
+	if msg.Grant.SubGranter != "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("sub-granter must be set on the message, not on the grant")
+	}
+	return msg.Grant.ValidateBasic()
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgSubGrant) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgSubGrant) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgSubGrant) GetSignBytes() []byte {
+	return sdk.MustSortJSON(authzcodec.ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetAuthorization returns the cache value from the MsgSubGrant.Authorization if present.
+func (msg *MsgSubGrant) GetAuthorization() (Authorization, error) {
+	return msg.Grant.GetAuthorization()
+}
+
+// SetAuthorization converts Authorization to any and adds it to MsgSubGrant.Authorization.
+func (msg *MsgSubGrant) SetAuthorization(a Authorization) error {
+	m, ok := a.(proto.Message)
+	if !ok {
+		return sdkerrors.ErrPackAny.Wrapf("can't proto marshal %T", m)
+	}
+	any, err := cdctypes.NewAnyWithValue(m)
+	if err != nil {
+		return err
+	}
+	msg.Grant.Authorization = any
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgSubGrant) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	return msg.Grant.UnpackInterfaces(unpacker)
+}
+
+// NewMsgRevokeSubGrant creates a new MsgRevokeSubGrant
+//
+//nolint:interfacer
+func NewMsgRevokeSubGrant(granter, subGranter, grantee sdk.AccAddress, msgTypeURL string) MsgRevokeSubGrant {
+	return MsgRevokeSubGrant{
+		Granter:    granter.String(),
+		SubGranter: subGranter.String(),
+		Grantee:    grantee.String(),
+		MsgTypeUrl: msgTypeURL,
+	}
+}
+
+// GetSigners implements Msg
+func (msg MsgRevokeSubGrant) GetSigners() []sdk.AccAddress {
+	subGranter, _ := sdk.AccAddressFromBech32(msg.SubGranter)
+	return []sdk.AccAddress{subGranter}
+}
+
+// ValidateBasic implements MsgRequest.ValidateBasic
+func (msg MsgRevokeSubGrant) ValidateBasic() error {
+	if err := validateSubGrantAddresses(msg.Granter, msg.SubGranter, msg.Grantee); err != nil {
+		return err
+	}
+
+	if msg.MsgTypeUrl == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("missing method name")
+	}
+
+	return nil
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgRevokeSubGrant) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgRevokeSubGrant) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgRevokeSubGrant) GetSignBytes() []byte {
+	return sdk.MustSortJSON(authzcodec.ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// validateSubGrantAddresses checks that the granter, sub-granter and grantee
+// of a sub-grant are valid and pairwise different.
+func validateSubGrantAddresses(granterStr, subGranterStr, granteeStr string) error {
+	granter, err := sdk.AccAddressFromBech32(granterStr)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid granter address: %s", err)
+	}
+	subGranter, err := sdk.AccAddressFromBech32(subGranterStr)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sub-granter address: %s", err)
+	}
+	grantee, err := sdk.AccAddressFromBech32(granteeStr)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid grantee address: %s", err)
+	}
+
+	if granter.Equals(grantee) || granter.Equals(subGranter) || subGranter.Equals(grantee) {
+		return ErrGranteeIsGranter.Wrap("granter, sub-granter and grantee should be different")
+	}
+
+	return nil
+}
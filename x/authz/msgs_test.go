@@ -70,6 +70,37 @@ func TestMsgRevokeAuthorization(t *testing.T) {
 	}
 }
 
+func TestMsgSubGrant(t *testing.T) {
+	subGranter := sdk.AccAddress("_____sub_granter____")
+	tests := []struct {
+		title                        string
+		granter, subGranter, grantee sdk.AccAddress
+		authorization                authz.Authorization
+		expectPass                   bool
+	}{
+		{"nil sub-granter address", granter, nil, grantee, &banktypes.SendAuthorization{SpendLimit: coinsPos}, false},
+		{"sub-granter is granter", granter, granter, grantee, &banktypes.SendAuthorization{SpendLimit: coinsPos}, false},
+		{"sub-granter is grantee", granter, grantee, grantee, &banktypes.SendAuthorization{SpendLimit: coinsPos}, false},
+		{"invalid authorization", granter, subGranter, grantee, &banktypes.SendAuthorization{}, false},
+		{"valid test case", granter, subGranter, grantee, &banktypes.SendAuthorization{SpendLimit: coinsPos}, true},
+	}
+	for i, tc := range tests {
+		msg, err := authz.NewMsgSubGrant(tc.granter, tc.subGranter, tc.grantee, tc.authorization, nil, false)
+		require.NoError(t, err)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+			require.Equal(t, []sdk.AccAddress{tc.subGranter}, msg.GetSigners(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+
+	msg, err := authz.NewMsgSubGrant(granter, subGranter, grantee, &banktypes.SendAuthorization{SpendLimit: coinsPos}, nil, false)
+	require.NoError(t, err)
+	msg.Grant.SubGranter = subGranter.String()
+	require.Error(t, msg.ValidateBasic())
+}
+
 // add time interval to a time object and returns a pointer
 func addDatePtr(t *time.Time, months, days int) *time.Time {
 	t2 := t.AddDate(0, months, days)
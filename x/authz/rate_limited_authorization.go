@@ -0,0 +1,69 @@
+package authz
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ Authorization    = &RateLimitedAuthorization{}
+	_ SubAuthorization = &RateLimitedAuthorization{}
+)
+
+// NewRateLimitedAuthorization creates a new RateLimitedAuthorization object.
+func NewRateLimitedAuthorization(msgTypeURL string, maxExecutions uint64, period time.Duration) *RateLimitedAuthorization {
+	return &RateLimitedAuthorization{
+		Msg:           msgTypeURL,
+		MaxExecutions: maxExecutions,
+		Period:        period,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a RateLimitedAuthorization) MsgTypeURL() string {
+	return a.Msg
+}
+
+// Accept implements Authorization.Accept.
+func (a RateLimitedAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (AcceptResponse, error) {
+	now := ctx.BlockTime()
+
+	// a new window starts with the first execution after the end of the
+	// previous one
+	if a.WindowStart == nil || !now.Before(a.WindowStart.Add(a.Period)) {
+		a.WindowStart = &now
+		a.Executions = 0
+	}
+
+	if a.Executions >= a.MaxExecutions {
+		return AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf(
+			"maximum of %d executions reached until %s", a.MaxExecutions, a.WindowStart.Add(a.Period),
+		)
+	}
+
+	a.Executions++
+	return AcceptResponse{Accept: true, Updated: &a}, nil
+}
+
+// IsSubsetOf implements SubAuthorization.IsSubsetOf. The authorization may
+// allow no more executions than the parent, over no shorter a period.
+func (a RateLimitedAuthorization) IsSubsetOf(parent Authorization) bool {
+	p, ok := parent.(*RateLimitedAuthorization)
+	return ok && a.Msg == p.Msg && a.MaxExecutions <= p.MaxExecutions && a.Period >= p.Period
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a RateLimitedAuthorization) ValidateBasic() error {
+	if a.MaxExecutions == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("max executions must be positive")
+	}
+	if a.Period <= 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("period must be positive")
+	}
+	if a.Executions > a.MaxExecutions {
+		return sdkerrors.ErrInvalidRequest.Wrap("executions cannot exceed max executions")
+	}
+	return nil
+}
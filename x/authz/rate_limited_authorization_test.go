@@ -0,0 +1,61 @@
+package authz_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestRateLimitedAuthorization(t *testing.T) {
+	ctx := testutil.DefaultContext(sdk.NewKVStoreKey("authz"), sdk.NewTransientStoreKey("transient_test"))
+	now := time.Unix(1000, 0).UTC()
+	ctx = ctx.WithBlockTime(now)
+	msgTypeURL := banktypes.SendAuthorization{}.MsgTypeURL()
+	msg := banktypes.NewMsgSend(sdk.AccAddress("from"), sdk.AccAddress("to"), sdk.NewCoins())
+
+	require.Error(t, authz.NewRateLimitedAuthorization(msgTypeURL, 0, time.Hour).ValidateBasic())
+	require.Error(t, authz.NewRateLimitedAuthorization(msgTypeURL, 2, 0).ValidateBasic())
+
+	var a authz.Authorization = authz.NewRateLimitedAuthorization(msgTypeURL, 2, time.Hour)
+	require.NoError(t, a.ValidateBasic())
+
+	t.Log("verify the first execution starts a window")
+	resp, err := a.Accept(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	updated := resp.Updated.(*authz.RateLimitedAuthorization)
+	require.Equal(t, now, *updated.WindowStart)
+	require.Equal(t, uint64(1), updated.Executions)
+
+	t.Log("verify executions are rejected once the maximum is reached")
+	resp, err = resp.Updated.Accept(ctx.WithBlockTime(now.Add(30*time.Minute)), msg)
+	require.NoError(t, err)
+	a = resp.Updated
+	_, err = a.Accept(ctx.WithBlockTime(now.Add(59*time.Minute)), msg)
+	require.Error(t, err)
+
+	t.Log("verify a new window starts at the end of the previous one")
+	resp, err = a.Accept(ctx.WithBlockTime(now.Add(time.Hour)), msg)
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	updated = resp.Updated.(*authz.RateLimitedAuthorization)
+	require.Equal(t, now.Add(time.Hour), *updated.WindowStart)
+	require.Equal(t, uint64(1), updated.Executions)
+}
+
+func TestRateLimitedAuthorizationIsSubsetOf(t *testing.T) {
+	msgTypeURL := banktypes.SendAuthorization{}.MsgTypeURL()
+	parent := authz.NewRateLimitedAuthorization(msgTypeURL, 2, time.Hour)
+
+	require.True(t, authz.IsSubAuthorization(authz.NewRateLimitedAuthorization(msgTypeURL, 2, time.Hour), parent))
+	require.True(t, authz.IsSubAuthorization(authz.NewRateLimitedAuthorization(msgTypeURL, 1, 2*time.Hour), parent))
+	require.False(t, authz.IsSubAuthorization(authz.NewRateLimitedAuthorization(msgTypeURL, 3, time.Hour), parent))
+	require.False(t, authz.IsSubAuthorization(authz.NewRateLimitedAuthorization(msgTypeURL, 2, time.Minute), parent))
+	require.False(t, authz.IsSubAuthorization(authz.NewRateLimitedAuthorization(msgTypeURL, 1, time.Hour), authz.NewUsageLimitedAuthorization(msgTypeURL, 1)))
+}
@@ -269,6 +269,173 @@ func (m *MsgRevokeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRevokeResponse proto.InternalMessageInfo
 
+// MsgSubGrant is a request type for SubGrant method. It declares an
+// authorization to the grantee on behalf of the granter, issued by the
+// sub_granter out of the grant it holds from the granter.
+//
+// Since: cosmos-sdk 0.47
+type MsgSubGrant struct {
+	Granter    string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	SubGranter string `protobuf:"bytes,2,opt,name=sub_granter,json=subGranter,proto3" json:"sub_granter,omitempty"`
+	Grantee    string `protobuf:"bytes,3,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	Grant      Grant  `protobuf:"bytes,4,opt,name=grant,proto3" json:"grant"`
+}
+
+func (m *MsgSubGrant) Reset()         { *m = MsgSubGrant{} }
+func (m *MsgSubGrant) String() string { return proto.CompactTextString(m) }
+func (*MsgSubGrant) ProtoMessage()    {}
+func (*MsgSubGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ceddab7d8589ad1, []int{6}
+}
+func (m *MsgSubGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubGrant.Merge(m, src)
+}
+func (m *MsgSubGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubGrant proto.InternalMessageInfo
+
+// MsgSubGrantResponse defines the Msg/MsgSubGrant response type.
+//
+// Since: cosmos-sdk 0.47
+type MsgSubGrantResponse struct {
+}
+
+func (m *MsgSubGrantResponse) Reset()         { *m = MsgSubGrantResponse{} }
+func (m *MsgSubGrantResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubGrantResponse) ProtoMessage()    {}
+func (*MsgSubGrantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ceddab7d8589ad1, []int{7}
+}
+func (m *MsgSubGrantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubGrantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubGrantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubGrantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubGrantResponse.Merge(m, src)
+}
+func (m *MsgSubGrantResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubGrantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubGrantResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubGrantResponse proto.InternalMessageInfo
+
+// MsgRevokeSubGrant revokes the authorization with the provided sdk.Msg type
+// on the granter's account that the sub_granter has sub-granted to the grantee.
+//
+// Since: cosmos-sdk 0.47
+type MsgRevokeSubGrant struct {
+	Granter    string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	SubGranter string `protobuf:"bytes,2,opt,name=sub_granter,json=subGranter,proto3" json:"sub_granter,omitempty"`
+	Grantee    string `protobuf:"bytes,3,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	MsgTypeUrl string `protobuf:"bytes,4,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+}
+
+func (m *MsgRevokeSubGrant) Reset()         { *m = MsgRevokeSubGrant{} }
+func (m *MsgRevokeSubGrant) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeSubGrant) ProtoMessage()    {}
+func (*MsgRevokeSubGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ceddab7d8589ad1, []int{8}
+}
+func (m *MsgRevokeSubGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeSubGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeSubGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeSubGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeSubGrant.Merge(m, src)
+}
+func (m *MsgRevokeSubGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeSubGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeSubGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeSubGrant proto.InternalMessageInfo
+
+// MsgRevokeSubGrantResponse defines the Msg/MsgRevokeSubGrant response type.
+//
+// Since: cosmos-sdk 0.47
+type MsgRevokeSubGrantResponse struct {
+}
+
+func (m *MsgRevokeSubGrantResponse) Reset()         { *m = MsgRevokeSubGrantResponse{} }
+func (m *MsgRevokeSubGrantResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeSubGrantResponse) ProtoMessage()    {}
+func (*MsgRevokeSubGrantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ceddab7d8589ad1, []int{9}
+}
+func (m *MsgRevokeSubGrantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeSubGrantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeSubGrantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeSubGrantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeSubGrantResponse.Merge(m, src)
+}
+func (m *MsgRevokeSubGrantResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeSubGrantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeSubGrantResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeSubGrantResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgGrant)(nil), "cosmos.authz.v1beta1.MsgGrant")
 	proto.RegisterType((*MsgExecResponse)(nil), "cosmos.authz.v1beta1.MsgExecResponse")
@@ -276,45 +443,56 @@ func init() {
 	proto.RegisterType((*MsgGrantResponse)(nil), "cosmos.authz.v1beta1.MsgGrantResponse")
 	proto.RegisterType((*MsgRevoke)(nil), "cosmos.authz.v1beta1.MsgRevoke")
 	proto.RegisterType((*MsgRevokeResponse)(nil), "cosmos.authz.v1beta1.MsgRevokeResponse")
+	proto.RegisterType((*MsgSubGrant)(nil), "cosmos.authz.v1beta1.MsgSubGrant")
+	proto.RegisterType((*MsgSubGrantResponse)(nil), "cosmos.authz.v1beta1.MsgSubGrantResponse")
+	proto.RegisterType((*MsgRevokeSubGrant)(nil), "cosmos.authz.v1beta1.MsgRevokeSubGrant")
+	proto.RegisterType((*MsgRevokeSubGrantResponse)(nil), "cosmos.authz.v1beta1.MsgRevokeSubGrantResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/tx.proto", fileDescriptor_3ceddab7d8589ad1) }
 
 var fileDescriptor_3ceddab7d8589ad1 = []byte{
-	// 519 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x53, 0xcd, 0x8e, 0x12, 0x41,
-	0x10, 0xa6, 0x17, 0x76, 0x91, 0x5e, 0x12, 0x75, 0x24, 0x71, 0x16, 0xdd, 0xd9, 0xc9, 0xc4, 0x1f,
-	0xa2, 0xd2, 0x13, 0xf0, 0x60, 0xe2, 0x8d, 0x49, 0x8c, 0x89, 0x71, 0x62, 0x32, 0xea, 0xc5, 0x0b,
-	0x19, 0xa0, 0x6d, 0x08, 0xcc, 0x34, 0x99, 0xea, 0x21, 0xb0, 0x47, 0x9f, 0xc0, 0x9b, 0x0f, 0xe0,
-	0x0b, 0x78, 0xd8, 0x8b, 0x6f, 0x40, 0x3c, 0x6d, 0x3c, 0x79, 0x32, 0x0a, 0x07, 0x5f, 0xc1, 0xa3,
-	0xa1, 0x7b, 0x1a, 0x7f, 0xc2, 0xae, 0x7b, 0xda, 0x53, 0x77, 0xd5, 0xf7, 0x55, 0xf5, 0x57, 0xfd,
-	0x75, 0xe3, 0xfd, 0x2e, 0x87, 0x88, 0x83, 0x1b, 0xa6, 0xa2, 0x7f, 0xe8, 0x4e, 0x1a, 0x1d, 0x2a,
-	0xc2, 0x86, 0x2b, 0xa6, 0x64, 0x9c, 0x70, 0xc1, 0x8d, 0x8a, 0x82, 0x89, 0x84, 0x49, 0x06, 0x57,
-	0xf7, 0x54, 0xb6, 0x2d, 0x39, 0x6e, 0x46, 0x91, 0x41, 0xb5, 0xc2, 0x38, 0xe3, 0x2a, 0xbf, 0xda,
-	0x65, 0xd9, 0x3d, 0xc6, 0x39, 0x1b, 0x51, 0x57, 0x46, 0x9d, 0xf4, 0xb5, 0x1b, 0xc6, 0xb3, 0x0c,
-	0xb2, 0x37, 0x0a, 0x50, 0xe7, 0x29, 0xc6, 0xd5, 0x8c, 0x11, 0x01, 0x73, 0x27, 0x8d, 0xd5, 0xa2,
-	0x00, 0xe7, 0x23, 0xc2, 0x17, 0x7c, 0x60, 0x8f, 0x93, 0x30, 0x16, 0x46, 0x13, 0x17, 0xd9, 0x6a,
-	0x43, 0x13, 0x13, 0xd9, 0xa8, 0x56, 0xf2, 0xcc, 0xcf, 0x47, 0x75, 0x2d, 0xbf, 0xd5, 0xeb, 0x25,
-	0x14, 0xe0, 0xb9, 0x48, 0x06, 0x31, 0x0b, 0x34, 0xf1, 0x77, 0x0d, 0x35, 0xb7, 0xce, 0x56, 0x43,
-	0x8d, 0x07, 0x78, 0x5b, 0x6e, 0xcd, 0xbc, 0x8d, 0x6a, 0xbb, 0xcd, 0x6b, 0x64, 0xd3, 0x0d, 0x11,
-	0xa9, 0xc9, 0x2b, 0xcc, 0xbf, 0x1e, 0xe4, 0x02, 0xc5, 0x7f, 0x58, 0x7e, 0xf3, 0xe3, 0xc3, 0x1d,
-	0x7d, 0xb4, 0x73, 0x17, 0x5f, 0xf4, 0x81, 0x3d, 0x9a, 0xd2, 0x6e, 0x40, 0x61, 0xcc, 0x63, 0xa0,
-	0x86, 0x89, 0x8b, 0x09, 0x85, 0x74, 0x24, 0xc0, 0x44, 0x76, 0xbe, 0x56, 0x0e, 0x74, 0xe8, 0xbc,
-	0x43, 0xb8, 0x98, 0xb1, 0xff, 0xd4, 0x8c, 0xce, 0xaa, 0xf9, 0x09, 0x2e, 0x44, 0xc0, 0xc0, 0xdc,
-	0xb2, 0xf3, 0xb5, 0xdd, 0x66, 0x85, 0x28, 0x37, 0x88, 0x76, 0x83, 0xb4, 0xe2, 0x99, 0x67, 0x7f,
-	0x3a, 0xaa, 0x5f, 0x87, 0xde, 0x90, 0xf8, 0xc0, 0xee, 0xd9, 0x6a, 0x9a, 0x56, 0x2a, 0xfa, 0x3c,
-	0x19, 0x1c, 0x86, 0x62, 0xc0, 0xe3, 0x40, 0xf6, 0xf8, 0x6b, 0x0c, 0xea, 0x18, 0xf8, 0x92, 0x76,
-	0x40, 0xcf, 0xe1, 0xbc, 0x47, 0xb8, 0xe4, 0x03, 0x0b, 0xe8, 0x84, 0x0f, 0xe9, 0xb9, 0xf9, 0x62,
-	0xe3, 0x72, 0x04, 0xac, 0x2d, 0x66, 0x63, 0xda, 0x4e, 0x93, 0x91, 0xb4, 0xa7, 0x14, 0xe0, 0x08,
-	0xd8, 0x8b, 0xd9, 0x98, 0xbe, 0x4c, 0x46, 0xff, 0x18, 0x70, 0x05, 0x5f, 0x5e, 0x8b, 0xd4, 0xd2,
-	0x9b, 0x3f, 0x11, 0xce, 0xfb, 0xc0, 0x8c, 0x67, 0x78, 0x5b, 0xbd, 0x2a, 0x6b, 0xb3, 0xbd, 0x7a,
-	0xe6, 0xea, 0xad, 0xd3, 0xf1, 0xb5, 0xb7, 0x4f, 0x71, 0x41, 0xba, 0xb7, 0x7f, 0x22, 0x7f, 0x05,
-	0x57, 0x6f, 0x9e, 0x0a, 0xaf, 0xbb, 0x05, 0x78, 0x27, 0xbb, 0xdd, 0x83, 0x13, 0x0b, 0x14, 0xa1,
-	0x7a, 0xfb, 0x3f, 0x04, 0xdd, 0xd3, 0xf3, 0xe6, 0xdf, 0xad, 0xdc, 0x7c, 0x61, 0xa1, 0xe3, 0x85,
-	0x85, 0xbe, 0x2d, 0x2c, 0xf4, 0x76, 0x69, 0xe5, 0x8e, 0x97, 0x56, 0xee, 0xcb, 0xd2, 0xca, 0xbd,
-	0xba, 0xc1, 0x06, 0xa2, 0x9f, 0x76, 0x48, 0x97, 0x47, 0xd9, 0x7f, 0xcf, 0x96, 0x3a, 0xf4, 0x86,
-	0xee, 0x54, 0xfd, 0xd7, 0xce, 0x8e, 0x7c, 0x51, 0xf7, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0x59,
-	0x66, 0xa5, 0x3e, 0x55, 0x04, 0x00, 0x00,
+	// 627 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0x4d, 0x6f, 0xd3, 0x4c,
+	0x10, 0xce, 0xd6, 0xe9, 0xd7, 0xa4, 0x7a, 0xdf, 0xe2, 0x16, 0xe1, 0xba, 0xd4, 0x35, 0x16, 0x1f,
+	0xe1, 0xa3, 0xb6, 0x1a, 0x0e, 0x08, 0x6e, 0x8d, 0x84, 0x90, 0x10, 0x16, 0x92, 0x0b, 0x12, 0xe2,
+	0x12, 0xc5, 0xcd, 0xb2, 0x0d, 0x8d, 0xbd, 0x91, 0xd7, 0x8e, 0x92, 0x1e, 0xf9, 0x05, 0xdc, 0xf8,
+	0x01, 0xfc, 0x01, 0x0e, 0xbd, 0x70, 0xe1, 0x1c, 0x71, 0xaa, 0x38, 0x71, 0x42, 0x90, 0x1c, 0x90,
+	0xf8, 0x0b, 0x5c, 0x50, 0xbc, 0x5e, 0x93, 0xe6, 0xa3, 0x49, 0x39, 0x20, 0x71, 0xf2, 0xee, 0xce,
+	0x33, 0x3b, 0xcf, 0x3c, 0x33, 0xe3, 0x85, 0x8d, 0x3d, 0xca, 0x3c, 0xca, 0xac, 0x72, 0x14, 0xee,
+	0x1f, 0x5a, 0x8d, 0x6d, 0x17, 0x87, 0xe5, 0x6d, 0x2b, 0x6c, 0x9a, 0xf5, 0x80, 0x86, 0x54, 0x5e,
+	0xe5, 0x66, 0x33, 0x36, 0x9b, 0x89, 0x59, 0x5d, 0xe3, 0xa7, 0xa5, 0x18, 0x63, 0x25, 0x90, 0x78,
+	0xa3, 0xae, 0x12, 0x4a, 0x28, 0x3f, 0xef, 0xad, 0x92, 0xd3, 0x35, 0x42, 0x29, 0xa9, 0x61, 0x2b,
+	0xde, 0xb9, 0xd1, 0x0b, 0xab, 0xec, 0xb7, 0x12, 0x93, 0x3e, 0x92, 0x00, 0x8f, 0xc7, 0x11, 0x17,
+	0x12, 0x84, 0xc7, 0x88, 0xd5, 0xd8, 0xee, 0x7d, 0xb8, 0xc1, 0x78, 0x8f, 0x60, 0xc1, 0x66, 0xe4,
+	0x41, 0x50, 0xf6, 0x43, 0xb9, 0x00, 0xf3, 0xa4, 0xb7, 0xc0, 0x81, 0x82, 0x74, 0x94, 0x5f, 0x2c,
+	0x2a, 0x9f, 0x8e, 0xb6, 0x04, 0xfd, 0x9d, 0x4a, 0x25, 0xc0, 0x8c, 0xed, 0x86, 0x41, 0xd5, 0x27,
+	0x8e, 0x00, 0xfe, 0xf6, 0xc1, 0xca, 0xcc, 0x74, 0x3e, 0x58, 0xbe, 0x03, 0xb3, 0xf1, 0x52, 0x91,
+	0x74, 0x94, 0xcf, 0x15, 0xd6, 0xcd, 0x51, 0x0a, 0x99, 0x31, 0xa7, 0x62, 0xb6, 0xfd, 0x65, 0x33,
+	0xe3, 0x70, 0xfc, 0xbd, 0xa5, 0x57, 0xdf, 0xdf, 0xdd, 0x10, 0xa1, 0x8d, 0x9b, 0xf0, 0xbf, 0xcd,
+	0xc8, 0xfd, 0x26, 0xde, 0x73, 0x30, 0xab, 0x53, 0x9f, 0x61, 0x59, 0x81, 0xf9, 0x00, 0xb3, 0xa8,
+	0x16, 0x32, 0x05, 0xe9, 0x52, 0x7e, 0xc9, 0x11, 0x5b, 0xe3, 0x0d, 0x82, 0xf9, 0x04, 0xdd, 0xcf,
+	0x19, 0x4d, 0xcb, 0xf9, 0x21, 0x64, 0x3d, 0x46, 0x98, 0x32, 0xa3, 0x4b, 0xf9, 0x5c, 0x61, 0xd5,
+	0xe4, 0xd5, 0x30, 0x45, 0x35, 0xcc, 0x1d, 0xbf, 0x55, 0xd4, 0x3f, 0x1e, 0x6d, 0x5d, 0x64, 0x95,
+	0x03, 0xd3, 0x66, 0xe4, 0x96, 0xce, 0xb3, 0xd9, 0x89, 0xc2, 0x7d, 0x1a, 0x54, 0x0f, 0xcb, 0x61,
+	0x95, 0xfa, 0x4e, 0x7c, 0xc7, 0x89, 0x34, 0xb0, 0x21, 0xc3, 0xb2, 0xa8, 0x80, 0xc8, 0xc3, 0x78,
+	0x8b, 0x60, 0xd1, 0x66, 0xc4, 0xc1, 0x0d, 0x7a, 0x80, 0xff, 0x5a, 0x5d, 0x74, 0x58, 0xf2, 0x18,
+	0x29, 0x85, 0xad, 0x3a, 0x2e, 0x45, 0x41, 0x2d, 0x2e, 0xcf, 0xa2, 0x03, 0x1e, 0x23, 0x4f, 0x5a,
+	0x75, 0xfc, 0x34, 0xa8, 0x0d, 0x14, 0x60, 0x05, 0xce, 0xa5, 0x24, 0x53, 0xea, 0x3f, 0x11, 0xe4,
+	0x6c, 0x46, 0x76, 0x23, 0xf7, 0xcf, 0x9b, 0xea, 0x2e, 0xe4, 0x58, 0xe4, 0x96, 0x84, 0xdf, 0xa4,
+	0x04, 0x80, 0x25, 0xc1, 0x4e, 0xe6, 0x2d, 0x9d, 0xb9, 0x1f, 0xb3, 0x67, 0xec, 0xc7, 0xe5, 0x9e,
+	0x1c, 0xfd, 0x54, 0x8d, 0xf3, 0xb0, 0xd2, 0x97, 0x7c, 0x2a, 0xca, 0x0f, 0xd4, 0x27, 0xd5, 0xbf,
+	0x24, 0xcd, 0x60, 0x4b, 0x64, 0x87, 0x5a, 0x62, 0x58, 0x83, 0x75, 0x58, 0x1b, 0xca, 0x55, 0x28,
+	0x51, 0xf8, 0x20, 0x81, 0x64, 0x33, 0x22, 0x3f, 0x86, 0x59, 0x2e, 0x82, 0x36, 0x5a, 0x6d, 0x31,
+	0x12, 0xea, 0xd5, 0xd3, 0xed, 0xe9, 0xe8, 0x3f, 0x82, 0x6c, 0x3c, 0xdc, 0x1b, 0x63, 0xf1, 0x3d,
+	0xb3, 0x7a, 0xe5, 0x54, 0x73, 0x7a, 0x9b, 0x03, 0x73, 0xc9, 0xf0, 0x6d, 0x8e, 0x75, 0xe0, 0x00,
+	0xf5, 0xda, 0x04, 0x40, 0x7a, 0xe7, 0x33, 0x58, 0x48, 0x4b, 0x7f, 0x69, 0xac, 0x93, 0x80, 0xa8,
+	0xd7, 0x27, 0x42, 0xd2, 0x9b, 0x5f, 0xc2, 0x7f, 0x03, 0xad, 0x35, 0x89, 0x54, 0x1a, 0xc5, 0x9a,
+	0x12, 0x28, 0x62, 0x15, 0x8b, 0xed, 0x6f, 0x5a, 0xa6, 0xdd, 0xd1, 0xd0, 0x71, 0x47, 0x43, 0x5f,
+	0x3b, 0x1a, 0x7a, 0xdd, 0xd5, 0x32, 0xc7, 0x5d, 0x2d, 0xf3, 0xb9, 0xab, 0x65, 0x9e, 0x5f, 0x26,
+	0xd5, 0x70, 0x3f, 0x72, 0xcd, 0x3d, 0xea, 0x25, 0x8f, 0x5a, 0xf2, 0xd9, 0x62, 0x95, 0x03, 0xab,
+	0xc9, 0x1f, 0x25, 0x77, 0x2e, 0xfe, 0x6d, 0xde, 0xfe, 0x35, 0x00, 0x7e, 0xfb, 0xa6, 0x5e, 0x3a,
+	0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -341,6 +519,18 @@ type MsgClient interface {
 	// Revoke revokes any authorization corresponding to the provided method name on the
 	// granter's account that has been granted to the grantee.
 	Revoke(ctx context.Context, in *MsgRevoke, opts ...grpc.CallOption) (*MsgRevokeResponse, error)
+	// SubGrant grants a slice of an authorization held by the sub-granter on the
+	// granter's account to a third party. The parent grant must allow sub-grants
+	// and the sub-grant may neither exceed its authorization nor outlive it.
+	// Revoking the parent grant revokes all grants sub-granted from it.
+	//
+	// Since: cosmos-sdk 0.47
+	SubGrant(ctx context.Context, in *MsgSubGrant, opts ...grpc.CallOption) (*MsgSubGrantResponse, error)
+	// RevokeSubGrant revokes a grant previously issued by the sub-granter with
+	// SubGrant, together with all grants sub-granted from it.
+	//
+	// Since: cosmos-sdk 0.47
+	RevokeSubGrant(ctx context.Context, in *MsgRevokeSubGrant, opts ...grpc.CallOption) (*MsgRevokeSubGrantResponse, error)
 }
 
 type msgClient struct {
@@ -378,6 +568,24 @@ func (c *msgClient) Revoke(ctx context.Context, in *MsgRevoke, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *msgClient) SubGrant(ctx context.Context, in *MsgSubGrant, opts ...grpc.CallOption) (*MsgSubGrantResponse, error) {
+	out := new(MsgSubGrantResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.authz.v1beta1.Msg/SubGrant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeSubGrant(ctx context.Context, in *MsgRevokeSubGrant, opts ...grpc.CallOption) (*MsgRevokeSubGrantResponse, error) {
+	out := new(MsgRevokeSubGrantResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.authz.v1beta1.Msg/RevokeSubGrant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Grant grants the provided authorization to the grantee on the granter's
@@ -392,6 +600,18 @@ type MsgServer interface {
 	// Revoke revokes any authorization corresponding to the provided method name on the
 	// granter's account that has been granted to the grantee.
 	Revoke(context.Context, *MsgRevoke) (*MsgRevokeResponse, error)
+	// SubGrant grants a slice of an authorization held by the sub-granter on the
+	// granter's account to a third party. The parent grant must allow sub-grants
+	// and the sub-grant may neither exceed its authorization nor outlive it.
+	// Revoking the parent grant revokes all grants sub-granted from it.
+	//
+	// Since: cosmos-sdk 0.47
+	SubGrant(context.Context, *MsgSubGrant) (*MsgSubGrantResponse, error)
+	// RevokeSubGrant revokes a grant previously issued by the sub-granter with
+	// SubGrant, together with all grants sub-granted from it.
+	//
+	// Since: cosmos-sdk 0.47
+	RevokeSubGrant(context.Context, *MsgRevokeSubGrant) (*MsgRevokeSubGrantResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -407,6 +627,12 @@ func (*UnimplementedMsgServer) Exec(ctx context.Context, req *MsgExec) (*MsgExec
 func (*UnimplementedMsgServer) Revoke(ctx context.Context, req *MsgRevoke) (*MsgRevokeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Revoke not implemented")
 }
+func (*UnimplementedMsgServer) SubGrant(ctx context.Context, req *MsgSubGrant) (*MsgSubGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubGrant not implemented")
+}
+func (*UnimplementedMsgServer) RevokeSubGrant(ctx context.Context, req *MsgRevokeSubGrant) (*MsgRevokeSubGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSubGrant not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -466,6 +692,42 @@ func _Msg_Revoke_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SubGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubGrant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.authz.v1beta1.Msg/SubGrant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubGrant(ctx, req.(*MsgSubGrant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeSubGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeSubGrant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeSubGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.authz.v1beta1.Msg/RevokeSubGrant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeSubGrant(ctx, req.(*MsgRevokeSubGrant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.authz.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -482,6 +744,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Revoke",
 			Handler:    _Msg_Revoke_Handler,
 		},
+		{
+			MethodName: "SubGrant",
+			Handler:    _Msg_SubGrant_Handler,
+		},
+		{
+			MethodName: "RevokeSubGrant",
+			Handler:    _Msg_RevokeSubGrant_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/authz/v1beta1/tx.proto",
@@ -700,87 +970,304 @@ func (m *MsgRevokeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSubGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgGrant) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Granter)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Grant.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+
+func (m *MsgSubGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgExecResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSubGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Results) > 0 {
-		for _, b := range m.Results {
-			l = len(b)
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Grant.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *MsgExec) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x22
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.SubGranter) > 0 {
+		i -= len(m.SubGranter)
+		copy(dAtA[i:], m.SubGranter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SubGranter)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Msgs) > 0 {
-		for _, e := range m.Msgs {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgGrantResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSubGrantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubGrantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubGrantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRevoke) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRevokeSubGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeSubGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeSubGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Granter)
-	if l > 0 {
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SubGranter) > 0 {
+		i -= len(m.SubGranter)
+		copy(dAtA[i:], m.SubGranter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SubGranter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeSubGrantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeSubGrantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeSubGrantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Grant.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgExecResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, b := range m.Results {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgExec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgGrantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevoke) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SubGranter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Grant.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSubGrantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeSubGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SubGranter)
+	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
 	l = len(m.Grantee)
@@ -791,25 +1278,420 @@ func (m *MsgRevoke) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgRevokeSubGrantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grant", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Grant.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, make([]byte, postIndex-iNdEx))
+			copy(m.Results[len(m.Results)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, &types.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgRevokeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgGrantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgGrant) Unmarshal(dAtA []byte) error {
+func (m *MsgRevoke) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -832,10 +1714,10 @@ func (m *MsgGrant) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGrant: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevoke: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevoke: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -904,9 +1786,9 @@ func (m *MsgGrant) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -916,24 +1798,23 @@ func (m *MsgGrant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Grant.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -956,7 +1837,7 @@ func (m *MsgGrant) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -979,44 +1860,12 @@ func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Results = append(m.Results, make([]byte, postIndex-iNdEx))
-			copy(m.Results[len(m.Results)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1038,7 +1887,7 @@ func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExec) Unmarshal(dAtA []byte) error {
+func (m *MsgSubGrant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1061,13 +1910,77 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExec: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubGrant: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubGrant: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubGranter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubGranter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
@@ -1099,9 +2012,9 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 			}
 			m.Grantee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grant", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1128,8 +2041,7 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msgs = append(m.Msgs, &types.Any{})
-			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Grant.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1154,7 +2066,7 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgGrantResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubGrantResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1177,10 +2089,10 @@ func (m *MsgGrantResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGrantResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubGrantResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGrantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubGrantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1204,7 +2116,7 @@ func (m *MsgGrantResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevoke) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeSubGrant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1227,10 +2139,10 @@ func (m *MsgRevoke) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevoke: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeSubGrant: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevoke: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeSubGrant: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1266,6 +2178,38 @@ func (m *MsgRevoke) Unmarshal(dAtA []byte) error {
 			m.Granter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubGranter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubGranter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
@@ -1297,7 +2241,7 @@ func (m *MsgRevoke) Unmarshal(dAtA []byte) error {
 			}
 			m.Grantee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
 			}
@@ -1350,7 +2294,7 @@ func (m *MsgRevoke) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevokeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeSubGrantResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1373,10 +2317,10 @@ func (m *MsgRevokeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevokeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeSubGrantResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevokeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeSubGrantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
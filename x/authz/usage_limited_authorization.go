@@ -0,0 +1,52 @@
+package authz
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ Authorization    = &UsageLimitedAuthorization{}
+	_ SubAuthorization = &UsageLimitedAuthorization{}
+)
+
+// NewUsageLimitedAuthorization creates a new UsageLimitedAuthorization object.
+func NewUsageLimitedAuthorization(msgTypeURL string, executions uint64) *UsageLimitedAuthorization {
+	return &UsageLimitedAuthorization{
+		Msg:                 msgTypeURL,
+		RemainingExecutions: executions,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a UsageLimitedAuthorization) MsgTypeURL() string {
+	return a.Msg
+}
+
+// Accept implements Authorization.Accept.
+func (a UsageLimitedAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (AcceptResponse, error) {
+	if a.RemainingExecutions == 0 {
+		return AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap("no executions left")
+	}
+
+	remaining := a.RemainingExecutions - 1
+	if remaining == 0 {
+		return AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return AcceptResponse{Accept: true, Updated: NewUsageLimitedAuthorization(a.Msg, remaining)}, nil
+}
+
+// IsSubsetOf implements SubAuthorization.IsSubsetOf.
+func (a UsageLimitedAuthorization) IsSubsetOf(parent Authorization) bool {
+	p, ok := parent.(*UsageLimitedAuthorization)
+	return ok && a.Msg == p.Msg && a.RemainingExecutions <= p.RemainingExecutions
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a UsageLimitedAuthorization) ValidateBasic() error {
+	if a.RemainingExecutions == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("remaining executions must be positive")
+	}
+	return nil
+}
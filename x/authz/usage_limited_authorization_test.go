@@ -0,0 +1,38 @@
+package authz_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestUsageLimitedAuthorization(t *testing.T) {
+	ctx := testutil.DefaultContext(sdk.NewKVStoreKey("authz"), sdk.NewTransientStoreKey("transient_test"))
+	msgTypeURL := banktypes.SendAuthorization{}.MsgTypeURL()
+	msg := banktypes.NewMsgSend(sdk.AccAddress("from"), sdk.AccAddress("to"), sdk.NewCoins())
+
+	require.Error(t, authz.NewUsageLimitedAuthorization(msgTypeURL, 0).ValidateBasic())
+
+	a := authz.NewUsageLimitedAuthorization(msgTypeURL, 2)
+	require.NoError(t, a.ValidateBasic())
+	require.Equal(t, msgTypeURL, a.MsgTypeURL())
+
+	t.Log("verify the remaining executions are decremented")
+	resp, err := a.Accept(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	require.Equal(t, authz.NewUsageLimitedAuthorization(msgTypeURL, 1), resp.Updated)
+
+	t.Log("verify the authorization is deleted after the last execution")
+	resp, err = resp.Updated.Accept(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.True(t, resp.Delete)
+	require.Nil(t, resp.Updated)
+}
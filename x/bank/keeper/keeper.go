@@ -89,17 +89,21 @@ func (k BaseKeeper) GetPaginatedTotalSupply(ctx sdk.Context, pagination *query.P
 }
 
 // NewBaseKeeper returns a new BaseKeeper object with a given codec, dedicated
-// store key, an AccountKeeper implementation, and a parameter Subspace used to
-// store and fetch module parameters. The BaseKeeper also accepts a
+// store key, an AccountKeeper implementation, and the legacy parameter Subspace
+// the module parameters are migrated from. The parameters themselves are kept
+// in the module store. The BaseKeeper also accepts a
 // blocklist map. This blocklist describes the set of addresses that are not allowed
 // to receive funds through direct and explicit actions, for example, by using a MsgSend or
-// by using a SendCoinsFromModuleToAccount execution.
+// by using a SendCoinsFromModuleToAccount execution. Finally, authority is the
+// address allowed to update the module parameters, typically the x/gov module
+// account.
 func NewBaseKeeper(
 	cdc codec.BinaryCodec,
 	storeKey storetypes.StoreKey,
 	ak types.AccountKeeper,
 	paramSpace paramtypes.Subspace,
 	blockedAddrs map[string]bool,
+	authority string,
 ) BaseKeeper {
 	// set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
@@ -107,7 +111,7 @@ func NewBaseKeeper(
 	}
 
 	return BaseKeeper{
-		BaseSendKeeper:         NewBaseSendKeeper(cdc, storeKey, ak, paramSpace, blockedAddrs),
+		BaseSendKeeper:         NewBaseSendKeeper(cdc, storeKey, ak, paramSpace, blockedAddrs, authority),
 		ak:                     ak,
 		cdc:                    cdc,
 		storeKey:               storeKey,
@@ -315,7 +319,8 @@ func (k BaseKeeper) SetDenomMetaData(ctx sdk.Context, denomMetaData types.Metada
 
 // SendCoinsFromModuleToAccount transfers coins from a ModuleAccount to an AccAddress.
 // It will panic if the module account does not exist. An error is returned if
-// the recipient address is black-listed or if sending the tokens fails.
+// the recipient address, or the one set by the send restriction, is black-listed,
+// if the send restriction rejects the transfer or if sending the tokens fails.
 func (k BaseKeeper) SendCoinsFromModuleToAccount(
 	ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins,
 ) error {
@@ -328,7 +333,18 @@ func (k BaseKeeper) SendCoinsFromModuleToAccount(
 		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", recipientAddr)
 	}
 
-	return k.SendCoins(ctx, senderAddr, recipientAddr, amt)
+	// the send restriction may change the recipient, which must not be blocked
+	// either
+	toAddr, err := k.sendRestriction.apply(ctx, senderAddr, recipientAddr, amt)
+	if err != nil {
+		return err
+	}
+
+	if !toAddr.Equals(recipientAddr) && k.BlockedAddr(toAddr) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", toAddr)
+	}
+
+	return k.sendCoins(ctx, senderAddr, toAddr, amt)
 }
 
 // SendCoinsFromModuleToModule transfers coins from a ModuleAccount to another.
@@ -14,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
@@ -87,7 +88,7 @@ func (suite *IntegrationTestSuite) initKeepersWithmAccPerms(blockedAddrs map[str
 	)
 	keeper := keeper.NewBaseKeeper(
 		appCodec, app.GetKey(types.StoreKey), authKeeper,
-		app.GetSubspace(types.ModuleName), blockedAddrs,
+		app.GetSubspace(types.ModuleName), blockedAddrs, app.BankKeeper.GetAuthority(),
 	)
 
 	return authKeeper, keeper
@@ -458,6 +459,155 @@ func (suite *IntegrationTestSuite) TestSendCoins() {
 	suite.Require().Equal(newBarCoin(25), coins[0], "expected only bar coins in the account balance, got: %v", coins)
 }
 
+func (suite *IntegrationTestSuite) TestSendRestrictions() {
+	app, ctx := suite.app, suite.ctx
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	addr3 := sdk.AccAddress("addr3_______________")
+	escrow := sdk.AccAddress("escrow______________")
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr1, balances))
+
+	// bar coins can only be sent to addr2, foo coins sent to addr3 are escrowed
+	app.BankKeeper.AppendSendRestriction(func(_ sdk.Context, _, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		if !amt.AmountOf(barDenom).IsZero() && !toAddr.Equals(addr2) {
+			return nil, fmt.Errorf("%s cannot receive %s", toAddr, barDenom)
+		}
+		return toAddr, nil
+	})
+	app.BankKeeper.AppendSendRestriction(func(_ sdk.Context, _, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		if !amt.AmountOf(fooDenom).IsZero() && toAddr.Equals(addr3) {
+			return escrow, nil
+		}
+		return toAddr, nil
+	})
+	defer app.BankKeeper.ClearSendRestriction()
+
+	suite.Require().Error(app.BankKeeper.SendCoins(ctx, addr1, addr3, sdk.NewCoins(newBarCoin(10))))
+	suite.Require().Equal(balances, app.BankKeeper.GetAllBalances(ctx, addr1))
+
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newBarCoin(10))))
+	suite.Require().Equal(sdk.NewCoins(newBarCoin(10)), app.BankKeeper.GetAllBalances(ctx, addr2))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr3, sdk.NewCoins(newFooCoin(10))))
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, addr3).IsZero())
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(10)), app.BankKeeper.GetAllBalances(ctx, escrow))
+	suite.Require().Contains(ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeTransfer,
+		sdk.NewAttribute(types.AttributeKeyRecipient, escrow.String()),
+		sdk.NewAttribute(types.AttributeKeySender, addr1.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, newFooCoin(10).String()),
+	))
+
+	// multi-send outputs are restricted independently, and none is executed
+	// when one is rejected
+	inputs := []types.Input{{Address: addr1.String(), Coins: sdk.NewCoins(newFooCoin(20), newBarCoin(10))}}
+	outputs := []types.Output{
+		{Address: addr3.String(), Coins: sdk.NewCoins(newFooCoin(20))},
+		{Address: addr3.String(), Coins: sdk.NewCoins(newBarCoin(10))},
+	}
+	suite.Require().Error(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(90), newBarCoin(40)), app.BankKeeper.GetAllBalances(ctx, addr1))
+
+	outputs[1].Address = addr2.String()
+	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(30)), app.BankKeeper.GetAllBalances(ctx, escrow))
+	suite.Require().Equal(sdk.NewCoins(newBarCoin(20)), app.BankKeeper.GetAllBalances(ctx, addr2))
+
+	// module to account transfers are restricted too
+	suite.Require().NoError(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(newFooCoin(10), newBarCoin(10))))
+	suite.Require().Error(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr3, sdk.NewCoins(newBarCoin(10))))
+	suite.Require().NoError(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr3, sdk.NewCoins(newFooCoin(10))))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(40)), app.BankKeeper.GetAllBalances(ctx, escrow))
+
+	// restrictions registered on the app keeper apply to the copies held by
+	// the other modules, and can be prepended
+	var calls int
+	app.BankKeeper.PrependSendRestriction(func(_ sdk.Context, _, _ sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		calls++
+		return nil, fmt.Errorf("all sends are disabled")
+	})
+	suite.Require().Error(app.DistrKeeper.FundCommunityPool(ctx, sdk.NewCoins(newFooCoin(1)), addr1))
+	suite.Require().Equal(1, calls)
+}
+
+func (suite *IntegrationTestSuite) TestSendCoinsFromModuleToAccountRestrictedToBlockedAddr() {
+	app, ctx := suite.app, suite.ctx
+	addr := sdk.AccAddress("addr________________")
+	blockedAddr := authtypes.NewModuleAddress(minttypes.ModuleName)
+	suite.Require().True(app.BankKeeper.BlockedAddr(blockedAddr))
+
+	// the recipient set by the send restriction can't be a blocked address
+	app.BankKeeper.AppendSendRestriction(func(_ sdk.Context, _, _ sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		return blockedAddr, nil
+	})
+	defer app.BankKeeper.ClearSendRestriction()
+
+	coins := sdk.NewCoins(newFooCoin(10))
+	suite.Require().NoError(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	err := app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr, coins)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	suite.Require().Contains(err.Error(), blockedAddr.String())
+	suite.Require().Equal(coins, app.BankKeeper.GetAllBalances(ctx, blockedAddr))
+}
+
+func (suite *IntegrationTestSuite) TestInputOutputCoinsSendRestriction() {
+	app, ctx := suite.app, suite.ctx
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	addr3 := sdk.AccAddress("addr3_______________")
+	addr4 := sdk.AccAddress("addr4_______________")
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr1, balances))
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr2, balances))
+
+	type call struct {
+		from, to sdk.AccAddress
+		amt      sdk.Coins
+	}
+	var calls []call
+	app.BankKeeper.AppendSendRestriction(func(_ sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		calls = append(calls, call{fromAddr, toAddr, amt})
+		return toAddr, nil
+	})
+	defer app.BankKeeper.ClearSendRestriction()
+
+	// the restriction runs once per output, with the output amount
+	inputs := []types.Input{{Address: addr1.String(), Coins: sdk.NewCoins(newFooCoin(30), newBarCoin(10))}}
+	outputs := []types.Output{
+		{Address: addr3.String(), Coins: sdk.NewCoins(newFooCoin(30))},
+		{Address: addr4.String(), Coins: sdk.NewCoins(newBarCoin(10))},
+	}
+	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	suite.Require().Equal([]call{
+		{addr1, addr3, sdk.NewCoins(newFooCoin(30))},
+		{addr1, addr4, sdk.NewCoins(newBarCoin(10))},
+	}, calls)
+
+	// the sender of an output is undefined with several inputs
+	calls = nil
+	inputs = []types.Input{
+		{Address: addr1.String(), Coins: sdk.NewCoins(newFooCoin(30))},
+		{Address: addr2.String(), Coins: sdk.NewCoins(newFooCoin(30))},
+	}
+	outputs = []types.Output{
+		{Address: addr3.String(), Coins: sdk.NewCoins(newFooCoin(20))},
+		{Address: addr4.String(), Coins: sdk.NewCoins(newFooCoin(40))},
+	}
+	suite.Require().ErrorIs(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs), types.ErrMultipleSenders)
+	suite.Require().Empty(calls)
+	suite.Require().Equal(balances, app.BankKeeper.GetAllBalances(ctx, addr2))
+
+	// without a restriction, several inputs are allowed
+	app.BankKeeper.ClearSendRestriction()
+	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	suite.Require().Empty(calls)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(70), newBarCoin(50)), app.BankKeeper.GetAllBalances(ctx, addr2))
+}
+
 func (suite *IntegrationTestSuite) TestValidateBalance() {
 	app, ctx := suite.app, suite.ctx
 	now := tmtime.Now()
@@ -1054,7 +1204,7 @@ func (suite *IntegrationTestSuite) TestBalanceTrackingEvents() {
 	)
 
 	suite.app.BankKeeper = keeper.NewBaseKeeper(suite.app.AppCodec(), suite.app.GetKey(types.StoreKey),
-		suite.app.AccountKeeper, suite.app.GetSubspace(types.ModuleName), nil)
+		suite.app.AccountKeeper, suite.app.GetSubspace(types.ModuleName), nil, suite.app.BankKeeper.GetAuthority())
 
 	// set account with multiple permissions
 	suite.app.AccountKeeper.SetModuleAccount(suite.ctx, multiPermAcc)
@@ -1215,7 +1365,7 @@ func (suite *IntegrationTestSuite) TestMintCoinRestrictions() {
 
 	for _, test := range tests {
 		suite.app.BankKeeper = keeper.NewBaseKeeper(suite.app.AppCodec(), suite.app.GetKey(types.StoreKey),
-			suite.app.AccountKeeper, suite.app.GetSubspace(types.ModuleName), nil).WithMintCoinsRestriction(keeper.MintingRestrictionFn(test.restrictionFn))
+			suite.app.AccountKeeper, suite.app.GetSubspace(types.ModuleName), nil, suite.app.BankKeeper.GetAuthority()).WithMintCoinsRestriction(keeper.MintingRestrictionFn(test.restrictionFn))
 		for _, testCase := range test.testCases {
 			if testCase.expectPass {
 				suite.Require().NoError(
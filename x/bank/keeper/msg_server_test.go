@@ -0,0 +1,57 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (suite *IntegrationTestSuite) TestMsgUpdateParams() {
+	msgServer := keeper.NewMsgServerImpl(suite.app.BankKeeper)
+	authority := suite.app.BankKeeper.GetAuthority()
+
+	params := types.DefaultParams()
+	params = params.SetSendEnabledParam("foocoin", false)
+
+	invalidParams := types.DefaultParams()
+	invalidParams = invalidParams.SetSendEnabledParam("", true)
+
+	testCases := []struct {
+		name      string
+		input     *types.MsgUpdateParams
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name:      "invalid authority",
+			input:     &types.MsgUpdateParams{Authority: "invalid", Params: params},
+			expErr:    true,
+			expErrMsg: "expected gov account as only signer for proposal message",
+		},
+		{
+			name:      "invalid params",
+			input:     &types.MsgUpdateParams{Authority: authority, Params: invalidParams},
+			expErr:    true,
+			expErrMsg: "invalid denom",
+		},
+		{
+			name:   "all good",
+			input:  &types.MsgUpdateParams{Authority: authority, Params: params},
+			expErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			_, err := msgServer.UpdateParams(sdk.WrapSDKContext(suite.ctx), tc.input)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(params, suite.app.BankKeeper.GetParams(suite.ctx))
+			}
+		})
+	}
+}
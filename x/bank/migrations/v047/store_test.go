@@ -0,0 +1,38 @@
+package v047_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v047bank "github.com/cosmos/cosmos-sdk/x/bank/migrations/v047"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	bankKey := sdk.NewKVStoreKey(types.StoreKey)
+	tBankKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(bankKey, tBankKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, bankKey, tBankKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	// Set non default params in the legacy subspace.
+	params := types.DefaultParams()
+	params = params.SetSendEnabledParam("foocoin", false)
+	paramstore.SetParamSet(ctx, &params)
+
+	// Run migrations.
+	err := v047bank.MigrateStore(ctx, bankKey, paramstore, encCfg.Codec)
+	require.NoError(t, err)
+
+	// Make sure the params are now in the module store.
+	var res types.Params
+	bz := ctx.KVStore(bankKey).Get(types.ParamsKey)
+	require.NoError(t, encCfg.Codec.Unmarshal(bz, &res))
+	require.Equal(t, params, res)
+}
@@ -6,7 +6,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
-var _ authz.Authorization = &SendAuthorization{}
+var (
+	_ authz.Authorization    = &SendAuthorization{}
+	_ authz.SubAuthorization = &SendAuthorization{}
+)
 
 // NewSendAuthorization creates a new SendAuthorization object.
 func NewSendAuthorization(spendLimit sdk.Coins) *SendAuthorization {
@@ -37,6 +40,12 @@ func (a SendAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptRes
 	return authz.AcceptResponse{Accept: true, Delete: false, Updated: &SendAuthorization{SpendLimit: limitLeft}}, nil
 }
 
+// IsSubsetOf implements SubAuthorization.IsSubsetOf.
+func (a SendAuthorization) IsSubsetOf(parent authz.Authorization) bool {
+	p, ok := parent.(*SendAuthorization)
+	return ok && a.SpendLimit.IsAllLTE(p.SpendLimit)
+}
+
 // ValidateBasic implements Authorization.ValidateBasic.
 func (a SendAuthorization) ValidateBasic() error {
 	if a.SpendLimit == nil {
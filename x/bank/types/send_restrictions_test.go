@@ -0,0 +1,53 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestComposeSendRestrictions(t *testing.T) {
+	ctx := sdk.Context{}
+	from := sdk.AccAddress("from________________")
+	to := sdk.AccAddress("to__________________")
+	escrow := sdk.AccAddress("escrow______________")
+	amt := sdk.NewCoins(sdk.NewInt64Coin("stake", 1))
+
+	var calls []string
+	record := func(name string, newTo sdk.AccAddress, err error) types.SendRestrictionFn {
+		return func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+			calls = append(calls, name+":"+string(toAddr))
+			if newTo == nil {
+				newTo = toAddr
+			}
+			return newTo, err
+		}
+	}
+
+	require.Nil(t, types.ComposeSendRestrictions())
+	require.Nil(t, types.ComposeSendRestrictions(nil, nil))
+
+	// the recipient is threaded through the restrictions, in order
+	calls = nil
+	fn := types.ComposeSendRestrictions(record("a", nil, nil), nil, record("b", escrow, nil), record("c", nil, nil))
+	newTo, err := fn(ctx, from, to, amt)
+	require.NoError(t, err)
+	require.Equal(t, escrow, newTo)
+	require.Equal(t, []string{"a:" + string(to), "b:" + string(to), "c:" + string(escrow)}, calls)
+
+	// the first error stops the chain
+	calls = nil
+	expErr := errors.New("restricted")
+	fn = record("a", nil, expErr).Then(record("b", nil, nil))
+	_, err = fn(ctx, from, to, amt)
+	require.ErrorIs(t, err, expErr)
+	require.Equal(t, []string{"a:" + string(to)}, calls)
+
+	newTo, err = types.NoOpSendRestrictionFn(ctx, from, to, amt)
+	require.NoError(t, err)
+	require.Equal(t, to, newTo)
+}
@@ -0,0 +1,149 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+)
+
+// Flag names and values
+const (
+	FlagLimitTypeURLs = "limit-type-urls"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	circuitTxCmd := &cobra.Command{
+		Use:                        circuit.ModuleName,
+		Short:                      "circuit transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	circuitTxCmd.AddCommand(
+		NewCmdAuthorizeCircuitBreaker(),
+		NewCmdTripCircuitBreaker(),
+		NewCmdResetCircuitBreaker(),
+	)
+
+	return circuitTxCmd
+}
+
+// NewCmdAuthorizeCircuitBreaker returns a CLI command handler for creating a
+// MsgAuthorizeCircuitBreaker transaction.
+func NewCmdAuthorizeCircuitBreaker() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "authorize [grantee] [level] --from [granter]",
+		Args:  cobra.ExactArgs(2),
+		Short: "authorize an account to trip or reset circuit breakers",
+		Long: strings.TrimSpace(fmt.Sprintf(`Authorize an account to trip or reset circuit breakers.
+The level is one of "none", "some-msgs", "all-msgs" and "super-admin". The
+some-msgs level must be used together with --%s.
+
+Example:
+$ %s tx %s authorize <grantee> some-msgs --%s /cosmos.bank.v1beta1.MsgSend --from <granter>
+`, FlagLimitTypeURLs, version.AppName, circuit.ModuleName, FlagLimitTypeURLs),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			level, err := parseLevel(args[1])
+			if err != nil {
+				return err
+			}
+
+			limitTypeURLs, err := cmd.Flags().GetStringSlice(FlagLimitTypeURLs)
+			if err != nil {
+				return err
+			}
+
+			perms := circuit.NewPermissions(level, limitTypeURLs...)
+			msg := circuit.NewMsgAuthorizeCircuitBreaker(clientCtx.GetFromAddress().String(), args[0], &perms)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagLimitTypeURLs, nil, "Comma separated Msg type URLs the grantee can trip or reset, with the some-msgs level")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdTripCircuitBreaker returns a CLI command handler for creating a
+// MsgTripCircuitBreaker transaction.
+func NewCmdTripCircuitBreaker() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trip [msg-type-url]... --from [authority]",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "disable the execution of the given Msg type URLs",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s trip /cosmos.bank.v1beta1.MsgSend --from <authority>`, version.AppName, circuit.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := circuit.NewMsgTripCircuitBreaker(clientCtx.GetFromAddress().String(), args)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdResetCircuitBreaker returns a CLI command handler for creating a
+// MsgResetCircuitBreaker transaction.
+func NewCmdResetCircuitBreaker() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reset [msg-type-url]... --from [authority]",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "enable again the execution of the given Msg type URLs",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s reset /cosmos.bank.v1beta1.MsgSend --from <authority>`, version.AppName, circuit.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := circuit.NewMsgResetCircuitBreaker(clientCtx.GetFromAddress().String(), args)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseLevel parses a permission level given on the command line.
+func parseLevel(level string) (circuit.Permissions_Level, error) {
+	switch strings.ToLower(level) {
+	case "none":
+		return circuit.Permissions_LEVEL_NONE_UNSPECIFIED, nil
+	case "some-msgs":
+		return circuit.Permissions_LEVEL_SOME_MSGS, nil
+	case "all-msgs":
+		return circuit.Permissions_LEVEL_ALL_MSGS, nil
+	case "super-admin":
+		return circuit.Permissions_LEVEL_SUPER_ADMIN, nil
+	default:
+		if v, ok := circuit.Permissions_Level_value[level]; ok {
+			return circuit.Permissions_Level(v), nil
+		}
+		return 0, fmt.Errorf("invalid permission level %q", level)
+	}
+}
@@ -0,0 +1,38 @@
+package circuit
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateGenesis check the given genesis state has no integrity issues
+func ValidateGenesis(data GenesisState) error {
+	seen := make(map[string]struct{}, len(data.AccountPermissions))
+	for _, account := range data.AccountPermissions {
+		if _, err := sdk.AccAddressFromBech32(account.Address); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid account address %s: %s", account.Address, err)
+		}
+		if _, ok := seen[account.Address]; ok {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate permissions for account %s", account.Address)
+		}
+		seen[account.Address] = struct{}{}
+
+		if account.Permissions == nil {
+			return sdkerrors.Wrapf(ErrInvalidPermissions, "nil permissions for account %s", account.Address)
+		}
+		if err := account.Permissions.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if len(data.DisabledTypeUrls) == 0 {
+		return nil
+	}
+
+	return validateMsgTypeURLs(data.DisabledTypeUrls)
+}
+
+// DefaultGenesisState - Return a default genesis state
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
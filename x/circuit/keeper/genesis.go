@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+)
+
+// InitGenesis initializes the circuit module's state from a given genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, data *circuit.GenesisState) {
+	for _, account := range data.AccountPermissions {
+		addr := sdk.MustAccAddressFromBech32(account.Address)
+		k.SetPermissions(ctx, addr, *account.Permissions)
+	}
+
+	for _, msgTypeURL := range data.DisabledTypeUrls {
+		k.DisableMsg(ctx, msgTypeURL)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *circuit.GenesisState {
+	var accounts []*circuit.GenesisAccountPermissions
+	k.IteratePermissions(ctx, func(addr sdk.AccAddress, perms circuit.Permissions) bool {
+		accounts = append(accounts, &circuit.GenesisAccountPermissions{
+			Address:     addr.String(),
+			Permissions: &perms,
+		})
+		return false
+	})
+
+	return &circuit.GenesisState{
+		AccountPermissions: accounts,
+		DisabledTypeUrls:   k.GetDisableList(ctx),
+	}
+}
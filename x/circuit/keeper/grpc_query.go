@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+)
+
+var _ circuit.QueryServer = Keeper{}
+
+// Account returns the circuit breaker permissions of an account.
+func (k Keeper) Account(goCtx context.Context, req *circuit.QueryAccountRequest) (*circuit.AccountResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	perms, _ := k.GetPermissions(ctx, addr)
+	return &circuit.AccountResponse{Permission: &perms}, nil
+}
+
+// Accounts returns all the accounts with circuit breaker permissions.
+func (k Keeper) Accounts(goCtx context.Context, req *circuit.QueryAccountsRequest) (*circuit.AccountsResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), circuit.AccountPermissionPrefix)
+
+	var accounts []*circuit.GenesisAccountPermissions
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var perms circuit.Permissions
+		if err := k.cdc.Unmarshal(value, &perms); err != nil {
+			return err
+		}
+
+		accounts = append(accounts, &circuit.GenesisAccountPermissions{
+			Address:     sdk.AccAddress(key).String(),
+			Permissions: &perms,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &circuit.AccountsResponse{Accounts: accounts, Pagination: pageRes}, nil
+}
+
+// DisabledList returns the Msg type URLs whose circuit breaker is tripped.
+func (k Keeper) DisabledList(goCtx context.Context, req *circuit.QueryDisabledListRequest) (*circuit.DisabledListResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &circuit.DisabledListResponse{DisabledList: k.GetDisableList(ctx)}, nil
+}
@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+)
+
+var _ baseapp.CircuitBreaker = Keeper{}
+
+// Keeper of the circuit store
+type Keeper struct {
+	cdc      codec.BinaryCodec
+	storeKey storetypes.StoreKey
+
+	// the address capable of executing all the circuit breaker messages and
+	// granting permissions. Typically, this should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper creates a new circuit Keeper instance
+func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, authority string) Keeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(err)
+	}
+
+	return Keeper{
+		cdc:       cdc,
+		storeKey:  key,
+		authority: authority,
+	}
+}
+
+// GetAuthority returns the x/circuit module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+circuit.ModuleName)
+}
+
+// IsAllowed implements baseapp.CircuitBreaker, it returns false if the
+// circuit breaker of the Msg type URL has been tripped.
+func (k Keeper) IsAllowed(goCtx context.Context, msgTypeURL string) (bool, error) {
+	ctx, ok := goCtx.(sdk.Context)
+	if !ok {
+		ctx = sdk.UnwrapSDKContext(goCtx)
+	}
+
+	return !k.IsMsgDisabled(ctx, msgTypeURL), nil
+}
+
+// GetPermissions returns the circuit breaker permissions of an account.
+func (k Keeper) GetPermissions(ctx sdk.Context, addr sdk.AccAddress) (circuit.Permissions, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(circuit.AccountPermissionKey(addr))
+	if bz == nil {
+		return circuit.Permissions{}, false
+	}
+
+	var perms circuit.Permissions
+	k.cdc.MustUnmarshal(bz, &perms)
+	return perms, true
+}
+
+// SetPermissions sets the circuit breaker permissions of an account, they are
+// removed if the level is LEVEL_NONE_UNSPECIFIED.
+func (k Keeper) SetPermissions(ctx sdk.Context, addr sdk.AccAddress, perms circuit.Permissions) {
+	store := ctx.KVStore(k.storeKey)
+	if perms.Level == circuit.Permissions_LEVEL_NONE_UNSPECIFIED {
+		store.Delete(circuit.AccountPermissionKey(addr))
+		return
+	}
+
+	store.Set(circuit.AccountPermissionKey(addr), k.cdc.MustMarshal(&perms))
+}
+
+// IteratePermissions iterates over all the accounts with circuit breaker
+// permissions, stopping when cb returns true.
+func (k Keeper) IteratePermissions(ctx sdk.Context, cb func(addr sdk.AccAddress, perms circuit.Permissions) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), circuit.AccountPermissionPrefix)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var perms circuit.Permissions
+		k.cdc.MustUnmarshal(iter.Value(), &perms)
+		if cb(sdk.AccAddress(iter.Key()), perms) {
+			break
+		}
+	}
+}
+
+// IsMsgDisabled reports whether the circuit breaker of the Msg type URL has
+// been tripped.
+func (k Keeper) IsMsgDisabled(ctx sdk.Context, msgTypeURL string) bool {
+	return ctx.KVStore(k.storeKey).Has(circuit.DisableListKey(msgTypeURL))
+}
+
+// DisableMsg trips the circuit breaker of the Msg type URL.
+func (k Keeper) DisableMsg(ctx sdk.Context, msgTypeURL string) {
+	ctx.KVStore(k.storeKey).Set(circuit.DisableListKey(msgTypeURL), []byte{0x01})
+}
+
+// EnableMsg resets the circuit breaker of the Msg type URL.
+func (k Keeper) EnableMsg(ctx sdk.Context, msgTypeURL string) {
+	ctx.KVStore(k.storeKey).Delete(circuit.DisableListKey(msgTypeURL))
+}
+
+// IterateDisableList iterates over the disabled Msg type URLs, stopping when
+// cb returns true.
+func (k Keeper) IterateDisableList(ctx sdk.Context, cb func(msgTypeURL string) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), circuit.DisableListPrefix)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb(string(iter.Key())) {
+			break
+		}
+	}
+}
+
+// GetDisableList returns all the disabled Msg type URLs.
+func (k Keeper) GetDisableList(ctx sdk.Context) []string {
+	var msgTypeURLs []string
+	k.IterateDisableList(ctx, func(msgTypeURL string) bool {
+		msgTypeURLs = append(msgTypeURLs, msgTypeURL)
+		return false
+	})
+
+	return msgTypeURLs
+}
@@ -0,0 +1,180 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+	"github.com/cosmos/cosmos-sdk/x/circuit/keeper"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+var msgSendTypeURL = sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+type TestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+	authority   string
+	msgServer   circuit.MsgServer
+	queryClient circuit.QueryClient
+}
+
+func (s *TestSuite) SetupTest() {
+	app := simapp.Setup(s.T(), false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	ctx = ctx.WithBlockHeader(tmproto.Header{Time: tmtime.Now()})
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	circuit.RegisterQueryServer(queryHelper, app.CircuitKeeper)
+
+	s.app = app
+	s.ctx = ctx
+	s.authority = app.CircuitKeeper.GetAuthority()
+	s.msgServer = keeper.NewMsgServerImpl(app.CircuitKeeper)
+	s.queryClient = circuit.NewQueryClient(queryHelper)
+	s.addrs = simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(30000000))
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+func (s *TestSuite) TestAuthorizeCircuitBreaker() {
+	superAdmin := circuit.NewPermissions(circuit.Permissions_LEVEL_SUPER_ADMIN)
+	someMsgs := circuit.NewPermissions(circuit.Permissions_LEVEL_SOME_MSGS, msgSendTypeURL)
+
+	// only the authority or a super admin can grant permissions
+	_, err := s.msgServer.AuthorizeCircuitBreaker(s.ctx, circuit.NewMsgAuthorizeCircuitBreaker(s.addrs[0].String(), s.addrs[1].String(), &someMsgs))
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = s.msgServer.AuthorizeCircuitBreaker(s.ctx, circuit.NewMsgAuthorizeCircuitBreaker(s.authority, s.addrs[0].String(), &superAdmin))
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.AuthorizeCircuitBreaker(s.ctx, circuit.NewMsgAuthorizeCircuitBreaker(s.addrs[0].String(), s.addrs[1].String(), &someMsgs))
+	s.Require().NoError(err)
+
+	perms, found := s.app.CircuitKeeper.GetPermissions(s.ctx, s.addrs[1])
+	s.Require().True(found)
+	s.Require().Equal(someMsgs, perms)
+
+	// a some msgs grantee cannot grant permissions
+	_, err = s.msgServer.AuthorizeCircuitBreaker(s.ctx, circuit.NewMsgAuthorizeCircuitBreaker(s.addrs[1].String(), s.addrs[2].String(), &someMsgs))
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// revoke the permissions
+	none := circuit.NewPermissions(circuit.Permissions_LEVEL_NONE_UNSPECIFIED)
+	_, err = s.msgServer.AuthorizeCircuitBreaker(s.ctx, circuit.NewMsgAuthorizeCircuitBreaker(s.addrs[0].String(), s.addrs[1].String(), &none))
+	s.Require().NoError(err)
+
+	_, found = s.app.CircuitKeeper.GetPermissions(s.ctx, s.addrs[1])
+	s.Require().False(found)
+
+	res, err := s.queryClient.Accounts(s.ctx, &circuit.QueryAccountsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(res.Accounts, 1)
+	s.Require().Equal(s.addrs[0].String(), res.Accounts[0].Address)
+}
+
+func (s *TestSuite) TestTripAndResetCircuitBreaker() {
+	otherTypeURL := sdk.MsgTypeURL(&banktypes.MsgMultiSend{})
+	someMsgs := circuit.NewPermissions(circuit.Permissions_LEVEL_SOME_MSGS, msgSendTypeURL)
+	s.app.CircuitKeeper.SetPermissions(s.ctx, s.addrs[0], someMsgs)
+
+	// no permissions
+	_, err := s.msgServer.TripCircuitBreaker(s.ctx, circuit.NewMsgTripCircuitBreaker(s.addrs[1].String(), []string{msgSendTypeURL}))
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// the type url is not in the grantee's limit list
+	_, err = s.msgServer.TripCircuitBreaker(s.ctx, circuit.NewMsgTripCircuitBreaker(s.addrs[0].String(), []string{otherTypeURL}))
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = s.msgServer.TripCircuitBreaker(s.ctx, circuit.NewMsgTripCircuitBreaker(s.addrs[0].String(), []string{msgSendTypeURL}))
+	s.Require().NoError(err)
+
+	allowed, err := s.app.CircuitKeeper.IsAllowed(s.ctx, msgSendTypeURL)
+	s.Require().NoError(err)
+	s.Require().False(allowed)
+
+	allowed, err = s.app.CircuitKeeper.IsAllowed(sdk.WrapSDKContext(s.ctx), otherTypeURL)
+	s.Require().NoError(err)
+	s.Require().True(allowed)
+
+	_, err = s.msgServer.TripCircuitBreaker(s.ctx, circuit.NewMsgTripCircuitBreaker(s.authority, []string{msgSendTypeURL}))
+	s.Require().ErrorIs(err, circuit.ErrAlreadyDisabled)
+
+	// only the super admins can disable the governance messages
+	voteTypeURL := sdk.MsgTypeURL(&govv1.MsgVote{})
+	s.app.CircuitKeeper.SetPermissions(s.ctx, s.addrs[1], circuit.NewPermissions(circuit.Permissions_LEVEL_ALL_MSGS))
+	_, err = s.msgServer.TripCircuitBreaker(s.ctx, circuit.NewMsgTripCircuitBreaker(s.addrs[1].String(), []string{voteTypeURL}))
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	s.app.CircuitKeeper.SetPermissions(s.ctx, s.addrs[2], circuit.NewPermissions(circuit.Permissions_LEVEL_SUPER_ADMIN))
+	_, err = s.msgServer.TripCircuitBreaker(s.ctx, circuit.NewMsgTripCircuitBreaker(s.addrs[2].String(), []string{voteTypeURL}))
+	s.Require().NoError(err)
+	_, err = s.msgServer.ResetCircuitBreaker(s.ctx, circuit.NewMsgResetCircuitBreaker(s.addrs[1].String(), []string{voteTypeURL}))
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = s.msgServer.ResetCircuitBreaker(s.ctx, circuit.NewMsgResetCircuitBreaker(s.authority, []string{voteTypeURL}))
+	s.Require().NoError(err)
+	s.app.CircuitKeeper.SetPermissions(s.ctx, s.addrs[1], circuit.Permissions{})
+
+	// circuit breaker messages cannot be disabled
+	_, err = s.msgServer.TripCircuitBreaker(s.ctx, circuit.NewMsgTripCircuitBreaker(s.authority, []string{sdk.MsgTypeURL(&circuit.MsgResetCircuitBreaker{})}))
+	s.Require().ErrorIs(err, circuit.ErrCircuitBreakerMsg)
+
+	res, err := s.queryClient.DisabledList(s.ctx, &circuit.QueryDisabledListRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{msgSendTypeURL}, res.DisabledList)
+
+	_, err = s.msgServer.ResetCircuitBreaker(s.ctx, circuit.NewMsgResetCircuitBreaker(s.addrs[1].String(), []string{msgSendTypeURL}))
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = s.msgServer.ResetCircuitBreaker(s.ctx, circuit.NewMsgResetCircuitBreaker(s.addrs[0].String(), []string{msgSendTypeURL}))
+	s.Require().NoError(err)
+
+	allowed, err = s.app.CircuitKeeper.IsAllowed(s.ctx, msgSendTypeURL)
+	s.Require().NoError(err)
+	s.Require().True(allowed)
+
+	_, err = s.msgServer.ResetCircuitBreaker(s.ctx, circuit.NewMsgResetCircuitBreaker(s.authority, []string{msgSendTypeURL}))
+	s.Require().ErrorIs(err, circuit.ErrNotDisabled)
+}
+
+func (s *TestSuite) TestMsgServiceRouterCircuit() {
+	s.app.CircuitKeeper.DisableMsg(s.ctx, msgSendTypeURL)
+
+	handler := s.app.MsgServiceRouter().Handler(&banktypes.MsgSend{})
+	s.Require().NotNil(handler)
+
+	msg := banktypes.NewMsgSend(s.addrs[0], s.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)))
+	_, err := handler(s.ctx, msg)
+	s.Require().ErrorContains(err, "circuit breaker disables execution of this message")
+
+	s.app.CircuitKeeper.EnableMsg(s.ctx, msgSendTypeURL)
+	_, err = handler(s.ctx, msg)
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestGenesis() {
+	genesis := &circuit.GenesisState{
+		AccountPermissions: []*circuit.GenesisAccountPermissions{
+			{Address: s.addrs[0].String(), Permissions: &circuit.Permissions{Level: circuit.Permissions_LEVEL_ALL_MSGS}},
+			{Address: s.addrs[1].String(), Permissions: &circuit.Permissions{Level: circuit.Permissions_LEVEL_SOME_MSGS, LimitTypeUrls: []string{msgSendTypeURL}}},
+		},
+		DisabledTypeUrls: []string{msgSendTypeURL},
+	}
+	s.Require().NoError(circuit.ValidateGenesis(*genesis))
+
+	s.app.CircuitKeeper.InitGenesis(s.ctx, genesis)
+	exported := s.app.CircuitKeeper.ExportGenesis(s.ctx)
+	s.Require().ElementsMatch(genesis.AccountPermissions, exported.AccountPermissions)
+	s.Require().Equal(genesis.DisabledTypeUrls, exported.DisabledTypeUrls)
+}
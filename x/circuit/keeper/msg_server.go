@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ circuit.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the circuit MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) circuit.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// AuthorizeCircuitBreaker implements the Msg/AuthorizeCircuitBreaker method.
+func (srv msgServer) AuthorizeCircuitBreaker(goCtx context.Context, msg *circuit.MsgAuthorizeCircuitBreaker) (*circuit.MsgAuthorizeCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Granter != srv.authority {
+		perms, found := srv.GetPermissions(ctx, granter)
+		if !found || perms.Level != circuit.Permissions_LEVEL_SUPER_ADMIN {
+			return nil, sdkerrors.ErrUnauthorized.Wrapf("%s is not a super admin", msg.Granter)
+		}
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Permissions == nil {
+		return nil, sdkerrors.Wrap(circuit.ErrInvalidPermissions, "permissions cannot be nil")
+	}
+	if err := msg.Permissions.Validate(); err != nil {
+		return nil, err
+	}
+
+	srv.SetPermissions(ctx, grantee, *msg.Permissions)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			circuit.EventTypeAuthorizeCircuitBreaker,
+			sdk.NewAttribute(circuit.AttributeKeyGranter, msg.Granter),
+			sdk.NewAttribute(circuit.AttributeKeyGrantee, msg.Grantee),
+			sdk.NewAttribute(circuit.AttributeKeyLevel, msg.Permissions.Level.String()),
+		),
+	)
+
+	return &circuit.MsgAuthorizeCircuitBreakerResponse{}, nil
+}
+
+// TripCircuitBreaker implements the Msg/TripCircuitBreaker method.
+func (srv msgServer) TripCircuitBreaker(goCtx context.Context, msg *circuit.MsgTripCircuitBreaker) (*circuit.MsgTripCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	for _, msgTypeURL := range msg.MsgTypeUrls {
+		if isCircuitMsg(msgTypeURL) {
+			return nil, sdkerrors.Wrap(circuit.ErrCircuitBreakerMsg, msgTypeURL)
+		}
+
+		if err := srv.checkPermission(ctx, msg.Authority, msgTypeURL); err != nil {
+			return nil, err
+		}
+
+		if srv.IsMsgDisabled(ctx, msgTypeURL) {
+			return nil, sdkerrors.Wrap(circuit.ErrAlreadyDisabled, msgTypeURL)
+		}
+
+		srv.DisableMsg(ctx, msgTypeURL)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				circuit.EventTypeTripCircuitBreaker,
+				sdk.NewAttribute(circuit.AttributeKeyAuthority, msg.Authority),
+				sdk.NewAttribute(circuit.AttributeKeyMsgTypeURL, msgTypeURL),
+			),
+		)
+	}
+
+	srv.Logger(ctx).Info("circuit breaker tripped", "authority", msg.Authority, "msg_type_urls", msg.MsgTypeUrls)
+
+	return &circuit.MsgTripCircuitBreakerResponse{}, nil
+}
+
+// ResetCircuitBreaker implements the Msg/ResetCircuitBreaker method.
+func (srv msgServer) ResetCircuitBreaker(goCtx context.Context, msg *circuit.MsgResetCircuitBreaker) (*circuit.MsgResetCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	for _, msgTypeURL := range msg.MsgTypeUrls {
+		if err := srv.checkPermission(ctx, msg.Authority, msgTypeURL); err != nil {
+			return nil, err
+		}
+
+		if !srv.IsMsgDisabled(ctx, msgTypeURL) {
+			return nil, sdkerrors.Wrap(circuit.ErrNotDisabled, msgTypeURL)
+		}
+
+		srv.EnableMsg(ctx, msgTypeURL)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				circuit.EventTypeResetCircuitBreaker,
+				sdk.NewAttribute(circuit.AttributeKeyAuthority, msg.Authority),
+				sdk.NewAttribute(circuit.AttributeKeyMsgTypeURL, msgTypeURL),
+			),
+		)
+	}
+
+	srv.Logger(ctx).Info("circuit breaker reset", "authority", msg.Authority, "msg_type_urls", msg.MsgTypeUrls)
+
+	return &circuit.MsgResetCircuitBreakerResponse{}, nil
+}
+
+// checkPermission returns an error if the account is not allowed to trip or
+// reset the circuit breaker of the Msg type URL.
+func (srv msgServer) checkPermission(ctx sdk.Context, account string, msgTypeURL string) error {
+	if account == srv.authority {
+		return nil
+	}
+
+	addr, err := sdk.AccAddressFromBech32(account)
+	if err != nil {
+		return err
+	}
+
+	perms, found := srv.GetPermissions(ctx, addr)
+	if !found || !perms.CanToggle(msgTypeURL) {
+		return sdkerrors.ErrUnauthorized.Wrapf("%s cannot toggle the circuit breaker of %s", account, msgTypeURL)
+	}
+
+	return nil
+}
+
+// isCircuitMsg reports whether the Msg type URL belongs to the circuit module,
+// disabling those would make it impossible to reset the circuit breaker.
+func isCircuitMsg(msgTypeURL string) bool {
+	return strings.HasPrefix(msgTypeURL, "/cosmos.circuit.")
+}
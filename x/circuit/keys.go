@@ -0,0 +1,34 @@
+package circuit
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "circuit"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+)
+
+// Keys for circuit store
+// Items are stored with the following key: values
+//
+// - 0x01<address_Bytes>: Permissions
+//
+// - 0x02<msg_type_url_Bytes>: []byte{0x01}
+var (
+	AccountPermissionPrefix = []byte{0x01}
+	DisableListPrefix       = []byte{0x02}
+)
+
+// AccountPermissionKey returns the key under which the circuit breaker
+// permissions of an account are stored.
+func AccountPermissionKey(addr []byte) []byte {
+	return append(append([]byte{}, AccountPermissionPrefix...), addr...)
+}
+
+// DisableListKey returns the key marking a Msg type URL as disabled.
+func DisableListKey(msgTypeURL string) []byte {
+	return append(append([]byte{}, DisableListPrefix...), []byte(msgTypeURL)...)
+}
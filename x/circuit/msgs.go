@@ -0,0 +1,133 @@
+package circuit
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_, _, _ sdk.Msg            = &MsgAuthorizeCircuitBreaker{}, &MsgTripCircuitBreaker{}, &MsgResetCircuitBreaker{}
+	_, _, _ legacytx.LegacyMsg = &MsgAuthorizeCircuitBreaker{}, &MsgTripCircuitBreaker{}, &MsgResetCircuitBreaker{} // For amino support.
+)
+
+// NewMsgAuthorizeCircuitBreaker creates a new MsgAuthorizeCircuitBreaker.
+func NewMsgAuthorizeCircuitBreaker(granter, grantee string, permissions *Permissions) *MsgAuthorizeCircuitBreaker {
+	return &MsgAuthorizeCircuitBreaker{
+		Granter:     granter,
+		Grantee:     grantee,
+		Permissions: permissions,
+	}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgAuthorizeCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Granter); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid granter address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Grantee); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid grantee address: %s", err)
+	}
+	if msg.Permissions == nil {
+		return sdkerrors.Wrap(ErrInvalidPermissions, "permissions cannot be nil")
+	}
+
+	return msg.Permissions.Validate()
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgAuthorizeCircuitBreaker) GetSigners() []sdk.AccAddress {
+	granter, _ := sdk.AccAddressFromBech32(msg.Granter)
+	return []sdk.AccAddress{granter}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgAuthorizeCircuitBreaker) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgAuthorizeCircuitBreaker) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgAuthorizeCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgTripCircuitBreaker creates a new MsgTripCircuitBreaker.
+func NewMsgTripCircuitBreaker(authority string, msgTypeURLs []string) *MsgTripCircuitBreaker {
+	return &MsgTripCircuitBreaker{
+		Authority:   authority,
+		MsgTypeUrls: msgTypeURLs,
+	}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgTripCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return validateMsgTypeURLs(msg.MsgTypeUrls)
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgTripCircuitBreaker) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgTripCircuitBreaker) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgTripCircuitBreaker) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgTripCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgResetCircuitBreaker creates a new MsgResetCircuitBreaker.
+func NewMsgResetCircuitBreaker(authority string, msgTypeURLs []string) *MsgResetCircuitBreaker {
+	return &MsgResetCircuitBreaker{
+		Authority:   authority,
+		MsgTypeUrls: msgTypeURLs,
+	}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgResetCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return validateMsgTypeURLs(msg.MsgTypeUrls)
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgResetCircuitBreaker) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgResetCircuitBreaker) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgResetCircuitBreaker) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgResetCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
@@ -0,0 +1,94 @@
+package circuit_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+)
+
+var (
+	granter = sdk.AccAddress("granter_____________").String()
+	grantee = sdk.AccAddress("grantee_____________").String()
+)
+
+func TestMsgAuthorizeCircuitBreaker(t *testing.T) {
+	testCases := []struct {
+		name   string
+		msg    *circuit.MsgAuthorizeCircuitBreaker
+		expErr bool
+	}{
+		{"valid", circuit.NewMsgAuthorizeCircuitBreaker(granter, grantee, &circuit.Permissions{Level: circuit.Permissions_LEVEL_ALL_MSGS}), false},
+		{"valid some msgs", circuit.NewMsgAuthorizeCircuitBreaker(granter, grantee, &circuit.Permissions{Level: circuit.Permissions_LEVEL_SOME_MSGS, LimitTypeUrls: []string{"/cosmos.bank.v1beta1.MsgSend"}}), false},
+		{"valid revoke", circuit.NewMsgAuthorizeCircuitBreaker(granter, grantee, &circuit.Permissions{}), false},
+		{"invalid granter", circuit.NewMsgAuthorizeCircuitBreaker("foo", grantee, &circuit.Permissions{}), true},
+		{"invalid grantee", circuit.NewMsgAuthorizeCircuitBreaker(granter, "foo", &circuit.Permissions{}), true},
+		{"nil permissions", circuit.NewMsgAuthorizeCircuitBreaker(granter, grantee, nil), true},
+		{"some msgs without type urls", circuit.NewMsgAuthorizeCircuitBreaker(granter, grantee, &circuit.Permissions{Level: circuit.Permissions_LEVEL_SOME_MSGS}), true},
+		{"type urls with all msgs", circuit.NewMsgAuthorizeCircuitBreaker(granter, grantee, &circuit.Permissions{Level: circuit.Permissions_LEVEL_ALL_MSGS, LimitTypeUrls: []string{"/cosmos.bank.v1beta1.MsgSend"}}), true},
+		{"protected type urls with some msgs", circuit.NewMsgAuthorizeCircuitBreaker(granter, grantee, &circuit.Permissions{Level: circuit.Permissions_LEVEL_SOME_MSGS, LimitTypeUrls: []string{"/cosmos.gov.v1.MsgVote"}}), true},
+		{"unknown level", circuit.NewMsgAuthorizeCircuitBreaker(granter, grantee, &circuit.Permissions{Level: 42}), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgTripAndResetCircuitBreaker(t *testing.T) {
+	testCases := []struct {
+		name        string
+		authority   string
+		msgTypeURLs []string
+		expErr      bool
+	}{
+		{"valid", granter, []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.bank.v1beta1.MsgMultiSend"}, false},
+		{"invalid authority", "foo", []string{"/cosmos.bank.v1beta1.MsgSend"}, true},
+		{"empty type urls", granter, nil, true},
+		{"invalid type url", granter, []string{"cosmos.bank.v1beta1.MsgSend"}, true},
+		{"duplicate type url", granter, []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.bank.v1beta1.MsgSend"}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, msg := range []sdk.Msg{
+				circuit.NewMsgTripCircuitBreaker(tc.authority, tc.msgTypeURLs),
+				circuit.NewMsgResetCircuitBreaker(tc.authority, tc.msgTypeURLs),
+			} {
+				err := msg.ValidateBasic()
+				if tc.expErr {
+					require.Error(t, err)
+				} else {
+					require.NoError(t, err)
+				}
+			}
+		})
+	}
+}
+
+func TestPermissionsCanToggle(t *testing.T) {
+	msgSend := "/cosmos.bank.v1beta1.MsgSend"
+	msgMultiSend := "/cosmos.bank.v1beta1.MsgMultiSend"
+
+	require.False(t, circuit.NewPermissions(circuit.Permissions_LEVEL_NONE_UNSPECIFIED).CanToggle(msgSend))
+	require.True(t, circuit.NewPermissions(circuit.Permissions_LEVEL_SOME_MSGS, msgSend).CanToggle(msgSend))
+	require.False(t, circuit.NewPermissions(circuit.Permissions_LEVEL_SOME_MSGS, msgSend).CanToggle(msgMultiSend))
+	require.True(t, circuit.NewPermissions(circuit.Permissions_LEVEL_ALL_MSGS).CanToggle(msgMultiSend))
+	require.True(t, circuit.NewPermissions(circuit.Permissions_LEVEL_SUPER_ADMIN).CanToggle(msgMultiSend))
+
+	// only the super admins can toggle the governance msgs
+	for _, typeURL := range []string{"/cosmos.gov.v1.MsgSubmitProposal", "/cosmos.gov.v1beta1.MsgVote", "/cosmos.authz.v1beta1.MsgExec"} {
+		require.False(t, circuit.NewPermissions(circuit.Permissions_LEVEL_SOME_MSGS, typeURL).CanToggle(typeURL))
+		require.False(t, circuit.NewPermissions(circuit.Permissions_LEVEL_ALL_MSGS).CanToggle(typeURL))
+		require.True(t, circuit.NewPermissions(circuit.Permissions_LEVEL_SUPER_ADMIN).CanToggle(typeURL))
+	}
+}
@@ -0,0 +1,94 @@
+package circuit
+
+import (
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewPermissions returns Permissions of the given level, limited to the given
+// Msg type URLs for LEVEL_SOME_MSGS.
+func NewPermissions(level Permissions_Level, limitTypeURLs ...string) Permissions {
+	return Permissions{
+		Level:         level,
+		LimitTypeUrls: limitTypeURLs,
+	}
+}
+
+// Validate performs a stateless validation of the permissions.
+func (p Permissions) Validate() error {
+	if _, ok := Permissions_Level_name[int32(p.Level)]; !ok {
+		return sdkerrors.Wrapf(ErrInvalidPermissions, "unknown level %d", p.Level)
+	}
+
+	if p.Level != Permissions_LEVEL_SOME_MSGS {
+		if len(p.LimitTypeUrls) > 0 {
+			return sdkerrors.Wrapf(ErrInvalidPermissions, "limit_type_urls cannot be used with level %s", p.Level)
+		}
+		return nil
+	}
+
+	if len(p.LimitTypeUrls) == 0 {
+		return sdkerrors.Wrap(ErrInvalidPermissions, "limit_type_urls must be provided with level LEVEL_SOME_MSGS")
+	}
+
+	for _, typeURL := range p.LimitTypeUrls {
+		if IsProtectedMsg(typeURL) {
+			return sdkerrors.Wrapf(ErrInvalidPermissions, "%s can only be toggled by a super admin", typeURL)
+		}
+	}
+
+	return validateMsgTypeURLs(p.LimitTypeUrls)
+}
+
+// CanToggle reports whether the permissions allow to trip or reset the
+// circuit breaker of the given Msg type URL. Only the super admins can toggle
+// the protected Msgs.
+func (p Permissions) CanToggle(msgTypeURL string) bool {
+	if IsProtectedMsg(msgTypeURL) {
+		return p.Level == Permissions_LEVEL_SUPER_ADMIN
+	}
+
+	switch p.Level {
+	case Permissions_LEVEL_SUPER_ADMIN, Permissions_LEVEL_ALL_MSGS:
+		return true
+	case Permissions_LEVEL_SOME_MSGS:
+		for _, typeURL := range p.LimitTypeUrls {
+			if typeURL == msgTypeURL {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
+
+// IsProtectedMsg reports whether the Msg type URL is one of the governance
+// Msgs, which only super admins can trip: the x/gov Msgs and the authz
+// MsgExec. Otherwise an account allowed to trip any Msg could prevent the
+// governance from revoking its permissions.
+func IsProtectedMsg(msgTypeURL string) bool {
+	return strings.HasPrefix(msgTypeURL, "/cosmos.gov.") || msgTypeURL == "/cosmos.authz.v1beta1.MsgExec"
+}
+
+// validateMsgTypeURLs checks that a list of Msg type URLs is not empty, does
+// not contain duplicates and only holds well formed type URLs.
+func validateMsgTypeURLs(msgTypeURLs []string) error {
+	if len(msgTypeURLs) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("msg type urls cannot be empty")
+	}
+
+	seen := make(map[string]struct{}, len(msgTypeURLs))
+	for _, typeURL := range msgTypeURLs {
+		if !strings.HasPrefix(typeURL, "/") || len(typeURL) == 1 {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid msg type url %q", typeURL)
+		}
+		if _, ok := seen[typeURL]; ok {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate msg type url %q", typeURL)
+		}
+		seen[typeURL] = struct{}{}
+	}
+
+	return nil
+}
@@ -11,8 +11,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
-// BeginBlocker sets the proposer for determining distribution during endblock
-// and distribute rewards for the previous block
+// BeginBlocker sets the proposer for determining distribution during endblock,
+// distribute rewards for the previous block and pay the community pool streams
 func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
@@ -32,6 +32,10 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper)
 		k.AllocateTokens(ctx, sumPreviousPrecommitPower, previousTotalPower, previousProposer, req.LastCommitInfo.GetVotes())
 	}
 
+	// pay the community pool streams out of the community pool, which now
+	// includes the community tax of the previous block
+	k.PayCommunityPoolStreams(ctx)
+
 	// record the proposer for when we payout on the next block
 	consAddr := sdk.ConsAddress(req.Header.ProposerAddress)
 	k.SetPreviousProposerConsAddr(ctx, consAddr)
@@ -32,6 +32,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryValidatorSlashes(),
 		GetCmdQueryDelegatorRewards(),
 		GetCmdQueryCommunityPool(),
+		GetCmdQueryCommunityPoolStream(),
+		GetCmdQueryCommunityPoolStreams(),
 	)
 
 	return distQueryCmd
@@ -364,3 +366,86 @@ $ %s query distribution community-pool
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryCommunityPoolStream returns the command for fetching a community
+// pool stream.
+func GetCmdQueryCommunityPoolStream() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "community-pool-stream [stream-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a community pool stream by its id",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a stream paying coins from the community pool to a recipient.
+
+Example:
+$ %s query distribution community-pool-stream 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			streamID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("stream-id %s not a valid uint, please input a valid stream-id", args[0])
+			}
+
+			res, err := queryClient.CommunityPoolStream(cmd.Context(), &types.QueryCommunityPoolStreamRequest{StreamId: streamID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Stream)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryCommunityPoolStreams returns the command for fetching all the
+// active community pool streams.
+func GetCmdQueryCommunityPoolStreams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "community-pool-streams",
+		Args:  cobra.NoArgs,
+		Short: "Query all the active community pool streams",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the streams paying coins from the community pool to a recipient.
+
+Example:
+$ %s query distribution community-pool-streams
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.CommunityPoolStreams(cmd.Context(), &types.QueryCommunityPoolStreamsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "community pool streams")
+	return cmd
+}
@@ -0,0 +1,240 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// GetCommunityPoolStream returns a community pool stream by its id.
+func (k Keeper) GetCommunityPoolStream(ctx sdk.Context, streamID uint64) (stream types.CommunityPoolStream, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetCommunityPoolStreamKey(streamID))
+	if bz == nil {
+		return stream, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &stream)
+	return stream, true
+}
+
+// SetCommunityPoolStream sets a community pool stream and queues it at its due
+// time.
+func (k Keeper) SetCommunityPoolStream(ctx sdk.Context, stream types.CommunityPoolStream) {
+	store := ctx.KVStore(k.storeKey)
+	if old, found := k.GetCommunityPoolStream(ctx, stream.Id); found {
+		store.Delete(types.GetCommunityPoolStreamQueueKey(old.Id, old.DueTime()))
+	}
+
+	bz := k.cdc.MustMarshal(&stream)
+	store.Set(types.GetCommunityPoolStreamKey(stream.Id), bz)
+	store.Set(types.GetCommunityPoolStreamQueueKey(stream.Id, stream.DueTime()), sdk.Uint64ToBigEndian(stream.Id))
+}
+
+// DeleteCommunityPoolStream deletes a community pool stream and removes it
+// from the queue.
+func (k Keeper) DeleteCommunityPoolStream(ctx sdk.Context, streamID uint64) {
+	stream, found := k.GetCommunityPoolStream(ctx, streamID)
+	if !found {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetCommunityPoolStreamQueueKey(stream.Id, stream.DueTime()))
+	store.Delete(types.GetCommunityPoolStreamKey(streamID))
+}
+
+// IterateCommunityPoolStreams iterates over the community pool streams in
+// ascending id order.
+func (k Keeper) IterateCommunityPoolStreams(ctx sdk.Context, handler func(stream types.CommunityPoolStream) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CommunityPoolStreamPrefix)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var stream types.CommunityPoolStream
+		k.cdc.MustUnmarshal(iter.Value(), &stream)
+		if handler(stream) {
+			break
+		}
+	}
+}
+
+// IterateDueCommunityPoolStreams iterates over the community pool streams due
+// at blockTime, in the order they fell due.
+func (k Keeper) IterateDueCommunityPoolStreams(ctx sdk.Context, blockTime time.Time, handler func(stream types.CommunityPoolStream) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.CommunityPoolStreamQueuePrefix, sdk.PrefixEndBytes(types.GetCommunityPoolStreamQueueTimeKey(blockTime)))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		streamID := sdk.BigEndianToUint64(iter.Value())
+		stream, found := k.GetCommunityPoolStream(ctx, streamID)
+		if !found {
+			panic(fmt.Sprintf("community pool stream %d does not exist", streamID))
+		}
+
+		if handler(stream) {
+			break
+		}
+	}
+}
+
+// GetAllCommunityPoolStreams returns all the community pool streams.
+func (k Keeper) GetAllCommunityPoolStreams(ctx sdk.Context) []types.CommunityPoolStream {
+	streams := make([]types.CommunityPoolStream, 0)
+	k.IterateCommunityPoolStreams(ctx, func(stream types.CommunityPoolStream) (stop bool) {
+		streams = append(streams, stream)
+		return false
+	})
+
+	return streams
+}
+
+// GetNextCommunityPoolStreamID returns the id of the next community pool
+// stream.
+func (k Keeper) GetNextCommunityPoolStreamID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextCommunityPoolStreamIDKey)
+	if bz == nil {
+		return types.DefaultStartingCommunityPoolStreamID
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextCommunityPoolStreamID sets the id of the next community pool stream.
+func (k Keeper) SetNextCommunityPoolStreamID(ctx sdk.Context, streamID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextCommunityPoolStreamIDKey, sdk.Uint64ToBigEndian(streamID))
+}
+
+// CreateCommunityPoolStream creates a stream paying amount from the community
+// pool to the recipient every period, starting one period after the current
+// block. It returns the id of the new stream.
+func (k Keeper) CreateCommunityPoolStream(
+	ctx sdk.Context, recipient sdk.AccAddress, amount sdk.Coins, period time.Duration,
+	maxAmount sdk.Coins, endTime *time.Time,
+) (uint64, error) {
+	if k.bankKeeper.BlockedAddr(recipient) {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", recipient)
+	}
+
+	streamID := k.GetNextCommunityPoolStreamID(ctx)
+	stream := types.NewCommunityPoolStream(
+		streamID, recipient, amount, period, maxAmount, endTime, ctx.BlockTime().Add(period),
+	)
+	if err := stream.Validate(); err != nil {
+		return 0, err
+	}
+
+	k.SetCommunityPoolStream(ctx, stream)
+	k.SetNextCommunityPoolStreamID(ctx, streamID+1)
+
+	return streamID, nil
+}
+
+// CancelCommunityPoolStream removes a community pool stream, stopping its
+// payments.
+func (k Keeper) CancelCommunityPoolStream(ctx sdk.Context, streamID uint64) error {
+	if _, found := k.GetCommunityPoolStream(ctx, streamID); !found {
+		return sdkerrors.Wrapf(types.ErrStreamNotFound, "stream id %d", streamID)
+	}
+
+	k.DeleteCommunityPoolStream(ctx, streamID)
+	return nil
+}
+
+// PayCommunityPoolStreams makes the payments of the community pool streams
+// that are due at the current block time, in the order they fell due. Streams
+// that reached their end time or paid their max amount are removed. A payment
+// the community pool cannot afford is skipped, and a stream is cancelled once
+// MaxCommunityPoolStreamFailedPayments payments in a row were skipped.
+//
+// NOTE: A stream pays at most once per block, so a periodic stream that fell
+// behind catches up one payment per block.
+func (k Keeper) PayCommunityPoolStreams(ctx sdk.Context) {
+	logger := k.Logger(ctx)
+	blockTime := ctx.BlockTime()
+
+	var streams []types.CommunityPoolStream
+	k.IterateDueCommunityPoolStreams(ctx, blockTime, func(stream types.CommunityPoolStream) (stop bool) {
+		streams = append(streams, stream)
+		return false
+	})
+
+	for _, stream := range streams {
+		if stream.IsExpired(blockTime) {
+			k.DeleteCommunityPoolStream(ctx, stream.Id)
+			continue
+		}
+
+		payment := stream.NextPayment()
+		recipient := sdk.MustAccAddressFromBech32(stream.Recipient)
+		stream.NextPaymentTime = stream.NextPaymentTime.Add(stream.Period)
+
+		// pay in a cached context, so a failed payment leaves no partial writes
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.DistributeFromFeePool(cacheCtx, payment, recipient); err != nil {
+			logger.Error(
+				"failed to pay community pool stream",
+				"stream_id", stream.Id,
+				"amount", payment.String(),
+				"err", err,
+			)
+			k.skipCommunityPoolStreamPayment(ctx, stream, payment)
+			continue
+		}
+		write()
+
+		stream.PaidAmount = stream.PaidAmount.Add(payment...)
+		stream.FailedPayments = 0
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCommunityPoolStreamPayment,
+				sdk.NewAttribute(types.AttributeKeyStreamID, fmt.Sprintf("%d", stream.Id)),
+				sdk.NewAttribute(types.AttributeKeyRecipient, stream.Recipient),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, payment.String()),
+			),
+		)
+
+		if stream.IsExhausted() {
+			k.DeleteCommunityPoolStream(ctx, stream.Id)
+			continue
+		}
+		k.SetCommunityPoolStream(ctx, stream)
+	}
+}
+
+// skipCommunityPoolStreamPayment records a payment of the stream the community
+// pool could not afford, cancelling the stream once it failed
+// MaxCommunityPoolStreamFailedPayments payments in a row.
+func (k Keeper) skipCommunityPoolStreamPayment(ctx sdk.Context, stream types.CommunityPoolStream, payment sdk.Coins) {
+	stream.FailedPayments++
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCommunityPoolStreamFailedPayment,
+			sdk.NewAttribute(types.AttributeKeyStreamID, fmt.Sprintf("%d", stream.Id)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, stream.Recipient),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, payment.String()),
+			sdk.NewAttribute(types.AttributeKeyFailedPayments, fmt.Sprintf("%d", stream.FailedPayments)),
+		),
+	)
+
+	if stream.FailedPayments < types.MaxCommunityPoolStreamFailedPayments {
+		k.SetCommunityPoolStream(ctx, stream)
+		return
+	}
+
+	k.DeleteCommunityPoolStream(ctx, stream.Id)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelCommunityPoolStream,
+			sdk.NewAttribute(types.AttributeKeyStreamID, fmt.Sprintf("%d", stream.Id)),
+		),
+	)
+}
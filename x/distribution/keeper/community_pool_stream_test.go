@@ -0,0 +1,229 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+func TestPayCommunityPoolStreams(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Unix(1000, 0).UTC()})
+
+	// reset fee pool
+	app.DistrKeeper.SetFeePool(ctx, types.InitialFeePool())
+
+	addrs := simapp.AddTestAddrs(app, ctx, 4, sdk.ZeroInt())
+	funder, perBlock, periodic, expiring := addrs[0], addrs[1], addrs[2], addrs[3]
+
+	pool := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	require.NoError(t, testutil.FundAccount(app.BankKeeper, ctx, funder, pool))
+	require.NoError(t, app.DistrKeeper.FundCommunityPool(ctx, pool, funder))
+
+	endTime := ctx.BlockTime().Add(2 * time.Hour)
+
+	// 10stake every block, capped at 25stake
+	perBlockID, err := app.DistrKeeper.CreateCommunityPoolStream(
+		ctx, perBlock, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), 0,
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 25)), nil,
+	)
+	require.NoError(t, err)
+	// 5stake every hour, until the end time
+	periodicID, err := app.DistrKeeper.CreateCommunityPoolStream(
+		ctx, periodic, sdk.NewCoins(sdk.NewInt64Coin("stake", 5)), time.Hour, nil, &endTime,
+	)
+	require.NoError(t, err)
+	// 1stake every block, until the end time
+	expiringID, err := app.DistrKeeper.CreateCommunityPoolStream(
+		ctx, expiring, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)), 0, nil, &endTime,
+	)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultStartingCommunityPoolStreamID, perBlockID)
+	require.Equal(t, perBlockID+1, periodicID)
+	require.Equal(t, periodicID+1, expiringID)
+
+	balance := func(addr sdk.AccAddress) int64 {
+		return app.BankKeeper.GetBalance(ctx, addr, "stake").Amount.Int64()
+	}
+
+	// first block: the periodic stream is not due yet
+	app.DistrKeeper.PayCommunityPoolStreams(ctx)
+	require.Equal(t, int64(10), balance(perBlock))
+	require.Equal(t, int64(0), balance(periodic))
+	require.Equal(t, int64(1), balance(expiring))
+
+	// one hour later: the periodic stream is due
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	app.DistrKeeper.PayCommunityPoolStreams(ctx)
+	require.Equal(t, int64(20), balance(perBlock))
+	require.Equal(t, int64(5), balance(periodic))
+	require.Equal(t, int64(2), balance(expiring))
+
+	// the per block stream only pays what is left of its cap and is removed
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute))
+	app.DistrKeeper.PayCommunityPoolStreams(ctx)
+	require.Equal(t, int64(25), balance(perBlock))
+	require.Equal(t, int64(5), balance(periodic))
+	require.Equal(t, int64(3), balance(expiring))
+	_, found := app.DistrKeeper.GetCommunityPoolStream(ctx, perBlockID)
+	require.False(t, found)
+
+	stream, found := app.DistrKeeper.GetCommunityPoolStream(ctx, periodicID)
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 5)), stream.PaidAmount)
+	require.Equal(t, endTime, stream.NextPaymentTime)
+
+	// at the end time the remaining streams are removed without paying
+	ctx = ctx.WithBlockTime(endTime)
+	app.DistrKeeper.PayCommunityPoolStreams(ctx)
+	require.Equal(t, int64(5), balance(periodic))
+	require.Equal(t, int64(3), balance(expiring))
+	require.Empty(t, app.DistrKeeper.GetAllCommunityPoolStreams(ctx))
+
+	require.Equal(t,
+		sdk.NewDecCoinsFromCoins(sdk.NewInt64Coin("stake", 67)),
+		app.DistrKeeper.GetFeePool(ctx).CommunityPool,
+	)
+}
+
+func TestPayCommunityPoolStreamsInsufficientPool(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Unix(1000, 0).UTC()})
+
+	// reset fee pool
+	app.DistrKeeper.SetFeePool(ctx, types.InitialFeePool())
+
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.ZeroInt())
+	funder, recipient := addrs[0], addrs[1]
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	streamID, err := app.DistrKeeper.CreateCommunityPoolStream(ctx, recipient, amount, time.Hour, amount, nil)
+	require.NoError(t, err)
+
+	// the community pool is empty, so the payment is skipped until the next
+	// period
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	app.DistrKeeper.PayCommunityPoolStreams(ctx)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, recipient).IsZero())
+	stream, found := app.DistrKeeper.GetCommunityPoolStream(ctx, streamID)
+	require.True(t, found)
+	require.True(t, stream.PaidAmount.IsZero())
+	require.Equal(t, uint64(1), stream.FailedPayments)
+	require.Equal(t, ctx.BlockTime().Add(time.Hour), stream.NextPaymentTime)
+
+	require.NoError(t, testutil.FundAccount(app.BankKeeper, ctx, funder, amount))
+	require.NoError(t, app.DistrKeeper.FundCommunityPool(ctx, amount, funder))
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second))
+	app.DistrKeeper.PayCommunityPoolStreams(ctx)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, recipient).IsZero())
+
+	ctx = ctx.WithBlockTime(stream.NextPaymentTime)
+	app.DistrKeeper.PayCommunityPoolStreams(ctx)
+	require.Equal(t, amount, app.BankKeeper.GetAllBalances(ctx, recipient))
+	_, found = app.DistrKeeper.GetCommunityPoolStream(ctx, streamID)
+	require.False(t, found)
+}
+
+func TestPayCommunityPoolStreamsCancelsFailingStream(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Unix(1000, 0).UTC()})
+
+	// reset fee pool
+	app.DistrKeeper.SetFeePool(ctx, types.InitialFeePool())
+
+	recipient := simapp.AddTestAddrs(app, ctx, 1, sdk.ZeroInt())[0]
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	streamID, err := app.DistrKeeper.CreateCommunityPoolStream(ctx, recipient, amount, 0, amount, nil)
+	require.NoError(t, err)
+
+	// the community pool stays empty, so every payment fails
+	for i := uint64(1); i < types.MaxCommunityPoolStreamFailedPayments; i++ {
+		ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second)).WithEventManager(sdk.NewEventManager())
+		app.DistrKeeper.PayCommunityPoolStreams(ctx)
+		stream, found := app.DistrKeeper.GetCommunityPoolStream(ctx, streamID)
+		require.True(t, found)
+		require.Equal(t, i, stream.FailedPayments)
+		require.Equal(t, types.EventTypeCommunityPoolStreamFailedPayment, ctx.EventManager().Events()[0].Type)
+	}
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second)).WithEventManager(sdk.NewEventManager())
+	app.DistrKeeper.PayCommunityPoolStreams(ctx)
+	_, found := app.DistrKeeper.GetCommunityPoolStream(ctx, streamID)
+	require.False(t, found)
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 2)
+	require.Equal(t, types.EventTypeCancelCommunityPoolStream, events[1].Type)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, recipient).IsZero())
+}
+
+func TestIterateDueCommunityPoolStreams(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Unix(1000, 0).UTC()})
+
+	recipient := simapp.AddTestAddrs(app, ctx, 1, sdk.ZeroInt())[0]
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	endTime := ctx.BlockTime().Add(30 * time.Minute)
+
+	dailyID, err := app.DistrKeeper.CreateCommunityPoolStream(ctx, recipient, amount, 24*time.Hour, amount, nil)
+	require.NoError(t, err)
+	hourlyID, err := app.DistrKeeper.CreateCommunityPoolStream(ctx, recipient, amount, time.Hour, amount, nil)
+	require.NoError(t, err)
+	// due at its end time, before its first payment
+	endingID, err := app.DistrKeeper.CreateCommunityPoolStream(ctx, recipient, amount, time.Hour, nil, &endTime)
+	require.NoError(t, err)
+
+	dueIDs := func(blockTime time.Time) []uint64 {
+		ids := []uint64{}
+		app.DistrKeeper.IterateDueCommunityPoolStreams(ctx, blockTime, func(stream types.CommunityPoolStream) bool {
+			ids = append(ids, stream.Id)
+			return false
+		})
+		return ids
+	}
+
+	require.Empty(t, dueIDs(ctx.BlockTime()))
+	require.Equal(t, []uint64{endingID}, dueIDs(endTime))
+	require.Equal(t, []uint64{endingID, hourlyID}, dueIDs(ctx.BlockTime().Add(time.Hour)))
+	require.Equal(t, []uint64{endingID, hourlyID, dailyID}, dueIDs(ctx.BlockTime().Add(24*time.Hour)))
+
+	// the queue follows the updates and the removals of the streams
+	stream, found := app.DistrKeeper.GetCommunityPoolStream(ctx, dailyID)
+	require.True(t, found)
+	stream.NextPaymentTime = ctx.BlockTime().Add(time.Minute)
+	app.DistrKeeper.SetCommunityPoolStream(ctx, stream)
+	require.NoError(t, app.DistrKeeper.CancelCommunityPoolStream(ctx, endingID))
+	require.Equal(t, []uint64{dailyID, hourlyID}, dueIDs(ctx.BlockTime().Add(time.Hour)))
+}
+
+func TestCreateAndCancelCommunityPoolStream(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrs(app, ctx, 1, sdk.ZeroInt())
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+
+	// blocked addresses cannot receive payments
+	distrAddr := app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	_, err := app.DistrKeeper.CreateCommunityPoolStream(ctx, distrAddr, amount, 0, amount, nil)
+	require.Error(t, err)
+
+	// unbounded streams are not allowed
+	_, err = app.DistrKeeper.CreateCommunityPoolStream(ctx, addrs[0], amount, 0, nil, nil)
+	require.ErrorIs(t, err, types.ErrInvalidStream)
+
+	streamID, err := app.DistrKeeper.CreateCommunityPoolStream(ctx, addrs[0], amount, 0, amount, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, app.DistrKeeper.CancelCommunityPoolStream(ctx, streamID))
+	_, found := app.DistrKeeper.GetCommunityPoolStream(ctx, streamID)
+	require.False(t, found)
+	require.ErrorIs(t, app.DistrKeeper.CancelCommunityPoolStream(ctx, streamID), types.ErrStreamNotFound)
+}
@@ -9,7 +9,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -704,3 +706,47 @@ func Test100PercentCommissionReward(t *testing.T) {
 	}
 	require.True(t, hasValue)
 }
+
+func TestWithdrawTokenizeShareRecordReward(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	addr := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(1000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+
+	// create validator with no commission and a delegation to tokenize
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	tstaking.CreateValidator(valAddrs[0], valConsPk1, sdk.NewInt(100), true)
+	tstaking.Delegate(addr[1], valAddrs[0], sdk.NewInt(100))
+
+	// end block to bond validator and start new block
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// the owner has no record yet
+	_, err := app.DistrKeeper.WithdrawTokenizeShareRecordReward(ctx, addr[1])
+	require.ErrorIs(t, err, stakingtypes.ErrTokenizeShareRecordNotExists)
+
+	msgServer := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), stakingtypes.NewMsgTokenizeShares(
+		addr[1], valAddrs[0], sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), addr[1],
+	))
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some rewards
+	initial := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	tokens := sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)}
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, distrtypes.ModuleName, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initial))))
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, tokens)
+
+	// the record holds half of the delegator shares
+	balanceBefore := app.BankKeeper.GetBalance(ctx, addr[1], sdk.DefaultBondDenom)
+	rewards, err := app.DistrKeeper.WithdrawTokenizeShareRecordReward(ctx, addr[1])
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(2))), rewards)
+	require.Equal(t, balanceBefore.Amount.Add(initial.QuoRaw(2)), app.BankKeeper.GetBalance(ctx, addr[1], sdk.DefaultBondDenom).Amount)
+}
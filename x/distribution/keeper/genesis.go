@@ -76,6 +76,12 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		}
 		k.SetValidatorSlashEvent(ctx, valAddr, evt.Height, evt.Period, evt.ValidatorSlashEvent)
 	}
+	for _, stream := range data.CommunityPoolStreams {
+		k.SetCommunityPoolStream(ctx, stream)
+	}
+	if data.NextCommunityPoolStreamId != 0 {
+		k.SetNextCommunityPoolStreamID(ctx, data.NextCommunityPoolStreamId)
+	}
 
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
@@ -181,5 +187,8 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	streams := k.GetAllCommunityPoolStreams(ctx)
+	nextStreamID := k.GetNextCommunityPoolStreamID(ctx)
+
+	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes, streams, nextStreamID)
 }
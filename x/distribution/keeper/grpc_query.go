@@ -27,8 +27,7 @@ func NewQuerier(keeper Keeper) Querier {
 // Params queries params of distribution module
 func (k Querier) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	var params types.Params
-	k.paramSpace.GetParamSet(ctx, &params)
+	params := k.GetParams(ctx)
 
 	return &types.QueryParamsResponse{Params: params}, nil
 }
@@ -294,3 +293,44 @@ func (k Querier) CommunityPool(c context.Context, req *types.QueryCommunityPoolR
 
 	return &types.QueryCommunityPoolResponse{Pool: pool}, nil
 }
+
+// CommunityPoolStream queries a community pool stream by its id
+func (k Querier) CommunityPoolStream(c context.Context, req *types.QueryCommunityPoolStreamRequest) (*types.QueryCommunityPoolStreamResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	stream, found := k.GetCommunityPoolStream(ctx, req.StreamId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "community pool stream %d not found", req.StreamId)
+	}
+
+	return &types.QueryCommunityPoolStreamResponse{Stream: stream}, nil
+}
+
+// CommunityPoolStreams queries all the active community pool streams
+func (k Querier) CommunityPoolStreams(c context.Context, req *types.QueryCommunityPoolStreamsRequest) (*types.QueryCommunityPoolStreamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	streamsStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.CommunityPoolStreamPrefix)
+
+	streams := []types.CommunityPoolStream{}
+	pageRes, err := query.Paginate(streamsStore, req.Pagination, func(key []byte, value []byte) error {
+		var stream types.CommunityPoolStream
+		if err := k.cdc.Unmarshal(value, &stream); err != nil {
+			return err
+		}
+
+		streams = append(streams, stream)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCommunityPoolStreamsResponse{Streams: streams, Pagination: pageRes}, nil
+}
@@ -4,6 +4,7 @@ import (
 	gocontext "context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 
@@ -659,6 +660,27 @@ func (suite *KeeperTestSuite) TestGRPCCommunityPool() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCCommunityPoolStreams() {
+	app, ctx, queryClient, addrs := suite.app, suite.ctx, suite.queryClient, suite.addrs
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	streamID, err := app.DistrKeeper.CreateCommunityPoolStream(ctx, addrs[0], amount, time.Hour, amount, nil)
+	suite.Require().NoError(err)
+	stream, found := app.DistrKeeper.GetCommunityPoolStream(ctx, streamID)
+	suite.Require().True(found)
+
+	res, err := queryClient.CommunityPoolStream(gocontext.Background(), &types.QueryCommunityPoolStreamRequest{StreamId: streamID})
+	suite.Require().NoError(err)
+	suite.Require().Equal(stream, res.Stream)
+
+	_, err = queryClient.CommunityPoolStream(gocontext.Background(), &types.QueryCommunityPoolStreamRequest{StreamId: streamID + 1})
+	suite.Require().Error(err)
+
+	streamsRes, err := queryClient.CommunityPoolStreams(gocontext.Background(), &types.QueryCommunityPoolStreamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.CommunityPoolStream{stream}, streamsRes.Streams)
+}
+
 func TestDistributionTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+func (suite *KeeperTestSuite) TestMsgUpdateParams() {
+	msgServer := keeper.NewMsgServerImpl(suite.app.DistrKeeper)
+	authority := suite.app.DistrKeeper.GetAuthority()
+
+	params := types.DefaultParams()
+	params.CommunityTax = sdk.NewDecWithPrec(5, 2)
+
+	invalidParams := types.DefaultParams()
+	invalidParams.CommunityTax = sdk.NewDec(2)
+
+	testCases := []struct {
+		name      string
+		input     *types.MsgUpdateParams
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name:      "invalid authority",
+			input:     &types.MsgUpdateParams{Authority: "invalid", Params: params},
+			expErr:    true,
+			expErrMsg: "expected gov account as only signer for proposal message",
+		},
+		{
+			name:      "invalid params",
+			input:     &types.MsgUpdateParams{Authority: authority, Params: invalidParams},
+			expErr:    true,
+			expErrMsg: "community tax should be non-negative and less than one",
+		},
+		{
+			name:   "all good",
+			input:  &types.MsgUpdateParams{Authority: authority, Params: params},
+			expErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			_, err := msgServer.UpdateParams(sdk.WrapSDKContext(suite.ctx), tc.input)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(params, suite.app.DistrKeeper.GetParams(suite.ctx))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgCommunityPoolStream() {
+	msgServer := keeper.NewMsgServerImpl(suite.app.DistrKeeper)
+	authority := suite.app.DistrKeeper.GetAuthority()
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+
+	_, err := msgServer.CreateCommunityPoolStream(sdk.WrapSDKContext(suite.ctx),
+		types.NewMsgCreateCommunityPoolStream("invalid", suite.addrs[0], amount, 0, amount, nil))
+	suite.Require().ErrorContains(err, "expected gov account as only signer for proposal message")
+
+	res, err := msgServer.CreateCommunityPoolStream(sdk.WrapSDKContext(suite.ctx),
+		types.NewMsgCreateCommunityPoolStream(authority, suite.addrs[0], amount, 0, amount, nil))
+	suite.Require().NoError(err)
+	_, found := suite.app.DistrKeeper.GetCommunityPoolStream(suite.ctx, res.StreamId)
+	suite.Require().True(found)
+
+	_, err = msgServer.CancelCommunityPoolStream(sdk.WrapSDKContext(suite.ctx),
+		types.NewMsgCancelCommunityPoolStream("invalid", res.StreamId))
+	suite.Require().ErrorContains(err, "expected gov account as only signer for proposal message")
+
+	_, err = msgServer.CancelCommunityPoolStream(sdk.WrapSDKContext(suite.ctx),
+		types.NewMsgCancelCommunityPoolStream(authority, res.StreamId))
+	suite.Require().NoError(err)
+	_, found = suite.app.DistrKeeper.GetCommunityPoolStream(suite.ctx, res.StreamId)
+	suite.Require().False(found)
+}
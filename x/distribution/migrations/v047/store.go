@@ -0,0 +1,28 @@
+package v047
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateStore performs in-place store migrations from v0.46 to v0.47. The
+// migration includes:
+//
+// - Move the params from the x/params subspace to the x/distribution module
+// store.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, legacySubspace paramtypes.Subspace, cdc codec.BinaryCodec) error {
+	var params types.Params
+	legacySubspace.GetParamSet(ctx, &params)
+
+	if err := params.ValidateBasic(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(storeKey)
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+
+	return nil
+}
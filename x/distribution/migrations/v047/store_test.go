@@ -0,0 +1,38 @@
+package v047_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v047distribution "github.com/cosmos/cosmos-sdk/x/distribution/migrations/v047"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	distrKey := sdk.NewKVStoreKey(types.StoreKey)
+	tDistrKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(distrKey, tDistrKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, distrKey, tDistrKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	// Set non default params in the legacy subspace.
+	params := types.DefaultParams()
+	params.CommunityTax = sdk.NewDecWithPrec(5, 2)
+	paramstore.SetParamSet(ctx, &params)
+
+	// Run migrations.
+	err := v047distribution.MigrateStore(ctx, distrKey, paramstore, encCfg.Codec)
+	require.NoError(t, err)
+
+	// Make sure the params are now in the module store.
+	var res types.Params
+	bz := ctx.KVStore(distrKey).Get(types.ParamsKey)
+	require.NoError(t, encCfg.Codec.Unmarshal(bz, &res))
+	require.Equal(t, params, res)
+}
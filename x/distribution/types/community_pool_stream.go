@@ -0,0 +1,107 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxCommunityPoolStreamFailedPayments is the number of consecutive payments
+// the community pool can't afford after which a stream is cancelled.
+const MaxCommunityPoolStreamFailedPayments = 10
+
+// NewCommunityPoolStream creates a new CommunityPoolStream instance
+//
+//nolint:interfacer
+func NewCommunityPoolStream(
+	id uint64, recipient sdk.AccAddress, amount sdk.Coins, period time.Duration,
+	maxAmount sdk.Coins, endTime *time.Time, nextPaymentTime time.Time,
+) CommunityPoolStream {
+	return CommunityPoolStream{
+		Id:              id,
+		Recipient:       recipient.String(),
+		Amount:          amount,
+		Period:          period,
+		MaxAmount:       maxAmount,
+		EndTime:         endTime,
+		PaidAmount:      sdk.NewCoins(),
+		NextPaymentTime: nextPaymentTime,
+	}
+}
+
+// Validate performs a stateless validation of the community pool stream.
+func (s CommunityPoolStream) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(s.Recipient); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+	if err := ValidateCommunityPoolStreamTerms(s.Amount, s.Period, s.MaxAmount, s.EndTime); err != nil {
+		return err
+	}
+	if !s.PaidAmount.IsValid() {
+		return sdkerrors.Wrapf(ErrInvalidStream, "invalid paid amount %s", s.PaidAmount)
+	}
+	if !s.MaxAmount.Empty() && !s.MaxAmount.IsAllGTE(s.PaidAmount) {
+		return sdkerrors.Wrapf(ErrInvalidStream, "paid amount %s exceeds max amount %s", s.PaidAmount, s.MaxAmount)
+	}
+	if s.FailedPayments >= MaxCommunityPoolStreamFailedPayments {
+		return sdkerrors.Wrapf(ErrInvalidStream, "%d failed payments, must be less than %d", s.FailedPayments, MaxCommunityPoolStreamFailedPayments)
+	}
+
+	return nil
+}
+
+// IsExhausted returns true if the stream has paid its max amount.
+func (s CommunityPoolStream) IsExhausted() bool {
+	return !s.MaxAmount.Empty() && s.PaidAmount.IsAllGTE(s.MaxAmount)
+}
+
+// IsExpired returns true if the stream has reached its end time.
+func (s CommunityPoolStream) IsExpired(blockTime time.Time) bool {
+	return s.EndTime != nil && !blockTime.Before(*s.EndTime)
+}
+
+// DueTime returns the time from which the stream has to be processed, which is
+// its next payment time or its end time, whichever comes first.
+func (s CommunityPoolStream) DueTime() time.Time {
+	if s.EndTime != nil && s.EndTime.Before(s.NextPaymentTime) {
+		return *s.EndTime
+	}
+
+	return s.NextPaymentTime
+}
+
+// NextPayment returns the amount of the next payment of the stream, which is
+// the stream amount capped at what is left of its max amount.
+func (s CommunityPoolStream) NextPayment() sdk.Coins {
+	if s.MaxAmount.Empty() {
+		return s.Amount
+	}
+
+	return s.Amount.Min(s.MaxAmount.Sub(s.PaidAmount...))
+}
+
+// ValidateCommunityPoolStreamTerms validates the payment terms of a community
+// pool stream. A stream must be bounded by a max amount, an end time or both,
+// and a max amount must cap exactly the denoms of the amount.
+func ValidateCommunityPoolStreamTerms(amount sdk.Coins, period time.Duration, maxAmount sdk.Coins, endTime *time.Time) error {
+	if !amount.IsValid() || amount.Empty() {
+		return sdkerrors.Wrapf(ErrInvalidStream, "invalid amount %s", amount)
+	}
+	if period < 0 {
+		return sdkerrors.Wrapf(ErrInvalidStream, "period cannot be negative: %s", period)
+	}
+	if !maxAmount.IsValid() {
+		return sdkerrors.Wrapf(ErrInvalidStream, "invalid max amount %s", maxAmount)
+	}
+	if maxAmount.Empty() && endTime == nil {
+		return sdkerrors.Wrap(ErrInvalidStream, "either a max amount or an end time must be set")
+	}
+	// a denom of the max amount missing from the amount would never be paid,
+	// and the stream would never be exhausted.
+	if !maxAmount.Empty() && (!amount.DenomsSubsetOf(maxAmount) || !maxAmount.DenomsSubsetOf(amount)) {
+		return sdkerrors.Wrapf(ErrInvalidStream, "max amount %s must have the same denoms as amount %s", maxAmount, amount)
+	}
+
+	return nil
+}
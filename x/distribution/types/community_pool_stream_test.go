@@ -0,0 +1,58 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+func TestValidateCommunityPoolStreamTerms(t *testing.T) {
+	endTime := time.Unix(1000, 0).UTC()
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+
+	tests := []struct {
+		name      string
+		amount    sdk.Coins
+		maxAmount sdk.Coins
+		endTime   *time.Time
+		wantErr   bool
+	}{
+		{"max amount", amount, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), nil, false},
+		{"end time", amount, nil, &endTime, false},
+		{"unbounded", amount, nil, nil, true},
+		{"empty amount", sdk.NewCoins(), sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), nil, true},
+		{"amount denom not capped", amount.Add(sdk.NewInt64Coin("atom", 1)), sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), nil, true},
+		{"max amount denom never paid", amount, sdk.NewCoins(sdk.NewInt64Coin("atom", 1), sdk.NewInt64Coin("stake", 100)), &endTime, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := types.ValidateCommunityPoolStreamTerms(tt.amount, time.Hour, tt.maxAmount, tt.endTime)
+			if tt.wantErr {
+				require.ErrorIs(t, err, types.ErrInvalidStream)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCommunityPoolStreamIsExhausted(t *testing.T) {
+	recipient := sdk.AccAddress("recipient")
+	amount := sdk.NewCoins(sdk.NewInt64Coin("atom", 5), sdk.NewInt64Coin("stake", 10))
+	maxAmount := sdk.NewCoins(sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin("stake", 15))
+	stream := types.NewCommunityPoolStream(1, recipient, amount, time.Hour, maxAmount, nil, time.Unix(0, 0).UTC())
+	require.NoError(t, stream.Validate())
+
+	// every payment is capped at what is left of the max amount, until all of
+	// its denoms are paid
+	for i := 0; i < 2; i++ {
+		require.False(t, stream.IsExhausted())
+		stream.PaidAmount = stream.PaidAmount.Add(stream.NextPayment()...)
+	}
+	require.Equal(t, maxAmount, stream.PaidAmount)
+	require.True(t, stream.IsExhausted())
+}
@@ -10,15 +10,20 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -80,11 +85,12 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 // The reference count indicates the number of objects
 // which might need to reference this historical entry at any point.
 // ReferenceCount =
-//    number of outstanding delegations which ended the associated period (and
-//    might need to read that record)
-//  + number of slashes which ended the associated period (and might need to
-//  read that record)
-//  + one per validator for the zeroeth period, set on initialization
+//
+//	  number of outstanding delegations which ended the associated period (and
+//	  might need to read that record)
+//	+ number of slashes which ended the associated period (and might need to
+//	read that record)
+//	+ one per validator for the zeroeth period, set on initialization
 type ValidatorHistoricalRewards struct {
 	CumulativeRewardRatio github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=cumulative_reward_ratio,json=cumulativeRewardRatio,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"cumulative_reward_ratio"`
 	ReferenceCount        uint32                                      `protobuf:"varint,2,opt,name=reference_count,json=referenceCount,proto3" json:"reference_count,omitempty"`
@@ -606,6 +612,132 @@ func (m *CommunityPoolSpendProposalWithDeposit) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommunityPoolSpendProposalWithDeposit proto.InternalMessageInfo
 
+// CommunityPoolStream defines a continuous payment from the community pool to
+// a recipient. The payments are made in the distribution BeginBlock until the
+// max amount is paid or the end time is reached.
+//
+// Since: cosmos-sdk 0.47
+type CommunityPoolStream struct {
+	// id is the unique identifier of the stream.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// recipient is the address receiving the payments.
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is paid to the recipient every period.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// period is the time between two payments. A zero period pays the amount
+	// every block.
+	Period time.Duration `protobuf:"bytes,4,opt,name=period,proto3,stdduration" json:"period"`
+	// max_amount caps the total amount paid by the stream. An empty max amount
+	// leaves the stream uncapped.
+	MaxAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=max_amount,json=maxAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_amount"`
+	// end_time is the time from which the stream is no longer paid. A nil end
+	// time leaves the stream open-ended.
+	EndTime *time.Time `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty"`
+	// paid_amount is the total amount paid by the stream so far.
+	PaidAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=paid_amount,json=paidAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"paid_amount"`
+	// next_payment_time is the time from which the next payment is due.
+	NextPaymentTime time.Time `protobuf:"bytes,8,opt,name=next_payment_time,json=nextPaymentTime,proto3,stdtime" json:"next_payment_time"`
+	// failed_payments is the number of consecutive payments the community pool
+	// could not afford.
+	FailedPayments uint64 `protobuf:"varint,9,opt,name=failed_payments,json=failedPayments,proto3" json:"failed_payments,omitempty"`
+}
+
+func (m *CommunityPoolStream) Reset()         { *m = CommunityPoolStream{} }
+func (m *CommunityPoolStream) String() string { return proto.CompactTextString(m) }
+func (*CommunityPoolStream) ProtoMessage()    {}
+func (*CommunityPoolStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{12}
+}
+func (m *CommunityPoolStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommunityPoolStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommunityPoolStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommunityPoolStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityPoolStream.Merge(m, src)
+}
+func (m *CommunityPoolStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommunityPoolStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityPoolStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityPoolStream proto.InternalMessageInfo
+
+func (m *CommunityPoolStream) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *CommunityPoolStream) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *CommunityPoolStream) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *CommunityPoolStream) GetPeriod() time.Duration {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *CommunityPoolStream) GetMaxAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxAmount
+	}
+	return nil
+}
+
+func (m *CommunityPoolStream) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+func (m *CommunityPoolStream) GetPaidAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PaidAmount
+	}
+	return nil
+}
+
+func (m *CommunityPoolStream) GetNextPaymentTime() time.Time {
+	if m != nil {
+		return m.NextPaymentTime
+	}
+	return time.Time{}
+}
+
+func (m *CommunityPoolStream) GetFailedPayments() uint64 {
+	if m != nil {
+		return m.FailedPayments
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.distribution.v1beta1.Params")
 	proto.RegisterType((*ValidatorHistoricalRewards)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewards")
@@ -619,6 +751,7 @@ func init() {
 	proto.RegisterType((*DelegatorStartingInfo)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfo")
 	proto.RegisterType((*DelegationDelegatorReward)(nil), "cosmos.distribution.v1beta1.DelegationDelegatorReward")
 	proto.RegisterType((*CommunityPoolSpendProposalWithDeposit)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit")
+	proto.RegisterType((*CommunityPoolStream)(nil), "cosmos.distribution.v1beta1.CommunityPoolStream")
 }
 
 func init() {
@@ -626,66 +759,78 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 941 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xf7, 0x34, 0x8e, 0x93, 0x4e, 0xbf, 0x4d, 0xbe, 0x4c, 0x9c, 0xd4, 0x71, 0x2b, 0x3b, 0x5a,
-	0x89, 0x12, 0xa8, 0xe2, 0x34, 0xed, 0x2d, 0xe2, 0x12, 0x3b, 0x41, 0x70, 0x6a, 0xb4, 0x41, 0x80,
-	0xb8, 0xac, 0xc6, 0xbb, 0x2f, 0xf6, 0x28, 0xbb, 0x33, 0xcb, 0xcc, 0xac, 0x93, 0x9e, 0x7b, 0xe0,
-	0xc7, 0x09, 0x89, 0x0b, 0xe2, 0x80, 0x72, 0x44, 0x9c, 0xf3, 0x0f, 0x70, 0xab, 0x38, 0x95, 0x5e,
-	0x40, 0x1c, 0x02, 0x4a, 0x2e, 0x88, 0xbf, 0x02, 0xcd, 0xce, 0x78, 0xed, 0x40, 0x88, 0x7a, 0x88,
-	0xc5, 0xc9, 0x9e, 0xf7, 0x66, 0x3f, 0x3f, 0xde, 0xbc, 0x7d, 0xb3, 0xb8, 0x15, 0x0a, 0x95, 0x08,
-	0xb5, 0x1e, 0x31, 0xa5, 0x25, 0xeb, 0x66, 0x9a, 0x09, 0xbe, 0x3e, 0xd8, 0xe8, 0x82, 0xa6, 0x1b,
-	0x17, 0x82, 0xad, 0x54, 0x0a, 0x2d, 0xc8, 0x5d, 0xbb, 0xbf, 0x75, 0x21, 0xe5, 0xf6, 0xd7, 0xab,
-	0x3d, 0xd1, 0x13, 0xf9, 0xbe, 0x75, 0xf3, 0xcf, 0x3e, 0x52, 0x6f, 0x38, 0x8a, 0x2e, 0x55, 0x50,
-	0x40, 0x87, 0x82, 0x39, 0xc8, 0xfa, 0xb2, 0xcd, 0x07, 0xf6, 0x41, 0x87, 0x9f, 0x2f, 0xbc, 0x4f,
-	0xa7, 0x70, 0x65, 0x97, 0x4a, 0x9a, 0x28, 0x42, 0xf1, 0xed, 0x50, 0x24, 0x49, 0xc6, 0x99, 0x7e,
-	0x1a, 0x68, 0x7a, 0x54, 0x43, 0x2b, 0x68, 0xf5, 0x66, 0xfb, 0xed, 0xe7, 0xa7, 0xcd, 0xd2, 0xaf,
-	0xa7, 0xcd, 0xfb, 0x3d, 0xa6, 0xfb, 0x59, 0xb7, 0x15, 0x8a, 0xc4, 0x41, 0xb8, 0x9f, 0x35, 0x15,
-	0x1d, 0xac, 0xeb, 0xa7, 0x29, 0xa8, 0xd6, 0x36, 0x84, 0x2f, 0x4f, 0xd6, 0xb0, 0x63, 0xd8, 0x86,
-	0xd0, 0xff, 0x5f, 0x01, 0xf9, 0x3e, 0x3d, 0x22, 0x1c, 0x57, 0x8d, 0x46, 0x23, 0x24, 0x15, 0x0a,
-	0x64, 0x20, 0xe1, 0x90, 0xca, 0xa8, 0x76, 0xe3, 0x1a, 0x98, 0x88, 0x41, 0xde, 0x75, 0xc0, 0x7e,
-	0x8e, 0x4b, 0x52, 0xbc, 0xd8, 0x15, 0x3c, 0x53, 0xff, 0x20, 0x9c, 0xba, 0x06, 0xc2, 0x85, 0x1c,
-	0xfa, 0x6f, 0x8c, 0x8f, 0xf0, 0xe2, 0x21, 0xd3, 0xfd, 0x48, 0xd2, 0xc3, 0x80, 0x46, 0x91, 0x0c,
-	0x80, 0xd3, 0x6e, 0x0c, 0x51, 0xad, 0xbc, 0x82, 0x56, 0x67, 0xfd, 0x85, 0x61, 0x72, 0x2b, 0x8a,
-	0xe4, 0x8e, 0x4d, 0x6d, 0x96, 0xbf, 0x3e, 0x6e, 0x96, 0xbc, 0x9f, 0x10, 0xae, 0x7f, 0x40, 0x63,
-	0x16, 0x51, 0x2d, 0xe4, 0xbb, 0x4c, 0x69, 0x21, 0x59, 0x48, 0x63, 0x8b, 0xab, 0xc8, 0xe7, 0x08,
-	0xdf, 0x09, 0xb3, 0x24, 0x8b, 0xa9, 0x66, 0x03, 0x70, 0x3e, 0x02, 0x49, 0x35, 0x13, 0x35, 0xb4,
-	0x32, 0xb5, 0x7a, 0xeb, 0xd1, 0x3d, 0xd7, 0x69, 0x2d, 0x53, 0x88, 0x61, 0xc7, 0x18, 0xa5, 0x1d,
-	0xc1, 0x78, 0xfb, 0xb1, 0xf1, 0xfa, 0xfd, 0x6f, 0xcd, 0x07, 0xaf, 0xe6, 0xd5, 0x3c, 0xa3, 0xfc,
-	0xc5, 0x11, 0xa3, 0xd5, 0xe1, 0x1b, 0x3e, 0xf2, 0x06, 0x9e, 0x97, 0xb0, 0x0f, 0x12, 0x78, 0x08,
-	0x41, 0x28, 0x32, 0xae, 0xf3, 0x13, 0xbc, 0xed, 0xcf, 0x15, 0xe1, 0x8e, 0x89, 0x7a, 0xdf, 0x22,
-	0x7c, 0xa7, 0xf0, 0xd4, 0xc9, 0xa4, 0x04, 0xae, 0x87, 0x86, 0x0e, 0xf0, 0x8c, 0x35, 0xa1, 0x26,
-	0xa7, 0x7f, 0xc8, 0x40, 0x96, 0x70, 0x25, 0x05, 0xc9, 0x84, 0x6d, 0xb5, 0xb2, 0xef, 0x56, 0xde,
-	0x57, 0x08, 0x37, 0x0a, 0x81, 0x5b, 0xa1, 0xb3, 0x0b, 0x51, 0x47, 0x24, 0x09, 0x53, 0x8a, 0x09,
-	0x4e, 0x3e, 0xc1, 0x38, 0x2c, 0x56, 0x93, 0x93, 0x3a, 0x46, 0xe2, 0x7d, 0x81, 0xf0, 0xdd, 0x42,
-	0xd5, 0x93, 0x4c, 0x2b, 0x4d, 0x79, 0xc4, 0x78, 0xef, 0xbf, 0x28, 0x9d, 0xf7, 0x0d, 0xc2, 0x0b,
-	0x85, 0x98, 0xbd, 0x98, 0xaa, 0xfe, 0xce, 0x00, 0xb8, 0x26, 0x6f, 0xe2, 0xff, 0x0f, 0x86, 0xe1,
-	0xc0, 0x15, 0x17, 0xe5, 0xc5, 0x9d, 0x2f, 0xe2, 0xbb, 0x79, 0x98, 0x7c, 0x84, 0x67, 0xf7, 0x25,
-	0x0d, 0xcd, 0x24, 0xbb, 0x96, 0x57, 0xbd, 0x40, 0x33, 0x95, 0xaa, 0x5e, 0x22, 0x4e, 0x91, 0x18,
-	0x2f, 0x8d, 0xd4, 0x29, 0x93, 0x08, 0x20, 0xcf, 0xb8, 0x8a, 0x3d, 0x6c, 0x5d, 0x31, 0x66, 0x5b,
-	0x97, 0x40, 0xb6, 0xcb, 0x46, 0xb2, 0x5f, 0x1d, 0x5c, 0xc2, 0xe6, 0xde, 0xe0, 0x67, 0x08, 0xcf,
-	0xbc, 0x03, 0xb0, 0x2b, 0x44, 0x4c, 0x8e, 0xf0, 0xdc, 0x68, 0x98, 0xa6, 0x42, 0xc4, 0x93, 0x3b,
-	0xa9, 0xd1, 0xd4, 0x36, 0xcc, 0xde, 0xb3, 0x1b, 0xb8, 0xde, 0x19, 0x8f, 0xec, 0xa5, 0xc0, 0x23,
-	0x3b, 0xa6, 0x68, 0x4c, 0xaa, 0x78, 0x5a, 0x33, 0x1d, 0x83, 0x9d, 0xee, 0xbe, 0x5d, 0x90, 0x15,
-	0x7c, 0x2b, 0x02, 0x15, 0x4a, 0x96, 0x8e, 0x0e, 0xc9, 0x1f, 0x0f, 0x91, 0x7b, 0xf8, 0xa6, 0x84,
-	0x90, 0xa5, 0x0c, 0xb8, 0xb6, 0xe3, 0xd3, 0x1f, 0x05, 0x48, 0x88, 0x2b, 0x34, 0xc9, 0x07, 0x41,
-	0x39, 0xb7, 0xb9, 0x7c, 0xa9, 0xcd, 0xdc, 0xe3, 0x43, 0xe7, 0x71, 0xf5, 0x15, 0x3c, 0x5a, 0x83,
-	0x0e, 0x7a, 0xf3, 0xad, 0xcf, 0x8e, 0x9b, 0x25, 0x53, 0xe9, 0x3f, 0x8e, 0x9b, 0xa5, 0x1f, 0x4f,
-	0xd6, 0xea, 0x8e, 0xa3, 0x27, 0x06, 0x63, 0x14, 0x5c, 0x03, 0xd7, 0xde, 0x0f, 0x08, 0x2f, 0x6e,
-	0x43, 0x0c, 0xbd, 0xfc, 0xa8, 0x34, 0x95, 0x9a, 0xf1, 0xde, 0x7b, 0x7c, 0x3f, 0x1f, 0x5e, 0xa9,
-	0x84, 0x01, 0x13, 0xe6, 0x5a, 0x18, 0x6f, 0xdb, 0xb9, 0x61, 0xd8, 0x75, 0xad, 0x8f, 0xa7, 0x95,
-	0xa6, 0x07, 0x70, 0x2d, 0x2d, 0x6b, 0xa1, 0xc8, 0x03, 0x5c, 0xe9, 0x03, 0xeb, 0xf5, 0x6d, 0x09,
-	0xcb, 0xed, 0x85, 0x3f, 0x4f, 0x9b, 0xf3, 0xa1, 0x04, 0x33, 0x56, 0x79, 0x60, 0x53, 0xbe, 0xdb,
-	0xe2, 0xfd, 0x8c, 0xf0, 0xb2, 0xf3, 0xc0, 0x04, 0x2f, 0xdc, 0xb8, 0x9b, 0x66, 0x07, 0xbf, 0x36,
-	0xea, 0x70, 0x73, 0xd5, 0x80, 0x52, 0xee, 0xca, 0xae, 0xbd, 0x3c, 0x59, 0xab, 0x3a, 0xf2, 0x2d,
-	0x9b, 0xd9, 0xd3, 0xd2, 0x0c, 0x90, 0xd1, 0x2b, 0xeb, 0xe2, 0x84, 0xe1, 0x4a, 0x71, 0x09, 0x4f,
-	0xa8, 0x41, 0x1d, 0xc1, 0xe6, 0xac, 0x3b, 0x3f, 0x64, 0x9c, 0xbd, 0xfe, 0xef, 0x3d, 0xfa, 0x21,
-	0xd3, 0xfd, 0x6d, 0x48, 0x85, 0x62, 0x7a, 0x42, 0xed, 0xba, 0x34, 0xd6, 0xae, 0x26, 0xe5, 0x56,
-	0xa4, 0x86, 0x67, 0x22, 0x4b, 0x5c, 0x9b, 0xce, 0x13, 0xc3, 0xe5, 0xe6, 0xfd, 0xa1, 0xf6, 0xab,
-	0xfb, 0xae, 0xfd, 0xe4, 0xbb, 0xb3, 0x06, 0x7a, 0x7e, 0xd6, 0x40, 0x2f, 0xce, 0x1a, 0xe8, 0xf7,
-	0xb3, 0x06, 0xfa, 0xf2, 0xbc, 0x51, 0x7a, 0x71, 0xde, 0x28, 0xfd, 0x72, 0xde, 0x28, 0x7d, 0xbc,
-	0x71, 0x65, 0xd9, 0x8e, 0x2e, 0x7e, 0x23, 0xe6, 0x55, 0xec, 0x56, 0xf2, 0xef, 0xb4, 0xc7, 0x7f,
-	0x05, 0x00, 0x00, 0xff, 0xff, 0xc6, 0x8d, 0xa6, 0x48, 0x47, 0x0a, 0x00, 0x00,
+	// 1130 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcf, 0x4f, 0x1b, 0x47,
+	0x14, 0xf6, 0x82, 0x31, 0x66, 0x68, 0xa0, 0x19, 0x0c, 0x31, 0x4e, 0x64, 0x23, 0x4b, 0x4d, 0x68,
+	0x23, 0xec, 0x90, 0x48, 0x3d, 0xd0, 0x5e, 0x30, 0x50, 0xb5, 0xa7, 0x58, 0x4b, 0xd4, 0x56, 0xbd,
+	0xac, 0xc6, 0xbb, 0x83, 0x3d, 0x65, 0x77, 0x66, 0x3b, 0x33, 0x36, 0xe6, 0x9c, 0x43, 0x7f, 0x9c,
+	0x52, 0xf5, 0x12, 0xf5, 0x50, 0x71, 0xac, 0x7a, 0xe6, 0x1f, 0xe8, 0x2d, 0xea, 0x29, 0xcd, 0xa5,
+	0x55, 0x0f, 0xa4, 0x82, 0x4b, 0xd5, 0xbf, 0xa2, 0x9a, 0x1f, 0xbb, 0x36, 0x09, 0x0d, 0x39, 0xd8,
+	0xca, 0x09, 0xe6, 0xbd, 0x99, 0xf7, 0x7d, 0xdf, 0x9b, 0x37, 0xef, 0xad, 0x41, 0xcd, 0x67, 0x22,
+	0x62, 0xa2, 0x1e, 0x10, 0x21, 0x39, 0x69, 0x75, 0x25, 0x61, 0xb4, 0xde, 0x5b, 0x6f, 0x61, 0x89,
+	0xd6, 0xcf, 0x19, 0x6b, 0x31, 0x67, 0x92, 0xc1, 0xeb, 0x66, 0x7f, 0xed, 0x9c, 0xcb, 0xee, 0x2f,
+	0x15, 0xda, 0xac, 0xcd, 0xf4, 0xbe, 0xba, 0xfa, 0xcf, 0x1c, 0x29, 0x95, 0x2d, 0x44, 0x0b, 0x09,
+	0x9c, 0x86, 0xf6, 0x19, 0xb1, 0x21, 0x4b, 0xcb, 0xc6, 0xef, 0x99, 0x83, 0x36, 0xbe, 0x3d, 0xda,
+	0x66, 0xac, 0x1d, 0xe2, 0xba, 0x5e, 0xb5, 0xba, 0x7b, 0xf5, 0xa0, 0xcb, 0xd1, 0x80, 0x4d, 0xa9,
+	0xf2, 0xa2, 0x5f, 0x92, 0x08, 0x0b, 0x89, 0xa2, 0xd8, 0x6c, 0xa8, 0x7e, 0x3d, 0x09, 0x72, 0x4d,
+	0xc4, 0x51, 0x24, 0x20, 0x02, 0x57, 0x7c, 0x16, 0x45, 0x5d, 0x4a, 0xe4, 0xa1, 0x27, 0x51, 0xbf,
+	0xe8, 0xac, 0x38, 0xab, 0x33, 0x8d, 0x0f, 0x9f, 0x9c, 0x54, 0x32, 0x7f, 0x9d, 0x54, 0x6e, 0xb6,
+	0x89, 0xec, 0x74, 0x5b, 0x35, 0x9f, 0x45, 0x96, 0x83, 0xfd, 0xb3, 0x26, 0x82, 0xfd, 0xba, 0x3c,
+	0x8c, 0xb1, 0xa8, 0x6d, 0x63, 0xff, 0xd9, 0xf1, 0x1a, 0xb0, 0x14, 0xb7, 0xb1, 0xef, 0xbe, 0x95,
+	0x86, 0x7c, 0x80, 0xfa, 0x90, 0x82, 0x82, 0x12, 0xa9, 0x94, 0xc4, 0x4c, 0x60, 0xee, 0x71, 0x7c,
+	0x80, 0x78, 0x50, 0x9c, 0x18, 0x01, 0x12, 0x54, 0x91, 0x9b, 0x36, 0xb0, 0xab, 0xe3, 0xc2, 0x18,
+	0x2c, 0xb6, 0x18, 0xed, 0x8a, 0x97, 0x00, 0x27, 0x47, 0x00, 0xb8, 0xa0, 0x43, 0xbf, 0x80, 0x78,
+	0x17, 0x2c, 0x1e, 0x10, 0xd9, 0x09, 0x38, 0x3a, 0xf0, 0x50, 0x10, 0x70, 0x0f, 0x53, 0xd4, 0x0a,
+	0x71, 0x50, 0xcc, 0xae, 0x38, 0xab, 0x79, 0x77, 0x21, 0x71, 0x6e, 0x06, 0x01, 0xdf, 0x31, 0xae,
+	0x8d, 0xec, 0xe3, 0xa3, 0x4a, 0xa6, 0xfa, 0xbb, 0x03, 0x4a, 0x9f, 0xa2, 0x90, 0x04, 0x48, 0x32,
+	0xfe, 0x31, 0x11, 0x92, 0x71, 0xe2, 0xa3, 0xd0, 0xc4, 0x15, 0xf0, 0x5b, 0x07, 0x5c, 0xf3, 0xbb,
+	0x51, 0x37, 0x44, 0x92, 0xf4, 0xb0, 0xd5, 0xe1, 0xe9, 0xcb, 0x2e, 0x3a, 0x2b, 0x93, 0xab, 0xb3,
+	0x77, 0x6f, 0xd8, 0x52, 0xad, 0xa9, 0x44, 0x24, 0x25, 0xa7, 0x98, 0x6e, 0x31, 0x42, 0x1b, 0xf7,
+	0x94, 0xd6, 0x5f, 0x9e, 0x57, 0x6e, 0xbf, 0x9e, 0x56, 0x75, 0x46, 0xb8, 0x8b, 0x03, 0x44, 0xc3,
+	0xc3, 0x55, 0x78, 0xf0, 0x16, 0x98, 0xe7, 0x78, 0x0f, 0x73, 0x4c, 0x7d, 0xec, 0xf9, 0xac, 0x4b,
+	0xa5, 0xbe, 0xc1, 0x2b, 0xee, 0x5c, 0x6a, 0xde, 0x52, 0xd6, 0xea, 0x4f, 0x0e, 0xb8, 0x96, 0x6a,
+	0xda, 0xea, 0x72, 0x8e, 0xa9, 0x4c, 0x04, 0xed, 0x83, 0x69, 0x23, 0x42, 0x8c, 0x8f, 0x7f, 0x82,
+	0x00, 0x97, 0x40, 0x2e, 0xc6, 0x9c, 0x30, 0x53, 0x6a, 0x59, 0xd7, 0xae, 0xaa, 0x3f, 0x38, 0xa0,
+	0x9c, 0x12, 0xdc, 0xf4, 0xad, 0x5c, 0x1c, 0x6c, 0xb1, 0x28, 0x22, 0x42, 0x10, 0x46, 0xe1, 0x57,
+	0x00, 0xf8, 0xe9, 0x6a, 0x7c, 0x54, 0x87, 0x40, 0xaa, 0xdf, 0x39, 0xe0, 0x7a, 0xca, 0xea, 0x7e,
+	0x57, 0x0a, 0x89, 0x68, 0x40, 0x68, 0xfb, 0x4d, 0xa4, 0xae, 0xfa, 0xa3, 0x03, 0x16, 0x52, 0x32,
+	0xbb, 0x21, 0x12, 0x9d, 0x9d, 0x1e, 0xa6, 0x12, 0xbe, 0x0b, 0xde, 0xee, 0x25, 0x66, 0xcf, 0x26,
+	0xd7, 0xd1, 0xc9, 0x9d, 0x4f, 0xed, 0x4d, 0x6d, 0x86, 0x9f, 0x83, 0xfc, 0x1e, 0x47, 0xbe, 0xea,
+	0x4b, 0x23, 0x79, 0xea, 0x69, 0x34, 0x95, 0xa9, 0xc2, 0x05, 0xe4, 0x04, 0x0c, 0xc1, 0xd2, 0x80,
+	0x9d, 0x50, 0x0e, 0x0f, 0x6b, 0x8f, 0xcd, 0xd8, 0x9d, 0xda, 0x2b, 0xfa, 0x74, 0xed, 0x82, 0x90,
+	0x8d, 0xac, 0xa2, 0xec, 0x16, 0x7a, 0x17, 0xa0, 0xd9, 0x17, 0xfc, 0xd0, 0x01, 0xd3, 0x1f, 0x61,
+	0xdc, 0x64, 0x2c, 0x84, 0x7d, 0x30, 0x37, 0x68, 0xa6, 0x31, 0x63, 0xe1, 0xf8, 0x6e, 0x6a, 0xd0,
+	0xb5, 0x15, 0x72, 0xf5, 0xe1, 0x04, 0x28, 0x6d, 0x0d, 0x5b, 0x76, 0x63, 0x4c, 0x03, 0xd3, 0xa6,
+	0x50, 0x08, 0x0b, 0x60, 0x4a, 0x12, 0x19, 0x62, 0xd3, 0xdd, 0x5d, 0xb3, 0x80, 0x2b, 0x60, 0x36,
+	0xc0, 0xc2, 0xe7, 0x24, 0x1e, 0x5c, 0x92, 0x3b, 0x6c, 0x82, 0x37, 0xc0, 0x0c, 0xc7, 0x3e, 0x89,
+	0x09, 0xa6, 0xd2, 0xb4, 0x4f, 0x77, 0x60, 0x80, 0x3e, 0xc8, 0xa1, 0x48, 0x37, 0x82, 0xac, 0x96,
+	0xb9, 0x7c, 0xa1, 0x4c, 0xad, 0xf1, 0x8e, 0xd5, 0xb8, 0xfa, 0x1a, 0x1a, 0x8d, 0x40, 0x1b, 0x7a,
+	0xe3, 0xbd, 0x6f, 0x8e, 0x2a, 0x19, 0x95, 0xe9, 0x7f, 0x8e, 0x2a, 0x99, 0xdf, 0x8e, 0xd7, 0x4a,
+	0x16, 0xa3, 0xcd, 0x7a, 0x43, 0x10, 0x54, 0x62, 0x2a, 0xab, 0xbf, 0x3a, 0x60, 0x71, 0x1b, 0x87,
+	0xb8, 0xad, 0xaf, 0x4a, 0x22, 0x2e, 0x09, 0x6d, 0x7f, 0x42, 0xf7, 0x74, 0xf3, 0x8a, 0x39, 0xee,
+	0x11, 0xa6, 0xc6, 0xc2, 0x70, 0xd9, 0xce, 0x25, 0x66, 0x5b, 0xb5, 0x2e, 0x98, 0x12, 0x12, 0xed,
+	0xe3, 0x91, 0x94, 0xac, 0x09, 0x05, 0x6f, 0x83, 0x5c, 0x07, 0x93, 0x76, 0xc7, 0xa4, 0x30, 0xdb,
+	0x58, 0xf8, 0xf7, 0xa4, 0x32, 0xef, 0x73, 0xac, 0x67, 0xb6, 0x67, 0x5c, 0xae, 0xdd, 0x52, 0xfd,
+	0xc3, 0x01, 0xcb, 0x56, 0x03, 0x61, 0x34, 0x55, 0x63, 0x27, 0xcd, 0x0e, 0xb8, 0x3a, 0xa8, 0x70,
+	0x35, 0x6a, 0xb0, 0x10, 0x76, 0x64, 0x17, 0x9f, 0x1d, 0xaf, 0x15, 0x2c, 0xf8, 0xa6, 0xf1, 0xec,
+	0x4a, 0xae, 0x1a, 0xc8, 0xe0, 0xc9, 0x5a, 0x3b, 0x24, 0x20, 0x97, 0x0e, 0xe1, 0x31, 0x15, 0xa8,
+	0x05, 0xd8, 0xc8, 0xdb, 0xfb, 0x73, 0x94, 0xb2, 0x77, 0xfe, 0xbf, 0x46, 0x3f, 0x23, 0xb2, 0xb3,
+	0x8d, 0x63, 0x26, 0x88, 0x1c, 0x53, 0xb9, 0x2e, 0x0d, 0x95, 0xab, 0x72, 0xd9, 0x15, 0x2c, 0x82,
+	0xe9, 0xc0, 0x00, 0x17, 0xa7, 0xb4, 0x23, 0x59, 0x6e, 0xdc, 0x4c, 0xb8, 0x5f, 0x52, 0x77, 0xdf,
+	0x4f, 0x81, 0x85, 0xf3, 0xca, 0x24, 0xc7, 0x28, 0x82, 0x73, 0x60, 0x82, 0x24, 0x85, 0x36, 0x41,
+	0x02, 0xf8, 0xfe, 0x30, 0xbf, 0x89, 0x4b, 0x6e, 0xed, 0xc2, 0x87, 0x36, 0x39, 0xb6, 0x87, 0x06,
+	0x3f, 0x48, 0xa7, 0xa5, 0x4a, 0x8f, 0x02, 0x31, 0x9f, 0x91, 0xb5, 0xe4, 0x33, 0xb2, 0xb6, 0x6d,
+	0x3f, 0x33, 0x1b, 0x79, 0x05, 0xf2, 0xf8, 0x79, 0xc5, 0x49, 0x46, 0x2a, 0xfc, 0x12, 0x80, 0x08,
+	0xf5, 0x3d, 0xcb, 0x72, 0x6a, 0xf4, 0x2c, 0x67, 0x22, 0xd4, 0xdf, 0x4c, 0x88, 0xe6, 0x31, 0x0d,
+	0x3c, 0x49, 0x22, 0x5c, 0xcc, 0x69, 0xaa, 0xa5, 0x97, 0xa8, 0x3e, 0x48, 0xbe, 0x78, 0x1b, 0xd9,
+	0x47, 0x8a, 0xe7, 0x34, 0xa6, 0x81, 0xb2, 0xc1, 0x10, 0xcc, 0xc6, 0x88, 0x04, 0x09, 0xd3, 0xe9,
+	0xd1, 0x33, 0x05, 0x2a, 0xbe, 0xa5, 0xda, 0x04, 0x57, 0x29, 0xee, 0x4b, 0x2f, 0x46, 0x87, 0x11,
+	0xa6, 0xd2, 0x70, 0xce, 0x5f, 0xca, 0x59, 0xe7, 0x57, 0xf3, 0x9e, 0x57, 0xc7, 0x9b, 0xe6, 0xb4,
+	0xe6, 0x7f, 0x0b, 0xcc, 0xef, 0x21, 0x12, 0xe2, 0x20, 0x89, 0x29, 0x8a, 0x33, 0xa6, 0x91, 0x19,
+	0xb3, 0xdd, 0x2b, 0x1a, 0xf7, 0x7f, 0x3e, 0x2d, 0x3b, 0x4f, 0x4e, 0xcb, 0xce, 0xd3, 0xd3, 0xb2,
+	0xf3, 0xf7, 0x69, 0xd9, 0x79, 0x74, 0x56, 0xce, 0x3c, 0x3d, 0x2b, 0x67, 0xfe, 0x3c, 0x2b, 0x67,
+	0xbe, 0x58, 0x7f, 0xa5, 0x9c, 0xfe, 0xf9, 0x1f, 0x3e, 0x5a, 0x5d, 0x2b, 0xa7, 0x89, 0xde, 0xfb,
+	0x6f, 0x00, 0xbd, 0xe6, 0x21, 0x2a, 0x1c, 0x0d, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -1026,6 +1171,73 @@ func (this *CommunityPoolSpendProposalWithDeposit) Equal(that interface{}) bool
 	}
 	return true
 }
+func (this *CommunityPoolStream) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CommunityPoolStream)
+	if !ok {
+		that2, ok := that.(CommunityPoolStream)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Recipient != that1.Recipient {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	if this.Period != that1.Period {
+		return false
+	}
+	if len(this.MaxAmount) != len(that1.MaxAmount) {
+		return false
+	}
+	for i := range this.MaxAmount {
+		if !this.MaxAmount[i].Equal(&that1.MaxAmount[i]) {
+			return false
+		}
+	}
+	if that1.EndTime == nil {
+		if this.EndTime != nil {
+			return false
+		}
+	} else if !this.EndTime.Equal(*that1.EndTime) {
+		return false
+	}
+	if len(this.PaidAmount) != len(that1.PaidAmount) {
+		return false
+	}
+	for i := range this.PaidAmount {
+		if !this.PaidAmount[i].Equal(&that1.PaidAmount[i]) {
+			return false
+		}
+	}
+	if !this.NextPaymentTime.Equal(that1.NextPaymentTime) {
+		return false
+	}
+	if this.FailedPayments != that1.FailedPayments {
+		return false
+	}
+	return true
+}
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1562,6 +1774,114 @@ func (m *CommunityPoolSpendProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
+func (m *CommunityPoolStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommunityPoolStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommunityPoolStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FailedPayments != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.FailedPayments))
+		i--
+		dAtA[i] = 0x48
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.NextPaymentTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.NextPaymentTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintDistribution(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x42
+	if len(m.PaidAmount) > 0 {
+		for iNdEx := len(m.PaidAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PaidAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.EndTime != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintDistribution(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.MaxAmount) > 0 {
+		for iNdEx := len(m.MaxAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintDistribution(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDistribution(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDistribution(v)
 	base := offset
@@ -1793,6 +2113,51 @@ func (m *CommunityPoolSpendProposalWithDeposit) Size() (n int) {
 	return n
 }
 
+func (m *CommunityPoolStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovDistribution(uint64(m.Id))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovDistribution(uint64(l))
+	if len(m.MaxAmount) > 0 {
+		for _, e := range m.MaxAmount {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if m.EndTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if len(m.PaidAmount) > 0 {
+		for _, e := range m.PaidAmount {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.NextPaymentTime)
+	n += 1 + l + sovDistribution(uint64(l))
+	if m.FailedPayments != 0 {
+		n += 1 + sovDistribution(uint64(m.FailedPayments))
+	}
+	return n
+}
+
 func sovDistribution(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3244,6 +3609,330 @@ func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CommunityPoolStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommunityPoolStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommunityPoolStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxAmount = append(m.MaxAmount, types.Coin{})
+			if err := m.MaxAmount[len(m.MaxAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaidAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaidAmount = append(m.PaidAmount, types.Coin{})
+			if err := m.PaidAmount[len(m.PaidAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPaymentTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.NextPaymentTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedPayments", wireType)
+			}
+			m.FailedPayments = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedPayments |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDistribution(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,15 +2,23 @@ package types
 
 // distribution module event types
 const (
-	EventTypeSetWithdrawAddress = "set_withdraw_address"
-	EventTypeRewards            = "rewards"
-	EventTypeCommission         = "commission"
-	EventTypeWithdrawRewards    = "withdraw_rewards"
-	EventTypeWithdrawCommission = "withdraw_commission"
-	EventTypeProposerReward     = "proposer_reward"
+	EventTypeSetWithdrawAddress               = "set_withdraw_address"
+	EventTypeRewards                          = "rewards"
+	EventTypeCommission                       = "commission"
+	EventTypeWithdrawRewards                  = "withdraw_rewards"
+	EventTypeWithdrawCommission               = "withdraw_commission"
+	EventTypeProposerReward                   = "proposer_reward"
+	EventTypeWithdrawTokenizeShareReward      = "withdraw_tokenize_share_reward"
+	EventTypeCreateCommunityPoolStream        = "create_community_pool_stream"
+	EventTypeCancelCommunityPoolStream        = "cancel_community_pool_stream"
+	EventTypeCommunityPoolStreamPayment       = "community_pool_stream_payment"
+	EventTypeCommunityPoolStreamFailedPayment = "community_pool_stream_failed_payment"
 
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
 	AttributeKeyDelegator       = "delegator"
+	AttributeKeyStreamID        = "stream_id"
+	AttributeKeyRecipient       = "recipient"
+	AttributeKeyFailedPayments  = "failed_payments"
 	AttributeValueCategory      = ModuleName
 )
@@ -1,14 +1,21 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// DefaultStartingCommunityPoolStreamID is the id of the first community pool
+// stream.
+const DefaultStartingCommunityPoolStreamID uint64 = 1
+
 //nolint:interfacer
 func NewGenesisState(
 	params Params, fp FeePool, dwis []DelegatorWithdrawInfo, pp sdk.ConsAddress, r []ValidatorOutstandingRewardsRecord,
 	acc []ValidatorAccumulatedCommissionRecord, historical []ValidatorHistoricalRewardsRecord,
 	cur []ValidatorCurrentRewardsRecord, dels []DelegatorStartingInfoRecord, slashes []ValidatorSlashEventRecord,
+	streams []CommunityPoolStream, nextStreamID uint64,
 ) *GenesisState {
 	return &GenesisState{
 		Params:                          params,
@@ -21,6 +28,8 @@ func NewGenesisState(
 		ValidatorCurrentRewards:         cur,
 		DelegatorStartingInfos:          dels,
 		ValidatorSlashEvents:            slashes,
+		CommunityPoolStreams:            streams,
+		NextCommunityPoolStreamId:       nextStreamID,
 	}
 }
 
@@ -37,6 +46,8 @@ func DefaultGenesisState() *GenesisState {
 		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
 		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
 		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		CommunityPoolStreams:            []CommunityPoolStream{},
+		NextCommunityPoolStreamId:       DefaultStartingCommunityPoolStreamID,
 	}
 }
 
@@ -45,5 +56,20 @@ func ValidateGenesis(gs *GenesisState) error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
+
+	seenStreams := make(map[uint64]bool, len(gs.CommunityPoolStreams))
+	for _, stream := range gs.CommunityPoolStreams {
+		if seenStreams[stream.Id] {
+			return fmt.Errorf("duplicate community pool stream id %d", stream.Id)
+		}
+		if stream.Id >= gs.NextCommunityPoolStreamId {
+			return fmt.Errorf("community pool stream id %d must be lower than the next stream id %d", stream.Id, gs.NextCommunityPoolStreamId)
+		}
+		if err := stream.Validate(); err != nil {
+			return err
+		}
+		seenStreams[stream.Id] = true
+	}
+
 	return gs.FeePool.ValidateGenesis()
 }
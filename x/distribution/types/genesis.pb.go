@@ -347,6 +347,16 @@ type GenesisState struct {
 	DelegatorStartingInfos []DelegatorStartingInfoRecord `protobuf:"bytes,9,rep,name=delegator_starting_infos,json=delegatorStartingInfos,proto3" json:"delegator_starting_infos"`
 	// fee_pool defines the validator slash events at genesis.
 	ValidatorSlashEvents []ValidatorSlashEventRecord `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events"`
+	// community_pool_streams defines the active community pool streams at
+	// genesis.
+	//
+	// Since: cosmos-sdk 0.47
+	CommunityPoolStreams []CommunityPoolStream `protobuf:"bytes,11,rep,name=community_pool_streams,json=communityPoolStreams,proto3" json:"community_pool_streams"`
+	// next_community_pool_stream_id defines the id of the next community pool
+	// stream at genesis.
+	//
+	// Since: cosmos-sdk 0.47
+	NextCommunityPoolStreamId uint64 `protobuf:"varint,12,opt,name=next_community_pool_stream_id,json=nextCommunityPoolStreamId,proto3" json:"next_community_pool_stream_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -398,63 +408,67 @@ func init() {
 }
 
 var fileDescriptor_76eed0f9489db580 = []byte{
-	// 896 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x6e, 0xeb, 0x44,
-	0x14, 0x8e, 0x93, 0x92, 0x9b, 0x3b, 0xb9, 0x88, 0x8b, 0x6f, 0x6f, 0x71, 0x7b, 0x8b, 0xd3, 0x96,
-	0x2e, 0x8a, 0x50, 0x1d, 0x9a, 0x22, 0x40, 0x45, 0x20, 0x25, 0x69, 0xf9, 0x59, 0xb5, 0x4a, 0x10,
-	0x95, 0x90, 0x50, 0x34, 0xb1, 0x27, 0xce, 0x40, 0xe2, 0x89, 0x66, 0xc6, 0x6e, 0x91, 0x58, 0x21,
-	0x21, 0x75, 0x89, 0x04, 0x0f, 0xd0, 0x25, 0x42, 0x62, 0xc7, 0x33, 0xa0, 0x2e, 0x2b, 0x56, 0x2c,
-	0x50, 0x41, 0x29, 0x0b, 0x5e, 0x81, 0x1d, 0xf2, 0x78, 0xfc, 0xa7, 0xba, 0x26, 0x2d, 0xed, 0xaa,
-	0x1d, 0xcf, 0xf9, 0xf9, 0xbe, 0x73, 0xbe, 0x9c, 0x33, 0xe0, 0x55, 0x93, 0xb0, 0x31, 0x61, 0x75,
-	0x0b, 0x33, 0x4e, 0x71, 0xdf, 0xe5, 0x98, 0x38, 0x75, 0x6f, 0xab, 0x8f, 0x38, 0xdc, 0xaa, 0xdb,
-	0xc8, 0x41, 0x0c, 0x33, 0x63, 0x42, 0x09, 0x27, 0xea, 0xb3, 0xc0, 0xd4, 0x48, 0x9a, 0x1a, 0xd2,
-	0x74, 0x69, 0xde, 0x26, 0x36, 0x11, 0x76, 0x75, 0xff, 0xbf, 0xc0, 0x65, 0x49, 0x97, 0xd1, 0xfb,
-	0x90, 0xa1, 0x28, 0xaa, 0x49, 0xb0, 0x23, 0xef, 0x8d, 0xbc, 0xec, 0xa9, 0x3c, 0x81, 0xfd, 0x62,
-	0x60, 0xdf, 0x0b, 0x12, 0x49, 0x3c, 0xe2, 0xb0, 0xf6, 0x93, 0x02, 0x9e, 0xee, 0xa2, 0x11, 0xb2,
-	0x21, 0x27, 0xf4, 0x10, 0xf3, 0xa1, 0x45, 0xe1, 0xd1, 0x47, 0xce, 0x80, 0xa8, 0x7b, 0xe0, 0x45,
-	0x2b, 0xbc, 0xe8, 0x41, 0xcb, 0xa2, 0x88, 0x31, 0x4d, 0x59, 0x51, 0x36, 0x1e, 0xb6, 0xb4, 0x5f,
-	0x7f, 0xde, 0x9c, 0x97, 0x61, 0x9a, 0xc1, 0x4d, 0x97, 0x53, 0xec, 0xd8, 0x9d, 0xc7, 0x91, 0x8b,
-	0xfc, 0xae, 0xb6, 0xc1, 0xe3, 0x23, 0x19, 0x36, 0x8a, 0x52, 0xfc, 0x8f, 0x28, 0x2f, 0x84, 0x1e,
-	0xf2, 0xf3, 0x4e, 0xe5, 0xe4, 0xb4, 0x56, 0xf8, 0xfb, 0xb4, 0x56, 0x58, 0xfb, 0x47, 0x01, 0xab,
-	0x9f, 0xc0, 0x11, 0xb6, 0xfc, 0x1c, 0xfb, 0x2e, 0x67, 0x1c, 0x3a, 0x96, 0xef, 0x83, 0x8e, 0x20,
-	0xb5, 0x58, 0x07, 0x99, 0x84, 0x5a, 0x3e, 0x76, 0x2f, 0x34, 0x9a, 0x1d, 0x7b, 0xe4, 0x12, 0x62,
-	0xff, 0x5a, 0x01, 0x4f, 0x48, 0x9c, 0xa3, 0x47, 0x83, 0x24, 0x5a, 0x71, 0xa5, 0xb4, 0x51, 0x6d,
-	0x2c, 0xcb, 0x36, 0x18, 0x7e, 0x9b, 0xc2, 0x8e, 0x1a, 0xbb, 0xc8, 0x6c, 0x13, 0xec, 0xb4, 0xb6,
-	0xcf, 0x2e, 0x6a, 0x85, 0x1f, 0xff, 0xa8, 0xbd, 0x66, 0x63, 0x3e, 0x74, 0xfb, 0x86, 0x49, 0xc6,
-	0xb2, 0xf2, 0xf2, 0xcf, 0x26, 0xb3, 0xbe, 0xa8, 0xf3, 0x2f, 0x27, 0x88, 0x85, 0x3e, 0xac, 0xa3,
-	0x92, 0x2b, 0x8c, 0x12, 0xdc, 0x7f, 0x57, 0xc0, 0x7a, 0xc4, 0xbd, 0x69, 0x9a, 0xee, 0xd8, 0x1d,
-	0x41, 0x8e, 0xac, 0x36, 0x19, 0x8f, 0x31, 0x63, 0x98, 0x38, 0x77, 0x4b, 0xdf, 0x04, 0x55, 0x18,
-	0x67, 0x11, 0x5d, 0xab, 0x36, 0xde, 0x31, 0x72, 0xf4, 0x6c, 0xe4, 0xc3, 0x6b, 0xcd, 0xf9, 0x45,
-	0xe9, 0x24, 0xa3, 0x26, 0xe8, 0xfd, 0xa5, 0x80, 0x95, 0xc8, 0xff, 0x43, 0xcc, 0x38, 0xa1, 0xd8,
-	0x84, 0xa3, 0x7b, 0xe9, 0xec, 0x02, 0x28, 0x4f, 0x10, 0xc5, 0x24, 0x60, 0x35, 0xd7, 0x91, 0x27,
-	0xf5, 0x10, 0x3c, 0x08, 0x9b, 0x5c, 0x12, 0x74, 0xdf, 0x9a, 0x8d, 0xee, 0x15, 0xb8, 0x92, 0x6a,
-	0x18, 0x2d, 0x41, 0xf3, 0x17, 0x05, 0xbc, 0x1c, 0xf9, 0xb5, 0x5d, 0x4a, 0x91, 0xc3, 0xef, 0x85,
-	0xe3, 0xc7, 0x31, 0x97, 0xa0, 0x75, 0x6f, 0xcc, 0xc6, 0x25, 0x8d, 0xe9, 0x7a, 0x22, 0xdf, 0x17,
-	0xc1, 0xb3, 0x68, 0x74, 0x74, 0x39, 0xa4, 0x1c, 0x3b, 0xb6, 0x3f, 0x3a, 0x62, 0x1a, 0x77, 0x31,
-	0x40, 0x32, 0xab, 0x51, 0xbc, 0x71, 0x35, 0x3e, 0x03, 0xcf, 0x33, 0x89, 0xb1, 0x87, 0x9d, 0x01,
-	0x91, 0xfd, 0x6d, 0xe4, 0xd6, 0x24, 0x93, 0x9e, 0xac, 0xc8, 0x23, 0x96, 0xf8, 0x96, 0x28, 0xcb,
-	0x49, 0x11, 0x2c, 0x46, 0xb5, 0xec, 0x8e, 0x20, 0x1b, 0xee, 0x79, 0xa2, 0x9c, 0x77, 0xac, 0xdf,
-	0x21, 0xc2, 0xf6, 0x90, 0x87, 0xfa, 0x0d, 0x4e, 0x09, 0x5d, 0x97, 0x52, 0xba, 0xfe, 0x1c, 0x3c,
-	0x8d, 0xd3, 0x32, 0x1f, 0x54, 0x0f, 0xf9, 0xa8, 0xb4, 0x39, 0x51, 0x85, 0xd7, 0x67, 0x53, 0x46,
-	0xcc, 0x46, 0xd6, 0xe0, 0x89, 0x77, 0xf5, 0x2a, 0x51, 0x8a, 0x8b, 0x0a, 0x78, 0xf4, 0x41, 0xb0,
-	0x0c, 0xbb, 0x1c, 0x72, 0xa4, 0x36, 0x41, 0x79, 0x02, 0x29, 0x1c, 0x07, 0x94, 0xab, 0x8d, 0x57,
-	0x72, 0xf3, 0x1e, 0x08, 0x53, 0x99, 0x4a, 0x3a, 0xaa, 0x7b, 0xa0, 0x32, 0x40, 0xa8, 0x37, 0x21,
-	0x64, 0x24, 0x65, 0xbd, 0x9e, 0x1b, 0xe4, 0x7d, 0x84, 0x0e, 0x08, 0x19, 0x85, 0x32, 0x1e, 0x04,
-	0x47, 0x95, 0x02, 0x2d, 0x16, 0x67, 0xb4, 0xa0, 0x7c, 0x61, 0xf8, 0xbf, 0xfc, 0xd2, 0xec, 0xca,
-	0x48, 0xee, 0x4c, 0x99, 0x64, 0xc1, 0xca, 0xba, 0x14, 0x4a, 0x9e, 0x50, 0xe4, 0x61, 0xe2, 0x8a,
-	0x55, 0x3c, 0x21, 0x0c, 0x51, 0xd1, 0x80, 0xdc, 0xde, 0x87, 0x2e, 0x07, 0xd2, 0x43, 0x75, 0xb3,
-	0x97, 0xd2, 0x73, 0x02, 0xf5, 0x7b, 0xb3, 0x75, 0xf2, 0xba, 0xcd, 0x29, 0x19, 0x64, 0xec, 0x21,
-	0xf5, 0x3b, 0x05, 0xac, 0x26, 0xa4, 0x1b, 0x8f, 0xf0, 0x9e, 0x19, 0x0d, 0x78, 0xa6, 0x95, 0x05,
-	0x8a, 0xe6, 0xff, 0x58, 0x12, 0x29, 0x20, 0x35, 0x2f, 0xd7, 0x96, 0xa9, 0xdf, 0x28, 0x60, 0x39,
-	0x46, 0x35, 0x8c, 0xc6, 0x70, 0x54, 0x96, 0x07, 0x02, 0xd0, 0xbb, 0xb7, 0x1c, 0xe3, 0x29, 0x30,
-	0x4b, 0xde, 0xb5, 0x76, 0xea, 0x57, 0x60, 0x31, 0x86, 0x61, 0x06, 0x13, 0x34, 0xc2, 0x50, 0x11,
-	0x18, 0x76, 0x6e, 0x33, 0x7e, 0x53, 0x00, 0x5e, 0xf2, 0xb2, 0x8d, 0xd4, 0xe3, 0xa4, 0x9a, 0x53,
-	0x63, 0x8e, 0x69, 0x0f, 0x45, 0xf2, 0xb7, 0x6f, 0x3e, 0xe7, 0x52, 0xa9, 0x63, 0x4d, 0x27, 0x4d,
-	0x98, 0x4a, 0xc1, 0x42, 0xe6, 0x60, 0x61, 0x1a, 0x10, 0x79, 0xdf, 0xbc, 0xe9, 0x64, 0x49, 0x65,
-	0x9d, 0xcf, 0x98, 0x2f, 0x89, 0x15, 0xd4, 0xda, 0xff, 0x61, 0xaa, 0x2b, 0x67, 0x53, 0x5d, 0x39,
-	0x9f, 0xea, 0xca, 0x9f, 0x53, 0x5d, 0xf9, 0xf6, 0x52, 0x2f, 0x9c, 0x5f, 0xea, 0x85, 0xdf, 0x2e,
-	0xf5, 0xc2, 0xa7, 0x5b, 0xb9, 0x4f, 0xaf, 0xe3, 0xf4, 0xf3, 0x59, 0xbc, 0xc4, 0xfa, 0x65, 0xf1,
-	0x2a, 0xde, 0xfe, 0x37, 0x00, 0x00, 0xff, 0xff, 0x18, 0xd7, 0x00, 0x14, 0xe0, 0x0b, 0x00, 0x00,
+	// 954 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xda, 0x21, 0x4d, 0xc7, 0x41, 0x94, 0x69, 0x6a, 0x36, 0x69, 0xbb, 0x4e, 0x4b, 0x0f,
+	0x45, 0xa8, 0x6b, 0x92, 0x22, 0x40, 0x45, 0x20, 0x62, 0x37, 0x40, 0x4f, 0x8d, 0x6c, 0x44, 0x25,
+	0x24, 0xb4, 0x1a, 0xef, 0x8e, 0xd7, 0x03, 0xeb, 0x1d, 0x6b, 0x66, 0x76, 0x93, 0x4a, 0x9c, 0x90,
+	0x90, 0x7a, 0x42, 0x48, 0xf0, 0x07, 0xf4, 0x88, 0x90, 0xb8, 0xf1, 0x37, 0xa0, 0x1e, 0x2b, 0x4e,
+	0x1c, 0x10, 0x20, 0x87, 0x03, 0xff, 0x02, 0x37, 0xb4, 0xb3, 0xb3, 0xbf, 0xe4, 0xcd, 0xc6, 0x69,
+	0x93, 0x53, 0xb2, 0x3b, 0xef, 0xbd, 0xef, 0xfb, 0xde, 0x7b, 0xfe, 0x66, 0xc1, 0x6b, 0x36, 0xe5,
+	0x13, 0xca, 0x3b, 0x0e, 0xe1, 0x82, 0x91, 0x61, 0x20, 0x08, 0xf5, 0x3b, 0xe1, 0xd6, 0x10, 0x0b,
+	0xb4, 0xd5, 0x71, 0xb1, 0x8f, 0x39, 0xe1, 0xe6, 0x94, 0x51, 0x41, 0xe1, 0xe5, 0x38, 0xd4, 0xcc,
+	0x87, 0x9a, 0x2a, 0x74, 0x63, 0xcd, 0xa5, 0x2e, 0x95, 0x71, 0x9d, 0xe8, 0xbf, 0x38, 0x65, 0xc3,
+	0x50, 0xd5, 0x87, 0x88, 0xe3, 0xb4, 0xaa, 0x4d, 0x89, 0xaf, 0xce, 0xcd, 0x2a, 0xf4, 0x02, 0x4e,
+	0x1c, 0xbf, 0x1e, 0xc7, 0x5b, 0x31, 0x90, 0xe2, 0x23, 0x1f, 0xae, 0xff, 0xac, 0x81, 0x4b, 0x77,
+	0xb1, 0x87, 0x5d, 0x24, 0x28, 0x7b, 0x40, 0xc4, 0xd8, 0x61, 0x68, 0xff, 0x9e, 0x3f, 0xa2, 0x70,
+	0x17, 0xbc, 0xec, 0x24, 0x07, 0x16, 0x72, 0x1c, 0x86, 0x39, 0xd7, 0xb5, 0x4d, 0xed, 0xe6, 0xf9,
+	0xae, 0xfe, 0xdb, 0x2f, 0xb7, 0xd6, 0x54, 0x99, 0x9d, 0xf8, 0x64, 0x20, 0x18, 0xf1, 0xdd, 0xfe,
+	0x85, 0x34, 0x45, 0xbd, 0x87, 0x3d, 0x70, 0x61, 0x5f, 0x95, 0x4d, 0xab, 0xd4, 0x8f, 0xa9, 0xf2,
+	0x52, 0x92, 0xa1, 0x5e, 0xdf, 0x59, 0x79, 0xf4, 0xb8, 0x5d, 0xfb, 0xf7, 0x71, 0xbb, 0x76, 0xfd,
+	0x3f, 0x0d, 0x5c, 0xfb, 0x14, 0x79, 0xc4, 0x89, 0x30, 0xee, 0x07, 0x82, 0x0b, 0xe4, 0x3b, 0x51,
+	0x0e, 0xde, 0x47, 0xcc, 0xe1, 0x7d, 0x6c, 0x53, 0xe6, 0x44, 0xdc, 0xc3, 0x24, 0x68, 0x71, 0xee,
+	0x69, 0x4a, 0xc2, 0xfd, 0x6b, 0x0d, 0x5c, 0xa4, 0x19, 0x86, 0xc5, 0x62, 0x10, 0xbd, 0xbe, 0xd9,
+	0xb8, 0xd9, 0xdc, 0xbe, 0xa2, 0xc6, 0x60, 0x46, 0x63, 0x4a, 0x26, 0x6a, 0xde, 0xc5, 0x76, 0x8f,
+	0x12, 0xbf, 0x7b, 0xfb, 0xc9, 0x9f, 0xed, 0xda, 0x4f, 0x7f, 0xb5, 0x5f, 0x77, 0x89, 0x18, 0x07,
+	0x43, 0xd3, 0xa6, 0x13, 0xd5, 0x79, 0xf5, 0xe7, 0x16, 0x77, 0xbe, 0xec, 0x88, 0x87, 0x53, 0xcc,
+	0x93, 0x1c, 0xde, 0x87, 0x74, 0x4e, 0x51, 0x4e, 0xfb, 0x1f, 0x1a, 0xb8, 0x91, 0x6a, 0xdf, 0xb1,
+	0xed, 0x60, 0x12, 0x78, 0x48, 0x60, 0xa7, 0x47, 0x27, 0x13, 0xc2, 0x39, 0xa1, 0xfe, 0xe9, 0xca,
+	0xb7, 0x41, 0x13, 0x65, 0x28, 0x72, 0x6a, 0xcd, 0xed, 0x77, 0xcd, 0x8a, 0x7d, 0x36, 0xab, 0xe9,
+	0x75, 0x97, 0xa2, 0xa6, 0xf4, 0xf3, 0x55, 0x73, 0xf2, 0xfe, 0xd1, 0xc0, 0x66, 0x9a, 0xff, 0x31,
+	0xe1, 0x82, 0x32, 0x62, 0x23, 0xef, 0x4c, 0x26, 0xdb, 0x02, 0xcb, 0x53, 0xcc, 0x08, 0x8d, 0x55,
+	0x2d, 0xf5, 0xd5, 0x13, 0x7c, 0x00, 0xce, 0x25, 0x43, 0x6e, 0x48, 0xb9, 0x6f, 0x2f, 0x26, 0x77,
+	0x8e, 0xae, 0x92, 0x9a, 0x54, 0xcb, 0xc9, 0xfc, 0x55, 0x03, 0x57, 0xd3, 0xbc, 0x5e, 0xc0, 0x18,
+	0xf6, 0xc5, 0x99, 0x68, 0xfc, 0x24, 0xd3, 0x12, 0x8f, 0xee, 0xcd, 0xc5, 0xb4, 0x14, 0x39, 0x1d,
+	0x2d, 0xe4, 0x87, 0x3a, 0xb8, 0x9c, 0x5a, 0xc7, 0x40, 0x20, 0x26, 0x88, 0xef, 0x46, 0xd6, 0x91,
+	0xc9, 0x38, 0x0d, 0x03, 0x29, 0xed, 0x46, 0xfd, 0xc4, 0xdd, 0xf8, 0x1c, 0xbc, 0xc8, 0x15, 0x47,
+	0x8b, 0xf8, 0x23, 0xaa, 0xe6, 0xbb, 0x5d, 0xd9, 0x93, 0x52, 0x79, 0xaa, 0x23, 0xab, 0x3c, 0xf7,
+	0x2e, 0xd7, 0x96, 0x47, 0x75, 0xb0, 0x9e, 0xf6, 0x72, 0xe0, 0x21, 0x3e, 0xde, 0x0d, 0x65, 0x3b,
+	0x4f, 0x79, 0x7f, 0xc7, 0x98, 0xb8, 0x63, 0x91, 0xec, 0x6f, 0xfc, 0x94, 0xdb, 0xeb, 0x46, 0x61,
+	0xaf, 0xbf, 0x00, 0x97, 0x32, 0x58, 0x1e, 0x91, 0xb2, 0x70, 0xc4, 0x4a, 0x5f, 0x92, 0x5d, 0x78,
+	0x63, 0xb1, 0xcd, 0xc8, 0xd4, 0xa8, 0x1e, 0x5c, 0x0c, 0xe7, 0x8f, 0x72, 0xad, 0xf8, 0x16, 0x80,
+	0xd5, 0x8f, 0xe2, 0xcb, 0x70, 0x20, 0x90, 0xc0, 0x70, 0x07, 0x2c, 0x4f, 0x11, 0x43, 0x93, 0x58,
+	0x72, 0x73, 0xfb, 0xd5, 0x4a, 0xdc, 0x3d, 0x19, 0xaa, 0xa0, 0x54, 0x22, 0xdc, 0x05, 0x2b, 0x23,
+	0x8c, 0xad, 0x29, 0xa5, 0x9e, 0x5a, 0xeb, 0x1b, 0x95, 0x45, 0x3e, 0xc4, 0x78, 0x8f, 0x52, 0x2f,
+	0x59, 0xe3, 0x51, 0xfc, 0x08, 0x19, 0xd0, 0xb3, 0xe5, 0x4c, 0x2f, 0xa8, 0x68, 0x31, 0xa2, 0x5f,
+	0x7e, 0x63, 0xf1, 0xcd, 0xc8, 0xdf, 0x99, 0x0a, 0xa4, 0xe5, 0x94, 0x1d, 0xca, 0x4d, 0x9e, 0x32,
+	0x1c, 0x12, 0x1a, 0xc8, 0xab, 0x78, 0x4a, 0x39, 0x66, 0xfa, 0xd2, 0x71, 0xb3, 0x4f, 0x52, 0xf6,
+	0x54, 0x06, 0x0c, 0xca, 0x2f, 0xa5, 0x17, 0x24, 0xeb, 0xf7, 0x17, 0x9b, 0xe4, 0x51, 0x37, 0xa7,
+	0x52, 0x50, 0x72, 0x0f, 0xc1, 0xef, 0x35, 0x70, 0x2d, 0xb7, 0xba, 0x99, 0x85, 0x5b, 0x76, 0x6a,
+	0xf0, 0x5c, 0x5f, 0x96, 0x2c, 0x76, 0x9e, 0xe3, 0x92, 0x28, 0x10, 0x69, 0x87, 0x95, 0xb1, 0x1c,
+	0x7e, 0xa3, 0x81, 0x2b, 0x19, 0xab, 0x71, 0x6a, 0xc3, 0x69, 0x5b, 0xce, 0x49, 0x42, 0xef, 0x3d,
+	0xa3, 0x8d, 0x17, 0xc8, 0x6c, 0x84, 0x47, 0xc6, 0xc1, 0xaf, 0xc0, 0x7a, 0x46, 0xc3, 0x8e, 0x1d,
+	0x34, 0xe5, 0xb0, 0x22, 0x39, 0xdc, 0x79, 0x16, 0xfb, 0x2d, 0x10, 0x78, 0x25, 0x2c, 0x0f, 0x82,
+	0x07, 0xf9, 0x6d, 0x2e, 0xd8, 0x1c, 0xd7, 0xcf, 0x4b, 0xf0, 0x77, 0x4e, 0xee, 0x73, 0x05, 0xe8,
+	0x96, 0x53, 0x16, 0xc2, 0x21, 0x03, 0xad, 0x52, 0x63, 0xe1, 0x3a, 0x90, 0xb8, 0x6f, 0x9d, 0xd4,
+	0x59, 0x0a, 0xa8, 0x6b, 0x25, 0xfe, 0xc2, 0xa1, 0x07, 0x5a, 0xd1, 0xca, 0x05, 0x3e, 0x11, 0x0f,
+	0xa5, 0x11, 0x58, 0x5c, 0x30, 0x1c, 0xb9, 0x4a, 0x73, 0xb3, 0x71, 0xac, 0x9b, 0xf5, 0x92, 0xd4,
+	0xc8, 0x07, 0x06, 0x32, 0x31, 0x41, 0xb3, 0xe7, 0x8f, 0x38, 0xfc, 0x00, 0x5c, 0xf5, 0xf1, 0x81,
+	0xb0, 0x4a, 0x21, 0x2d, 0xe2, 0xe8, 0xab, 0xd2, 0x69, 0xd7, 0xa3, 0xa0, 0x92, 0xda, 0xf7, 0x72,
+	0x9f, 0x38, 0xdd, 0xfb, 0x3f, 0xce, 0x0c, 0xed, 0xc9, 0xcc, 0xd0, 0x9e, 0xce, 0x0c, 0xed, 0xef,
+	0x99, 0xa1, 0x7d, 0x77, 0x68, 0xd4, 0x9e, 0x1e, 0x1a, 0xb5, 0xdf, 0x0f, 0x8d, 0xda, 0x67, 0x5b,
+	0x95, 0x9f, 0x8a, 0x07, 0xc5, 0xcf, 0x7d, 0xf9, 0xe5, 0x38, 0x5c, 0x96, 0x5f, 0xf1, 0xb7, 0xff,
+	0x1f, 0x00, 0xa8, 0x66, 0xf7, 0xdb, 0x90, 0x0c, 0x00, 0x00,
 }
 
 func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
@@ -780,6 +794,25 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextCommunityPoolStreamId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextCommunityPoolStreamId))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.CommunityPoolStreams) > 0 {
+		for iNdEx := len(m.CommunityPoolStreams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommunityPoolStreams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ValidatorSlashEvents) > 0 {
 		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1099,6 +1132,15 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.CommunityPoolStreams) > 0 {
+		for _, e := range m.CommunityPoolStreams {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextCommunityPoolStreamId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextCommunityPoolStreamId))
+	}
 	return n
 }
 
@@ -2367,6 +2409,59 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolStreams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityPoolStreams = append(m.CommunityPoolStreams, CommunityPoolStream{})
+			if err := m.CommunityPoolStreams[len(m.CommunityPoolStreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextCommunityPoolStreamId", wireType)
+			}
+			m.NextCommunityPoolStreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextCommunityPoolStreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
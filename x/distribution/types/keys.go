@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/binary"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -42,6 +43,14 @@ const (
 // - 0x07<valAddrLen (1 Byte)><valAddr_Bytes>: ValidatorCurrentCommission
 //
 // - 0x08<valAddrLen (1 Byte)><valAddr_Bytes><height>: ValidatorSlashEvent
+//
+// - 0x09: Params
+//
+// - 0x0A<streamID_Bytes>: CommunityPoolStream
+//
+// - 0x0B: NextCommunityPoolStreamID
+//
+// - 0x0C<dueTime_Bytes><streamID_Bytes>: streamID
 var (
 	FeePoolKey                        = []byte{0x00} // key for global distribution state
 	ProposerKey                       = []byte{0x01} // key for the proposer operator address
@@ -53,6 +62,12 @@ var (
 	ValidatorCurrentRewardsPrefix        = []byte{0x06} // key for current validator rewards
 	ValidatorAccumulatedCommissionPrefix = []byte{0x07} // key for accumulated validator commission
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
+
+	ParamsKey = []byte{0x09} // key for distribution module params
+
+	CommunityPoolStreamPrefix      = []byte{0x0A} // key for community pool streams
+	NextCommunityPoolStreamIDKey   = []byte{0x0B} // key for the next community pool stream id
+	CommunityPoolStreamQueuePrefix = []byte{0x0C} // key for the community pool streams by due time
 )
 
 // GetValidatorOutstandingRewardsAddress creates an address from a validator's outstanding rewards key.
@@ -213,3 +228,20 @@ func GetValidatorSlashEventKey(v sdk.ValAddress, height, period uint64) []byte {
 
 	return append(prefix, periodBz...)
 }
+
+// GetCommunityPoolStreamKey creates the key for a community pool stream.
+func GetCommunityPoolStreamKey(streamID uint64) []byte {
+	return append(CommunityPoolStreamPrefix, sdk.Uint64ToBigEndian(streamID)...)
+}
+
+// GetCommunityPoolStreamQueueTimeKey creates the prefix of the community pool
+// stream queue keys of the streams due at dueTime.
+func GetCommunityPoolStreamQueueTimeKey(dueTime time.Time) []byte {
+	return append(CommunityPoolStreamQueuePrefix, sdk.FormatTimeBytes(dueTime)...)
+}
+
+// GetCommunityPoolStreamQueueKey creates the community pool stream queue key
+// of a stream due at dueTime.
+func GetCommunityPoolStreamQueueKey(streamID uint64, dueTime time.Time) []byte {
+	return append(GetCommunityPoolStreamQueueTimeKey(dueTime), sdk.Uint64ToBigEndian(streamID)...)
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -93,3 +94,36 @@ func TestMsgDepositIntoCommunityPool(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for MsgCreateCommunityPoolStream
+func TestMsgCreateCommunityPoolStream(t *testing.T) {
+	authority := delAddr2.String()
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	endTime := time.Unix(1000, 0).UTC()
+
+	tests := []struct {
+		recipient  sdk.AccAddress
+		amount     sdk.Coins
+		period     time.Duration
+		maxAmount  sdk.Coins
+		endTime    *time.Time
+		expectPass bool
+	}{
+		{delAddr1, amount, 0, amount, nil, true},
+		{delAddr1, amount, time.Hour, nil, &endTime, true},
+		{delAddr1, amount, time.Hour, amount, &endTime, true},
+		{emptyDelAddr, amount, 0, amount, nil, false},
+		{delAddr1, sdk.NewCoins(), 0, amount, nil, false},
+		{delAddr1, amount, -time.Hour, amount, nil, false},
+		{delAddr1, amount, 0, nil, nil, false},
+		{delAddr1, amount, 0, sdk.NewCoins(sdk.NewInt64Coin("atom", 10)), nil, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgCreateCommunityPoolStream(authority, tc.recipient, tc.amount, tc.period, tc.maxAmount, tc.endTime)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
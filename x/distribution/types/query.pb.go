@@ -951,6 +951,211 @@ func (m *QueryCommunityPoolResponse) GetPool() github_com_cosmos_cosmos_sdk_type
 	return nil
 }
 
+// QueryCommunityPoolStreamRequest is the request type for the
+// Query/CommunityPoolStream RPC method.
+//
+// Since: cosmos-sdk 0.47
+type QueryCommunityPoolStreamRequest struct {
+	// stream_id defines the id of the stream to query for.
+	StreamId uint64 `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+}
+
+func (m *QueryCommunityPoolStreamRequest) Reset()         { *m = QueryCommunityPoolStreamRequest{} }
+func (m *QueryCommunityPoolStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCommunityPoolStreamRequest) ProtoMessage()    {}
+func (*QueryCommunityPoolStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{20}
+}
+func (m *QueryCommunityPoolStreamRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCommunityPoolStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCommunityPoolStreamRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCommunityPoolStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommunityPoolStreamRequest.Merge(m, src)
+}
+func (m *QueryCommunityPoolStreamRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCommunityPoolStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommunityPoolStreamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCommunityPoolStreamRequest proto.InternalMessageInfo
+
+func (m *QueryCommunityPoolStreamRequest) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
+	}
+	return 0
+}
+
+// QueryCommunityPoolStreamResponse is the response type for the
+// Query/CommunityPoolStream RPC method.
+//
+// Since: cosmos-sdk 0.47
+type QueryCommunityPoolStreamResponse struct {
+	// stream defines the community pool stream.
+	Stream CommunityPoolStream `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream"`
+}
+
+func (m *QueryCommunityPoolStreamResponse) Reset()         { *m = QueryCommunityPoolStreamResponse{} }
+func (m *QueryCommunityPoolStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCommunityPoolStreamResponse) ProtoMessage()    {}
+func (*QueryCommunityPoolStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{21}
+}
+func (m *QueryCommunityPoolStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCommunityPoolStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCommunityPoolStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCommunityPoolStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommunityPoolStreamResponse.Merge(m, src)
+}
+func (m *QueryCommunityPoolStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCommunityPoolStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommunityPoolStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCommunityPoolStreamResponse proto.InternalMessageInfo
+
+func (m *QueryCommunityPoolStreamResponse) GetStream() CommunityPoolStream {
+	if m != nil {
+		return m.Stream
+	}
+	return CommunityPoolStream{}
+}
+
+// QueryCommunityPoolStreamsRequest is the request type for the
+// Query/CommunityPoolStreams RPC method.
+//
+// Since: cosmos-sdk 0.47
+type QueryCommunityPoolStreamsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCommunityPoolStreamsRequest) Reset()         { *m = QueryCommunityPoolStreamsRequest{} }
+func (m *QueryCommunityPoolStreamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCommunityPoolStreamsRequest) ProtoMessage()    {}
+func (*QueryCommunityPoolStreamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{22}
+}
+func (m *QueryCommunityPoolStreamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCommunityPoolStreamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCommunityPoolStreamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCommunityPoolStreamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommunityPoolStreamsRequest.Merge(m, src)
+}
+func (m *QueryCommunityPoolStreamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCommunityPoolStreamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommunityPoolStreamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCommunityPoolStreamsRequest proto.InternalMessageInfo
+
+func (m *QueryCommunityPoolStreamsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryCommunityPoolStreamsResponse is the response type for the
+// Query/CommunityPoolStreams RPC method.
+//
+// Since: cosmos-sdk 0.47
+type QueryCommunityPoolStreamsResponse struct {
+	// streams defines the active community pool streams.
+	Streams []CommunityPoolStream `protobuf:"bytes,1,rep,name=streams,proto3" json:"streams"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCommunityPoolStreamsResponse) Reset()         { *m = QueryCommunityPoolStreamsResponse{} }
+func (m *QueryCommunityPoolStreamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCommunityPoolStreamsResponse) ProtoMessage()    {}
+func (*QueryCommunityPoolStreamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{23}
+}
+func (m *QueryCommunityPoolStreamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCommunityPoolStreamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCommunityPoolStreamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCommunityPoolStreamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommunityPoolStreamsResponse.Merge(m, src)
+}
+func (m *QueryCommunityPoolStreamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCommunityPoolStreamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommunityPoolStreamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCommunityPoolStreamsResponse proto.InternalMessageInfo
+
+func (m *QueryCommunityPoolStreamsResponse) GetStreams() []CommunityPoolStream {
+	if m != nil {
+		return m.Streams
+	}
+	return nil
+}
+
+func (m *QueryCommunityPoolStreamsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.distribution.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.distribution.v1beta1.QueryParamsResponse")
@@ -972,6 +1177,10 @@ func init() {
 	proto.RegisterType((*QueryDelegatorWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressResponse")
 	proto.RegisterType((*QueryCommunityPoolRequest)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolRequest")
 	proto.RegisterType((*QueryCommunityPoolResponse)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolResponse")
+	proto.RegisterType((*QueryCommunityPoolStreamRequest)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolStreamRequest")
+	proto.RegisterType((*QueryCommunityPoolStreamResponse)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolStreamResponse")
+	proto.RegisterType((*QueryCommunityPoolStreamsRequest)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolStreamsRequest")
+	proto.RegisterType((*QueryCommunityPoolStreamsResponse)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolStreamsResponse")
 }
 
 func init() {
@@ -979,84 +1188,93 @@ func init() {
 }
 
 var fileDescriptor_5efd02cbc06efdc9 = []byte{
-	// 1228 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4d, 0x6c, 0x1b, 0x45,
-	0x14, 0xf6, 0x38, 0x69, 0x4a, 0x5f, 0x29, 0x49, 0xa6, 0x11, 0x72, 0x36, 0xc1, 0x8e, 0x36, 0xb4,
-	0x89, 0x88, 0xe2, 0x6d, 0x12, 0xa9, 0x40, 0x43, 0x05, 0xb1, 0x93, 0x52, 0xd4, 0xaa, 0x4d, 0xdd,
-	0xaa, 0x29, 0x5c, 0xac, 0xb5, 0x77, 0xba, 0x5e, 0xd5, 0xde, 0x71, 0x76, 0xd6, 0x09, 0x51, 0x95,
-	0x0b, 0xa5, 0x12, 0x17, 0x24, 0x24, 0x2e, 0x3d, 0xe6, 0xcc, 0x19, 0x84, 0xc4, 0x99, 0x43, 0x8f,
-	0x15, 0x48, 0x88, 0x13, 0x45, 0x09, 0x42, 0xe5, 0xc0, 0x99, 0x2b, 0xf2, 0xcc, 0xac, 0xbd, 0xeb,
-	0x9f, 0xb5, 0x1d, 0xc7, 0xa7, 0x6c, 0xde, 0xce, 0xfb, 0xde, 0xfb, 0xde, 0xcf, 0xec, 0x97, 0xc0,
-	0x5c, 0x9e, 0xb2, 0x12, 0x65, 0x9a, 0x61, 0x31, 0xd7, 0xb1, 0x72, 0x15, 0xd7, 0xa2, 0xb6, 0xb6,
-	0xb3, 0x94, 0x23, 0xae, 0xbe, 0xa4, 0x6d, 0x57, 0x88, 0xb3, 0x97, 0x2c, 0x3b, 0xd4, 0xa5, 0x78,
-	0x4a, 0x1c, 0x4c, 0xfa, 0x0f, 0x26, 0xe5, 0x41, 0xe5, 0x1d, 0x89, 0x92, 0xd3, 0x19, 0x11, 0x5e,
-	0x35, 0x8c, 0xb2, 0x6e, 0x5a, 0xb6, 0xce, 0x4f, 0x73, 0x20, 0x65, 0xc2, 0xa4, 0x26, 0xe5, 0x8f,
-	0x5a, 0xf5, 0x49, 0x5a, 0xa7, 0x4d, 0x4a, 0xcd, 0x22, 0xd1, 0xf4, 0xb2, 0xa5, 0xe9, 0xb6, 0x4d,
-	0x5d, 0xee, 0xc2, 0xe4, 0xdb, 0xb8, 0x1f, 0xdf, 0x43, 0xce, 0x53, 0xcb, 0xc3, 0x4c, 0x86, 0xb1,
-	0x08, 0x64, 0x2c, 0xce, 0x4f, 0x8a, 0xf3, 0x59, 0x91, 0x86, 0x64, 0xc6, 0x7f, 0x51, 0x27, 0x00,
-	0xdf, 0xa9, 0x12, 0xd8, 0xd4, 0x1d, 0xbd, 0xc4, 0x32, 0x64, 0xbb, 0x42, 0x98, 0xab, 0x3e, 0x80,
-	0xf3, 0x01, 0x2b, 0x2b, 0x53, 0x9b, 0x11, 0xbc, 0x06, 0x23, 0x65, 0x6e, 0x89, 0xa1, 0x19, 0x34,
-	0x7f, 0x76, 0x79, 0x36, 0x19, 0x52, 0xa5, 0xa4, 0x70, 0x4e, 0x0d, 0x3f, 0xff, 0x23, 0x11, 0xc9,
-	0x48, 0x47, 0xd5, 0x86, 0x0b, 0x1c, 0xf9, 0xbe, 0x5e, 0xb4, 0x0c, 0xdd, 0xa5, 0xce, 0xba, 0xcf,
-	0xf5, 0x13, 0xfb, 0x21, 0x95, 0x29, 0xe0, 0x0d, 0x18, 0xdf, 0xf1, 0xce, 0x64, 0x75, 0xc3, 0x70,
-	0x08, 0x13, 0x61, 0xcf, 0xa4, 0x62, 0xbf, 0x7c, 0xbf, 0x38, 0x21, 0x23, 0xaf, 0x89, 0x37, 0x77,
-	0x5d, 0xc7, 0xb2, 0xcd, 0xcc, 0x58, 0xcd, 0x45, 0xda, 0xd5, 0x97, 0x51, 0xb8, 0xd8, 0x29, 0xa0,
-	0x64, 0x97, 0x86, 0x31, 0x5a, 0x26, 0x4e, 0x4f, 0x01, 0x47, 0x3d, 0x0f, 0x69, 0xc6, 0xfb, 0x30,
-	0xce, 0x48, 0xf1, 0x61, 0x36, 0x47, 0x6d, 0x23, 0xeb, 0x90, 0x5d, 0xdd, 0x31, 0x58, 0x2c, 0x3a,
-	0x33, 0x34, 0x7f, 0x76, 0x79, 0xda, 0xab, 0x56, 0xb5, 0xad, 0xb5, 0x2a, 0xad, 0x93, 0x7c, 0x9a,
-	0x5a, 0x76, 0x6a, 0xa5, 0x5a, 0xa6, 0xef, 0x5e, 0x26, 0x16, 0x4c, 0xcb, 0x2d, 0x54, 0x72, 0xc9,
-	0x3c, 0x2d, 0xc9, 0x4e, 0xc9, 0x1f, 0x8b, 0xcc, 0x78, 0xa4, 0xb9, 0x7b, 0x65, 0xc2, 0x3c, 0x1f,
-	0x96, 0x19, 0xad, 0xc6, 0x4a, 0x51, 0xdb, 0xc8, 0x88, 0x48, 0x78, 0x1b, 0x20, 0x4f, 0x4b, 0x25,
-	0x8b, 0x31, 0x8b, 0xda, 0xb1, 0xa1, 0x41, 0xc5, 0xf5, 0x05, 0x51, 0xcb, 0x30, 0x17, 0x2c, 0xf0,
-	0xed, 0x8a, 0xcb, 0x5c, 0xdd, 0x36, 0xaa, 0xf5, 0x11, 0x69, 0x9d, 0x70, 0x4f, 0xbf, 0x44, 0x30,
-	0xdf, 0x39, 0xa4, 0xec, 0xea, 0x03, 0x38, 0xed, 0xb5, 0x41, 0x0c, 0xed, 0x7b, 0xa1, 0x43, 0x1b,
-	0x02, 0x29, 0x27, 0xd9, 0x83, 0x53, 0x0b, 0x90, 0x08, 0x66, 0x91, 0xae, 0x15, 0xe5, 0x84, 0x09,
-	0x3f, 0x45, 0x30, 0xd3, 0x3e, 0x94, 0x24, 0xaa, 0x07, 0x5a, 0x2f, 0xb8, 0xae, 0x76, 0xc7, 0x75,
-	0x2d, 0x9f, 0xaf, 0x94, 0x2a, 0x45, 0xdd, 0x25, 0x46, 0x1d, 0x58, 0xd2, 0xf5, 0xb7, 0xfa, 0x69,
-	0x14, 0xa6, 0x83, 0x79, 0xdc, 0x2d, 0xea, 0xac, 0x40, 0x4e, 0xb8, 0xc1, 0x78, 0x0e, 0x46, 0x99,
-	0xab, 0x3b, 0xae, 0x65, 0x9b, 0xd9, 0x02, 0xb1, 0xcc, 0x82, 0x1b, 0x8b, 0xce, 0xa0, 0xf9, 0xe1,
-	0xcc, 0x1b, 0x9e, 0xf9, 0x3a, 0xb7, 0xe2, 0x59, 0x38, 0x47, 0x78, 0x8b, 0xbc, 0x63, 0x43, 0xfc,
-	0xd8, 0xeb, 0xc2, 0x28, 0x0f, 0x5d, 0x03, 0xa8, 0xdf, 0xca, 0xb1, 0x61, 0x5e, 0x98, 0x8b, 0x81,
-	0x9d, 0x10, 0x17, 0x7f, 0xfd, 0xde, 0x32, 0x89, 0x24, 0x94, 0xf1, 0x79, 0x5e, 0x79, 0xed, 0xab,
-	0x83, 0x44, 0xe4, 0xd9, 0x41, 0x02, 0xa9, 0x3f, 0x21, 0x78, 0xab, 0x4d, 0x1d, 0x64, 0x33, 0x36,
-	0xe1, 0x34, 0x13, 0xa6, 0x18, 0xe2, 0x4b, 0x78, 0xa9, 0xbb, 0x4e, 0x70, 0x9c, 0x8d, 0x1d, 0x62,
-	0xbb, 0xde, 0xb4, 0x49, 0x18, 0xfc, 0x71, 0x80, 0x45, 0x94, 0xb3, 0x98, 0xeb, 0xc8, 0x42, 0xa4,
-	0xe3, 0xa7, 0xa1, 0xfe, 0xe8, 0x25, 0xbf, 0x4e, 0x8a, 0xc4, 0xe4, 0xb6, 0xe6, 0x35, 0x35, 0xc4,
-	0xbb, 0x5e, 0xba, 0x58, 0x73, 0xf1, 0xba, 0xd8, 0x72, 0x18, 0xa2, 0xbd, 0x0e, 0x83, 0x28, 0xfb,
-	0xab, 0x83, 0x44, 0x44, 0xfd, 0x1a, 0x41, 0xbc, 0x5d, 0xe6, 0xb2, 0xee, 0x8f, 0xfc, 0xdb, 0x3e,
-	0xa0, 0xcb, 0xaf, 0x76, 0x01, 0x54, 0x40, 0x6d, 0x48, 0xe7, 0x1e, 0x75, 0xf5, 0xe2, 0x40, 0xaa,
-	0xe9, 0x2b, 0xc3, 0xdf, 0x08, 0x66, 0x43, 0xe3, 0xca, 0x5a, 0xdc, 0x6f, 0xac, 0xc5, 0xe5, 0xd0,
-	0x19, 0xac, 0xa3, 0xad, 0x7b, 0xb1, 0x05, 0x62, 0xc3, 0xbd, 0x87, 0x4d, 0x38, 0xe5, 0x56, 0xe3,
-	0x0d, 0xee, 0xb3, 0x26, 0xf0, 0x55, 0x47, 0x5e, 0xb0, 0xb5, 0x7c, 0x6a, 0x6b, 0x32, 0xb8, 0xe2,
-	0xde, 0x94, 0x37, 0x6d, 0xcb, 0x98, 0xb2, 0xb0, 0x71, 0x80, 0xda, 0x94, 0x8a, 0xda, 0x9e, 0xc9,
-	0xf8, 0x2c, 0x3e, 0xb4, 0x5d, 0x78, 0x3b, 0x88, 0xb6, 0x65, 0xb9, 0x05, 0xc3, 0xd1, 0x77, 0x65,
-	0xe0, 0x81, 0xd1, 0xd8, 0x91, 0x32, 0xab, 0x7d, 0xe0, 0xba, 0xe8, 0xd9, 0x95, 0xaf, 0xba, 0x17,
-	0x3d, 0xbb, 0x41, 0x30, 0x5f, 0xdc, 0x29, 0x98, 0xe4, 0x71, 0xab, 0x9f, 0x91, 0x8a, 0x6d, 0xb9,
-	0x7b, 0x9b, 0x94, 0x16, 0x3d, 0x55, 0xf9, 0x04, 0x81, 0xd2, 0xea, 0xad, 0x4c, 0x85, 0xc0, 0x70,
-	0x99, 0xd2, 0xe2, 0xe0, 0x16, 0x97, 0xc3, 0x2f, 0xff, 0x3c, 0x0e, 0xa7, 0x78, 0x16, 0xf8, 0x19,
-	0x82, 0x11, 0x21, 0x52, 0xb1, 0x16, 0xba, 0x1a, 0xcd, 0x0a, 0x59, 0xb9, 0xd4, 0xbd, 0x83, 0xa0,
-	0xa7, 0x2e, 0x7c, 0xf1, 0xeb, 0x5f, 0xdf, 0x46, 0x2f, 0xe0, 0x59, 0x2d, 0x4c, 0xbd, 0x0b, 0x99,
-	0x8c, 0xff, 0x41, 0x30, 0xd9, 0x56, 0xb1, 0xe2, 0x54, 0xe7, 0xe0, 0x9d, 0xf4, 0xb5, 0x92, 0xee,
-	0x0b, 0x43, 0x72, 0x4a, 0x73, 0x4e, 0x57, 0xf1, 0x6a, 0x28, 0xa7, 0xfa, 0x6a, 0x68, 0x8f, 0x9b,
-	0xbe, 0x08, 0xfb, 0xf8, 0x49, 0x14, 0xa6, 0x42, 0x64, 0x17, 0x5e, 0xef, 0x21, 0xd3, 0xb6, 0xda,
-	0x53, 0xd9, 0xe8, 0x13, 0x45, 0x32, 0xde, 0xe2, 0x8c, 0xef, 0xe0, 0xdb, 0x7d, 0x30, 0xd6, 0x68,
-	0x1d, 0xdf, 0xfb, 0x1b, 0x01, 0x1f, 0x22, 0x38, 0xdf, 0x42, 0xde, 0xe1, 0x0f, 0x7a, 0xc8, 0xbb,
-	0x49, 0x80, 0x2a, 0x57, 0x8f, 0xe9, 0x2d, 0xd9, 0xde, 0xe2, 0x6c, 0xaf, 0xe3, 0x6b, 0xfd, 0xb0,
-	0xad, 0x0b, 0x48, 0xfc, 0x1b, 0x82, 0xb1, 0x46, 0xcd, 0x84, 0xdf, 0xef, 0x21, 0xc7, 0xa0, 0xde,
-	0x54, 0xae, 0x1c, 0xc7, 0x55, 0x72, 0xbb, 0xc1, 0xb9, 0x6d, 0xe0, 0x74, 0x3f, 0xdc, 0x3c, 0x75,
-	0xf6, 0x2f, 0x82, 0xf1, 0x26, 0x55, 0x82, 0xbb, 0x48, 0xaf, 0x9d, 0x08, 0x53, 0x56, 0x8f, 0xe5,
-	0x2b, 0xb9, 0x65, 0x39, 0xb7, 0x4f, 0xf1, 0x56, 0x28, 0xb7, 0xda, 0xf7, 0x83, 0x69, 0x8f, 0x9b,
-	0x3e, 0x3f, 0xfb, 0x9a, 0x9c, 0xcc, 0x96, 0x3b, 0xfb, 0x0a, 0xc1, 0x9b, 0xad, 0xe5, 0x07, 0xfe,
-	0xb0, 0x97, 0xc4, 0x5b, 0x08, 0x26, 0xe5, 0xa3, 0xe3, 0x03, 0xf4, 0xd4, 0xda, 0xee, 0xe8, 0xf3,
-	0xc5, 0x6c, 0xa1, 0x06, 0xba, 0x59, 0xcc, 0xf6, 0xc2, 0xa5, 0x9b, 0xc5, 0x0c, 0x91, 0x20, 0x5d,
-	0x2e, 0x66, 0x07, 0x86, 0xf5, 0xd9, 0xc6, 0xff, 0x21, 0x88, 0xb5, 0xd3, 0x0a, 0x78, 0xad, 0x87,
-	0x5c, 0x5b, 0x0b, 0x1c, 0x25, 0xd5, 0x0f, 0x84, 0xe4, 0x7c, 0x8f, 0x73, 0xbe, 0x85, 0x6f, 0xf6,
-	0xc3, 0xb9, 0x51, 0xec, 0xe0, 0x1f, 0x10, 0x9c, 0x0b, 0xe8, 0x11, 0x7c, 0xb9, 0x73, 0xae, 0xad,
-	0xe4, 0x8d, 0xf2, 0x6e, 0xcf, 0x7e, 0x92, 0xd8, 0x0a, 0x27, 0xb6, 0x88, 0x17, 0x42, 0x89, 0xe5,
-	0x3d, 0xdf, 0x6c, 0x55, 0xc6, 0xa4, 0x6e, 0x3c, 0x3f, 0x8c, 0xa3, 0x17, 0x87, 0x71, 0xf4, 0xe7,
-	0x61, 0x1c, 0x7d, 0x73, 0x14, 0x8f, 0xbc, 0x38, 0x8a, 0x47, 0x7e, 0x3f, 0x8a, 0x47, 0x3e, 0x5b,
-	0x0a, 0xd5, 0x44, 0x9f, 0x07, 0xd1, 0xb9, 0x44, 0xca, 0x8d, 0xf0, 0x7f, 0x06, 0xae, 0xfc, 0x1f,
-	0x00, 0x00, 0xff, 0xff, 0x9e, 0xe9, 0xb3, 0x0a, 0x1f, 0x15, 0x00, 0x00,
+	// 1372 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4d, 0x6c, 0x13, 0x47,
+	0x14, 0xce, 0x98, 0x10, 0xe0, 0x51, 0x0a, 0x0c, 0x51, 0x65, 0x36, 0xd4, 0x76, 0x37, 0x85, 0x44,
+	0x45, 0x78, 0x81, 0xa8, 0xb4, 0x25, 0x0d, 0x34, 0x76, 0xc2, 0x8f, 0x40, 0xfc, 0x18, 0x04, 0xb4,
+	0x17, 0x6b, 0xed, 0x5d, 0xd6, 0x5b, 0xec, 0x1d, 0xb3, 0xb3, 0x4e, 0x8a, 0x50, 0x2e, 0xa5, 0x48,
+	0xbd, 0x54, 0xaa, 0xd4, 0x0b, 0x47, 0xce, 0x3d, 0xb7, 0xaa, 0xd4, 0x43, 0xcf, 0x1c, 0x11, 0x95,
+	0xaa, 0x4a, 0x95, 0x4a, 0x95, 0x54, 0x15, 0x3d, 0xf4, 0xcc, 0xb5, 0xf2, 0xcc, 0xec, 0x7a, 0x37,
+	0xde, 0x5d, 0x7b, 0xed, 0xf8, 0x84, 0x79, 0x3b, 0xef, 0x7b, 0xef, 0x7b, 0x6f, 0x7e, 0xbe, 0x17,
+	0x98, 0xa9, 0x12, 0xda, 0x20, 0x54, 0xd1, 0x4c, 0xea, 0xd8, 0x66, 0xa5, 0xe5, 0x98, 0xc4, 0x52,
+	0x56, 0x4e, 0x54, 0x74, 0x47, 0x3d, 0xa1, 0xdc, 0x6f, 0xe9, 0xf6, 0x83, 0x7c, 0xd3, 0x26, 0x0e,
+	0xc1, 0x53, 0x7c, 0x61, 0xde, 0xbf, 0x30, 0x2f, 0x16, 0x4a, 0xef, 0x09, 0x94, 0x8a, 0x4a, 0x75,
+	0xee, 0xe5, 0x61, 0x34, 0x55, 0xc3, 0xb4, 0x54, 0xb6, 0x9a, 0x01, 0x49, 0x93, 0x06, 0x31, 0x08,
+	0xfb, 0xa9, 0xb4, 0x7f, 0x09, 0xeb, 0x21, 0x83, 0x10, 0xa3, 0xae, 0x2b, 0x6a, 0xd3, 0x54, 0x54,
+	0xcb, 0x22, 0x0e, 0x73, 0xa1, 0xe2, 0x6b, 0xc6, 0x8f, 0xef, 0x22, 0x57, 0x89, 0xe9, 0x62, 0xe6,
+	0xe3, 0x58, 0x04, 0x32, 0xe6, 0xeb, 0x0f, 0xf2, 0xf5, 0x65, 0x9e, 0x86, 0x60, 0xc6, 0xfe, 0x23,
+	0x4f, 0x02, 0xbe, 0xde, 0x26, 0x70, 0x4d, 0xb5, 0xd5, 0x06, 0x2d, 0xe9, 0xf7, 0x5b, 0x3a, 0x75,
+	0xe4, 0x3b, 0x70, 0x20, 0x60, 0xa5, 0x4d, 0x62, 0x51, 0x1d, 0x2f, 0xc2, 0x44, 0x93, 0x59, 0xd2,
+	0x28, 0x87, 0x66, 0x77, 0x9f, 0x9c, 0xce, 0xc7, 0x54, 0x29, 0xcf, 0x9d, 0x0b, 0xe3, 0xcf, 0xfe,
+	0xcc, 0x8e, 0x95, 0x84, 0xa3, 0x6c, 0xc1, 0x61, 0x86, 0x7c, 0x4b, 0xad, 0x9b, 0x9a, 0xea, 0x10,
+	0x7b, 0xc9, 0xe7, 0x7a, 0xd1, 0xba, 0x4b, 0x44, 0x0a, 0x78, 0x19, 0xf6, 0xaf, 0xb8, 0x6b, 0xca,
+	0xaa, 0xa6, 0xd9, 0x3a, 0xe5, 0x61, 0x77, 0x15, 0xd2, 0x2f, 0x7e, 0x38, 0x36, 0x29, 0x22, 0x2f,
+	0xf2, 0x2f, 0x37, 0x1c, 0xdb, 0xb4, 0x8c, 0xd2, 0x3e, 0xcf, 0x45, 0xd8, 0xe5, 0x97, 0x29, 0x38,
+	0xd2, 0x2b, 0xa0, 0x60, 0x57, 0x84, 0x7d, 0xa4, 0xa9, 0xdb, 0x89, 0x02, 0xee, 0x75, 0x3d, 0x84,
+	0x19, 0xaf, 0xc1, 0x7e, 0xaa, 0xd7, 0xef, 0x96, 0x2b, 0xc4, 0xd2, 0xca, 0xb6, 0xbe, 0xaa, 0xda,
+	0x1a, 0x4d, 0xa7, 0x72, 0xdb, 0x66, 0x77, 0x9f, 0x3c, 0xe4, 0x56, 0xab, 0xdd, 0x56, 0xaf, 0x4a,
+	0x4b, 0x7a, 0xb5, 0x48, 0x4c, 0xab, 0x30, 0xd7, 0x2e, 0xd3, 0xf7, 0x2f, 0xb3, 0x47, 0x0d, 0xd3,
+	0xa9, 0xb5, 0x2a, 0xf9, 0x2a, 0x69, 0x88, 0x4e, 0x89, 0x7f, 0x8e, 0x51, 0xed, 0x9e, 0xe2, 0x3c,
+	0x68, 0xea, 0xd4, 0xf5, 0xa1, 0xa5, 0xbd, 0xed, 0x58, 0x05, 0x62, 0x69, 0x25, 0x1e, 0x09, 0xdf,
+	0x07, 0xa8, 0x92, 0x46, 0xc3, 0xa4, 0xd4, 0x24, 0x56, 0x7a, 0xdb, 0xa8, 0xe2, 0xfa, 0x82, 0xc8,
+	0x4d, 0x98, 0x09, 0x16, 0xf8, 0x6a, 0xcb, 0xa1, 0x8e, 0x6a, 0x69, 0xed, 0xfa, 0xf0, 0xb4, 0xb6,
+	0xb8, 0xa7, 0x5f, 0x21, 0x98, 0xed, 0x1d, 0x52, 0x74, 0xf5, 0x0e, 0xec, 0x70, 0xdb, 0xc0, 0x37,
+	0xed, 0x87, 0xb1, 0x9b, 0x36, 0x06, 0x52, 0xec, 0x64, 0x17, 0x4e, 0xae, 0x41, 0x36, 0x98, 0x45,
+	0xd1, 0x2b, 0xca, 0x16, 0x13, 0x7e, 0x8c, 0x20, 0x17, 0x1d, 0x4a, 0x10, 0x55, 0x03, 0xad, 0xe7,
+	0x5c, 0xe7, 0xfb, 0xe3, 0xba, 0x58, 0xad, 0xb6, 0x1a, 0xad, 0xba, 0xea, 0xe8, 0x5a, 0x07, 0x58,
+	0xd0, 0xf5, 0xb7, 0xfa, 0x71, 0x0a, 0x0e, 0x05, 0xf3, 0xb8, 0x51, 0x57, 0x69, 0x4d, 0xdf, 0xe2,
+	0x06, 0xe3, 0x19, 0xd8, 0x4b, 0x1d, 0xd5, 0x76, 0x4c, 0xcb, 0x28, 0xd7, 0x74, 0xd3, 0xa8, 0x39,
+	0xe9, 0x54, 0x0e, 0xcd, 0x8e, 0x97, 0xde, 0x74, 0xcd, 0x17, 0x98, 0x15, 0x4f, 0xc3, 0x1e, 0xdd,
+	0xd2, 0x7c, 0xcb, 0xb6, 0xb1, 0x65, 0x6f, 0x70, 0xa3, 0x58, 0x74, 0x0e, 0xa0, 0x73, 0x2b, 0xa7,
+	0xc7, 0x59, 0x61, 0x8e, 0x04, 0xce, 0x04, 0xbf, 0xf8, 0x3b, 0xf7, 0x96, 0xa1, 0x0b, 0x42, 0x25,
+	0x9f, 0xe7, 0xe9, 0x9d, 0x5f, 0x3f, 0xcd, 0x8e, 0x3d, 0x79, 0x9a, 0x45, 0xf2, 0xcf, 0x08, 0xde,
+	0x8e, 0xa8, 0x83, 0x68, 0xc6, 0x35, 0xd8, 0x41, 0xb9, 0x29, 0x8d, 0xd8, 0x21, 0x3c, 0xde, 0x5f,
+	0x27, 0x18, 0xce, 0xf2, 0x8a, 0x6e, 0x39, 0xee, 0x6e, 0x13, 0x30, 0xf8, 0x7c, 0x80, 0x45, 0x8a,
+	0xb1, 0x98, 0xe9, 0xc9, 0x82, 0xa7, 0xe3, 0xa7, 0x21, 0xff, 0xe4, 0x26, 0xbf, 0xa4, 0xd7, 0x75,
+	0x83, 0xd9, 0xba, 0x8f, 0xa9, 0xc6, 0xbf, 0x25, 0xe9, 0xa2, 0xe7, 0xe2, 0x76, 0x31, 0x74, 0x33,
+	0xa4, 0x92, 0x6e, 0x06, 0x5e, 0xf6, 0x57, 0x4f, 0xb3, 0x63, 0xf2, 0x37, 0x08, 0x32, 0x51, 0x99,
+	0x8b, 0xba, 0xdf, 0xf3, 0x9f, 0xf6, 0x11, 0x5d, 0x7e, 0xde, 0x05, 0xd0, 0x02, 0x79, 0x53, 0x3a,
+	0x37, 0x89, 0xa3, 0xd6, 0x47, 0x52, 0x4d, 0x5f, 0x19, 0xfe, 0x41, 0x30, 0x1d, 0x1b, 0x57, 0xd4,
+	0xe2, 0xd6, 0xe6, 0x5a, 0x9c, 0x8a, 0xdd, 0x83, 0x1d, 0xb4, 0x25, 0x37, 0x36, 0x47, 0xdc, 0x74,
+	0xef, 0x61, 0x03, 0xb6, 0x3b, 0xed, 0x78, 0xa3, 0x7b, 0xd6, 0x38, 0xbe, 0x6c, 0x8b, 0x0b, 0xd6,
+	0xcb, 0xc7, 0x3b, 0x26, 0xa3, 0x2b, 0xee, 0x65, 0xc8, 0x45, 0xc7, 0x14, 0x85, 0xcd, 0x00, 0x78,
+	0xbb, 0x94, 0xd7, 0x76, 0x57, 0xc9, 0x67, 0xf1, 0xa1, 0xad, 0xc2, 0xbb, 0x41, 0xb4, 0xdb, 0xa6,
+	0x53, 0xd3, 0x6c, 0x75, 0x55, 0x04, 0x1e, 0x19, 0x8d, 0x15, 0x38, 0xdc, 0x23, 0x70, 0x47, 0xf4,
+	0xac, 0x8a, 0x4f, 0xfd, 0x8b, 0x9e, 0xd5, 0x20, 0x98, 0x2f, 0xee, 0x14, 0x1c, 0x64, 0x71, 0xdb,
+	0xcf, 0x48, 0xcb, 0x32, 0x9d, 0x07, 0xd7, 0x08, 0xa9, 0xbb, 0xaa, 0xf2, 0x11, 0x02, 0x29, 0xec,
+	0xab, 0x48, 0x45, 0x87, 0xf1, 0x26, 0x21, 0xf5, 0xd1, 0x1d, 0x5c, 0x06, 0x2f, 0x9f, 0x81, 0x6c,
+	0x77, 0x12, 0x37, 0x1c, 0x5b, 0x57, 0x1b, 0x6e, 0x3b, 0xa6, 0x60, 0x17, 0x65, 0x86, 0xb2, 0xa9,
+	0xb1, 0x6a, 0x8c, 0x97, 0x76, 0x72, 0xc3, 0x45, 0x4d, 0xb6, 0x21, 0x17, 0xed, 0x2f, 0xa8, 0x5c,
+	0x81, 0x09, 0xbe, 0x5e, 0xbc, 0xc3, 0xf1, 0xb7, 0x7f, 0x08, 0x92, 0xab, 0x9a, 0x39, 0x8a, 0xfc,
+	0x79, 0x74, 0x4c, 0x6f, 0x0f, 0x05, 0x9f, 0x39, 0x34, 0xe8, 0x33, 0x27, 0xff, 0x82, 0xe0, 0x9d,
+	0x98, 0x60, 0xbe, 0x07, 0x8e, 0x9b, 0xfa, 0x7a, 0xe0, 0xa2, 0x29, 0xba, 0x30, 0x5b, 0xf6, 0xc0,
+	0x9d, 0x7c, 0x3d, 0x09, 0xdb, 0x19, 0x01, 0xfc, 0x04, 0xc1, 0x04, 0x9f, 0x42, 0xb0, 0x12, 0x9b,
+	0x5e, 0xf7, 0x08, 0x24, 0x1d, 0xef, 0xdf, 0x81, 0xe7, 0x20, 0x1f, 0xfd, 0xf2, 0xd7, 0xbf, 0xbf,
+	0x4b, 0x1d, 0xc6, 0xd3, 0x4a, 0xdc, 0x78, 0xc6, 0xe7, 0x20, 0xfc, 0x2f, 0x82, 0x83, 0x91, 0x23,
+	0x09, 0x2e, 0xf4, 0x0e, 0xde, 0x6b, 0x80, 0x92, 0x8a, 0x43, 0x61, 0x08, 0x4e, 0x45, 0xc6, 0x69,
+	0x01, 0xcf, 0xc7, 0x72, 0xea, 0xdc, 0x7d, 0xca, 0xc3, 0xae, 0x27, 0x7f, 0x0d, 0x3f, 0x4a, 0xc1,
+	0x54, 0x8c, 0xae, 0xc6, 0x4b, 0x09, 0x32, 0x8d, 0x1c, 0x2e, 0xa4, 0xe5, 0x21, 0x51, 0x04, 0xe3,
+	0xdb, 0x8c, 0xf1, 0x75, 0x7c, 0x75, 0x08, 0xc6, 0x0a, 0xe9, 0xe0, 0xbb, 0x43, 0x20, 0x5e, 0x47,
+	0x70, 0x20, 0x44, 0xbf, 0xe3, 0x8f, 0x13, 0xe4, 0xdd, 0x35, 0x61, 0x48, 0x0b, 0x03, 0x7a, 0x0b,
+	0xb6, 0x57, 0x18, 0xdb, 0x0b, 0xf8, 0xdc, 0x30, 0x6c, 0x3b, 0x13, 0x02, 0xfe, 0x0d, 0xc1, 0xbe,
+	0xcd, 0xa2, 0x18, 0x7f, 0x94, 0x20, 0xc7, 0xe0, 0x40, 0x21, 0x9d, 0x1e, 0xc4, 0x55, 0x70, 0xbb,
+	0xc4, 0xb8, 0x2d, 0xe3, 0xe2, 0x30, 0xdc, 0x5c, 0xf9, 0xfd, 0x1f, 0x82, 0xfd, 0x5d, 0xb2, 0x13,
+	0xf7, 0x91, 0x5e, 0x94, 0xca, 0x96, 0xe6, 0x07, 0xf2, 0x15, 0xdc, 0xca, 0x8c, 0xdb, 0xa7, 0xf8,
+	0x76, 0x2c, 0x37, 0x4f, 0x20, 0x50, 0xe5, 0x61, 0x97, 0xbe, 0x58, 0x53, 0xc4, 0xce, 0x0c, 0x3d,
+	0xb3, 0xaf, 0x10, 0xbc, 0x15, 0xae, 0x2f, 0xf1, 0xd9, 0x24, 0x89, 0x87, 0x28, 0x62, 0xe9, 0x93,
+	0xc1, 0x01, 0x12, 0xb5, 0xb6, 0x3f, 0xfa, 0xec, 0x60, 0x86, 0xc8, 0xbd, 0x7e, 0x0e, 0x66, 0xb4,
+	0x32, 0x95, 0x16, 0x06, 0xf4, 0x4e, 0x74, 0x30, 0x7b, 0x30, 0xec, 0xec, 0x6d, 0xfc, 0x1a, 0x41,
+	0x3a, 0x4a, 0x0c, 0xe2, 0xc5, 0x04, 0xb9, 0x86, 0x2b, 0x58, 0xa9, 0x30, 0x0c, 0x84, 0xe0, 0x7c,
+	0x93, 0x71, 0xbe, 0x82, 0x2f, 0x0f, 0xc3, 0x79, 0xb3, 0x9a, 0xc5, 0x3f, 0x22, 0xd8, 0x13, 0x90,
+	0x1f, 0xf8, 0x54, 0xef, 0x5c, 0xc3, 0xf4, 0xab, 0xf4, 0x41, 0x62, 0x3f, 0x41, 0x6c, 0x8e, 0x11,
+	0x3b, 0x86, 0x8f, 0xc6, 0x12, 0xab, 0xba, 0xbe, 0xe5, 0xb6, 0x4e, 0xc5, 0x7f, 0x20, 0x38, 0x10,
+	0x22, 0x9b, 0xfa, 0xd9, 0x96, 0xd1, 0xd2, 0x56, 0x5a, 0x18, 0xd0, 0x5b, 0x30, 0x39, 0xcf, 0x98,
+	0x2c, 0xe2, 0xb3, 0x09, 0x98, 0x28, 0x42, 0xe1, 0x29, 0x0f, 0x3d, 0x51, 0xbd, 0x86, 0x5f, 0x20,
+	0x98, 0x0c, 0x09, 0x44, 0xf1, 0x60, 0x09, 0x7a, 0xfb, 0xf0, 0xcc, 0xa0, 0xee, 0x82, 0xe0, 0x3c,
+	0x23, 0xf8, 0x3e, 0x9e, 0x1b, 0x80, 0x60, 0xe1, 0xd2, 0xb3, 0xf5, 0x0c, 0x7a, 0xbe, 0x9e, 0x41,
+	0x7f, 0xad, 0x67, 0xd0, 0xb7, 0x1b, 0x99, 0xb1, 0xe7, 0x1b, 0x99, 0xb1, 0xdf, 0x37, 0x32, 0x63,
+	0x9f, 0x9d, 0x88, 0x9d, 0x53, 0xbe, 0x08, 0x46, 0x61, 0x63, 0x4b, 0x65, 0x82, 0xfd, 0x81, 0x7e,
+	0xee, 0xff, 0x01, 0x00, 0x94, 0xaa, 0x31, 0x93, 0xb3, 0x18, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1092,6 +1310,14 @@ type QueryClient interface {
 	DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error)
 	// CommunityPool queries the community pool coins.
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
+	// CommunityPoolStream queries a community pool stream by its id.
+	//
+	// Since: cosmos-sdk 0.47
+	CommunityPoolStream(ctx context.Context, in *QueryCommunityPoolStreamRequest, opts ...grpc.CallOption) (*QueryCommunityPoolStreamResponse, error)
+	// CommunityPoolStreams queries all the active community pool streams.
+	//
+	// Since: cosmos-sdk 0.47
+	CommunityPoolStreams(ctx context.Context, in *QueryCommunityPoolStreamsRequest, opts ...grpc.CallOption) (*QueryCommunityPoolStreamsResponse, error)
 }
 
 type queryClient struct {
@@ -1192,6 +1418,24 @@ func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolR
 	return out, nil
 }
 
+func (c *queryClient) CommunityPoolStream(ctx context.Context, in *QueryCommunityPoolStreamRequest, opts ...grpc.CallOption) (*QueryCommunityPoolStreamResponse, error) {
+	out := new(QueryCommunityPoolStreamResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/CommunityPoolStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CommunityPoolStreams(ctx context.Context, in *QueryCommunityPoolStreamsRequest, opts ...grpc.CallOption) (*QueryCommunityPoolStreamsResponse, error) {
+	out := new(QueryCommunityPoolStreamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/CommunityPoolStreams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries params of the distribution module.
@@ -1215,6 +1459,14 @@ type QueryServer interface {
 	DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error)
 	// CommunityPool queries the community pool coins.
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
+	// CommunityPoolStream queries a community pool stream by its id.
+	//
+	// Since: cosmos-sdk 0.47
+	CommunityPoolStream(context.Context, *QueryCommunityPoolStreamRequest) (*QueryCommunityPoolStreamResponse, error)
+	// CommunityPoolStreams queries all the active community pool streams.
+	//
+	// Since: cosmos-sdk 0.47
+	CommunityPoolStreams(context.Context, *QueryCommunityPoolStreamsRequest) (*QueryCommunityPoolStreamsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1251,6 +1503,12 @@ func (*UnimplementedQueryServer) DelegatorWithdrawAddress(ctx context.Context, r
 func (*UnimplementedQueryServer) CommunityPool(ctx context.Context, req *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommunityPool not implemented")
 }
+func (*UnimplementedQueryServer) CommunityPoolStream(ctx context.Context, req *QueryCommunityPoolStreamRequest) (*QueryCommunityPoolStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommunityPoolStream not implemented")
+}
+func (*UnimplementedQueryServer) CommunityPoolStreams(ctx context.Context, req *QueryCommunityPoolStreamsRequest) (*QueryCommunityPoolStreamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommunityPoolStreams not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1436,6 +1694,42 @@ func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CommunityPoolStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCommunityPoolStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CommunityPoolStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/CommunityPoolStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CommunityPoolStream(ctx, req.(*QueryCommunityPoolStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CommunityPoolStreams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCommunityPoolStreamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CommunityPoolStreams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/CommunityPoolStreams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CommunityPoolStreams(ctx, req.(*QueryCommunityPoolStreamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.distribution.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1480,6 +1774,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CommunityPool",
 			Handler:    _Query_CommunityPool_Handler,
 		},
+		{
+			MethodName: "CommunityPoolStream",
+			Handler:    _Query_CommunityPoolStream_Handler,
+		},
+		{
+			MethodName: "CommunityPoolStreams",
+			Handler:    _Query_CommunityPoolStreams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/query.proto",
@@ -2193,81 +2495,226 @@ func (m *QueryCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCommunityPoolStreamRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryCommunityPoolStreamRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryValidatorDistributionInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryCommunityPoolStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.StreamId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorDistributionInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCommunityPoolStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommunityPoolStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommunityPoolStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.SelfBondRewards) > 0 {
-		for _, e := range m.SelfBondRewards {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if len(m.Commission) > 0 {
-		for _, e := range m.Commission {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Stream.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorOutstandingRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCommunityPoolStreamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommunityPoolStreamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommunityPoolStreamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommunityPoolStreamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommunityPoolStreamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommunityPoolStreamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Streams) > 0 {
+		for iNdEx := len(m.Streams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Streams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorDistributionInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorDistributionInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.SelfBondRewards) > 0 {
+		for _, e := range m.SelfBondRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Commission) > 0 {
+		for _, e := range m.Commission {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryValidatorOutstandingRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	l = len(m.ValidatorAddress)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
@@ -2496,6 +2943,61 @@ func (m *QueryCommunityPoolResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryCommunityPoolStreamRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		n += 1 + sovQuery(uint64(m.StreamId))
+	}
+	return n
+}
+
+func (m *QueryCommunityPoolStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stream.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCommunityPoolStreamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCommunityPoolStreamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Streams) > 0 {
+		for _, e := range m.Streams {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4333,6 +4835,364 @@ func (m *QueryCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryCommunityPoolStreamRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCommunityPoolStreamRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCommunityPoolStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCommunityPoolStreamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCommunityPoolStreamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCommunityPoolStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCommunityPoolStreamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCommunityPoolStreamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCommunityPoolStreamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCommunityPoolStreamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCommunityPoolStreamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCommunityPoolStreamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Streams = append(m.Streams, CommunityPoolStream{})
+			if err := m.Streams[len(m.Streams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,23 @@
+package epoching
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching/keeper"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+// EndBlocker executes the queued messages when the current block is the last
+// one of its epoch. It must run before the staking EndBlocker, so that the
+// validator set updates of the epoch are applied in the same block.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	if !k.IsEpochEnd(ctx) {
+		return
+	}
+
+	k.EndEpoch(ctx)
+}
@@ -0,0 +1,54 @@
+package epoching
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// nestedMsgs is implemented by the messages executing other messages, e.g. the
+// authz MsgExec.
+type nestedMsgs interface {
+	GetMessages() ([]sdk.Msg, error)
+}
+
+// RejectStakingMsgsDecorator rejects the transactions containing a raw staking
+// message which must go through the epoch queue, including within the
+// messages executing other messages. It runs the check of the
+// StakingMsgsCircuitBreaker in CheckTx, so that such transactions are not
+// included in a block only to fail in DeliverTx after paying fees.
+//
+// The group and gov proposals are only executed later on, their messages are
+// still rejected by the StakingMsgsCircuitBreaker.
+type RejectStakingMsgsDecorator struct{}
+
+// NewRejectStakingMsgsDecorator creates a new RejectStakingMsgsDecorator.
+func NewRejectStakingMsgsDecorator() RejectStakingMsgsDecorator {
+	return RejectStakingMsgsDecorator{}
+}
+
+func (rsd RejectStakingMsgsDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if err := rejectStakingMsgs(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func rejectStakingMsgs(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		if err := checkStakingMsg(ctx, sdk.MsgTypeURL(msg)); err != nil {
+			return err
+		}
+
+		if nested, ok := msg.(nestedMsgs); ok {
+			inner, err := nested.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err := rejectStakingMsgs(ctx, inner); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
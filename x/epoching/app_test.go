@@ -0,0 +1,119 @@
+package epoching_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// setupApp returns a simapp with a validator and addr holding amount,
+// rejecting the raw staking messages if requireQueued is set.
+func setupApp(t *testing.T, addr sdk.AccAddress, amount sdk.Coin, requireQueued bool) *simapp.SimApp {
+	appOpts := viper.New()
+	appOpts.Set(epoching.FlagRequireQueuedStakingMsgs, requireQueued)
+	app := simapp.NewSimappWithCustomOptions(t, false, simapp.SetupOptions{
+		Logger:             log.NewNopLogger(),
+		DB:                 dbm.NewMemDB(),
+		InvCheckPeriod:     0,
+		HomePath:           simapp.DefaultNodeHome,
+		SkipUpgradeHeights: map[int64]bool{},
+		EncConfig:          simapp.MakeTestEncodingConfig(),
+		AppOpts:            appOpts,
+	})
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr))
+	require.NoError(t, testutil.FundAccount(app.BankKeeper, ctx, addr, sdk.NewCoins(amount)))
+	app.Commit()
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1}})
+	app.Commit()
+
+	return app
+}
+
+func TestRawStakingMsgsAllowedByDefault(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	amount := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+	app := setupApp(t, addr, amount, false)
+
+	ctx := app.BaseApp.NewContext(true, tmproto.Header{})
+	accNum := app.AccountKeeper.GetAccount(ctx, addr).GetAccountNumber()
+	valAddr := app.StakingKeeper.GetAllValidators(ctx)[0].GetOperator()
+	txGen := simapp.MakeTestEncodingConfig().TxConfig
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+
+	// the raw staking messages are executed right away
+	delegate := stakingtypes.NewMsgDelegate(addr, valAddr, amount)
+	_, _, err := simapp.SignCheckDeliver(t, txGen, app.BaseApp, header, []sdk.Msg{delegate}, "", []uint64{accNum}, []uint64{0}, true, true, priv)
+	require.NoError(t, err)
+
+	ctx = app.BaseApp.NewContext(true, tmproto.Header{})
+	require.Empty(t, app.EpochingKeeper.GetEpochActions(ctx))
+	_, found := app.StakingKeeper.GetDelegation(ctx, addr, valAddr)
+	require.True(t, found)
+}
+
+func TestRawStakingMsgsRejected(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	amount := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+	app := setupApp(t, addr, amount, true)
+
+	ctx := app.BaseApp.NewContext(true, tmproto.Header{})
+	accNum := app.AccountKeeper.GetAccount(ctx, addr).GetAccountNumber()
+	valAddr := app.StakingKeeper.GetAllValidators(ctx)[0].GetOperator()
+	delegation, _ := app.StakingKeeper.GetDelegation(ctx, addr, valAddr)
+
+	txGen := simapp.MakeTestEncodingConfig().TxConfig
+	header := func() tmproto.Header { return tmproto.Header{Height: app.LastBlockHeight() + 1} }
+
+	checkTx := func(msgs ...sdk.Msg) abci.ResponseCheckTx {
+		tx, err := helpers.GenSignedMockTx(rand.New(rand.NewSource(1)), txGen, msgs, sdk.NewCoins(), helpers.DefaultGenTxGas, "", []uint64{accNum}, []uint64{0}, priv)
+		require.NoError(t, err)
+		txBytes, err := txGen.TxEncoder()(tx)
+		require.NoError(t, err)
+		return app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+	}
+
+	// the raw staking messages are rejected in CheckTx, even nested
+	delegate := stakingtypes.NewMsgDelegate(addr, valAddr, amount)
+	exec := authz.NewMsgExec(addr, []sdk.Msg{delegate})
+	for _, msg := range []sdk.Msg{delegate, &exec, stakingtypes.NewMsgUndelegate(addr, valAddr, amount)} {
+		res := checkTx(msg)
+		require.Equal(t, types.ErrUnqueuedStakingMsg.Codespace(), res.Codespace)
+		require.Equal(t, types.ErrUnqueuedStakingMsg.ABCICode(), res.Code)
+	}
+
+	// and in DeliverTx, before any fee is paid or the sequence is increased
+	_, _, err := simapp.SignCheckDeliver(t, txGen, app.BaseApp, header(), []sdk.Msg{delegate}, "", []uint64{accNum}, []uint64{0}, false, false, priv)
+	require.ErrorIs(t, err, types.ErrUnqueuedStakingMsg)
+	require.Equal(t, sdk.NewCoins(amount), app.BankKeeper.GetAllBalances(app.BaseApp.NewContext(true, tmproto.Header{}), addr))
+
+	// the wrapped one is queued until the end of the epoch
+	wrapped := types.NewMsgWrappedDelegate(delegate)
+	_, _, err = simapp.SignCheckDeliver(t, txGen, app.BaseApp, header(), []sdk.Msg{wrapped}, "", []uint64{accNum}, []uint64{0}, true, true, priv)
+	require.NoError(t, err)
+
+	ctx = app.BaseApp.NewContext(true, tmproto.Header{})
+	require.Len(t, app.EpochingKeeper.GetEpochActions(ctx), 1)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, addr).IsZero())
+	newDelegation, _ := app.StakingKeeper.GetDelegation(ctx, addr, valAddr)
+	require.Equal(t, delegation, newDelegation)
+}
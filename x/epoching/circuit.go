@@ -0,0 +1,67 @@
+package epoching
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var _ baseapp.CircuitBreaker = StakingMsgsCircuitBreaker{}
+
+// stakingMsgTypeURLs are the staking messages changing the voting power of
+// the validators, which must go through the epoch queue.
+var stakingMsgTypeURLs = map[string]bool{
+	sdk.MsgTypeURL(&stakingtypes.MsgCreateValidator{}):           true,
+	sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}):                  true,
+	sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}):                true,
+	sdk.MsgTypeURL(&stakingtypes.MsgBeginRedelegate{}):           true,
+	sdk.MsgTypeURL(&stakingtypes.MsgCancelUnbondingDelegation{}): true,
+	sdk.MsgTypeURL(&stakingtypes.MsgTokenizeShares{}):            true,
+	sdk.MsgTypeURL(&stakingtypes.MsgRedeemTokensForShares{}):     true,
+	sdk.MsgTypeURL(&stakingtypes.MsgValidatorBond{}):             true,
+}
+
+// checkStakingMsg rejects the staking message typeURL if it must go through
+// the epoch queue and ctx does not execute a queued message. The genesis
+// transactions are executed directly.
+func checkStakingMsg(ctx context.Context, typeURL string) error {
+	if !stakingMsgTypeURLs[typeURL] || types.IsQueuedMsgExecution(ctx) || sdk.UnwrapSDKContext(ctx).BlockHeight() == 0 {
+		return nil
+	}
+
+	return types.ErrUnqueuedStakingMsg.Wrapf("%s must be sent wrapped in the %s module messages", typeURL, types.ModuleName)
+}
+
+// StakingMsgsCircuitBreaker is a baseapp.CircuitBreaker rejecting the raw
+// staking messages changing the voting power of the validators, so that every
+// validator set change goes through the epoch queue. It runs in the
+// MsgServiceRouter, so the staking messages executed by other messages, e.g.
+// the authz MsgExec or the group and gov proposals, are rejected as well. Only
+// the queued messages executed by the epoching EndBlocker are allowed.
+//
+// The other messages are checked by the wrapped CircuitBreaker, if any.
+type StakingMsgsCircuitBreaker struct {
+	next baseapp.CircuitBreaker
+}
+
+// NewStakingMsgsCircuitBreaker creates a new StakingMsgsCircuitBreaker
+// wrapping next, which can be nil.
+func NewStakingMsgsCircuitBreaker(next baseapp.CircuitBreaker) StakingMsgsCircuitBreaker {
+	return StakingMsgsCircuitBreaker{next: next}
+}
+
+// IsAllowed implements baseapp.CircuitBreaker.
+func (cb StakingMsgsCircuitBreaker) IsAllowed(ctx context.Context, typeURL string) (bool, error) {
+	if err := checkStakingMsg(ctx, typeURL); err != nil {
+		return false, err
+	}
+
+	if cb.next == nil {
+		return true, nil
+	}
+
+	return cb.next.IsAllowed(ctx, typeURL)
+}
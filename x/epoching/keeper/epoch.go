@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// EndEpoch executes the messages queued in the current epoch in the order
+// they were queued, dequeues them and moves to the next epoch.
+//
+// Each message is executed in its own cached context: when it fails, none of
+// its state changes are written, the tokens escrowed for a delegation are
+// refunded to the delegator and the following messages are executed anyway.
+func (k Keeper) EndEpoch(ctx sdk.Context) {
+	epochNumber := k.GetEpochNumber(ctx)
+
+	// the queue is read before executing any message, so that the messages
+	// never iterate over a store they modify
+	for _, queued := range k.GetEpochMsgs(ctx, epochNumber) {
+		k.executeQueuedMsg(ctx, queued)
+		k.DequeueEpochMsg(ctx, epochNumber, queued.Id)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEpochEnd,
+			sdk.NewAttribute(types.AttributeKeyEpochNumber, strconv.FormatUint(epochNumber, 10)),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+	)
+
+	k.IncreaseEpochNumber(ctx)
+}
+
+// executeQueuedMsg executes a single queued message and emits its outcome.
+func (k Keeper) executeQueuedMsg(ctx sdk.Context, queued types.QueuedMessage) {
+	msg, err := queued.GetSdkMsg()
+	if err != nil {
+		// this can only happen if the store is corrupted
+		panic(err)
+	}
+
+	// Messages may mutate state thus we use a cached context. If the handler
+	// fails, no state mutation is written and the error is logged.
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	var res *sdk.Result
+	if err = k.releaseEscrow(cacheCtx, msg); err == nil {
+		res, err = k.handle(types.WithQueuedMsgExecution(cacheCtx), msg)
+	}
+
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyMsgID, strconv.FormatUint(queued.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyMsgType, sdk.MsgTypeURL(msg)),
+		sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(err == nil)),
+	}
+
+	if err == nil {
+		// write state to the underlying multi-store
+		writeCache()
+
+		// propagate the msg events to the current context
+		ctx.EventManager().EmitEvents(res.GetEvents())
+	} else {
+		k.Logger(ctx).Info(
+			"queued message failed on execution",
+			"id", queued.Id,
+			"msg", sdk.MsgTypeURL(msg),
+			"err", err,
+		)
+
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyError, err.Error()))
+
+		// the escrow must be refunded on failure, which cannot fail unless
+		// the module account invariant is broken
+		if err := k.releaseEscrow(ctx, msg); err != nil {
+			panic(fmt.Sprintf("failed to refund escrow of queued message %d: %s", queued.Id, err))
+		}
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeExecuteMsg, attrs...))
+}
+
+// handle routes msg to its handler.
+func (k Keeper) handle(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+	handler := k.router.Handler(msg)
+	if handler == nil {
+		return nil, sdkerrors.ErrUnknownRequest.Wrapf("unrecognized message route: %s", sdk.MsgTypeURL(msg))
+	}
+
+	return handler(ctx, msg)
+}
+
+// releaseEscrow sends back the tokens escrowed for a queued delegation or
+// validator creation to the delegator. It is a no-op for the other messages.
+func (k Keeper) releaseEscrow(ctx sdk.Context, msg sdk.Msg) error {
+	delegator, escrowed, ok := escrowOf(msg)
+	if !ok {
+		return nil
+	}
+
+	delegatorAddress, err := sdk.AccAddressFromBech32(delegator)
+	if err != nil {
+		return err
+	}
+
+	return k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.ModuleName, delegatorAddress, sdk.NewCoins(escrowed))
+}
+
+// escrowOf returns the delegator and the tokens escrowed for msg, if any.
+func escrowOf(msg sdk.Msg) (string, sdk.Coin, bool) {
+	switch msg := msg.(type) {
+	case *stakingtypes.MsgDelegate:
+		return msg.DelegatorAddress, msg.Amount, true
+	case *stakingtypes.MsgCreateValidator:
+		return msg.DelegatorAddress, msg.Value, true
+	default:
+		return "", sdk.Coin{}, false
+	}
+}
+
+// GetEscrowedCoins returns the sum of the tokens escrowed for the queued
+// delegations and validator creations.
+func (k Keeper) GetEscrowedCoins(ctx sdk.Context) sdk.Coins {
+	escrowed := sdk.NewCoins()
+	for _, queued := range k.GetEpochActions(ctx) {
+		msg, err := queued.GetSdkMsg()
+		if err != nil {
+			panic(err)
+		}
+
+		if _, amount, ok := escrowOf(msg); ok {
+			escrowed = escrowed.Add(amount)
+		}
+	}
+
+	return escrowed
+}
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+// InitGenesis initializes the epoching module's state from a given genesis
+// state. Queued messages are restored in the current epoch.
+func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
+	// check if the module account exists
+	k.authKeeper.GetModuleAccount(ctx, types.ModuleName)
+
+	k.SetParams(ctx, data.Params)
+	k.SetEpochNumber(ctx, data.EpochNumber)
+
+	nextID := uint64(types.DefaultEpochActionID)
+	for _, queued := range data.QueuedMsgs {
+		k.SetEpochMsg(ctx, data.EpochNumber, queued)
+		if queued.Id >= nextID {
+			nextID = queued.Id + 1
+		}
+	}
+	k.setNextActionID(ctx, nextID)
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx), k.GetEpochNumber(ctx), k.GetEpochActions(ctx))
+}
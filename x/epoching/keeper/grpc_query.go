@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns params of the epoching module.
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// CurrentEpoch returns the current epoch number and the height at which it ends.
+func (k Keeper) CurrentEpoch(c context.Context, _ *types.QueryCurrentEpochRequest) (*types.QueryCurrentEpochResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryCurrentEpochResponse{
+		EpochNumber:    k.GetEpochNumber(ctx),
+		EpochEndHeight: k.GetEpochEndHeight(ctx),
+	}, nil
+}
+
+// EpochMsgs returns the messages queued in the current epoch.
+func (k Keeper) EpochMsgs(c context.Context, req *types.QueryEpochMsgsRequest) (*types.QueryEpochMsgsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.EpochActionQueueKey(k.GetEpochNumber(ctx)))
+
+	var msgs []types.QueuedMessage
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		msgs = append(msgs, k.mustUnmarshalQueuedMsg(value))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEpochMsgsResponse{Msgs: msgs, Pagination: pageRes}, nil
+}
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+// RegisterInvariants registers all epoching invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "escrowed-funds", EscrowedFundsInvariant(k))
+}
+
+// EscrowedFundsInvariant checks that the epoching module account holds exactly
+// the tokens of the queued delegations.
+func EscrowedFundsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		escrowed := k.GetEscrowedCoins(ctx)
+		balance := k.bankKeeper.GetAllBalances(ctx, k.authKeeper.GetModuleAddress(types.ModuleName))
+
+		broken := !balance.IsEqual(escrowed)
+
+		return sdk.FormatInvariant(types.ModuleName, "escrowed funds", fmt.Sprintf(
+			"\tsum of queued delegations: %v\n\tmodule account balance: %v\n", escrowed, balance,
+		)), broken
+	}
+}
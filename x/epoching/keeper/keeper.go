@@ -1,174 +1,206 @@
 package keeper
 
 import (
-	"time"
+	"github.com/tendermint/tendermint/libs/log"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
-	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	db "github.com/tendermint/tm-db"
-)
-
-const (
-	DefaultEpochActionID = 1
-	DefaultEpochNumber   = 0
-)
-
-var (
-	NextEpochActionID      = []byte{0x11}
-	EpochNumberID          = []byte{0x12}
-	EpochActionQueuePrefix = []byte{0x13} // prefix for the epoch
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
 )
 
 // Keeper of the store
 type Keeper struct {
-	storeKey storetypes.StoreKey
-	cdc      codec.BinaryCodec
-	// Used to calculate the estimated next epoch time.
-	// This is local to every node
-	// TODO: remove in favor of consensus param when its added
-	commitTimeout time.Duration
+	storeKey      storetypes.StoreKey
+	cdc           codec.BinaryCodec
+	authKeeper    types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	stakingKeeper types.StakingKeeper
+
+	// router is used to execute the queued messages at the end of the epoch.
+	router *baseapp.MsgServiceRouter
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
 }
 
 // NewKeeper creates a epoch queue manager
-func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, commitTimeout time.Duration) Keeper {
+func NewKeeper(
+	cdc codec.BinaryCodec, key storetypes.StoreKey,
+	ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper,
+	router *baseapp.MsgServiceRouter, authority string,
+) Keeper {
+	// ensure epoching module account is set, it escrows the queued delegations
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic("the epoching module account has not been set")
+	}
+
 	return Keeper{
 		storeKey:      key,
 		cdc:           cdc,
-		commitTimeout: commitTimeout,
+		authKeeper:    ak,
+		bankKeeper:    bk,
+		stakingKeeper: sk,
+		router:        router,
+		authority:     authority,
 	}
 }
 
-// GetNewActionID returns ID to be used for next epoch
-func (k Keeper) GetNewActionID(ctx sdk.Context) uint64 {
-	store := ctx.KVStore(k.storeKey)
+// GetAuthority returns the x/epoching module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
 
-	bz := store.Get(NextEpochActionID)
+// GetParams returns the total set of epoching parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
 	if bz == nil {
-		// return default action ID to 1
-		return DefaultEpochActionID
+		return params
 	}
-	id := sdk.BigEndianToUint64(bz)
-
-	// increment next action ID
-	store.Set(NextEpochActionID, sdk.Uint64ToBigEndian(id+1))
 
-	return id
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
 }
 
-// ActionStoreKey returns action store key from ID
-func ActionStoreKey(epochNumber int64, actionID uint64) []byte {
-	return append(EpochActionQueuePrefix, byte(epochNumber), byte(actionID))
+// SetParams sets the total set of epoching parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ParamsKey, k.cdc.MustMarshal(&params))
 }
 
-// QueueMsgForEpoch save the actions that need to be executed on next epoch
-func (k Keeper) QueueMsgForEpoch(ctx sdk.Context, epochNumber int64, msg sdk.Msg) {
+// GetNewActionID returns ID to be used for next queued message
+func (k Keeper) GetNewActionID(ctx sdk.Context) uint64 {
 	store := ctx.KVStore(k.storeKey)
 
-	bz, err := k.cdc.MarshalInterface(msg)
-	if err != nil {
-		panic(err)
+	id := uint64(types.DefaultEpochActionID)
+	if bz := store.Get(types.NextEpochActionIDKey); bz != nil {
+		id = sdk.BigEndianToUint64(bz)
 	}
 
-	actionID := k.GetNewActionID(ctx)
-	store.Set(ActionStoreKey(epochNumber, actionID), bz)
+	// increment next action ID
+	k.setNextActionID(ctx, id+1)
+
+	return id
 }
 
-// RestoreEpochAction restore the actions that need to be executed on next epoch
-func (k Keeper) RestoreEpochAction(ctx sdk.Context, epochNumber int64, action *codectypes.Any) {
+func (k Keeper) setNextActionID(ctx sdk.Context, id uint64) {
 	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextEpochActionIDKey, sdk.Uint64ToBigEndian(id))
+}
 
-	// reference from TestMarshalAny(t *testing.T)
-	bz, err := k.cdc.MarshalInterface(action)
+// QueueMsgForEpoch saves a message that needs to be executed at the end of the
+// given epoch and returns its action ID.
+func (k Keeper) QueueMsgForEpoch(ctx sdk.Context, epochNumber uint64, msg sdk.Msg) (uint64, error) {
+	queued, err := types.NewQueuedMessage(k.GetNewActionID(ctx), msg, ctx.BlockHeight(), ctx.BlockTime())
 	if err != nil {
-		panic(err)
+		return 0, err
 	}
 
-	actionID := k.GetNewActionID(ctx)
-	store.Set(ActionStoreKey(epochNumber, actionID), bz)
+	k.SetEpochMsg(ctx, epochNumber, queued)
+	return queued.Id, nil
+}
+
+// SetEpochMsg stores a queued message in the queue of the given epoch.
+func (k Keeper) SetEpochMsg(ctx sdk.Context, epochNumber uint64, queued types.QueuedMessage) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ActionStoreKey(epochNumber, queued.Id), k.cdc.MustMarshal(&queued))
 }
 
-// GetEpochMsg gets a msg by ID
-func (k Keeper) GetEpochMsg(ctx sdk.Context, epochNumber int64, actionID uint64) sdk.Msg {
+// GetEpochMsg gets a queued message by its epoch number and action ID
+func (k Keeper) GetEpochMsg(ctx sdk.Context, epochNumber uint64, actionID uint64) (types.QueuedMessage, bool) {
 	store := ctx.KVStore(k.storeKey)
 
-	bz := store.Get(ActionStoreKey(epochNumber, actionID))
+	bz := store.Get(types.ActionStoreKey(epochNumber, actionID))
 	if bz == nil {
-		return nil
+		return types.QueuedMessage{}, false
 	}
 
-	var action sdk.Msg
-	k.cdc.UnmarshalInterface(bz, &action)
-
-	return action
+	return k.mustUnmarshalQueuedMsg(bz), true
 }
 
-// GetEpochActions get all actions
-func (k Keeper) GetEpochActions(ctx sdk.Context) []sdk.Msg {
-	actions := []sdk.Msg{}
-	iterator := k.GetEpochActionsIterator(ctx)
+// IterateEpochMsgs iterates over the messages queued in the given epoch, in
+// the order they were queued, until cb returns true.
+func (k Keeper) IterateEpochMsgs(ctx sdk.Context, epochNumber uint64, cb func(queued types.QueuedMessage) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.EpochActionQueueKey(epochNumber))
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
-		var action sdk.Msg
-		bz := iterator.Value()
-		k.cdc.UnmarshalInterface(bz, &action)
-		actions = append(actions, action)
+		if cb(k.mustUnmarshalQueuedMsg(iterator.Value())) {
+			break
+		}
 	}
-
-	return actions
 }
 
-// GetEpochActionsIterator returns iterator for EpochActions
-func (k Keeper) GetEpochActionsIterator(ctx sdk.Context) db.Iterator {
-	return sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), EpochActionQueuePrefix)
+// GetEpochMsgs returns the messages queued in the given epoch.
+func (k Keeper) GetEpochMsgs(ctx sdk.Context, epochNumber uint64) []types.QueuedMessage {
+	var msgs []types.QueuedMessage
+	k.IterateEpochMsgs(ctx, epochNumber, func(queued types.QueuedMessage) bool {
+		msgs = append(msgs, queued)
+		return false
+	})
+
+	return msgs
 }
 
-// DequeueEpochActions dequeue all the actions store on epoch
-func (k Keeper) DequeueEpochActions(ctx sdk.Context) {
-	store := ctx.KVStore(k.storeKey)
-	iterator := sdk.KVStorePrefixIterator(store, EpochActionQueuePrefix)
+// GetEpochActions gets the messages queued in all the epochs.
+func (k Keeper) GetEpochActions(ctx sdk.Context) []types.QueuedMessage {
+	var msgs []types.QueuedMessage
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.EpochActionQueuePrefix)
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
-		key := iterator.Key()
-		store.Delete(key)
+		msgs = append(msgs, k.mustUnmarshalQueuedMsg(iterator.Value()))
 	}
+
+	return msgs
 }
 
-// DeleteByKey delete item by key
-func (k Keeper) DeleteByKey(ctx sdk.Context, key []byte) {
+// DequeueEpochMsg deletes a queued message.
+func (k Keeper) DequeueEpochMsg(ctx sdk.Context, epochNumber uint64, actionID uint64) {
 	store := ctx.KVStore(k.storeKey)
-	store.Delete(key)
+	store.Delete(types.ActionStoreKey(epochNumber, actionID))
 }
 
-// GetEpochActionByIterator get action by iterator
-func (k Keeper) GetEpochActionByIterator(iterator db.Iterator) sdk.Msg {
-	bz := iterator.Value()
+// DequeueEpochActions deletes all the messages queued in the given epoch.
+func (k Keeper) DequeueEpochActions(ctx sdk.Context, epochNumber uint64) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.EpochActionQueueKey(epochNumber))
+	defer iterator.Close()
 
-	var action sdk.Msg
-	k.cdc.UnmarshalInterface(bz, &action)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
 
-	return action
+	for _, key := range keys {
+		store.Delete(key)
+	}
 }
 
 // SetEpochNumber set epoch number
-func (k Keeper) SetEpochNumber(ctx sdk.Context, epochNumber int64) {
+func (k Keeper) SetEpochNumber(ctx sdk.Context, epochNumber uint64) {
 	store := ctx.KVStore(k.storeKey)
-	store.Set(EpochNumberID, sdk.Uint64ToBigEndian(uint64(epochNumber)))
+	store.Set(types.EpochNumberKey, sdk.Uint64ToBigEndian(epochNumber))
 }
 
 // GetEpochNumber fetches epoch number
-func (k Keeper) GetEpochNumber(ctx sdk.Context) int64 {
+func (k Keeper) GetEpochNumber(ctx sdk.Context) uint64 {
 	store := ctx.KVStore(k.storeKey)
 
-	bz := store.Get(EpochNumberID)
+	bz := store.Get(types.EpochNumberKey)
 	if bz == nil {
-		return DefaultEpochNumber
+		return types.DefaultEpochNumber
 	}
 
-	return int64(sdk.BigEndianToUint64(bz))
+	return sdk.BigEndianToUint64(bz)
 }
 
 // IncreaseEpochNumber increases epoch number
@@ -177,16 +209,19 @@ func (k Keeper) IncreaseEpochNumber(ctx sdk.Context) {
 	k.SetEpochNumber(ctx, epochNumber+1)
 }
 
-// GetNextEpochHeight returns next epoch block height
-func (k Keeper) GetNextEpochHeight(ctx sdk.Context, epochInterval int64) int64 {
-	currentHeight := ctx.BlockHeight()
-	return currentHeight + (epochInterval - currentHeight%epochInterval)
+// GetEpochEndHeight returns the height of the last block of the current
+// epoch, at the end of which the queued messages are executed.
+func (k Keeper) GetEpochEndHeight(ctx sdk.Context) int64 {
+	return types.EpochEndHeight(ctx.BlockHeight(), k.GetParams(ctx).EpochLength)
 }
 
-// GetNextEpochTime returns estimated next epoch time
-func (k Keeper) GetNextEpochTime(ctx sdk.Context, epochInterval int64) time.Time {
-	currentTime := ctx.BlockTime()
-	currentHeight := ctx.BlockHeight()
+// IsEpochEnd returns true if the current block is the last one of its epoch.
+func (k Keeper) IsEpochEnd(ctx sdk.Context) bool {
+	return k.GetEpochEndHeight(ctx) == ctx.BlockHeight()
+}
 
-	return currentTime.Add(k.commitTimeout * time.Duration(k.GetNextEpochHeight(ctx, epochInterval)-currentHeight))
+func (k Keeper) mustUnmarshalQueuedMsg(bz []byte) types.QueuedMessage {
+	var queued types.QueuedMessage
+	k.cdc.MustUnmarshal(bz, &queued)
+	return queued
 }
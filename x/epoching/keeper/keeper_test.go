@@ -0,0 +1,455 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
+	"github.com/cosmos/cosmos-sdk/x/epoching/keeper"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app       *simapp.SimApp
+	ctx       sdk.Context
+	msgServer types.MsgServer
+	addrs     []sdk.AccAddress
+	valAddr   sdk.ValAddress
+	bondDenom string
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.app = simapp.Setup(s.T(), false)
+	s.ctx = s.app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+	s.msgServer = keeper.NewMsgServerImpl(s.app.EpochingKeeper)
+	s.addrs = simapp.AddTestAddrsIncremental(s.app, s.ctx, 2, sdk.NewInt(1000000))
+	s.valAddr = s.app.StakingKeeper.GetAllValidators(s.ctx)[0].GetOperator()
+	s.bondDenom = s.app.StakingKeeper.BondDenom(s.ctx)
+
+	s.app.EpochingKeeper.SetParams(s.ctx, types.NewParams(10))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// endEpoch moves the context to the last block of the epoch and runs the
+// epoching EndBlocker.
+func (s *KeeperTestSuite) endEpoch() {
+	s.ctx = s.ctx.WithBlockHeight(s.app.EpochingKeeper.GetEpochEndHeight(s.ctx)).WithEventManager(sdk.NewEventManager())
+	epoching.EndBlocker(s.ctx, s.app.EpochingKeeper)
+}
+
+func (s *KeeperTestSuite) moduleBalance() sdk.Coins {
+	return s.app.BankKeeper.GetAllBalances(s.ctx, s.app.AccountKeeper.GetModuleAddress(types.ModuleName))
+}
+
+func (s *KeeperTestSuite) checkInvariant() {
+	msg, broken := keeper.EscrowedFundsInvariant(s.app.EpochingKeeper)(s.ctx)
+	s.Require().False(broken, msg)
+}
+
+func (s *KeeperTestSuite) TestGetNewActionID() {
+	k := s.app.EpochingKeeper
+	s.Require().Equal(uint64(1), k.GetNewActionID(s.ctx))
+	s.Require().Equal(uint64(2), k.GetNewActionID(s.ctx))
+	s.Require().Equal(uint64(3), k.GetNewActionID(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestEpochEndHeight() {
+	k := s.app.EpochingKeeper
+	for height, expected := range map[int64]int64{1: 10, 9: 10, 10: 10, 11: 20} {
+		ctx := s.ctx.WithBlockHeight(height)
+		s.Require().Equal(expected, k.GetEpochEndHeight(ctx))
+		s.Require().Equal(expected == height, k.IsEpochEnd(ctx))
+	}
+}
+
+func (s *KeeperTestSuite) TestWrappedDelegate() {
+	k := s.app.EpochingKeeper
+	amount := sdk.NewInt64Coin(s.bondDenom, 1000)
+	before := s.app.BankKeeper.GetBalance(s.ctx, s.addrs[0], s.bondDenom)
+
+	res, err := s.msgServer.WrappedDelegate(sdk.WrapSDKContext(s.ctx), types.NewMsgWrappedDelegate(
+		stakingtypes.NewMsgDelegate(s.addrs[0], s.valAddr, amount),
+	))
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(0), res.EpochNumber)
+
+	// the tokens are escrowed, the delegation is not executed yet
+	s.Require().Equal(before.Sub(amount), s.app.BankKeeper.GetBalance(s.ctx, s.addrs[0], s.bondDenom))
+	s.Require().Equal(sdk.NewCoins(amount), s.moduleBalance())
+	_, found := s.app.StakingKeeper.GetDelegation(s.ctx, s.addrs[0], s.valAddr)
+	s.Require().False(found)
+	s.Require().Len(k.GetEpochMsgs(s.ctx, 0), 1)
+	s.checkInvariant()
+
+	// nothing happens before the end of the epoch
+	epoching.EndBlocker(s.ctx, k)
+	s.Require().Len(k.GetEpochMsgs(s.ctx, 0), 1)
+
+	s.endEpoch()
+
+	delegation, found := s.app.StakingKeeper.GetDelegation(s.ctx, s.addrs[0], s.valAddr)
+	s.Require().True(found)
+	validator, _ := s.app.StakingKeeper.GetValidator(s.ctx, s.valAddr)
+	s.Require().Equal(amount.Amount, validator.TokensFromShares(delegation.Shares).TruncateInt())
+	s.Require().True(s.moduleBalance().IsZero())
+	s.Require().Empty(k.GetEpochActions(s.ctx))
+	s.Require().Equal(uint64(1), k.GetEpochNumber(s.ctx))
+	s.checkInvariant()
+
+	var executed, ended bool
+	for _, event := range s.ctx.EventManager().Events() {
+		switch event.Type {
+		case types.EventTypeExecuteMsg:
+			executed = true
+			s.Require().Contains(event.Attributes, sdk.NewAttribute(types.AttributeKeySuccess, "true").ToKVPair())
+		case types.EventTypeEpochEnd:
+			ended = true
+		}
+	}
+	s.Require().True(executed)
+	s.Require().True(ended)
+}
+
+func (s *KeeperTestSuite) TestWrappedDelegateVestingAccount() {
+	amount := sdk.NewInt64Coin(s.bondDenom, 1000)
+	addr := sdk.AccAddress("vesting_account_____")
+	baseAcc := s.app.AccountKeeper.NewAccountWithAddress(s.ctx, addr).(*authtypes.BaseAccount)
+	s.app.AccountKeeper.SetAccount(s.ctx, vestingtypes.NewDelayedVestingAccount(baseAcc, sdk.NewCoins(amount), s.ctx.BlockTime().Unix()+1000))
+	s.Require().NoError(banktestutil.FundAccount(s.app.BankKeeper, s.ctx, addr, sdk.NewCoins(amount)))
+	s.Require().True(s.app.BankKeeper.SpendableCoins(s.ctx, addr).IsZero())
+
+	// the locked tokens are escrowed as delegated tokens
+	_, err := s.msgServer.WrappedDelegate(sdk.WrapSDKContext(s.ctx), types.NewMsgWrappedDelegate(
+		stakingtypes.NewMsgDelegate(addr, s.valAddr, amount),
+	))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(amount), s.moduleBalance())
+	acc := s.app.AccountKeeper.GetAccount(s.ctx, addr).(*vestingtypes.DelayedVestingAccount)
+	s.Require().Equal(sdk.NewCoins(amount), acc.DelegatedVesting)
+	s.checkInvariant()
+
+	s.endEpoch()
+
+	delegation, found := s.app.StakingKeeper.GetDelegation(s.ctx, addr, s.valAddr)
+	s.Require().True(found)
+	validator, _ := s.app.StakingKeeper.GetValidator(s.ctx, s.valAddr)
+	s.Require().Equal(amount.Amount, validator.TokensFromShares(delegation.Shares).TruncateInt())
+	acc = s.app.AccountKeeper.GetAccount(s.ctx, addr).(*vestingtypes.DelayedVestingAccount)
+	s.Require().Equal(sdk.NewCoins(amount), acc.DelegatedVesting)
+	s.Require().True(s.moduleBalance().IsZero())
+	s.checkInvariant()
+}
+
+func (s *KeeperTestSuite) TestQueuedMsgsAreExecutedInOrder() {
+	goCtx := sdk.WrapSDKContext(s.ctx)
+
+	_, err := s.msgServer.WrappedDelegate(goCtx, types.NewMsgWrappedDelegate(
+		stakingtypes.NewMsgDelegate(s.addrs[0], s.valAddr, sdk.NewInt64Coin(s.bondDenom, 1000)),
+	))
+	s.Require().NoError(err)
+
+	// the undelegation cannot be queued before the delegation exists
+	_, err = s.msgServer.WrappedUndelegate(goCtx, types.NewMsgWrappedUndelegate(
+		stakingtypes.NewMsgUndelegate(s.addrs[0], s.valAddr, sdk.NewInt64Coin(s.bondDenom, 1000)),
+	))
+	s.Require().ErrorIs(err, stakingtypes.ErrNoDelegation)
+
+	s.endEpoch()
+
+	// the undelegation of more than the first delegation only succeeds once
+	// the second delegation has been executed
+	_, err = s.msgServer.WrappedDelegate(goCtx, types.NewMsgWrappedDelegate(
+		stakingtypes.NewMsgDelegate(s.addrs[0], s.valAddr, sdk.NewInt64Coin(s.bondDenom, 500)),
+	))
+	s.Require().NoError(err)
+	_, err = s.msgServer.WrappedUndelegate(goCtx, types.NewMsgWrappedUndelegate(
+		stakingtypes.NewMsgUndelegate(s.addrs[0], s.valAddr, sdk.NewInt64Coin(s.bondDenom, 1500)),
+	))
+	s.Require().NoError(err)
+
+	msgs := s.app.EpochingKeeper.GetEpochMsgs(s.ctx, 1)
+	s.Require().Len(msgs, 2)
+	s.Require().Less(msgs[0].Id, msgs[1].Id)
+
+	s.endEpoch()
+
+	_, found := s.app.StakingKeeper.GetDelegation(s.ctx, s.addrs[0], s.valAddr)
+	s.Require().False(found)
+	_, found = s.app.StakingKeeper.GetUnbondingDelegation(s.ctx, s.addrs[0], s.valAddr)
+	s.Require().True(found)
+	s.checkInvariant()
+}
+
+func (s *KeeperTestSuite) TestFailedQueuedMsgIsRolledBack() {
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	amount := sdk.NewInt64Coin(s.bondDenom, 1000)
+	before := s.app.BankKeeper.GetBalance(s.ctx, s.addrs[0], s.bondDenom)
+
+	msg := stakingtypes.NewMsgDelegate(s.addrs[0], s.valAddr, amount)
+	_, err := s.msgServer.WrappedDelegate(goCtx, types.NewMsgWrappedDelegate(msg))
+	s.Require().NoError(err)
+
+	// the delegations are disabled before the end of the epoch, hence the
+	// queued delegation fails
+	s.app.CircuitKeeper.DisableMsg(s.ctx, sdk.MsgTypeURL(msg))
+
+	s.endEpoch()
+
+	_, found := s.app.StakingKeeper.GetDelegation(s.ctx, s.addrs[0], s.valAddr)
+	s.Require().False(found)
+	s.Require().Equal(before, s.app.BankKeeper.GetBalance(s.ctx, s.addrs[0], s.bondDenom))
+	s.Require().True(s.moduleBalance().IsZero())
+	s.Require().Empty(s.app.EpochingKeeper.GetEpochActions(s.ctx))
+	s.checkInvariant()
+
+	var failed bool
+	for _, event := range s.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeExecuteMsg {
+			failed = true
+			s.Require().Contains(event.Attributes, sdk.NewAttribute(types.AttributeKeySuccess, "false").ToKVPair())
+		}
+	}
+	s.Require().True(failed)
+}
+
+func (s *KeeperTestSuite) TestWrappedMsgsValidation() {
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	unknownVal := sdk.ValAddress(s.addrs[1])
+
+	_, err := s.msgServer.WrappedDelegate(goCtx, types.NewMsgWrappedDelegate(
+		stakingtypes.NewMsgDelegate(s.addrs[0], unknownVal, sdk.NewInt64Coin(s.bondDenom, 1000)),
+	))
+	s.Require().ErrorIs(err, stakingtypes.ErrNoValidatorFound)
+
+	_, err = s.msgServer.WrappedDelegate(goCtx, types.NewMsgWrappedDelegate(
+		stakingtypes.NewMsgDelegate(s.addrs[0], s.valAddr, sdk.NewInt64Coin("foo", 1000)),
+	))
+	s.Require().Error(err)
+
+	_, err = s.msgServer.WrappedBeginRedelegate(goCtx, types.NewMsgWrappedBeginRedelegate(
+		stakingtypes.NewMsgBeginRedelegate(s.addrs[0], s.valAddr, unknownVal, sdk.NewInt64Coin(s.bondDenom, 1000)),
+	))
+	s.Require().ErrorIs(err, stakingtypes.ErrNoDelegation)
+
+	s.Require().Empty(s.app.EpochingKeeper.GetEpochActions(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestStakingMsgsCircuitBreaker() {
+	// simapp only rejects the raw staking messages if opted in
+	s.app.MsgServiceRouter().SetCircuit(epoching.NewStakingMsgsCircuitBreaker(s.app.CircuitKeeper))
+
+	handle := func(msg sdk.Msg) error {
+		cacheCtx, _ := s.ctx.CacheContext()
+		_, err := s.app.MsgServiceRouter().Handler(msg)(cacheCtx, msg)
+		return err
+	}
+
+	amount := sdk.NewInt64Coin(s.bondDenom, 1000)
+	delegate := stakingtypes.NewMsgDelegate(s.addrs[0], s.valAddr, amount)
+	exec := authz.NewMsgExec(s.addrs[0], []sdk.Msg{delegate})
+
+	// the raw staking messages are rejected by the router, even nested
+	s.Require().ErrorIs(handle(delegate), types.ErrUnqueuedStakingMsg)
+	s.Require().ErrorIs(handle(&exec), types.ErrUnqueuedStakingMsg)
+	s.Require().ErrorIs(handle(
+		stakingtypes.NewMsgUndelegate(s.addrs[0], s.valAddr, amount),
+	), types.ErrUnqueuedStakingMsg)
+	s.Require().ErrorIs(handle(
+		stakingtypes.NewMsgBeginRedelegate(s.addrs[0], s.valAddr, s.valAddr, amount),
+	), types.ErrUnqueuedStakingMsg)
+
+	// every staking message changing the voting power is rejected, unless
+	// executed from the queue or in a genesis transaction
+	cb := epoching.NewStakingMsgsCircuitBreaker(nil)
+	for _, msg := range []sdk.Msg{
+		&stakingtypes.MsgCreateValidator{}, &stakingtypes.MsgCancelUnbondingDelegation{},
+		&stakingtypes.MsgTokenizeShares{}, &stakingtypes.MsgRedeemTokensForShares{}, &stakingtypes.MsgValidatorBond{},
+	} {
+		allowed, err := cb.IsAllowed(s.ctx, sdk.MsgTypeURL(msg))
+		s.Require().ErrorIs(err, types.ErrUnqueuedStakingMsg)
+		s.Require().False(allowed)
+	}
+	for _, ctx := range []sdk.Context{types.WithQueuedMsgExecution(s.ctx), s.ctx.WithBlockHeight(0)} {
+		allowed, err := cb.IsAllowed(ctx, sdk.MsgTypeURL(delegate))
+		s.Require().NoError(err)
+		s.Require().True(allowed)
+	}
+
+	// the other messages are checked by the wrapped circuit breaker
+	allowed, err := cb.IsAllowed(s.ctx, sdk.MsgTypeURL(&banktypes.MsgSend{}))
+	s.Require().NoError(err)
+	s.Require().True(allowed)
+	allowed, err = cb.IsAllowed(s.ctx, sdk.MsgTypeURL(&stakingtypes.MsgEditValidator{}))
+	s.Require().NoError(err)
+	s.Require().True(allowed)
+
+	// the queued message is still executed at the end of the epoch
+	_, err = s.msgServer.WrappedDelegate(sdk.WrapSDKContext(s.ctx), types.NewMsgWrappedDelegate(delegate))
+	s.Require().NoError(err)
+	s.endEpoch()
+
+	_, found := s.app.StakingKeeper.GetDelegation(s.ctx, s.addrs[0], s.valAddr)
+	s.Require().True(found)
+}
+
+func (s *KeeperTestSuite) TestRejectStakingMsgsDecorator() {
+	decorator := epoching.NewRejectStakingMsgsDecorator()
+	txBuilder := simapp.MakeTestEncodingConfig().TxConfig.NewTxBuilder()
+	anteHandle := func(ctx sdk.Context, msgs ...sdk.Msg) error {
+		s.Require().NoError(txBuilder.SetMsgs(msgs...))
+		_, err := decorator.AnteHandle(ctx, txBuilder.GetTx(), false, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+			return ctx, nil
+		})
+		return err
+	}
+
+	amount := sdk.NewInt64Coin(s.bondDenom, 1000)
+	delegate := stakingtypes.NewMsgDelegate(s.addrs[0], s.valAddr, amount)
+	exec := authz.NewMsgExec(s.addrs[1], []sdk.Msg{delegate})
+	validatorBond := stakingtypes.NewMsgValidatorBond(s.addrs[0], s.valAddr)
+
+	// the raw staking messages are rejected at transaction entry, even nested
+	s.Require().ErrorIs(anteHandle(s.ctx, delegate), types.ErrUnqueuedStakingMsg)
+	s.Require().ErrorIs(anteHandle(s.ctx, &exec), types.ErrUnqueuedStakingMsg)
+	s.Require().ErrorIs(anteHandle(s.ctx, validatorBond), types.ErrUnqueuedStakingMsg)
+
+	// but not the wrapped ones, nor the genesis transactions
+	s.Require().NoError(anteHandle(s.ctx, types.NewMsgWrappedDelegate(delegate), types.NewMsgWrappedValidatorBond(validatorBond)))
+	s.Require().NoError(anteHandle(s.ctx.WithBlockHeight(0), delegate))
+}
+
+func (s *KeeperTestSuite) TestWrappedCreateValidator() {
+	amount := sdk.NewInt64Coin(s.bondDenom, 1000)
+	before := s.app.BankKeeper.GetBalance(s.ctx, s.addrs[0], s.bondDenom)
+	valAddr := sdk.ValAddress(s.addrs[0])
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		valAddr, ed25519.GenPrivKey().PubKey(), amount, stakingtypes.Description{Moniker: "queued"},
+		stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt(),
+	)
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.WrappedCreateValidator(sdk.WrapSDKContext(s.ctx), types.NewMsgWrappedCreateValidator(msg))
+	s.Require().NoError(err)
+
+	// the self-delegation is escrowed, the validator is not created yet
+	s.Require().Equal(before.Sub(amount), s.app.BankKeeper.GetBalance(s.ctx, s.addrs[0], s.bondDenom))
+	s.Require().Equal(sdk.NewCoins(amount), s.app.EpochingKeeper.GetEscrowedCoins(s.ctx))
+	s.Require().Equal(sdk.NewCoins(amount), s.moduleBalance())
+	_, found := s.app.StakingKeeper.GetValidator(s.ctx, valAddr)
+	s.Require().False(found)
+	s.checkInvariant()
+
+	// an existing validator cannot be created again
+	existing := *msg
+	existing.ValidatorAddress = s.valAddr.String()
+	_, err = s.msgServer.WrappedCreateValidator(sdk.WrapSDKContext(s.ctx), types.NewMsgWrappedCreateValidator(&existing))
+	s.Require().ErrorIs(err, stakingtypes.ErrValidatorOwnerExists)
+
+	// the escrow is released at the end of the epoch, whatever the outcome
+	s.endEpoch()
+	s.Require().True(s.moduleBalance().IsZero())
+	s.checkInvariant()
+}
+
+func (s *KeeperTestSuite) TestWrappedValidatorBond() {
+	delegation := s.app.StakingKeeper.GetValidatorDelegations(s.ctx, s.valAddr)[0]
+	delAddr := delegation.GetDelegatorAddr()
+	s.Require().False(delegation.ValidatorBond)
+
+	_, err := s.msgServer.WrappedValidatorBond(sdk.WrapSDKContext(s.ctx), types.NewMsgWrappedValidatorBond(
+		stakingtypes.NewMsgValidatorBond(s.addrs[0], s.valAddr),
+	))
+	s.Require().ErrorIs(err, stakingtypes.ErrNoDelegation)
+
+	_, err = s.msgServer.WrappedValidatorBond(sdk.WrapSDKContext(s.ctx), types.NewMsgWrappedValidatorBond(
+		stakingtypes.NewMsgValidatorBond(delAddr, s.valAddr),
+	))
+	s.Require().NoError(err)
+
+	// the delegation is only flagged at the end of the epoch
+	delegation, _ = s.app.StakingKeeper.GetDelegation(s.ctx, delAddr, s.valAddr)
+	s.Require().False(delegation.ValidatorBond)
+
+	s.endEpoch()
+
+	delegation, _ = s.app.StakingKeeper.GetDelegation(s.ctx, delAddr, s.valAddr)
+	s.Require().True(delegation.ValidatorBond)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	k := s.app.EpochingKeeper
+	k.SetEpochNumber(s.ctx, 3)
+	_, err := s.msgServer.WrappedDelegate(sdk.WrapSDKContext(s.ctx), types.NewMsgWrappedDelegate(
+		stakingtypes.NewMsgDelegate(s.addrs[0], s.valAddr, sdk.NewInt64Coin(s.bondDenom, 1000)),
+	))
+	s.Require().NoError(err)
+
+	genState := k.ExportGenesis(s.ctx)
+	s.Require().NoError(types.ValidateGenesis(*genState))
+	s.Require().Len(genState.QueuedMsgs, 1)
+
+	k.DequeueEpochActions(s.ctx, 3)
+	k.InitGenesis(s.ctx, genState)
+	s.Require().Equal(genState, k.ExportGenesis(s.ctx))
+	s.Require().Equal(uint64(2), k.GetNewActionID(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	k := s.app.EpochingKeeper
+	params := types.NewParams(20)
+
+	testCases := []struct {
+		name      string
+		request   *types.MsgUpdateParams
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name:      "invalid authority",
+			request:   types.NewMsgUpdateParams("invalid", params),
+			expErr:    true,
+			expErrMsg: "expected gov account as only signer for proposal message",
+		},
+		{
+			name:      "invalid params",
+			request:   types.NewMsgUpdateParams(k.GetAuthority(), types.NewParams(0)),
+			expErr:    true,
+			expErrMsg: "epoch length must be positive",
+		},
+		{
+			name:    "all good",
+			request: types.NewMsgUpdateParams(k.GetAuthority(), params),
+			expErr:  false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.UpdateParams(sdk.WrapSDKContext(s.ctx), tc.request)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(params, k.GetParams(s.ctx))
+			}
+		})
+	}
+}
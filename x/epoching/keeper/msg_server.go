@@ -0,0 +1,288 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the epoching MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// WrappedDelegate escrows the delegated tokens and queues the delegation until
+// the end of the epoch.
+func (k msgServer) WrappedDelegate(goCtx context.Context, msg *types.MsgWrappedDelegate) (*types.MsgWrappedDelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.Msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.validateValidator(ctx, msg.Msg.ValidatorAddress); err != nil {
+		return nil, err
+	}
+
+	if err := k.validateBondDenom(ctx, msg.Msg.Amount); err != nil {
+		return nil, err
+	}
+
+	// the tokens are escrowed right away so that they cannot be spent before
+	// the delegation is executed. They are delegated to the module account, as
+	// the staking pools are, so that the locked tokens of a vesting account
+	// can be escrowed too.
+	if err := k.bankKeeper.DelegateCoinsFromAccountToModule(ctx, delegatorAddress, types.ModuleName, sdk.NewCoins(msg.Msg.Amount)); err != nil {
+		return nil, err
+	}
+
+	id, epochNumber, err := k.queueMsg(ctx, msg.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWrappedDelegateResponse{Id: id, EpochNumber: epochNumber}, nil
+}
+
+// WrappedUndelegate queues the undelegation until the end of the epoch.
+func (k msgServer) WrappedUndelegate(goCtx context.Context, msg *types.MsgWrappedUndelegate) (*types.MsgWrappedUndelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateDelegation(ctx, msg.Msg.DelegatorAddress, msg.Msg.ValidatorAddress); err != nil {
+		return nil, err
+	}
+
+	if err := k.validateBondDenom(ctx, msg.Msg.Amount); err != nil {
+		return nil, err
+	}
+
+	id, epochNumber, err := k.queueMsg(ctx, msg.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWrappedUndelegateResponse{Id: id, EpochNumber: epochNumber}, nil
+}
+
+// WrappedBeginRedelegate queues the redelegation until the end of the epoch.
+func (k msgServer) WrappedBeginRedelegate(goCtx context.Context, msg *types.MsgWrappedBeginRedelegate) (*types.MsgWrappedBeginRedelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateDelegation(ctx, msg.Msg.DelegatorAddress, msg.Msg.ValidatorSrcAddress); err != nil {
+		return nil, err
+	}
+
+	if err := k.validateValidator(ctx, msg.Msg.ValidatorDstAddress); err != nil {
+		return nil, err
+	}
+
+	if err := k.validateBondDenom(ctx, msg.Msg.Amount); err != nil {
+		return nil, err
+	}
+
+	id, epochNumber, err := k.queueMsg(ctx, msg.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWrappedBeginRedelegateResponse{Id: id, EpochNumber: epochNumber}, nil
+}
+
+// WrappedCreateValidator escrows the self-delegation and queues the creation
+// of the validator until the end of the epoch.
+func (k msgServer) WrappedCreateValidator(goCtx context.Context, msg *types.MsgWrappedCreateValidator) (*types.MsgWrappedCreateValidatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.Msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.Msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, found := k.stakingKeeper.GetValidator(ctx, valAddr); found {
+		return nil, stakingtypes.ErrValidatorOwnerExists
+	}
+
+	if err := k.validateBondDenom(ctx, msg.Msg.Value); err != nil {
+		return nil, err
+	}
+
+	// the self-delegation is escrowed like the tokens of a queued delegation
+	if err := k.bankKeeper.DelegateCoinsFromAccountToModule(ctx, delegatorAddress, types.ModuleName, sdk.NewCoins(msg.Msg.Value)); err != nil {
+		return nil, err
+	}
+
+	id, epochNumber, err := k.queueMsg(ctx, msg.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWrappedCreateValidatorResponse{Id: id, EpochNumber: epochNumber}, nil
+}
+
+// WrappedCancelUnbondingDelegation queues the cancellation of the unbonding
+// delegation until the end of the epoch.
+func (k msgServer) WrappedCancelUnbondingDelegation(goCtx context.Context, msg *types.MsgWrappedCancelUnbondingDelegation) (*types.MsgWrappedCancelUnbondingDelegationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateValidator(ctx, msg.Msg.ValidatorAddress); err != nil {
+		return nil, err
+	}
+
+	if err := k.validateBondDenom(ctx, msg.Msg.Amount); err != nil {
+		return nil, err
+	}
+
+	id, epochNumber, err := k.queueMsg(ctx, msg.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWrappedCancelUnbondingDelegationResponse{Id: id, EpochNumber: epochNumber}, nil
+}
+
+// WrappedTokenizeShares queues the tokenization of the delegation until the
+// end of the epoch.
+func (k msgServer) WrappedTokenizeShares(goCtx context.Context, msg *types.MsgWrappedTokenizeShares) (*types.MsgWrappedTokenizeSharesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateDelegation(ctx, msg.Msg.DelegatorAddress, msg.Msg.ValidatorAddress); err != nil {
+		return nil, err
+	}
+
+	if err := k.validateBondDenom(ctx, msg.Msg.Amount); err != nil {
+		return nil, err
+	}
+
+	id, epochNumber, err := k.queueMsg(ctx, msg.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWrappedTokenizeSharesResponse{Id: id, EpochNumber: epochNumber}, nil
+}
+
+// WrappedRedeemTokensForShares queues the redemption of the share tokens until
+// the end of the epoch. The share tokens are only checked when the message is
+// executed.
+func (k msgServer) WrappedRedeemTokensForShares(goCtx context.Context, msg *types.MsgWrappedRedeemTokensForShares) (*types.MsgWrappedRedeemTokensForSharesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	id, epochNumber, err := k.queueMsg(ctx, msg.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWrappedRedeemTokensForSharesResponse{Id: id, EpochNumber: epochNumber}, nil
+}
+
+// WrappedValidatorBond queues the validator bond of the delegation until the
+// end of the epoch.
+func (k msgServer) WrappedValidatorBond(goCtx context.Context, msg *types.MsgWrappedValidatorBond) (*types.MsgWrappedValidatorBondResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateDelegation(ctx, msg.Msg.DelegatorAddress, msg.Msg.ValidatorAddress); err != nil {
+		return nil, err
+	}
+
+	id, epochNumber, err := k.queueMsg(ctx, msg.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWrappedValidatorBondResponse{Id: id, EpochNumber: epochNumber}, nil
+}
+
+// queueMsg queues msg in the current epoch and emits the corresponding event.
+func (k msgServer) queueMsg(ctx sdk.Context, msg sdk.Msg) (uint64, uint64, error) {
+	epochNumber := k.GetEpochNumber(ctx)
+	id, err := k.QueueMsgForEpoch(ctx, epochNumber, msg)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeQueueMsg,
+			sdk.NewAttribute(types.AttributeKeyMsgID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyMsgType, sdk.MsgTypeURL(msg)),
+			sdk.NewAttribute(types.AttributeKeyEpochNumber, strconv.FormatUint(epochNumber, 10)),
+		),
+	)
+
+	return id, epochNumber, nil
+}
+
+func (k msgServer) validateValidator(ctx sdk.Context, validatorAddress string) error {
+	valAddr, err := sdk.ValAddressFromBech32(validatorAddress)
+	if err != nil {
+		return err
+	}
+
+	if _, found := k.stakingKeeper.GetValidator(ctx, valAddr); !found {
+		return stakingtypes.ErrNoValidatorFound
+	}
+
+	return nil
+}
+
+func (k msgServer) validateDelegation(ctx sdk.Context, delegatorAddress, validatorAddress string) error {
+	delAddr, err := sdk.AccAddressFromBech32(delegatorAddress)
+	if err != nil {
+		return err
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(validatorAddress)
+	if err != nil {
+		return err
+	}
+
+	if _, found := k.stakingKeeper.GetDelegation(ctx, delAddr, valAddr); !found {
+		return stakingtypes.ErrNoDelegation
+	}
+
+	return nil
+}
+
+func (k msgServer) validateBondDenom(ctx sdk.Context, amount sdk.Coin) error {
+	if bondDenom := k.stakingKeeper.BondDenom(ctx); amount.Denom != bondDenom {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", amount.Denom, bondDenom,
+		)
+	}
+
+	return nil
+}
+
+// UpdateParams updates the params.
+func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != req.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", k.authority, req.Authority)
+	}
+
+	if err := req.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetParams(ctx, req.Params)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
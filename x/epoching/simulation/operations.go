@@ -0,0 +1,64 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// QueueOperations returns the weighted operations ops, sending their staking
+// messages through the epoch queue.
+func QueueOperations(ops []simtypes.WeightedOperation) []simtypes.WeightedOperation {
+	queued := make([]simtypes.WeightedOperation, len(ops))
+	for i, op := range ops {
+		queued[i] = simulation.NewWeightedOperation(op.Weight(), QueueOperation(op.Op()))
+	}
+
+	return queued
+}
+
+// QueueOperation returns an operation running op, but delivering its staking
+// messages wrapped in the epoching messages, so that they are queued until the
+// end of the epoch. The other messages are delivered unchanged.
+func QueueOperation(op simtypes.Operation) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		opMsg, futureOps, err := op(r, app, simulation.WithMsgWrapper(ctx, WrapStakingMsg), accs, chainID)
+		for i, futureOp := range futureOps {
+			futureOps[i].Op = QueueOperation(futureOp.Op)
+		}
+
+		return opMsg, futureOps, err
+	}
+}
+
+// WrapStakingMsg wraps msg in the epoching message queuing it, if msg is a
+// staking message which must go through the epoch queue.
+func WrapStakingMsg(msg sdk.Msg) sdk.Msg {
+	switch msg := msg.(type) {
+	case *stakingtypes.MsgCreateValidator:
+		return types.NewMsgWrappedCreateValidator(msg)
+	case *stakingtypes.MsgDelegate:
+		return types.NewMsgWrappedDelegate(msg)
+	case *stakingtypes.MsgUndelegate:
+		return types.NewMsgWrappedUndelegate(msg)
+	case *stakingtypes.MsgBeginRedelegate:
+		return types.NewMsgWrappedBeginRedelegate(msg)
+	case *stakingtypes.MsgCancelUnbondingDelegation:
+		return types.NewMsgWrappedCancelUnbondingDelegation(msg)
+	case *stakingtypes.MsgTokenizeShares:
+		return types.NewMsgWrappedTokenizeShares(msg)
+	case *stakingtypes.MsgRedeemTokensForShares:
+		return types.NewMsgWrappedRedeemTokensForShares(msg)
+	case *stakingtypes.MsgValidatorBond:
+		return types.NewMsgWrappedValidatorBond(msg)
+	default:
+		return msg
+	}
+}
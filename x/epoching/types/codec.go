@@ -0,0 +1,64 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	authzcodec "github.com/cosmos/cosmos-sdk/x/authz/codec"
+)
+
+// RegisterLegacyAminoCodec registers the necessary x/epoching interfaces and concrete types
+// on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	legacy.RegisterAminoMsg(cdc, &MsgWrappedDelegate{}, "cosmos-sdk/MsgWrappedDelegate")
+	legacy.RegisterAminoMsg(cdc, &MsgWrappedUndelegate{}, "cosmos-sdk/MsgWrappedUndelegate")
+	legacy.RegisterAminoMsg(cdc, &MsgWrappedBeginRedelegate{}, "cosmos-sdk/MsgWrappedBeginRedelegate")
+	legacy.RegisterAminoMsg(cdc, &MsgWrappedCreateValidator{}, "cosmos-sdk/MsgWrappedCreateValidator")
+	legacy.RegisterAminoMsg(cdc, &MsgWrappedCancelUnbondingDelegation{}, "cosmos-sdk/MsgWrappedCancelUnbonding")
+	legacy.RegisterAminoMsg(cdc, &MsgWrappedTokenizeShares{}, "cosmos-sdk/MsgWrappedTokenizeShares")
+	legacy.RegisterAminoMsg(cdc, &MsgWrappedRedeemTokensForShares{}, "cosmos-sdk/MsgWrappedRedeemTokens")
+	legacy.RegisterAminoMsg(cdc, &MsgWrappedValidatorBond{}, "cosmos-sdk/MsgWrappedValidatorBond")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/epoching/MsgUpdateParams")
+}
+
+// RegisterInterfaces registers the interfaces types with the interface registry
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgWrappedDelegate{},
+		&MsgWrappedUndelegate{},
+		&MsgWrappedBeginRedelegate{},
+		&MsgWrappedCreateValidator{},
+		&MsgWrappedCancelUnbondingDelegation{},
+		&MsgWrappedTokenizeShares{},
+		&MsgWrappedRedeemTokensForShares{},
+		&MsgWrappedValidatorBond{},
+		&MsgUpdateParams{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	amino = codec.NewLegacyAmino()
+
+	// ModuleCdc references the global x/epoching module codec. Note, the codec should
+	// ONLY be used in certain instances of tests and for JSON encoding as Amino is
+	// still used for that purpose.
+	//
+	// The actual codec used for serialization should be provided to x/epoching and
+	// defined at the application level.
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+	sdk.RegisterLegacyAminoCodec(amino)
+
+	// Register all Amino interfaces and concrete types on the authz Amino codec so that this can later be
+	// used to properly serialize MsgGrant and MsgExec instances
+	RegisterLegacyAminoCodec(authzcodec.Amino)
+}
@@ -0,0 +1,23 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// queuedMsgExecutionKey is the context key marking the execution of a queued
+// message at the end of an epoch.
+type queuedMsgExecutionKey struct{}
+
+// WithQueuedMsgExecution returns a copy of ctx marked as executing a queued
+// message. It is only set by the epoching EndBlocker.
+func WithQueuedMsgExecution(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(queuedMsgExecutionKey{}, true)
+}
+
+// IsQueuedMsgExecution returns true if ctx executes a queued message.
+func IsQueuedMsgExecution(ctx context.Context) bool {
+	executing, _ := ctx.Value(queuedMsgExecutionKey{}).(bool)
+	return executing
+}
@@ -0,0 +1,13 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/epoching module sentinel errors
+var (
+	ErrInvalidMsg         = sdkerrors.Register(ModuleName, 2, "invalid wrapped message")
+	ErrInvalidEpochLength = sdkerrors.Register(ModuleName, 3, "invalid epoch length")
+	ErrDuplicateQueuedMsg = sdkerrors.Register(ModuleName, 4, "duplicate queued message")
+	ErrUnqueuedStakingMsg = sdkerrors.Register(ModuleName, 5, "staking message not queued")
+)
@@ -0,0 +1,20 @@
+package types
+
+// epoching module event types
+const (
+	EventTypeQueueMsg     = "queue_msg"
+	EventTypeExecuteMsg   = "execute_queued_msg"
+	EventTypeEpochEnd     = "epoch_end"
+	EventTypeRefundEscrow = "refund_escrow"
+
+	AttributeKeyMsgID       = "msg_id"
+	AttributeKeyMsgType     = "msg_type"
+	AttributeKeyEpochNumber = "epoch_number"
+	AttributeKeyHeight      = "height"
+	AttributeKeySuccess     = "success"
+	AttributeKeyError       = "error"
+	AttributeKeyDelegator   = "delegator"
+	AttributeKeyAmount      = "amount"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+var _ codectypes.UnpackInterfacesMessage = GenesisState{}
+
+// NewGenesisState creates a new GenesisState object.
+func NewGenesisState(params Params, epochNumber uint64, queuedMsgs []QueuedMessage) *GenesisState {
+	return &GenesisState{
+		Params:      params,
+		EpochNumber: epochNumber,
+		QueuedMsgs:  queuedMsgs,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), DefaultEpochNumber, nil)
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	ids := make(map[uint64]struct{}, len(data.QueuedMsgs))
+	for _, queued := range data.QueuedMsgs {
+		if _, ok := ids[queued.Id]; ok {
+			return ErrDuplicateQueuedMsg.Wrapf("id %d", queued.Id)
+		}
+		ids[queued.Id] = struct{}{}
+
+		if queued.Id < DefaultEpochActionID {
+			return fmt.Errorf("invalid queued message id: %d", queued.Id)
+		}
+
+		msg, err := queued.GetSdkMsg()
+		if err != nil {
+			return err
+		}
+
+		if err := ValidateQueuedMsg(msg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (data GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, queued := range data.QueuedMsgs {
+		if err := queued.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
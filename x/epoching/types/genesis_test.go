@@ -0,0 +1,64 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	_, _, addr := testdata.KeyTestPubAddr()
+	valAddr := sdk.ValAddress(addr)
+	coin := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+
+	newQueued := func(id uint64, msg sdk.Msg) types.QueuedMessage {
+		queued, err := types.NewQueuedMessage(id, msg, 1, time.Now())
+		require.NoError(t, err)
+		return queued
+	}
+	delegate := stakingtypes.NewMsgDelegate(addr, valAddr, coin)
+
+	testCases := []struct {
+		name     string
+		genState *types.GenesisState
+		expErr   bool
+	}{
+		{"default", types.DefaultGenesisState(), false},
+		{"valid queued msgs", types.NewGenesisState(types.DefaultParams(), 2, []types.QueuedMessage{
+			newQueued(1, delegate),
+			newQueued(2, stakingtypes.NewMsgUndelegate(addr, valAddr, coin)),
+		}), false},
+		{"zero epoch length", types.NewGenesisState(types.NewParams(0), 0, nil), true},
+		{"duplicate id", types.NewGenesisState(types.DefaultParams(), 0, []types.QueuedMessage{
+			newQueued(1, delegate),
+			newQueued(1, delegate),
+		}), true},
+		{"zero id", types.NewGenesisState(types.DefaultParams(), 0, []types.QueuedMessage{
+			newQueued(0, delegate),
+		}), true},
+		{"invalid staking msg", types.NewGenesisState(types.DefaultParams(), 0, []types.QueuedMessage{
+			newQueued(1, stakingtypes.NewMsgDelegate(addr, valAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 0))),
+		}), true},
+		{"non staking msg", types.NewGenesisState(types.DefaultParams(), 0, []types.QueuedMessage{
+			newQueued(1, banktypes.NewMsgSend(addr, addr, sdk.NewCoins(coin))),
+		}), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateGenesis(*tc.genState)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
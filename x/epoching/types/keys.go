@@ -0,0 +1,56 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the epoching module
+	ModuleName = "epoching"
+
+	// StoreKey is the default store key for epoching
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for epoching
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the epoching store.
+	QuerierRoute = StoreKey
+)
+
+const (
+	// DefaultEpochActionID is the id of the first queued message.
+	DefaultEpochActionID = 1
+	// DefaultEpochNumber is the number of the first epoch.
+	DefaultEpochNumber = 0
+)
+
+// Keys for epoching store
+// Items are stored with the following key: values
+//
+// - 0x01: Params
+//
+// - 0x11: nextActionID
+//
+// - 0x12: epochNumber
+//
+// - 0x13<epochNumber_Bytes><actionID_Bytes>: QueuedMessage
+var (
+	ParamsKey              = []byte{0x01}
+	NextEpochActionIDKey   = []byte{0x11}
+	EpochNumberKey         = []byte{0x12}
+	EpochActionQueuePrefix = []byte{0x13}
+)
+
+// EpochActionQueueKey returns the prefix of the messages queued in the given
+// epoch.
+func EpochActionQueueKey(epochNumber uint64) []byte {
+	return append(append([]byte{}, EpochActionQueuePrefix...), sdk.Uint64ToBigEndian(epochNumber)...)
+}
+
+// ActionStoreKey returns the key of a queued message from its epoch number and
+// action ID. Both are big endian encoded so that the messages of an epoch are
+// iterated in the order they were queued.
+func ActionStoreKey(epochNumber uint64, actionID uint64) []byte {
+	return append(EpochActionQueueKey(epochNumber), sdk.Uint64ToBigEndian(actionID)...)
+}
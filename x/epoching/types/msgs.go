@@ -0,0 +1,433 @@
+package types
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var (
+	_, _, _, _ sdk.Msg            = &MsgWrappedDelegate{}, &MsgWrappedUndelegate{}, &MsgWrappedBeginRedelegate{}, &MsgUpdateParams{}
+	_, _, _, _ legacytx.LegacyMsg = &MsgWrappedDelegate{}, &MsgWrappedUndelegate{}, &MsgWrappedBeginRedelegate{}, &MsgUpdateParams{} // For amino support.
+
+	_, _, _, _, _ sdk.Msg            = &MsgWrappedCreateValidator{}, &MsgWrappedCancelUnbondingDelegation{}, &MsgWrappedTokenizeShares{}, &MsgWrappedRedeemTokensForShares{}, &MsgWrappedValidatorBond{}
+	_, _, _, _, _ legacytx.LegacyMsg = &MsgWrappedCreateValidator{}, &MsgWrappedCancelUnbondingDelegation{}, &MsgWrappedTokenizeShares{}, &MsgWrappedRedeemTokensForShares{}, &MsgWrappedValidatorBond{} // For amino support.
+
+	_ codectypes.UnpackInterfacesMessage = QueuedMessage{}
+	_ codectypes.UnpackInterfacesMessage = MsgWrappedCreateValidator{}
+)
+
+// NewMsgWrappedDelegate creates a new MsgWrappedDelegate instance.
+func NewMsgWrappedDelegate(msg *stakingtypes.MsgDelegate) *MsgWrappedDelegate {
+	return &MsgWrappedDelegate{Msg: msg}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgWrappedDelegate) ValidateBasic() error {
+	if msg.Msg == nil {
+		return ErrInvalidMsg.Wrap("empty delegate message")
+	}
+
+	return msg.Msg.ValidateBasic()
+}
+
+// GetSigners returns the signers of the wrapped message.
+func (msg MsgWrappedDelegate) GetSigners() []sdk.AccAddress {
+	if msg.Msg == nil {
+		return nil
+	}
+
+	return msg.Msg.GetSigners()
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgWrappedDelegate) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgWrappedDelegate) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgWrappedDelegate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgWrappedUndelegate creates a new MsgWrappedUndelegate instance.
+func NewMsgWrappedUndelegate(msg *stakingtypes.MsgUndelegate) *MsgWrappedUndelegate {
+	return &MsgWrappedUndelegate{Msg: msg}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgWrappedUndelegate) ValidateBasic() error {
+	if msg.Msg == nil {
+		return ErrInvalidMsg.Wrap("empty undelegate message")
+	}
+
+	return msg.Msg.ValidateBasic()
+}
+
+// GetSigners returns the signers of the wrapped message.
+func (msg MsgWrappedUndelegate) GetSigners() []sdk.AccAddress {
+	if msg.Msg == nil {
+		return nil
+	}
+
+	return msg.Msg.GetSigners()
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgWrappedUndelegate) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgWrappedUndelegate) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgWrappedUndelegate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgWrappedBeginRedelegate creates a new MsgWrappedBeginRedelegate instance.
+func NewMsgWrappedBeginRedelegate(msg *stakingtypes.MsgBeginRedelegate) *MsgWrappedBeginRedelegate {
+	return &MsgWrappedBeginRedelegate{Msg: msg}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgWrappedBeginRedelegate) ValidateBasic() error {
+	if msg.Msg == nil {
+		return ErrInvalidMsg.Wrap("empty redelegate message")
+	}
+
+	return msg.Msg.ValidateBasic()
+}
+
+// GetSigners returns the signers of the wrapped message.
+func (msg MsgWrappedBeginRedelegate) GetSigners() []sdk.AccAddress {
+	if msg.Msg == nil {
+		return nil
+	}
+
+	return msg.Msg.GetSigners()
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgWrappedBeginRedelegate) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgWrappedBeginRedelegate) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgWrappedBeginRedelegate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgWrappedCreateValidator creates a new MsgWrappedCreateValidator instance.
+func NewMsgWrappedCreateValidator(msg *stakingtypes.MsgCreateValidator) *MsgWrappedCreateValidator {
+	return &MsgWrappedCreateValidator{Msg: msg}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgWrappedCreateValidator) ValidateBasic() error {
+	if msg.Msg == nil {
+		return ErrInvalidMsg.Wrap("empty create validator message")
+	}
+
+	return msg.Msg.ValidateBasic()
+}
+
+// GetSigners returns the signers of the wrapped message.
+func (msg MsgWrappedCreateValidator) GetSigners() []sdk.AccAddress {
+	if msg.Msg == nil {
+		return nil
+	}
+
+	return msg.Msg.GetSigners()
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgWrappedCreateValidator) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgWrappedCreateValidator) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgWrappedCreateValidator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces, for
+// the public key of the wrapped message.
+func (msg MsgWrappedCreateValidator) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if msg.Msg == nil {
+		return nil
+	}
+
+	return msg.Msg.UnpackInterfaces(unpacker)
+}
+
+// NewMsgWrappedCancelUnbondingDelegation creates a new MsgWrappedCancelUnbondingDelegation instance.
+func NewMsgWrappedCancelUnbondingDelegation(msg *stakingtypes.MsgCancelUnbondingDelegation) *MsgWrappedCancelUnbondingDelegation {
+	return &MsgWrappedCancelUnbondingDelegation{Msg: msg}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgWrappedCancelUnbondingDelegation) ValidateBasic() error {
+	if msg.Msg == nil {
+		return ErrInvalidMsg.Wrap("empty cancel unbonding delegation message")
+	}
+
+	return msg.Msg.ValidateBasic()
+}
+
+// GetSigners returns the signers of the wrapped message.
+func (msg MsgWrappedCancelUnbondingDelegation) GetSigners() []sdk.AccAddress {
+	if msg.Msg == nil {
+		return nil
+	}
+
+	return msg.Msg.GetSigners()
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgWrappedCancelUnbondingDelegation) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgWrappedCancelUnbondingDelegation) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgWrappedCancelUnbondingDelegation) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgWrappedTokenizeShares creates a new MsgWrappedTokenizeShares instance.
+func NewMsgWrappedTokenizeShares(msg *stakingtypes.MsgTokenizeShares) *MsgWrappedTokenizeShares {
+	return &MsgWrappedTokenizeShares{Msg: msg}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgWrappedTokenizeShares) ValidateBasic() error {
+	if msg.Msg == nil {
+		return ErrInvalidMsg.Wrap("empty tokenize shares message")
+	}
+
+	return msg.Msg.ValidateBasic()
+}
+
+// GetSigners returns the signers of the wrapped message.
+func (msg MsgWrappedTokenizeShares) GetSigners() []sdk.AccAddress {
+	if msg.Msg == nil {
+		return nil
+	}
+
+	return msg.Msg.GetSigners()
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgWrappedTokenizeShares) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgWrappedTokenizeShares) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgWrappedTokenizeShares) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgWrappedRedeemTokensForShares creates a new MsgWrappedRedeemTokensForShares instance.
+func NewMsgWrappedRedeemTokensForShares(msg *stakingtypes.MsgRedeemTokensForShares) *MsgWrappedRedeemTokensForShares {
+	return &MsgWrappedRedeemTokensForShares{Msg: msg}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgWrappedRedeemTokensForShares) ValidateBasic() error {
+	if msg.Msg == nil {
+		return ErrInvalidMsg.Wrap("empty redeem tokens for shares message")
+	}
+
+	return msg.Msg.ValidateBasic()
+}
+
+// GetSigners returns the signers of the wrapped message.
+func (msg MsgWrappedRedeemTokensForShares) GetSigners() []sdk.AccAddress {
+	if msg.Msg == nil {
+		return nil
+	}
+
+	return msg.Msg.GetSigners()
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgWrappedRedeemTokensForShares) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgWrappedRedeemTokensForShares) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgWrappedRedeemTokensForShares) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgWrappedValidatorBond creates a new MsgWrappedValidatorBond instance.
+func NewMsgWrappedValidatorBond(msg *stakingtypes.MsgValidatorBond) *MsgWrappedValidatorBond {
+	return &MsgWrappedValidatorBond{Msg: msg}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgWrappedValidatorBond) ValidateBasic() error {
+	if msg.Msg == nil {
+		return ErrInvalidMsg.Wrap("empty validator bond message")
+	}
+
+	return msg.Msg.ValidateBasic()
+}
+
+// GetSigners returns the signers of the wrapped message.
+func (msg MsgWrappedValidatorBond) GetSigners() []sdk.AccAddress {
+	if msg.Msg == nil {
+		return nil
+	}
+
+	return msg.Msg.GetSigners()
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgWrappedValidatorBond) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgWrappedValidatorBond) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgWrappedValidatorBond) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateQueuedMsg checks that msg is a staking message which can be queued
+// until the end of an epoch.
+func ValidateQueuedMsg(msg sdk.Msg) error {
+	switch msg.(type) {
+	case *stakingtypes.MsgDelegate, *stakingtypes.MsgUndelegate, *stakingtypes.MsgBeginRedelegate,
+		*stakingtypes.MsgCreateValidator, *stakingtypes.MsgCancelUnbondingDelegation,
+		*stakingtypes.MsgTokenizeShares, *stakingtypes.MsgRedeemTokensForShares, *stakingtypes.MsgValidatorBond:
+		return msg.ValidateBasic()
+	default:
+		return ErrInvalidMsg.Wrapf("cannot queue %s", sdk.MsgTypeURL(msg))
+	}
+}
+
+// NewMsgUpdateParams creates a new MsgUpdateParams instance.
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrap(err, "authority")
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return sdkerrors.Wrap(err, "params")
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgUpdateParams message.
+func (msg *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgUpdateParams) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgUpdateParams) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// NewQueuedMessage creates a new QueuedMessage instance.
+func NewQueuedMessage(id uint64, msg sdk.Msg, blockHeight int64, blockTime time.Time) (QueuedMessage, error) {
+	m, ok := msg.(proto.Message)
+	if !ok {
+		return QueuedMessage{}, sdkerrors.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", msg)
+	}
+
+	any, err := codectypes.NewAnyWithValue(m)
+	if err != nil {
+		return QueuedMessage{}, err
+	}
+
+	return QueuedMessage{
+		Id:          id,
+		Msg:         any,
+		BlockHeight: blockHeight,
+		BlockTime:   blockTime,
+	}, nil
+}
+
+// GetSdkMsg returns the unpacked queued message.
+func (m QueuedMessage) GetSdkMsg() (sdk.Msg, error) {
+	if m.Msg == nil {
+		return nil, ErrInvalidMsg.Wrapf("queued message %d is empty", m.Id)
+	}
+
+	msg, ok := m.Msg.GetCachedValue().(sdk.Msg)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("expected %T, got %T", (sdk.Msg)(nil), m.Msg.GetCachedValue())
+	}
+
+	return msg, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m QueuedMessage) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var msg sdk.Msg
+	return unpacker.UnpackAny(m.Msg, &msg)
+}
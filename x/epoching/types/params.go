@@ -0,0 +1,49 @@
+package types
+
+import (
+	"sigs.k8s.io/yaml"
+)
+
+// DefaultEpochLength is the default number of blocks of an epoch.
+const DefaultEpochLength int64 = 100
+
+// NewParams creates a new Params instance.
+func NewParams(epochLength int64) Params {
+	return Params{
+		EpochLength: epochLength,
+	}
+}
+
+// DefaultParams returns the default epoching module parameters.
+func DefaultParams() Params {
+	return NewParams(DefaultEpochLength)
+}
+
+// Validate validates the set of params.
+func (p Params) Validate() error {
+	return validateEpochLength(p.EpochLength)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+func validateEpochLength(v int64) error {
+	if v <= 0 {
+		return ErrInvalidEpochLength.Wrapf("epoch length must be positive: %d", v)
+	}
+
+	return nil
+}
+
+// EpochEndHeight returns the height of the last block of the epoch that
+// contains the given height.
+func EpochEndHeight(height, epochLength int64) int64 {
+	if rem := height % epochLength; rem != 0 {
+		return height + epochLength - rem
+	}
+
+	return height
+}
@@ -27,6 +27,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryFeeGrant(),
 		GetCmdQueryFeeGrantsByGrantee(),
 		GetCmdQueryFeeGrantsByGranter(),
+		GetCmdQueryFeeGrantMembers(),
 	)
 
 	return feegrantQueryCmd
@@ -175,3 +176,57 @@ $ %s query feegrant grants-by-granter [granter]
 
 	return cmd
 }
+
+// GetCmdQueryFeeGrantMembers returns cmd to query for the members sharing a grant between granter and grantee.
+func GetCmdQueryFeeGrantMembers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "members [granter] [grantee]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the members sharing a grant",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Queries the members sharing the grant of a granter to a grantee.
+
+Example:
+$ %s query feegrant members [granter] [grantee]
+`, version.AppName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := feegrant.NewQueryClient(clientCtx)
+
+			granterAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			granteeAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AllowanceMembers(
+				cmd.Context(),
+				&feegrant.QueryAllowanceMembersRequest{
+					Granter:    granterAddr.String(),
+					Grantee:    granteeAddr.String(),
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "members")
+
+	return cmd
+}
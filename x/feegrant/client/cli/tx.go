@@ -22,6 +22,11 @@ const (
 	FlagPeriodLimit = "period-limit"
 	FlagSpendLimit  = "spend-limit"
 	FlagAllowedMsgs = "allowed-messages"
+
+	FlagAllowedMsgFields = "allowed-msg-field"
+	FlagMaxGas           = "max-gas"
+	FlagAddMembers       = "add-members"
+	FlagRemoveMembers    = "remove-members"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -38,6 +43,7 @@ func GetTxCmd() *cobra.Command {
 	feegrantTxCmd.AddCommand(
 		NewCmdFeeGrant(),
 		NewCmdRevokeFeegrant(),
+		NewCmdUpdateFeegrantMembers(),
 	)
 
 	return feegrantTxCmd
@@ -57,8 +63,11 @@ Examples:
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z or
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --period 3600 --period-limit 10stake --expiration 2022-01-30T15:04:05Z or
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z 
-	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote"
+	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote" or
+%s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --max-gas 10000000
+	--allowed-msg-field "/cosmos.bank.v1beta1.MsgSend:to_address=cosmos1a...,cosmos1b..."
 				`, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName,
+				version.AppName, feegrant.ModuleName,
 			),
 		),
 		Args: cobra.ExactArgs(2),
@@ -153,13 +162,40 @@ Examples:
 				return err
 			}
 
-			if len(allowedMsgs) > 0 {
+			allowedMsgFields, err := cmd.Flags().GetStringArray(FlagAllowedMsgFields)
+			if err != nil {
+				return err
+			}
+
+			if len(allowedMsgFields) > 0 {
+				allowed, err := parseAllowedMsgFields(allowedMsgs, allowedMsgFields)
+				if err != nil {
+					return err
+				}
+
+				grant, err = feegrant.NewAllowedMsgFieldsAllowance(grant, allowed)
+				if err != nil {
+					return err
+				}
+			} else if len(allowedMsgs) > 0 {
 				grant, err = feegrant.NewAllowedMsgAllowance(grant, allowedMsgs)
 				if err != nil {
 					return err
 				}
 			}
 
+			maxGas, err := cmd.Flags().GetUint64(FlagMaxGas)
+			if err != nil {
+				return err
+			}
+
+			if maxGas > 0 {
+				grant, err = feegrant.NewGasAllowance(grant, maxGas)
+				if err != nil {
+					return err
+				}
+			}
+
 			msg, err := feegrant.NewMsgGrantAllowance(grant, granter, grantee)
 			if err != nil {
 				return err
@@ -175,10 +211,52 @@ Examples:
 	cmd.Flags().String(FlagSpendLimit, "", "Spend limit specifies the max limit can be used, if not mentioned there is no limit")
 	cmd.Flags().Int64(FlagPeriod, 0, "period specifies the time duration(in seconds) in which period_limit coins can be spent before that allowance is reset (ex: 3600)")
 	cmd.Flags().String(FlagPeriodLimit, "", "period limit specifies the maximum number of coins that can be spent in the period")
+	cmd.Flags().StringArray(FlagAllowedMsgFields, []string{}, "Allowed values of a message field for fee allowance, as <msg_type_url>:<field>=<value>,<value>... (repeatable)")
+	cmd.Flags().Uint64(FlagMaxGas, 0, "max gas specifies the total gas limit of the transactions the allowance pays fees for, each tx is charged its gas limit, if not mentioned there is no limit")
 
 	return cmd
 }
 
+// parseAllowedMsgFields parses field restrictions of the form
+// <msg_type_url>:<field>=<value>,<value>... and merges them with the allowed
+// messages without restrictions.
+func parseAllowedMsgFields(allowedMsgs, allowedMsgFields []string) ([]feegrant.AllowedMsgFields, error) {
+	var allowed []feegrant.AllowedMsgFields
+	indexes := make(map[string]int)
+	add := func(msgTypeURL string) int {
+		if i, ok := indexes[msgTypeURL]; ok {
+			return i
+		}
+		indexes[msgTypeURL] = len(allowed)
+		allowed = append(allowed, feegrant.AllowedMsgFields{MsgTypeUrl: msgTypeURL})
+		return len(allowed) - 1
+	}
+
+	for _, msgTypeURL := range allowedMsgs {
+		add(msgTypeURL)
+	}
+
+	for _, allowedMsgField := range allowedMsgFields {
+		msgTypeURL, restriction, found := strings.Cut(allowedMsgField, ":")
+		if !found {
+			return nil, fmt.Errorf("invalid allowed message field %s, expected <msg_type_url>:<field>=<value>,<value>", allowedMsgField)
+		}
+
+		field, values, found := strings.Cut(restriction, "=")
+		if !found || field == "" || values == "" {
+			return nil, fmt.Errorf("invalid allowed message field %s, expected <msg_type_url>:<field>=<value>,<value>", allowedMsgField)
+		}
+
+		i := add(msgTypeURL)
+		allowed[i].Restrictions = append(allowed[i].Restrictions, sdk.MsgFieldRestriction{
+			Field:         field,
+			AllowedValues: strings.Split(values, ","),
+		})
+	}
+
+	return allowed, nil
+}
+
 // NewCmdRevokeFeegrant returns a CLI command handler for creating a MsgRevokeAllowance transaction.
 func NewCmdRevokeFeegrant() *cobra.Command {
 	cmd := &cobra.Command{
@@ -215,6 +293,72 @@ Example:
 	return cmd
 }
 
+// NewCmdUpdateFeegrantMembers returns a CLI command handler for creating a MsgUpdateAllowanceMembers transaction.
+func NewCmdUpdateFeegrantMembers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-members [granter] [grantee]",
+		Short: "add and remove the members sharing a fee-grant",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`add and remove the members sharing the fee grant from a granter to a grantee. Members
+			without a fee grant of their own from the granter have their fees paid out of the shared fee grant.
+			Note, the'--from' flag is ignored as it is implied from [granter].
+
+Example:
+ $ %s tx %s update-members cosmos1skj.. cosmos1skj.. --add-members cosmos1a..,cosmos1b.. --remove-members cosmos1c..
+			`, version.AppName, feegrant.ModuleName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			addMembers, err := getMembers(cmd, FlagAddMembers)
+			if err != nil {
+				return err
+			}
+
+			removeMembers, err := getMembers(cmd, FlagRemoveMembers)
+			if err != nil {
+				return err
+			}
+
+			msg := feegrant.NewMsgUpdateAllowanceMembers(clientCtx.GetFromAddress(), grantee, addMembers, removeMembers)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().StringSlice(FlagAddMembers, []string{}, "Addresses of the members to add, separated by ,")
+	cmd.Flags().StringSlice(FlagRemoveMembers, []string{}, "Addresses of the members to remove, separated by ,")
+	return cmd
+}
+
+func getMembers(cmd *cobra.Command, flag string) ([]sdk.AccAddress, error) {
+	members, err := cmd.Flags().GetStringSlice(flag)
+	if err != nil {
+		return nil, err
+	}
+
+	addrs := make([]sdk.AccAddress, len(members))
+	for i, member := range members {
+		addrs[i], err = sdk.AccAddressFromBech32(member)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return addrs, nil
+}
+
 func getPeriodReset(duration int64) time.Time {
 	return time.Now().Add(getPeriod(duration))
 }
@@ -22,4 +22,8 @@ var (
 	ErrNoMessages = sdkerrors.Register(DefaultCodespace, 6, "allowed messages are empty")
 	// ErrMessageNotAllowed error if message is not allowed
 	ErrMessageNotAllowed = sdkerrors.Register(DefaultCodespace, 7, "message not allowed")
+	// ErrGasLimitExceeded error if there is not enough gas allowance to cover the tx gas
+	ErrGasLimitExceeded = sdkerrors.Register(DefaultCodespace, 8, "gas limit exceeded")
+	// ErrInvalidMember error if an account can't be added to or removed from the members of an allowance
+	ErrInvalidMember = sdkerrors.Register(DefaultCodespace, 9, "invalid allowance member")
 )
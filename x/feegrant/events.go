@@ -7,6 +7,8 @@ const (
 	EventTypeSetFeeGrant    = "set_feegrant"
 	EventTypeUpdateFeeGrant = "update_feegrant"
 
+	EventTypeUpdateFeeGrantMembers = "update_feegrant_members"
+
 	AttributeKeyGranter = "granter"
 	AttributeKeyGrantee = "grantee"
 
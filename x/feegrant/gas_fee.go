@@ -0,0 +1,122 @@
+package feegrant
+
+import (
+	"math"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ FeeAllowanceI                 = (*GasAllowance)(nil)
+	_ types.UnpackInterfacesMessage = (*GasAllowance)(nil)
+)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a *GasAllowance) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(a.Allowance, &allowance)
+}
+
+// NewGasAllowance creates new allowance capping the total gas limit of the
+// transactions the given allowance pays fees for.
+func NewGasAllowance(allowance FeeAllowanceI, maxGas uint64) (*GasAllowance, error) {
+	msg, ok := allowance.(proto.Message)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", msg)
+	}
+	any, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GasAllowance{
+		Allowance: any,
+		MaxGas:    maxGas,
+	}, nil
+}
+
+// GetAllowance returns the wrapped fee allowance.
+func (a *GasAllowance) GetAllowance() (FeeAllowanceI, error) {
+	allowance, ok := a.Allowance.GetCachedValue().(FeeAllowanceI)
+	if !ok {
+		return nil, sdkerrors.Wrap(ErrNoAllowance, "failed to get allowance")
+	}
+
+	return allowance, nil
+}
+
+// SetAllowance sets the wrapped fee allowance.
+func (a *GasAllowance) SetAllowance(allowance FeeAllowanceI) error {
+	var err error
+	a.Allowance, err = types.NewAnyWithValue(allowance.(proto.Message))
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", allowance)
+	}
+
+	return nil
+}
+
+// Accept charges the gas limit of the transaction to the allowance, as the gas
+// the transaction actually consumes is not known yet when fees are deducted,
+// before passing the fee on to the wrapped allowance. Transactions run with an
+// infinite gas meter, as in simulations and in the genesis block, are not charged.
+func (a *GasAllowance) Accept(ctx sdk.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	gas := ctx.GasMeter().Limit()
+	if gas == math.MaxUint64 {
+		gas = 0
+	}
+
+	if gas > a.MaxGas-a.GasCharged {
+		return false, sdkerrors.Wrapf(ErrGasLimitExceeded, "gas allowance has %d gas left, tx gas limit is %d", a.MaxGas-a.GasCharged, gas)
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return false, err
+	}
+
+	remove, err := allowance.Accept(ctx, fee, msgs)
+	if err != nil || remove {
+		return remove, err
+	}
+
+	if err = a.SetAllowance(allowance); err != nil {
+		return false, err
+	}
+
+	a.GasCharged += gas
+	return a.GasCharged == a.MaxGas, nil
+}
+
+// ValidateBasic implements FeeAllowance and enforces basic sanity checks
+func (a *GasAllowance) ValidateBasic() error {
+	if a.Allowance == nil {
+		return sdkerrors.Wrap(ErrNoAllowance, "allowance should not be empty")
+	}
+	if a.MaxGas == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "max gas must be positive")
+	}
+	if a.GasCharged > a.MaxGas {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "gas charged cannot exceed the max gas")
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return err
+	}
+
+	return allowance.ValidateBasic()
+}
+
+func (a *GasAllowance) ExpiresAt() (*time.Time, error) {
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return nil, err
+	}
+	return allowance.ExpiresAt()
+}
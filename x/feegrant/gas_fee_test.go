@@ -0,0 +1,65 @@
+package feegrant_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ocproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+func TestGasAllowance(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, ocproto.Header{Time: time.Now()})
+
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 43))
+
+	allowance, err := feegrant.NewGasAllowance(&feegrant.BasicAllowance{SpendLimit: atom}, 250000)
+	require.NoError(t, err)
+	require.NoError(t, allowance.ValidateBasic())
+
+	// the gas limit of the tx is charged
+	removed, err := allowance.Accept(ctx.WithGasMeter(sdk.NewGasMeter(100000)), fee, nil)
+	require.NoError(t, err)
+	require.False(t, removed)
+	require.Equal(t, uint64(100000), allowance.GasCharged)
+
+	inner, err := allowance.GetAllowance()
+	require.NoError(t, err)
+	require.Equal(t, atom.Sub(fee...), inner.(*feegrant.BasicAllowance).SpendLimit)
+
+	// txs with an infinite gas meter are not charged
+	removed, err = allowance.Accept(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), fee, nil)
+	require.NoError(t, err)
+	require.False(t, removed)
+	require.Equal(t, uint64(100000), allowance.GasCharged)
+
+	// txs exceeding the gas left are rejected
+	removed, err = allowance.Accept(ctx.WithGasMeter(sdk.NewGasMeter(200000)), fee, nil)
+	require.ErrorIs(t, err, feegrant.ErrGasLimitExceeded)
+	require.False(t, removed)
+	require.Equal(t, uint64(100000), allowance.GasCharged)
+
+	// the allowance is removed once all gas is charged
+	removed, err = allowance.Accept(ctx.WithGasMeter(sdk.NewGasMeter(150000)), fee, nil)
+	require.NoError(t, err)
+	require.True(t, removed)
+}
+
+func TestGasAllowanceValidateBasic(t *testing.T) {
+	allowance, err := feegrant.NewGasAllowance(&feegrant.BasicAllowance{}, 0)
+	require.NoError(t, err)
+	require.Error(t, allowance.ValidateBasic())
+
+	allowance.MaxGas = 10
+	allowance.GasCharged = 11
+	require.Error(t, allowance.ValidateBasic())
+
+	allowance.GasCharged = 10
+	require.NoError(t, allowance.ValidateBasic())
+}
@@ -2,6 +2,8 @@ package feegrant
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var _ types.UnpackInterfacesMessage = GenesisState{}
@@ -25,6 +27,25 @@ func ValidateGenesis(data GenesisState) error {
 			return err
 		}
 	}
+
+	grants := make(map[string]bool, len(data.Allowances))
+	for _, f := range data.Allowances {
+		grants[f.Granter+"/"+f.Grantee] = true
+	}
+
+	members := make(map[string]bool, len(data.AllowanceMembers))
+	for _, m := range data.AllowanceMembers {
+		if !grants[m.Granter+"/"+m.Grantee] {
+			return sdkerrors.Wrapf(ErrNoAllowance, "member %s of missing allowance from %s to %s", m.Member, m.Granter, m.Grantee)
+		}
+		if _, err := sdk.AccAddressFromBech32(m.Member); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid member address: %s", err)
+		}
+		if m.Member == m.Granter || m.Member == m.Grantee || members[m.Granter+"/"+m.Member] {
+			return sdkerrors.Wrapf(ErrInvalidMember, "invalid member %s of allowance from %s to %s", m.Member, m.Granter, m.Grantee)
+		}
+		members[m.Granter+"/"+m.Member] = true
+	}
 	return nil
 }
 
@@ -125,3 +125,38 @@ func (q Keeper) AllowancesByGranter(c context.Context, req *feegrant.QueryAllowa
 
 	return &feegrant.QueryAllowancesByGranterResponse{Allowances: grants, Pagination: pageRes}, nil
 }
+
+// AllowanceMembers queries the members sharing the allowance granted by the given granter to the given grantee
+func (q Keeper) AllowanceMembers(c context.Context, req *feegrant.QueryAllowanceMembersRequest) (*feegrant.QueryAllowanceMembersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	granterAddr, err := sdk.AccAddressFromBech32(req.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	granteeAddr, err := sdk.AccAddressFromBech32(req.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var members []string
+
+	store := ctx.KVStore(q.storeKey)
+	membersStore := prefix.NewStore(store, feegrant.AllowanceMembersPrefix(granterAddr, granteeAddr))
+
+	pageRes, err := query.Paginate(membersStore, req.Pagination, func(key []byte, value []byte) error {
+		// key is the length prefixed member address
+		members = append(members, sdk.AccAddress(key[1:]).String())
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &feegrant.QueryAllowanceMembersResponse{Members: members, Pagination: pageRes}, nil
+}
@@ -161,6 +161,7 @@ func (k Keeper) revokeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress
 	store := ctx.KVStore(k.storeKey)
 	key := feegrant.FeeAllowanceKey(granter, grantee)
 	store.Delete(key)
+	k.deleteAllowanceMembers(ctx, granter, grantee)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -223,9 +224,100 @@ func (k Keeper) IterateAllFeeAllowances(ctx sdk.Context, cb func(grant feegrant.
 	return nil
 }
 
-// UseGrantedFees will try to pay the given fee from the granter's account as requested by the grantee
+// UpdateAllowanceMembers adds and removes the members sharing the allowance granted
+// by the granter to the grantee. An account can share at most one allowance of a granter.
+func (k Keeper) UpdateAllowanceMembers(ctx sdk.Context, granter, grantee sdk.AccAddress, addMembers, removeMembers []sdk.AccAddress) error {
+	if _, err := k.getGrant(ctx, granter, grantee); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, member := range removeMembers {
+		if !grantee.Equals(sdk.AccAddress(store.Get(feegrant.AllowanceMemberKey(granter, member)))) {
+			return sdkerrors.Wrapf(feegrant.ErrInvalidMember, "%s is not a member of the allowance", member)
+		}
+
+		store.Delete(feegrant.AllowanceMemberKey(granter, member))
+		store.Delete(feegrant.AllowanceMembersKey(granter, grantee, member))
+	}
+
+	for _, member := range addMembers {
+		if store.Has(feegrant.AllowanceMemberKey(granter, member)) {
+			return sdkerrors.Wrapf(feegrant.ErrInvalidMember, "%s already shares an allowance of %s", member, granter)
+		}
+
+		// create the account if it is not in account state
+		if k.authKeeper.GetAccount(ctx, member) == nil {
+			k.authKeeper.SetAccount(ctx, k.authKeeper.NewAccountWithAddress(ctx, member))
+		}
+
+		store.Set(feegrant.AllowanceMemberKey(granter, member), grantee)
+		store.Set(feegrant.AllowanceMembersKey(granter, grantee, member), []byte{})
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			feegrant.EventTypeUpdateFeeGrantMembers,
+			sdk.NewAttribute(feegrant.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(feegrant.AttributeKeyGrantee, grantee.String()),
+		),
+	)
+
+	return nil
+}
+
+// GetAllowanceMembers returns the members sharing the allowance granted by the granter
+// to the grantee.
+func (k Keeper) GetAllowanceMembers(ctx sdk.Context, granter, grantee sdk.AccAddress) []sdk.AccAddress {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, feegrant.AllowanceMembersPrefix(granter, grantee))
+	defer iter.Close()
+
+	var members []sdk.AccAddress
+	for ; iter.Valid(); iter.Next() {
+		_, _, member := feegrant.ParseAddressesFromAllowanceMembersKey(iter.Key())
+		members = append(members, member)
+	}
+
+	return members
+}
+
+// deleteAllowanceMembers removes all members of the allowance granted by the granter
+// to the grantee.
+func (k Keeper) deleteAllowanceMembers(ctx sdk.Context, granter, grantee sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	for _, member := range k.GetAllowanceMembers(ctx, granter, grantee) {
+		store.Delete(feegrant.AllowanceMemberKey(granter, member))
+		store.Delete(feegrant.AllowanceMembersKey(granter, grantee, member))
+	}
+}
+
+// IterateAllAllowanceMembers iterates over all the members of allowances in the store.
+// Callback to get all data, returns true to stop, false to keep reading
+// Calling this without pagination is very expensive and only designed for export genesis
+func (k Keeper) IterateAllAllowanceMembers(ctx sdk.Context, cb func(member feegrant.AllowanceMember) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, feegrant.AllowanceMembersKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		granter, grantee, member := feegrant.ParseAddressesFromAllowanceMembersKey(iter.Key())
+		if cb(feegrant.AllowanceMember{Granter: granter.String(), Grantee: grantee.String(), Member: member.String()}) {
+			break
+		}
+	}
+}
+
+// UseGrantedFees will try to pay the given fee from the granter's account as requested by the grantee.
+// A grantee without an allowance of its own from the granter uses the allowance it is a member of, if any.
 func (k Keeper) UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
 	f, err := k.getGrant(ctx, granter, grantee)
+	if sdkerrors.IsOf(err, sdkerrors.ErrNotFound) {
+		if owner := ctx.KVStore(k.storeKey).Get(feegrant.AllowanceMemberKey(granter, grantee)); owner != nil {
+			grantee = owner
+			f, err = k.getGrant(ctx, granter, grantee)
+		}
+	}
 	if err != nil {
 		return err
 	}
@@ -291,6 +383,25 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *feegrant.GenesisState) error
 			return err
 		}
 	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, m := range data.AllowanceMembers {
+		granter, err := sdk.AccAddressFromBech32(m.Granter)
+		if err != nil {
+			return err
+		}
+		grantee, err := sdk.AccAddressFromBech32(m.Grantee)
+		if err != nil {
+			return err
+		}
+		member, err := sdk.AccAddressFromBech32(m.Member)
+		if err != nil {
+			return err
+		}
+
+		store.Set(feegrant.AllowanceMemberKey(granter, member), grantee)
+		store.Set(feegrant.AllowanceMembersKey(granter, grantee, member), []byte{})
+	}
 	return nil
 }
 
@@ -303,9 +414,20 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (*feegrant.GenesisState, error) {
 		return false
 	})
 
+	if err != nil {
+		return nil, err
+	}
+
+	var members []feegrant.AllowanceMember
+	k.IterateAllAllowanceMembers(ctx, func(member feegrant.AllowanceMember) bool {
+		members = append(members, member)
+		return false
+	})
+
 	return &feegrant.GenesisState{
-		Allowances: grants,
-	}, err
+		Allowances:       grants,
+		AllowanceMembers: members,
+	}, nil
 }
 
 func (k Keeper) removeFromGrantQueue(ctx sdk.Context, exp *time.Time, allowanceKey []byte) {
@@ -331,5 +453,6 @@ func (k Keeper) RemoveExpiredAllowances(ctx sdk.Context) {
 
 		granter, grantee := feegrant.ParseAddressesFromFeeAllowanceQueueKey(iterator.Key())
 		store.Delete(feegrant.FeeAllowanceKey(granter, grantee))
+		k.deleteAllowanceMembers(ctx, granter, grantee)
 	}
 }
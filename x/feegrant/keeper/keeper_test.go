@@ -242,6 +242,63 @@ func (suite *KeeperTestSuite) TestUseGrantedFee() {
 	suite.Contains(err.Error(), "fee-grant not found")
 }
 
+func (suite *KeeperTestSuite) TestAllowanceMembers() {
+	granter, pool, member, other := suite.addrs[0], suite.addrs[1], suite.addrs[2], suite.addrs[3]
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 5))
+
+	// members can only be added to existing allowances
+	err := suite.keeper.UpdateAllowanceMembers(suite.sdkCtx, granter, pool, []sdk.AccAddress{member}, nil)
+	suite.Require().Error(err)
+
+	err = suite.keeper.GrantAllowance(suite.sdkCtx, granter, pool, &feegrant.BasicAllowance{SpendLimit: suite.atom})
+	suite.Require().NoError(err)
+	_, err = suite.msgSrvr.UpdateAllowanceMembers(suite.ctx, &feegrant.MsgUpdateAllowanceMembers{
+		Granter:    granter.String(),
+		Grantee:    pool.String(),
+		AddMembers: []string{member.String()},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]sdk.AccAddress{member}, suite.keeper.GetAllowanceMembers(suite.sdkCtx, granter, pool))
+
+	res, err := suite.keeper.AllowanceMembers(suite.ctx, &feegrant.QueryAllowanceMembersRequest{Granter: granter.String(), Grantee: pool.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{member.String()}, res.Members)
+
+	// members pay their fees out of the shared allowance
+	err = suite.keeper.UseGrantedFees(suite.sdkCtx, granter, member, smallAtom, []sdk.Msg{})
+	suite.Require().NoError(err)
+	allowance, err := suite.keeper.GetAllowance(suite.sdkCtx, granter, pool)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.atom.Sub(smallAtom...), allowance.(*feegrant.BasicAllowance).SpendLimit)
+
+	// non members don't
+	err = suite.keeper.UseGrantedFees(suite.sdkCtx, granter, other, smallAtom, []sdk.Msg{})
+	suite.Require().Error(err)
+
+	// an account shares at most one allowance of a granter
+	err = suite.keeper.GrantAllowance(suite.sdkCtx, granter, other, &feegrant.BasicAllowance{SpendLimit: suite.atom})
+	suite.Require().NoError(err)
+	err = suite.keeper.UpdateAllowanceMembers(suite.sdkCtx, granter, other, []sdk.AccAddress{member}, nil)
+	suite.Require().ErrorIs(err, feegrant.ErrInvalidMember)
+	err = suite.keeper.UpdateAllowanceMembers(suite.sdkCtx, granter, other, nil, []sdk.AccAddress{member})
+	suite.Require().ErrorIs(err, feegrant.ErrInvalidMember)
+
+	// members are exported and imported along with the allowances
+	genesis, err := suite.keeper.ExportGenesis(suite.sdkCtx)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]feegrant.AllowanceMember{{Granter: granter.String(), Grantee: pool.String(), Member: member.String()}}, genesis.AllowanceMembers)
+	suite.Require().NoError(feegrant.ValidateGenesis(*genesis))
+
+	// revoking the allowance removes its members
+	_, err = suite.msgSrvr.RevokeAllowance(suite.ctx, &feegrant.MsgRevokeAllowance{Granter: granter.String(), Grantee: pool.String()})
+	suite.Require().NoError(err)
+	suite.Require().Empty(suite.keeper.GetAllowanceMembers(suite.sdkCtx, granter, pool))
+	err = suite.keeper.UseGrantedFees(suite.sdkCtx, granter, member, smallAtom, []sdk.Msg{})
+	suite.Require().Error(err)
+	err = suite.keeper.UpdateAllowanceMembers(suite.sdkCtx, granter, other, []sdk.AccAddress{member}, nil)
+	suite.Require().NoError(err)
+}
+
 func (suite *KeeperTestSuite) TestIterateGrants() {
 	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 123))
 	exp := suite.sdkCtx.BlockTime().AddDate(1, 0, 0)
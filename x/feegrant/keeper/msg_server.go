@@ -76,3 +76,47 @@ func (k msgServer) RevokeAllowance(goCtx context.Context, msg *feegrant.MsgRevok
 
 	return &feegrant.MsgRevokeAllowanceResponse{}, nil
 }
+
+// UpdateAllowanceMembers adds and removes the members sharing a fee allowance between a granter and grantee.
+func (k msgServer) UpdateAllowanceMembers(goCtx context.Context, msg *feegrant.MsgUpdateAllowanceMembers) (*feegrant.MsgUpdateAllowanceMembersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	addMembers, err := accAddressesFromBech32(msg.AddMembers)
+	if err != nil {
+		return nil, err
+	}
+
+	removeMembers, err := accAddressesFromBech32(msg.RemoveMembers)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Keeper.UpdateAllowanceMembers(ctx, granter, grantee, addMembers, removeMembers)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feegrant.MsgUpdateAllowanceMembersResponse{}, nil
+}
+
+func accAddressesFromBech32(addrs []string) ([]sdk.AccAddress, error) {
+	accAddrs := make([]sdk.AccAddress, len(addrs))
+	for i, addr := range addrs {
+		accAddr, err := sdk.AccAddressFromBech32(addr)
+		if err != nil {
+			return nil, err
+		}
+		accAddrs[i] = accAddr
+	}
+	return accAddrs, nil
+}
@@ -29,6 +29,14 @@ var (
 	// FeeAllowanceQueueKeyPrefix is the set of the kvstore for fee allowance keys data
 	// - 0x01<allowance_prefix_queue_key_bytes>: <empty value>
 	FeeAllowanceQueueKeyPrefix = []byte{0x01}
+
+	// AllowanceMemberKeyPrefix is the set of the kvstore for the grantee whose allowance a member shares
+	// - 0x02<allowance_member_key_bytes>: grantee
+	AllowanceMemberKeyPrefix = []byte{0x02}
+
+	// AllowanceMembersKeyPrefix is the set of the kvstore for the members of an allowance
+	// - 0x03<allowance_members_key_bytes>: <empty value>
+	AllowanceMembersKeyPrefix = []byte{0x03}
 )
 
 // FeeAllowanceKey is the canonical key to store a grant from granter to grantee
@@ -93,3 +101,40 @@ func ParseAddressesFromFeeAllowanceQueueKey(key []byte) (granter, grantee sdk.Ac
 
 	return granter, grantee
 }
+
+// AllowanceMemberKey is the canonical key to store the grantee whose allowance from
+// granter is shared by the member.
+//
+// Key format:
+// - <0x02><len(member_address_bytes)><member_address_bytes><len(granter_address_bytes)><granter_address_bytes>
+func AllowanceMemberKey(granter, member sdk.AccAddress) []byte {
+	return append(AllowanceMemberKeyPrefix, FeeAllowanceKey(granter, member)[1:]...)
+}
+
+// AllowanceMembersPrefix returns a prefix to scan for all members of the allowance
+// from granter to grantee.
+//
+// Key format:
+// - <0x03><len(grantee_address_bytes)><grantee_address_bytes><len(granter_address_bytes)><granter_address_bytes>
+func AllowanceMembersPrefix(granter, grantee sdk.AccAddress) []byte {
+	return append(AllowanceMembersKeyPrefix, FeeAllowanceKey(granter, grantee)[1:]...)
+}
+
+// AllowanceMembersKey is the canonical key to store a member of the allowance from
+// granter to grantee.
+//
+// Key format:
+// - <0x03><len(grantee_address_bytes)><grantee_address_bytes><len(granter_address_bytes)><granter_address_bytes><len(member_address_bytes)><member_address_bytes>
+func AllowanceMembersKey(granter, grantee, member sdk.AccAddress) []byte {
+	return append(AllowanceMembersPrefix(granter, grantee), address.MustLengthPrefix(member.Bytes())...)
+}
+
+// ParseAddressesFromAllowanceMembersKey extracts and returns the granter, grantee and member from the given key.
+func ParseAddressesFromAllowanceMembersKey(key []byte) (granter, grantee, member sdk.AccAddress) {
+	granter, grantee = ParseAddressesFromFeeAllowanceKey(key)
+	memberAddrLenIndex := 1 + 1 + len(grantee) + 1 + len(granter)
+	memberAddrLen, _ := sdk.ParseLengthPrefixedBytes(key, memberAddrLenIndex, 1)
+	member, _ = sdk.ParseLengthPrefixedBytes(key, memberAddrLenIndex+1, int(memberAddrLen[0]))
+
+	return granter, grantee, member
+}
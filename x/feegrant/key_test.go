@@ -41,3 +41,18 @@ func TestMarshalAndUnmarshalFeegrantKeyQueueKey(t *testing.T) {
 	require.Equal(t, granter, granter1)
 	require.Equal(t, grantee, grantee1)
 }
+
+func TestMarshalAndUnmarshalAllowanceMembersKey(t *testing.T) {
+	granter := sdk.AccAddress("granter_____________")
+	grantee := sdk.AccAddress("grantee_____________")
+	member := sdk.AccAddress("member______________")
+
+	key := feegrant.AllowanceMembersKey(granter, grantee, member)
+	require.Len(t, key, len(grantee.Bytes())+len(granter.Bytes())+len(member.Bytes())+4)
+	require.Equal(t, feegrant.AllowanceMembersPrefix(granter, grantee), key[:len(key)-len(member.Bytes())-1])
+
+	granter1, grantee1, member1 := feegrant.ParseAddressesFromAllowanceMembersKey(key)
+	require.Equal(t, granter, granter1)
+	require.Equal(t, grantee, grantee1)
+	require.Equal(t, member, member1)
+}
@@ -0,0 +1,147 @@
+package feegrant
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ FeeAllowanceI                 = (*AllowedMsgFieldsAllowance)(nil)
+	_ types.UnpackInterfacesMessage = (*AllowedMsgFieldsAllowance)(nil)
+)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a *AllowedMsgFieldsAllowance) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(a.Allowance, &allowance)
+}
+
+// NewAllowedMsgFieldsAllowance creates new allowance filtered by message types and fields.
+func NewAllowedMsgFieldsAllowance(allowance FeeAllowanceI, allowedMsgs []AllowedMsgFields) (*AllowedMsgFieldsAllowance, error) {
+	msg, ok := allowance.(proto.Message)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", msg)
+	}
+	any, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AllowedMsgFieldsAllowance{
+		Allowance:       any,
+		AllowedMessages: allowedMsgs,
+	}, nil
+}
+
+// GetAllowance returns allowed fee allowance.
+func (a *AllowedMsgFieldsAllowance) GetAllowance() (FeeAllowanceI, error) {
+	allowance, ok := a.Allowance.GetCachedValue().(FeeAllowanceI)
+	if !ok {
+		return nil, sdkerrors.Wrap(ErrNoAllowance, "failed to get allowance")
+	}
+
+	return allowance, nil
+}
+
+// SetAllowance sets allowed fee allowance.
+func (a *AllowedMsgFieldsAllowance) SetAllowance(allowance FeeAllowanceI) error {
+	var err error
+	a.Allowance, err = types.NewAnyWithValue(allowance.(proto.Message))
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", allowance)
+	}
+
+	return nil
+}
+
+// Accept method checks that all messages are allowed, and their fields hold
+// allowed values, before passing the fee on to the wrapped allowance.
+func (a *AllowedMsgFieldsAllowance) Accept(ctx sdk.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	if err := a.allMsgsAllowed(ctx, msgs); err != nil {
+		return false, err
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return false, err
+	}
+
+	remove, err := allowance.Accept(ctx, fee, msgs)
+	if err == nil && !remove {
+		if err = a.SetAllowance(allowance); err != nil {
+			return false, err
+		}
+	}
+	return remove, err
+}
+
+func (a *AllowedMsgFieldsAllowance) allMsgsAllowed(ctx sdk.Context, msgs []sdk.Msg) error {
+	msgsMap := make(map[string]AllowedMsgFields, len(a.AllowedMessages))
+	for _, allowed := range a.AllowedMessages {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "check msg")
+		msgsMap[allowed.MsgTypeUrl] = allowed
+	}
+
+	for _, msg := range msgs {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "check msg")
+		allowed, ok := msgsMap[sdk.MsgTypeURL(msg)]
+		if !ok {
+			return sdkerrors.Wrap(ErrMessageNotAllowed, "message does not exist in allowed messages")
+		}
+
+		for _, restriction := range allowed.Restrictions {
+			if err := restriction.Check(ctx, msg); err != nil {
+				return sdkerrors.Wrap(ErrMessageNotAllowed, err.Error())
+			}
+		}
+	}
+
+	return nil
+}
+
+// ValidateBasic implements FeeAllowance and enforces basic sanity checks
+func (a *AllowedMsgFieldsAllowance) ValidateBasic() error {
+	if a.Allowance == nil {
+		return sdkerrors.Wrap(ErrNoAllowance, "allowance should not be empty")
+	}
+	if len(a.AllowedMessages) == 0 {
+		return sdkerrors.Wrap(ErrNoMessages, "allowed messages shouldn't be empty")
+	}
+
+	msgTypes := make(map[string]bool, len(a.AllowedMessages))
+	for _, allowed := range a.AllowedMessages {
+		if allowed.MsgTypeUrl == "" {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "allowed message type cannot be empty")
+		}
+		if msgTypes[allowed.MsgTypeUrl] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate allowed message %s", allowed.MsgTypeUrl)
+		}
+		msgTypes[allowed.MsgTypeUrl] = true
+
+		for _, restriction := range allowed.Restrictions {
+			if err := restriction.ValidateBasic(); err != nil {
+				return sdkerrors.Wrapf(err, "invalid restriction of %s", allowed.MsgTypeUrl)
+			}
+		}
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return err
+	}
+
+	return allowance.ValidateBasic()
+}
+
+func (a *AllowedMsgFieldsAllowance) ExpiresAt() (*time.Time, error) {
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return nil, err
+	}
+	return allowance.ExpiresAt()
+}
@@ -0,0 +1,158 @@
+package feegrant_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ocproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+func TestAllowedMsgFieldsAllowance(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, ocproto.Header{Time: time.Now()})
+
+	from := sdk.AccAddress("from________________")
+	alice := sdk.AccAddress("alice_______________")
+	bob := sdk.AccAddress("bob_________________")
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 43))
+	sendType := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	allowed := []feegrant.AllowedMsgFields{
+		{
+			MsgTypeUrl: sendType,
+			Restrictions: []sdk.MsgFieldRestriction{
+				{Field: "to_address", AllowedValues: []string{alice.String()}},
+				{Field: "amount.denom", AllowedValues: []string{"atom"}},
+			},
+		},
+		{MsgTypeUrl: "/cosmos.gov.v1.MsgVote"},
+	}
+
+	cases := map[string]struct {
+		msgs   []sdk.Msg
+		accept bool
+	}{
+		"allowed recipient": {
+			msgs:   []sdk.Msg{banktypes.NewMsgSend(from, alice, atom)},
+			accept: true,
+		},
+		"disallowed recipient": {
+			msgs:   []sdk.Msg{banktypes.NewMsgSend(from, alice, atom), banktypes.NewMsgSend(from, bob, atom)},
+			accept: false,
+		},
+		"disallowed denom": {
+			msgs:   []sdk.Msg{banktypes.NewMsgSend(from, alice, sdk.NewCoins(sdk.NewInt64Coin("eth", 1)))},
+			accept: false,
+		},
+		"msg not contained": {
+			msgs:   []sdk.Msg{banktypes.NewMsgMultiSend(nil, nil)},
+			accept: false,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			allowance, err := feegrant.NewAllowedMsgFieldsAllowance(&feegrant.BasicAllowance{SpendLimit: atom}, allowed)
+			require.NoError(t, err)
+			require.NoError(t, allowance.ValidateBasic())
+
+			removed, err := allowance.Accept(ctx, fee, tc.msgs)
+			require.False(t, removed)
+			if !tc.accept {
+				require.ErrorIs(t, err, feegrant.ErrMessageNotAllowed)
+				return
+			}
+			require.NoError(t, err)
+
+			inner, err := allowance.GetAllowance()
+			require.NoError(t, err)
+			require.Equal(t, atom.Sub(fee...), inner.(*feegrant.BasicAllowance).SpendLimit)
+		})
+	}
+}
+
+func TestAllowedMsgFieldsAllowanceGas(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, ocproto.Header{Time: time.Now()})
+
+	from := sdk.AccAddress("from________________")
+	alice := sdk.AccAddress("alice_______________")
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	msg := banktypes.NewMsgSend(from, alice, atom)
+
+	allowed := []feegrant.AllowedMsgFields{{
+		MsgTypeUrl: sdk.MsgTypeURL(msg),
+		Restrictions: []sdk.MsgFieldRestriction{
+			{Field: "to_address", AllowedValues: []string{alice.String()}},
+			{Field: "amount.denom", AllowedValues: []string{"atom"}},
+		},
+	}}
+	allowance, err := feegrant.NewAllowedMsgFieldsAllowance(&feegrant.BasicAllowance{SpendLimit: atom}, allowed)
+	require.NoError(t, err)
+
+	bz, err := codec.ProtoMarshalJSON(msg, nil)
+	require.NoError(t, err)
+
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	_, err = allowance.Accept(ctx, sdk.NewCoins(sdk.NewInt64Coin("atom", 1)), []sdk.Msg{msg})
+	require.NoError(t, err)
+	// every restriction charges for each byte of the marshalled msg
+	require.GreaterOrEqual(t, ctx.GasMeter().GasConsumed(), uint64(2*len(bz)))
+}
+
+func TestAllowedMsgFieldsAllowanceValidateBasic(t *testing.T) {
+	basic := &feegrant.BasicAllowance{}
+	sendType := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	cases := map[string]struct {
+		allowed []feegrant.AllowedMsgFields
+		valid   bool
+	}{
+		"empty":          {allowed: nil},
+		"empty msg type": {allowed: []feegrant.AllowedMsgFields{{}}},
+		"duplicate msg type": {
+			allowed: []feegrant.AllowedMsgFields{{MsgTypeUrl: sendType}, {MsgTypeUrl: sendType}},
+		},
+		"empty field": {
+			allowed: []feegrant.AllowedMsgFields{{
+				MsgTypeUrl:   sendType,
+				Restrictions: []sdk.MsgFieldRestriction{{AllowedValues: []string{"a"}}},
+			}},
+		},
+		"no allowed values": {
+			allowed: []feegrant.AllowedMsgFields{{
+				MsgTypeUrl:   sendType,
+				Restrictions: []sdk.MsgFieldRestriction{{Field: "to_address"}},
+			}},
+		},
+		"valid": {
+			allowed: []feegrant.AllowedMsgFields{{
+				MsgTypeUrl:   sendType,
+				Restrictions: []sdk.MsgFieldRestriction{{Field: "to_address", AllowedValues: []string{"a"}}},
+			}},
+			valid: true,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			allowance, err := feegrant.NewAllowedMsgFieldsAllowance(basic, tc.allowed)
+			require.NoError(t, err)
+			if tc.valid {
+				require.NoError(t, allowance.ValidateBasic())
+			} else {
+				require.Error(t, allowance.ValidateBasic())
+			}
+		})
+	}
+}
@@ -10,8 +10,8 @@ import (
 )
 
 var (
-	_, _ sdk.Msg            = &MsgGrantAllowance{}, &MsgRevokeAllowance{}
-	_, _ legacytx.LegacyMsg = &MsgGrantAllowance{}, &MsgRevokeAllowance{} // For amino support.
+	_, _, _ sdk.Msg            = &MsgGrantAllowance{}, &MsgRevokeAllowance{}, &MsgUpdateAllowanceMembers{}
+	_, _, _ legacytx.LegacyMsg = &MsgGrantAllowance{}, &MsgRevokeAllowance{}, &MsgUpdateAllowanceMembers{} // For amino support.
 
 	_ types.UnpackInterfacesMessage = &MsgGrantAllowance{}
 )
@@ -136,3 +136,72 @@ func (msg MsgRevokeAllowance) Route() string {
 func (msg MsgRevokeAllowance) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
 }
+
+// NewMsgUpdateAllowanceMembers returns a message to add and remove the members
+// of the fee allowance for a given granter and grantee
+//
+//nolint:interfacer
+func NewMsgUpdateAllowanceMembers(granter, grantee sdk.AccAddress, addMembers, removeMembers []sdk.AccAddress) MsgUpdateAllowanceMembers {
+	msg := MsgUpdateAllowanceMembers{Granter: granter.String(), Grantee: grantee.String()}
+	for _, member := range addMembers {
+		msg.AddMembers = append(msg.AddMembers, member.String())
+	}
+	for _, member := range removeMembers {
+		msg.RemoveMembers = append(msg.RemoveMembers, member.String())
+	}
+	return msg
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUpdateAllowanceMembers) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Granter); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid granter address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Grantee); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid grantee address: %s", err)
+	}
+	if msg.Grantee == msg.Granter {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "addresses must be different")
+	}
+	if len(msg.AddMembers) == 0 && len(msg.RemoveMembers) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no members to add or remove")
+	}
+
+	members := make(map[string]bool, len(msg.AddMembers)+len(msg.RemoveMembers))
+	for _, member := range append(msg.AddMembers, msg.RemoveMembers...) {
+		if _, err := sdk.AccAddressFromBech32(member); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid member address: %s", err)
+		}
+		if member == msg.Granter || member == msg.Grantee {
+			return sdkerrors.Wrap(ErrInvalidMember, "granter and grantee cannot be members")
+		}
+		if members[member] {
+			return sdkerrors.Wrapf(ErrInvalidMember, "duplicate member %s", member)
+		}
+		members[member] = true
+	}
+
+	return nil
+}
+
+// GetSigners gets the granter address associated with an Allowance
+// to update the members of.
+func (msg MsgUpdateAllowanceMembers) GetSigners() []sdk.AccAddress {
+	granter, _ := sdk.AccAddressFromBech32(msg.Granter)
+	return []sdk.AccAddress{granter}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgUpdateAllowanceMembers) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgUpdateAllowanceMembers) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgUpdateAllowanceMembers) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
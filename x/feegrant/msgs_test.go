@@ -134,6 +134,56 @@ func TestMsgRevokeAllowance(t *testing.T) {
 	}
 }
 
+func TestMsgUpdateAllowanceMembers(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32("cosmos1aeuqja06474dfrj7uqsvukm6rael982kk89mqr")
+	addr2, _ := sdk.AccAddressFromBech32("cosmos1nph3cfzk6trsmfxkeu943nvach5qw4vwstnvkl")
+	member := sdk.AccAddress("member______________")
+
+	cases := map[string]struct {
+		grantee, granter sdk.AccAddress
+		add, remove      []sdk.AccAddress
+		valid            bool
+	}{
+		"valid": {
+			granter: addr,
+			grantee: addr2,
+			add:     []sdk.AccAddress{member},
+			valid:   true,
+		},
+		"no members": {
+			granter: addr,
+			grantee: addr2,
+		},
+		"granter as member": {
+			granter: addr,
+			grantee: addr2,
+			add:     []sdk.AccAddress{addr},
+		},
+		"duplicate member": {
+			granter: addr,
+			grantee: addr2,
+			add:     []sdk.AccAddress{member},
+			remove:  []sdk.AccAddress{member},
+		},
+		"same address": {
+			granter: addr,
+			grantee: addr,
+			add:     []sdk.AccAddress{member},
+		},
+	}
+
+	for _, tc := range cases {
+		msg := feegrant.NewMsgUpdateAllowanceMembers(tc.granter, tc.grantee, tc.add, tc.remove)
+		err := msg.ValidateBasic()
+		if tc.valid {
+			require.NoError(t, err)
+			require.Equal(t, tc.granter, msg.GetSigners()[0])
+		} else {
+			require.Error(t, err)
+		}
+	}
+}
+
 func TestAminoJSON(t *testing.T) {
 	tx := legacytx.StdTx{}
 	var msg legacytx.LegacyMsg
@@ -353,6 +353,129 @@ func (m *QueryAllowancesByGranterResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryAllowanceMembersRequest is the request type for the Query/AllowanceMembers RPC method.
+//
+// Since: cosmos-sdk 0.47
+type QueryAllowanceMembersRequest struct {
+	// granter is the address of the user granting an allowance of their funds.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// grantee is the address of the user being granted an allowance of another user's funds.
+	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// pagination defines an pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllowanceMembersRequest) Reset()         { *m = QueryAllowanceMembersRequest{} }
+func (m *QueryAllowanceMembersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowanceMembersRequest) ProtoMessage()    {}
+func (*QueryAllowanceMembersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{6}
+}
+func (m *QueryAllowanceMembersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowanceMembersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowanceMembersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowanceMembersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowanceMembersRequest.Merge(m, src)
+}
+func (m *QueryAllowanceMembersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowanceMembersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowanceMembersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowanceMembersRequest proto.InternalMessageInfo
+
+func (m *QueryAllowanceMembersRequest) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *QueryAllowanceMembersRequest) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *QueryAllowanceMembersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllowanceMembersResponse is the response type for the Query/AllowanceMembers RPC method.
+//
+// Since: cosmos-sdk 0.47
+type QueryAllowanceMembersResponse struct {
+	// members are the addresses sharing the allowance.
+	Members []string `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	// pagination defines an pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllowanceMembersResponse) Reset()         { *m = QueryAllowanceMembersResponse{} }
+func (m *QueryAllowanceMembersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowanceMembersResponse) ProtoMessage()    {}
+func (*QueryAllowanceMembersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{7}
+}
+func (m *QueryAllowanceMembersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowanceMembersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowanceMembersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowanceMembersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowanceMembersResponse.Merge(m, src)
+}
+func (m *QueryAllowanceMembersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowanceMembersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowanceMembersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowanceMembersResponse proto.InternalMessageInfo
+
+func (m *QueryAllowanceMembersResponse) GetMembers() []string {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+func (m *QueryAllowanceMembersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryAllowanceRequest)(nil), "cosmos.feegrant.v1beta1.QueryAllowanceRequest")
 	proto.RegisterType((*QueryAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.QueryAllowanceResponse")
@@ -360,6 +483,8 @@ func init() {
 	proto.RegisterType((*QueryAllowancesResponse)(nil), "cosmos.feegrant.v1beta1.QueryAllowancesResponse")
 	proto.RegisterType((*QueryAllowancesByGranterRequest)(nil), "cosmos.feegrant.v1beta1.QueryAllowancesByGranterRequest")
 	proto.RegisterType((*QueryAllowancesByGranterResponse)(nil), "cosmos.feegrant.v1beta1.QueryAllowancesByGranterResponse")
+	proto.RegisterType((*QueryAllowanceMembersRequest)(nil), "cosmos.feegrant.v1beta1.QueryAllowanceMembersRequest")
+	proto.RegisterType((*QueryAllowanceMembersResponse)(nil), "cosmos.feegrant.v1beta1.QueryAllowanceMembersResponse")
 }
 
 func init() {
@@ -367,40 +492,45 @@ func init() {
 }
 
 var fileDescriptor_59efc303945de53f = []byte{
-	// 525 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x94, 0xbd, 0x8b, 0x13, 0x41,
-	0x18, 0xc6, 0x33, 0xe7, 0x17, 0x79, 0xaf, 0x1b, 0x3f, 0x2e, 0x2e, 0xb2, 0x86, 0x15, 0xee, 0xfc,
-	0x20, 0x3b, 0x26, 0xa2, 0x9c, 0x20, 0x07, 0x49, 0x61, 0x5a, 0x8d, 0x60, 0x61, 0x23, 0x93, 0xe4,
-	0x75, 0x5d, 0xcc, 0xed, 0xe4, 0x76, 0x36, 0xea, 0x21, 0x87, 0xe0, 0x5f, 0x20, 0x68, 0x2b, 0x82,
-	0x85, 0x8d, 0x96, 0xb6, 0xf6, 0x96, 0x87, 0x36, 0x96, 0x92, 0xf8, 0x87, 0x48, 0xe6, 0x63, 0x37,
-	0xe6, 0xb2, 0x64, 0x51, 0x0b, 0xab, 0x64, 0x76, 0x9f, 0xe7, 0xdd, 0xdf, 0xf3, 0xbe, 0x33, 0x03,
-	0xe7, 0x7a, 0x42, 0x6e, 0x0b, 0xc9, 0x1e, 0x20, 0x06, 0x31, 0x8f, 0x12, 0xf6, 0xb8, 0xde, 0xc5,
-	0x84, 0xd7, 0xd9, 0xce, 0x08, 0xe3, 0x5d, 0x7f, 0x18, 0x8b, 0x44, 0xd0, 0x35, 0x2d, 0xf2, 0xad,
-	0xc8, 0x37, 0x22, 0x67, 0x3d, 0xcf, 0x9d, 0x2a, 0x55, 0x01, 0xe7, 0xa2, 0xd1, 0x75, 0xb9, 0x44,
-	0x5d, 0x39, 0x55, 0x0e, 0x79, 0x10, 0x46, 0x3c, 0x09, 0x45, 0x64, 0xb4, 0x67, 0x02, 0x21, 0x82,
-	0x01, 0x32, 0x3e, 0x0c, 0x19, 0x8f, 0x22, 0x91, 0xa8, 0x97, 0xd2, 0xbc, 0x3d, 0xad, 0x2b, 0xdd,
-	0x57, 0x2b, 0x66, 0xb8, 0xd4, 0xc2, 0x7b, 0x0e, 0x27, 0x6f, 0x4f, 0x4b, 0x37, 0x07, 0x03, 0xf1,
-	0x84, 0x47, 0x3d, 0xec, 0xe0, 0xce, 0x08, 0x65, 0x42, 0x1b, 0x70, 0x4c, 0xc1, 0x60, 0x5c, 0x21,
-	0x55, 0x72, 0xbe, 0xdc, 0xaa, 0x7c, 0xfd, 0x54, 0x3b, 0x61, 0xbc, 0xcd, 0x7e, 0x3f, 0x46, 0x29,
-	0xef, 0x24, 0x71, 0x18, 0x05, 0x1d, 0x2b, 0xcc, 0x3c, 0x58, 0x59, 0x29, 0xe6, 0x41, 0xef, 0x2e,
-	0x9c, 0x9a, 0x07, 0x90, 0x43, 0x11, 0x49, 0xa4, 0x37, 0xa0, 0xcc, 0xed, 0x43, 0xc5, 0xb0, 0xda,
-	0x70, 0xfd, 0x9c, 0xa6, 0xfa, 0xed, 0xe9, 0xaa, 0x93, 0x19, 0xbc, 0xd7, 0x64, 0xbe, 0xb0, 0x3c,
-	0x10, 0x0d, 0x8b, 0x46, 0x43, 0x7a, 0x13, 0x20, 0x6b, 0xba, 0x4a, 0xb7, 0xda, 0x58, 0xb7, 0x34,
-	0xd3, 0x09, 0xf9, 0x7a, 0xf6, 0x96, 0xe7, 0x16, 0x0f, 0x6c, 0x2b, 0x3b, 0x33, 0x4e, 0xef, 0x1d,
-	0x81, 0xb5, 0x03, 0x58, 0x26, 0xf0, 0x16, 0x40, 0xca, 0x2f, 0x2b, 0xa4, 0x7a, 0xa8, 0x40, 0xe2,
-	0x19, 0x07, 0x6d, 0x2f, 0x60, 0xdc, 0x58, 0xca, 0xa8, 0x3f, 0xfe, 0x1b, 0xe4, 0x1b, 0x02, 0x67,
-	0xe7, 0x20, 0x5b, 0xbb, 0x6d, 0x3d, 0xe4, 0xbf, 0xd9, 0x1f, 0xff, 0xaa, 0x89, 0x1f, 0x08, 0x54,
-	0xf3, 0xf9, 0xfe, 0xb3, 0x6e, 0x36, 0xde, 0x1e, 0x86, 0x23, 0x8a, 0x96, 0x7e, 0x24, 0x50, 0x4e,
-	0x91, 0xa9, 0x9f, 0x0b, 0xb3, 0xf0, 0x44, 0x3a, 0xac, 0xb0, 0x5e, 0x43, 0x78, 0x5b, 0x2f, 0xbe,
-	0xfd, 0x7c, 0xb5, 0xb2, 0x49, 0xaf, 0xb1, 0xbc, 0x1b, 0x27, 0x8d, 0xcb, 0x9e, 0x99, 0x19, 0xed,
-	0xd9, 0x7f, 0xb8, 0x47, 0xdf, 0x13, 0x80, 0xac, 0xc3, 0xb4, 0xe8, 0xf7, 0xed, 0x39, 0x73, 0x2e,
-	0x17, 0x37, 0x18, 0xe2, 0xab, 0x8a, 0x98, 0xd1, 0xda, 0x72, 0x62, 0x39, 0x03, 0xfa, 0x99, 0xc0,
-	0xf1, 0x05, 0x5b, 0x81, 0x6e, 0x16, 0x05, 0x98, 0xdf, 0xdd, 0xce, 0xf5, 0x3f, 0x70, 0x9a, 0x0c,
-	0x75, 0x95, 0xe1, 0x12, 0xbd, 0x90, 0x9b, 0x21, 0x94, 0x72, 0x84, 0xfd, 0xac, 0xe5, 0xad, 0xe6,
-	0x97, 0xb1, 0x4b, 0xf6, 0xc7, 0x2e, 0xf9, 0x31, 0x76, 0xc9, 0xcb, 0x89, 0x5b, 0xda, 0x9f, 0xb8,
-	0xa5, 0xef, 0x13, 0xb7, 0x74, 0x6f, 0x23, 0x08, 0x93, 0x87, 0xa3, 0xae, 0xdf, 0x13, 0xdb, 0xb6,
-	0x9c, 0xfe, 0xa9, 0xc9, 0xfe, 0x23, 0xf6, 0x34, 0xad, 0xdd, 0x3d, 0xaa, 0xae, 0xf3, 0x2b, 0xbf,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0x67, 0xde, 0x8f, 0xb5, 0x9b, 0x06, 0x00, 0x00,
+	// 603 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xbf, 0x6f, 0xd3, 0x4e,
+	0x18, 0xc6, 0x73, 0xad, 0xfa, 0xad, 0xf2, 0x76, 0xf9, 0xea, 0xf8, 0xd1, 0x60, 0x15, 0x13, 0x05,
+	0xa9, 0xe5, 0x87, 0xe2, 0x23, 0x41, 0xad, 0x8a, 0x84, 0x22, 0x92, 0xa1, 0x99, 0x90, 0x20, 0x48,
+	0x0c, 0x2c, 0xc8, 0x49, 0x5e, 0x8c, 0x45, 0xe2, 0x4b, 0x7d, 0x0e, 0x50, 0xa1, 0x0a, 0x89, 0xbf,
+	0x00, 0x09, 0x46, 0x58, 0x18, 0x58, 0x60, 0x64, 0x65, 0x87, 0x05, 0x55, 0xb0, 0x30, 0xa2, 0x84,
+	0x8d, 0x7f, 0x02, 0xe5, 0x7c, 0xb6, 0x13, 0x37, 0xa6, 0x26, 0x54, 0x88, 0x29, 0x39, 0xfb, 0x79,
+	0xee, 0x3e, 0xef, 0x73, 0xef, 0x9d, 0x0c, 0xa7, 0x5b, 0x5c, 0x74, 0xb9, 0x60, 0x77, 0x10, 0x2d,
+	0xd7, 0x74, 0x3c, 0x76, 0xbf, 0xd4, 0x44, 0xcf, 0x2c, 0xb1, 0xed, 0x3e, 0xba, 0x3b, 0x46, 0xcf,
+	0xe5, 0x1e, 0xa7, 0xcb, 0xbe, 0xc8, 0x08, 0x44, 0x86, 0x12, 0x69, 0xab, 0x49, 0xee, 0x50, 0x29,
+	0x27, 0xd0, 0xce, 0x29, 0x5d, 0xd3, 0x14, 0xe8, 0xcf, 0x1c, 0x2a, 0x7b, 0xa6, 0x65, 0x3b, 0xa6,
+	0x67, 0x73, 0x47, 0x69, 0x57, 0x2c, 0xce, 0xad, 0x0e, 0x32, 0xb3, 0x67, 0x33, 0xd3, 0x71, 0xb8,
+	0x27, 0x5f, 0x0a, 0xf5, 0xf6, 0x84, 0x3f, 0xd3, 0x6d, 0x39, 0x62, 0x8a, 0x4b, 0x0e, 0x0a, 0x8f,
+	0xe1, 0xd8, 0xf5, 0xd1, 0xd4, 0xd5, 0x4e, 0x87, 0x3f, 0x30, 0x9d, 0x16, 0x36, 0x70, 0xbb, 0x8f,
+	0xc2, 0xa3, 0x65, 0x58, 0x94, 0x30, 0xe8, 0xe6, 0x48, 0x9e, 0x9c, 0xc9, 0xd6, 0x72, 0x9f, 0xdf,
+	0x15, 0x8f, 0x2a, 0x6f, 0xb5, 0xdd, 0x76, 0x51, 0x88, 0x1b, 0x9e, 0x6b, 0x3b, 0x56, 0x23, 0x10,
+	0x46, 0x1e, 0xcc, 0xcd, 0xa5, 0xf3, 0x60, 0xe1, 0x26, 0x1c, 0x8f, 0x03, 0x88, 0x1e, 0x77, 0x04,
+	0xd2, 0xcb, 0x90, 0x35, 0x83, 0x87, 0x92, 0x61, 0xa9, 0xac, 0x1b, 0x09, 0xa1, 0x1a, 0xf5, 0xd1,
+	0xa8, 0x11, 0x19, 0x0a, 0xcf, 0x49, 0x7c, 0x62, 0xb1, 0xaf, 0x34, 0x4c, 0x5b, 0x1a, 0xd2, 0x2d,
+	0x80, 0x28, 0x74, 0x59, 0xdd, 0x52, 0x79, 0x35, 0xa0, 0x19, 0xed, 0x90, 0xe1, 0xef, 0x7d, 0xc0,
+	0x73, 0xcd, 0xb4, 0x82, 0x28, 0x1b, 0x63, 0xce, 0xc2, 0x2b, 0x02, 0xcb, 0xfb, 0xb0, 0x54, 0xc1,
+	0x15, 0x80, 0x90, 0x5f, 0xe4, 0x48, 0x7e, 0x3e, 0x45, 0xc5, 0x63, 0x0e, 0x5a, 0x9f, 0xc2, 0xb8,
+	0x76, 0x20, 0xa3, 0xbf, 0xf8, 0x04, 0xe4, 0x4b, 0x02, 0xa7, 0x62, 0x90, 0xb5, 0x9d, 0xba, 0xbf,
+	0xc9, 0x7f, 0xd2, 0x1f, 0x87, 0x15, 0xe2, 0x1b, 0x02, 0xf9, 0x64, 0xbe, 0x7f, 0x2d, 0xcd, 0x4f,
+	0x04, 0x56, 0x26, 0x69, 0xaf, 0x62, 0xb7, 0x89, 0xae, 0xf8, 0xcb, 0x47, 0x2d, 0x16, 0xff, 0xfc,
+	0xcc, 0xf1, 0xbf, 0x20, 0x70, 0x32, 0xa1, 0x20, 0x95, 0x7d, 0x19, 0x16, 0xbb, 0xfe, 0x23, 0x19,
+	0xfc, 0x2f, 0xe9, 0x94, 0xf0, 0xd0, 0xf2, 0x2e, 0xff, 0x58, 0x80, 0x05, 0x89, 0x47, 0xdf, 0x12,
+	0xc8, 0x86, 0x8c, 0xd4, 0x48, 0xdc, 0xfc, 0xa9, 0x37, 0xa0, 0xc6, 0x52, 0xeb, 0x7d, 0x88, 0x42,
+	0xe5, 0xc9, 0x97, 0xef, 0xcf, 0xe6, 0x36, 0xe9, 0x06, 0x4b, 0xba, 0xe1, 0xc3, 0xf6, 0x62, 0x8f,
+	0xd4, 0x46, 0xee, 0x06, 0xff, 0x70, 0x97, 0xbe, 0x26, 0x00, 0x51, 0x47, 0xd3, 0xb4, 0xeb, 0x07,
+	0x7d, 0xa4, 0x5d, 0x48, 0x6f, 0x50, 0xc4, 0xeb, 0x92, 0x98, 0xd1, 0xe2, 0xc1, 0xc4, 0x62, 0x0c,
+	0xf4, 0x3d, 0x81, 0x23, 0x53, 0x8e, 0x1e, 0xdd, 0x4c, 0x0b, 0x10, 0xbf, 0x4d, 0xb4, 0x4b, 0x33,
+	0x38, 0x55, 0x0d, 0x25, 0x59, 0xc3, 0x79, 0x7a, 0x36, 0xb1, 0x06, 0x5b, 0x88, 0x3e, 0xb6, 0xa3,
+	0xc8, 0xe9, 0x47, 0x02, 0xff, 0xc7, 0x7b, 0x97, 0xae, 0xa7, 0x44, 0x98, 0x3c, 0xbc, 0xda, 0xc6,
+	0xef, 0xda, 0x14, 0xf6, 0x96, 0xc4, 0xbe, 0x42, 0x2b, 0xb3, 0x35, 0x0b, 0x53, 0xc7, 0xa6, 0x56,
+	0xfd, 0x30, 0xd0, 0xc9, 0xde, 0x40, 0x27, 0xdf, 0x06, 0x3a, 0x79, 0x3a, 0xd4, 0x33, 0x7b, 0x43,
+	0x3d, 0xf3, 0x75, 0xa8, 0x67, 0x6e, 0xad, 0x59, 0xb6, 0x77, 0xb7, 0xdf, 0x34, 0x5a, 0xbc, 0x1b,
+	0xac, 0xe1, 0xff, 0x14, 0x45, 0xfb, 0x1e, 0x7b, 0x18, 0x2e, 0xd8, 0xfc, 0x4f, 0x7e, 0x0a, 0x5c,
+	0xfc, 0x39, 0x00, 0x47, 0xc9, 0x58, 0xe9, 0xd7, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -423,6 +553,11 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	AllowancesByGranter(ctx context.Context, in *QueryAllowancesByGranterRequest, opts ...grpc.CallOption) (*QueryAllowancesByGranterResponse, error)
+	// AllowanceMembers returns the accounts sharing the allowance granted to the
+	// grantee by the granter.
+	//
+	// Since: cosmos-sdk 0.47
+	AllowanceMembers(ctx context.Context, in *QueryAllowanceMembersRequest, opts ...grpc.CallOption) (*QueryAllowanceMembersResponse, error)
 }
 
 type queryClient struct {
@@ -460,6 +595,15 @@ func (c *queryClient) AllowancesByGranter(ctx context.Context, in *QueryAllowanc
 	return out, nil
 }
 
+func (c *queryClient) AllowanceMembers(ctx context.Context, in *QueryAllowanceMembersRequest, opts ...grpc.CallOption) (*QueryAllowanceMembersResponse, error) {
+	out := new(QueryAllowanceMembersResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Query/AllowanceMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Allowance returns fee granted to the grantee by the granter.
@@ -470,6 +614,11 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	AllowancesByGranter(context.Context, *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error)
+	// AllowanceMembers returns the accounts sharing the allowance granted to the
+	// grantee by the granter.
+	//
+	// Since: cosmos-sdk 0.47
+	AllowanceMembers(context.Context, *QueryAllowanceMembersRequest) (*QueryAllowanceMembersResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -485,6 +634,9 @@ func (*UnimplementedQueryServer) Allowances(ctx context.Context, req *QueryAllow
 func (*UnimplementedQueryServer) AllowancesByGranter(ctx context.Context, req *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllowancesByGranter not implemented")
 }
+func (*UnimplementedQueryServer) AllowanceMembers(ctx context.Context, req *QueryAllowanceMembersRequest) (*QueryAllowanceMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowanceMembers not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -544,6 +696,24 @@ func _Query_AllowancesByGranter_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AllowanceMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowanceMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowanceMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Query/AllowanceMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowanceMembers(ctx, req.(*QueryAllowanceMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.feegrant.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -560,6 +730,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllowancesByGranter",
 			Handler:    _Query_AllowancesByGranter_Handler,
 		},
+		{
+			MethodName: "AllowanceMembers",
+			Handler:    _Query_AllowanceMembers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/query.proto",
@@ -819,6 +993,99 @@ func (m *QueryAllowancesByGranterResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryAllowanceMembersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowanceMembersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowanceMembersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowanceMembersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowanceMembersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowanceMembersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Members[iNdEx])
+			copy(dAtA[i:], m.Members[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Members[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -932,6 +1199,46 @@ func (m *QueryAllowancesByGranterResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryAllowanceMembersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowanceMembersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for _, s := range m.Members {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1614,6 +1921,274 @@ func (m *QueryAllowancesByGranterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryAllowanceMembersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowanceMembersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowanceMembersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowanceMembersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowanceMembersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowanceMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -224,41 +224,164 @@ func (m *MsgRevokeAllowanceResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRevokeAllowanceResponse proto.InternalMessageInfo
 
+// MsgUpdateAllowanceMembers adds and removes the accounts sharing the Allowance
+// from Granter to Grantee. Members without an allowance of their own from
+// Granter have their fees paid out of the shared Allowance.
+//
+// Since: cosmos-sdk 0.47
+type MsgUpdateAllowanceMembers struct {
+	// granter is the address of the user granting an allowance of their funds.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// grantee is the address of the user being granted an allowance of another user's funds.
+	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// add_members are the addresses to add as members of the allowance.
+	AddMembers []string `protobuf:"bytes,3,rep,name=add_members,json=addMembers,proto3" json:"add_members,omitempty"`
+	// remove_members are the addresses to remove from the members of the allowance.
+	RemoveMembers []string `protobuf:"bytes,4,rep,name=remove_members,json=removeMembers,proto3" json:"remove_members,omitempty"`
+}
+
+func (m *MsgUpdateAllowanceMembers) Reset()         { *m = MsgUpdateAllowanceMembers{} }
+func (m *MsgUpdateAllowanceMembers) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAllowanceMembers) ProtoMessage()    {}
+func (*MsgUpdateAllowanceMembers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{4}
+}
+func (m *MsgUpdateAllowanceMembers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAllowanceMembers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAllowanceMembers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateAllowanceMembers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAllowanceMembers.Merge(m, src)
+}
+func (m *MsgUpdateAllowanceMembers) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAllowanceMembers) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAllowanceMembers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateAllowanceMembers proto.InternalMessageInfo
+
+func (m *MsgUpdateAllowanceMembers) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *MsgUpdateAllowanceMembers) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *MsgUpdateAllowanceMembers) GetAddMembers() []string {
+	if m != nil {
+		return m.AddMembers
+	}
+	return nil
+}
+
+func (m *MsgUpdateAllowanceMembers) GetRemoveMembers() []string {
+	if m != nil {
+		return m.RemoveMembers
+	}
+	return nil
+}
+
+// MsgUpdateAllowanceMembersResponse defines the Msg/UpdateAllowanceMembers response type.
+//
+// Since: cosmos-sdk 0.47
+type MsgUpdateAllowanceMembersResponse struct {
+}
+
+func (m *MsgUpdateAllowanceMembersResponse) Reset()         { *m = MsgUpdateAllowanceMembersResponse{} }
+func (m *MsgUpdateAllowanceMembersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAllowanceMembersResponse) ProtoMessage()    {}
+func (*MsgUpdateAllowanceMembersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{5}
+}
+func (m *MsgUpdateAllowanceMembersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAllowanceMembersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAllowanceMembersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateAllowanceMembersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAllowanceMembersResponse.Merge(m, src)
+}
+func (m *MsgUpdateAllowanceMembersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAllowanceMembersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAllowanceMembersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateAllowanceMembersResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgGrantAllowance)(nil), "cosmos.feegrant.v1beta1.MsgGrantAllowance")
 	proto.RegisterType((*MsgGrantAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.MsgGrantAllowanceResponse")
 	proto.RegisterType((*MsgRevokeAllowance)(nil), "cosmos.feegrant.v1beta1.MsgRevokeAllowance")
 	proto.RegisterType((*MsgRevokeAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.MsgRevokeAllowanceResponse")
+	proto.RegisterType((*MsgUpdateAllowanceMembers)(nil), "cosmos.feegrant.v1beta1.MsgUpdateAllowanceMembers")
+	proto.RegisterType((*MsgUpdateAllowanceMembersResponse)(nil), "cosmos.feegrant.v1beta1.MsgUpdateAllowanceMembersResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/feegrant/v1beta1/tx.proto", fileDescriptor_dd44ad7946dad783) }
 
 var fileDescriptor_dd44ad7946dad783 = []byte{
-	// 376 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x4b, 0x4d, 0x4d, 0x2f, 0x4a, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x87, 0xa8,
-	0xd0, 0x83, 0xa9, 0xd0, 0x83, 0xaa, 0x90, 0x92, 0x4c, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5, 0x07,
-	0x2b, 0x4b, 0x2a, 0x4d, 0xd3, 0x4f, 0xcc, 0xab, 0x84, 0xe8, 0x91, 0x92, 0x84, 0xe8, 0x89, 0x07,
-	0xf3, 0xf4, 0xa1, 0x06, 0x40, 0xa4, 0xa0, 0xc6, 0xe9, 0xe7, 0x16, 0xa7, 0xeb, 0x97, 0x19, 0x82,
-	0x28, 0x88, 0x84, 0xd2, 0x05, 0x46, 0x2e, 0x41, 0xdf, 0xe2, 0x74, 0x77, 0x90, 0x1d, 0x8e, 0x39,
-	0x39, 0xf9, 0xe5, 0x89, 0x79, 0xc9, 0xa9, 0x42, 0x46, 0x5c, 0xec, 0x60, 0x5b, 0x53, 0x8b, 0x24,
-	0x18, 0x15, 0x18, 0x35, 0x38, 0x9d, 0x24, 0x2e, 0x6d, 0xd1, 0x15, 0x81, 0x9a, 0xe8, 0x98, 0x92,
-	0x52, 0x94, 0x5a, 0x5c, 0x1c, 0x5c, 0x52, 0x94, 0x99, 0x97, 0x1e, 0x04, 0x53, 0x88, 0xd0, 0x93,
-	0x2a, 0xc1, 0x44, 0x9c, 0x9e, 0x54, 0x21, 0x57, 0x2e, 0xce, 0x44, 0x98, 0xa5, 0x12, 0xcc, 0x0a,
-	0x8c, 0x1a, 0xdc, 0x46, 0x22, 0x7a, 0x10, 0x0f, 0xea, 0xc1, 0x3c, 0xa8, 0xe7, 0x98, 0x57, 0xe9,
-	0x24, 0x78, 0x6a, 0x8b, 0x2e, 0xaf, 0x5b, 0x6a, 0x2a, 0xdc, 0x89, 0x9e, 0x41, 0x08, 0x9d, 0x56,
-	0x3c, 0x4d, 0xcf, 0x37, 0x68, 0xc1, 0x1c, 0xa2, 0x24, 0xcd, 0x25, 0x89, 0xe1, 0xa3, 0xa0, 0xd4,
-	0xe2, 0x82, 0xfc, 0xbc, 0xe2, 0x54, 0xa5, 0x2e, 0x46, 0x2e, 0x21, 0xdf, 0xe2, 0xf4, 0xa0, 0xd4,
-	0xb2, 0xfc, 0xec, 0x54, 0xba, 0x7b, 0x18, 0xcd, 0xa5, 0x32, 0x5c, 0x52, 0x98, 0x6e, 0x81, 0x39,
-	0xd5, 0xe8, 0x0d, 0x23, 0x17, 0xb3, 0x6f, 0x71, 0xba, 0x50, 0x01, 0x17, 0x1f, 0x5a, 0xf4, 0x68,
-	0xe9, 0xe1, 0x48, 0x1d, 0x7a, 0x18, 0x1e, 0x97, 0x32, 0x22, 0x5e, 0x2d, 0xcc, 0x66, 0xa1, 0x62,
-	0x2e, 0x7e, 0xf4, 0x00, 0xd2, 0xc6, 0x67, 0x0c, 0x9a, 0x62, 0x29, 0x63, 0x12, 0x14, 0xc3, 0x2c,
-	0x75, 0x72, 0x3c, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27,
-	0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xf5, 0xf4, 0xcc,
-	0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x68, 0xaa, 0x86, 0x52, 0xba, 0xc5, 0x29, 0xd9,
-	0xfa, 0x15, 0xf0, 0x5c, 0x94, 0xc4, 0x06, 0x4e, 0x33, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xc0, 0xb7, 0x32, 0x99, 0x5f, 0x03, 0x00, 0x00,
+	// 464 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x3f, 0x6f, 0xd3, 0x40,
+	0x14, 0xcf, 0x25, 0x08, 0xd4, 0x2b, 0x2d, 0xaa, 0x55, 0x81, 0x63, 0x90, 0x15, 0xc2, 0x40, 0x55,
+	0x94, 0x3b, 0x25, 0x9d, 0xe8, 0x82, 0x1c, 0x09, 0x10, 0x83, 0x17, 0x23, 0x16, 0x96, 0xea, 0x9c,
+	0x7b, 0x3d, 0xaa, 0xc6, 0x3e, 0xcb, 0xe7, 0x9a, 0x76, 0x65, 0x42, 0x48, 0x48, 0x7c, 0x14, 0x86,
+	0x7e, 0x08, 0xc4, 0x14, 0x31, 0x31, 0xa2, 0x64, 0xe0, 0x23, 0xb0, 0xa2, 0xfa, 0x7c, 0x8e, 0xe4,
+	0x90, 0xd0, 0x2e, 0x9d, 0x4e, 0xd6, 0xfb, 0xfd, 0x7b, 0xcf, 0xef, 0x0e, 0x77, 0x46, 0x52, 0x45,
+	0x52, 0xd1, 0x43, 0x00, 0x91, 0xb2, 0x38, 0xa3, 0x79, 0x3f, 0x84, 0x8c, 0xf5, 0x69, 0x76, 0x4a,
+	0x92, 0x54, 0x66, 0xd2, 0xba, 0xa7, 0x11, 0xc4, 0x20, 0x48, 0x89, 0x70, 0xda, 0x42, 0x4a, 0x31,
+	0x06, 0x5a, 0xc0, 0xc2, 0x93, 0x43, 0xca, 0xe2, 0x33, 0xcd, 0x71, 0xda, 0x9a, 0x73, 0x50, 0x7c,
+	0xd1, 0x52, 0x40, 0x97, 0x4a, 0x39, 0x1a, 0x29, 0x41, 0xf3, 0xfe, 0xc5, 0xa1, 0x0b, 0xdd, 0x09,
+	0xc2, 0x5b, 0xbe, 0x12, 0x2f, 0x2f, 0x3c, 0xbc, 0xf1, 0x58, 0xbe, 0x67, 0xf1, 0x08, 0xac, 0x01,
+	0xbe, 0x55, 0xb8, 0x42, 0x6a, 0xa3, 0x0e, 0xda, 0x59, 0x1b, 0xda, 0x3f, 0xce, 0x7b, 0xdb, 0xa5,
+	0xa2, 0xc7, 0x79, 0x0a, 0x4a, 0xbd, 0xce, 0xd2, 0xa3, 0x58, 0x04, 0x06, 0x38, 0xe7, 0x80, 0xdd,
+	0xbc, 0x1c, 0x07, 0xac, 0xe7, 0x78, 0x8d, 0x19, 0x53, 0xbb, 0xd5, 0x41, 0x3b, 0xeb, 0x83, 0x6d,
+	0xa2, 0x1b, 0x24, 0xa6, 0x41, 0xe2, 0xc5, 0x67, 0xc3, 0xad, 0xef, 0xe7, 0xbd, 0x8d, 0x17, 0x00,
+	0x55, 0xc4, 0x57, 0xc1, 0x9c, 0xb9, 0x7f, 0xfb, 0xc3, 0xef, 0xaf, 0xbb, 0x26, 0x48, 0xf7, 0x3e,
+	0x6e, 0x2f, 0x74, 0x14, 0x80, 0x4a, 0x64, 0xac, 0xa0, 0xfb, 0x09, 0x61, 0xcb, 0x57, 0x22, 0x80,
+	0x5c, 0x1e, 0xc3, 0xb5, 0x37, 0x5c, 0x4b, 0xfa, 0x00, 0x3b, 0x8b, 0x59, 0xaa, 0xa8, 0x9f, 0x9b,
+	0x45, 0x23, 0x6f, 0x12, 0xce, 0xb2, 0x79, 0xd9, 0x87, 0x28, 0x84, 0x54, 0x5d, 0xdb, 0x2f, 0x7a,
+	0x8a, 0xd7, 0x19, 0xe7, 0x07, 0x91, 0xb6, 0xb5, 0x5b, 0x9d, 0xd6, 0x4a, 0x1e, 0x66, 0x9c, 0x9b,
+	0x88, 0xcf, 0xf0, 0x66, 0x0a, 0x91, 0xcc, 0xa1, 0x62, 0xdf, 0xf8, 0x0f, 0x7b, 0x43, 0xe3, 0x4b,
+	0x81, 0xda, 0xb4, 0x1e, 0xe1, 0x87, 0x4b, 0xc7, 0x61, 0x86, 0x36, 0xf8, 0xd3, 0xc4, 0x2d, 0x5f,
+	0x09, 0x2b, 0xc1, 0x9b, 0xb5, 0x9d, 0xde, 0x25, 0x4b, 0xae, 0x14, 0x59, 0xd8, 0x16, 0x67, 0x70,
+	0x79, 0xac, 0x71, 0xb6, 0x14, 0xbe, 0x53, 0xdf, 0xaa, 0x27, 0xab, 0x64, 0x6a, 0x60, 0x67, 0xef,
+	0x0a, 0xe0, 0xca, 0xf4, 0x23, 0xc2, 0x77, 0x97, 0x2d, 0xc8, 0x2a, 0xbd, 0x7f, 0x73, 0x9c, 0xfd,
+	0xab, 0x73, 0x4c, 0x94, 0xa1, 0xf7, 0x6d, 0xea, 0xa2, 0xc9, 0xd4, 0x45, 0xbf, 0xa6, 0x2e, 0xfa,
+	0x32, 0x73, 0x1b, 0x93, 0x99, 0xdb, 0xf8, 0x39, 0x73, 0x1b, 0x6f, 0x1f, 0x8b, 0xa3, 0xec, 0xdd,
+	0x49, 0x48, 0x46, 0x32, 0x2a, 0x5f, 0xa5, 0xf2, 0xe8, 0x29, 0x7e, 0x4c, 0x4f, 0xab, 0x57, 0x30,
+	0xbc, 0x59, 0xdc, 0xf9, 0xbd, 0xbf, 0x03, 0x00, 0xa6, 0xc8, 0xba, 0x18, 0x1f, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -279,6 +402,11 @@ type MsgClient interface {
 	// RevokeAllowance revokes any fee allowance of granter's account that
 	// has been granted to the grantee.
 	RevokeAllowance(ctx context.Context, in *MsgRevokeAllowance, opts ...grpc.CallOption) (*MsgRevokeAllowanceResponse, error)
+	// UpdateAllowanceMembers adds and removes the accounts sharing the fee
+	// allowance of granter's account granted to the grantee.
+	//
+	// Since: cosmos-sdk 0.47
+	UpdateAllowanceMembers(ctx context.Context, in *MsgUpdateAllowanceMembers, opts ...grpc.CallOption) (*MsgUpdateAllowanceMembersResponse, error)
 }
 
 type msgClient struct {
@@ -307,6 +435,15 @@ func (c *msgClient) RevokeAllowance(ctx context.Context, in *MsgRevokeAllowance,
 	return out, nil
 }
 
+func (c *msgClient) UpdateAllowanceMembers(ctx context.Context, in *MsgUpdateAllowanceMembers, opts ...grpc.CallOption) (*MsgUpdateAllowanceMembersResponse, error) {
+	out := new(MsgUpdateAllowanceMembersResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Msg/UpdateAllowanceMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// GrantAllowance grants fee allowance to the grantee on the granter's
@@ -315,6 +452,11 @@ type MsgServer interface {
 	// RevokeAllowance revokes any fee allowance of granter's account that
 	// has been granted to the grantee.
 	RevokeAllowance(context.Context, *MsgRevokeAllowance) (*MsgRevokeAllowanceResponse, error)
+	// UpdateAllowanceMembers adds and removes the accounts sharing the fee
+	// allowance of granter's account granted to the grantee.
+	//
+	// Since: cosmos-sdk 0.47
+	UpdateAllowanceMembers(context.Context, *MsgUpdateAllowanceMembers) (*MsgUpdateAllowanceMembersResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -327,6 +469,9 @@ func (*UnimplementedMsgServer) GrantAllowance(ctx context.Context, req *MsgGrant
 func (*UnimplementedMsgServer) RevokeAllowance(ctx context.Context, req *MsgRevokeAllowance) (*MsgRevokeAllowanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RevokeAllowance not implemented")
 }
+func (*UnimplementedMsgServer) UpdateAllowanceMembers(ctx context.Context, req *MsgUpdateAllowanceMembers) (*MsgUpdateAllowanceMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAllowanceMembers not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -368,6 +513,24 @@ func _Msg_RevokeAllowance_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateAllowanceMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateAllowanceMembers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateAllowanceMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Msg/UpdateAllowanceMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateAllowanceMembers(ctx, req.(*MsgUpdateAllowanceMembers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.feegrant.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -380,6 +543,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RevokeAllowance",
 			Handler:    _Msg_RevokeAllowance_Handler,
 		},
+		{
+			MethodName: "UpdateAllowanceMembers",
+			Handler:    _Msg_UpdateAllowanceMembers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/tx.proto",
@@ -517,6 +684,84 @@ func (m *MsgRevokeAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateAllowanceMembers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAllowanceMembers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAllowanceMembers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemoveMembers) > 0 {
+		for iNdEx := len(m.RemoveMembers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemoveMembers[iNdEx])
+			copy(dAtA[i:], m.RemoveMembers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.RemoveMembers[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AddMembers) > 0 {
+		for iNdEx := len(m.AddMembers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AddMembers[iNdEx])
+			copy(dAtA[i:], m.AddMembers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AddMembers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAllowanceMembersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAllowanceMembersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAllowanceMembersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -584,6 +829,44 @@ func (m *MsgRevokeAllowanceResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateAllowanceMembers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.AddMembers) > 0 {
+		for _, s := range m.AddMembers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.RemoveMembers) > 0 {
+		for _, s := range m.RemoveMembers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateAllowanceMembersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -954,6 +1237,234 @@ func (m *MsgRevokeAllowanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateAllowanceMembers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAllowanceMembers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAllowanceMembers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddMembers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddMembers = append(m.AddMembers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveMembers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoveMembers = append(m.RemoveMembers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAllowanceMembersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAllowanceMembersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAllowanceMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -47,7 +47,46 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 	keeper.IterateActiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal v1.Proposal) bool {
 		var tagValue, logMsg string
 
-		passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
+		// Tally deletes the votes it counts. An expedited proposal is tallied
+		// in a cached context, so that its votes are kept if it is converted
+		// into a regular proposal.
+		tallyCtx, writeTally := ctx, func() {}
+		if proposal.Expedited {
+			tallyCtx, writeTally = ctx.CacheContext()
+		}
+
+		passes, burnDeposits, tallyResults := keeper.Tally(tallyCtx, proposal)
+
+		// An expedited proposal that does not pass is converted into a regular
+		// proposal which keeps its votes and deposits until the end of the
+		// regular voting period, counted from its voting start time.
+		if proposal.Expedited && !passes {
+			keeper.RemoveFromActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
+
+			endTime := proposal.VotingStartTime.Add(keeper.GetParams(ctx).ProposalVotingPeriod(false))
+			proposal.VotingEndTime = &endTime
+			proposal.Expedited = false
+
+			keeper.SetProposal(ctx, proposal)
+			keeper.InsertActiveProposalQueue(ctx, proposal.Id, endTime)
+
+			logger.Info(
+				"expedited proposal converted to regular",
+				"proposal", proposal.Id,
+				"voting_end_time", endTime,
+			)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeActiveProposal,
+					sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+					sdk.NewAttribute(types.AttributeKeyProposalResult, types.AttributeValueExpeditedProposalRejected),
+				),
+			)
+			return false
+		}
+
+		writeTally()
 
 		if burnDeposits {
 			keeper.DeleteAndBurnDeposits(ctx, proposal.Id)
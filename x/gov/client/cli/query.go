@@ -38,6 +38,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryDeposit(),
 		GetCmdQueryDeposits(),
 		GetCmdQueryTally(),
+		GetCmdQuerySimulateProposal(),
 	)
 
 	return govQueryCmd
@@ -530,39 +531,17 @@ $ %s query gov params
 			}
 			queryClient := v1.NewQueryClient(clientCtx)
 
-			// Query store for all 3 params
-			ctx := cmd.Context()
-			votingRes, err := queryClient.Params(
-				ctx,
-				&v1.QueryParamsRequest{ParamsType: "voting"},
-			)
-			if err != nil {
-				return err
-			}
-
-			tallyRes, err := queryClient.Params(
-				ctx,
-				&v1.QueryParamsRequest{ParamsType: "tallying"},
-			)
-			if err != nil {
-				return err
-			}
-
-			depositRes, err := queryClient.Params(
-				ctx,
-				&v1.QueryParamsRequest{ParamsType: "deposit"},
+			// Query the store for all the params, the params type only selects
+			// which deprecated field is filled in the response.
+			res, err := queryClient.Params(
+				cmd.Context(),
+				&v1.QueryParamsRequest{ParamsType: v1.ParamDeposit},
 			)
 			if err != nil {
 				return err
 			}
 
-			params := v1.NewParams(
-				*votingRes.GetVotingParams(),
-				*tallyRes.GetTallyParams(),
-				*depositRes.GetDepositParams(),
-			)
-
-			return clientCtx.PrintObjectLegacy(params)
+			return clientCtx.PrintProto(res.Params)
 		},
 	}
 
@@ -665,3 +644,49 @@ $ %s query gov proposer 1
 
 	return cmd
 }
+
+// GetCmdQuerySimulateProposal implements the query simulate-proposal command.
+func GetCmdQuerySimulateProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-proposal [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Simulate the execution of the messages of a proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the outcome of executing the messages of a proposal against the
+current state, as if the proposal passed now. No state change is committed.
+
+Example:
+$ %s query gov simulate-proposal 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			res, err := queryClient.SimulateProposal(
+				cmd.Context(),
+				&v1.QuerySimulateProposalRequest{ProposalId: proposalID},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -24,23 +24,27 @@ func (s *IntegrationTestSuite) TestCmdParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"voting_params":{"voting_period":"172800000000000"},"tally_params":{"quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000"},"deposit_params":{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800000000000"}}`,
+			`{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800s","voting_period":"172800s","quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000","proposal_cancel_ratio":"0.500000000000000000","proposal_cancel_dest":"","expedited_voting_period":"86400s","expedited_threshold":"0.667000000000000000","expedited_min_deposit":[{"denom":"stake","amount":"50000000"}]}`,
 		},
 		{
 			"text output",
 			[]string{},
 			`
-deposit_params:
-  max_deposit_period: "172800000000000"
-  min_deposit:
-  - amount: "10000000"
-    denom: stake
-tally_params:
-  quorum: "0.334000000000000000"
-  threshold: "0.500000000000000000"
-  veto_threshold: "0.334000000000000000"
-voting_params:
-  voting_period: "172800000000000"
+expedited_min_deposit:
+- amount: "50000000"
+  denom: stake
+expedited_threshold: "0.667000000000000000"
+expedited_voting_period: 86400s
+max_deposit_period: 172800s
+min_deposit:
+- amount: "10000000"
+  denom: stake
+proposal_cancel_dest: ""
+proposal_cancel_ratio: "0.500000000000000000"
+quorum: "0.334000000000000000"
+threshold: "0.500000000000000000"
+veto_threshold: "0.334000000000000000"
+voting_period: 172800s
 	`,
 		},
 	}
@@ -12,9 +12,12 @@ import (
 // InitGenesis - store genesis parameters
 func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, data *v1.GenesisState) {
 	k.SetProposalID(ctx, data.StartingProposalId)
-	k.SetDepositParams(ctx, *data.DepositParams)
-	k.SetVotingParams(ctx, *data.VotingParams)
-	k.SetTallyParams(ctx, *data.TallyParams)
+
+	params, err := data.ResolveParams()
+	if err != nil {
+		panic(err)
+	}
+	k.SetParams(ctx, params)
 
 	// check if the deposits pool account exists
 	moduleAcc := k.GetGovernanceAccount(ctx)
@@ -57,9 +60,7 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 // ExportGenesis - output genesis parameters
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *v1.GenesisState {
 	startingProposalID, _ := k.GetProposalID(ctx)
-	depositParams := k.GetDepositParams(ctx)
-	votingParams := k.GetVotingParams(ctx)
-	tallyParams := k.GetTallyParams(ctx)
+	params := k.GetParams(ctx)
 	proposals := k.GetProposals(ctx)
 
 	var proposalsDeposits v1.Deposits
@@ -77,8 +78,6 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *v1.GenesisState {
 		Deposits:           proposalsDeposits,
 		Votes:              proposalsVotes,
 		Proposals:          proposals,
-		DepositParams:      &depositParams,
-		VotingParams:       &votingParams,
-		TallyParams:        &tallyParams,
+		Params:             &params,
 	}
 }
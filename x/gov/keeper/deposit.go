@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
@@ -131,7 +132,7 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 	// Check if deposit has provided sufficient total funds to transition the proposal into the voting period
 	activatedVotingPeriod := false
 
-	if proposal.Status == v1.StatusDepositPeriod && sdk.NewCoins(proposal.TotalDeposit...).IsAllGTE(keeper.GetDepositParams(ctx).MinDeposit) {
+	if proposal.Status == v1.StatusDepositPeriod && sdk.NewCoins(proposal.TotalDeposit...).IsAllGTE(keeper.GetParams(ctx).ProposalMinDeposit(proposal.Expedited)) {
 		keeper.ActivateVotingPeriod(ctx, proposal)
 
 		activatedVotingPeriod = true
@@ -178,3 +179,57 @@ func (keeper Keeper) RefundAndDeleteDeposits(ctx sdk.Context, proposalID uint64)
 		return false
 	})
 }
+
+// ChargeDeposit charges a share, defined by proposalCancelRatio, of all the
+// deposits on a specific proposal and refunds the rest to the depositors. The
+// charged amount is burned when destAddress is empty, sent to the community
+// pool when destAddress is the distribution module account and sent to
+// destAddress otherwise. The deposits are deleted.
+func (keeper Keeper) ChargeDeposit(ctx sdk.Context, proposalID uint64, destAddress, proposalCancelRatio string) error {
+	ratio, err := sdk.NewDecFromStr(proposalCancelRatio)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(keeper.storeKey)
+
+	var cancellationCharges sdk.Coins
+	for _, deposit := range keeper.GetDeposits(ctx, proposalID) {
+		depositor := sdk.MustAccAddressFromBech32(deposit.Depositor)
+
+		var refund sdk.Coins
+		for _, coin := range deposit.Amount {
+			charge := sdk.NewDecFromInt(coin.Amount).Mul(ratio).TruncateInt()
+			refund = refund.Add(sdk.NewCoin(coin.Denom, coin.Amount.Sub(charge)))
+			cancellationCharges = cancellationCharges.Add(sdk.NewCoin(coin.Denom, charge))
+		}
+
+		if !refund.IsZero() {
+			if err := keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, refund); err != nil {
+				return err
+			}
+		}
+
+		store.Delete(types.DepositKey(proposalID, depositor))
+	}
+
+	if cancellationCharges.IsZero() {
+		return nil
+	}
+
+	switch {
+	case destAddress == "":
+		return keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, cancellationCharges)
+
+	case destAddress == keeper.authKeeper.GetModuleAddress(distrtypes.ModuleName).String():
+		return keeper.distrKeeper.FundCommunityPool(ctx, cancellationCharges, keeper.GetGovernanceAccount(ctx).GetAddress())
+
+	default:
+		dest, err := sdk.AccAddressFromBech32(destAddress)
+		if err != nil {
+			return err
+		}
+
+		return keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, dest, cancellationCharges)
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
 func TestDeposits(t *testing.T) {
@@ -17,7 +18,7 @@ func TestDeposits(t *testing.T) {
 	TestAddrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000000))
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 
@@ -101,7 +102,7 @@ func TestDeposits(t *testing.T) {
 	require.Equal(t, addr1Initial, app.BankKeeper.GetAllBalances(ctx, TestAddrs[1]))
 
 	// Test delete and burn deposits
-	proposal, err = app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err = app.GovKeeper.SubmitProposal(ctx, tp, "", addr, false)
 	require.NoError(t, err)
 	proposalID = proposal.Id
 	_, err = app.GovKeeper.AddDeposit(ctx, proposalID, TestAddrs[0], fourStake)
@@ -111,3 +112,60 @@ func TestDeposits(t *testing.T) {
 	require.Len(t, deposits, 0)
 	require.Equal(t, addr0Initial.Sub(fourStake...), app.BankKeeper.GetAllBalances(ctx, TestAddrs[0]))
 }
+
+func TestChargeDeposit(t *testing.T) {
+	testCases := []struct {
+		name        string
+		cancelRatio string
+		toPool      bool
+	}{
+		{"burn half of the deposits", "0.5", false},
+		{"fund the community pool", "0.5", true},
+		{"refund all the deposits", "0", false},
+		{"charge all the deposits", "1", true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			app := simapp.Setup(t, false)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+			TestAddrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000000))
+
+			dest := ""
+			if tc.toPool {
+				dest = app.AccountKeeper.GetModuleAddress(distrtypes.ModuleName).String()
+			}
+
+			proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, "", TestAddrs[0], false)
+			require.NoError(t, err)
+
+			deposit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1001)))
+			for _, depositor := range TestAddrs {
+				_, err = app.GovKeeper.AddDeposit(ctx, proposal.Id, depositor, deposit)
+				require.NoError(t, err)
+			}
+
+			addr0Initial := app.BankKeeper.GetAllBalances(ctx, TestAddrs[0])
+			supplyInitial := app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom)
+			poolInitial := app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+
+			require.NoError(t, app.GovKeeper.ChargeDeposit(ctx, proposal.Id, dest, tc.cancelRatio))
+
+			ratio := sdk.MustNewDecFromStr(tc.cancelRatio)
+			charge := sdk.NewDecFromInt(deposit.AmountOf(sdk.DefaultBondDenom)).Mul(ratio).TruncateInt()
+			totalCharge := sdk.NewCoin(sdk.DefaultBondDenom, charge.MulRaw(int64(len(TestAddrs))))
+
+			require.Empty(t, app.GovKeeper.GetDeposits(ctx, proposal.Id))
+			require.Equal(t, addr0Initial.Add(deposit...).Sub(sdk.NewCoin(sdk.DefaultBondDenom, charge)), app.BankKeeper.GetAllBalances(ctx, TestAddrs[0]))
+
+			if tc.toPool {
+				require.Equal(t, supplyInitial, app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom))
+				require.Equal(t, poolInitial.Add(sdk.NewDecCoinsFromCoins(totalCharge)...), app.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+			} else {
+				require.Equal(t, supplyInitial.Sub(totalCharge), app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom))
+				require.Equal(t, poolInitial, app.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+			}
+		})
+	}
+}
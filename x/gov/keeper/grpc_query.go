@@ -158,24 +158,28 @@ func (q Keeper) Params(c context.Context, req *v1.QueryParamsRequest) (*v1.Query
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
+	params := q.GetParams(ctx)
+	response := &v1.QueryParamsResponse{Params: &params}
 
 	switch req.ParamsType {
 	case v1.ParamDeposit:
-		depositParmas := q.GetDepositParams(ctx)
-		return &v1.QueryParamsResponse{DepositParams: &depositParmas}, nil
+		depositParams := params.ToDepositParams()
+		response.DepositParams = &depositParams
 
 	case v1.ParamVoting:
-		votingParmas := q.GetVotingParams(ctx)
-		return &v1.QueryParamsResponse{VotingParams: &votingParmas}, nil
+		votingParams := params.ToVotingParams()
+		response.VotingParams = &votingParams
 
 	case v1.ParamTallying:
-		tallyParams := q.GetTallyParams(ctx)
-		return &v1.QueryParamsResponse{TallyParams: &tallyParams}, nil
+		tallyParams := params.ToTallyParams()
+		response.TallyParams = &tallyParams
 
 	default:
 		return nil, status.Errorf(codes.InvalidArgument,
 			"%s is not a valid parameter type", req.ParamsType)
 	}
+
+	return response, nil
 }
 
 // Deposit queries single deposit information based on proposalID, depositAddr.
@@ -273,6 +277,48 @@ func (q Keeper) TallyResult(c context.Context, req *v1.QueryTallyResultRequest)
 	return &v1.QueryTallyResultResponse{Tally: &tallyResult}, nil
 }
 
+// SimulateProposal executes the messages of a proposal against the current
+// state and returns the outcome, without committing any state change. The
+// messages are executed under a gas meter limited to the configured
+// SimulateProposalGasLimit.
+func (q Keeper) SimulateProposal(c context.Context, req *v1.QuerySimulateProposalRequest) (*v1.QuerySimulateProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	proposal, ok := q.GetProposal(ctx, req.ProposalId)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+	}
+
+	messages, err := proposal.GetMsgs()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	ctx = ctx.WithGasMeter(sdk.NewGasMeter(q.config.SimulateProposalGasLimit))
+	events, msgResponses, idx, err := q.SimulateProposalMsgs(ctx, messages)
+
+	res := &v1.QuerySimulateProposalResponse{
+		Success:      err == nil,
+		MsgResponses: msgResponses,
+		Events:       events.ToABCIEvents(),
+		GasUsed:      ctx.GasMeter().GasConsumedToLimit(),
+	}
+	if err != nil {
+		res.FailedMsgIndex = uint64(idx)
+		res.Error = err.Error()
+	}
+
+	return res, nil
+}
+
 var _ v1beta1.QueryServer = legacyQueryServer{}
 
 type legacyQueryServer struct {
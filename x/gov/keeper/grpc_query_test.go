@@ -4,10 +4,14 @@ import (
 	gocontext "context"
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	v046 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v046"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
@@ -53,7 +57,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposal() {
 				testProposal := v1beta1.NewTextProposal("Proposal", "testing proposal")
 				msgContent, err := v1.NewLegacyContent(testProposal, govAcct.String())
 				suite.Require().NoError(err)
-				submittedProposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, "")
+				submittedProposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, "", addr, false)
 				suite.Require().NoError(err)
 				suite.Require().NotEmpty(submittedProposal)
 
@@ -127,7 +131,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryProposal() {
 				testProposal := v1beta1.NewTextProposal("Proposal", "testing proposal")
 				msgContent, err := v1.NewLegacyContent(testProposal, govAcct.String())
 				suite.Require().NoError(err)
-				submittedProposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, "")
+				submittedProposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, "", addr, false)
 				suite.Require().NoError(err)
 				suite.Require().NotEmpty(submittedProposal)
 
@@ -192,7 +196,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposals() {
 					testProposal := []sdk.Msg{
 						v1.NewMsgVote(govAddress, uint64(i), v1.OptionYes, ""),
 					}
-					proposal, err := app.GovKeeper.SubmitProposal(ctx, testProposal, "")
+					proposal, err := app.GovKeeper.SubmitProposal(ctx, testProposal, "", addr, false)
 					suite.Require().NotEmpty(proposal)
 					suite.Require().NoError(err)
 					testProposals = append(testProposals, &proposal)
@@ -329,7 +333,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryProposals() {
 				testProposal := v1beta1.NewTextProposal("Proposal", "testing proposal")
 				msgContent, err := v1.NewLegacyContent(testProposal, govAcct.String())
 				suite.Require().NoError(err)
-				submittedProposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, "")
+				submittedProposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, "", addr, false)
 				suite.Require().NoError(err)
 				suite.Require().NotEmpty(submittedProposal)
 			},
@@ -410,7 +414,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryVote() {
 			"no votes present",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "")
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "", addr, false)
 				suite.Require().NoError(err)
 
 				req = &v1.QueryVoteRequest{
@@ -524,7 +528,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryVote() {
 			"no votes present",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "")
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "", addr, false)
 				suite.Require().NoError(err)
 
 				req = &v1beta1.QueryVoteRequest{
@@ -629,7 +633,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryVotes() {
 			"create a proposal and get votes",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "")
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "", addr, false)
 				suite.Require().NoError(err)
 
 				req = &v1.QueryVotesRequest{
@@ -730,7 +734,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryVotes() {
 			"create a proposal and get votes",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "")
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "", addr, false)
 				suite.Require().NoError(err)
 
 				req = &v1beta1.QueryVotesRequest{
@@ -1012,7 +1016,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryDeposit() {
 			"no deposits proposal",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "")
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "", addr, false)
 				suite.Require().NoError(err)
 				suite.Require().NotNil(proposal)
 
@@ -1113,7 +1117,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryDeposit() {
 			"no deposits proposal",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "")
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "", addr, false)
 				suite.Require().NoError(err)
 				suite.Require().NotNil(proposal)
 
@@ -1203,7 +1207,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryDeposits() {
 			"create a proposal and get deposits",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "")
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "", addr, false)
 				suite.Require().NoError(err)
 
 				req = &v1.QueryDepositsRequest{
@@ -1297,7 +1301,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryDeposits() {
 			"create a proposal and get deposits",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "")
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "", addr, false)
 				suite.Require().NoError(err)
 
 				req = &v1beta1.QueryDepositsRequest{
@@ -1391,7 +1395,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryTally() {
 			"create a proposal and get tally",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "")
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "", addr, false)
 				suite.Require().NoError(err)
 				suite.Require().NotNil(proposal)
 
@@ -1502,7 +1506,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryTally() {
 			"create a proposal and get tally",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "")
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "", addr, false)
 				suite.Require().NoError(err)
 				suite.Require().NotNil(proposal)
 
@@ -1584,3 +1588,98 @@ func v1TallyToV1Beta1Tally(t v1.TallyResult) v1beta1.TallyResult {
 		Abstain:    abstain,
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCQuerySimulateProposal() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	// the gov account holds 100000stake, see SetupTest
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, "", addr, false)
+	suite.Require().NoError(err)
+
+	overspend := banktypes.NewMsgSend(govAcct, addr, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(200000))))
+	failingProposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{TestProposal[0], overspend}, "", addr, false)
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		msg     string
+		req     *v1.QuerySimulateProposalRequest
+		expPass bool
+		expRes  func(res *v1.QuerySimulateProposalResponse)
+	}{
+		{
+			"empty request",
+			&v1.QuerySimulateProposalRequest{},
+			false,
+			nil,
+		},
+		{
+			"non existing proposal",
+			&v1.QuerySimulateProposalRequest{ProposalId: 100},
+			false,
+			nil,
+		},
+		{
+			"successful execution",
+			&v1.QuerySimulateProposalRequest{ProposalId: proposal.Id},
+			true,
+			func(res *v1.QuerySimulateProposalResponse) {
+				suite.Require().True(res.Success)
+				suite.Require().Empty(res.Error)
+				suite.Require().Len(res.MsgResponses, 2)
+				suite.Require().NotEmpty(res.Events)
+				suite.Require().NotZero(res.GasUsed)
+			},
+		},
+		{
+			"failed execution",
+			&v1.QuerySimulateProposalRequest{ProposalId: failingProposal.Id},
+			true,
+			func(res *v1.QuerySimulateProposalResponse) {
+				suite.Require().False(res.Success)
+				suite.Require().Equal(uint64(1), res.FailedMsgIndex)
+				suite.Require().Contains(res.Error, "insufficient funds")
+				suite.Require().Len(res.MsgResponses, 1)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			res, err := queryClient.SimulateProposal(gocontext.Background(), tc.req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				tc.expRes(res)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+
+	// the simulation does not change the state
+	suite.Require().Equal(sdk.NewInt(100000), app.BankKeeper.GetBalance(ctx, govAcct, "stake").Amount)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQuerySimulateProposalGasLimit() {
+	app, ctx := suite.app, suite.ctx
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, "", addr, false)
+	suite.Require().NoError(err)
+
+	// the messages run out of gas past the configured limit
+	govKeeper := keeper.NewKeeper(
+		app.AppCodec(), app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName), app.AccountKeeper, app.BankKeeper,
+		app.StakingKeeper, app.DistrKeeper, v1beta1.NewRouter(), app.MsgServiceRouter(),
+		types.Config{SimulateProposalGasLimit: 1000}, app.GovKeeper.GetAuthority(),
+	)
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	v1.RegisterQueryServer(queryHelper, govKeeper)
+	queryClient := v1.NewQueryClient(queryHelper)
+
+	res, err := queryClient.SimulateProposal(gocontext.Background(), &v1.QuerySimulateProposalRequest{ProposalId: proposal.Id})
+	suite.Require().NoError(err)
+	suite.Require().False(res.Success)
+	suite.Require().Zero(res.FailedMsgIndex)
+	suite.Require().Contains(res.Error, "out of gas")
+	suite.Require().Equal(uint64(1000), res.GasUsed)
+}
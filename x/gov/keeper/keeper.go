@@ -18,7 +18,8 @@ import (
 
 // Keeper defines the governance module Keeper
 type Keeper struct {
-	// The reference to the Paramstore to get and set gov specific params
+	// The reference to the legacy Paramstore, only used to migrate the gov
+	// params to the module store
 	paramSpace types.ParamSubspace
 
 	authKeeper types.AccountKeeper
@@ -27,6 +28,10 @@ type Keeper struct {
 	// The reference to the DelegationSet and ValidatorSet to get information about validators and delegators
 	sk types.StakingKeeper
 
+	// The reference to the distribution keeper, used to fund the community
+	// pool with the deposits charged at proposal cancellation
+	distrKeeper types.DistributionKeeper
+
 	// GovHooks
 	hooks types.GovHooks
 
@@ -43,6 +48,10 @@ type Keeper struct {
 	router *baseapp.MsgServiceRouter
 
 	config types.Config
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
 }
 
 // NewKeeper returns a governance keeper. It handles:
@@ -55,8 +64,8 @@ type Keeper struct {
 func NewKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace types.ParamSubspace,
 	authKeeper types.AccountKeeper, bankKeeper types.BankKeeper, sk types.StakingKeeper,
-	legacyRouter v1beta1.Router, router *baseapp.MsgServiceRouter,
-	config types.Config,
+	distrKeeper types.DistributionKeeper, legacyRouter v1beta1.Router, router *baseapp.MsgServiceRouter,
+	config types.Config, authority string,
 ) Keeper {
 	// ensure governance module account is set
 	if addr := authKeeper.GetModuleAddress(types.ModuleName); addr == nil {
@@ -73,19 +82,31 @@ func NewKeeper(
 		config.MaxMetadataLen = types.DefaultConfig().MaxMetadataLen
 	}
 
+	// If SimulateProposalGasLimit not set by app developer, set to default value.
+	if config.SimulateProposalGasLimit == 0 {
+		config.SimulateProposalGasLimit = types.DefaultConfig().SimulateProposalGasLimit
+	}
+
 	return Keeper{
 		storeKey:     key,
 		paramSpace:   paramSpace,
 		authKeeper:   authKeeper,
 		bankKeeper:   bankKeeper,
 		sk:           sk,
+		distrKeeper:  distrKeeper,
 		cdc:          cdc,
 		legacyRouter: legacyRouter,
 		router:       router,
 		config:       config,
+		authority:    authority,
 	}
 }
 
+// GetAuthority returns the x/gov module's authority.
+func (keeper Keeper) GetAuthority() string {
+	return keeper.authority
+}
+
 // SetHooks sets the hooks for governance
 func (keeper *Keeper) SetHooks(gh types.GovHooks) *Keeper {
 	if keeper.hooks != nil {
@@ -64,17 +64,17 @@ func TestIncrementProposalNumber(t *testing.T) {
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
 
 	tp := TestProposal
-	_, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	_, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr, false)
 	require.NoError(t, err)
-	_, err = app.GovKeeper.SubmitProposal(ctx, tp, "")
+	_, err = app.GovKeeper.SubmitProposal(ctx, tp, "", addr, false)
 	require.NoError(t, err)
-	_, err = app.GovKeeper.SubmitProposal(ctx, tp, "")
+	_, err = app.GovKeeper.SubmitProposal(ctx, tp, "", addr, false)
 	require.NoError(t, err)
-	_, err = app.GovKeeper.SubmitProposal(ctx, tp, "")
+	_, err = app.GovKeeper.SubmitProposal(ctx, tp, "", addr, false)
 	require.NoError(t, err)
-	_, err = app.GovKeeper.SubmitProposal(ctx, tp, "")
+	_, err = app.GovKeeper.SubmitProposal(ctx, tp, "", addr, false)
 	require.NoError(t, err)
-	proposal6, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal6, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr, false)
 	require.NoError(t, err)
 
 	require.Equal(t, uint64(6), proposal6.Id)
@@ -86,7 +86,7 @@ func TestProposalQueues(t *testing.T) {
 
 	// create test proposals
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr, false)
 	require.NoError(t, err)
 
 	inactiveIterator := app.GovKeeper.InactiveProposalQueueIterator(ctx, *proposal.DepositEndTime)
@@ -110,6 +110,24 @@ func TestProposalQueues(t *testing.T) {
 	activeIterator.Close()
 }
 
+func TestSetLegacyParams(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	params := app.GovKeeper.GetParams(ctx)
+
+	// the legacy params can't make the expedited params less strict
+	votingPeriod := *params.ExpeditedVotingPeriod
+	require.Error(t, app.GovKeeper.SetVotingParams(ctx, v1.VotingParams{VotingPeriod: &votingPeriod}))
+	require.Error(t, app.GovKeeper.SetTallyParams(ctx, v1.NewTallyParams(v1.DefaultQuorum, sdk.MustNewDecFromStr(params.ExpeditedThreshold), v1.DefaultVetoThreshold)))
+	require.Error(t, app.GovKeeper.SetDepositParams(ctx, v1.NewDepositParams(sdk.Coins(params.ExpeditedMinDeposit).Add(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)), v1.DefaultPeriod)))
+	require.Equal(t, params, app.GovKeeper.GetParams(ctx))
+
+	// valid legacy params are set
+	votingPeriod = *params.VotingPeriod * 2
+	require.NoError(t, app.GovKeeper.SetVotingParams(ctx, v1.VotingParams{VotingPeriod: &votingPeriod}))
+	require.Equal(t, votingPeriod, *app.GovKeeper.GetParams(ctx).VotingPeriod)
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
@@ -410,6 +410,90 @@ func (suite *KeeperTestSuite) TestDepositReq() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestCancelProposalReq() {
+	govAcct := suite.app.GovKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
+	addrs := suite.addrs
+	proposer := addrs[0]
+
+	coins := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100)))
+	bankMsg := &banktypes.MsgSend{
+		FromAddress: govAcct.String(),
+		ToAddress:   proposer.String(),
+		Amount:      coins,
+	}
+
+	msg, err := v1.NewMsgSubmitProposal(
+		[]sdk.Msg{bankMsg},
+		coins,
+		proposer.String(),
+		"",
+	)
+	suite.Require().NoError(err)
+
+	res, err := suite.msgSrvr.SubmitProposal(suite.ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res.ProposalId)
+	pId := res.ProposalId
+	proposerBalance := suite.app.BankKeeper.GetAllBalances(suite.ctx, proposer)
+
+	cases := []struct {
+		name       string
+		proposalId uint64
+		proposer   string
+		expErr     bool
+		expErrMsg  string
+	}{
+		{
+			name:       "wrong proposal id",
+			proposalId: 0,
+			proposer:   proposer.String(),
+			expErr:     true,
+			expErrMsg:  "unknown proposal",
+		},
+		{
+			name:       "invalid proposer",
+			proposalId: pId,
+			proposer:   addrs[1].String(),
+			expErr:     true,
+			expErrMsg:  "invalid proposer",
+		},
+		{
+			name:       "all good",
+			proposalId: pId,
+			proposer:   proposer.String(),
+			expErr:     false,
+		},
+		{
+			name:       "proposal already canceled",
+			proposalId: pId,
+			proposer:   proposer.String(),
+			expErr:     true,
+			expErrMsg:  "unknown proposal",
+		},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			cancelReq := v1.NewMsgCancelProposal(tc.proposalId, tc.proposer)
+			_, err := suite.msgSrvr.CancelProposal(suite.ctx, cancelReq)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				suite.Require().NoError(err)
+
+				_, found := suite.app.GovKeeper.GetProposal(suite.ctx, pId)
+				suite.Require().False(found)
+				suite.Require().Empty(suite.app.GovKeeper.GetDeposits(suite.ctx, pId))
+
+				// half of the deposit is refunded with the default params
+				refund := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(50)))
+				suite.Require().Equal(proposerBalance.Add(refund...), suite.app.BankKeeper.GetAllBalances(suite.ctx, proposer))
+			}
+		})
+	}
+}
+
 // legacy msg server tests
 func (suite *KeeperTestSuite) TestLegacyMsgSubmitProposal() {
 	addrs := suite.addrs
@@ -732,3 +816,51 @@ func (suite *KeeperTestSuite) TestLegacyMsgDeposit() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgUpdateParams() {
+	authority := suite.app.GovKeeper.GetAuthority()
+	params := v1.DefaultParams()
+	params.Quorum = "0.5"
+
+	invalidParams := v1.DefaultParams()
+	invalidParams.Threshold = "2"
+
+	testCases := []struct {
+		name      string
+		input     *v1.MsgUpdateParams
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name:      "invalid authority",
+			input:     v1.NewMsgUpdateParams("invalid", params),
+			expErr:    true,
+			expErrMsg: "expected gov account as only signer for proposal message",
+		},
+		{
+			name:      "invalid params",
+			input:     v1.NewMsgUpdateParams(authority, invalidParams),
+			expErr:    true,
+			expErrMsg: "vote threshold too large",
+		},
+		{
+			name:   "all good",
+			input:  v1.NewMsgUpdateParams(authority, params),
+			expErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			_, err := suite.msgSrvr.UpdateParams(suite.ctx, tc.input)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(params, suite.app.GovKeeper.GetParams(suite.ctx))
+			}
+		})
+	}
+}
@@ -2,41 +2,80 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
-// GetDepositParams returns the current DepositParams from the global param store
+// GetParams returns the current x/gov module parameters.
+func (keeper Keeper) GetParams(ctx sdk.Context) (params v1.Params) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	keeper.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the x/gov module parameters.
+func (keeper Keeper) SetParams(ctx sdk.Context, params v1.Params) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshal(&params)
+	store.Set(types.ParamsKey, bz)
+}
+
+// GetDepositParams returns the current DepositParams from the module params
 func (keeper Keeper) GetDepositParams(ctx sdk.Context) v1.DepositParams {
-	var depositParams v1.DepositParams
-	keeper.paramSpace.Get(ctx, v1.ParamStoreKeyDepositParams, &depositParams)
-	return depositParams
+	return keeper.GetParams(ctx).ToDepositParams()
 }
 
-// GetVotingParams returns the current VotingParams from the global param store
+// GetVotingParams returns the current VotingParams from the module params
 func (keeper Keeper) GetVotingParams(ctx sdk.Context) v1.VotingParams {
-	var votingParams v1.VotingParams
-	keeper.paramSpace.Get(ctx, v1.ParamStoreKeyVotingParams, &votingParams)
-	return votingParams
+	return keeper.GetParams(ctx).ToVotingParams()
 }
 
-// GetTallyParams returns the current TallyParam from the global param store
+// GetTallyParams returns the current TallyParam from the module params
 func (keeper Keeper) GetTallyParams(ctx sdk.Context) v1.TallyParams {
-	var tallyParams v1.TallyParams
-	keeper.paramSpace.Get(ctx, v1.ParamStoreKeyTallyParams, &tallyParams)
-	return tallyParams
+	return keeper.GetParams(ctx).ToTallyParams()
+}
+
+// SetDepositParams sets DepositParams in the module params. An error is
+// returned if the resulting params are invalid, e.g. if the expedited min
+// deposit becomes lower than the min deposit.
+func (keeper Keeper) SetDepositParams(ctx sdk.Context, depositParams v1.DepositParams) error {
+	params := keeper.GetParams(ctx)
+	params.MinDeposit = depositParams.MinDeposit
+	params.MaxDepositPeriod = depositParams.MaxDepositPeriod
+	return keeper.setValidParams(ctx, params)
 }
 
-// SetDepositParams sets DepositParams to the global param store
-func (keeper Keeper) SetDepositParams(ctx sdk.Context, depositParams v1.DepositParams) {
-	keeper.paramSpace.Set(ctx, v1.ParamStoreKeyDepositParams, &depositParams)
+// SetVotingParams sets VotingParams in the module params. An error is
+// returned if the resulting params are invalid, e.g. if the expedited voting
+// period becomes longer than the voting period.
+func (keeper Keeper) SetVotingParams(ctx sdk.Context, votingParams v1.VotingParams) error {
+	params := keeper.GetParams(ctx)
+	params.VotingPeriod = votingParams.VotingPeriod
+	return keeper.setValidParams(ctx, params)
 }
 
-// SetVotingParams sets VotingParams to the global param store
-func (keeper Keeper) SetVotingParams(ctx sdk.Context, votingParams v1.VotingParams) {
-	keeper.paramSpace.Set(ctx, v1.ParamStoreKeyVotingParams, &votingParams)
+// SetTallyParams sets TallyParams in the module params. An error is returned
+// if the resulting params are invalid, e.g. if the expedited threshold becomes
+// lower than the threshold.
+func (keeper Keeper) SetTallyParams(ctx sdk.Context, tallyParams v1.TallyParams) error {
+	params := keeper.GetParams(ctx)
+	params.Quorum = tallyParams.Quorum
+	params.Threshold = tallyParams.Threshold
+	params.VetoThreshold = tallyParams.VetoThreshold
+	return keeper.setValidParams(ctx, params)
 }
 
-// SetTallyParams sets TallyParams to the global param store
-func (keeper Keeper) SetTallyParams(ctx sdk.Context, tallyParams v1.TallyParams) {
-	keeper.paramSpace.Set(ctx, v1.ParamStoreKeyTallyParams, &tallyParams)
+// setValidParams sets the params if they pass their stateless validation.
+func (keeper Keeper) setValidParams(ctx sdk.Context, params v1.Params) error {
+	if err := params.ValidateBasic(); err != nil {
+		return err
+	}
+
+	keeper.SetParams(ctx, params)
+	return nil
 }
@@ -4,14 +4,17 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
-// SubmitProposal creates a new proposal given an array of messages
-func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadata string) (v1.Proposal, error) {
+// SubmitProposal creates a new proposal given an array of messages. An
+// expedited proposal uses the expedited deposit, voting period and threshold
+// params.
+func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadata string, proposer sdk.AccAddress, expedited bool) (v1.Proposal, error) {
 	err := keeper.assertMetadataLength(metadata)
 	if err != nil {
 		return v1.Proposal{}, err
@@ -60,6 +63,14 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 
 	}
 
+	// Optionally dry-run all the messages, in order, to reject a proposal
+	// which would fail on execution.
+	if keeper.config.SimulateProposalMsgs {
+		if _, _, idx, err := keeper.SimulateProposalMsgs(ctx, messages); err != nil {
+			return v1.Proposal{}, sdkerrors.Wrapf(types.ErrInvalidProposalMsg, "msg %d (%s) failed on execution: %s", idx, sdk.MsgTypeURL(messages[idx]), err)
+		}
+	}
+
 	proposalID, err := keeper.GetProposalID(ctx)
 	if err != nil {
 		return v1.Proposal{}, err
@@ -68,7 +79,7 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 	submitTime := ctx.BlockHeader().Time
 	depositPeriod := keeper.GetDepositParams(ctx).MaxDepositPeriod
 
-	proposal, err := v1.NewProposal(messages, proposalID, metadata, submitTime, submitTime.Add(*depositPeriod))
+	proposal, err := v1.NewProposal(messages, proposalID, metadata, submitTime, submitTime.Add(*depositPeriod), proposer, expedited)
 	if err != nil {
 		return v1.Proposal{}, err
 	}
@@ -91,6 +102,43 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 	return proposal, nil
 }
 
+// SimulateProposalMsgs executes the given proposal messages in order against a
+// cache context, the state changes being discarded. It returns the events and
+// the responses of the executed messages and, if one of them fails, its index
+// and error. A message running out of the gas of the context fails with an
+// ErrOutOfGas error.
+func (keeper Keeper) SimulateProposalMsgs(ctx sdk.Context, messages []sdk.Msg) (events sdk.Events, msgResponses []*codectypes.Any, idx int, err error) {
+	cacheCtx, _ := ctx.CacheContext()
+
+	defer func() {
+		if r := recover(); r != nil {
+			oog, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			err = sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %v", oog.Descriptor)
+		}
+	}()
+
+	var msg sdk.Msg
+	for idx, msg = range messages {
+		handler := keeper.router.Handler(msg)
+		if handler == nil {
+			return events, msgResponses, idx, sdkerrors.Wrap(types.ErrUnroutableProposalMsg, sdk.MsgTypeURL(msg))
+		}
+
+		res, err := handler(cacheCtx, msg)
+		if err != nil {
+			return events, msgResponses, idx, err
+		}
+
+		events = append(events, res.GetEvents()...)
+		msgResponses = append(msgResponses, res.MsgResponses...)
+	}
+
+	return events, msgResponses, 0, nil
+}
+
 // GetProposal gets a proposal from store by ProposalID.
 // Panics if can't unmarshal the proposal.
 func (keeper Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (v1.Proposal, bool) {
@@ -140,6 +188,61 @@ func (keeper Keeper) DeleteProposal(ctx sdk.Context, proposalID uint64) {
 	store.Delete(types.ProposalKey(proposalID))
 }
 
+// CancelProposal cancels a proposal on behalf of its proposer. The proposal
+// must still be in its deposit or voting period. A share of the deposits,
+// defined by the ProposalCancelRatio param, is charged and the rest refunded
+// to the depositors. The proposal, its votes and its deposits are deleted.
+func (keeper Keeper) CancelProposal(ctx sdk.Context, proposalID uint64, proposer string) error {
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+
+	// Proposals submitted before the proposer was recorded cannot be canceled.
+	if proposal.Proposer == "" {
+		return sdkerrors.Wrapf(types.ErrInvalidProposer, "proposal %d doesn't have a proposer, so it cannot be canceled", proposalID)
+	}
+
+	if proposal.Proposer != proposer {
+		return sdkerrors.Wrapf(types.ErrInvalidProposer, "%s is not the proposer of proposal %d", proposer, proposalID)
+	}
+
+	if proposal.Status != v1.StatusDepositPeriod && proposal.Status != v1.StatusVotingPeriod {
+		return sdkerrors.Wrapf(types.ErrInactiveProposal, "proposal %d is not in its deposit or voting period", proposalID)
+	}
+
+	if proposal.VotingEndTime != nil && !ctx.BlockTime().Before(*proposal.VotingEndTime) {
+		return sdkerrors.Wrapf(types.ErrVotingPeriodEnded, "proposal %d", proposalID)
+	}
+
+	params := keeper.GetParams(ctx)
+	if err := keeper.ChargeDeposit(ctx, proposalID, params.ProposalCancelDest, params.ProposalCancelRatio); err != nil {
+		return err
+	}
+
+	if proposal.VotingStartTime != nil {
+		keeper.deleteVotes(ctx, proposalID)
+	}
+
+	keeper.DeleteProposal(ctx, proposalID)
+
+	keeper.Logger(ctx).Info(
+		"proposal canceled by its proposer",
+		"proposal", proposalID,
+		"proposer", proposer,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyProposalProposer, proposer),
+		),
+	)
+
+	return nil
+}
+
 // IterateProposals iterates over the all the proposals and performs a callback function.
 // Panics when the iterator encounters a proposal which can't be unmarshaled.
 func (keeper Keeper) IterateProposals(ctx sdk.Context, cb func(proposal v1.Proposal) (stop bool)) {
@@ -237,8 +340,8 @@ func (keeper Keeper) SetProposalID(ctx sdk.Context, proposalID uint64) {
 func (keeper Keeper) ActivateVotingPeriod(ctx sdk.Context, proposal v1.Proposal) {
 	startTime := ctx.BlockHeader().Time
 	proposal.VotingStartTime = &startTime
-	votingPeriod := keeper.GetVotingParams(ctx).VotingPeriod
-	endTime := proposal.VotingStartTime.Add(*votingPeriod)
+	votingPeriod := keeper.GetParams(ctx).ProposalVotingPeriod(proposal.Expedited)
+	endTime := proposal.VotingStartTime.Add(votingPeriod)
 	proposal.VotingEndTime = &endTime
 	proposal.Status = v1.StatusVotingPeriod
 	keeper.SetProposal(ctx, proposal)
@@ -11,6 +11,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
@@ -18,7 +20,7 @@ import (
 
 func (suite *KeeperTestSuite) TestGetSetProposal() {
 	tp := TestProposal
-	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, "")
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, "", addr, false)
 	suite.Require().NoError(err)
 	proposalID := proposal.Id
 	suite.app.GovKeeper.SetProposal(suite.ctx, proposal)
@@ -30,7 +32,7 @@ func (suite *KeeperTestSuite) TestGetSetProposal() {
 
 func (suite *KeeperTestSuite) TestActivateVotingPeriod() {
 	tp := TestProposal
-	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, "")
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, "", addr, false)
 	suite.Require().NoError(err)
 
 	suite.Require().Nil(proposal.VotingStartTime)
@@ -49,6 +51,32 @@ func (suite *KeeperTestSuite) TestActivateVotingPeriod() {
 	activeIterator.Close()
 }
 
+func (suite *KeeperTestSuite) TestCancelProposal() {
+	proposer := suite.addrs[0]
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, TestProposal, "", proposer, false)
+	suite.Require().NoError(err)
+
+	suite.app.GovKeeper.ActivateVotingPeriod(suite.ctx, proposal)
+	proposal, ok := suite.app.GovKeeper.GetProposal(suite.ctx, proposal.Id)
+	suite.Require().True(ok)
+	suite.Require().NoError(suite.app.GovKeeper.AddVote(suite.ctx, proposal.Id, suite.addrs[1], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+
+	// the proposal cannot be canceled once its voting period has ended
+	endCtx := suite.ctx.WithBlockTime(*proposal.VotingEndTime)
+	err = suite.app.GovKeeper.CancelProposal(endCtx, proposal.Id, proposer.String())
+	suite.Require().ErrorIs(err, types.ErrVotingPeriodEnded)
+
+	suite.Require().NoError(suite.app.GovKeeper.CancelProposal(suite.ctx, proposal.Id, proposer.String()))
+
+	_, ok = suite.app.GovKeeper.GetProposal(suite.ctx, proposal.Id)
+	suite.Require().False(ok)
+	suite.Require().Empty(suite.app.GovKeeper.GetVotes(suite.ctx, proposal.Id))
+
+	activeIterator := suite.app.GovKeeper.ActiveProposalQueueIterator(suite.ctx, *proposal.VotingEndTime)
+	suite.Require().False(activeIterator.Valid())
+	activeIterator.Close()
+}
+
 type invalidProposalRoute struct{ v1beta1.TextProposal }
 
 func (invalidProposalRoute) ProposalRoute() string { return "nonexistingroute" }
@@ -81,11 +109,39 @@ func (suite *KeeperTestSuite) TestSubmitProposal() {
 	for i, tc := range testCases {
 		prop, err := v1.NewLegacyContent(tc.content, tc.authority)
 		suite.Require().NoError(err)
-		_, err = suite.app.GovKeeper.SubmitProposal(suite.ctx, []sdk.Msg{prop}, tc.metadata)
+		_, err = suite.app.GovKeeper.SubmitProposal(suite.ctx, []sdk.Msg{prop}, tc.metadata, addr, false)
 		suite.Require().True(errors.Is(tc.expectedErr, err), "tc #%d; got: %v, expected: %v", i, err, tc.expectedErr)
 	}
 }
 
+func (suite *KeeperTestSuite) TestSubmitProposalSimulateMsgs() {
+	app, ctx := suite.app, suite.ctx
+
+	// the gov account holds 100000stake, see SetupTest
+	overspend := banktypes.NewMsgSend(govAcct, addr, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(200000))))
+
+	// without simulation, a proposal failing on execution is accepted
+	_, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{overspend}, "", addr, false)
+	suite.Require().NoError(err)
+
+	govKeeper := keeper.NewKeeper(
+		app.AppCodec(), app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName), app.AccountKeeper, app.BankKeeper,
+		app.StakingKeeper, app.DistrKeeper, v1beta1.NewRouter(), app.MsgServiceRouter(),
+		types.Config{SimulateProposalMsgs: true}, app.GovKeeper.GetAuthority(),
+	)
+
+	_, err = govKeeper.SubmitProposal(ctx, TestProposal, "", addr, false)
+	suite.Require().NoError(err)
+
+	// the messages are simulated in order, the second send fails
+	_, err = govKeeper.SubmitProposal(ctx, []sdk.Msg{TestProposal[0], overspend}, "", addr, false)
+	suite.Require().ErrorIs(err, types.ErrInvalidProposalMsg)
+	suite.Require().Contains(err.Error(), "msg 1 (/cosmos.bank.v1beta1.MsgSend) failed on execution")
+
+	// the simulation does not change the state
+	suite.Require().Equal(sdk.NewInt(100000), app.BankKeeper.GetBalance(ctx, govAcct, "stake").Amount)
+}
+
 func (suite *KeeperTestSuite) TestGetProposalsFiltered() {
 	proposalID := uint64(1)
 	status := []v1.ProposalStatus{v1.StatusDepositPeriod, v1.StatusVotingPeriod}
@@ -94,7 +150,7 @@ func (suite *KeeperTestSuite) TestGetProposalsFiltered() {
 
 	for _, s := range status {
 		for i := 0; i < 50; i++ {
-			p, err := v1.NewProposal(TestProposal, proposalID, "", time.Now(), time.Now())
+			p, err := v1.NewProposal(TestProposal, proposalID, "", time.Now(), time.Now(), addr1, false)
 			suite.Require().NoError(err)
 
 			p.Status = s
@@ -0,0 +1,35 @@
+package v047
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// MigrateStore performs in-place store migrations from v0.46 to v0.47. The
+// migration includes:
+//
+// - Merge the deposit, voting and tally params stored in the x/params
+// subspace into a single Params stored in the x/gov module store.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, legacySubspace types.ParamSubspace, cdc codec.BinaryCodec) error {
+	var (
+		depositParams v1.DepositParams
+		votingParams  v1.VotingParams
+		tallyParams   v1.TallyParams
+	)
+	legacySubspace.Get(ctx, v1.ParamStoreKeyDepositParams, &depositParams)
+	legacySubspace.Get(ctx, v1.ParamStoreKeyVotingParams, &votingParams)
+	legacySubspace.Get(ctx, v1.ParamStoreKeyTallyParams, &tallyParams)
+
+	params := v1.NewParamsFromLegacy(depositParams, votingParams, tallyParams)
+	if err := params.ValidateBasic(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(storeKey)
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+
+	return nil
+}
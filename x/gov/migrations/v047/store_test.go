@@ -0,0 +1,75 @@
+package v047_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v047gov "github.com/cosmos/cosmos-sdk/x/gov/migrations/v047"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	govKey := sdk.NewKVStoreKey(types.StoreKey)
+	tGovKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(govKey, tGovKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, govKey, tGovKey, types.ModuleName).
+		WithKeyTable(v1.ParamKeyTable())
+
+	// Set non default params in the legacy subspace.
+	params := v1.DefaultParams()
+	votingPeriod := time.Hour
+	params.VotingPeriod = &votingPeriod
+	// the expedited voting period is shortened to stay below the voting period
+	expeditedVotingPeriod := votingPeriod / 2
+	params.ExpeditedVotingPeriod = &expeditedVotingPeriod
+	paramstore.Set(ctx, v1.ParamStoreKeyDepositParams, params.ToDepositParams())
+	paramstore.Set(ctx, v1.ParamStoreKeyVotingParams, params.ToVotingParams())
+	paramstore.Set(ctx, v1.ParamStoreKeyTallyParams, params.ToTallyParams())
+
+	// Run migrations.
+	err := v047gov.MigrateStore(ctx, govKey, paramstore, encCfg.Codec)
+	require.NoError(t, err)
+
+	// Make sure the merged params are now in the module store.
+	var res v1.Params
+	bz := ctx.KVStore(govKey).Get(types.ParamsKey)
+	require.NoError(t, encCfg.Codec.Unmarshal(bz, &res))
+	require.Equal(t, params, res)
+}
+
+func TestStoreMigrationExpeditedBounds(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	govKey := sdk.NewKVStoreKey(types.StoreKey)
+	tGovKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(govKey, tGovKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, govKey, tGovKey, types.ModuleName).
+		WithKeyTable(v1.ParamKeyTable())
+
+	// Set a voting period of 1ns and a threshold of 1 in the legacy subspace,
+	// the expedited params can't be stricter.
+	params := v1.DefaultParams()
+	paramstore.Set(ctx, v1.ParamStoreKeyDepositParams, params.ToDepositParams())
+	paramstore.Set(ctx, v1.ParamStoreKeyVotingParams, v1.NewVotingParams(time.Nanosecond))
+	paramstore.Set(ctx, v1.ParamStoreKeyTallyParams, v1.NewTallyParams(v1.DefaultQuorum, sdk.OneDec(), v1.DefaultVetoThreshold))
+
+	// Run migrations.
+	err := v047gov.MigrateStore(ctx, govKey, paramstore, encCfg.Codec)
+	require.NoError(t, err)
+
+	// The expedited params are set to the regular ones.
+	var res v1.Params
+	bz := ctx.KVStore(govKey).Get(types.ParamsKey)
+	require.NoError(t, encCfg.Codec.Unmarshal(bz, &res))
+	require.Equal(t, time.Nanosecond, *res.VotingPeriod)
+	require.Equal(t, time.Nanosecond, *res.ExpeditedVotingPeriod)
+	require.Equal(t, sdk.OneDec().String(), res.Threshold)
+	require.Equal(t, sdk.OneDec().String(), res.ExpeditedThreshold)
+}
@@ -46,12 +46,15 @@ func TestRandomizedGenState(t *testing.T) {
 	dec2, _ := sdk.NewDecFromStr("0.512000000000000000")
 	dec3, _ := sdk.NewDecFromStr("0.267000000000000000")
 
-	require.Equal(t, "905stake", govGenesis.DepositParams.MinDeposit[0].String())
-	require.Equal(t, "77h26m10s", govGenesis.DepositParams.MaxDepositPeriod.String())
-	require.Equal(t, float64(148296), govGenesis.VotingParams.VotingPeriod.Seconds())
-	require.Equal(t, dec1.String(), govGenesis.TallyParams.Quorum)
-	require.Equal(t, dec2.String(), govGenesis.TallyParams.Threshold)
-	require.Equal(t, dec3.String(), govGenesis.TallyParams.VetoThreshold)
+	require.Equal(t, "905stake", govGenesis.Params.MinDeposit[0].String())
+	require.Equal(t, "77h26m10s", govGenesis.Params.MaxDepositPeriod.String())
+	require.Equal(t, float64(231494), govGenesis.Params.VotingPeriod.Seconds())
+	require.Equal(t, dec1.String(), govGenesis.Params.Quorum)
+	require.Equal(t, dec2.String(), govGenesis.Params.Threshold)
+	require.Equal(t, dec3.String(), govGenesis.Params.VetoThreshold)
+	require.Equal(t, "5274stake", govGenesis.Params.ExpeditedMinDeposit[0].String())
+	require.Equal(t, float64(80646), govGenesis.Params.ExpeditedVotingPeriod.Seconds())
+	require.Equal(t, "0.595000000000000000", govGenesis.Params.ExpeditedThreshold)
 	require.Equal(t, uint64(0x28), govGenesis.StartingProposalId)
 	require.Equal(t, []*v1.Deposit{}, govGenesis.Deposits)
 	require.Equal(t, []*v1.Vote{}, govGenesis.Votes)
@@ -4,11 +4,21 @@ package types
 type Config struct {
 	// MaxMetadataLen defines the maximum proposal metadata length.
 	MaxMetadataLen uint64
+
+	// SimulateProposalMsgs defines whether the messages of a proposal are
+	// executed against a cache context at submission. The proposal is rejected
+	// if one of them fails.
+	SimulateProposalMsgs bool
+
+	// SimulateProposalGasLimit defines the gas available to the messages of a
+	// proposal executed by the SimulateProposal query.
+	SimulateProposalGasLimit uint64
 }
 
 // DefaultConfig returns the default config for gov.
 func DefaultConfig() Config {
 	return Config{
-		MaxMetadataLen: 255,
+		MaxMetadataLen:           255,
+		SimulateProposalGasLimit: 10_000_000,
 	}
 }
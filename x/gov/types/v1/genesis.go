@@ -8,12 +8,10 @@ import (
 )
 
 // NewGenesisState creates a new genesis state for the governance module
-func NewGenesisState(startingProposalID uint64, dp DepositParams, vp VotingParams, tp TallyParams) *GenesisState {
+func NewGenesisState(startingProposalID uint64, params Params) *GenesisState {
 	return &GenesisState{
 		StartingProposalId: startingProposalID,
-		DepositParams:      &dp,
-		VotingParams:       &vp,
-		TallyParams:        &tp,
+		Params:             &params,
 	}
 }
 
@@ -21,18 +19,35 @@ func NewGenesisState(startingProposalID uint64, dp DepositParams, vp VotingParam
 func DefaultGenesisState() *GenesisState {
 	return NewGenesisState(
 		DefaultStartingProposalID,
-		DefaultDepositParams(),
-		DefaultVotingParams(),
-		DefaultTallyParams(),
+		DefaultParams(),
 	)
 }
 
 // Empty returns true if a GenesisState is empty
 func (data GenesisState) Empty() bool {
-	return data.StartingProposalId == 0 ||
-		data.DepositParams == nil ||
-		data.VotingParams == nil ||
-		data.TallyParams == nil
+	if data.StartingProposalId == 0 {
+		return true
+	}
+
+	_, err := data.ResolveParams()
+	return err != nil
+}
+
+// ResolveParams returns the params of the genesis state. When they are not
+// set, the params are built from the deprecated deposit, voting and tally
+// params, which must then all be set.
+func (data GenesisState) ResolveParams() (Params, error) {
+	if data.Params != nil {
+		return *data.Params, nil
+	}
+
+	//nolint:staticcheck // the legacy params are still supported in genesis
+	if data.DepositParams == nil || data.VotingParams == nil || data.TallyParams == nil {
+		return Params{}, errors.New("governance params must be set")
+	}
+
+	//nolint:staticcheck // the legacy params are still supported in genesis
+	return NewParamsFromLegacy(*data.DepositParams, *data.VotingParams, *data.TallyParams), nil
 }
 
 // ValidateGenesis checks if parameters are within valid ranges
@@ -41,16 +56,13 @@ func ValidateGenesis(data *GenesisState) error {
 		return errors.New("starting proposal id must be greater than 0")
 	}
 
-	if err := validateTallyParams(*data.TallyParams); err != nil {
-		return fmt.Errorf("invalid tally params: %w", err)
-	}
-
-	if err := validateVotingParams(*data.VotingParams); err != nil {
-		return fmt.Errorf("invalid voting params: %w", err)
+	params, err := data.ResolveParams()
+	if err != nil {
+		return err
 	}
 
-	if err := validateDepositParams(*data.DepositParams); err != nil {
-		return fmt.Errorf("invalid deposit params: %w", err)
+	if err := params.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid params: %w", err)
 	}
 
 	return nil
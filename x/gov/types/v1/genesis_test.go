@@ -19,6 +19,9 @@ func TestValidateGenesis(t *testing.T) {
 	depositParams := v1.DefaultDepositParams()
 	votingParams := v1.DefaultVotingParams()
 	tallyParams := v1.DefaultTallyParams()
+	params := v1.DefaultParams()
+	invalidParams := v1.DefaultParams()
+	invalidParams.Quorum = "-1"
 
 	testCases := []struct {
 		name         string
@@ -29,6 +32,30 @@ func TestValidateGenesis(t *testing.T) {
 			name:         "valid",
 			genesisState: v1.DefaultGenesisState(),
 		},
+		{
+			name: "valid legacy params",
+			genesisState: &v1.GenesisState{
+				StartingProposalId: v1.DefaultStartingProposalID,
+				DepositParams:      &depositParams,
+				VotingParams:       &votingParams,
+				TallyParams:        &tallyParams,
+			},
+		},
+		{
+			name:         "missing params",
+			genesisState: &v1.GenesisState{StartingProposalId: v1.DefaultStartingProposalID},
+			expErr:       true,
+		},
+		{
+			name:         "invalid Params",
+			genesisState: v1.NewGenesisState(v1.DefaultStartingProposalID, invalidParams),
+			expErr:       true,
+		},
+		{
+			name:         "invalid StartingProposalId with Params",
+			genesisState: v1.NewGenesisState(0, params),
+			expErr:       true,
+		},
 		{
 			name: "invalid StartingProposalId",
 			genesisState: &v1.GenesisState{
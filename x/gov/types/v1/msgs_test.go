@@ -57,6 +57,29 @@ func TestMsgDeposit(t *testing.T) {
 	}
 }
 
+// test ValidateBasic for MsgCancelProposal
+func TestMsgCancelProposal(t *testing.T) {
+	tests := []struct {
+		proposalID uint64
+		proposer   string
+		expectPass bool
+	}{
+		{1, addrs[0].String(), true},
+		{0, addrs[0].String(), true},
+		{1, "", false},
+		{1, "invalid", false},
+	}
+
+	for i, tc := range tests {
+		msg := v1.NewMsgCancelProposal(tc.proposalID, tc.proposer)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
 // test ValidateBasic for MsgVote
 func TestMsgVote(t *testing.T) {
 	metadata := "metadata"
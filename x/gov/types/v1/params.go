@@ -11,15 +11,25 @@ import (
 
 // Default period for deposits & voting
 const (
-	DefaultPeriod time.Duration = time.Hour * 24 * 2 // 2 days
+	DefaultPeriod          time.Duration = time.Hour * 24 * 2 // 2 days
+	DefaultExpeditedPeriod time.Duration = time.Hour * 24     // 1 day
 )
 
+// DefaultExpeditedMinDepositRatio is the ratio between the default expedited
+// and regular minimum deposits.
+const DefaultExpeditedMinDepositRatio = 5
+
 // Default governance params
 var (
-	DefaultMinDepositTokens = sdk.NewInt(10000000)
-	DefaultQuorum           = sdk.NewDecWithPrec(334, 3)
-	DefaultThreshold        = sdk.NewDecWithPrec(5, 1)
-	DefaultVetoThreshold    = sdk.NewDecWithPrec(334, 3)
+	DefaultMinDepositTokens          = sdk.NewInt(10000000)
+	DefaultMinExpeditedDepositTokens = DefaultMinDepositTokens.MulRaw(DefaultExpeditedMinDepositRatio)
+	DefaultQuorum                    = sdk.NewDecWithPrec(334, 3)
+	DefaultThreshold                 = sdk.NewDecWithPrec(5, 1)
+	DefaultExpeditedThreshold        = sdk.NewDecWithPrec(667, 3)
+	DefaultVetoThreshold             = sdk.NewDecWithPrec(334, 3)
+	// DefaultProposalCancelRatio is the share of the deposits charged when a
+	// proposal is canceled by its proposer.
+	DefaultProposalCancelRatio = sdk.NewDecWithPrec(5, 1)
 )
 
 // Parameter store key
@@ -170,28 +180,207 @@ func validateVotingParams(i interface{}) error {
 	return nil
 }
 
-// Params returns all of the governance params
-type Params struct {
-	VotingParams  VotingParams  `json:"voting_params" yaml:"voting_params"`
-	TallyParams   TallyParams   `json:"tally_params" yaml:"tally_params"`
-	DepositParams DepositParams `json:"deposit_params" yaml:"deposit_params"`
+// NewParams creates a new Params instance with given values.
+func NewParams(
+	minDeposit sdk.Coins, maxDepositPeriod, votingPeriod time.Duration,
+	quorum, threshold, vetoThreshold, proposalCancelRatio, proposalCancelDest string,
+	expeditedMinDeposit sdk.Coins, expeditedVotingPeriod time.Duration, expeditedThreshold string,
+) Params {
+	return Params{
+		MinDeposit:            minDeposit,
+		MaxDepositPeriod:      &maxDepositPeriod,
+		VotingPeriod:          &votingPeriod,
+		Quorum:                quorum,
+		Threshold:             threshold,
+		VetoThreshold:         vetoThreshold,
+		ProposalCancelRatio:   proposalCancelRatio,
+		ProposalCancelDest:    proposalCancelDest,
+		ExpeditedMinDeposit:   expeditedMinDeposit,
+		ExpeditedVotingPeriod: &expeditedVotingPeriod,
+		ExpeditedThreshold:    expeditedThreshold,
+	}
 }
 
-func (gp Params) String() string {
-	return gp.VotingParams.String() + "\n" +
-		gp.TallyParams.String() + "\n" + gp.DepositParams.String()
-}
+// NewParamsFromLegacy merges the legacy deposit, voting and tally params into
+// a single Params instance. The params without a legacy counterpart are set
+// to their default value, the expedited ones being adjusted to remain
+// stricter than the legacy params.
+func NewParamsFromLegacy(dp DepositParams, vp VotingParams, tp TallyParams) Params {
+	params := Params{
+		MinDeposit:          dp.MinDeposit,
+		Quorum:              tp.Quorum,
+		Threshold:           tp.Threshold,
+		VetoThreshold:       tp.VetoThreshold,
+		ProposalCancelRatio: DefaultProposalCancelRatio.String(),
+		ExpeditedThreshold:  DefaultExpeditedThreshold.String(),
+	}
 
-// NewParams creates a new gov Params instance
-func NewParams(vp VotingParams, tp TallyParams, dp DepositParams) Params {
-	return Params{
-		VotingParams:  vp,
-		DepositParams: dp,
-		TallyParams:   tp,
+	if dp.MaxDepositPeriod != nil {
+		maxDepositPeriod := *dp.MaxDepositPeriod
+		params.MaxDepositPeriod = &maxDepositPeriod
+	}
+
+	expeditedVotingPeriod := DefaultExpeditedPeriod
+	if vp.VotingPeriod != nil {
+		votingPeriod := *vp.VotingPeriod
+		params.VotingPeriod = &votingPeriod
+
+		if expeditedVotingPeriod >= votingPeriod {
+			expeditedVotingPeriod = votingPeriod / 2
+		}
+		// a voting period too short to be halved is kept as is
+		if expeditedVotingPeriod <= 0 {
+			expeditedVotingPeriod = votingPeriod
+		}
 	}
+	params.ExpeditedVotingPeriod = &expeditedVotingPeriod
+
+	expeditedMinDeposit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinExpeditedDepositTokens))
+	if minDeposit := sdk.Coins(dp.MinDeposit); !expeditedMinDeposit.IsAllGTE(minDeposit) {
+		expeditedMinDeposit = minDeposit.MulInt(sdk.NewInt(DefaultExpeditedMinDepositRatio))
+	}
+	params.ExpeditedMinDeposit = expeditedMinDeposit
+
+	if threshold, err := sdk.NewDecFromStr(tp.Threshold); err == nil && threshold.GTE(DefaultExpeditedThreshold) {
+		expeditedThreshold := threshold.Add(sdk.OneDec()).QuoInt64(2)
+		// a threshold of 1, or rounded to it, cannot be exceeded
+		if expeditedThreshold.LTE(threshold) {
+			expeditedThreshold = sdk.OneDec()
+		}
+		params.ExpeditedThreshold = expeditedThreshold.String()
+	}
+
+	return params
 }
 
-// DefaultParams default governance params
+// DefaultParams returns the default governance params
 func DefaultParams() Params {
-	return NewParams(DefaultVotingParams(), DefaultTallyParams(), DefaultDepositParams())
+	return NewParamsFromLegacy(DefaultDepositParams(), DefaultVotingParams(), DefaultTallyParams())
+}
+
+// ToDepositParams returns the legacy DepositParams view of the params.
+func (p Params) ToDepositParams() DepositParams {
+	return DepositParams{
+		MinDeposit:       p.MinDeposit,
+		MaxDepositPeriod: p.MaxDepositPeriod,
+	}
+}
+
+// ToVotingParams returns the legacy VotingParams view of the params.
+func (p Params) ToVotingParams() VotingParams {
+	return VotingParams{
+		VotingPeriod: p.VotingPeriod,
+	}
+}
+
+// ToTallyParams returns the legacy TallyParams view of the params.
+func (p Params) ToTallyParams() TallyParams {
+	return TallyParams{
+		Quorum:        p.Quorum,
+		Threshold:     p.Threshold,
+		VetoThreshold: p.VetoThreshold,
+	}
+}
+
+// ValidateBasic performs basic validation on governance parameters.
+func (p Params) ValidateBasic() error {
+	if err := validateDepositParams(p.ToDepositParams()); err != nil {
+		return err
+	}
+
+	if err := validateVotingParams(p.ToVotingParams()); err != nil {
+		return err
+	}
+
+	if err := validateTallyParams(p.ToTallyParams()); err != nil {
+		return err
+	}
+
+	proposalCancelRatio, err := sdk.NewDecFromStr(p.ProposalCancelRatio)
+	if err != nil {
+		return fmt.Errorf("invalid proposal cancel ratio string: %w", err)
+	}
+	if proposalCancelRatio.IsNegative() {
+		return fmt.Errorf("proposal cancel ratio cannot be negative: %s", proposalCancelRatio)
+	}
+	if proposalCancelRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf("proposal cancel ratio too large: %s", proposalCancelRatio)
+	}
+
+	if len(p.ProposalCancelDest) != 0 {
+		if _, err := sdk.AccAddressFromBech32(p.ProposalCancelDest); err != nil {
+			return fmt.Errorf("invalid proposal cancel destination address: %w", err)
+		}
+	}
+
+	return p.validateExpedited()
+}
+
+// validateExpedited checks that the expedited params are valid and stricter
+// than the regular ones: a shorter voting period, a higher threshold and a
+// higher minimum deposit. A regular voting period of 1ns or a regular
+// threshold of 1 cannot be made stricter, so the expedited one may equal it.
+func (p Params) validateExpedited() error {
+	if p.ExpeditedVotingPeriod == nil {
+		return errors.New("expedited voting period must not be nil")
+	}
+	if p.ExpeditedVotingPeriod.Seconds() <= 0 {
+		return fmt.Errorf("expedited voting period must be positive: %s", p.ExpeditedVotingPeriod)
+	}
+	if *p.ExpeditedVotingPeriod > *p.VotingPeriod ||
+		(*p.ExpeditedVotingPeriod == *p.VotingPeriod && *p.VotingPeriod > time.Nanosecond) {
+		return fmt.Errorf("expedited voting period %s must be strictly less than the regular voting period %s", p.ExpeditedVotingPeriod, p.VotingPeriod)
+	}
+
+	expeditedThreshold, err := sdk.NewDecFromStr(p.ExpeditedThreshold)
+	if err != nil {
+		return fmt.Errorf("invalid expedited threshold string: %w", err)
+	}
+	if expeditedThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("expedited vote threshold too large: %s", expeditedThreshold)
+	}
+	// the regular threshold is known to be valid at this point
+	threshold, _ := sdk.NewDecFromStr(p.Threshold)
+	if expeditedThreshold.LT(threshold) || (expeditedThreshold.Equal(threshold) && threshold.LT(sdk.OneDec())) {
+		return fmt.Errorf("expedited vote threshold %s must be greater than the regular threshold %s", expeditedThreshold, threshold)
+	}
+
+	expeditedMinDeposit := sdk.Coins(p.ExpeditedMinDeposit)
+	if expeditedMinDeposit.Empty() || !expeditedMinDeposit.IsValid() {
+		return fmt.Errorf("invalid expedited minimum deposit: %s", expeditedMinDeposit)
+	}
+	if !expeditedMinDeposit.IsAllGTE(p.MinDeposit) {
+		return fmt.Errorf("expedited minimum deposit %s must be greater than or equal to the regular minimum deposit %s", expeditedMinDeposit, sdk.Coins(p.MinDeposit))
+	}
+
+	return nil
+}
+
+// ProposalMinDeposit returns the minimum deposit required for a proposal to enter
+// its voting period.
+func (p Params) ProposalMinDeposit(expedited bool) sdk.Coins {
+	if expedited {
+		return p.ExpeditedMinDeposit
+	}
+
+	return p.MinDeposit
+}
+
+// ProposalVotingPeriod returns the voting period of a proposal.
+func (p Params) ProposalVotingPeriod(expedited bool) time.Duration {
+	if expedited {
+		return *p.ExpeditedVotingPeriod
+	}
+
+	return *p.VotingPeriod
+}
+
+// ProposalThreshold returns the minimum proportion of Yes votes for a proposal to
+// pass.
+func (p Params) ProposalThreshold(expedited bool) string {
+	if expedited {
+		return p.ExpeditedThreshold
+	}
+
+	return p.Threshold
 }
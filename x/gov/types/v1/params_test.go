@@ -0,0 +1,124 @@
+package v1_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+func TestParamsValidateExpedited(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(params *v1.Params)
+		expErr   string
+	}{
+		{"valid", func(*v1.Params) {}, ""},
+		{
+			"nil expedited voting period",
+			func(params *v1.Params) { params.ExpeditedVotingPeriod = nil },
+			"expedited voting period must not be nil",
+		},
+		{
+			"expedited voting period not shorter than the voting period",
+			func(params *v1.Params) { params.ExpeditedVotingPeriod = params.VotingPeriod },
+			"must be strictly less than the regular voting period",
+		},
+		{
+			"expedited voting period equal to a voting period of 1ns",
+			func(params *v1.Params) {
+				votingPeriod := time.Nanosecond
+				params.VotingPeriod = &votingPeriod
+				params.ExpeditedVotingPeriod = &votingPeriod
+			},
+			"",
+		},
+		{
+			"expedited threshold not higher than the threshold",
+			func(params *v1.Params) { params.ExpeditedThreshold = params.Threshold },
+			"must be greater than the regular threshold",
+		},
+		{
+			"expedited threshold equal to a threshold of 1",
+			func(params *v1.Params) {
+				params.Threshold = sdk.OneDec().String()
+				params.ExpeditedThreshold = sdk.OneDec().String()
+			},
+			"",
+		},
+		{
+			"expedited threshold lower than a threshold of 1",
+			func(params *v1.Params) {
+				params.Threshold = sdk.OneDec().String()
+				params.ExpeditedThreshold = "0.9"
+			},
+			"must be greater than the regular threshold",
+		},
+		{
+			"expedited threshold too large",
+			func(params *v1.Params) { params.ExpeditedThreshold = "1.1" },
+			"expedited vote threshold too large",
+		},
+		{
+			"empty expedited min deposit",
+			func(params *v1.Params) { params.ExpeditedMinDeposit = nil },
+			"invalid expedited minimum deposit",
+		},
+		{
+			"expedited min deposit lower than the min deposit",
+			func(params *v1.Params) {
+				params.ExpeditedMinDeposit = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, v1.DefaultMinDepositTokens.SubRaw(1)))
+			},
+			"must be greater than or equal to the regular minimum deposit",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := v1.DefaultParams()
+			tc.malleate(&params)
+
+			err := params.ValidateBasic()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestNewParamsFromLegacyExpedited(t *testing.T) {
+	minDeposit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, v1.DefaultMinExpeditedDepositTokens.MulRaw(2)))
+	dp := v1.NewDepositParams(minDeposit, v1.DefaultPeriod)
+	vp := v1.NewVotingParams(time.Hour)
+	tp := v1.NewTallyParams(v1.DefaultQuorum, sdk.NewDecWithPrec(8, 1), v1.DefaultVetoThreshold)
+
+	// the expedited params are adjusted to remain stricter than the legacy ones
+	params := v1.NewParamsFromLegacy(dp, vp, tp)
+	require.NoError(t, params.ValidateBasic())
+	require.Equal(t, 30*time.Minute, *params.ExpeditedVotingPeriod)
+	require.Equal(t, sdk.NewDecWithPrec(9, 1).String(), params.ExpeditedThreshold)
+	require.Equal(t, minDeposit.MulInt(sdk.NewInt(v1.DefaultExpeditedMinDepositRatio)), sdk.Coins(params.ExpeditedMinDeposit))
+}
+
+func TestNewParamsFromLegacyBounds(t *testing.T) {
+	dp := v1.DefaultDepositParams()
+
+	// the expedited params can't be stricter than a voting period of 1ns and
+	// a threshold of 1, they are set to the same values
+	params := v1.NewParamsFromLegacy(dp, v1.NewVotingParams(time.Nanosecond), v1.NewTallyParams(v1.DefaultQuorum, sdk.OneDec(), v1.DefaultVetoThreshold))
+	require.NoError(t, params.ValidateBasic())
+	require.Equal(t, time.Nanosecond, *params.ExpeditedVotingPeriod)
+	require.Equal(t, sdk.OneDec().String(), params.ExpeditedThreshold)
+
+	// a threshold whose halfway point to 1 rounds down to itself
+	threshold := sdk.OneDec().Sub(sdk.SmallestDec())
+	params = v1.NewParamsFromLegacy(dp, v1.NewVotingParams(2*time.Nanosecond), v1.NewTallyParams(v1.DefaultQuorum, threshold, v1.DefaultVetoThreshold))
+	require.NoError(t, params.ValidateBasic())
+	require.Equal(t, time.Nanosecond, *params.ExpeditedVotingPeriod)
+	require.True(t, sdk.MustNewDecFromStr(params.ExpeditedThreshold).GT(threshold))
+}
@@ -7,9 +7,12 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	types1 "github.com/tendermint/tendermint/abci/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -509,12 +512,17 @@ func (m *QueryParamsRequest) GetParamsType() string {
 
 // QueryParamsResponse is the response type for the Query/Params RPC method.
 type QueryParamsResponse struct {
+	// Deprecated: Prefer to use `params` instead.
 	// voting_params defines the parameters related to voting.
-	VotingParams *VotingParams `protobuf:"bytes,1,opt,name=voting_params,json=votingParams,proto3" json:"voting_params,omitempty"`
+	VotingParams *VotingParams `protobuf:"bytes,1,opt,name=voting_params,json=votingParams,proto3" json:"voting_params,omitempty"` // Deprecated: Do not use.
+	// Deprecated: Prefer to use `params` instead.
 	// deposit_params defines the parameters related to deposit.
-	DepositParams *DepositParams `protobuf:"bytes,2,opt,name=deposit_params,json=depositParams,proto3" json:"deposit_params,omitempty"`
+	DepositParams *DepositParams `protobuf:"bytes,2,opt,name=deposit_params,json=depositParams,proto3" json:"deposit_params,omitempty"` // Deprecated: Do not use.
+	// Deprecated: Prefer to use `params` instead.
 	// tally_params defines the parameters related to tally.
-	TallyParams *TallyParams `protobuf:"bytes,3,opt,name=tally_params,json=tallyParams,proto3" json:"tally_params,omitempty"`
+	TallyParams *TallyParams `protobuf:"bytes,3,opt,name=tally_params,json=tallyParams,proto3" json:"tally_params,omitempty"` // Deprecated: Do not use.
+	// params defines all the paramaters of x/gov module.
+	Params *Params `protobuf:"bytes,4,opt,name=params,proto3" json:"params,omitempty"`
 }
 
 func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
@@ -550,6 +558,7 @@ func (m *QueryParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
 
+// Deprecated: Do not use.
 func (m *QueryParamsResponse) GetVotingParams() *VotingParams {
 	if m != nil {
 		return m.VotingParams
@@ -557,6 +566,7 @@ func (m *QueryParamsResponse) GetVotingParams() *VotingParams {
 	return nil
 }
 
+// Deprecated: Do not use.
 func (m *QueryParamsResponse) GetDepositParams() *DepositParams {
 	if m != nil {
 		return m.DepositParams
@@ -564,6 +574,7 @@ func (m *QueryParamsResponse) GetDepositParams() *DepositParams {
 	return nil
 }
 
+// Deprecated: Do not use.
 func (m *QueryParamsResponse) GetTallyParams() *TallyParams {
 	if m != nil {
 		return m.TallyParams
@@ -571,6 +582,13 @@ func (m *QueryParamsResponse) GetTallyParams() *TallyParams {
 	return nil
 }
 
+func (m *QueryParamsResponse) GetParams() *Params {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
 // QueryDepositRequest is the request type for the Query/Deposit RPC method.
 type QueryDepositRequest struct {
 	// proposal_id defines the unique id of the proposal.
@@ -873,6 +891,149 @@ func (m *QueryTallyResultResponse) GetTally() *TallyResult {
 	return nil
 }
 
+// QuerySimulateProposalRequest is the request type for the
+// Query/SimulateProposal RPC method.
+type QuerySimulateProposalRequest struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *QuerySimulateProposalRequest) Reset()         { *m = QuerySimulateProposalRequest{} }
+func (m *QuerySimulateProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateProposalRequest) ProtoMessage()    {}
+func (*QuerySimulateProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{16}
+}
+func (m *QuerySimulateProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateProposalRequest.Merge(m, src)
+}
+func (m *QuerySimulateProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateProposalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateProposalRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateProposalRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// QuerySimulateProposalResponse is the response type for the
+// Query/SimulateProposal RPC method.
+type QuerySimulateProposalResponse struct {
+	// success is true if all the messages of the proposal would be executed
+	// without error.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// failed_msg_index is the index of the first failing message, it is only
+	// set when success is false.
+	FailedMsgIndex uint64 `protobuf:"varint,2,opt,name=failed_msg_index,json=failedMsgIndex,proto3" json:"failed_msg_index,omitempty"`
+	// error is the execution error of the failing message.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// msg_responses contains the responses of the successfully executed
+	// messages.
+	MsgResponses []*types.Any `protobuf:"bytes,4,rep,name=msg_responses,json=msgResponses,proto3" json:"msg_responses,omitempty"`
+	// events contains the events emitted by the successfully executed messages.
+	Events []types1.Event `protobuf:"bytes,5,rep,name=events,proto3" json:"events"`
+	// gas_used is the gas consumed by the executed messages. The simulation
+	// fails with an out of gas error past the gas limit configured by the node.
+	GasUsed uint64 `protobuf:"varint,6,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+}
+
+func (m *QuerySimulateProposalResponse) Reset()         { *m = QuerySimulateProposalResponse{} }
+func (m *QuerySimulateProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateProposalResponse) ProtoMessage()    {}
+func (*QuerySimulateProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{17}
+}
+func (m *QuerySimulateProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateProposalResponse.Merge(m, src)
+}
+func (m *QuerySimulateProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateProposalResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateProposalResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *QuerySimulateProposalResponse) GetFailedMsgIndex() uint64 {
+	if m != nil {
+		return m.FailedMsgIndex
+	}
+	return 0
+}
+
+func (m *QuerySimulateProposalResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *QuerySimulateProposalResponse) GetMsgResponses() []*types.Any {
+	if m != nil {
+		return m.MsgResponses
+	}
+	return nil
+}
+
+func (m *QuerySimulateProposalResponse) GetEvents() []types1.Event {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *QuerySimulateProposalResponse) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryProposalRequest)(nil), "cosmos.gov.v1.QueryProposalRequest")
 	proto.RegisterType((*QueryProposalResponse)(nil), "cosmos.gov.v1.QueryProposalResponse")
@@ -890,72 +1051,88 @@ func init() {
 	proto.RegisterType((*QueryDepositsResponse)(nil), "cosmos.gov.v1.QueryDepositsResponse")
 	proto.RegisterType((*QueryTallyResultRequest)(nil), "cosmos.gov.v1.QueryTallyResultRequest")
 	proto.RegisterType((*QueryTallyResultResponse)(nil), "cosmos.gov.v1.QueryTallyResultResponse")
+	proto.RegisterType((*QuerySimulateProposalRequest)(nil), "cosmos.gov.v1.QuerySimulateProposalRequest")
+	proto.RegisterType((*QuerySimulateProposalResponse)(nil), "cosmos.gov.v1.QuerySimulateProposalResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/query.proto", fileDescriptor_46a436d1109b50d0) }
 
 var fileDescriptor_46a436d1109b50d0 = []byte{
-	// 945 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x5d, 0x6f, 0xdc, 0x44,
-	0x14, 0xcd, 0x6c, 0x92, 0x76, 0xf7, 0xa6, 0x09, 0x70, 0x5b, 0x9a, 0xc5, 0x94, 0x6d, 0x70, 0x68,
-	0x12, 0x28, 0xf5, 0xb0, 0xe9, 0x97, 0x04, 0xad, 0x04, 0x2d, 0x04, 0x90, 0x78, 0x08, 0xdb, 0x8a,
-	0x07, 0x5e, 0x22, 0x27, 0x6b, 0x19, 0x8b, 0x8d, 0xc7, 0xdd, 0x99, 0x5d, 0x11, 0xd2, 0x08, 0xa9,
-	0x12, 0x82, 0x27, 0x40, 0xa2, 0x12, 0xfc, 0x10, 0x7e, 0x04, 0x8f, 0x15, 0xbc, 0x20, 0x78, 0x41,
-	0x09, 0x3f, 0x04, 0x79, 0xe6, 0xda, 0x6b, 0x3b, 0xde, 0xcd, 0xa6, 0x8a, 0xfa, 0x14, 0x8d, 0x7d,
-	0xee, 0xb9, 0xe7, 0x7e, 0xf8, 0x4c, 0x16, 0x5e, 0xda, 0x12, 0x72, 0x5b, 0x48, 0xee, 0x8b, 0x3e,
-	0xef, 0x37, 0xf9, 0x83, 0x9e, 0xd7, 0xdd, 0x71, 0xa2, 0xae, 0x50, 0x02, 0x67, 0xcd, 0x2b, 0xc7,
-	0x17, 0x7d, 0xa7, 0xdf, 0xb4, 0xde, 0x20, 0xe4, 0xa6, 0x2b, 0x3d, 0x83, 0xe3, 0xfd, 0xe6, 0xa6,
-	0xa7, 0xdc, 0x26, 0x8f, 0x5c, 0x3f, 0x08, 0x5d, 0x15, 0x88, 0xd0, 0x84, 0x5a, 0x17, 0x7c, 0x21,
-	0xfc, 0x8e, 0xc7, 0xdd, 0x28, 0xe0, 0x6e, 0x18, 0x0a, 0xa5, 0x5f, 0x4a, 0x7a, 0x3b, 0x9f, 0xcf,
-	0x19, 0xf3, 0x9b, 0x17, 0x24, 0x66, 0x43, 0x9f, 0x38, 0xa5, 0xd7, 0x07, 0xfb, 0x26, 0x9c, 0xfb,
-	0x34, 0xce, 0xb9, 0xde, 0x15, 0x91, 0x90, 0x6e, 0xa7, 0xe5, 0x3d, 0xe8, 0x79, 0x52, 0xe1, 0x45,
-	0x98, 0x89, 0xe8, 0xd1, 0x46, 0xd0, 0xae, 0xb3, 0x05, 0xb6, 0x32, 0xd5, 0x82, 0xe4, 0xd1, 0xc7,
-	0x6d, 0xfb, 0x13, 0x78, 0xb1, 0x10, 0x28, 0x23, 0x11, 0x4a, 0x0f, 0xaf, 0x42, 0x35, 0x81, 0xe9,
-	0xb0, 0x99, 0xd5, 0x79, 0x27, 0x57, 0xb1, 0x93, 0x86, 0xa4, 0x40, 0xfb, 0xc7, 0x4a, 0x81, 0x4e,
-	0x26, 0x42, 0xd6, 0xe0, 0xb9, 0x54, 0x88, 0x54, 0xae, 0xea, 0x49, 0xcd, 0x3a, 0xb7, 0xfa, 0xca,
-	0x10, 0xd6, 0x7b, 0x1a, 0xd4, 0x9a, 0x8b, 0x72, 0x67, 0x74, 0x60, 0xba, 0x2f, 0x94, 0xd7, 0xad,
-	0x57, 0x16, 0xd8, 0x4a, 0xed, 0x4e, 0xfd, 0x8f, 0xdf, 0xae, 0x9c, 0x23, 0x82, 0xf7, 0xda, 0xed,
-	0xae, 0x27, 0xe5, 0x3d, 0xd5, 0x0d, 0x42, 0xbf, 0x65, 0x60, 0x78, 0x03, 0x6a, 0x6d, 0x2f, 0x12,
-	0x32, 0x50, 0xa2, 0x5b, 0x9f, 0x3c, 0x22, 0x66, 0x00, 0xc5, 0x35, 0x80, 0xc1, 0xd8, 0xea, 0x53,
-	0xba, 0x01, 0x4b, 0x89, 0xd4, 0x78, 0xc6, 0x8e, 0xd9, 0x05, 0x9a, 0xb1, 0xb3, 0xee, 0xfa, 0x1e,
-	0xd5, 0xda, 0xca, 0x44, 0xda, 0xbf, 0x32, 0x38, 0x5f, 0xec, 0x08, 0x75, 0xf8, 0x3a, 0xd4, 0x92,
-	0xe2, 0xe2, 0x66, 0x4c, 0x8e, 0x6a, 0xf1, 0x00, 0x89, 0x1f, 0xe6, 0x94, 0x55, 0xb4, 0xb2, 0xe5,
-	0x23, 0x95, 0x99, 0x9c, 0x39, 0x69, 0x5b, 0xf0, 0xbc, 0x56, 0xf6, 0x99, 0x50, 0xde, 0xb8, 0xfb,
-	0x72, 0xdc, 0xfe, 0xdb, 0xb7, 0xe0, 0x85, 0x4c, 0x12, 0xaa, 0x7c, 0x19, 0xa6, 0xe2, 0xb7, 0xb4,
-	0x57, 0x67, 0x0b, 0x45, 0x6b, 0xa8, 0x06, 0xd8, 0x0f, 0x33, 0xd1, 0x72, 0x6c, 0x8d, 0x6b, 0x25,
-	0x1d, 0x7a, 0x9a, 0xd9, 0x7d, 0xcf, 0x00, 0xb3, 0xe9, 0x49, 0xfd, 0xeb, 0xa6, 0x05, 0xc9, 0xcc,
-	0x4a, 0xe5, 0x1b, 0xc4, 0xc9, 0xcd, 0xea, 0x3a, 0x29, 0x59, 0x77, 0xbb, 0xee, 0x76, 0xae, 0x13,
-	0xfa, 0xc1, 0x86, 0xda, 0x89, 0x4c, 0x3b, 0x6b, 0x71, 0x58, 0xfc, 0xe8, 0xfe, 0x4e, 0xe4, 0xd9,
-	0xff, 0x30, 0x38, 0x9b, 0x8b, 0xa3, 0x12, 0xde, 0x85, 0xd9, 0xbe, 0x50, 0x41, 0xe8, 0x6f, 0x18,
-	0x30, 0x4d, 0xe2, 0xe5, 0xc3, 0xa5, 0x04, 0xa1, 0x4f, 0xb1, 0x67, 0xfa, 0x99, 0x13, 0xde, 0x85,
-	0x39, 0xfa, 0x58, 0x12, 0x0a, 0x53, 0xdd, 0x85, 0x02, 0xc5, 0xfb, 0x06, 0x44, 0x1c, 0xb3, 0xed,
-	0xec, 0x11, 0x6f, 0xc3, 0x19, 0xe5, 0x76, 0x3a, 0x3b, 0x09, 0xc5, 0xa4, 0xa6, 0xb0, 0x0a, 0x14,
-	0xf7, 0x63, 0x08, 0x11, 0xcc, 0xa8, 0xc1, 0xc1, 0x0e, 0xa9, 0x38, 0xca, 0x31, 0xf6, 0x7e, 0xe4,
-	0x3c, 0xa1, 0x32, 0xb6, 0x27, 0xd8, 0x1f, 0x91, 0xc9, 0xa6, 0xf9, 0xa8, 0x9b, 0x6f, 0xc1, 0x69,
-	0x02, 0x51, 0x1f, 0xcf, 0x97, 0x37, 0xa1, 0x95, 0xc0, 0xec, 0x6f, 0xf2, 0x4c, 0xcf, 0x7e, 0xb5,
-	0x1f, 0x33, 0x32, 0xea, 0x81, 0x02, 0x2a, 0x66, 0x15, 0xaa, 0xa4, 0x32, 0x59, 0xf0, 0x61, 0xd5,
-	0xa4, 0xb8, 0x93, 0x5b, 0xf3, 0xb7, 0x61, 0x5e, 0xab, 0xd2, 0x23, 0x6f, 0x79, 0xb2, 0xd7, 0x51,
-	0xc7, 0xb8, 0xc9, 0xea, 0x87, 0x63, 0xd3, 0x09, 0x4d, 0xeb, 0xc5, 0xa1, 0xf9, 0x94, 0x6e, 0x18,
-	0x85, 0x18, 0xe0, 0xea, 0xdf, 0x55, 0x98, 0xd6, 0x74, 0xf8, 0x2d, 0x83, 0x6a, 0xe2, 0xc3, 0xb8,
-	0x58, 0x88, 0x2c, 0xbb, 0x74, 0xad, 0xd7, 0x46, 0x83, 0x8c, 0x26, 0xdb, 0x79, 0xf4, 0xe7, 0x7f,
-	0x3f, 0x57, 0x56, 0x70, 0x89, 0xe7, 0xef, 0xfb, 0xd4, 0xe9, 0xf9, 0x6e, 0xa6, 0xe0, 0x3d, 0xfc,
-	0x1a, 0x6a, 0xe9, 0x1d, 0x82, 0x23, 0x53, 0x24, 0xeb, 0x64, 0x5d, 0x3a, 0x02, 0x45, 0x4a, 0x16,
-	0xb4, 0x12, 0x0b, 0xeb, 0xc3, 0x94, 0xe0, 0x77, 0x0c, 0xa6, 0x62, 0x5f, 0xc3, 0x8b, 0x65, 0x8c,
-	0x99, 0x0b, 0xc4, 0x5a, 0x18, 0x0e, 0xa0, 0x6c, 0xb7, 0x74, 0xb6, 0x1b, 0x78, 0x6d, 0xbc, 0xba,
-	0xb9, 0x76, 0x52, 0xbe, 0xab, 0xaf, 0x93, 0x3d, 0x7c, 0xc4, 0x60, 0x5a, 0xdb, 0x31, 0x0e, 0xcd,
-	0x94, 0x96, 0xff, 0xea, 0x08, 0x04, 0x89, 0xb9, 0xa6, 0xc5, 0x38, 0xf8, 0xe6, 0x71, 0xc4, 0xe0,
-	0x43, 0x38, 0x45, 0x0e, 0x56, 0x9a, 0x22, 0x67, 0xd2, 0x96, 0x3d, 0x0a, 0x42, 0x32, 0x2e, 0x6b,
-	0x19, 0x97, 0x70, 0xb1, 0x28, 0x43, 0xc3, 0xf8, 0x6e, 0xc6, 0xe5, 0xf7, 0xf0, 0x17, 0x06, 0xa7,
-	0xe9, 0x1b, 0xc4, 0x52, 0xf2, 0xbc, 0x1f, 0x5a, 0x8b, 0x23, 0x31, 0xa4, 0xe0, 0xae, 0x56, 0x70,
-	0x1b, 0xdf, 0x19, 0xb3, 0x11, 0xc9, 0xb7, 0xcf, 0x77, 0x53, 0x7f, 0xdc, 0xc3, 0x1f, 0x18, 0x54,
-	0x13, 0x43, 0xc1, 0x51, 0x69, 0xe5, 0xc8, 0x4f, 0xa5, 0xe8, 0x49, 0xf6, 0x4d, 0x2d, 0xae, 0x89,
-	0xfc, 0x98, 0xe2, 0xf0, 0x31, 0x83, 0x99, 0xcc, 0xc7, 0x8d, 0x4b, 0x65, 0xe9, 0x0e, 0x9b, 0x8d,
-	0xb5, 0x7c, 0x24, 0xee, 0x29, 0xf7, 0x47, 0x9b, 0xcb, 0x9d, 0x0f, 0x7e, 0xdf, 0x6f, 0xb0, 0x27,
-	0xfb, 0x0d, 0xf6, 0xef, 0x7e, 0x83, 0xfd, 0x74, 0xd0, 0x98, 0x78, 0x72, 0xd0, 0x98, 0xf8, 0xeb,
-	0xa0, 0x31, 0xf1, 0xf9, 0x65, 0x3f, 0x50, 0x5f, 0xf4, 0x36, 0x9d, 0x2d, 0xb1, 0x9d, 0x30, 0x9a,
-	0x3f, 0x57, 0x64, 0xfb, 0x4b, 0xfe, 0x95, 0xa6, 0x8f, 0xb7, 0x40, 0xc6, 0x3f, 0x2e, 0x4e, 0xe9,
-	0xff, 0xfd, 0xaf, 0xfe, 0x1f, 0x00, 0x00, 0xff, 0xff, 0xce, 0x57, 0x85, 0xcf, 0xa5, 0x0c, 0x00,
-	0x00,
+	// 1179 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x51, 0x4f, 0x1c, 0x55,
+	0x14, 0x66, 0xb6, 0xbb, 0xb0, 0x1c, 0x0a, 0xe2, 0xed, 0x52, 0x86, 0x29, 0x5d, 0x70, 0xb0, 0x80,
+	0x52, 0x66, 0x84, 0xd2, 0x36, 0x6a, 0x8d, 0x01, 0x5b, 0x6a, 0x13, 0x4d, 0x70, 0xa8, 0x3e, 0xf8,
+	0xb2, 0x19, 0x76, 0x6e, 0xc7, 0x89, 0xbb, 0x33, 0xdb, 0xb9, 0x77, 0x37, 0x45, 0x4a, 0x4c, 0x6a,
+	0x1a, 0x7d, 0x52, 0x13, 0x1b, 0xf5, 0x17, 0xf8, 0x0b, 0xfc, 0x11, 0x7d, 0x6c, 0xf4, 0xc5, 0x27,
+	0x63, 0xc0, 0x1f, 0x62, 0xe6, 0xde, 0x33, 0xcb, 0xcc, 0xb0, 0xbb, 0x2c, 0xa4, 0xf1, 0x09, 0xe6,
+	0xde, 0xef, 0x7c, 0xe7, 0x3b, 0xe7, 0x9e, 0x7b, 0xce, 0x5d, 0x98, 0xaa, 0x06, 0xac, 0x1e, 0x30,
+	0xd3, 0x0d, 0x5a, 0x66, 0x6b, 0xc5, 0x7c, 0xd8, 0xa4, 0xe1, 0xae, 0xd1, 0x08, 0x03, 0x1e, 0x90,
+	0x51, 0xb9, 0x65, 0xb8, 0x41, 0xcb, 0x68, 0xad, 0x68, 0x6f, 0x22, 0x72, 0xc7, 0x66, 0x54, 0xe2,
+	0xcc, 0xd6, 0xca, 0x0e, 0xe5, 0xf6, 0x8a, 0xd9, 0xb0, 0x5d, 0xcf, 0xb7, 0xb9, 0x17, 0xf8, 0xd2,
+	0x54, 0x2b, 0xb9, 0x81, 0x1b, 0x88, 0x7f, 0xcd, 0xe8, 0x3f, 0x5c, 0x9d, 0x76, 0x83, 0xc0, 0xad,
+	0x51, 0xd3, 0x6e, 0x78, 0xa6, 0xed, 0xfb, 0x01, 0x17, 0x26, 0x0c, 0x77, 0xa7, 0x70, 0x57, 0x7c,
+	0xed, 0x34, 0x1f, 0x98, 0xb6, 0x8f, 0x4a, 0xb4, 0xc9, 0xb4, 0xc8, 0x48, 0x10, 0xda, 0xc8, 0x8d,
+	0x8a, 0x74, 0x85, 0x7a, 0xe5, 0xd6, 0x25, 0x4e, 0x7d, 0x87, 0x86, 0x75, 0xcf, 0xe7, 0xa6, 0xbd,
+	0x53, 0xf5, 0x4c, 0xbe, 0xdb, 0xa0, 0xb8, 0xa9, 0xdf, 0x84, 0xd2, 0x27, 0x51, 0x04, 0x5b, 0x61,
+	0xd0, 0x08, 0x98, 0x5d, 0xb3, 0xe8, 0xc3, 0x26, 0x65, 0x9c, 0xcc, 0xc0, 0x48, 0x03, 0x97, 0x2a,
+	0x9e, 0xa3, 0x2a, 0xb3, 0xca, 0x62, 0xde, 0x82, 0x78, 0xe9, 0x9e, 0xa3, 0x7f, 0x04, 0x13, 0x19,
+	0x43, 0xd6, 0x08, 0x7c, 0x46, 0xc9, 0x35, 0x28, 0xc6, 0x30, 0x61, 0x36, 0xb2, 0x3a, 0x69, 0xa4,
+	0xf2, 0x67, 0xb4, 0x4d, 0xda, 0x40, 0xfd, 0x87, 0x5c, 0x86, 0x8e, 0xc5, 0x42, 0x36, 0xe1, 0x95,
+	0xb6, 0x10, 0xc6, 0x6d, 0xde, 0x64, 0x82, 0x75, 0x6c, 0xf5, 0x72, 0x17, 0xd6, 0x6d, 0x01, 0xb2,
+	0xc6, 0x1a, 0xa9, 0x6f, 0x62, 0x40, 0xa1, 0x15, 0x70, 0x1a, 0xaa, 0xb9, 0x59, 0x65, 0x71, 0x78,
+	0x43, 0xfd, 0xe3, 0xf7, 0xe5, 0x12, 0x12, 0xac, 0x3b, 0x4e, 0x48, 0x19, 0xdb, 0xe6, 0xa1, 0xe7,
+	0xbb, 0x96, 0x84, 0x91, 0x1b, 0x30, 0xec, 0xd0, 0x46, 0xc0, 0x3c, 0x1e, 0x84, 0xea, 0xb9, 0x13,
+	0x6c, 0x8e, 0xa0, 0x64, 0x13, 0xe0, 0xa8, 0x08, 0xd4, 0xbc, 0x48, 0xc0, 0x7c, 0x2c, 0x35, 0xaa,
+	0x18, 0x43, 0x56, 0x16, 0x56, 0x8c, 0xb1, 0x65, 0xbb, 0x14, 0x63, 0xb5, 0x12, 0x96, 0xfa, 0xaf,
+	0x0a, 0x5c, 0xcc, 0x66, 0x04, 0x33, 0x7c, 0x1d, 0x86, 0xe3, 0xe0, 0xa2, 0x64, 0x9c, 0xeb, 0x95,
+	0xe2, 0x23, 0x24, 0xb9, 0x9b, 0x52, 0x96, 0x13, 0xca, 0x16, 0x4e, 0x54, 0x26, 0x7d, 0xa6, 0xa4,
+	0x55, 0x61, 0x5c, 0x28, 0xfb, 0x2c, 0xe0, 0xb4, 0xdf, 0x7a, 0x39, 0x6d, 0xfe, 0xf5, 0x5b, 0xf0,
+	0x6a, 0xc2, 0x09, 0x46, 0xbe, 0x00, 0xf9, 0x68, 0x17, 0xeb, 0xea, 0x42, 0x26, 0x68, 0x01, 0x15,
+	0x00, 0xfd, 0x71, 0xc2, 0x9a, 0xf5, 0xad, 0x71, 0xb3, 0x43, 0x86, 0xce, 0x72, 0x76, 0xdf, 0x29,
+	0x40, 0x92, 0xee, 0x51, 0xfd, 0x1b, 0x32, 0x05, 0xf1, 0x99, 0x75, 0x94, 0x2f, 0x11, 0x2f, 0xef,
+	0xac, 0xae, 0xa3, 0x92, 0x2d, 0x3b, 0xb4, 0xeb, 0xa9, 0x4c, 0x88, 0x85, 0x4a, 0xd4, 0x0b, 0x44,
+	0x26, 0x86, 0x2d, 0x90, 0x4b, 0xf7, 0x77, 0x1b, 0x54, 0xff, 0x39, 0x07, 0x17, 0x52, 0x76, 0x18,
+	0xc2, 0x6d, 0x18, 0x6d, 0x05, 0xdc, 0xf3, 0xdd, 0x8a, 0x04, 0xe3, 0x49, 0x5c, 0x3a, 0x1e, 0x8a,
+	0xe7, 0xbb, 0xd2, 0x76, 0x23, 0xa7, 0x2a, 0xd6, 0xf9, 0x56, 0x62, 0x85, 0xdc, 0x85, 0x31, 0xbc,
+	0x30, 0x31, 0x8d, 0x8c, 0x70, 0x3a, 0x43, 0x73, 0x5b, 0x82, 0x12, 0x3c, 0xa3, 0x4e, 0x72, 0x89,
+	0xac, 0xc3, 0x79, 0x6e, 0xd7, 0x6a, 0xbb, 0x31, 0xcd, 0x39, 0x41, 0xa3, 0x65, 0x68, 0xee, 0x47,
+	0x90, 0x04, 0xc9, 0x08, 0x3f, 0x5a, 0x20, 0xcb, 0x30, 0x88, 0xc6, 0xf2, 0xae, 0x4e, 0x64, 0x6f,
+	0x92, 0x4c, 0x00, 0x82, 0x74, 0x1f, 0xf3, 0x82, 0xd2, 0xfa, 0x2e, 0xad, 0x54, 0x3b, 0xc9, 0xf5,
+	0xdd, 0x4e, 0xf4, 0x0f, 0xa1, 0x94, 0xf6, 0x87, 0x07, 0xf1, 0x16, 0x0c, 0x21, 0x08, 0x8f, 0xe0,
+	0x62, 0xe7, 0xdc, 0x59, 0x31, 0x4c, 0xff, 0x3a, 0xcd, 0xf4, 0xff, 0xdf, 0x8a, 0x67, 0x0a, 0x4c,
+	0x64, 0x14, 0x60, 0x30, 0xab, 0x50, 0x44, 0x95, 0xf1, 0xdd, 0xe8, 0x16, 0x4d, 0x1b, 0xf7, 0xf2,
+	0x6e, 0xc8, 0x3b, 0x30, 0x29, 0x54, 0x89, 0x2a, 0xb1, 0x28, 0x6b, 0xd6, 0xf8, 0x29, 0x86, 0xa0,
+	0x7a, 0xdc, 0xb6, 0x7d, 0x42, 0x05, 0x51, 0x67, 0xaa, 0xd2, 0xbd, 0x28, 0xd1, 0x44, 0x02, 0xf5,
+	0xf7, 0x61, 0x5a, 0xb0, 0x6d, 0x7b, 0xf5, 0x66, 0xcd, 0xe6, 0xf4, 0xd4, 0x33, 0xf9, 0x69, 0x0e,
+	0x2e, 0x77, 0x61, 0x40, 0x51, 0x2a, 0x0c, 0xb1, 0x66, 0xb5, 0x4a, 0x99, 0xbc, 0xb9, 0x45, 0x2b,
+	0xfe, 0x24, 0x8b, 0x30, 0xfe, 0xc0, 0xf6, 0x6a, 0xd4, 0xa9, 0xd4, 0x99, 0x5b, 0xf1, 0x7c, 0x87,
+	0x3e, 0x12, 0x59, 0xcd, 0x5b, 0x63, 0x72, 0xfd, 0x63, 0xe6, 0xde, 0x8b, 0x56, 0x49, 0x09, 0x0a,
+	0x34, 0x0c, 0xe3, 0xa9, 0x68, 0xc9, 0x0f, 0xf2, 0x36, 0x8c, 0x46, 0x86, 0x21, 0x7a, 0x8a, 0xae,
+	0x53, 0x74, 0x90, 0x25, 0x43, 0x3e, 0x66, 0x8c, 0xf8, 0x31, 0x63, 0xac, 0xfb, 0xbb, 0xd6, 0xf9,
+	0x3a, 0x73, 0x63, 0x4d, 0x8c, 0xac, 0xc1, 0x20, 0x6d, 0x51, 0x9f, 0x33, 0xb5, 0x80, 0x87, 0x7f,
+	0xf4, 0x62, 0x31, 0xa2, 0x17, 0x8b, 0x71, 0x27, 0xda, 0xde, 0xc8, 0x3f, 0xff, 0x7b, 0x66, 0xc0,
+	0x42, 0x2c, 0x99, 0x82, 0xa2, 0x6b, 0xb3, 0x4a, 0x93, 0x51, 0x47, 0x1d, 0x14, 0x42, 0x87, 0x5c,
+	0x9b, 0x7d, 0xca, 0xa8, 0xb3, 0xfa, 0x0d, 0x40, 0x41, 0xe4, 0x81, 0x3c, 0x55, 0xa0, 0x18, 0x27,
+	0x81, 0xcc, 0x65, 0x8e, 0xa0, 0xd3, 0xc3, 0x47, 0x7b, 0xbd, 0x37, 0x48, 0x6a, 0xd6, 0x8d, 0x27,
+	0x7f, 0xfe, 0xfb, 0x53, 0x6e, 0x91, 0xcc, 0x9b, 0xe9, 0x07, 0x59, 0x7b, 0xda, 0x9a, 0x7b, 0x89,
+	0xa3, 0xda, 0x27, 0x5f, 0xc1, 0x70, 0xcc, 0xc1, 0x48, 0x4f, 0x17, 0xf1, 0xbd, 0xd4, 0xae, 0x9c,
+	0x80, 0x42, 0x25, 0xb3, 0x42, 0x89, 0x46, 0xd4, 0x6e, 0x4a, 0xc8, 0xb7, 0x0a, 0xe4, 0xa3, 0xd9,
+	0x42, 0x66, 0x3a, 0x31, 0x26, 0x86, 0xb8, 0x36, 0xdb, 0x1d, 0x80, 0xde, 0x6e, 0x09, 0x6f, 0x37,
+	0xc8, 0x5a, 0x7f, 0x71, 0x9b, 0x62, 0x9a, 0x99, 0x7b, 0xd1, 0x9f, 0x70, 0x9f, 0x3c, 0x51, 0xa0,
+	0x10, 0xd1, 0x31, 0xd2, 0xd5, 0x53, 0x3b, 0xfc, 0xd7, 0x7a, 0x20, 0x50, 0xcc, 0x9a, 0x10, 0x63,
+	0x90, 0xab, 0xa7, 0x11, 0x43, 0x1e, 0xc3, 0x20, 0xb6, 0xfe, 0x8e, 0x2e, 0x52, 0x83, 0x52, 0xd3,
+	0x7b, 0x41, 0x50, 0xc6, 0x92, 0x90, 0x71, 0x85, 0xcc, 0x65, 0x65, 0x08, 0x98, 0xb9, 0x97, 0x98,
+	0xb4, 0xfb, 0xe4, 0x17, 0x05, 0x86, 0xb0, 0x99, 0x91, 0x8e, 0xe4, 0xe9, 0xc1, 0xa2, 0xcd, 0xf5,
+	0xc4, 0xa0, 0x82, 0x0f, 0x84, 0x82, 0xf7, 0xc8, 0xbb, 0x7d, 0x26, 0x22, 0x6e, 0xa2, 0xe6, 0x5e,
+	0x7b, 0xd0, 0xec, 0x93, 0xef, 0x15, 0x28, 0x22, 0x31, 0x23, 0xbd, 0xdc, 0xb2, 0x9e, 0x57, 0x25,
+	0xdb, 0xdc, 0xf5, 0x9b, 0x42, 0xdc, 0x0a, 0x31, 0x4f, 0x29, 0x8e, 0x3c, 0x53, 0x60, 0x24, 0xd1,
+	0x25, 0xc9, 0x7c, 0x27, 0x77, 0xc7, 0xbb, 0xb6, 0xb6, 0x70, 0x22, 0xee, 0x8c, 0xf5, 0x23, 0xba,
+	0x34, 0xf9, 0x4d, 0x81, 0xf1, 0x6c, 0x7f, 0x25, 0x4b, 0x9d, 0x7c, 0x76, 0xe9, 0xe3, 0xda, 0xd5,
+	0xfe, 0xc0, 0x67, 0xcc, 0x1f, 0x43, 0xa2, 0x8d, 0x3b, 0xcf, 0x0f, 0xca, 0xca, 0x8b, 0x83, 0xb2,
+	0xf2, 0xcf, 0x41, 0x59, 0xf9, 0xf1, 0xb0, 0x3c, 0xf0, 0xe2, 0xb0, 0x3c, 0xf0, 0xd7, 0x61, 0x79,
+	0xe0, 0xf3, 0x25, 0xd7, 0xe3, 0x5f, 0x34, 0x77, 0x8c, 0x6a, 0x50, 0x8f, 0x49, 0xe5, 0x9f, 0x65,
+	0xe6, 0x7c, 0x69, 0x3e, 0x12, 0x1e, 0xc4, 0x8f, 0xc4, 0xe8, 0x77, 0xed, 0xa0, 0xe8, 0xdc, 0xd7,
+	0xfe, 0x1b, 0x00, 0xab, 0x2d, 0xf2, 0x7b, 0x20, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -986,6 +1163,9 @@ type QueryClient interface {
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// SimulateProposal executes the messages of a proposal against the current
+	// state, without committing the state changes, and returns the outcome.
+	SimulateProposal(ctx context.Context, in *QuerySimulateProposalRequest, opts ...grpc.CallOption) (*QuerySimulateProposalResponse, error)
 }
 
 type queryClient struct {
@@ -1068,6 +1248,15 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) SimulateProposal(ctx context.Context, in *QuerySimulateProposalRequest, opts ...grpc.CallOption) (*QuerySimulateProposalResponse, error) {
+	out := new(QuerySimulateProposalResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Query/SimulateProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Proposal queries proposal details based on ProposalID.
@@ -1086,6 +1275,9 @@ type QueryServer interface {
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// SimulateProposal executes the messages of a proposal against the current
+	// state, without committing the state changes, and returns the outcome.
+	SimulateProposal(context.Context, *QuerySimulateProposalRequest) (*QuerySimulateProposalResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1116,6 +1308,9 @@ func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDeposit
 func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (*UnimplementedQueryServer) SimulateProposal(ctx context.Context, req *QuerySimulateProposalRequest) (*QuerySimulateProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateProposal not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1265,6 +1460,24 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SimulateProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Query/SimulateProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateProposal(ctx, req.(*QuerySimulateProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gov.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1301,6 +1514,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "SimulateProposal",
+			Handler:    _Query_SimulateProposal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
@@ -1681,6 +1898,18 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.TallyParams != nil {
 		{
 			size, err := m.TallyParams.MarshalToSizedBuffer(dAtA[:i])
@@ -1942,6 +2171,112 @@ func (m *QueryTallyResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QuerySimulateProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.MsgResponses) > 0 {
+		for iNdEx := len(m.MsgResponses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.FailedMsgIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FailedMsgIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2116,6 +2451,10 @@ func (m *QueryParamsResponse) Size() (n int) {
 		l = m.TallyParams.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -2208,6 +2547,52 @@ func (m *QueryTallyResultResponse) Size() (n int) {
 	return n
 }
 
+func (m *QuerySimulateProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QuerySimulateProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	if m.FailedMsgIndex != 0 {
+		n += 1 + sovQuery(uint64(m.FailedMsgIndex))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.MsgResponses) > 0 {
+		for _, e := range m.MsgResponses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.GasUsed))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3289,6 +3674,42 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3877,6 +4298,283 @@ func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuerySimulateProposalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateProposalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedMsgIndex", wireType)
+			}
+			m.FailedMsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedMsgIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgResponses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgResponses = append(m.MsgResponses, &types.Any{})
+			if err := m.MsgResponses[len(m.MsgResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, types1.Event{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -379,6 +379,36 @@ Here, we can use percentage decision policy when needed, where 0 < percentage <=
         "voting_period": "120h",
         "min_execution_period": "0s"
     }
+}
+
+A veto decision policy lets designated members block a proposal during its voting period,
+by voting VOTE_OPTION_NO_WITH_VETO with a total weight of at least veto_threshold:
+
+{
+    "@type": "/cosmos.group.v1.VetoDecisionPolicy",
+    "threshold": "2",
+    "veto_members": ["addr1", "addr2"],
+    "veto_threshold": "1",
+    "windows": {
+        "voting_period": "120h",
+        "min_execution_period": "0s"
+    }
+}
+
+Any decision policy can be wrapped in a timelock decision policy, which enforces a delay
+between the end of the voting period and the execution of an accepted proposal:
+
+{
+    "@type": "/cosmos.group.v1.TimelockDecisionPolicy",
+    "decision_policy": {
+        "@type": "/cosmos.group.v1.ThresholdDecisionPolicy",
+        "threshold": "1",
+        "windows": {
+            "voting_period": "120h",
+            "min_execution_period": "0s"
+        }
+    },
+    "timelock": "48h"
 }`, version.AppName),
 		Args: cobra.ExactArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -2,8 +2,14 @@ package cli
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/x/group"
 )
 
 func Test_ParseCLIProposal(t *testing.T) {
@@ -28,3 +34,34 @@ func Test_ParseCLIProposal(t *testing.T) {
 	require.Equal(t, result.Metadata, "4pIMOgIGx1vZGU=")
 	require.Equal(t, result.Proposers, []string{"cosmos15r295x4994egvckteam9skazy9kvfvzpak4naf"})
 }
+
+func Test_ParseDecisionPolicy(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	group.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	policyFile := testutil.WriteToNewTempFile(t, `{
+		"@type": "/cosmos.group.v1.TimelockDecisionPolicy",
+		"decision_policy": {
+			"@type": "/cosmos.group.v1.VetoDecisionPolicy",
+			"threshold": "2",
+			"veto_members": ["cosmos15r295x4994egvckteam9skazy9kvfvzpak4naf"],
+			"veto_threshold": "1",
+			"windows": {
+				"voting_period": "120h",
+				"min_execution_period": "0s"
+			}
+		},
+		"timelock": "48h"
+	}`)
+
+	policy, err := parseDecisionPolicy(cdc, policyFile.Name())
+	require.NoError(t, err)
+	require.NoError(t, policy.ValidateBasic())
+	require.Equal(t, 120*time.Hour, policy.GetVotingPeriod())
+	require.Equal(t, 168*time.Hour, policy.GetMinExecutionPeriod())
+
+	timelock, ok := policy.(*group.TimelockDecisionPolicy)
+	require.True(t, ok)
+	require.Equal(t, []string{"cosmos15r295x4994egvckteam9skazy9kvfvzpak4naf"}, timelock.GetVetoMembers())
+}
@@ -3246,6 +3246,166 @@ func (s *TestSuite) TestTallyProposalsAtVPEnd_GroupMemberLeaving() {
 	s.NotPanics(func() { module.EndBlocker(ctx, s.app.GroupKeeper) })
 }
 
+func (s *TestSuite) TestVetoDecisionPolicy() {
+	addrs := s.addrs
+	addr1 := addrs[0]
+	addr2 := addrs[1]
+	addr3 := addrs[2]
+	votingPeriod := time.Duration(4 * time.Minute)
+
+	policy := group.NewVetoDecisionPolicy("2", []string{addr1.String()}, "1", votingPeriod, 0)
+	policyAddr, _ := s.createGroupAndGroupPolicy(addr1, []group.MemberRequest{
+		{Address: addr1.String(), Weight: "1"},
+		{Address: addr2.String(), Weight: "2"},
+		{Address: addr3.String(), Weight: "1"},
+	}, policy)
+
+	submitAndVote := func(votes map[string]group.VoteOption) uint64 {
+		proposalRes, err := s.keeper.SubmitProposal(s.ctx, &group.MsgSubmitProposal{
+			GroupPolicyAddress: policyAddr,
+			Proposers:          []string{addr2.String()},
+		})
+		s.Require().NoError(err)
+		for voter, option := range votes {
+			_, err = s.keeper.Vote(s.ctx, &group.MsgVote{
+				ProposalId: proposalRes.ProposalId,
+				Voter:      voter,
+				Option:     option,
+			})
+			s.Require().NoError(err)
+		}
+		return proposalRes.ProposalId
+	}
+
+	// a proposal reaching the threshold is not accepted before the end of
+	// the voting period, and can still be vetoed
+	vetoedID := submitAndVote(map[string]group.VoteOption{addr2.String(): group.VOTE_OPTION_YES})
+	_, err := s.keeper.Exec(s.ctx, &group.MsgExec{ProposalId: vetoedID, Executor: addr2.String()})
+	s.Require().NoError(err)
+	res, err := s.keeper.Proposal(s.ctx, &group.QueryProposalRequest{ProposalId: vetoedID})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_STATUS_SUBMITTED, res.Proposal.Status)
+
+	_, err = s.keeper.Vote(s.ctx, &group.MsgVote{
+		ProposalId: vetoedID,
+		Voter:      addr1.String(),
+		Option:     group.VOTE_OPTION_NO_WITH_VETO,
+	})
+	s.Require().NoError(err)
+	_, err = s.keeper.Exec(s.ctx, &group.MsgExec{ProposalId: vetoedID, Executor: addr2.String()})
+	s.Require().NoError(err)
+	res, err = s.keeper.Proposal(s.ctx, &group.QueryProposalRequest{ProposalId: vetoedID})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_STATUS_REJECTED, res.Proposal.Status)
+
+	// vetoes of members who are not veto members are simply counted as no
+	// votes, and the proposal is accepted at the end of the voting period
+	acceptedID := submitAndVote(map[string]group.VoteOption{
+		addr2.String(): group.VOTE_OPTION_YES,
+		addr3.String(): group.VOTE_OPTION_NO_WITH_VETO,
+	})
+	ctx := s.sdkCtx.WithBlockTime(s.sdkCtx.BlockTime().Add(votingPeriod + 1))
+	execRes, err := s.keeper.Exec(sdk.WrapSDKContext(ctx), &group.MsgExec{ProposalId: acceptedID, Executor: addr2.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_SUCCESS, execRes.Result)
+}
+
+func (s *TestSuite) TestVetoDecisionPolicyMembers() {
+	addrs := s.addrs
+	addr1 := addrs[0]
+	addr2 := addrs[1]
+	addr3 := addrs[2]
+	votingPeriod := time.Duration(4 * time.Minute)
+
+	policyAddr, groupID := s.createGroupAndGroupPolicy(addr1, []group.MemberRequest{
+		{Address: addr1.String(), Weight: "1"},
+		{Address: addr2.String(), Weight: "1"},
+	}, group.NewVetoDecisionPolicy("1", []string{addr2.String()}, "1", votingPeriod, 0))
+
+	// veto members must be group members when creating a policy
+	createPolicy := &group.MsgCreateGroupPolicy{Admin: addr1.String(), GroupId: groupID}
+	err := createPolicy.SetDecisionPolicy(group.NewVetoDecisionPolicy("1", []string{addr3.String()}, "1", votingPeriod, 0))
+	s.Require().NoError(err)
+	_, err = s.keeper.CreateGroupPolicy(s.ctx, createPolicy)
+	s.Require().ErrorContains(err, "veto member "+addr3.String()+" is not in group")
+
+	// and when updating one, including through a timelock
+	timelock, err := group.NewTimelockDecisionPolicy(group.NewVetoDecisionPolicy("1", []string{addr3.String()}, "1", votingPeriod, 0), time.Hour)
+	s.Require().NoError(err)
+	updatePolicy := &group.MsgUpdateGroupPolicyDecisionPolicy{Admin: addr1.String(), GroupPolicyAddress: policyAddr}
+	s.Require().NoError(updatePolicy.SetDecisionPolicy(timelock))
+	_, err = s.keeper.UpdateGroupPolicyDecisionPolicy(s.ctx, updatePolicy)
+	s.Require().ErrorContains(err, "veto member "+addr3.String()+" is not in group")
+
+	// veto members can't be removed from the group while a policy names them,
+	// the failed msgs are run in a cache context as their writes aren't
+	// reverted outside of a tx
+	cacheCtx, _ := s.sdkCtx.CacheContext()
+	_, err = s.keeper.UpdateGroupMembers(sdk.WrapSDKContext(cacheCtx), &group.MsgUpdateGroupMembers{
+		Admin:         addr1.String(),
+		GroupId:       groupID,
+		MemberUpdates: []group.MemberRequest{{Address: addr2.String(), Weight: "0"}},
+	})
+	s.Require().ErrorContains(err, "veto member "+addr2.String()+" is not in group")
+
+	cacheCtx, _ = s.sdkCtx.CacheContext()
+	_, err = s.keeper.LeaveGroup(sdk.WrapSDKContext(cacheCtx), &group.MsgLeaveGroup{Address: addr2.String(), GroupId: groupID})
+	s.Require().ErrorContains(err, "veto member "+addr2.String()+" is not in group")
+
+	// other members can still be removed
+	_, err = s.keeper.LeaveGroup(s.ctx, &group.MsgLeaveGroup{Address: addr1.String(), GroupId: groupID})
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestTimelockDecisionPolicy() {
+	addrs := s.addrs
+	addr1 := addrs[0]
+	addr2 := addrs[1]
+	votingPeriod := time.Duration(4 * time.Minute)
+	timelock := time.Hour
+
+	policy, err := group.NewTimelockDecisionPolicy(group.NewThresholdDecisionPolicy("1", votingPeriod, 0), timelock)
+	s.Require().NoError(err)
+	policyAddr, _ := s.createGroupAndGroupPolicy(addr1, []group.MemberRequest{
+		{Address: addr1.String(), Weight: "1"},
+		{Address: addr2.String(), Weight: "1"},
+	}, policy)
+
+	proposalRes, err := s.keeper.SubmitProposal(s.ctx, &group.MsgSubmitProposal{
+		GroupPolicyAddress: policyAddr,
+		Proposers:          []string{addr1.String()},
+	})
+	s.Require().NoError(err)
+	_, err = s.keeper.Vote(s.ctx, &group.MsgVote{
+		ProposalId: proposalRes.ProposalId,
+		Voter:      addr1.String(),
+		Option:     group.VOTE_OPTION_YES,
+	})
+	s.Require().NoError(err)
+
+	// the proposal is not accepted before the end of the voting period
+	_, err = s.keeper.Exec(s.ctx, &group.MsgExec{ProposalId: proposalRes.ProposalId, Executor: addr1.String()})
+	s.Require().NoError(err)
+	res, err := s.keeper.Proposal(s.ctx, &group.QueryProposalRequest{ProposalId: proposalRes.ProposalId})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_STATUS_SUBMITTED, res.Proposal.Status)
+
+	// the proposal is accepted at the end of the voting period, but can't be
+	// executed before the timelock has elapsed
+	ctx := s.sdkCtx.WithBlockTime(s.sdkCtx.BlockTime().Add(votingPeriod + 1))
+	execRes, err := s.keeper.Exec(sdk.WrapSDKContext(ctx), &group.MsgExec{ProposalId: proposalRes.ProposalId, Executor: addr1.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_FAILURE, execRes.Result)
+	res, err = s.keeper.Proposal(sdk.WrapSDKContext(ctx), &group.QueryProposalRequest{ProposalId: proposalRes.ProposalId})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_STATUS_ACCEPTED, res.Proposal.Status)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(timelock))
+	execRes, err = s.keeper.Exec(sdk.WrapSDKContext(ctx), &group.MsgExec{ProposalId: proposalRes.ProposalId, Executor: addr1.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_SUCCESS, execRes.Result)
+}
+
 func eventTypeFound(events []abci.Event, eventType string) bool {
 	eventTypeFound := false
 	for _, e := range events {
@@ -323,7 +323,7 @@ func (k Keeper) CreateGroupPolicy(goCtx context.Context, req *group.MsgCreateGro
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "not group admin")
 	}
 
-	err = policy.Validate(g, k.config)
+	err = k.validateDecisionPolicy(ctx, policy, g)
 	if err != nil {
 		return nil, err
 	}
@@ -410,7 +410,7 @@ func (k Keeper) UpdateGroupPolicyDecisionPolicy(goCtx context.Context, req *grou
 			return err
 		}
 
-		err = policy.Validate(g, k.config)
+		err = k.validateDecisionPolicy(ctx, policy, g)
 		if err != nil {
 			return err
 		}
@@ -499,7 +499,7 @@ func (k Keeper) SubmitProposal(goCtx context.Context, req *group.MsgSubmitPropos
 	}
 
 	// Prevent proposal that can not succeed.
-	err = policy.Validate(g, k.config)
+	err = k.validateDecisionPolicy(ctx, policy, g)
 	if err != nil {
 		return nil, err
 	}
@@ -690,6 +690,22 @@ func (k Keeper) doTallyAndUpdate(ctx sdk.Context, p *group.Proposal, electorate
 		return sdkerrors.Wrap(err, "policy allow")
 	}
 
+	// Veto members can reject a proposal at any time during its voting period,
+	// regardless of the outcome of the policy's Allow method.
+	if vetoPolicy, ok := policy.(group.VetoPolicy); ok && len(vetoPolicy.GetVetoMembers()) > 0 {
+		vetoWeight, err := k.vetoWeight(ctx, *p, policyInfo.GroupId, vetoPolicy.GetVetoMembers())
+		if err != nil {
+			return err
+		}
+		vetoed, err := vetoPolicy.IsVetoed(vetoWeight)
+		if err != nil {
+			return sdkerrors.Wrap(err, "policy veto")
+		}
+		if vetoed {
+			result = group.DecisionPolicyResult{Allow: false, Final: true}
+		}
+	}
+
 	// If the result was final (i.e. enough votes to pass) or if the voting
 	// period ended, then we consider the proposal as final.
 	if isFinal := result.Final || ctx.BlockTime().After(p.VotingPeriodEnd); isFinal {
@@ -978,8 +994,28 @@ func (k Keeper) assertMetadataLength(metadata string, description string) error
 	return nil
 }
 
+// validateDecisionPolicy calls the Validate() method of a decision policy of
+// the group, and checks that its veto members, if any, are members of the group.
+func (k Keeper) validateDecisionPolicy(ctx sdk.Context, policy group.DecisionPolicy, g group.GroupInfo) error {
+	if err := policy.Validate(g, k.config); err != nil {
+		return err
+	}
+
+	vetoPolicy, ok := policy.(group.VetoPolicy)
+	if !ok {
+		return nil
+	}
+	for _, vetoMember := range vetoPolicy.GetVetoMembers() {
+		if !k.groupMemberTable.Has(ctx.KVStore(k.key), orm.PrimaryKey(&group.GroupMember{GroupId: g.Id, Member: &group.Member{Address: vetoMember}})) {
+			return sdkerrors.Wrapf(errors.ErrInvalid, "veto member %s is not in group %d", vetoMember, g.Id)
+		}
+	}
+
+	return nil
+}
+
 // validateDecisionPolicies loops through all decision policies from the group,
-// and calls each of their Validate() method.
+// and calls validateDecisionPolicy on each of them.
 func (k Keeper) validateDecisionPolicies(ctx sdk.Context, g group.GroupInfo) error {
 	it, err := k.groupPolicyByGroupIndex.Get(ctx.KVStore(k.key), g.Id)
 	if err != nil {
@@ -997,7 +1033,7 @@ func (k Keeper) validateDecisionPolicies(ctx sdk.Context, g group.GroupInfo) err
 			return err
 		}
 
-		err = groupPolicy.DecisionPolicy.GetCachedValue().(group.DecisionPolicy).Validate(g, k.config)
+		err = k.validateDecisionPolicy(ctx, groupPolicy.DecisionPolicy.GetCachedValue().(group.DecisionPolicy), g)
 		if err != nil {
 			return err
 		}
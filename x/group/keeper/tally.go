@@ -61,3 +61,48 @@ func (k Keeper) Tally(ctx sdk.Context, p group.Proposal, groupID uint64) (group.
 
 	return tallyResult, nil
 }
+
+// vetoWeight returns the weighted sum of the `NO_WITH_VETO` votes cast on a
+// proposal by the given veto members. As veto members are validated against
+// the group when the policy is created or updated, and can't be removed from
+// the group while the policy names them, a veto member missing from the group
+// is an error.
+func (k Keeper) vetoWeight(ctx sdk.Context, p group.Proposal, groupID uint64, vetoMembers []string) (string, error) {
+	vetoTally := group.DefaultTallyResult()
+
+	for _, vetoMember := range vetoMembers {
+		var member group.GroupMember
+		err := k.groupMemberTable.GetOne(ctx.KVStore(k.key), orm.PrimaryKey(&group.GroupMember{
+			GroupId: groupID,
+			Member:  &group.Member{Address: vetoMember},
+		}), &member)
+		switch {
+		case sdkerrors.ErrNotFound.Is(err):
+			return "", sdkerrors.Wrapf(errors.ErrInvalid, "veto member %s is not in group %d", vetoMember, groupID)
+		case err != nil:
+			return "", err
+		}
+
+		var vote group.Vote
+		err = k.voteTable.GetOne(ctx.KVStore(k.key), orm.PrimaryKey(&group.Vote{
+			ProposalId: p.Id,
+			Voter:      vetoMember,
+		}), &vote)
+		switch {
+		case sdkerrors.ErrNotFound.Is(err):
+			continue
+		case err != nil:
+			return "", err
+		}
+
+		if vote.Option != group.VOTE_OPTION_NO_WITH_VETO {
+			continue
+		}
+
+		if err := vetoTally.Add(vote, member.Member.Weight); err != nil {
+			return "", sdkerrors.Wrap(err, "add new veto")
+		}
+	}
+
+	return vetoTally.NoWithVetoCount, nil
+}
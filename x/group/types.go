@@ -231,6 +231,261 @@ func (p PercentageDecisionPolicy) Allow(tally TallyResult, totalPower string) (D
 	return DecisionPolicyResult{Allow: false, Final: false}, nil
 }
 
+// VetoPolicy is implemented by decision policies which allow designated group
+// members to veto a proposal. Vetoes are checked by the keeper on every tally,
+// on top of the DecisionPolicy's Allow method.
+type VetoPolicy interface {
+	// GetVetoMembers returns the addresses of the group members allowed to
+	// veto a proposal.
+	GetVetoMembers() []string
+	// IsVetoed returns true if the given weighted sum of the veto members'
+	// `NO_WITH_VETO` votes vetoes the proposal.
+	IsVetoed(vetoWeight string) (bool, error)
+}
+
+// Implements DecisionPolicy and VetoPolicy Interfaces
+var (
+	_ DecisionPolicy = &VetoDecisionPolicy{}
+	_ VetoPolicy     = &VetoDecisionPolicy{}
+)
+
+// NewVetoDecisionPolicy creates a veto DecisionPolicy
+func NewVetoDecisionPolicy(threshold string, vetoMembers []string, vetoThreshold string, votingPeriod time.Duration, minExecutionPeriod time.Duration) DecisionPolicy {
+	return &VetoDecisionPolicy{threshold, vetoMembers, vetoThreshold, &DecisionPolicyWindows{votingPeriod, minExecutionPeriod}}
+}
+
+func (p VetoDecisionPolicy) GetVotingPeriod() time.Duration {
+	return p.Windows.VotingPeriod
+}
+
+func (p VetoDecisionPolicy) GetMinExecutionPeriod() time.Duration {
+	return p.Windows.MinExecutionPeriod
+}
+
+func (p VetoDecisionPolicy) ValidateBasic() error {
+	if _, err := math.NewPositiveDecFromString(p.Threshold); err != nil {
+		return sdkerrors.Wrap(err, "threshold")
+	}
+
+	if len(p.VetoMembers) == 0 {
+		return sdkerrors.Wrap(errors.ErrEmpty, "veto members")
+	}
+	addrs := make([]sdk.AccAddress, len(p.VetoMembers))
+	for i, member := range p.VetoMembers {
+		addr, err := sdk.AccAddressFromBech32(member)
+		if err != nil {
+			return sdkerrors.Wrap(err, "veto member")
+		}
+		addrs[i] = addr
+	}
+	if err := accAddresses(addrs).ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "veto members")
+	}
+
+	if _, err := math.NewPositiveDecFromString(p.VetoThreshold); err != nil {
+		return sdkerrors.Wrap(err, "veto threshold")
+	}
+
+	if p.Windows == nil || p.Windows.VotingPeriod == 0 {
+		return sdkerrors.Wrap(errors.ErrInvalid, "voting period cannot be zero")
+	}
+
+	return nil
+}
+
+// Allow allows a proposal to pass when the tally of yes votes equals or exceeds
+// the threshold. As veto members can veto a proposal until the end of its
+// voting period, an accepted proposal is only final once the voting period has
+// ended.
+func (p VetoDecisionPolicy) Allow(tallyResult TallyResult, totalPower string) (DecisionPolicyResult, error) {
+	result, err := ThresholdDecisionPolicy{Threshold: p.Threshold, Windows: p.Windows}.Allow(tallyResult, totalPower)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+
+	if result.Allow {
+		result.Final = false
+	}
+	return result, nil
+}
+
+// IsVetoed returns true when the weighted sum of the veto members'
+// `NO_WITH_VETO` votes equals or exceeds the veto threshold.
+func (p VetoDecisionPolicy) IsVetoed(vetoWeight string) (bool, error) {
+	vetoThreshold, err := math.NewPositiveDecFromString(p.VetoThreshold)
+	if err != nil {
+		return false, sdkerrors.Wrap(err, "veto threshold")
+	}
+	vetoWeightDec, err := math.NewNonNegativeDecFromString(vetoWeight)
+	if err != nil {
+		return false, sdkerrors.Wrap(err, "veto weight")
+	}
+
+	return vetoWeightDec.Cmp(vetoThreshold) >= 0, nil
+}
+
+// Validate validates the policy against the group. As for the threshold
+// policy, the threshold can be greater than the group's total weight. The
+// veto members are checked against the group members by the keeper, as
+// GroupInfo doesn't hold the members.
+func (p *VetoDecisionPolicy) Validate(g GroupInfo, config Config) error {
+	_, err := math.NewPositiveDecFromString(p.Threshold)
+	if err != nil {
+		return sdkerrors.Wrap(err, "threshold")
+	}
+	_, err = math.NewNonNegativeDecFromString(g.TotalWeight)
+	if err != nil {
+		return sdkerrors.Wrap(err, "group total weight")
+	}
+
+	if p.Windows.MinExecutionPeriod > p.Windows.VotingPeriod+config.MaxExecutionPeriod {
+		return sdkerrors.Wrap(errors.ErrInvalid, "min_execution_period should be smaller than voting_period + max_execution_period")
+	}
+	return nil
+}
+
+// Implements DecisionPolicy, VetoPolicy and UnpackInterfacesMessage Interfaces
+var (
+	_ DecisionPolicy                     = &TimelockDecisionPolicy{}
+	_ VetoPolicy                         = &TimelockDecisionPolicy{}
+	_ codectypes.UnpackInterfacesMessage = TimelockDecisionPolicy{}
+)
+
+// NewTimelockDecisionPolicy creates a DecisionPolicy wrapping the given policy
+// and enforcing a timelock between the end of the voting period and the
+// execution of a proposal.
+func NewTimelockDecisionPolicy(decisionPolicy DecisionPolicy, timelock time.Duration) (DecisionPolicy, error) {
+	any, err := codectypes.NewAnyWithValue(decisionPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TimelockDecisionPolicy{DecisionPolicy: any, Timelock: timelock}, nil
+}
+
+// GetWrappedPolicy returns the decision policy wrapped by the timelock.
+func (p TimelockDecisionPolicy) GetWrappedPolicy() (DecisionPolicy, error) {
+	if p.DecisionPolicy == nil {
+		return nil, sdkerrors.Wrap(errors.ErrEmpty, "decision policy")
+	}
+	decisionPolicy, ok := p.DecisionPolicy.GetCachedValue().(DecisionPolicy)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("expected %T, got %T", (DecisionPolicy)(nil), p.DecisionPolicy.GetCachedValue())
+	}
+
+	return decisionPolicy, nil
+}
+
+func (p TimelockDecisionPolicy) GetVotingPeriod() time.Duration {
+	decisionPolicy, err := p.GetWrappedPolicy()
+	if err != nil {
+		return 0
+	}
+	return decisionPolicy.GetVotingPeriod()
+}
+
+// GetMinExecutionPeriod returns the minimum execution period of the wrapped
+// policy, extended so that a proposal can't be executed before the timelock
+// has elapsed after the end of its voting period.
+func (p TimelockDecisionPolicy) GetMinExecutionPeriod() time.Duration {
+	decisionPolicy, err := p.GetWrappedPolicy()
+	if err != nil {
+		return 0
+	}
+
+	minExecutionPeriod := decisionPolicy.GetMinExecutionPeriod()
+	if timelocked := decisionPolicy.GetVotingPeriod() + p.Timelock; timelocked > minExecutionPeriod {
+		return timelocked
+	}
+	return minExecutionPeriod
+}
+
+func (p TimelockDecisionPolicy) ValidateBasic() error {
+	decisionPolicy, err := p.GetWrappedPolicy()
+	if err != nil {
+		return err
+	}
+	if _, ok := decisionPolicy.(*TimelockDecisionPolicy); ok {
+		return sdkerrors.Wrap(errors.ErrInvalid, "timelock decision policies cannot be nested")
+	}
+	if err := decisionPolicy.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "decision policy")
+	}
+
+	if p.Timelock <= 0 {
+		return sdkerrors.Wrap(errors.ErrInvalid, "timelock must be positive")
+	}
+
+	return nil
+}
+
+// Allow delegates to the wrapped policy. An accepted proposal is only final
+// once its voting period has ended, as the timelock starts at the end of the
+// voting period.
+func (p TimelockDecisionPolicy) Allow(tallyResult TallyResult, totalPower string) (DecisionPolicyResult, error) {
+	decisionPolicy, err := p.GetWrappedPolicy()
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+
+	result, err := decisionPolicy.Allow(tallyResult, totalPower)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+
+	if result.Allow {
+		result.Final = false
+	}
+	return result, nil
+}
+
+// GetVetoMembers returns the veto members of the wrapped policy, if any.
+func (p TimelockDecisionPolicy) GetVetoMembers() []string {
+	decisionPolicy, err := p.GetWrappedPolicy()
+	if err != nil {
+		return nil
+	}
+	if vetoPolicy, ok := decisionPolicy.(VetoPolicy); ok {
+		return vetoPolicy.GetVetoMembers()
+	}
+	return nil
+}
+
+// IsVetoed delegates to the wrapped policy, if it allows vetoes.
+func (p TimelockDecisionPolicy) IsVetoed(vetoWeight string) (bool, error) {
+	decisionPolicy, err := p.GetWrappedPolicy()
+	if err != nil {
+		return false, err
+	}
+	if vetoPolicy, ok := decisionPolicy.(VetoPolicy); ok {
+		return vetoPolicy.IsVetoed(vetoWeight)
+	}
+	return false, nil
+}
+
+// Validate validates the wrapped policy against the group, and makes sure the
+// timelock doesn't prevent proposals from being executed before they expire.
+func (p *TimelockDecisionPolicy) Validate(g GroupInfo, config Config) error {
+	decisionPolicy, err := p.GetWrappedPolicy()
+	if err != nil {
+		return err
+	}
+	if err := decisionPolicy.Validate(g, config); err != nil {
+		return err
+	}
+
+	if p.GetMinExecutionPeriod() > decisionPolicy.GetVotingPeriod()+config.MaxExecutionPeriod {
+		return sdkerrors.Wrap(errors.ErrInvalid, "voting_period + timelock should be smaller than voting_period + max_execution_period")
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (p TimelockDecisionPolicy) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var decisionPolicy DecisionPolicy
+	return unpacker.UnpackAny(p.DecisionPolicy, &decisionPolicy)
+}
+
 var _ orm.Validateable = GroupPolicyInfo{}
 
 // NewGroupPolicyInfo creates a new GroupPolicyInfo instance
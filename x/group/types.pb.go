@@ -297,10 +297,10 @@ func (m *MemberRequest) GetMetadata() string {
 
 // ThresholdDecisionPolicy is a decision policy where a proposal passes when it
 // satisfies the two following conditions:
-// 1. The sum of all `YES` voters' weights is greater or equal than the defined
-//    `threshold`.
-// 2. The voting and execution periods of the proposal respect the parameters
-//    given by `windows`.
+//  1. The sum of all `YES` voters' weights is greater or equal than the defined
+//     `threshold`.
+//  2. The voting and execution periods of the proposal respect the parameters
+//     given by `windows`.
 type ThresholdDecisionPolicy struct {
 	// threshold is the minimum weighted sum of `YES` votes that must be met or
 	// exceeded for a proposal to succeed.
@@ -358,10 +358,10 @@ func (m *ThresholdDecisionPolicy) GetWindows() *DecisionPolicyWindows {
 
 // PercentageDecisionPolicy is a decision policy where a proposal passes when
 // it satisfies the two following conditions:
-// 1. The percentage of all `YES` voters' weights out of the total group weight
-//    is greater or equal than the given `percentage`.
-// 2. The voting and execution periods of the proposal respect the parameters
-//    given by `windows`.
+//  1. The percentage of all `YES` voters' weights out of the total group weight
+//     is greater or equal than the given `percentage`.
+//  2. The voting and execution periods of the proposal respect the parameters
+//     given by `windows`.
 type PercentageDecisionPolicy struct {
 	// percentage is the minimum percentage the weighted sum of `YES` votes must
 	// meet for a proposal to succeed.
@@ -417,6 +417,153 @@ func (m *PercentageDecisionPolicy) GetWindows() *DecisionPolicyWindows {
 	return nil
 }
 
+// VetoDecisionPolicy is a decision policy where designated veto members can
+// block a proposal. A proposal passes when it satisfies the three following
+// conditions:
+//  1. The sum of all `YES` voters' weights is greater or equal than the defined
+//     `threshold`.
+//  2. The sum of the weights of the veto members who voted `NO_WITH_VETO` is
+//     lower than the defined `veto_threshold`.
+//  3. The voting and execution periods of the proposal respect the parameters
+//     given by `windows`.
+//
+// Vetoes can be cast until the end of the voting period, hence a proposal is
+// never accepted before the end of its voting period.
+type VetoDecisionPolicy struct {
+	// threshold is the minimum weighted sum of `YES` votes that must be met or
+	// exceeded for a proposal to succeed.
+	Threshold string `protobuf:"bytes,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// veto_members are the addresses of the group members allowed to veto a
+	// proposal.
+	VetoMembers []string `protobuf:"bytes,2,rep,name=veto_members,json=vetoMembers,proto3" json:"veto_members,omitempty"`
+	// veto_threshold is the minimum weighted sum of `NO_WITH_VETO` votes of the
+	// veto members that must be met or exceeded for a proposal to be vetoed.
+	VetoThreshold string `protobuf:"bytes,3,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
+	// windows defines the different windows for voting and execution.
+	Windows *DecisionPolicyWindows `protobuf:"bytes,4,opt,name=windows,proto3" json:"windows,omitempty"`
+}
+
+func (m *VetoDecisionPolicy) Reset()         { *m = VetoDecisionPolicy{} }
+func (m *VetoDecisionPolicy) String() string { return proto.CompactTextString(m) }
+func (*VetoDecisionPolicy) ProtoMessage()    {}
+func (*VetoDecisionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{4}
+}
+func (m *VetoDecisionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VetoDecisionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VetoDecisionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VetoDecisionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VetoDecisionPolicy.Merge(m, src)
+}
+func (m *VetoDecisionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *VetoDecisionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_VetoDecisionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VetoDecisionPolicy proto.InternalMessageInfo
+
+func (m *VetoDecisionPolicy) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
+	}
+	return ""
+}
+
+func (m *VetoDecisionPolicy) GetVetoMembers() []string {
+	if m != nil {
+		return m.VetoMembers
+	}
+	return nil
+}
+
+func (m *VetoDecisionPolicy) GetVetoThreshold() string {
+	if m != nil {
+		return m.VetoThreshold
+	}
+	return ""
+}
+
+func (m *VetoDecisionPolicy) GetWindows() *DecisionPolicyWindows {
+	if m != nil {
+		return m.Windows
+	}
+	return nil
+}
+
+// TimelockDecisionPolicy is a decision policy which wraps another decision
+// policy and enforces a timelock between the acceptance of a proposal and its
+// execution. A proposal is only accepted at the end of its voting period, if
+// the wrapped policy allows it, and can be executed once `timelock` has
+// elapsed after the end of the voting period.
+type TimelockDecisionPolicy struct {
+	// decision_policy is the wrapped decision policy.
+	DecisionPolicy *types.Any `protobuf:"bytes,1,opt,name=decision_policy,json=decisionPolicy,proto3" json:"decision_policy,omitempty"`
+	// timelock is the minimum duration between the end of the voting period of
+	// a proposal and its execution.
+	Timelock time.Duration `protobuf:"bytes,2,opt,name=timelock,proto3,stdduration" json:"timelock"`
+}
+
+func (m *TimelockDecisionPolicy) Reset()         { *m = TimelockDecisionPolicy{} }
+func (m *TimelockDecisionPolicy) String() string { return proto.CompactTextString(m) }
+func (*TimelockDecisionPolicy) ProtoMessage()    {}
+func (*TimelockDecisionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{5}
+}
+func (m *TimelockDecisionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimelockDecisionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimelockDecisionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TimelockDecisionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimelockDecisionPolicy.Merge(m, src)
+}
+func (m *TimelockDecisionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimelockDecisionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimelockDecisionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimelockDecisionPolicy proto.InternalMessageInfo
+
+func (m *TimelockDecisionPolicy) GetDecisionPolicy() *types.Any {
+	if m != nil {
+		return m.DecisionPolicy
+	}
+	return nil
+}
+
+func (m *TimelockDecisionPolicy) GetTimelock() time.Duration {
+	if m != nil {
+		return m.Timelock
+	}
+	return 0
+}
+
 // DecisionPolicyWindows defines the different windows for voting and execution.
 type DecisionPolicyWindows struct {
 	// voting_period is the duration from submission of a proposal to the end of voting period
@@ -440,7 +587,7 @@ func (m *DecisionPolicyWindows) Reset()         { *m = DecisionPolicyWindows{} }
 func (m *DecisionPolicyWindows) String() string { return proto.CompactTextString(m) }
 func (*DecisionPolicyWindows) ProtoMessage()    {}
 func (*DecisionPolicyWindows) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{4}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{6}
 }
 func (m *DecisionPolicyWindows) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -506,7 +653,7 @@ func (m *GroupInfo) Reset()         { *m = GroupInfo{} }
 func (m *GroupInfo) String() string { return proto.CompactTextString(m) }
 func (*GroupInfo) ProtoMessage()    {}
 func (*GroupInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{5}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{7}
 }
 func (m *GroupInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -589,7 +736,7 @@ func (m *GroupMember) Reset()         { *m = GroupMember{} }
 func (m *GroupMember) String() string { return proto.CompactTextString(m) }
 func (*GroupMember) ProtoMessage()    {}
 func (*GroupMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{6}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{8}
 }
 func (m *GroupMember) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -655,7 +802,7 @@ func (m *GroupPolicyInfo) Reset()         { *m = GroupPolicyInfo{} }
 func (m *GroupPolicyInfo) String() string { return proto.CompactTextString(m) }
 func (*GroupPolicyInfo) ProtoMessage()    {}
 func (*GroupPolicyInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{7}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{9}
 }
 func (m *GroupPolicyInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -730,7 +877,7 @@ func (m *Proposal) Reset()         { *m = Proposal{} }
 func (m *Proposal) String() string { return proto.CompactTextString(m) }
 func (*Proposal) ProtoMessage()    {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{8}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{10}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -775,7 +922,7 @@ func (m *TallyResult) Reset()         { *m = TallyResult{} }
 func (m *TallyResult) String() string { return proto.CompactTextString(m) }
 func (*TallyResult) ProtoMessage()    {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{9}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{11}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -822,7 +969,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{10}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{12}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -894,6 +1041,8 @@ func init() {
 	proto.RegisterType((*MemberRequest)(nil), "cosmos.group.v1.MemberRequest")
 	proto.RegisterType((*ThresholdDecisionPolicy)(nil), "cosmos.group.v1.ThresholdDecisionPolicy")
 	proto.RegisterType((*PercentageDecisionPolicy)(nil), "cosmos.group.v1.PercentageDecisionPolicy")
+	proto.RegisterType((*VetoDecisionPolicy)(nil), "cosmos.group.v1.VetoDecisionPolicy")
+	proto.RegisterType((*TimelockDecisionPolicy)(nil), "cosmos.group.v1.TimelockDecisionPolicy")
 	proto.RegisterType((*DecisionPolicyWindows)(nil), "cosmos.group.v1.DecisionPolicyWindows")
 	proto.RegisterType((*GroupInfo)(nil), "cosmos.group.v1.GroupInfo")
 	proto.RegisterType((*GroupMember)(nil), "cosmos.group.v1.GroupMember")
@@ -906,89 +1055,94 @@ func init() {
 func init() { proto.RegisterFile("cosmos/group/v1/types.proto", fileDescriptor_f5bddd15d7a54a9d) }
 
 var fileDescriptor_f5bddd15d7a54a9d = []byte{
-	// 1299 bytes of a gzipped FileDescriptorProto
+	// 1377 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4d, 0x6f, 0x1b, 0x45,
-	0x18, 0xf6, 0xda, 0x8e, 0x3f, 0x5e, 0xa7, 0xb6, 0x99, 0x86, 0x66, 0x93, 0x14, 0x3b, 0x98, 0x0a,
-	0xa2, 0xa2, 0xd8, 0x6d, 0x2a, 0x81, 0xd4, 0x03, 0x60, 0x3b, 0x5b, 0xea, 0xaa, 0xb5, 0xad, 0xdd,
-	0x75, 0x42, 0xb9, 0xac, 0x36, 0xde, 0xe9, 0x66, 0x85, 0xbd, 0x63, 0x76, 0xc7, 0x49, 0xfd, 0x0f,
+	0x18, 0xf6, 0xda, 0x8e, 0x3f, 0x5e, 0x27, 0xb6, 0x99, 0x86, 0x66, 0x93, 0x14, 0x3b, 0x98, 0x02,
+	0x51, 0x51, 0xec, 0x36, 0x95, 0x40, 0x2a, 0x12, 0xc5, 0x76, 0xb6, 0xd4, 0x55, 0x6b, 0x5b, 0xeb,
+	0x75, 0x42, 0xb9, 0xac, 0x36, 0xde, 0xa9, 0xb3, 0xaa, 0xbd, 0x63, 0x76, 0xc7, 0x49, 0xfd, 0x0f,
 	0x7a, 0x41, 0xf4, 0xc8, 0x05, 0xa9, 0x12, 0xbf, 0x00, 0xa9, 0x07, 0xc4, 0x85, 0x6b, 0xd5, 0x03,
-	0xaa, 0x38, 0x71, 0x02, 0xd4, 0x5e, 0xe0, 0xc4, 0x95, 0x23, 0xda, 0x99, 0xd9, 0xc4, 0x1f, 0x89,
-	0x69, 0x2a, 0xe0, 0x94, 0xcc, 0x3c, 0xcf, 0xfb, 0xce, 0xf3, 0x7e, 0xae, 0x0c, 0x6b, 0x5d, 0xe2,
-	0xf7, 0x89, 0x5f, 0xb1, 0x3d, 0x32, 0x1c, 0x54, 0x0e, 0xae, 0x56, 0xe8, 0x68, 0x80, 0xfd, 0xf2,
-	0xc0, 0x23, 0x94, 0xa0, 0x1c, 0x07, 0xcb, 0x0c, 0x2c, 0x1f, 0x5c, 0x5d, 0x5d, 0xb2, 0x89, 0x4d,
-	0x18, 0x56, 0x09, 0xfe, 0xe3, 0xb4, 0xd5, 0x82, 0x4d, 0x88, 0xdd, 0xc3, 0x15, 0x76, 0xda, 0x1b,
-	0xde, 0xab, 0x58, 0x43, 0xcf, 0xa4, 0x0e, 0x71, 0x05, 0x5e, 0x9c, 0xc6, 0xa9, 0xd3, 0xc7, 0x3e,
-	0x35, 0xfb, 0x03, 0x41, 0x58, 0xe1, 0xef, 0x18, 0xdc, 0xb3, 0x78, 0x54, 0x40, 0xd3, 0xb6, 0xa6,
-	0x3b, 0xe2, 0x50, 0xe9, 0x5b, 0x09, 0x12, 0x77, 0x70, 0x7f, 0x0f, 0x7b, 0x68, 0x0b, 0x92, 0xa6,
-	0x65, 0x79, 0xd8, 0xf7, 0x65, 0x69, 0x5d, 0xda, 0x48, 0xd7, 0xe4, 0x9f, 0x1e, 0x6f, 0x2e, 0x09,
-	0x47, 0x55, 0x8e, 0x68, 0xd4, 0x73, 0x5c, 0x5b, 0x0d, 0x89, 0xe8, 0x02, 0x24, 0x0e, 0xb1, 0x63,
-	0xef, 0x53, 0x39, 0x1a, 0x98, 0xa8, 0xe2, 0x84, 0x56, 0x21, 0xd5, 0xc7, 0xd4, 0xb4, 0x4c, 0x6a,
-	0xca, 0x31, 0x86, 0x1c, 0x9d, 0xd1, 0x87, 0x90, 0x32, 0x2d, 0x0b, 0x5b, 0x86, 0x49, 0xe5, 0xf8,
-	0xba, 0xb4, 0x91, 0xd9, 0x5a, 0x2d, 0x73, 0x81, 0xe5, 0x50, 0x60, 0x59, 0x0f, 0x83, 0xab, 0xa5,
-	0x9e, 0xfc, 0x52, 0x8c, 0x3c, 0xfc, 0xb5, 0x28, 0xb1, 0x47, 0xb1, 0x55, 0xa5, 0xa5, 0x43, 0x38,
-	0xc7, 0x25, 0xab, 0xf8, 0xf3, 0x21, 0xf6, 0xe9, 0xff, 0xa5, 0xbc, 0xf4, 0x85, 0x04, 0xcb, 0xfa,
-	0xbe, 0x87, 0xfd, 0x7d, 0xd2, 0xb3, 0xb6, 0x71, 0xd7, 0xf1, 0x1d, 0xe2, 0xb6, 0x49, 0xcf, 0xe9,
-	0x8e, 0xd0, 0x45, 0x48, 0xd3, 0x10, 0xe2, 0x2a, 0xd4, 0xe3, 0x0b, 0xf4, 0x11, 0x24, 0x0f, 0x1d,
-	0xd7, 0x22, 0x87, 0x3e, 0x7b, 0x2e, 0xb3, 0xf5, 0x76, 0x79, 0xaa, 0x2d, 0xca, 0x93, 0xfe, 0x76,
-	0x39, 0x5b, 0x0d, 0xcd, 0xae, 0xa3, 0xa7, 0x8f, 0x37, 0xb3, 0x93, 0x9c, 0xd2, 0x43, 0x09, 0xe4,
-	0x36, 0xf6, 0xba, 0xd8, 0xa5, 0xa6, 0x8d, 0xa7, 0x04, 0x15, 0x00, 0x06, 0x47, 0x98, 0x50, 0x34,
-	0x76, 0xf3, 0x1f, 0x49, 0xfa, 0x4e, 0x82, 0xd7, 0x4f, 0x34, 0x43, 0x37, 0xe1, 0xdc, 0x01, 0xa1,
-	0x8e, 0x6b, 0x1b, 0x03, 0xec, 0x39, 0x84, 0x27, 0x29, 0xb3, 0xb5, 0x32, 0x53, 0xfb, 0x6d, 0xd1,
-	0xf8, 0xbc, 0xf4, 0x5f, 0x05, 0xa5, 0x5f, 0xe4, 0x96, 0x6d, 0x66, 0x88, 0x3a, 0xb0, 0xd4, 0x77,
-	0x5c, 0x03, 0xdf, 0xc7, 0xdd, 0x61, 0x40, 0x0c, 0x1d, 0x46, 0x5f, 0xde, 0x21, 0xea, 0x3b, 0xae,
-	0x12, 0xda, 0x73, 0xb7, 0xa5, 0x3f, 0x24, 0x48, 0x7f, 0x1c, 0x84, 0xde, 0x70, 0xef, 0x11, 0x94,
-	0x85, 0xa8, 0xc3, 0x35, 0xc6, 0xd5, 0xa8, 0x63, 0xa1, 0x32, 0x2c, 0x98, 0x56, 0xdf, 0x71, 0x79,
-	0xbb, 0xcc, 0xe9, 0x30, 0x4e, 0x9b, 0x3b, 0x01, 0x32, 0x24, 0x0f, 0xb0, 0x17, 0xa4, 0x88, 0x0d,
-	0x40, 0x5c, 0x0d, 0x8f, 0xe8, 0x4d, 0x58, 0xa4, 0x84, 0x9a, 0x3d, 0x43, 0xf4, 0xe6, 0x02, 0xb3,
-	0xcc, 0xb0, 0xbb, 0x5d, 0xde, 0xa0, 0x75, 0x80, 0xae, 0x87, 0x4d, 0xca, 0x07, 0x28, 0x71, 0x86,
-	0x01, 0x4a, 0x0b, 0xbb, 0x2a, 0x2d, 0xdd, 0x85, 0x0c, 0x0b, 0x55, 0x8c, 0xfe, 0x0a, 0xa4, 0x58,
-	0xd1, 0x8d, 0xa3, 0x90, 0x93, 0xec, 0xdc, 0xb0, 0x50, 0x05, 0x12, 0x7d, 0x46, 0x12, 0xe9, 0x5d,
-	0x9e, 0xe9, 0x12, 0x31, 0x8b, 0x82, 0x56, 0xfa, 0x2b, 0x0a, 0x39, 0xe6, 0x9b, 0x97, 0x9f, 0x25,
-	0xf3, 0x55, 0x06, 0x74, 0x5c, 0x53, 0x74, 0x52, 0xd3, 0x51, 0x2d, 0x62, 0x67, 0xaf, 0x45, 0xfc,
-	0xf4, 0x5a, 0x2c, 0x4c, 0xd6, 0xc2, 0x84, 0x9c, 0x25, 0x3a, 0xd9, 0x18, 0xb0, 0x58, 0x44, 0xb6,
-	0x97, 0x66, 0xb2, 0x5d, 0x75, 0x47, 0xb5, 0xd2, 0xd3, 0xc7, 0x9b, 0x85, 0xf9, 0x13, 0xa4, 0x66,
-	0xad, 0xc9, 0x19, 0x9d, 0xac, 0x65, 0xf2, 0x95, 0x6a, 0x79, 0x3d, 0xf5, 0xe0, 0x51, 0x31, 0xf2,
-	0xfb, 0xa3, 0xa2, 0x54, 0xfa, 0x61, 0x01, 0x52, 0x6d, 0x8f, 0x0c, 0x88, 0x6f, 0xf6, 0x66, 0x1a,
-	0xf8, 0x16, 0x2c, 0xf1, 0x7c, 0xf2, 0x58, 0x8c, 0xb0, 0x20, 0xff, 0xd4, 0xcf, 0xc8, 0x3e, 0x2e,
-	0xa6, 0x40, 0xe6, 0x36, 0xf7, 0x7b, 0x90, 0x1e, 0x30, 0x0d, 0xd8, 0xf3, 0xe5, 0xf8, 0x7a, 0x6c,
-	0xae, 0xf3, 0x63, 0x2a, 0x52, 0x20, 0xe3, 0x0f, 0xf7, 0xfa, 0x0e, 0x35, 0x82, 0x2f, 0x1b, 0x2b,
-	0xc6, 0xcb, 0x26, 0x03, 0xb8, 0x61, 0x00, 0xa1, 0xb7, 0xe0, 0x1c, 0x0f, 0x33, 0xac, 0x6a, 0x82,
-	0x65, 0x60, 0x91, 0x5d, 0xee, 0x88, 0xd2, 0x5e, 0x99, 0xca, 0x45, 0xc8, 0x4d, 0x32, 0xee, 0x78,
-	0xc4, 0xa1, 0xc5, 0xfb, 0x90, 0xf0, 0xa9, 0x49, 0x87, 0xbe, 0x9c, 0x5a, 0x97, 0x36, 0xb2, 0x5b,
-	0xc5, 0x99, 0x31, 0x08, 0x13, 0xaf, 0x31, 0x9a, 0x2a, 0xe8, 0xa8, 0x0d, 0xe8, 0x9e, 0xe3, 0x9a,
-	0x3d, 0x83, 0x9a, 0xbd, 0xde, 0xc8, 0xf0, 0xb0, 0x3f, 0xec, 0x51, 0x39, 0xcd, 0xa2, 0xbb, 0x38,
-	0xe3, 0x44, 0x0f, 0x48, 0x2a, 0xe3, 0xd4, 0xe2, 0x41, 0x7c, 0x6a, 0x9e, 0x59, 0x8f, 0xdd, 0xa3,
-	0x36, 0xbc, 0x36, 0xb1, 0x48, 0x0d, 0xec, 0x5a, 0x32, 0x9c, 0x21, 0x5d, 0xb9, 0xf1, 0x6d, 0xaa,
-	0xb8, 0x16, 0x6a, 0x43, 0x8e, 0x2f, 0x53, 0xe2, 0x85, 0x02, 0x33, 0x2c, 0xca, 0x77, 0x4e, 0x8d,
-	0x52, 0x11, 0x7c, 0xae, 0x49, 0xcd, 0xe2, 0x89, 0x33, 0xba, 0x12, 0x34, 0x88, 0xef, 0x9b, 0x36,
-	0xf6, 0xe5, 0xc5, 0xf5, 0xd8, 0x69, 0x43, 0xa3, 0x1e, 0xb1, 0xae, 0xc7, 0x83, 0x2e, 0x2e, 0x7d,
-	0x2d, 0x41, 0x66, 0x3c, 0xd6, 0x35, 0x48, 0x8f, 0xb0, 0x6f, 0x74, 0xc9, 0xd0, 0xa5, 0xe2, 0x1b,
-	0x96, 0x1a, 0x61, 0xbf, 0x1e, 0x9c, 0x83, 0x52, 0x9b, 0x7b, 0x3e, 0x35, 0x1d, 0x57, 0x10, 0xf8,
-	0x97, 0x7c, 0x51, 0x5c, 0x72, 0xd2, 0x0a, 0xa4, 0x5c, 0x22, 0x70, 0xde, 0xaa, 0x49, 0x97, 0x70,
-	0xe8, 0x5d, 0x40, 0x2e, 0x31, 0x0e, 0x1d, 0xba, 0x6f, 0x1c, 0x60, 0x1a, 0x92, 0xf8, 0x82, 0xc8,
-	0xb9, 0x64, 0xd7, 0xa1, 0xfb, 0x3b, 0x98, 0x72, 0xb2, 0xd0, 0xf7, 0xa7, 0x04, 0xf1, 0x1d, 0x42,
-	0x31, 0x2a, 0x42, 0x66, 0x20, 0x52, 0x71, 0xbc, 0x34, 0x21, 0xbc, 0xe2, 0x3b, 0xea, 0x80, 0x50,
-	0xb1, 0x36, 0xe7, 0xee, 0x28, 0x46, 0x43, 0xd7, 0x20, 0x41, 0x06, 0xc1, 0xd7, 0x88, 0xa9, 0xcc,
-	0x6e, 0xad, 0xcd, 0xa4, 0x3e, 0x78, 0xb7, 0xc5, 0x28, 0xaa, 0xa0, 0xce, 0x5d, 0x6c, 0xff, 0xce,
-	0x3c, 0x5d, 0xfe, 0x52, 0x02, 0x38, 0x7e, 0x19, 0xad, 0xc1, 0xf2, 0x4e, 0x4b, 0x57, 0x8c, 0x56,
-	0x5b, 0x6f, 0xb4, 0x9a, 0x46, 0xa7, 0xa9, 0xb5, 0x95, 0x7a, 0xe3, 0x46, 0x43, 0xd9, 0xce, 0x47,
-	0xd0, 0x79, 0xc8, 0x8d, 0x83, 0x77, 0x15, 0x2d, 0x2f, 0xa1, 0x65, 0x38, 0x3f, 0x7e, 0x59, 0xad,
-	0x69, 0x7a, 0xb5, 0xd1, 0xcc, 0x47, 0x11, 0x82, 0xec, 0x38, 0xd0, 0x6c, 0xe5, 0x63, 0xe8, 0x22,
-	0xc8, 0x93, 0x77, 0xc6, 0x6e, 0x43, 0xbf, 0x69, 0xec, 0x28, 0x7a, 0x2b, 0x1f, 0x5f, 0x8d, 0x3f,
-	0xf8, 0xa6, 0x10, 0xb9, 0xfc, 0xa3, 0x04, 0xd9, 0xc9, 0x61, 0x43, 0x45, 0x58, 0x6b, 0xab, 0xad,
-	0x76, 0x4b, 0xab, 0xde, 0x36, 0x34, 0xbd, 0xaa, 0x77, 0xb4, 0x29, 0x65, 0x6f, 0xc0, 0xca, 0x34,
-	0x41, 0xeb, 0xd4, 0xee, 0x34, 0x74, 0x5d, 0xd9, 0xce, 0x4b, 0xc1, 0xb3, 0xd3, 0x70, 0xb5, 0x5e,
-	0x57, 0xda, 0x01, 0x1a, 0x3d, 0x09, 0x55, 0x95, 0x5b, 0x4a, 0x3d, 0x40, 0x63, 0x41, 0x46, 0x66,
-	0x6c, 0x6b, 0x2d, 0x35, 0x00, 0xe3, 0x27, 0xbd, 0x1b, 0x04, 0xb4, 0xad, 0x56, 0x77, 0x9b, 0xf9,
-	0x05, 0x11, 0xd0, 0xf7, 0x12, 0x5c, 0x38, 0x79, 0xae, 0xd0, 0x06, 0x5c, 0x3a, 0xb2, 0x57, 0x3e,
-	0x51, 0xea, 0x1d, 0xbd, 0xa5, 0x1a, 0xaa, 0xa2, 0x75, 0x6e, 0xeb, 0x53, 0x11, 0x5e, 0x82, 0xf5,
-	0x53, 0x99, 0xcd, 0x96, 0x6e, 0xa8, 0x9d, 0x66, 0x5e, 0x9a, 0xcb, 0xd2, 0x3a, 0xf5, 0xba, 0xa2,
-	0x69, 0xf9, 0xe8, 0x5c, 0xd6, 0x8d, 0x6a, 0xe3, 0x76, 0x47, 0x55, 0xf2, 0x31, 0x2e, 0xbe, 0xf6,
-	0xc1, 0x93, 0xe7, 0x05, 0xe9, 0xd9, 0xf3, 0x82, 0xf4, 0xdb, 0xf3, 0x82, 0xf4, 0xf0, 0x45, 0x21,
-	0xf2, 0xec, 0x45, 0x21, 0xf2, 0xf3, 0x8b, 0x42, 0xe4, 0xd3, 0x4b, 0xb6, 0x43, 0xf7, 0x87, 0x7b,
-	0xe5, 0x2e, 0xe9, 0x8b, 0x9f, 0x23, 0xe2, 0xcf, 0xa6, 0x6f, 0x7d, 0x56, 0xb9, 0xcf, 0x7f, 0x2d,
-	0xed, 0x25, 0x58, 0x27, 0x5e, 0xfb, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xea, 0x13, 0x0f, 0x14, 0x44,
-	0x0d, 0x00, 0x00,
+	0xaa, 0x38, 0x71, 0x02, 0xd4, 0x5e, 0x40, 0x42, 0xe2, 0xca, 0x11, 0xed, 0xcc, 0xac, 0xe3, 0xaf,
+	0xb8, 0xa4, 0x2a, 0x9c, 0x92, 0x79, 0x9f, 0xe7, 0x9d, 0x79, 0x3f, 0x1f, 0xdb, 0xb0, 0xde, 0x22,
+	0x6e, 0x97, 0xb8, 0x85, 0xb6, 0x43, 0xfa, 0xbd, 0xc2, 0xe1, 0xa5, 0x02, 0x1d, 0xf4, 0xb0, 0x9b,
+	0xef, 0x39, 0x84, 0x12, 0x94, 0xe2, 0x60, 0x9e, 0x81, 0xf9, 0xc3, 0x4b, 0x6b, 0xcb, 0x6d, 0xd2,
+	0x26, 0x0c, 0x2b, 0x78, 0xff, 0x71, 0xda, 0x5a, 0xa6, 0x4d, 0x48, 0xbb, 0x83, 0x0b, 0xec, 0xb4,
+	0xdf, 0xbf, 0x53, 0x30, 0xfb, 0x8e, 0x41, 0x2d, 0x62, 0x0b, 0x3c, 0x3b, 0x89, 0x53, 0xab, 0x8b,
+	0x5d, 0x6a, 0x74, 0x7b, 0x82, 0xb0, 0xca, 0xdf, 0xd1, 0xf9, 0xcd, 0xe2, 0x51, 0x01, 0x4d, 0xfa,
+	0x1a, 0xf6, 0x80, 0x43, 0xb9, 0x6f, 0x25, 0x88, 0xdc, 0xc2, 0xdd, 0x7d, 0xec, 0xa0, 0x6d, 0x88,
+	0x1a, 0xa6, 0xe9, 0x60, 0xd7, 0x95, 0xa5, 0x0d, 0x69, 0x33, 0x5e, 0x92, 0x7f, 0x7a, 0xb4, 0xb5,
+	0x2c, 0x2e, 0x2a, 0x72, 0xa4, 0x41, 0x1d, 0xcb, 0x6e, 0xab, 0x3e, 0x11, 0x9d, 0x85, 0xc8, 0x11,
+	0xb6, 0xda, 0x07, 0x54, 0x0e, 0x7a, 0x2e, 0xaa, 0x38, 0xa1, 0x35, 0x88, 0x75, 0x31, 0x35, 0x4c,
+	0x83, 0x1a, 0x72, 0x88, 0x21, 0xc3, 0x33, 0xba, 0x0a, 0x31, 0xc3, 0x34, 0xb1, 0xa9, 0x1b, 0x54,
+	0x0e, 0x6f, 0x48, 0x9b, 0x89, 0xed, 0xb5, 0x3c, 0x0f, 0x30, 0xef, 0x07, 0x98, 0xd7, 0xfc, 0xe4,
+	0x4a, 0xb1, 0xc7, 0xbf, 0x64, 0x03, 0x0f, 0x7e, 0xcd, 0x4a, 0xec, 0x51, 0x6c, 0x16, 0x69, 0xee,
+	0x08, 0x96, 0x78, 0xc8, 0x2a, 0xfe, 0xbc, 0x8f, 0x5d, 0xfa, 0x7f, 0x45, 0x9e, 0xfb, 0x42, 0x82,
+	0x15, 0xed, 0xc0, 0xc1, 0xee, 0x01, 0xe9, 0x98, 0x3b, 0xb8, 0x65, 0xb9, 0x16, 0xb1, 0xeb, 0xa4,
+	0x63, 0xb5, 0x06, 0xe8, 0x1c, 0xc4, 0xa9, 0x0f, 0xf1, 0x28, 0xd4, 0x63, 0x03, 0xfa, 0x18, 0xa2,
+	0x47, 0x96, 0x6d, 0x92, 0x23, 0x97, 0x3d, 0x97, 0xd8, 0x7e, 0x27, 0x3f, 0x31, 0x16, 0xf9, 0xf1,
+	0xfb, 0xf6, 0x38, 0x5b, 0xf5, 0xdd, 0xae, 0xa0, 0x27, 0x8f, 0xb6, 0x92, 0xe3, 0x9c, 0xdc, 0x03,
+	0x09, 0xe4, 0x3a, 0x76, 0x5a, 0xd8, 0xa6, 0x46, 0x1b, 0x4f, 0x04, 0x94, 0x01, 0xe8, 0x0d, 0x31,
+	0x11, 0xd1, 0x88, 0xe5, 0x3f, 0x0a, 0xe9, 0x4f, 0x09, 0xd0, 0x2e, 0xa6, 0xe4, 0x54, 0xd5, 0xf9,
+	0x10, 0x16, 0x0f, 0x31, 0x25, 0x7a, 0x97, 0x75, 0xd5, 0x8b, 0x27, 0x34, 0xb7, 0x89, 0x09, 0x8f,
+	0xcd, 0x47, 0xc0, 0x45, 0x6f, 0x43, 0x92, 0x39, 0x1f, 0xdf, 0xcf, 0xdb, 0xb6, 0xe4, 0x59, 0xb5,
+	0x59, 0x1d, 0x08, 0xbf, 0xba, 0x74, 0x9f, 0x4a, 0x70, 0xd6, 0x9b, 0xd5, 0x0e, 0x69, 0xdd, 0x9d,
+	0x48, 0xd9, 0x80, 0x94, 0x29, 0x2c, 0x7a, 0x8f, 0x99, 0x58, 0xe2, 0x89, 0xed, 0xe5, 0xa9, 0x69,
+	0x2f, 0xda, 0x83, 0x52, 0xee, 0xc9, 0xa3, 0xad, 0xcc, 0xfc, 0x88, 0xd4, 0xa4, 0x39, 0xfe, 0xc4,
+	0x55, 0x88, 0x51, 0xf1, 0xb8, 0xe8, 0xe1, 0xea, 0xd4, 0xdd, 0x3b, 0x42, 0x46, 0xf8, 0x22, 0x7d,
+	0xe5, 0x2d, 0xd2, 0xd0, 0x69, 0x66, 0x4a, 0xdf, 0x49, 0xf0, 0xfa, 0xcc, 0x4a, 0xa0, 0xeb, 0xb0,
+	0x74, 0x48, 0xa8, 0x65, 0xb7, 0xf5, 0x1e, 0x76, 0x2c, 0x62, 0xca, 0xd2, 0xbf, 0x7f, 0x73, 0x91,
+	0x7b, 0xd6, 0x99, 0x23, 0x6a, 0xc2, 0x72, 0xd7, 0xb2, 0x75, 0x7c, 0x0f, 0xb7, 0xfa, 0x94, 0x15,
+	0x88, 0x5f, 0x78, 0x8a, 0x24, 0x50, 0xd7, 0xb2, 0x15, 0xdf, 0x9f, 0x5f, 0x9b, 0xfb, 0x43, 0x82,
+	0xf8, 0x27, 0x5e, 0xed, 0x2a, 0xf6, 0x1d, 0x82, 0x92, 0x10, 0xb4, 0x78, 0x8c, 0x61, 0x35, 0x68,
+	0x99, 0x28, 0x0f, 0x0b, 0x86, 0xd9, 0xb5, 0x6c, 0xbe, 0xf0, 0x73, 0xc6, 0x8b, 0xd3, 0xe6, 0x6a,
+	0x98, 0x0c, 0xd1, 0x43, 0xec, 0x78, 0x25, 0x62, 0xd3, 0x14, 0x56, 0xfd, 0x23, 0x7a, 0x13, 0x16,
+	0x29, 0xa1, 0x46, 0x47, 0x17, 0xea, 0xb2, 0xc0, 0x3c, 0x13, 0xcc, 0xb6, 0xc7, 0x4c, 0xa8, 0x0c,
+	0xd0, 0x72, 0xb0, 0x41, 0xb9, 0x04, 0x46, 0x4e, 0x21, 0x81, 0x71, 0xe1, 0x57, 0xa4, 0xb9, 0xdb,
+	0x90, 0x60, 0xa9, 0x0a, 0xf1, 0x5e, 0x85, 0x18, 0x9b, 0x1a, 0x7d, 0x98, 0x72, 0x94, 0x9d, 0x2b,
+	0x26, 0x2a, 0x40, 0x84, 0x2f, 0x96, 0x28, 0xef, 0xca, 0xd4, 0xe0, 0x0b, 0x35, 0x15, 0xb4, 0xdc,
+	0xdf, 0x41, 0x48, 0xb1, 0xbb, 0x79, 0xfb, 0x59, 0x31, 0x5f, 0x46, 0x62, 0x47, 0x63, 0x0a, 0x8e,
+	0xc7, 0x34, 0xec, 0x45, 0xe8, 0xf4, 0xbd, 0x08, 0x9f, 0xdc, 0x8b, 0x85, 0xf1, 0x5e, 0xcc, 0x58,
+	0xc1, 0xc8, 0x2b, 0x5e, 0xc1, 0xf1, 0x5e, 0x46, 0x5f, 0xaa, 0x97, 0x57, 0x62, 0xf7, 0x1f, 0x66,
+	0x03, 0xbf, 0x3f, 0xcc, 0x4a, 0xb9, 0x1f, 0x16, 0x20, 0x56, 0x77, 0x48, 0x8f, 0xb8, 0x46, 0x67,
+	0x6a, 0x80, 0x6f, 0xc0, 0x32, 0xaf, 0x27, 0xcf, 0x45, 0xf7, 0x1b, 0xf2, 0xa2, 0x79, 0x46, 0xed,
+	0xe3, 0x66, 0x0a, 0x64, 0xee, 0x70, 0xbf, 0x0f, 0xf1, 0x1e, 0x8b, 0xc1, 0xd3, 0xe2, 0xf0, 0x0b,
+	0xb4, 0xf8, 0x98, 0x8a, 0x14, 0x48, 0xb8, 0xfd, 0xfd, 0xae, 0x45, 0x75, 0x4f, 0x60, 0xe4, 0x85,
+	0x53, 0x14, 0x03, 0xb8, 0xa3, 0x07, 0xa1, 0xb7, 0x60, 0x89, 0xa7, 0xe9, 0x77, 0x35, 0xc2, 0x2a,
+	0xb0, 0xc8, 0x8c, 0xbb, 0xa2, 0xb5, 0x17, 0x27, 0x6a, 0xe1, 0x73, 0xa3, 0x8c, 0x3b, 0x9a, 0xb1,
+	0xef, 0xf1, 0x01, 0x44, 0x5c, 0x6a, 0xd0, 0xbe, 0x2b, 0xc7, 0x36, 0xa4, 0xcd, 0xe4, 0x76, 0x76,
+	0x6a, 0x0d, 0xfc, 0xc2, 0x37, 0x18, 0x4d, 0x15, 0x74, 0x54, 0x07, 0x74, 0xc7, 0xb2, 0x8d, 0x8e,
+	0x4e, 0x8d, 0x4e, 0x67, 0xa0, 0x3b, 0xd8, 0xed, 0x77, 0xa8, 0x1c, 0x67, 0xd9, 0x9d, 0x9b, 0xba,
+	0x44, 0xf3, 0x48, 0x2a, 0xe3, 0x94, 0xc2, 0x5e, 0x7e, 0x6a, 0x9a, 0x79, 0x8f, 0xd8, 0x51, 0x1d,
+	0x5e, 0x1b, 0x13, 0x52, 0x1d, 0xdb, 0xa6, 0x0c, 0xa7, 0x28, 0x57, 0x6a, 0x54, 0x4d, 0x15, 0xdb,
+	0x44, 0x75, 0x48, 0x71, 0x31, 0x25, 0x8e, 0x1f, 0x60, 0x82, 0x65, 0xf9, 0xee, 0x89, 0x59, 0x2a,
+	0x82, 0xcf, 0x63, 0x52, 0x93, 0x78, 0xec, 0x8c, 0x2e, 0x7a, 0x03, 0xe2, 0xba, 0x46, 0x1b, 0xbb,
+	0xf2, 0xe2, 0x46, 0xe8, 0xa4, 0xa5, 0x51, 0x87, 0xac, 0x2b, 0x61, 0x6f, 0x8a, 0x73, 0x5f, 0x4b,
+	0x90, 0x18, 0xcd, 0x75, 0x1d, 0xe2, 0x03, 0xec, 0xea, 0x2d, 0xd2, 0xb7, 0xa9, 0xf8, 0xe4, 0x8f,
+	0x0d, 0xb0, 0x5b, 0xf6, 0xce, 0x5e, 0xab, 0x8d, 0x7d, 0x97, 0x1a, 0x96, 0x2d, 0x08, 0xfc, 0xbb,
+	0xd8, 0xa2, 0x30, 0x72, 0xd2, 0x2a, 0xc4, 0x6c, 0x22, 0x70, 0x3e, 0xaa, 0x51, 0x9b, 0x70, 0xe8,
+	0x3d, 0x40, 0x36, 0xd1, 0x8f, 0x2c, 0x7a, 0xa0, 0xb3, 0xef, 0x00, 0x9c, 0xc4, 0x05, 0x22, 0x65,
+	0x93, 0x3d, 0x8b, 0x1e, 0x78, 0x5f, 0x46, 0x18, 0x59, 0xc4, 0xf7, 0x97, 0x04, 0xe1, 0x5d, 0x42,
+	0x31, 0xca, 0x42, 0xa2, 0x27, 0x4a, 0x71, 0x2c, 0x9a, 0xe0, 0x9b, 0xb8, 0x46, 0x1d, 0x12, 0x2a,
+	0x64, 0x73, 0xae, 0x46, 0x31, 0x1a, 0xba, 0x0c, 0x11, 0xd2, 0xf3, 0x3e, 0x8d, 0x58, 0x94, 0xc9,
+	0xed, 0xf5, 0xa9, 0xd2, 0x7b, 0xef, 0xd6, 0x18, 0x45, 0x15, 0xd4, 0xb9, 0xc2, 0xf6, 0x6a, 0xf6,
+	0xe9, 0xc2, 0x97, 0x12, 0xc0, 0xf1, 0xcb, 0x68, 0x1d, 0x56, 0x76, 0x6b, 0x9a, 0xa2, 0xd7, 0xea,
+	0x5a, 0xa5, 0x56, 0xd5, 0x9b, 0xd5, 0x46, 0x5d, 0x29, 0x57, 0xae, 0x55, 0x94, 0x9d, 0x74, 0x00,
+	0x9d, 0x81, 0xd4, 0x28, 0x78, 0x5b, 0x69, 0xa4, 0x25, 0xb4, 0x02, 0x67, 0x46, 0x8d, 0xc5, 0x52,
+	0x43, 0x2b, 0x56, 0xaa, 0xe9, 0x20, 0x42, 0x90, 0x1c, 0x05, 0xaa, 0xb5, 0x74, 0x08, 0x9d, 0x03,
+	0x79, 0xdc, 0xa6, 0xef, 0x55, 0xb4, 0xeb, 0xfa, 0xae, 0xa2, 0xd5, 0xd2, 0xe1, 0xb5, 0xf0, 0xfd,
+	0x6f, 0x32, 0x81, 0x0b, 0x3f, 0x4a, 0x90, 0x1c, 0x5f, 0x36, 0x94, 0x85, 0xf5, 0xba, 0x5a, 0xab,
+	0xd7, 0x1a, 0xc5, 0x9b, 0x7a, 0x43, 0x2b, 0x6a, 0xcd, 0xc6, 0x44, 0x64, 0x6f, 0xc0, 0xea, 0x24,
+	0xa1, 0xd1, 0x2c, 0xdd, 0xaa, 0x68, 0x9a, 0xb2, 0x93, 0x96, 0xbc, 0x67, 0x27, 0xe1, 0x62, 0xb9,
+	0xac, 0xd4, 0x3d, 0x34, 0x38, 0x0b, 0x55, 0x95, 0x1b, 0x4a, 0xd9, 0x43, 0x43, 0x5e, 0x45, 0xa6,
+	0x7c, 0x4b, 0x35, 0xd5, 0x03, 0xc3, 0xb3, 0xde, 0xf5, 0x12, 0xda, 0x51, 0x8b, 0x7b, 0xd5, 0xf4,
+	0x82, 0x48, 0xe8, 0x7b, 0x09, 0xce, 0xce, 0xde, 0x2b, 0xb4, 0x09, 0xe7, 0x87, 0xfe, 0xca, 0xa7,
+	0x4a, 0xb9, 0xa9, 0xd5, 0x54, 0x5d, 0x55, 0x1a, 0xcd, 0x9b, 0xda, 0x44, 0x86, 0xe7, 0x61, 0xe3,
+	0x44, 0x66, 0xb5, 0xa6, 0xe9, 0x6a, 0xb3, 0x9a, 0x96, 0xe6, 0xb2, 0x1a, 0xcd, 0x72, 0x59, 0x69,
+	0x34, 0xd2, 0xc1, 0xb9, 0xac, 0x6b, 0xc5, 0xca, 0xcd, 0xa6, 0xaa, 0xa4, 0x43, 0x3c, 0xf8, 0xd2,
+	0x47, 0x8f, 0x9f, 0x65, 0xa4, 0xa7, 0xcf, 0x32, 0xd2, 0x6f, 0xcf, 0x32, 0xd2, 0x83, 0xe7, 0x99,
+	0xc0, 0xd3, 0xe7, 0x99, 0xc0, 0xcf, 0xcf, 0x33, 0x81, 0xcf, 0xce, 0xb7, 0x2d, 0x7a, 0xd0, 0xdf,
+	0xcf, 0xb7, 0x48, 0x57, 0xfc, 0xa0, 0x14, 0x7f, 0xb6, 0x5c, 0xf3, 0x6e, 0xe1, 0x1e, 0xff, 0xbd,
+	0xbb, 0x1f, 0x61, 0x93, 0x78, 0xf9, 0x9f, 0x01, 0x00, 0xc5, 0x3f, 0xbc, 0x81, 0x06, 0x0f, 0x00,
+	0x00,
 }
 
 func (this *GroupPolicyInfo) Equal(that interface{}) bool {
@@ -1213,6 +1367,107 @@ func (m *PercentageDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *VetoDecisionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VetoDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VetoDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Windows != nil {
+		{
+			size, err := m.Windows.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.VetoThreshold) > 0 {
+		i -= len(m.VetoThreshold)
+		copy(dAtA[i:], m.VetoThreshold)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.VetoThreshold)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VetoMembers) > 0 {
+		for iNdEx := len(m.VetoMembers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VetoMembers[iNdEx])
+			copy(dAtA[i:], m.VetoMembers[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.VetoMembers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TimelockDecisionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimelockDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimelockDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Timelock, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Timelock):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintTypes(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
+	if m.DecisionPolicy != nil {
+		{
+			size, err := m.DecisionPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DecisionPolicyWindows) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1233,20 +1488,20 @@ func (m *DecisionPolicyWindows) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MinExecutionPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinExecutionPeriod):])
-	if err4 != nil {
-		return 0, err4
+	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MinExecutionPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinExecutionPeriod):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n4
-	i = encodeVarintTypes(dAtA, i, uint64(n4))
+	i -= n7
+	i = encodeVarintTypes(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0x12
-	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod):])
-	if err5 != nil {
-		return 0, err5
+	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod):])
+	if err8 != nil {
+		return 0, err8
 	}
-	i -= n5
-	i = encodeVarintTypes(dAtA, i, uint64(n5))
+	i -= n8
+	i = encodeVarintTypes(dAtA, i, uint64(n8))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -1272,12 +1527,12 @@ func (m *GroupInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
-	if err6 != nil {
-		return 0, err6
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err9 != nil {
+		return 0, err9
 	}
-	i -= n6
-	i = encodeVarintTypes(dAtA, i, uint64(n6))
+	i -= n9
+	i = encodeVarintTypes(dAtA, i, uint64(n9))
 	i--
 	dAtA[i] = 0x32
 	if len(m.TotalWeight) > 0 {
@@ -1374,12 +1629,12 @@ func (m *GroupPolicyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
-	if err8 != nil {
-		return 0, err8
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err11 != nil {
+		return 0, err11
 	}
-	i -= n8
-	i = encodeVarintTypes(dAtA, i, uint64(n8))
+	i -= n11
+	i = encodeVarintTypes(dAtA, i, uint64(n11))
 	i--
 	dAtA[i] = 0x3a
 	if m.DecisionPolicy != nil {
@@ -1467,12 +1722,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x58
 	}
-	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingPeriodEnd, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingPeriodEnd):])
-	if err10 != nil {
-		return 0, err10
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingPeriodEnd, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingPeriodEnd):])
+	if err13 != nil {
+		return 0, err13
 	}
-	i -= n10
-	i = encodeVarintTypes(dAtA, i, uint64(n10))
+	i -= n13
+	i = encodeVarintTypes(dAtA, i, uint64(n13))
 	i--
 	dAtA[i] = 0x52
 	{
@@ -1500,12 +1755,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x30
 	}
-	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
-	if err12 != nil {
-		return 0, err12
+	n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
+	if err15 != nil {
+		return 0, err15
 	}
-	i -= n12
-	i = encodeVarintTypes(dAtA, i, uint64(n12))
+	i -= n15
+	i = encodeVarintTypes(dAtA, i, uint64(n15))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Proposers) > 0 {
@@ -1610,12 +1865,12 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
-	if err13 != nil {
-		return 0, err13
+	n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
+	if err16 != nil {
+		return 0, err16
 	}
-	i -= n13
-	i = encodeVarintTypes(dAtA, i, uint64(n13))
+	i -= n16
+	i = encodeVarintTypes(dAtA, i, uint64(n16))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Metadata) > 0 {
@@ -1734,6 +1989,48 @@ func (m *PercentageDecisionPolicy) Size() (n int) {
 	return n
 }
 
+func (m *VetoDecisionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.VetoMembers) > 0 {
+		for _, s := range m.VetoMembers {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.VetoThreshold)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Windows != nil {
+		l = m.Windows.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *TimelockDecisionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DecisionPolicy != nil {
+		l = m.DecisionPolicy.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Timelock)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
 func (m *DecisionPolicyWindows) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2492,6 +2789,307 @@ func (m *PercentageDecisionPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *VetoDecisionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VetoDecisionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VetoDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoMembers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VetoMembers = append(m.VetoMembers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Windows == nil {
+				m.Windows = &DecisionPolicyWindows{}
+			}
+			if err := m.Windows.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimelockDecisionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimelockDecisionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimelockDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DecisionPolicy == nil {
+				m.DecisionPolicy = &types.Any{}
+			}
+			if err := m.DecisionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timelock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Timelock, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *DecisionPolicyWindows) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/group"
 
 	"github.com/stretchr/testify/require"
@@ -386,3 +387,161 @@ func TestThresholdDecisionPolicyAllow(t *testing.T) {
 		})
 	}
 }
+
+func TestVetoDecisionPolicyValidateBasic(t *testing.T) {
+	vetoMember1 := sdk.AccAddress("veto_member_1_______").String()
+	vetoMember2 := sdk.AccAddress("veto_member_2_______").String()
+	windows := &group.DecisionPolicyWindows{VotingPeriod: time.Hour}
+
+	testCases := []struct {
+		name   string
+		policy group.VetoDecisionPolicy
+		expErr bool
+	}{
+		{
+			"all good",
+			group.VetoDecisionPolicy{
+				Threshold:     "2",
+				VetoMembers:   []string{vetoMember1, vetoMember2},
+				VetoThreshold: "1",
+				Windows:       windows,
+			},
+			false,
+		},
+		{
+			"no veto members",
+			group.VetoDecisionPolicy{
+				Threshold:     "2",
+				VetoThreshold: "1",
+				Windows:       windows,
+			},
+			true,
+		},
+		{
+			"invalid veto member",
+			group.VetoDecisionPolicy{
+				Threshold:     "2",
+				VetoMembers:   []string{"invalid"},
+				VetoThreshold: "1",
+				Windows:       windows,
+			},
+			true,
+		},
+		{
+			"duplicate veto member",
+			group.VetoDecisionPolicy{
+				Threshold:     "2",
+				VetoMembers:   []string{vetoMember1, vetoMember1},
+				VetoThreshold: "1",
+				Windows:       windows,
+			},
+			true,
+		},
+		{
+			"zero veto threshold",
+			group.VetoDecisionPolicy{
+				Threshold:     "2",
+				VetoMembers:   []string{vetoMember1},
+				VetoThreshold: "0",
+				Windows:       windows,
+			},
+			true,
+		},
+		{
+			"zero voting period",
+			group.VetoDecisionPolicy{
+				Threshold:     "2",
+				VetoMembers:   []string{vetoMember1},
+				VetoThreshold: "1",
+				Windows:       &group.DecisionPolicyWindows{},
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestVetoDecisionPolicyAllow(t *testing.T) {
+	policy := group.VetoDecisionPolicy{
+		Threshold:     "2",
+		VetoMembers:   []string{sdk.AccAddress("veto_member_1_______").String()},
+		VetoThreshold: "1",
+		Windows: &group.DecisionPolicyWindows{
+			VotingPeriod: time.Hour,
+		},
+	}
+
+	// an accepted proposal is not final before the end of the voting period
+	result, err := policy.Allow(group.TallyResult{YesCount: "2", NoCount: "0", AbstainCount: "0", NoWithVetoCount: "0"}, "3")
+	require.NoError(t, err)
+	require.Equal(t, group.DecisionPolicyResult{Allow: true, Final: false}, result)
+
+	// a proposal which can't reach the threshold is rejected right away
+	result, err = policy.Allow(group.TallyResult{YesCount: "0", NoCount: "2", AbstainCount: "0", NoWithVetoCount: "0"}, "3")
+	require.NoError(t, err)
+	require.Equal(t, group.DecisionPolicyResult{Allow: false, Final: true}, result)
+
+	vetoed, err := policy.IsVetoed("0")
+	require.NoError(t, err)
+	require.False(t, vetoed)
+
+	vetoed, err = policy.IsVetoed("1")
+	require.NoError(t, err)
+	require.True(t, vetoed)
+}
+
+func TestTimelockDecisionPolicy(t *testing.T) {
+	config := group.DefaultConfig()
+	threshold := group.NewThresholdDecisionPolicy("2", time.Hour, 0)
+
+	policy, err := group.NewTimelockDecisionPolicy(threshold, time.Hour*24)
+	require.NoError(t, err)
+	require.NoError(t, policy.ValidateBasic())
+	require.NoError(t, policy.Validate(group.GroupInfo{TotalWeight: "3"}, config))
+	require.Equal(t, time.Hour, policy.GetVotingPeriod())
+	require.Equal(t, time.Hour*25, policy.GetMinExecutionPeriod())
+
+	// an accepted proposal is not final before the end of the voting period
+	result, err := policy.Allow(group.TallyResult{YesCount: "2", NoCount: "0", AbstainCount: "0", NoWithVetoCount: "0"}, "3")
+	require.NoError(t, err)
+	require.Equal(t, group.DecisionPolicyResult{Allow: true, Final: false}, result)
+
+	// the timelock can't exceed the max execution period
+	policy, err = group.NewTimelockDecisionPolicy(threshold, config.MaxExecutionPeriod+time.Second)
+	require.NoError(t, err)
+	require.Error(t, policy.Validate(group.GroupInfo{TotalWeight: "3"}, config))
+
+	// the timelock must be positive
+	policy, err = group.NewTimelockDecisionPolicy(threshold, 0)
+	require.NoError(t, err)
+	require.Error(t, policy.ValidateBasic())
+
+	// timelock decision policies can't be nested
+	nested, err := group.NewTimelockDecisionPolicy(threshold, time.Hour)
+	require.NoError(t, err)
+	policy, err = group.NewTimelockDecisionPolicy(nested, time.Hour)
+	require.NoError(t, err)
+	require.Error(t, policy.ValidateBasic())
+
+	// veto members of the wrapped policy are exposed
+	vetoMember := sdk.AccAddress("veto_member_1_______").String()
+	veto := group.NewVetoDecisionPolicy("2", []string{vetoMember}, "1", time.Hour, 0)
+	policy, err = group.NewTimelockDecisionPolicy(veto, time.Hour)
+	require.NoError(t, err)
+	require.NoError(t, policy.ValidateBasic())
+	vetoPolicy, ok := policy.(group.VetoPolicy)
+	require.True(t, ok)
+	require.Equal(t, []string{vetoMember}, vetoPolicy.GetVetoMembers())
+	vetoed, err := vetoPolicy.IsVetoed("1")
+	require.NoError(t, err)
+	require.True(t, vetoed)
+}
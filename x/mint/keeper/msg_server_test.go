@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/keeper"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+func (suite *MintTestSuite) TestUpdateParams() {
+	msgServer := keeper.NewMsgServerImpl(suite.app.MintKeeper)
+
+	params := types.DefaultParams()
+	params.InflationMax = sdk.NewDecWithPrec(30, 2)
+
+	invalidParams := types.DefaultParams()
+	invalidParams.MintDenom = ""
+
+	testCases := []struct {
+		name      string
+		request   *types.MsgUpdateParams
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name:      "invalid authority",
+			request:   &types.MsgUpdateParams{Authority: "invalid", Params: params},
+			expErr:    true,
+			expErrMsg: "expected gov account as only signer for proposal message",
+		},
+		{
+			name:      "invalid params",
+			request:   &types.MsgUpdateParams{Authority: suite.app.MintKeeper.GetAuthority(), Params: invalidParams},
+			expErr:    true,
+			expErrMsg: "mint denom cannot be blank",
+		},
+		{
+			name:    "all good",
+			request: &types.MsgUpdateParams{Authority: suite.app.MintKeeper.GetAuthority(), Params: params},
+			expErr:  false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			_, err := msgServer.UpdateParams(sdk.WrapSDKContext(suite.ctx), tc.request)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(params, suite.app.MintKeeper.GetParams(suite.ctx))
+			}
+		})
+	}
+}
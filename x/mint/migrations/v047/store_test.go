@@ -0,0 +1,38 @@
+package v047_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v047mint "github.com/cosmos/cosmos-sdk/x/mint/migrations/v047"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	mintKey := sdk.NewKVStoreKey(types.StoreKey)
+	tMintKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(mintKey, tMintKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, mintKey, tMintKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	// Set non default params in the legacy subspace.
+	params := types.DefaultParams()
+	params.InflationMax = sdk.NewDecWithPrec(30, 2)
+	paramstore.SetParamSet(ctx, &params)
+
+	// Run migrations.
+	err := v047mint.MigrateStore(ctx, mintKey, paramstore, encCfg.Codec)
+	require.NoError(t, err)
+
+	// Make sure the params are now in the module store.
+	var res types.Params
+	bz := ctx.KVStore(mintKey).Get(types.ParamsKey)
+	require.NoError(t, encCfg.Codec.Unmarshal(bz, &res))
+	require.Equal(t, params, res)
+}
@@ -1,7 +1,12 @@
 package types
 
-// MinterKey is the key to use for the keeper store.
-var MinterKey = []byte{0x00}
+var (
+	// MinterKey is the key to use for the keeper store.
+	MinterKey = []byte{0x00}
+
+	// ParamsKey is the key of the module parameters in the keeper store.
+	ParamsKey = []byte{0x01}
+)
 
 const (
 	// module name
@@ -5,14 +5,28 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/nft"
 )
 
+// Flag names and values
+const (
+	FlagName            = "name"
+	FlagSymbol          = "symbol"
+	FlagDescription     = "description"
+	FlagURI             = "uri"
+	FlagURIHash         = "uri-hash"
+	FlagMaxSupply       = "max-supply"
+	FlagRoyaltyReceiver = "royalty-receiver"
+	FlagRoyaltyRate     = "royalty-rate"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	nftTxCmd := &cobra.Command{
@@ -26,6 +40,11 @@ func GetTxCmd() *cobra.Command {
 
 	nftTxCmd.AddCommand(
 		NewCmdSend(),
+		NewCmdCreateClass(),
+		NewCmdUpdateClass(),
+		NewCmdMint(),
+		NewCmdBurn(),
+		NewCmdUpdate(),
 	)
 
 	return nftTxCmd
@@ -58,3 +77,266 @@ func NewCmdSend() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func NewCmdCreateClass() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-class [class-id] --from [issuer]",
+		Args:  cobra.ExactArgs(1),
+		Short: "create a new nft class, managed by its issuer",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s create-class <class-id> --name <name> --symbol <symbol> --max-supply <max-supply> --royalty-receiver <receiver> --royalty-rate <rate> --from <issuer> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgCreateClass{
+				Issuer: clientCtx.GetFromAddress().String(),
+				Id:     args[0],
+			}
+			if msg.Name, err = cmd.Flags().GetString(FlagName); err != nil {
+				return err
+			}
+			if msg.Symbol, err = cmd.Flags().GetString(FlagSymbol); err != nil {
+				return err
+			}
+			if msg.Description, err = cmd.Flags().GetString(FlagDescription); err != nil {
+				return err
+			}
+			if msg.Uri, err = cmd.Flags().GetString(FlagURI); err != nil {
+				return err
+			}
+			if msg.UriHash, err = cmd.Flags().GetString(FlagURIHash); err != nil {
+				return err
+			}
+			if msg.MaxSupply, err = cmd.Flags().GetUint64(FlagMaxSupply); err != nil {
+				return err
+			}
+			if msg.Royalty, err = parseRoyalty(cmd.Flags(), nil); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	addClassFlags(cmd.Flags())
+	cmd.Flags().Uint64(FlagMaxSupply, 0, "Maximum number of nfts ever minted in the class, 0 for no cap")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdUpdateClass() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-class [class-id] --from [issuer]",
+		Args:  cobra.ExactArgs(1),
+		Short: "update an nft class, only the given fields are updated",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s update-class <class-id> --description <description> --from <issuer> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := nft.NewQueryClient(clientCtx).Class(cmd.Context(), &nft.QueryClassRequest{ClassId: args[0]})
+			if err != nil {
+				return err
+			}
+			class := res.Class
+
+			msg := nft.MsgUpdateClass{
+				Issuer:      clientCtx.GetFromAddress().String(),
+				Id:          class.Id,
+				Name:        class.Name,
+				Symbol:      class.Symbol,
+				Description: class.Description,
+				Uri:         class.Uri,
+				UriHash:     class.UriHash,
+				Data:        class.Data,
+			}
+			for flag, field := range map[string]*string{
+				FlagName:        &msg.Name,
+				FlagSymbol:      &msg.Symbol,
+				FlagDescription: &msg.Description,
+				FlagURI:         &msg.Uri,
+				FlagURIHash:     &msg.UriHash,
+			} {
+				if !cmd.Flags().Changed(flag) {
+					continue
+				}
+				if *field, err = cmd.Flags().GetString(flag); err != nil {
+					return err
+				}
+			}
+			if msg.Royalty, err = parseRoyalty(cmd.Flags(), class.Royalty); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	addClassFlags(cmd.Flags())
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdMint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint [class-id] [nft-id] [receiver] --from [issuer]",
+		Args:  cobra.ExactArgs(3),
+		Short: "mint a new nft of a class to the receiver",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s mint <class-id> <nft-id> <receiver> --uri <uri> --uri-hash <uri-hash> --from <issuer> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgMint{
+				Issuer:   clientCtx.GetFromAddress().String(),
+				ClassId:  args[0],
+				Id:       args[1],
+				Receiver: args[2],
+			}
+			if msg.Uri, err = cmd.Flags().GetString(FlagURI); err != nil {
+				return err
+			}
+			if msg.UriHash, err = cmd.Flags().GetString(FlagURIHash); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagURI, "", "URI of the nft metadata stored off chain")
+	cmd.Flags().String(FlagURIHash, "", "Hash of the document pointed by the URI")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdBurn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn [class-id] [nft-id] --from [owner]",
+		Args:  cobra.ExactArgs(2),
+		Short: "burn an owned nft",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s burn <class-id> <nft-id> --from <owner> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgBurn{
+				Owner:   clientCtx.GetFromAddress().String(),
+				ClassId: args[0],
+				Id:      args[1],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdUpdate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update [class-id] [nft-id] --from [issuer]",
+		Args:  cobra.ExactArgs(2),
+		Short: "update an nft, only the given fields are updated",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s update <class-id> <nft-id> --uri <uri> --uri-hash <uri-hash> --from <issuer> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := nft.NewQueryClient(clientCtx).NFT(cmd.Context(), &nft.QueryNFTRequest{ClassId: args[0], Id: args[1]})
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgUpdate{
+				Issuer:  clientCtx.GetFromAddress().String(),
+				ClassId: res.Nft.ClassId,
+				Id:      res.Nft.Id,
+				Uri:     res.Nft.Uri,
+				UriHash: res.Nft.UriHash,
+				Data:    res.Nft.Data,
+			}
+			if cmd.Flags().Changed(FlagURI) {
+				if msg.Uri, err = cmd.Flags().GetString(FlagURI); err != nil {
+					return err
+				}
+			}
+			if cmd.Flags().Changed(FlagURIHash) {
+				if msg.UriHash, err = cmd.Flags().GetString(FlagURIHash); err != nil {
+					return err
+				}
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagURI, "", "URI of the nft metadata stored off chain")
+	cmd.Flags().String(FlagURIHash, "", "Hash of the document pointed by the URI")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// addClassFlags adds the flags of the mutable class fields.
+func addClassFlags(fs *pflag.FlagSet) {
+	fs.String(FlagName, "", "Human-readable name of the class")
+	fs.String(FlagSymbol, "", "Abbreviated name of the class")
+	fs.String(FlagDescription, "", "Brief description of the class")
+	fs.String(FlagURI, "", "URI of the class metadata stored off chain")
+	fs.String(FlagURIHash, "", "Hash of the document pointed by the URI")
+	fs.String(FlagRoyaltyReceiver, "", "Address receiving the royalties of the nfts of the class")
+	fs.String(FlagRoyaltyRate, "", "Share of the sale price paid as royalties, between 0 and 1")
+}
+
+// parseRoyalty returns the royalty given by the royalty flags, falling back
+// to the fields of the current royalty for the flags which were not set.
+func parseRoyalty(fs *pflag.FlagSet, current *nft.Royalty) (*nft.Royalty, error) {
+	if !fs.Changed(FlagRoyaltyReceiver) && !fs.Changed(FlagRoyaltyRate) {
+		return current, nil
+	}
+
+	royalty := nft.Royalty{Rate: sdk.ZeroDec()}
+	if current != nil {
+		royalty = *current
+	}
+
+	if fs.Changed(FlagRoyaltyReceiver) {
+		receiver, err := fs.GetString(FlagRoyaltyReceiver)
+		if err != nil {
+			return nil, err
+		}
+		royalty.Receiver = receiver
+	}
+	if fs.Changed(FlagRoyaltyRate) {
+		rateStr, err := fs.GetString(FlagRoyaltyRate)
+		if err != nil {
+			return nil, err
+		}
+		rate, err := sdk.NewDecFromStr(rateStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid royalty rate %s: %w", rateStr, err)
+		}
+		royalty.Rate = rate
+	}
+	return &royalty, nil
+}
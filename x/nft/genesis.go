@@ -10,6 +10,19 @@ func ValidateGenesis(data GenesisState) error {
 		if err := ValidateClassID(class.Id); err != nil {
 			return err
 		}
+		if class.Issuer != "" {
+			if _, err := sdk.AccAddressFromBech32(class.Issuer); err != nil {
+				return err
+			}
+		}
+		if err := ValidateRoyalty(class.Royalty); err != nil {
+			return err
+		}
+	}
+	for _, minted := range data.Minted {
+		if err := ValidateClassID(minted.ClassId); err != nil {
+			return err
+		}
 	}
 	for _, entry := range data.Entries {
 		for _, nft := range entry.Nfts {
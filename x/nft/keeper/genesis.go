@@ -23,13 +23,24 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *nft.GenesisState) {
 			}
 		}
 	}
+	// the burned nfts are only accounted for by the exported minted numbers
+	for _, minted := range data.Minted {
+		if minted.Minted > k.GetMinted(ctx, minted.ClassId) {
+			k.setMinted(ctx, minted.ClassId, minted.Minted)
+		}
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *nft.GenesisState {
 	classes := k.GetClasses(ctx)
 	nftMap := make(map[string][]*nft.NFT)
+	var minted []*nft.ClassMinted
 	for _, class := range classes {
+		if n := k.GetMinted(ctx, class.Id); n > 0 {
+			minted = append(minted, &nft.ClassMinted{ClassId: class.Id, Minted: n})
+		}
+
 		nfts := k.GetNFTsOfClass(ctx, class.Id)
 		for i, n := range nfts {
 			owner := k.GetOwner(ctx, n.ClassId, n.Id)
@@ -57,5 +68,6 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *nft.GenesisState {
 	return &nft.GenesisState{
 		Classes: classes,
 		Entries: entries,
+		Minted:  minted,
 	}
 }
@@ -361,3 +361,24 @@ func (s *TestSuite) TestInitGenesis() {
 	s.Require().True(has)
 	s.Require().EqualValues(expNFT, actNFT)
 }
+
+func (s *TestSuite) TestGenesisMinted() {
+	class := nft.Class{Id: testClassID, MaxSupply: 2}
+	s.Require().NoError(s.app.NFTKeeper.SaveClass(s.ctx, class))
+	s.Require().NoError(s.app.NFTKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID}, s.addrs[0]))
+	s.Require().NoError(s.app.NFTKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID + "2"}, s.addrs[0]))
+	s.Require().NoError(s.app.NFTKeeper.Burn(s.ctx, testClassID, testID))
+
+	// the burned nft is exported in the minted number of the class
+	genesis := s.app.NFTKeeper.ExportGenesis(s.ctx)
+	s.Require().Equal([]*nft.ClassMinted{{ClassId: testClassID, Minted: 2}}, genesis.Minted)
+	s.Require().NoError(nft.ValidateGenesis(*genesis))
+
+	// and the max supply remains reached once imported
+	s.SetupTest()
+	s.app.NFTKeeper.InitGenesis(s.ctx, genesis)
+	s.Require().Equal(uint64(1), s.app.NFTKeeper.GetTotalSupply(s.ctx, testClassID))
+	s.Require().Equal(uint64(2), s.app.NFTKeeper.GetMinted(s.ctx, testClassID))
+	err := s.app.NFTKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID}, s.addrs[0])
+	s.Require().ErrorIs(err, nft.ErrMaxSupplyReached)
+}
@@ -15,6 +15,7 @@ var (
 	NFTOfClassByOwnerKey = []byte{0x03}
 	OwnerKey             = []byte{0x04}
 	ClassTotalSupply     = []byte{0x05}
+	ClassMintedKey       = []byte{0x06}
 
 	Delimiter   = []byte{0x00}
 	Placeholder = []byte{0x01}
@@ -48,6 +49,15 @@ func classTotalSupply(classID string) []byte {
 	return key
 }
 
+// classMintedStoreKey returns the byte representation of the number of nfts
+// ever minted in a class
+func classMintedStoreKey(classID string) []byte {
+	key := make([]byte, len(ClassMintedKey)+len(classID))
+	copy(key, ClassMintedKey)
+	copy(key[len(ClassMintedKey):], classID)
+	return key
+}
+
 // nftOfClassByOwnerStoreKey returns the byte representation of the nft owner
 // Items are stored with the following key: values
 // 0x03<owner><Delimiter(1 Byte)><classID><Delimiter(1 Byte)>
@@ -8,10 +8,29 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/nft"
 )
 
-var _ nft.MsgServer = Keeper{}
+type msgServer struct {
+	Keeper
+}
 
-// Send implement Send method of the types.MsgServer.
+// NewMsgServerImpl returns an implementation of the nft MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) nft.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ nft.MsgServer = msgServer{}
+
+// Send implements the Send method of the nft MsgServer on the Keeper, which
+// can't implement the whole interface as its Mint, Burn and Update methods
+// clash with the ones of the MsgServer.
+//
+// Deprecated: use NewMsgServerImpl instead.
 func (k Keeper) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendResponse, error) {
+	return msgServer{Keeper: k}.Send(goCtx, msg)
+}
+
+// Send implement Send method of the types.MsgServer.
+func (k msgServer) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	sender, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
@@ -40,3 +59,148 @@ func (k Keeper) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendRespo
 	})
 	return &nft.MsgSendResponse{}, nil
 }
+
+// CreateClass implement CreateClass method of the types.MsgServer.
+func (k msgServer) CreateClass(goCtx context.Context, msg *nft.MsgCreateClass) (*nft.MsgCreateClassResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	class := nft.Class{
+		Id:          msg.Id,
+		Name:        msg.Name,
+		Symbol:      msg.Symbol,
+		Description: msg.Description,
+		Uri:         msg.Uri,
+		UriHash:     msg.UriHash,
+		Data:        msg.Data,
+		Issuer:      msg.Issuer,
+		MaxSupply:   msg.MaxSupply,
+		Royalty:     msg.Royalty,
+	}
+	if err := k.SaveClass(ctx, class); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventCreateClass{
+		ClassId: msg.Id,
+		Issuer:  msg.Issuer,
+	})
+	return &nft.MsgCreateClassResponse{}, nil
+}
+
+// UpdateClass implement UpdateClass method of the types.MsgServer.
+func (k msgServer) UpdateClass(goCtx context.Context, msg *nft.MsgUpdateClass) (*nft.MsgUpdateClassResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	class, err := k.getIssuedClass(ctx, msg.Id, msg.Issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	class.Name = msg.Name
+	class.Symbol = msg.Symbol
+	class.Description = msg.Description
+	class.Uri = msg.Uri
+	class.UriHash = msg.UriHash
+	class.Data = msg.Data
+	class.Royalty = msg.Royalty
+	if err := k.Keeper.UpdateClass(ctx, class); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventUpdateClass{
+		ClassId: msg.Id,
+	})
+	return &nft.MsgUpdateClassResponse{}, nil
+}
+
+// Mint implement Mint method of the types.MsgServer.
+func (k msgServer) Mint(goCtx context.Context, msg *nft.MsgMint) (*nft.MsgMintResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.getIssuedClass(ctx, msg.ClassId, msg.Issuer); err != nil {
+		return nil, err
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	token := nft.NFT{
+		ClassId: msg.ClassId,
+		Id:      msg.Id,
+		Uri:     msg.Uri,
+		UriHash: msg.UriHash,
+		Data:    msg.Data,
+	}
+	if err := k.Keeper.Mint(ctx, token, receiver); err != nil {
+		return nil, err
+	}
+	return &nft.MsgMintResponse{}, nil
+}
+
+// Burn implement Burn method of the types.MsgServer.
+func (k msgServer) Burn(goCtx context.Context, msg *nft.MsgBurn) (*nft.MsgBurnResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	class, has := k.GetClass(ctx, msg.ClassId)
+	if !has {
+		return nil, sdkerrors.Wrap(nft.ErrClassNotExists, msg.ClassId)
+	}
+	if class.Issuer == "" {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "nfts of class %s cannot be burned through messages", msg.ClassId)
+	}
+
+	if !k.GetOwner(ctx, msg.ClassId, msg.Id).Equals(owner) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", owner, msg.Id)
+	}
+
+	if err := k.Keeper.Burn(ctx, msg.ClassId, msg.Id); err != nil {
+		return nil, err
+	}
+	return &nft.MsgBurnResponse{}, nil
+}
+
+// Update implement Update method of the types.MsgServer.
+func (k msgServer) Update(goCtx context.Context, msg *nft.MsgUpdate) (*nft.MsgUpdateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.getIssuedClass(ctx, msg.ClassId, msg.Issuer); err != nil {
+		return nil, err
+	}
+
+	token := nft.NFT{
+		ClassId: msg.ClassId,
+		Id:      msg.Id,
+		Uri:     msg.Uri,
+		UriHash: msg.UriHash,
+		Data:    msg.Data,
+	}
+	if err := k.Keeper.Update(ctx, token); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventUpdate{
+		ClassId: msg.ClassId,
+		Id:      msg.Id,
+	})
+	return &nft.MsgUpdateResponse{}, nil
+}
+
+// getIssuedClass returns the class of the specified id, making sure it was
+// issued by the given issuer.
+func (k msgServer) getIssuedClass(ctx sdk.Context, classID, issuer string) (nft.Class, error) {
+	class, has := k.GetClass(ctx, classID)
+	if !has {
+		return nft.Class{}, sdkerrors.Wrap(nft.ErrClassNotExists, classID)
+	}
+
+	if class.Issuer == "" || class.Issuer != issuer {
+		return nft.Class{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the issuer of class %s", issuer, classID)
+	}
+	return class, nil
+}
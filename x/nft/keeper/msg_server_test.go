@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/cosmos/cosmos-sdk/x/nft/keeper"
+)
+
+func (s *TestSuite) TestMsgClassLifecycle() {
+	msgServer := keeper.NewMsgServerImpl(s.app.NFTKeeper)
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	issuer, other := s.addrs[0].String(), s.addrs[1].String()
+	royalty := &nft.Royalty{Receiver: issuer, Rate: sdk.NewDecWithPrec(5, 2)}
+
+	_, err := msgServer.CreateClass(goCtx, &nft.MsgCreateClass{
+		Issuer:    issuer,
+		Id:        testClassID,
+		Name:      testClassName,
+		Symbol:    testClassSymbol,
+		MaxSupply: 1,
+		Royalty:   royalty,
+	})
+	s.Require().NoError(err)
+
+	// a class can't be created twice
+	_, err = msgServer.CreateClass(goCtx, &nft.MsgCreateClass{Issuer: other, Id: testClassID})
+	s.Require().ErrorIs(err, nft.ErrClassExists)
+
+	// only the issuer can update the class
+	_, err = msgServer.UpdateClass(goCtx, &nft.MsgUpdateClass{Issuer: other, Id: testClassID})
+	s.Require().Error(err)
+	_, err = msgServer.UpdateClass(goCtx, &nft.MsgUpdateClass{
+		Issuer:      issuer,
+		Id:          testClassID,
+		Name:        testClassName,
+		Description: testClassDescription,
+	})
+	s.Require().NoError(err)
+	class, has := s.app.NFTKeeper.GetClass(s.ctx, testClassID)
+	s.Require().True(has)
+	s.Require().Equal(testClassDescription, class.Description)
+	s.Require().Empty(class.Symbol)
+	s.Require().Nil(class.Royalty)
+	s.Require().Equal(issuer, class.Issuer)
+	s.Require().Equal(uint64(1), class.MaxSupply)
+}
+
+func (s *TestSuite) TestMsgMintBurnUpdate() {
+	msgServer := keeper.NewMsgServerImpl(s.app.NFTKeeper)
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	issuer, owner := s.addrs[0].String(), s.addrs[1].String()
+
+	_, err := msgServer.CreateClass(goCtx, &nft.MsgCreateClass{
+		Issuer:    issuer,
+		Id:        testClassID,
+		MaxSupply: 1,
+	})
+	s.Require().NoError(err)
+
+	// only the issuer can mint
+	_, err = msgServer.Mint(goCtx, &nft.MsgMint{Issuer: owner, ClassId: testClassID, Id: testID, Receiver: owner})
+	s.Require().Error(err)
+	_, err = msgServer.Mint(goCtx, &nft.MsgMint{Issuer: issuer, ClassId: testClassID, Id: testID, Uri: testURI, Receiver: owner})
+	s.Require().NoError(err)
+	s.Require().Equal(owner, s.app.NFTKeeper.GetOwner(s.ctx, testClassID, testID).String())
+
+	// the max supply of the class is reached
+	_, err = msgServer.Mint(goCtx, &nft.MsgMint{Issuer: issuer, ClassId: testClassID, Id: testID + "2", Receiver: owner})
+	s.Require().ErrorIs(err, nft.ErrMaxSupplyReached)
+
+	// only the issuer can update a nft
+	_, err = msgServer.Update(goCtx, &nft.MsgUpdate{Issuer: owner, ClassId: testClassID, Id: testID, Uri: testURI + "2"})
+	s.Require().Error(err)
+	_, err = msgServer.Update(goCtx, &nft.MsgUpdate{Issuer: issuer, ClassId: testClassID, Id: testID, Uri: testURI + "2"})
+	s.Require().NoError(err)
+	token, has := s.app.NFTKeeper.GetNFT(s.ctx, testClassID, testID)
+	s.Require().True(has)
+	s.Require().Equal(testURI+"2", token.Uri)
+
+	// only the owner can burn a nft, which does not free up supply
+	_, err = msgServer.Burn(goCtx, &nft.MsgBurn{Owner: issuer, ClassId: testClassID, Id: testID})
+	s.Require().Error(err)
+	_, err = msgServer.Burn(goCtx, &nft.MsgBurn{Owner: owner, ClassId: testClassID, Id: testID})
+	s.Require().NoError(err)
+	s.Require().False(s.app.NFTKeeper.HasNFT(s.ctx, testClassID, testID))
+	s.Require().Zero(s.app.NFTKeeper.GetTotalSupply(s.ctx, testClassID))
+	_, err = msgServer.Mint(goCtx, &nft.MsgMint{Issuer: issuer, ClassId: testClassID, Id: testID + "2", Receiver: owner})
+	s.Require().ErrorIs(err, nft.ErrMaxSupplyReached)
+	s.Require().Equal(uint64(1), s.app.NFTKeeper.GetMinted(s.ctx, testClassID))
+}
+
+func (s *TestSuite) TestMsgClassWithoutIssuer() {
+	msgServer := keeper.NewMsgServerImpl(s.app.NFTKeeper)
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	owner := s.addrs[0].String()
+
+	// classes saved through the keeper without issuer can't be managed
+	// through messages
+	s.Require().NoError(s.app.NFTKeeper.SaveClass(s.ctx, nft.Class{Id: testClassID}))
+	s.Require().NoError(s.app.NFTKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID}, s.addrs[0]))
+
+	_, err := msgServer.Mint(goCtx, &nft.MsgMint{ClassId: testClassID, Id: testID + "2", Receiver: owner})
+	s.Require().Error(err)
+	_, err = msgServer.Burn(goCtx, &nft.MsgBurn{Owner: owner, ClassId: testClassID, Id: testID})
+	s.Require().Error(err)
+}
+
+func (s *TestSuite) TestKeeperSend() {
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	s.Require().NoError(s.app.NFTKeeper.SaveClass(s.ctx, nft.Class{Id: testClassID}))
+	s.Require().NoError(s.app.NFTKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID}, s.addrs[0]))
+
+	// the deprecated Keeper.Send behaves as the msg server
+	_, err := s.app.NFTKeeper.Send(goCtx, &nft.MsgSend{ClassId: testClassID, Id: testID, Sender: s.addrs[1].String(), Receiver: s.addrs[2].String()})
+	s.Require().Error(err)
+	_, err = s.app.NFTKeeper.Send(goCtx, &nft.MsgSend{ClassId: testClassID, Id: testID, Sender: s.addrs[0].String(), Receiver: s.addrs[1].String()})
+	s.Require().NoError(err)
+	s.Require().Equal(s.addrs[1], s.app.NFTKeeper.GetOwner(s.ctx, testClassID, testID))
+}
@@ -7,9 +7,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/nft"
 )
 
-// Mint defines a method for minting a new nft
+// Mint defines a method for minting a new nft. It fails if the max supply of
+// the class is reached.
 func (k Keeper) Mint(ctx sdk.Context, token nft.NFT, receiver sdk.AccAddress) error {
-	if !k.HasClass(ctx, token.ClassId) {
+	class, has := k.GetClass(ctx, token.ClassId)
+	if !has {
 		return sdkerrors.Wrap(nft.ErrClassNotExists, token.ClassId)
 	}
 
@@ -17,6 +19,15 @@ func (k Keeper) Mint(ctx sdk.Context, token nft.NFT, receiver sdk.AccAddress) er
 		return sdkerrors.Wrap(nft.ErrNFTExists, token.Id)
 	}
 
+	// the burned nfts still count towards the max supply
+	if class.MaxSupply > 0 {
+		minted := k.GetMinted(ctx, token.ClassId)
+		if minted >= class.MaxSupply {
+			return sdkerrors.Wrapf(nft.ErrMaxSupplyReached, "class %s has a max supply of %d", token.ClassId, class.MaxSupply)
+		}
+		k.setMinted(ctx, token.ClassId, minted+1)
+	}
+
 	k.setNFT(ctx, token)
 	k.setOwner(ctx, token.ClassId, token.Id, receiver)
 	k.incrTotalSupply(ctx, token.ClassId)
@@ -148,6 +159,15 @@ func (k Keeper) GetTotalSupply(ctx sdk.Context, classID string) uint64 {
 	return sdk.BigEndianToUint64(bz)
 }
 
+// GetMinted returns the number of nfts ever minted under the specified classID,
+// the burned ones included. It is only tracked for the classes with a max
+// supply.
+func (k Keeper) GetMinted(ctx sdk.Context, classID string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(classMintedStoreKey(classID))
+	return sdk.BigEndianToUint64(bz)
+}
+
 // HasNFT determines whether the specified classID and nftID exist
 func (k Keeper) HasNFT(ctx sdk.Context, classID, id string) bool {
 	store := k.getNFTStore(ctx, classID)
@@ -208,3 +228,8 @@ func (k Keeper) updateTotalSupply(ctx sdk.Context, classID string, supply uint64
 	supplyKey := classTotalSupply(classID)
 	store.Set(supplyKey, sdk.Uint64ToBigEndian(supply))
 }
+
+func (k Keeper) setMinted(ctx sdk.Context, classID string, minted uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(classMintedStoreKey(classID), sdk.Uint64ToBigEndian(minted))
+}
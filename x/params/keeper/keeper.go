@@ -16,6 +16,7 @@ type Keeper struct {
 	key         storetypes.StoreKey
 	tkey        storetypes.StoreKey
 	spaces      map[string]*types.Subspace
+	migrated    map[string]bool
 }
 
 // NewKeeper constructs a params keeper
@@ -26,6 +27,7 @@ func NewKeeper(cdc codec.BinaryCodec, legacyAmino *codec.LegacyAmino, key, tkey
 		key:         key,
 		tkey:        tkey,
 		spaces:      make(map[string]*types.Subspace),
+		migrated:    make(map[string]bool),
 	}
 }
 
@@ -60,6 +62,20 @@ func (k Keeper) GetSubspace(s string) (types.Subspace, bool) {
 	return *space, ok
 }
 
+// SetMigrated marks the given subspaces as migrated: their module keeps its
+// params in its own store, the subspace is only read to migrate them, so a
+// ParameterChangeProposal can no longer update them.
+func (k Keeper) SetMigrated(subspaces ...string) {
+	for _, s := range subspaces {
+		k.migrated[s] = true
+	}
+}
+
+// IsMigrated returns true if the given subspace is migrated to its module.
+func (k Keeper) IsMigrated(s string) bool {
+	return k.migrated[s]
+}
+
 // GetSubspaces returns all the registered subspaces.
 func (k Keeper) GetSubspaces() []types.Subspace {
 	spaces := make([]types.Subspace, len(k.spaces))
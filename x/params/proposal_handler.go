@@ -30,6 +30,13 @@ func handleParameterChangeProposal(ctx sdk.Context, k keeper.Keeper, p *proposal
 			return sdkerrors.Wrap(proposal.ErrUnknownSubspace, c.Subspace)
 		}
 
+		// the module of a migrated subspace doesn't read it anymore, silently
+		// updating it would have no effect.
+		if k.IsMigrated(c.Subspace) {
+			return sdkerrors.Wrapf(proposal.ErrMigratedSubspace,
+				"%s params must be updated with the module MsgUpdateParams", c.Subspace)
+		}
+
 		k.Logger(ctx).Info(
 			fmt.Sprintf("attempt to set new parameter value; key: %s, value: %s", c.Key, c.Value),
 		)
@@ -7,8 +7,10 @@ import (
 
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
@@ -40,43 +42,63 @@ func testProposal(changes ...proposal.ParamChange) *proposal.ParameterChangeProp
 }
 
 func (suite *HandlerTestSuite) TestProposalHandler() {
+	maxValidators := suite.app.StakingKeeper.GetParams(suite.ctx).MaxValidators
+	minDeposit := suite.app.GovKeeper.GetParams(suite.ctx).MinDeposit
+	maxGas := suite.app.GetConsensusParams(suite.ctx).Block.MaxGas
+
 	testCases := []struct {
 		name     string
 		proposal *proposal.ParameterChangeProposal
 		onHandle func()
-		expErr   bool
+		expErr   error
 	}{
 		{
 			"all fields",
-			testProposal(proposal.NewParamChange(stakingtypes.ModuleName, string(stakingtypes.KeyMaxValidators), "1")),
+			testProposal(proposal.NewParamChange(authtypes.ModuleName, string(authtypes.KeyMaxMemoCharacters), `"1"`)),
 			func() {
-				maxVals := suite.app.StakingKeeper.MaxValidators(suite.ctx)
-				suite.Require().Equal(uint32(1), maxVals)
+				suite.Require().Equal(uint64(1), suite.app.AccountKeeper.GetParams(suite.ctx).MaxMemoCharacters)
 			},
-			false,
+			nil,
 		},
 		{
 			"invalid type",
-			testProposal(proposal.NewParamChange(stakingtypes.ModuleName, string(stakingtypes.KeyMaxValidators), "-")),
+			testProposal(proposal.NewParamChange(authtypes.ModuleName, string(authtypes.KeyMaxMemoCharacters), "-")),
 			func() {},
-			true,
+			proposal.ErrSettingParameter,
 		},
 		{
 			"omit empty fields",
+			testProposal(proposal.ParamChange{
+				Subspace: baseapp.Paramspace,
+				Key:      string(baseapp.ParamStoreKeyBlockParams),
+				Value:    `{"max_bytes": "1000"}`,
+			}),
+			func() {
+				block := suite.app.GetConsensusParams(suite.ctx).Block
+				suite.Require().Equal(int64(1000), block.MaxBytes)
+				suite.Require().Equal(maxGas, block.MaxGas)
+			},
+			nil,
+		},
+		{
+			"migrated subspace",
+			testProposal(proposal.NewParamChange(stakingtypes.ModuleName, string(stakingtypes.KeyMaxValidators), "1")),
+			func() {
+				suite.Require().Equal(maxValidators, suite.app.StakingKeeper.GetParams(suite.ctx).MaxValidators)
+			},
+			proposal.ErrMigratedSubspace,
+		},
+		{
+			"migrated gov subspace",
 			testProposal(proposal.ParamChange{
 				Subspace: govtypes.ModuleName,
 				Key:      string(govv1.ParamStoreKeyDepositParams),
 				Value:    `{"min_deposit": [{"denom": "uatom","amount": "64000000"}], "max_deposit_period": "172800000000000"}`,
 			}),
 			func() {
-				depositParams := suite.app.GovKeeper.GetDepositParams(suite.ctx)
-				defaultPeriod := govv1.DefaultPeriod
-				suite.Require().Equal(govv1.DepositParams{
-					MinDeposit:       sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(64000000))),
-					MaxDepositPeriod: &defaultPeriod,
-				}, depositParams)
+				suite.Require().Equal(minDeposit, suite.app.GovKeeper.GetParams(suite.ctx).MinDeposit)
 			},
-			false,
+			proposal.ErrMigratedSubspace,
 		},
 	}
 
@@ -84,12 +106,12 @@ func (suite *HandlerTestSuite) TestProposalHandler() {
 		tc := tc
 		suite.Run(tc.name, func() {
 			err := suite.govHandler(suite.ctx, tc.proposal)
-			if tc.expErr {
-				suite.Require().Error(err)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
 			} else {
 				suite.Require().NoError(err)
-				tc.onHandle()
 			}
+			tc.onHandle()
 		})
 	}
 }
@@ -61,6 +61,18 @@ func mustMarshalJSONIndent(o interface{}) []byte {
 	return bz
 }
 
+// msgWrapperKey is the context key of the function wrapping the messages
+// delivered by GenAndDeliverTx.
+type msgWrapperKey struct{}
+
+// WithMsgWrapper returns a copy of ctx with which GenAndDeliverTx delivers the
+// message returned by wrap instead of the generated message, e.g. to send it
+// through a module queuing other messages. The operation is still reported
+// with the generated message.
+func WithMsgWrapper(ctx sdk.Context, wrap func(sdk.Msg) sdk.Msg) sdk.Context {
+	return ctx.WithValue(msgWrapperKey{}, wrap)
+}
+
 // OperationInput is a struct that holds all the needed values to generate a tx and deliver it
 type OperationInput struct {
 	R               *rand.Rand
@@ -99,11 +111,16 @@ func GenAndDeliverTxWithRandFees(txCtx OperationInput) (simtypes.OperationMsg, [
 
 // GenAndDeliverTx generates a transactions and delivers it.
 func GenAndDeliverTx(txCtx OperationInput, fees sdk.Coins) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	msg := txCtx.Msg
+	if wrap, ok := txCtx.Context.Value(msgWrapperKey{}).(func(sdk.Msg) sdk.Msg); ok {
+		msg = wrap(msg)
+	}
+
 	account := txCtx.AccountKeeper.GetAccount(txCtx.Context, txCtx.SimAccount.Address)
 	tx, err := helpers.GenSignedMockTx(
 		txCtx.R,
 		txCtx.TxGen,
-		[]sdk.Msg{txCtx.Msg},
+		[]sdk.Msg{msg},
 		fees,
 		helpers.DefaultGenTxGas,
 		txCtx.Context.ChainID(),
@@ -97,7 +97,14 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 			)
 			k.sk.Jail(ctx, consAddr)
 
-			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(k.DowntimeJailDuration(ctx))
+			// Repeat offenders are jailed for longer, unless they stayed out of jail
+			// for the reset period since their last downtime jailing.
+			if !ctx.BlockHeader().Time.Before(signInfo.JailedUntil.Add(k.DowntimeJailResetPeriod(ctx))) {
+				signInfo.DowntimeJailCount = 0
+			}
+			jailDuration := k.EscalatedDowntimeJailDuration(ctx, signInfo.DowntimeJailCount)
+			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(jailDuration)
+			signInfo.DowntimeJailCount++
 
 			// We need to reset the counter & array so that the validator won't be immediately slashed for downtime upon rebonding.
 			signInfo.MissedBlocksCounter = 0
@@ -112,6 +119,7 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 				"threshold", minSignedPerWindow,
 				"slashed", k.SlashFractionDowntime(ctx).String(),
 				"jailed_until", signInfo.JailedUntil,
+				"jail_count", signInfo.DowntimeJailCount,
 			)
 		} else {
 			// validator was (a) not found or (b) already jailed so we do not slash
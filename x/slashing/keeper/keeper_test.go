@@ -283,3 +283,67 @@ func TestValidatorDippingInAndOut(t *testing.T) {
 	staking.EndBlocker(ctx, app.StakingKeeper)
 	tstaking.CheckValidator(valAddr, stakingtypes.Unbonding, true)
 }
+
+// Test a validator being jailed for downtime repeatedly
+// Ensure that the jail duration escalates up to the max and is reset
+// once the validator stayed out of jail for the reset period
+func TestHandleRepeatedDowntime(t *testing.T) {
+	// initial setup
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockTime(time.Unix(1000000, 0).UTC())
+
+	params := app.SlashingKeeper.GetParams(ctx)
+	params.DowntimeJailDuration = 10 * time.Minute
+	params.DowntimeJailMultiplier = sdk.NewDec(2)
+	params.MaxDowntimeJailDuration = 25 * time.Minute
+	params.DowntimeJailResetPeriod = time.Hour
+	app.SlashingKeeper.SetParams(ctx, params)
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+	pks := simapp.CreateTestPubKeys(1)
+	addr, val := valAddrs[0], pks[0]
+	consAddr := sdk.ConsAddress(val.Address())
+	power := int64(100)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	tstaking.CreateValidatorWithValPower(addr, val, power, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	height := int64(0)
+	testCases := []struct {
+		// time waited after the previous jail period ended before unjailing
+		jailedAfter  time.Duration
+		expDuration  time.Duration
+		expJailCount int64
+	}{
+		{0, 10 * time.Minute, 1},
+		{0, 20 * time.Minute, 2},
+		{0, 25 * time.Minute, 3},
+		{time.Hour, 10 * time.Minute, 1},
+	}
+
+	for i, tc := range testCases {
+		// miss blocks until the validator is jailed
+		for ; !app.StakingKeeper.Validator(ctx, addr).IsJailed(); height++ {
+			ctx = ctx.WithBlockHeight(height)
+			app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, false)
+		}
+		staking.EndBlocker(ctx, app.StakingKeeper)
+		tstaking.CheckValidator(addr, stakingtypes.Unbonding, true)
+
+		info, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+		require.True(t, found)
+		require.Equal(t, ctx.BlockTime().Add(tc.expDuration), info.JailedUntil, "offense %d", i)
+		require.Equal(t, tc.expJailCount, info.DowntimeJailCount, "offense %d", i)
+
+		// unjail once the jail period is over and rebond
+		if i+1 == len(testCases) {
+			break
+		}
+		ctx = ctx.WithBlockTime(info.JailedUntil.Add(testCases[i+1].jailedAfter))
+		require.NoError(t, app.SlashingKeeper.Unjail(ctx, addr))
+		staking.EndBlocker(ctx, app.StakingKeeper)
+		tstaking.CheckValidator(addr, stakingtypes.Bonded, false)
+	}
+}
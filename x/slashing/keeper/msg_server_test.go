@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+func (suite *SlashingTestSuite) TestMsgUpdateParams() {
+	msgServer := keeper.NewMsgServerImpl(suite.app.SlashingKeeper)
+	authority := suite.app.SlashingKeeper.GetAuthority()
+
+	params := types.DefaultParams()
+	params.SignedBlocksWindow = 1000
+
+	invalidParams := types.DefaultParams()
+	invalidParams.SignedBlocksWindow = 0
+
+	testCases := []struct {
+		name      string
+		input     *types.MsgUpdateParams
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name:      "invalid authority",
+			input:     &types.MsgUpdateParams{Authority: "invalid", Params: params},
+			expErr:    true,
+			expErrMsg: "expected gov account as only signer for proposal message",
+		},
+		{
+			name:      "invalid params",
+			input:     &types.MsgUpdateParams{Authority: authority, Params: invalidParams},
+			expErr:    true,
+			expErrMsg: "signed blocks window must be positive",
+		},
+		{
+			name:   "all good",
+			input:  &types.MsgUpdateParams{Authority: authority, Params: params},
+			expErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			_, err := msgServer.UpdateParams(sdk.WrapSDKContext(suite.ctx), tc.input)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(params, suite.app.SlashingKeeper.GetParams(suite.ctx))
+			}
+		})
+	}
+}
+
+func (suite *SlashingTestSuite) TestMsgUntombstone() {
+	msgServer := keeper.NewMsgServerImpl(suite.app.SlashingKeeper)
+	authority := suite.app.SlashingKeeper.GetAuthority()
+
+	validator := suite.app.StakingKeeper.GetAllValidators(suite.ctx)[0]
+	valAddr := validator.GetOperator()
+	consAddr, err := validator.GetConsAddr()
+	suite.Require().NoError(err)
+	suite.app.SlashingKeeper.SetValidatorSigningInfo(suite.ctx, consAddr,
+		types.NewValidatorSigningInfo(consAddr, 0, 0, time.Unix(0, 0), true, 0))
+
+	testCases := []struct {
+		name      string
+		input     *types.MsgUntombstone
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name:      "invalid authority",
+			input:     types.NewMsgUntombstone("invalid", valAddr),
+			expErr:    true,
+			expErrMsg: "expected gov account as only signer for proposal message",
+		},
+		{
+			name:      "unknown validator",
+			input:     types.NewMsgUntombstone(authority, sdk.ValAddress(suite.addrDels[0])),
+			expErr:    true,
+			expErrMsg: types.ErrNoValidatorForAddress.Error(),
+		},
+		{
+			name:   "all good",
+			input:  types.NewMsgUntombstone(authority, valAddr),
+			expErr: false,
+		},
+		{
+			name:      "not tombstoned",
+			input:     types.NewMsgUntombstone(authority, valAddr),
+			expErr:    true,
+			expErrMsg: types.ErrValidatorNotTombstoned.Error(),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			_, err := msgServer.Untombstone(sdk.WrapSDKContext(suite.ctx), tc.input)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().False(suite.app.SlashingKeeper.IsTombstoned(suite.ctx, consAddr))
+			}
+		})
+	}
+}
@@ -8,16 +8,15 @@ import (
 )
 
 // SignedBlocksWindow - sliding window for downtime slashing
-func (k Keeper) SignedBlocksWindow(ctx sdk.Context) (res int64) {
-	k.paramspace.Get(ctx, types.KeySignedBlocksWindow, &res)
-	return
+func (k Keeper) SignedBlocksWindow(ctx sdk.Context) int64 {
+	return k.GetParams(ctx).SignedBlocksWindow
 }
 
 // MinSignedPerWindow - minimum blocks signed per window
 func (k Keeper) MinSignedPerWindow(ctx sdk.Context) int64 {
-	var minSignedPerWindow sdk.Dec
-	k.paramspace.Get(ctx, types.KeyMinSignedPerWindow, &minSignedPerWindow)
-	signedBlocksWindow := k.SignedBlocksWindow(ctx)
+	params := k.GetParams(ctx)
+	minSignedPerWindow := params.MinSignedPerWindow
+	signedBlocksWindow := params.SignedBlocksWindow
 
 	// NOTE: RoundInt64 will never panic as minSignedPerWindow is
 	//       less than 1.
@@ -25,30 +24,74 @@ func (k Keeper) MinSignedPerWindow(ctx sdk.Context) int64 {
 }
 
 // DowntimeJailDuration - Downtime unbond duration
-func (k Keeper) DowntimeJailDuration(ctx sdk.Context) (res time.Duration) {
-	k.paramspace.Get(ctx, types.KeyDowntimeJailDuration, &res)
-	return
+func (k Keeper) DowntimeJailDuration(ctx sdk.Context) time.Duration {
+	return k.GetParams(ctx).DowntimeJailDuration
 }
 
 // SlashFractionDoubleSign - fraction of power slashed in case of double sign
-func (k Keeper) SlashFractionDoubleSign(ctx sdk.Context) (res sdk.Dec) {
-	k.paramspace.Get(ctx, types.KeySlashFractionDoubleSign, &res)
-	return
+func (k Keeper) SlashFractionDoubleSign(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).SlashFractionDoubleSign
 }
 
 // SlashFractionDowntime - fraction of power slashed for downtime
-func (k Keeper) SlashFractionDowntime(ctx sdk.Context) (res sdk.Dec) {
-	k.paramspace.Get(ctx, types.KeySlashFractionDowntime, &res)
-	return
+func (k Keeper) SlashFractionDowntime(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).SlashFractionDowntime
+}
+
+// DowntimeJailMultiplier - factor the downtime jail duration is multiplied by
+// for every previous downtime jailing
+func (k Keeper) DowntimeJailMultiplier(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).DowntimeJailMultiplier
+}
+
+// MaxDowntimeJailDuration - cap on the escalated downtime jail duration
+func (k Keeper) MaxDowntimeJailDuration(ctx sdk.Context) time.Duration {
+	return k.GetParams(ctx).MaxDowntimeJailDuration
+}
+
+// DowntimeJailResetPeriod - time out of jail after which the downtime jail
+// count is reset
+func (k Keeper) DowntimeJailResetPeriod(ctx sdk.Context) time.Duration {
+	return k.GetParams(ctx).DowntimeJailResetPeriod
+}
+
+// EscalatedDowntimeJailDuration returns the downtime jail duration of a
+// validator that has already been jailed jailCount times for downtime. The
+// DowntimeJailDuration is multiplied by the DowntimeJailMultiplier for every
+// previous jailing, capped at the MaxDowntimeJailDuration.
+func (k Keeper) EscalatedDowntimeJailDuration(ctx sdk.Context, jailCount int64) time.Duration {
+	params := k.GetParams(ctx)
+	if params.DowntimeJailMultiplier.Equal(sdk.OneDec()) {
+		return params.DowntimeJailDuration
+	}
+
+	duration := sdk.NewDec(int64(params.DowntimeJailDuration))
+	maxDuration := sdk.NewDec(int64(params.MaxDowntimeJailDuration))
+	for i := int64(0); i < jailCount && duration.LT(maxDuration); i++ {
+		duration = duration.Mul(params.DowntimeJailMultiplier)
+	}
+	if duration.GT(maxDuration) {
+		duration = maxDuration
+	}
+
+	return time.Duration(duration.TruncateInt64())
 }
 
 // GetParams returns the total set of slashing parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramspace.GetParamSet(ctx, &params)
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
 	return params
 }
 
-// SetParams sets the slashing parameters to the param space.
+// SetParams sets the slashing parameters in the module store.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
-	k.paramspace.SetParamSet(ctx, &params)
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&params)
+	store.Set(types.ParamsKey, bz)
 }
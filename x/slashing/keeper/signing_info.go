@@ -6,6 +6,7 @@ import (
 	gogotypes "github.com/gogo/protobuf/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
 
@@ -129,6 +130,29 @@ func (k Keeper) Tombstone(ctx sdk.Context, consAddr sdk.ConsAddress) {
 	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
 }
 
+// Untombstone pardons a tombstoned validator. The validator stays jailed but
+// can be unjailed from the current block time on, and its missed blocks are
+// reset so that it won't be immediately jailed for downtime upon rebonding.
+func (k Keeper) Untombstone(ctx sdk.Context, consAddr sdk.ConsAddress) error {
+	signInfo, ok := k.GetValidatorSigningInfo(ctx, consAddr)
+	if !ok {
+		return sdkerrors.Wrap(types.ErrNoSigningInfoFound, consAddr.String())
+	}
+
+	if !signInfo.Tombstoned {
+		return types.ErrValidatorNotTombstoned
+	}
+
+	signInfo.Tombstoned = false
+	signInfo.JailedUntil = ctx.BlockHeader().Time
+	signInfo.MissedBlocksCounter = 0
+	signInfo.IndexOffset = 0
+	k.clearValidatorMissedBlockBitArray(ctx, consAddr)
+	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
+
+	return nil
+}
+
 // IsTombstoned returns if a given validator by consensus address is tombstoned.
 func (k Keeper) IsTombstoned(ctx sdk.Context, consAddr sdk.ConsAddress) bool {
 	signInfo, ok := k.GetValidatorSigningInfo(ctx, consAddr)
@@ -94,3 +94,36 @@ func TestJailUntil(t *testing.T) {
 	require.True(t, ok)
 	require.Equal(t, time.Unix(253402300799, 0).UTC(), info.JailedUntil)
 }
+
+func TestUntombstone(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Unix(1000, 0).UTC()})
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	consAddr := sdk.ConsAddress(addrDels[0])
+
+	require.ErrorIs(t, app.SlashingKeeper.Untombstone(ctx, consAddr), types.ErrNoSigningInfoFound)
+
+	newInfo := types.NewValidatorSigningInfo(
+		consAddr,
+		int64(4),
+		int64(3),
+		time.Unix(2, 0),
+		false,
+		int64(10),
+	)
+	app.SlashingKeeper.SetValidatorSigningInfo(ctx, consAddr, newInfo)
+	app.SlashingKeeper.SetValidatorMissedBlockBitArray(ctx, consAddr, 2, true)
+	require.ErrorIs(t, app.SlashingKeeper.Untombstone(ctx, consAddr), types.ErrValidatorNotTombstoned)
+
+	app.SlashingKeeper.Tombstone(ctx, consAddr)
+	app.SlashingKeeper.JailUntil(ctx, consAddr, time.Unix(253402300799, 0).UTC())
+	require.NoError(t, app.SlashingKeeper.Untombstone(ctx, consAddr))
+	require.False(t, app.SlashingKeeper.IsTombstoned(ctx, consAddr))
+
+	info, ok := app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, ok)
+	require.Equal(t, ctx.BlockTime(), info.JailedUntil)
+	require.Equal(t, int64(0), info.MissedBlocksCounter)
+	require.Equal(t, int64(0), info.IndexOffset)
+	require.False(t, app.SlashingKeeper.GetValidatorMissedBlockBitArray(ctx, consAddr, 2))
+}
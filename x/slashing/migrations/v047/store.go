@@ -0,0 +1,31 @@
+package v047
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// MigrateStore performs in-place store migrations from v0.46 to v0.47. The
+// migration includes:
+//
+// - Move the params from the x/params subspace to the x/slashing module store.
+// - Set the downtime jail escalation params to their defaults.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, legacySubspace types.ParamSubspace, cdc codec.BinaryCodec) error {
+	var params types.Params
+	legacySubspace.GetParamSet(ctx, &params)
+
+	params.DowntimeJailMultiplier = types.DefaultDowntimeJailMultiplier
+	params.MaxDowntimeJailDuration = types.DefaultMaxDowntimeJailDuration
+	params.DowntimeJailResetPeriod = types.DefaultDowntimeJailResetPeriod
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(storeKey)
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+
+	return nil
+}
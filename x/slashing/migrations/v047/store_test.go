@@ -0,0 +1,38 @@
+package v047_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	v047slashing "github.com/cosmos/cosmos-sdk/x/slashing/migrations/v047"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	slashingKey := sdk.NewKVStoreKey(types.StoreKey)
+	tSlashingKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(slashingKey, tSlashingKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, slashingKey, tSlashingKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	// Set non default params in the legacy subspace.
+	params := types.DefaultParams()
+	params.SignedBlocksWindow = 1000
+	paramstore.SetParamSet(ctx, &params)
+
+	// Run migrations.
+	err := v047slashing.MigrateStore(ctx, slashingKey, paramstore, encCfg.Codec)
+	require.NoError(t, err)
+
+	// Make sure the params are now in the module store.
+	var res types.Params
+	bz := ctx.KVStore(slashingKey).Get(types.ParamsKey)
+	require.NoError(t, encCfg.Codec.Unmarshal(bz, &res))
+	require.Equal(t, params, res)
+}
@@ -11,7 +11,7 @@ const (
 )
 
 // verify interface at compile time
-var _ sdk.Msg = &MsgUnjail{}
+var _, _, _ sdk.Msg = &MsgUnjail{}, &MsgUpdateParams{}, &MsgUntombstone{}
 
 // NewMsgUnjail creates a new MsgUnjail instance
 //
@@ -42,3 +42,68 @@ func (msg MsgUnjail) ValidateBasic() error {
 	}
 	return nil
 }
+
+// NewMsgUpdateParams creates a new MsgUpdateParams instance
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+func (msg MsgUpdateParams) Route() string { return sdk.MsgTypeURL(&msg) }
+func (msg MsgUpdateParams) Type() string  { return sdk.MsgTypeURL(&msg) }
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic does a sanity check on the provided message
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrap(err, "authority")
+	}
+
+	return msg.Params.Validate()
+}
+
+// NewMsgUntombstone creates a new MsgUntombstone instance
+//
+//nolint:interfacer
+func NewMsgUntombstone(authority string, validatorAddr sdk.ValAddress) *MsgUntombstone {
+	return &MsgUntombstone{
+		Authority:     authority,
+		ValidatorAddr: validatorAddr.String(),
+	}
+}
+
+func (msg MsgUntombstone) Route() string { return sdk.MsgTypeURL(&msg) }
+func (msg MsgUntombstone) Type() string  { return sdk.MsgTypeURL(&msg) }
+func (msg MsgUntombstone) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgUntombstone) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic does a sanity check on the provided message
+func (msg MsgUntombstone) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrap(err, "authority")
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddr); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("validator input address: %s", err)
+	}
+
+	return nil
+}
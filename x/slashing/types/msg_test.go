@@ -18,3 +18,12 @@ func TestMsgUnjailGetSignBytes(t *testing.T) {
 		string(bytes),
 	)
 }
+
+func TestMsgUntombstoneValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress("authority").String()
+	valAddr := sdk.ValAddress("abcd")
+
+	require.NoError(t, NewMsgUntombstone(authority, valAddr).ValidateBasic())
+	require.Error(t, NewMsgUntombstone("invalid", valAddr).ValidateBasic())
+	require.Error(t, (&MsgUntombstone{Authority: authority}).ValidateBasic())
+}
@@ -10,14 +10,17 @@ import (
 
 // Default parameter namespace
 const (
-	DefaultSignedBlocksWindow   = int64(100)
-	DefaultDowntimeJailDuration = 60 * 10 * time.Second
+	DefaultSignedBlocksWindow      = int64(100)
+	DefaultDowntimeJailDuration    = 60 * 10 * time.Second
+	DefaultMaxDowntimeJailDuration = 7 * 24 * time.Hour
+	DefaultDowntimeJailResetPeriod = 30 * 24 * time.Hour
 )
 
 var (
 	DefaultMinSignedPerWindow      = sdk.NewDecWithPrec(5, 1)
 	DefaultSlashFractionDoubleSign = sdk.NewDec(1).Quo(sdk.NewDec(20))
 	DefaultSlashFractionDowntime   = sdk.NewDec(1).Quo(sdk.NewDec(100))
+	DefaultDowntimeJailMultiplier  = sdk.OneDec()
 )
 
 // Parameter store keys
@@ -34,10 +37,25 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-// NewParams creates a new Params object
+// NewParams creates a new Params object with the default downtime jail
+// escalation params, which don't escalate the downtime jail duration.
 func NewParams(
 	signedBlocksWindow int64, minSignedPerWindow sdk.Dec, downtimeJailDuration time.Duration,
 	slashFractionDoubleSign, slashFractionDowntime sdk.Dec,
+) Params {
+	return NewParamsWithJailEscalation(
+		signedBlocksWindow, minSignedPerWindow, downtimeJailDuration,
+		slashFractionDoubleSign, slashFractionDowntime, DefaultDowntimeJailMultiplier,
+		DefaultMaxDowntimeJailDuration, DefaultDowntimeJailResetPeriod,
+	)
+}
+
+// NewParamsWithJailEscalation creates a new Params object escalating the
+// downtime jail duration of repeat offenders.
+func NewParamsWithJailEscalation(
+	signedBlocksWindow int64, minSignedPerWindow sdk.Dec, downtimeJailDuration time.Duration,
+	slashFractionDoubleSign, slashFractionDowntime, downtimeJailMultiplier sdk.Dec,
+	maxDowntimeJailDuration, downtimeJailResetPeriod time.Duration,
 ) Params {
 	return Params{
 		SignedBlocksWindow:      signedBlocksWindow,
@@ -45,10 +63,16 @@ func NewParams(
 		DowntimeJailDuration:    downtimeJailDuration,
 		SlashFractionDoubleSign: slashFractionDoubleSign,
 		SlashFractionDowntime:   slashFractionDowntime,
+		DowntimeJailMultiplier:  downtimeJailMultiplier,
+		MaxDowntimeJailDuration: maxDowntimeJailDuration,
+		DowntimeJailResetPeriod: downtimeJailResetPeriod,
 	}
 }
 
 // ParamSetPairs - Implements params.ParamSet
+//
+// NOTE: The downtime jail escalation params were introduced after the params
+// moved out of x/params, so they have no legacy param set pair.
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeySignedBlocksWindow, &p.SignedBlocksWindow, validateSignedBlocksWindow),
@@ -67,6 +91,40 @@ func DefaultParams() Params {
 	)
 }
 
+// Validate validates the slashing parameters.
+func (p Params) Validate() error {
+	if err := validateSignedBlocksWindow(p.SignedBlocksWindow); err != nil {
+		return err
+	}
+	if err := validateMinSignedPerWindow(p.MinSignedPerWindow); err != nil {
+		return err
+	}
+	if err := validateDowntimeJailDuration(p.DowntimeJailDuration); err != nil {
+		return err
+	}
+	if err := validateSlashFractionDoubleSign(p.SlashFractionDoubleSign); err != nil {
+		return err
+	}
+
+	if err := validateSlashFractionDowntime(p.SlashFractionDowntime); err != nil {
+		return err
+	}
+	if err := validateDowntimeJailMultiplier(p.DowntimeJailMultiplier); err != nil {
+		return err
+	}
+	if err := validateMaxDowntimeJailDuration(p.MaxDowntimeJailDuration); err != nil {
+		return err
+	}
+	if p.MaxDowntimeJailDuration < p.DowntimeJailDuration {
+		return fmt.Errorf(
+			"max downtime jail duration %s cannot be less than the downtime jail duration %s",
+			p.MaxDowntimeJailDuration, p.DowntimeJailDuration,
+		)
+	}
+
+	return validateDowntimeJailResetPeriod(p.DowntimeJailResetPeriod)
+}
+
 func validateSignedBlocksWindow(i interface{}) error {
 	v, ok := i.(int64)
 	if !ok {
@@ -140,3 +198,45 @@ func validateSlashFractionDowntime(i interface{}) error {
 
 	return nil
 }
+
+func validateDowntimeJailMultiplier(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("downtime jail multiplier cannot be nil")
+	}
+	if v.LT(sdk.OneDec()) {
+		return fmt.Errorf("downtime jail multiplier cannot be less than one: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxDowntimeJailDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("max downtime jail duration must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateDowntimeJailResetPeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("downtime jail reset period cannot be negative: %s", v)
+	}
+
+	return nil
+}
@@ -48,6 +48,11 @@ type ValidatorSigningInfo struct {
 	// A counter kept to avoid unnecessary array reads.
 	// Note that `Sum(MissedBlocksBitArray)` always equals `MissedBlocksCounter`.
 	MissedBlocksCounter int64 `protobuf:"varint,6,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+	// Number of times the validator has been jailed for downtime since the
+	// counter was last reset. It determines the escalated downtime jail duration.
+	//
+	// Since: cosmos-sdk 0.47
+	DowntimeJailCount int64 `protobuf:"varint,7,opt,name=downtime_jail_count,json=downtimeJailCount,proto3" json:"downtime_jail_count,omitempty"`
 }
 
 func (m *ValidatorSigningInfo) Reset()      { *m = ValidatorSigningInfo{} }
@@ -124,6 +129,13 @@ func (m *ValidatorSigningInfo) GetMissedBlocksCounter() int64 {
 	return 0
 }
 
+func (m *ValidatorSigningInfo) GetDowntimeJailCount() int64 {
+	if m != nil {
+		return m.DowntimeJailCount
+	}
+	return 0
+}
+
 // Params represents the parameters used for by the slashing module.
 type Params struct {
 	SignedBlocksWindow      int64                                  `protobuf:"varint,1,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
@@ -131,6 +143,21 @@ type Params struct {
 	DowntimeJailDuration    time.Duration                          `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration"`
 	SlashFractionDoubleSign github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_double_sign"`
 	SlashFractionDowntime   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_downtime"`
+	// downtime_jail_multiplier is the factor the downtime jail duration is
+	// multiplied by for every previous downtime jailing of a validator. A value
+	// of 1 disables the escalation.
+	//
+	// Since: cosmos-sdk 0.47
+	DowntimeJailMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=downtime_jail_multiplier,json=downtimeJailMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"downtime_jail_multiplier"`
+	// max_downtime_jail_duration caps the escalated downtime jail duration.
+	//
+	// Since: cosmos-sdk 0.47
+	MaxDowntimeJailDuration time.Duration `protobuf:"bytes,7,opt,name=max_downtime_jail_duration,json=maxDowntimeJailDuration,proto3,stdduration" json:"max_downtime_jail_duration"`
+	// downtime_jail_reset_period is the time a validator has to stay out of jail
+	// after its last downtime jailing for its downtime jail count to be reset.
+	//
+	// Since: cosmos-sdk 0.47
+	DowntimeJailResetPeriod time.Duration `protobuf:"bytes,8,opt,name=downtime_jail_reset_period,json=downtimeJailResetPeriod,proto3,stdduration" json:"downtime_jail_reset_period"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -180,6 +207,20 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 	return 0
 }
 
+func (m *Params) GetMaxDowntimeJailDuration() time.Duration {
+	if m != nil {
+		return m.MaxDowntimeJailDuration
+	}
+	return 0
+}
+
+func (m *Params) GetDowntimeJailResetPeriod() time.Duration {
+	if m != nil {
+		return m.DowntimeJailResetPeriod
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.slashing.v1beta1.ValidatorSigningInfo")
 	proto.RegisterType((*Params)(nil), "cosmos.slashing.v1beta1.Params")
@@ -190,43 +231,48 @@ func init() {
 }
 
 var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 576 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
-	0x1c, 0x8d, 0x1b, 0x08, 0xe1, 0x92, 0xe9, 0x48, 0x89, 0x9b, 0xc1, 0x0e, 0x1d, 0xaa, 0x2c, 0xb1,
-	0x69, 0xd8, 0xd8, 0x08, 0x11, 0x7f, 0x07, 0x2a, 0x87, 0x3f, 0x82, 0xc5, 0x3a, 0xfb, 0xce, 0xce,
-	0x11, 0xfb, 0x2e, 0xf2, 0x9d, 0x49, 0xf9, 0x16, 0x1d, 0x3b, 0x76, 0xe4, 0x03, 0x20, 0xf1, 0x15,
-	0xba, 0x20, 0x55, 0x4c, 0x88, 0xa1, 0xa0, 0x64, 0xe1, 0x63, 0x20, 0xdf, 0xd9, 0x2d, 0x6a, 0xa5,
-	0x4a, 0x9d, 0x12, 0xbf, 0xf7, 0x7e, 0xef, 0xf9, 0xf7, 0xee, 0x0c, 0x76, 0x42, 0x2e, 0x52, 0x2e,
-	0x5c, 0x91, 0x20, 0x31, 0xa3, 0x2c, 0x76, 0x3f, 0xed, 0x06, 0x44, 0xa2, 0xdd, 0x33, 0xc0, 0x59,
-	0x64, 0x5c, 0x72, 0xd8, 0xd5, 0x3a, 0xe7, 0x0c, 0x2e, 0x75, 0xbd, 0x4e, 0xcc, 0x63, 0xae, 0x34,
-	0x6e, 0xf1, 0x4f, 0xcb, 0x7b, 0x56, 0xcc, 0x79, 0x9c, 0x10, 0x57, 0x3d, 0x05, 0x79, 0xe4, 0xe2,
-	0x3c, 0x43, 0x92, 0x72, 0x56, 0xf2, 0xf6, 0x45, 0x5e, 0xd2, 0x94, 0x08, 0x89, 0xd2, 0x45, 0x29,
-	0xd8, 0xd2, 0x79, 0xbe, 0x76, 0x2e, 0xc3, 0xd5, 0xc3, 0xf6, 0xb7, 0x0d, 0xd0, 0x79, 0x8b, 0x12,
-	0x8a, 0x91, 0xe4, 0xd9, 0x94, 0xc6, 0x8c, 0xb2, 0xf8, 0x39, 0x8b, 0x38, 0x1c, 0x81, 0x5b, 0x08,
-	0xe3, 0x8c, 0x08, 0x61, 0x1a, 0x7d, 0x63, 0x70, 0x7b, 0x6c, 0xfe, 0xf8, 0x3a, 0xec, 0x94, 0xb3,
-	0x8f, 0x34, 0x33, 0x95, 0x19, 0x65, 0xb1, 0x57, 0x09, 0xe1, 0x3d, 0xd0, 0x16, 0x12, 0x65, 0xd2,
-	0x9f, 0x11, 0x1a, 0xcf, 0xa4, 0xb9, 0xd1, 0x37, 0x06, 0x75, 0xaf, 0xa5, 0xb0, 0x67, 0x0a, 0x2a,
-	0x24, 0x94, 0x61, 0xb2, 0xef, 0xf3, 0x28, 0x12, 0x44, 0x9a, 0x75, 0x2d, 0x51, 0xd8, 0x2b, 0x05,
-	0xc1, 0xa7, 0xa0, 0xfd, 0x11, 0xd1, 0x84, 0x60, 0x3f, 0x67, 0x92, 0x26, 0xe6, 0x8d, 0xbe, 0x31,
-	0x68, 0x8d, 0x7a, 0x8e, 0xde, 0xd2, 0xa9, 0xb6, 0x74, 0x5e, 0x57, 0x5b, 0x8e, 0x9b, 0xc7, 0xa7,
-	0x76, 0xed, 0xe0, 0xb7, 0x6d, 0x78, 0x2d, 0x3d, 0xf9, 0xa6, 0x18, 0x84, 0x16, 0x00, 0x92, 0xa7,
-	0x81, 0x90, 0x9c, 0x11, 0x6c, 0xde, 0xec, 0x1b, 0x83, 0xa6, 0xf7, 0x1f, 0x02, 0x47, 0x60, 0x33,
-	0xa5, 0x42, 0x10, 0xec, 0x07, 0x09, 0x0f, 0xe7, 0xc2, 0x0f, 0x79, 0xce, 0x24, 0xc9, 0xcc, 0x86,
-	0x7a, 0xa9, 0x3b, 0x9a, 0x1c, 0x2b, 0xee, 0xb1, 0xa6, 0x1e, 0x36, 0x0f, 0x8f, 0xec, 0xda, 0xdf,
-	0x23, 0xdb, 0xd8, 0xfe, 0x5e, 0x07, 0x8d, 0x3d, 0x94, 0xa1, 0x54, 0xc0, 0xfb, 0xa0, 0x23, 0x68,
-	0xcc, 0xce, 0x8d, 0x96, 0x94, 0x61, 0xbe, 0x54, 0xc5, 0xd5, 0x3d, 0xa8, 0x39, 0xed, 0xf3, 0x4e,
-	0x31, 0x10, 0x15, 0xd1, 0xcc, 0x2f, 0xa7, 0x16, 0x24, 0xab, 0x46, 0x8a, 0xca, 0xda, 0x63, 0xa7,
-	0x58, 0xe8, 0xd7, 0xa9, 0xbd, 0x13, 0x53, 0x39, 0xcb, 0x03, 0x27, 0xe4, 0x69, 0x79, 0x6c, 0xe5,
-	0xcf, 0x50, 0xe0, 0xb9, 0x2b, 0x3f, 0x2f, 0x88, 0x70, 0x26, 0x24, 0xf4, 0x60, 0x4a, 0xd9, 0x54,
-	0x79, 0xed, 0x91, 0xac, 0x8c, 0x78, 0x0f, 0xee, 0x62, 0xbe, 0x64, 0xc5, 0x5d, 0xf0, 0x8b, 0x56,
-	0xfc, 0xea, 0xd6, 0xa8, 0xce, 0x5b, 0xa3, 0xad, 0x4b, 0x85, 0x4e, 0x4a, 0x81, 0xee, 0xf3, 0xb0,
-	0xe8, 0xb3, 0x53, 0x59, 0xbc, 0x40, 0x34, 0xa9, 0x78, 0x38, 0x07, 0x3d, 0x75, 0x75, 0xfd, 0x28,
-	0x43, 0x61, 0x81, 0xf8, 0x98, 0xe7, 0x41, 0x42, 0xd4, 0x3e, 0xea, 0xbc, 0xae, 0xbf, 0x42, 0x57,
-	0x39, 0x3e, 0x29, 0x0d, 0x27, 0xca, 0xaf, 0x58, 0x09, 0x46, 0xa0, 0x7b, 0x29, 0x4c, 0xbf, 0x93,
-	0x3a, 0xd2, 0xeb, 0x27, 0x6d, 0x5e, 0x48, 0xd2, 0x66, 0xe3, 0x97, 0x5f, 0x56, 0x96, 0x71, 0xbc,
-	0xb2, 0x8c, 0x93, 0x95, 0x65, 0xfc, 0x59, 0x59, 0xc6, 0xc1, 0xda, 0xaa, 0x9d, 0xac, 0xad, 0xda,
-	0xcf, 0xb5, 0x55, 0xfb, 0x30, 0xbc, 0xd2, 0x7c, 0xff, 0xfc, 0x93, 0x57, 0x39, 0x41, 0x43, 0x95,
-	0xfa, 0xe0, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc7, 0xbe, 0xf2, 0xba, 0x12, 0x04, 0x00, 0x00,
+	// 655 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x3d, 0x73, 0xd3, 0x30,
+	0x18, 0x8e, 0xfb, 0x19, 0x94, 0x2e, 0xa8, 0x69, 0xe3, 0x66, 0x70, 0x42, 0x87, 0x5e, 0x96, 0x3a,
+	0x34, 0x6c, 0x6c, 0x84, 0x1c, 0x9f, 0xc7, 0xd1, 0x73, 0xf9, 0x38, 0x58, 0x5c, 0x39, 0x92, 0x1d,
+	0x51, 0x5b, 0xca, 0x59, 0x32, 0x0d, 0xff, 0xa2, 0x63, 0xc7, 0x8e, 0xfc, 0x00, 0x7e, 0x44, 0xc7,
+	0x1e, 0x13, 0xc7, 0x50, 0xb8, 0x74, 0x80, 0xff, 0xc0, 0xc2, 0x49, 0xb2, 0xdb, 0xb4, 0x05, 0x8e,
+	0x76, 0x4a, 0xf4, 0x3e, 0xcf, 0xfb, 0x3c, 0x7a, 0x9f, 0x57, 0x67, 0xb0, 0xd6, 0xe7, 0x22, 0xe1,
+	0xa2, 0x2d, 0x62, 0x24, 0x06, 0x94, 0x45, 0xed, 0xf7, 0x1b, 0x01, 0x91, 0x68, 0xe3, 0xb4, 0xe0,
+	0x0e, 0x53, 0x2e, 0x39, 0xac, 0x19, 0x9e, 0x7b, 0x5a, 0xce, 0x79, 0xf5, 0x6a, 0xc4, 0x23, 0xae,
+	0x39, 0x6d, 0xf5, 0xcf, 0xd0, 0xeb, 0x4e, 0xc4, 0x79, 0x14, 0x93, 0xb6, 0x3e, 0x05, 0x59, 0xd8,
+	0xc6, 0x59, 0x8a, 0x24, 0xe5, 0x2c, 0xc7, 0x1b, 0x17, 0x71, 0x49, 0x13, 0x22, 0x24, 0x4a, 0x86,
+	0x39, 0x61, 0xc5, 0xf8, 0xf9, 0x46, 0x39, 0x37, 0xd7, 0x87, 0xd5, 0x1f, 0x53, 0xa0, 0xfa, 0x0a,
+	0xc5, 0x14, 0x23, 0xc9, 0xd3, 0x2d, 0x1a, 0x31, 0xca, 0xa2, 0xc7, 0x2c, 0xe4, 0xb0, 0x03, 0xe6,
+	0x11, 0xc6, 0x29, 0x11, 0xc2, 0xb6, 0x9a, 0x56, 0xeb, 0x46, 0xd7, 0xfe, 0xfc, 0x69, 0xbd, 0x9a,
+	0xf7, 0xde, 0x33, 0xc8, 0x96, 0x4c, 0x29, 0x8b, 0xbc, 0x82, 0x08, 0x6f, 0x81, 0x05, 0x21, 0x51,
+	0x2a, 0xfd, 0x01, 0xa1, 0xd1, 0x40, 0xda, 0x53, 0x4d, 0xab, 0x35, 0xed, 0x55, 0x74, 0xed, 0x91,
+	0x2e, 0x29, 0x0a, 0x65, 0x98, 0x8c, 0x7c, 0x1e, 0x86, 0x82, 0x48, 0x7b, 0xda, 0x50, 0x74, 0xed,
+	0xb9, 0x2e, 0xc1, 0x87, 0x60, 0xe1, 0x1d, 0xa2, 0x31, 0xc1, 0x7e, 0xc6, 0x24, 0x8d, 0xed, 0x99,
+	0xa6, 0xd5, 0xaa, 0x74, 0xea, 0xae, 0x99, 0xd2, 0x2d, 0xa6, 0x74, 0x5f, 0x14, 0x53, 0x76, 0xcb,
+	0x87, 0xc7, 0x8d, 0xd2, 0xde, 0xb7, 0x86, 0xe5, 0x55, 0x4c, 0xe7, 0x4b, 0xd5, 0x08, 0x1d, 0x00,
+	0x24, 0x4f, 0x02, 0x21, 0x39, 0x23, 0xd8, 0x9e, 0x6d, 0x5a, 0xad, 0xb2, 0x37, 0x51, 0x81, 0x1d,
+	0xb0, 0x94, 0x50, 0x21, 0x08, 0xf6, 0x83, 0x98, 0xf7, 0x77, 0x84, 0xdf, 0xe7, 0x19, 0x93, 0x24,
+	0xb5, 0xe7, 0xf4, 0xa5, 0x16, 0x0d, 0xd8, 0xd5, 0xd8, 0x7d, 0x03, 0x41, 0x17, 0x2c, 0x62, 0xbe,
+	0xcb, 0x54, 0xc2, 0xbe, 0xf2, 0x32, 0x3d, 0xf6, 0xbc, 0xee, 0xb8, 0x59, 0x40, 0x4f, 0x10, 0x8d,
+	0x75, 0xc7, 0xdd, 0xf2, 0xfe, 0x41, 0xa3, 0xf4, 0xf3, 0xa0, 0x61, 0xad, 0xfe, 0x9a, 0x05, 0x73,
+	0x9b, 0x28, 0x45, 0x89, 0x80, 0xb7, 0x41, 0x55, 0xd0, 0x88, 0x9d, 0x19, 0xef, 0x52, 0x86, 0xf9,
+	0xae, 0x0e, 0x7a, 0xda, 0x83, 0x06, 0x33, 0xbe, 0xaf, 0x35, 0x02, 0x91, 0xba, 0x2a, 0xf3, 0xf3,
+	0xae, 0x21, 0x49, 0x8b, 0x16, 0x15, 0xf1, 0x42, 0xd7, 0x55, 0x01, 0x7c, 0x3d, 0x6e, 0xac, 0x45,
+	0x54, 0x0e, 0xb2, 0xc0, 0xed, 0xf3, 0x24, 0x5f, 0x73, 0xfe, 0xb3, 0x2e, 0xf0, 0x4e, 0x5b, 0x7e,
+	0x18, 0x12, 0xe1, 0xf6, 0x48, 0xdf, 0x83, 0x09, 0x65, 0x5b, 0x5a, 0x6b, 0x93, 0xa4, 0xb9, 0xc5,
+	0x1b, 0xb0, 0x7c, 0x7e, 0xb2, 0xe2, 0x95, 0xe9, 0x1d, 0x55, 0x3a, 0x2b, 0x97, 0x16, 0xd0, 0xcb,
+	0x09, 0x26, 0xff, 0x7d, 0x95, 0x7f, 0x75, 0x32, 0x81, 0x02, 0x87, 0x3b, 0xa0, 0xae, 0x9f, 0xba,
+	0x1f, 0xa6, 0xa8, 0xaf, 0x2a, 0x3e, 0xe6, 0x59, 0x10, 0x13, 0x3d, 0x8f, 0x3d, 0x73, 0xad, 0x11,
+	0x6a, 0x5a, 0xf1, 0x41, 0x2e, 0xd8, 0xd3, 0x7a, 0x6a, 0x24, 0x18, 0x82, 0xda, 0x25, 0x33, 0x73,
+	0x27, 0x7b, 0xf6, 0x5a, 0x4e, 0x4b, 0x17, 0x9c, 0x8c, 0x18, 0x1c, 0x00, 0xfb, 0x7c, 0x5e, 0x49,
+	0x16, 0x4b, 0x3a, 0x8c, 0x69, 0xfe, 0x80, 0xae, 0x6e, 0xb4, 0x3c, 0x19, 0xde, 0xb3, 0x53, 0x35,
+	0xb8, 0x0d, 0xea, 0x09, 0x1a, 0xf9, 0x7f, 0xd9, 0xce, 0xfc, 0xff, 0x6f, 0xa7, 0x96, 0xa0, 0x51,
+	0xef, 0x4f, 0x0b, 0xda, 0x06, 0xf5, 0xf3, 0xea, 0x29, 0x11, 0x44, 0xaa, 0x77, 0x46, 0x39, 0xb6,
+	0xcb, 0x57, 0x70, 0x98, 0x1c, 0xc1, 0x53, 0x22, 0x9b, 0x5a, 0xa3, 0xfb, 0xf4, 0xe3, 0xd8, 0xb1,
+	0x0e, 0xc7, 0x8e, 0x75, 0x34, 0x76, 0xac, 0xef, 0x63, 0xc7, 0xda, 0x3b, 0x71, 0x4a, 0x47, 0x27,
+	0x4e, 0xe9, 0xcb, 0x89, 0x53, 0x7a, 0xbb, 0xfe, 0xcf, 0x84, 0x46, 0x67, 0x1f, 0x54, 0x1d, 0x56,
+	0x30, 0xa7, 0xaf, 0x70, 0xe7, 0xf7, 0x00, 0x9e, 0xaa, 0x90, 0x41, 0x70, 0x05, 0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -266,6 +312,9 @@ func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
 	if this.MissedBlocksCounter != that1.MissedBlocksCounter {
 		return false
 	}
+	if this.DowntimeJailCount != that1.DowntimeJailCount {
+		return false
+	}
 	return true
 }
 func (this *Params) Equal(that interface{}) bool {
@@ -302,6 +351,15 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
 		return false
 	}
+	if !this.DowntimeJailMultiplier.Equal(that1.DowntimeJailMultiplier) {
+		return false
+	}
+	if this.MaxDowntimeJailDuration != that1.MaxDowntimeJailDuration {
+		return false
+	}
+	if this.DowntimeJailResetPeriod != that1.DowntimeJailResetPeriod {
+		return false
+	}
 	return true
 }
 func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
@@ -324,6 +382,11 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DowntimeJailCount != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.DowntimeJailCount))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.MissedBlocksCounter != 0 {
 		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocksCounter))
 		i--
@@ -387,6 +450,32 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeJailResetPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailResetPeriod):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x42
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxDowntimeJailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxDowntimeJailDuration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintSlashing(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.DowntimeJailMultiplier.Size()
+		i -= size
+		if _, err := m.DowntimeJailMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	{
 		size := m.SlashFractionDowntime.Size()
 		i -= size
@@ -407,12 +496,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x22
-	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDuration):])
-	if err2 != nil {
-		return 0, err2
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDuration):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n2
-	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i -= n4
+	i = encodeVarintSlashing(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x1a
 	{
@@ -468,6 +557,9 @@ func (m *ValidatorSigningInfo) Size() (n int) {
 	if m.MissedBlocksCounter != 0 {
 		n += 1 + sovSlashing(uint64(m.MissedBlocksCounter))
 	}
+	if m.DowntimeJailCount != 0 {
+		n += 1 + sovSlashing(uint64(m.DowntimeJailCount))
+	}
 	return n
 }
 
@@ -488,6 +580,12 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovSlashing(uint64(l))
 	l = m.SlashFractionDowntime.Size()
 	n += 1 + l + sovSlashing(uint64(l))
+	l = m.DowntimeJailMultiplier.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxDowntimeJailDuration)
+	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailResetPeriod)
+	n += 1 + l + sovSlashing(uint64(l))
 	return n
 }
 
@@ -668,6 +766,25 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailCount", wireType)
+			}
+			m.DowntimeJailCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DowntimeJailCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
@@ -869,6 +986,105 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailMultiplier", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DowntimeJailMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDowntimeJailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxDowntimeJailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailResetPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DowntimeJailResetPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
@@ -45,6 +45,10 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisStateTokenizeShareRecords(data); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
@@ -85,3 +89,29 @@ func validateGenesisStateValidators(validators []types.Validator) error {
 
 	return nil
 }
+
+func validateGenesisStateTokenizeShareRecords(data *types.GenesisState) error {
+	ids := make(map[uint64]bool, len(data.TokenizeShareRecords))
+
+	for _, record := range data.TokenizeShareRecords {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+
+		if ids[record.Id] {
+			return fmt.Errorf("duplicate tokenize share record id: %d", record.Id)
+		}
+
+		if record.Id > data.LastTokenizeShareRecordId {
+			return fmt.Errorf("tokenize share record id %d is greater than the last tokenize share record id %d", record.Id, data.LastTokenizeShareRecordId)
+		}
+
+		ids[record.Id] = true
+	}
+
+	if !data.TotalLiquidStakedTokens.IsNil() && data.TotalLiquidStakedTokens.IsNegative() {
+		return fmt.Errorf("total liquid staked tokens cannot be negative: %s", data.TotalLiquidStakedTokens)
+	}
+
+	return nil
+}
@@ -682,7 +682,23 @@ func (k Keeper) Delegate(
 		}
 	}
 
-	_, newShares = k.AddValidatorTokensAndShares(ctx, validator, bondAmt)
+	validator, newShares = k.AddValidatorTokensAndShares(ctx, validator, bondAmt)
+
+	if delegation.ValidatorBond {
+		validator.ValidatorBondShares = validator.ValidatorBondShares.Add(newShares)
+		k.SetValidator(ctx, validator)
+	}
+
+	// delegations of liquid staking providers are bounded by the liquid
+	// staking caps
+	if k.DelegatorIsLiquidStaker(delegatorAddress) {
+		if err := k.SafelyIncreaseTotalLiquidStakedTokens(ctx, bondAmt); err != nil {
+			return sdk.ZeroDec(), err
+		}
+		if _, err := k.SafelyIncreaseValidatorLiquidShares(ctx, validator.GetOperator(), newShares); err != nil {
+			return sdk.ZeroDec(), err
+		}
+	}
 
 	// Update delegation
 	delegation.Shares = delegation.Shares.Add(newShares)
@@ -752,6 +768,15 @@ func (k Keeper) Unbond(
 		return amount, err
 	}
 
+	if delegation.ValidatorBond {
+		validator.ValidatorBondShares = sdk.MaxDec(validator.ValidatorBondShares.Sub(shares), sdk.ZeroDec())
+	}
+
+	if k.DelegatorIsLiquidStaker(delegatorAddress) {
+		validator.LiquidShares = sdk.MaxDec(validator.LiquidShares.Sub(shares), sdk.ZeroDec())
+		k.DecreaseTotalLiquidStakedTokens(ctx, validator.TokensFromShares(shares).TruncateInt())
+	}
+
 	// remove the shares and coins from the validator
 	// NOTE that the amount is later (in keeper.Delegation) moved between staking module pools
 	validator, amount = k.RemoveValidatorTokensAndShares(ctx, validator, shares)
@@ -1011,3 +1036,241 @@ func (k Keeper) ValidateUnbondAmount(
 
 	return shares, nil
 }
+
+// TransferUnbonding moves at most wantAmt tokens of the unbonding delegation
+// of fromAddr from valAddr to an unbonding delegation of toAddr. The moved
+// entries keep their creation height and completion time, so that they remain
+// slashable. It returns the amount of tokens moved.
+func (k Keeper) TransferUnbonding(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, wantAmt math.Int,
+) math.Int {
+	transferred := sdk.ZeroInt()
+
+	ubdFrom, found := k.GetUnbondingDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return transferred
+	}
+
+	ubdTo, found := k.GetUnbondingDelegation(ctx, toAddr, valAddr)
+	if !found {
+		ubdTo = types.UnbondingDelegation{
+			DelegatorAddress: toAddr.String(),
+			ValidatorAddress: valAddr.String(),
+		}
+	}
+
+	maxEntries := int(k.MaxEntries(ctx))
+	entries := make([]types.UnbondingDelegationEntry, 0, len(ubdFrom.Entries))
+
+	for _, entry := range ubdFrom.Entries {
+		amt := sdk.MinInt(entry.Balance, wantAmt.Sub(transferred))
+		if !amt.IsPositive() || len(ubdTo.Entries) >= maxEntries {
+			entries = append(entries, entry)
+			continue
+		}
+
+		// the initial balance is split proportionally so that slashes of the
+		// moved entry and of the remaining one add up as before
+		initialBalance := entry.InitialBalance.Mul(amt).Quo(entry.Balance)
+		ubdTo.Entries = append(ubdTo.Entries, types.UnbondingDelegationEntry{
+			CreationHeight: entry.CreationHeight,
+			CompletionTime: entry.CompletionTime,
+			InitialBalance: initialBalance,
+			Balance:        amt,
+		})
+		k.InsertUBDQueue(ctx, ubdTo, entry.CompletionTime)
+		transferred = transferred.Add(amt)
+
+		entry.Balance = entry.Balance.Sub(amt)
+		entry.InitialBalance = entry.InitialBalance.Sub(initialBalance)
+		if entry.Balance.IsPositive() {
+			entries = append(entries, entry)
+		}
+	}
+
+	if transferred.IsZero() {
+		return transferred
+	}
+
+	ubdFrom.Entries = entries
+	if len(ubdFrom.Entries) == 0 {
+		k.RemoveUnbondingDelegation(ctx, ubdFrom)
+	} else {
+		k.SetUnbondingDelegation(ctx, ubdFrom)
+	}
+	k.SetUnbondingDelegation(ctx, ubdTo)
+
+	return transferred
+}
+
+// TransferDelegation moves at most wantShares delegation shares of fromAddr
+// to valAddr to a delegation of toAddr, and returns the shares moved. Shares
+// backing redelegations of fromAddr to valAddr are moved along with the
+// corresponding redelegation entries, so that they remain slashable. Fewer
+// shares are moved when those entries can't be moved.
+func (k Keeper) TransferDelegation(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, wantShares sdk.Dec,
+) (sdk.Dec, error) {
+	delFrom, found := k.GetDelegation(ctx, fromAddr, valAddr)
+	if !found || !wantShares.IsPositive() {
+		return sdk.ZeroDec(), nil
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.ZeroDec(), types.ErrNoValidatorFound
+	}
+
+	transferred := sdk.MinDec(wantShares, delFrom.Shares)
+
+	var reds []types.Redelegation
+	redelegated := sdk.ZeroDec()
+	k.IterateDelegatorRedelegations(ctx, fromAddr, func(red types.Redelegation) bool {
+		if red.ValidatorDstAddress == valAddr.String() {
+			reds = append(reds, red)
+			for _, entry := range red.Entries {
+				redelegated = redelegated.Add(entry.SharesDst)
+			}
+		}
+		return false
+	})
+
+	// move the redelegation entries which can't be backed by the shares left
+	// to fromAddr anymore
+	if want := redelegated.Sub(delFrom.Shares.Sub(transferred)); want.IsPositive() {
+		moved := k.transferRedelegations(ctx, reds, toAddr, want)
+		transferred = transferred.Sub(want.Sub(moved))
+		if !transferred.IsPositive() {
+			return sdk.ZeroDec(), nil
+		}
+	}
+
+	delTo, found := k.GetDelegation(ctx, toAddr, valAddr)
+	if found {
+		if err := k.BeforeDelegationSharesModified(ctx, toAddr, valAddr); err != nil {
+			return sdk.ZeroDec(), err
+		}
+	} else {
+		delTo = types.NewDelegation(toAddr, valAddr, sdk.ZeroDec())
+		if err := k.BeforeDelegationCreated(ctx, toAddr, valAddr); err != nil {
+			return sdk.ZeroDec(), err
+		}
+	}
+
+	delTo.Shares = delTo.Shares.Add(transferred)
+	k.SetDelegation(ctx, delTo)
+	if err := k.AfterDelegationModified(ctx, toAddr, valAddr); err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	if err := k.BeforeDelegationSharesModified(ctx, fromAddr, valAddr); err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	delFrom.Shares = delFrom.Shares.Sub(transferred)
+
+	// If fromAddr is the operator of the validator and the transfer decreases
+	// the validator's self-delegation below their minimum, we jail the validator.
+	if fromAddr.Equals(validator.GetOperator()) && !validator.Jailed &&
+		validator.TokensFromShares(delFrom.Shares).TruncateInt().LT(validator.MinSelfDelegation) {
+		k.jailValidator(ctx, validator)
+	}
+
+	if delFrom.Shares.IsZero() {
+		if err := k.RemoveDelegation(ctx, delFrom); err != nil {
+			return sdk.ZeroDec(), err
+		}
+	} else {
+		k.SetDelegation(ctx, delFrom)
+		if err := k.AfterDelegationModified(ctx, fromAddr, valAddr); err != nil {
+			return sdk.ZeroDec(), err
+		}
+	}
+
+	// the transferred shares leave or join the validator bond when the validator
+	// bond flags of the delegations differ
+	switch {
+	case delFrom.ValidatorBond && !delTo.ValidatorBond:
+		if err := k.DecreaseValidatorBondShares(ctx, valAddr, transferred); err != nil {
+			return sdk.ZeroDec(), err
+		}
+	case !delFrom.ValidatorBond && delTo.ValidatorBond:
+		if err := k.IncreaseValidatorBondShares(ctx, valAddr, transferred); err != nil {
+			return sdk.ZeroDec(), err
+		}
+	}
+
+	return transferred, nil
+}
+
+// transferRedelegations moves redelegation entries worth at most wantShares
+// destination shares from the given redelegations to redelegations of toAddr,
+// and returns the destination shares moved.
+func (k Keeper) transferRedelegations(
+	ctx sdk.Context, reds []types.Redelegation, toAddr sdk.AccAddress, wantShares sdk.Dec,
+) sdk.Dec {
+	transferred := sdk.ZeroDec()
+	maxEntries := int(k.MaxEntries(ctx))
+
+	for _, redFrom := range reds {
+		if !transferred.LT(wantShares) {
+			break
+		}
+
+		valSrcAddr, err := sdk.ValAddressFromBech32(redFrom.ValidatorSrcAddress)
+		if err != nil {
+			panic(err)
+		}
+		valDstAddr, err := sdk.ValAddressFromBech32(redFrom.ValidatorDstAddress)
+		if err != nil {
+			panic(err)
+		}
+		redTo, found := k.GetRedelegation(ctx, toAddr, valSrcAddr, valDstAddr)
+		if !found {
+			redTo = types.Redelegation{
+				DelegatorAddress:    toAddr.String(),
+				ValidatorSrcAddress: redFrom.ValidatorSrcAddress,
+				ValidatorDstAddress: redFrom.ValidatorDstAddress,
+			}
+		}
+
+		moved := false
+		entries := make([]types.RedelegationEntry, 0, len(redFrom.Entries))
+		for _, entry := range redFrom.Entries {
+			shares := sdk.MinDec(entry.SharesDst, wantShares.Sub(transferred))
+			if !shares.IsPositive() || len(redTo.Entries) >= maxEntries {
+				entries = append(entries, entry)
+				continue
+			}
+
+			// the initial balance is split proportionally to the shares
+			initialBalance := sdk.NewDecFromInt(entry.InitialBalance).Mul(shares).Quo(entry.SharesDst).TruncateInt()
+			redTo.Entries = append(redTo.Entries, types.NewRedelegationEntry(
+				entry.CreationHeight, entry.CompletionTime, initialBalance, shares,
+			))
+			k.InsertRedelegationQueue(ctx, redTo, entry.CompletionTime)
+			transferred = transferred.Add(shares)
+			moved = true
+
+			entry.SharesDst = entry.SharesDst.Sub(shares)
+			entry.InitialBalance = entry.InitialBalance.Sub(initialBalance)
+			if entry.SharesDst.IsPositive() {
+				entries = append(entries, entry)
+			}
+		}
+
+		if !moved {
+			continue
+		}
+
+		redFrom.Entries = entries
+		if len(redFrom.Entries) == 0 {
+			k.RemoveRedelegation(ctx, redFrom)
+		} else {
+			k.SetRedelegation(ctx, redFrom)
+		}
+		k.SetRedelegation(ctx, redTo)
+	}
+
+	return transferred
+}
@@ -1000,3 +1000,93 @@ func TestRedelegateFromUnbondedValidator(t *testing.T) {
 	red, found := app.StakingKeeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
 	require.False(t, found, "%v", red)
 }
+
+func TestTransferUnbonding(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(0))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+
+	completionTime := time.Unix(0, 0).UTC()
+	ubd := types.NewUnbondingDelegation(addrDels[0], valAddrs[0], 0, completionTime, sdk.NewInt(5))
+	ubd.AddEntry(1, completionTime, sdk.NewInt(10))
+	app.StakingKeeper.SetUnbondingDelegation(ctx, ubd)
+
+	// the first entry is moved entirely, the second one partially
+	transferred := app.StakingKeeper.TransferUnbonding(ctx, addrDels[0], addrDels[1], valAddrs[0], sdk.NewInt(8))
+	require.Equal(t, sdk.NewInt(8), transferred)
+
+	ubdFrom, found := app.StakingKeeper.GetUnbondingDelegation(ctx, addrDels[0], valAddrs[0])
+	require.True(t, found)
+	require.Len(t, ubdFrom.Entries, 1)
+	require.Equal(t, int64(1), ubdFrom.Entries[0].CreationHeight)
+	require.Equal(t, sdk.NewInt(7), ubdFrom.Entries[0].Balance)
+	require.Equal(t, sdk.NewInt(7), ubdFrom.Entries[0].InitialBalance)
+
+	ubdTo, found := app.StakingKeeper.GetUnbondingDelegation(ctx, addrDels[1], valAddrs[0])
+	require.True(t, found)
+	require.Len(t, ubdTo.Entries, 2)
+	require.Equal(t, sdk.NewInt(5), ubdTo.Entries[0].Balance)
+	require.Equal(t, sdk.NewInt(3), ubdTo.Entries[1].Balance)
+	require.Equal(t, int64(1), ubdTo.Entries[1].CreationHeight)
+	require.Len(t, app.StakingKeeper.GetUBDQueueTimeSlice(ctx, completionTime), 2)
+
+	// the remaining balance is moved, removing the unbonding delegation
+	transferred = app.StakingKeeper.TransferUnbonding(ctx, addrDels[0], addrDels[1], valAddrs[0], sdk.NewInt(100))
+	require.Equal(t, sdk.NewInt(7), transferred)
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, addrDels[0], valAddrs[0])
+	require.False(t, found)
+}
+
+func TestTransferDelegation(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(0))
+	addrVals := simapp.ConvertAddrsToValAddrs(addrDels)
+
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	delCoins := sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), delTokens))
+
+	// add bonded tokens to pool for delegations
+	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, notBondedPool.GetName(), delCoins))
+	app.AccountKeeper.SetModuleAccount(ctx, notBondedPool)
+
+	validator := teststaking.NewValidator(t, addrVals[0], PKs[0])
+	validator, issuedShares := validator.AddTokensFromDel(delTokens)
+	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
+	app.StakingKeeper.SetDelegation(ctx, types.NewDelegation(addrDels[0], addrVals[0], issuedShares))
+
+	// 40% of the delegation comes from a redelegation
+	redShares := issuedShares.QuoInt64(5).MulInt64(2)
+	completionTime := time.Unix(0, 0).UTC()
+	red := types.NewRedelegation(addrDels[0], addrVals[1], addrVals[0], 0, completionTime, redShares.TruncateInt(), redShares)
+	app.StakingKeeper.SetRedelegation(ctx, red)
+
+	// moving 80% of the shares requires moving half of the redelegation
+	wantShares := issuedShares.QuoInt64(5).MulInt64(4)
+	transferred, err := app.StakingKeeper.TransferDelegation(ctx, addrDels[0], addrDels[1], addrVals[0], wantShares)
+	require.NoError(t, err)
+	require.Equal(t, wantShares, transferred)
+
+	delFrom, found := app.StakingKeeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, issuedShares.Sub(wantShares), delFrom.Shares)
+	delTo, found := app.StakingKeeper.GetDelegation(ctx, addrDels[1], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, wantShares, delTo.Shares)
+
+	redFrom, found := app.StakingKeeper.GetRedelegation(ctx, addrDels[0], addrVals[1], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, delFrom.Shares, redFrom.Entries[0].SharesDst)
+	redTo, found := app.StakingKeeper.GetRedelegation(ctx, addrDels[1], addrVals[1], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, redShares.Sub(delFrom.Shares), redTo.Entries[0].SharesDst)
+	require.Equal(t, redFrom.Entries[0].InitialBalance.Add(redTo.Entries[0].InitialBalance), redShares.TruncateInt())
+
+	// the validator is left untouched
+	validator2, found := app.StakingKeeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	require.Equal(t, validator.Tokens, validator2.Tokens)
+	require.Equal(t, validator.DelegatorShares, validator2.DelegatorShares)
+}
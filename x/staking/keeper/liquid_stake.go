@@ -0,0 +1,166 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// DelegatorIsLiquidStaker returns true if the delegator is a liquid staking
+// provider. Liquid staking providers delegate from module or interchain
+// accounts, which have 32 bytes addresses.
+func (k Keeper) DelegatorIsLiquidStaker(delegator sdk.AccAddress) bool {
+	return len(delegator) == address.Len
+}
+
+// GetTotalLiquidStakedTokens returns the amount of tokens delegated by
+// tokenize share records and liquid staking providers.
+func (k Keeper) GetTotalLiquidStakedTokens(ctx sdk.Context) math.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.TotalLiquidStakedTokensKey)
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	ip := sdk.IntProto{}
+	k.cdc.MustUnmarshal(bz, &ip)
+
+	return ip.Int
+}
+
+// SetTotalLiquidStakedTokens sets the amount of tokens delegated by tokenize
+// share records and liquid staking providers.
+func (k Keeper) SetTotalLiquidStakedTokens(ctx sdk.Context, tokens math.Int) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: tokens})
+	store.Set(types.TotalLiquidStakedTokensKey, bz)
+}
+
+// SafelyIncreaseTotalLiquidStakedTokens increases the total liquid staked
+// tokens, unless the increase would exceed the global liquid staking cap.
+func (k Keeper) SafelyIncreaseTotalLiquidStakedTokens(ctx sdk.Context, tokens math.Int) error {
+	total := k.GetTotalLiquidStakedTokens(ctx).Add(tokens)
+
+	liquidStakingCap := k.GlobalLiquidStakingCap(ctx)
+	if liquidStakingCap.LT(sdk.OneDec()) {
+		bonded := k.TotalBondedTokens(ctx)
+		if !bonded.IsPositive() || sdk.NewDecFromInt(total).Quo(sdk.NewDecFromInt(bonded)).GT(liquidStakingCap) {
+			return types.ErrGlobalLiquidStakingCapExceeded
+		}
+	}
+
+	k.SetTotalLiquidStakedTokens(ctx, total)
+
+	return nil
+}
+
+// DecreaseTotalLiquidStakedTokens decreases the total liquid staked tokens,
+// without going below zero.
+func (k Keeper) DecreaseTotalLiquidStakedTokens(ctx sdk.Context, tokens math.Int) {
+	total := k.GetTotalLiquidStakedTokens(ctx)
+	k.SetTotalLiquidStakedTokens(ctx, sdk.MaxInt(total.Sub(tokens), sdk.ZeroInt()))
+}
+
+// SafelyIncreaseValidatorLiquidShares increases the liquid shares of a
+// validator, unless the increase would exceed the validator liquid staking
+// cap.
+func (k Keeper) SafelyIncreaseValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress, shares sdk.Dec) (types.Validator, error) {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return validator, types.ErrNoValidatorFound
+	}
+
+	liquidShares := validator.LiquidShares.Add(shares)
+
+	liquidStakingCap := k.ValidatorLiquidStakingCap(ctx)
+	if liquidStakingCap.LT(sdk.OneDec()) {
+		if !validator.DelegatorShares.IsPositive() || liquidShares.Quo(validator.DelegatorShares).GT(liquidStakingCap) {
+			return validator, types.ErrValidatorLiquidStakingCapExceeded
+		}
+	}
+
+	if k.exceedsValidatorBondCap(ctx, validator.ValidatorBondShares, liquidShares) {
+		return validator, types.ErrInsufficientValidatorBondShares
+	}
+
+	validator.LiquidShares = liquidShares
+	k.SetValidator(ctx, validator)
+
+	return validator, nil
+}
+
+// DecreaseValidatorLiquidShares decreases the liquid shares of a validator,
+// without going below zero.
+func (k Keeper) DecreaseValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress, shares sdk.Dec) (types.Validator, error) {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return validator, types.ErrNoValidatorFound
+	}
+
+	validator.LiquidShares = sdk.MaxDec(validator.LiquidShares.Sub(shares), sdk.ZeroDec())
+	k.SetValidator(ctx, validator)
+
+	return validator, nil
+}
+
+// IncreaseValidatorBondShares increases the validator bond shares of a
+// validator.
+func (k Keeper) IncreaseValidatorBondShares(ctx sdk.Context, valAddr sdk.ValAddress, shares sdk.Dec) error {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorFound
+	}
+
+	validator.ValidatorBondShares = validator.ValidatorBondShares.Add(shares)
+	k.SetValidator(ctx, validator)
+
+	return nil
+}
+
+// DecreaseValidatorBondShares decreases the validator bond shares of a
+// validator, without going below zero.
+func (k Keeper) DecreaseValidatorBondShares(ctx sdk.Context, valAddr sdk.ValAddress, shares sdk.Dec) error {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorFound
+	}
+
+	validator.ValidatorBondShares = sdk.MaxDec(validator.ValidatorBondShares.Sub(shares), sdk.ZeroDec())
+	k.SetValidator(ctx, validator)
+
+	return nil
+}
+
+// validateValidatorBondDecrease returns an error if removing the given shares
+// from the delegation of delAddr would leave the validator with less validator
+// bond than its liquid shares require.
+func (k Keeper) validateValidatorBondDecrease(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec) error {
+	delegation, found := k.GetDelegation(ctx, delAddr, valAddr)
+	if !found || !delegation.ValidatorBond {
+		return nil
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorFound
+	}
+
+	if k.exceedsValidatorBondCap(ctx, validator.ValidatorBondShares.Sub(shares), validator.LiquidShares) {
+		return types.ErrInsufficientValidatorBondShares
+	}
+
+	return nil
+}
+
+// exceedsValidatorBondCap returns true if the liquid shares exceed the
+// validator bond factor multiple of the validator bond shares.
+func (k Keeper) exceedsValidatorBondCap(ctx sdk.Context, validatorBondShares, liquidShares sdk.Dec) bool {
+	factor := k.ValidatorBondFactor(ctx)
+	if factor.Equal(sdk.NewDec(-1)) {
+		return false
+	}
+
+	return liquidShares.GT(validatorBondShares.Mul(factor))
+}
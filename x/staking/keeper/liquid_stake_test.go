@@ -0,0 +1,292 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func setupTokenizeShares(t *testing.T) (*simapp.SimApp, sdk.Context, types.MsgServer, sdk.AccAddress, sdk.ValAddress) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, app.StakingKeeper.TokensFromConsensusPower(ctx, 100))
+	valAddr := sdk.ValAddress(addrs[1])
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidatorWithValPower(valAddr, PKs[0], 10, true)
+	tstaking.Delegate(addrs[0], valAddr, app.StakingKeeper.TokensFromConsensusPower(ctx, 10))
+	app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	return app, ctx, msgServer, addrs[0], valAddr
+}
+
+func TestTokenizeAndRedeemShares(t *testing.T) {
+	app, ctx, msgServer, delAddr, valAddr := setupTokenizeShares(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	tokenizeAmount := app.StakingKeeper.TokensFromConsensusPower(ctx, 4)
+
+	bondedBefore := app.StakingKeeper.TotalBondedTokens(ctx)
+
+	// only the bond denom can be tokenized
+	_, err := msgServer.TokenizeShares(goCtx, types.NewMsgTokenizeShares(delAddr, valAddr, sdk.NewCoin("foo", tokenizeAmount), delAddr))
+	require.ErrorIs(t, err, types.ErrOnlyBondDenomAllowedForTokenize)
+
+	res, err := msgServer.TokenizeShares(goCtx, types.NewMsgTokenizeShares(delAddr, valAddr, sdk.NewCoin(bondDenom, tokenizeAmount), delAddr))
+	require.NoError(t, err)
+
+	record, found := app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, delAddr.String(), record.Owner)
+	require.Equal(t, record.GetShareTokenDenom(), res.Amount.Denom)
+	require.Equal(t, tokenizeAmount, res.Amount.Amount)
+	require.Equal(t, res.Amount, app.BankKeeper.GetBalance(ctx, delAddr, res.Amount.Denom))
+
+	// the delegation moved to the module account of the record
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(tokenizeAmount), delegation.Shares)
+	require.Equal(t, bondedBefore, app.StakingKeeper.TotalBondedTokens(ctx))
+	require.Equal(t, tokenizeAmount, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(tokenizeAmount), validator.LiquidShares)
+
+	// redeem half of the share tokens
+	half := sdk.NewCoin(res.Amount.Denom, tokenizeAmount.QuoRaw(2))
+	_, err = msgServer.RedeemTokensForShares(goCtx, types.NewMsgRedeemTokensForShares(delAddr, half))
+	require.NoError(t, err)
+	require.Equal(t, tokenizeAmount.QuoRaw(2), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	_, found = app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.True(t, found)
+
+	// redeeming the rest removes the record
+	_, err = msgServer.RedeemTokensForShares(goCtx, types.NewMsgRedeemTokensForShares(delAddr, half))
+	require.NoError(t, err)
+
+	_, found = app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.False(t, found)
+	_, found = app.StakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+	require.False(t, found)
+	require.True(t, app.BankKeeper.GetSupply(ctx, res.Amount.Denom).IsZero())
+	require.True(t, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx).IsZero())
+
+	validator, found = app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.True(t, validator.LiquidShares.IsZero())
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(app.StakingKeeper.TokensFromConsensusPower(ctx, 10)), delegation.Shares)
+	require.Equal(t, bondedBefore, app.StakingKeeper.TotalBondedTokens(ctx))
+}
+
+func TestTransferTokenizeShareRecord(t *testing.T) {
+	app, ctx, msgServer, delAddr, valAddr := setupTokenizeShares(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+	newOwner := sdk.AccAddress("new_owner___________")
+
+	amount := sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), app.StakingKeeper.TokensFromConsensusPower(ctx, 1))
+	_, err := msgServer.TokenizeShares(goCtx, types.NewMsgTokenizeShares(delAddr, valAddr, amount, delAddr))
+	require.NoError(t, err)
+
+	// only the owner can transfer a record
+	_, err = msgServer.TransferTokenizeShareRecord(goCtx, types.NewMsgTransferTokenizeShareRecord(1, newOwner, newOwner))
+	require.ErrorIs(t, err, types.ErrNotTokenizeShareRecordOwner)
+
+	_, err = msgServer.TransferTokenizeShareRecord(goCtx, types.NewMsgTransferTokenizeShareRecord(2, delAddr, newOwner))
+	require.ErrorIs(t, err, types.ErrTokenizeShareRecordNotExists)
+
+	_, err = msgServer.TransferTokenizeShareRecord(goCtx, types.NewMsgTransferTokenizeShareRecord(1, delAddr, newOwner))
+	require.NoError(t, err)
+
+	record, found := app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, newOwner.String(), record.Owner)
+	require.Empty(t, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, delAddr))
+	require.Len(t, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, newOwner), 1)
+}
+
+func TestTokenizeSharesLiquidStakingCaps(t *testing.T) {
+	testCases := []struct {
+		name         string
+		globalCap    sdk.Dec
+		validatorCap sdk.Dec
+		expErr       error
+	}{
+		{"no caps", sdk.OneDec(), sdk.OneDec(), nil},
+		{"within the caps", sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), nil},
+		{"exceeding the global cap", sdk.NewDecWithPrec(1, 2), sdk.OneDec(), types.ErrGlobalLiquidStakingCapExceeded},
+		{"exceeding the validator cap", sdk.OneDec(), sdk.NewDecWithPrec(1, 2), types.ErrValidatorLiquidStakingCapExceeded},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			app, ctx, msgServer, delAddr, valAddr := setupTokenizeShares(t)
+
+			params := app.StakingKeeper.GetParams(ctx)
+			params.GlobalLiquidStakingCap = tc.globalCap
+			params.ValidatorLiquidStakingCap = tc.validatorCap
+			app.StakingKeeper.SetParams(ctx, params)
+
+			amount := sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), app.StakingKeeper.TokensFromConsensusPower(ctx, 5))
+			_, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), types.NewMsgTokenizeShares(delAddr, valAddr, amount, delAddr))
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSlashDecreasesTotalLiquidStakedTokens(t *testing.T) {
+	app, ctx, msgServer, delAddr, valAddr := setupTokenizeShares(t)
+
+	amount := sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), app.StakingKeeper.TokensFromConsensusPower(ctx, 10))
+	_, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), types.NewMsgTokenizeShares(delAddr, valAddr, amount, delAddr))
+	require.NoError(t, err)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+
+	liquidFraction := validator.LiquidShares.Quo(validator.DelegatorShares)
+	slashFactor := sdk.NewDecWithPrec(5, 1)
+	app.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), validator.ConsensusPower(app.StakingKeeper.PowerReduction(ctx)), slashFactor)
+
+	slashed := sdk.NewDecFromInt(validator.Tokens).Mul(slashFactor).TruncateInt()
+	expected := amount.Amount.Sub(sdk.NewDecFromInt(slashed).Mul(liquidFraction).TruncateInt())
+	require.Equal(t, expected, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+}
+
+func TestValidatorBond(t *testing.T) {
+	app, ctx, msgServer, delAddr, valAddr := setupTokenizeShares(t)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	coin := func(power int64) sdk.Coin {
+		return sdk.NewCoin(bondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, power))
+	}
+	shares := func(power int64) sdk.Dec {
+		return sdk.NewDecFromInt(app.StakingKeeper.TokensFromConsensusPower(ctx, power))
+	}
+	// expectErr runs a message in a cached context, as its state changes
+	// are discarded when it fails
+	expectErr := func(expErr error, handle func(goCtx context.Context) error) {
+		cacheCtx, _ := ctx.CacheContext()
+		require.ErrorIs(t, handle(sdk.WrapSDKContext(cacheCtx)), expErr)
+	}
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.ValidatorBondFactor = sdk.NewDec(2)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	liquidStaker := sdk.AccAddress(address.Module("liquidstaker", []byte("1")))
+	require.True(t, app.StakingKeeper.DelegatorIsLiquidStaker(liquidStaker))
+	require.False(t, app.StakingKeeper.DelegatorIsLiquidStaker(delAddr))
+	require.NoError(t, testutil.FundAccount(app.BankKeeper, ctx, liquidStaker, sdk.NewCoins(coin(100))))
+
+	// without validator bond, liquid staking providers can't delegate
+	expectErr(types.ErrInsufficientValidatorBondShares, func(goCtx context.Context) error {
+		_, err := msgServer.Delegate(goCtx, types.NewMsgDelegate(liquidStaker, valAddr, coin(1)))
+		return err
+	})
+
+	_, err := msgServer.ValidatorBond(goCtx, types.NewMsgValidatorBond(delAddr, valAddr))
+	require.NoError(t, err)
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	require.True(t, delegation.ValidatorBond)
+	validator, _ := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.Equal(t, shares(10), validator.ValidatorBondShares)
+
+	// liquid staking providers can delegate up to twice the validator bond
+	_, err = msgServer.Delegate(goCtx, types.NewMsgDelegate(liquidStaker, valAddr, coin(20)))
+	require.NoError(t, err)
+	expectErr(types.ErrInsufficientValidatorBondShares, func(goCtx context.Context) error {
+		_, err := msgServer.Delegate(goCtx, types.NewMsgDelegate(liquidStaker, valAddr, coin(1)))
+		return err
+	})
+
+	validator, _ = app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.Equal(t, shares(20), validator.LiquidShares)
+	require.Equal(t, coin(20).Amount, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	expectErr(types.ErrValidatorBondNotAllowedFromModuleAccount, func(goCtx context.Context) error {
+		_, err := msgServer.ValidatorBond(goCtx, types.NewMsgValidatorBond(liquidStaker, valAddr))
+		return err
+	})
+
+	// the validator bond can't be reduced below what the liquid shares require
+	expectErr(types.ErrInsufficientValidatorBondShares, func(goCtx context.Context) error {
+		_, err := msgServer.Undelegate(goCtx, types.NewMsgUndelegate(delAddr, valAddr, coin(1)))
+		return err
+	})
+
+	// delegating to a validator bond delegation increases the validator bond
+	_, err = msgServer.Delegate(goCtx, types.NewMsgDelegate(delAddr, valAddr, coin(5)))
+	require.NoError(t, err)
+	validator, _ = app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.Equal(t, shares(15), validator.ValidatorBondShares)
+
+	// unbonding from a liquid staking provider releases liquid shares
+	_, err = msgServer.Undelegate(goCtx, types.NewMsgUndelegate(liquidStaker, valAddr, coin(10)))
+	require.NoError(t, err)
+	_, err = msgServer.Undelegate(goCtx, types.NewMsgUndelegate(delAddr, valAddr, coin(10)))
+	require.NoError(t, err)
+
+	validator, _ = app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.Equal(t, shares(10), validator.LiquidShares)
+	require.Equal(t, shares(5), validator.ValidatorBondShares)
+	require.Equal(t, coin(10).Amount, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	// validator bond delegations and liquid staking providers can't tokenize
+	expectErr(types.ErrValidatorBondNotAllowedForTokenizeShare, func(goCtx context.Context) error {
+		_, err := msgServer.TokenizeShares(goCtx, types.NewMsgTokenizeShares(delAddr, valAddr, coin(1), delAddr))
+		return err
+	})
+	expectErr(types.ErrLiquidStakerNotAllowedForTokenizeShare, func(goCtx context.Context) error {
+		_, err := msgServer.TokenizeShares(goCtx, types.NewMsgTokenizeShares(liquidStaker, valAddr, coin(1), liquidStaker))
+		return err
+	})
+}
+
+func TestTokenizeSharesValidatorBondCap(t *testing.T) {
+	app, ctx, msgServer, delAddr, valAddr := setupTokenizeShares(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.ValidatorBondFactor = sdk.NewDecWithPrec(5, 1)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// the self-delegation of the validator is its validator bond
+	_, err := msgServer.ValidatorBond(goCtx, types.NewMsgValidatorBond(sdk.AccAddress(valAddr), valAddr))
+	require.NoError(t, err)
+
+	amount := sdk.NewCoin(bondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 5))
+	_, err = msgServer.TokenizeShares(goCtx, types.NewMsgTokenizeShares(delAddr, valAddr, amount, delAddr))
+	require.NoError(t, err)
+
+	cacheCtx, _ := ctx.CacheContext()
+	amount = sdk.NewCoin(bondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 1))
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(cacheCtx), types.NewMsgTokenizeShares(delAddr, valAddr, amount, delAddr))
+	require.ErrorIs(t, err, types.ErrInsufficientValidatorBondShares)
+}
@@ -14,6 +14,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -294,6 +296,10 @@ func (k msgServer) BeginRedelegate(goCtx context.Context, msg *types.MsgBeginRed
 		return nil, err
 	}
 
+	if err := k.validateValidatorBondDecrease(ctx, delegatorAddress, valSrcAddr, shares); err != nil {
+		return nil, err
+	}
+
 	completionTime, err := k.BeginRedelegation(
 		ctx, delegatorAddress, valSrcAddr, valDstAddr, shares,
 	)
@@ -358,6 +364,10 @@ func (k msgServer) Undelegate(goCtx context.Context, msg *types.MsgUndelegate) (
 		)
 	}
 
+	if err := k.validateValidatorBondDecrease(ctx, delegatorAddress, addr, shares); err != nil {
+		return nil, err
+	}
+
 	completionTime, err := k.Keeper.Undelegate(ctx, delegatorAddress, addr, shares)
 	if err != nil {
 		return nil, err
@@ -499,3 +509,329 @@ func (k msgServer) CancelUnbondingDelegation(goCtx context.Context, msg *types.M
 
 	return &types.MsgCancelUnbondingDelegationResponse{}, nil
 }
+
+// UpdateParams defines a method to perform updation of params exist in x/staking module.
+func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetParams(ctx, msg.Params)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// TokenizeShares defines a method for tokenizing a delegation into transferable
+// share tokens
+func (k msgServer) TokenizeShares(goCtx context.Context, msg *types.MsgTokenizeShares) (*types.MsgTokenizeSharesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	owner, err := sdk.AccAddressFromBech32(msg.TokenizedShareOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	bondDenom := k.BondDenom(ctx)
+	if msg.Amount.Denom != bondDenom {
+		return nil, types.ErrOnlyBondDenomAllowedForTokenize
+	}
+
+	// the shares backing a redelegation must stay with the delegator so that
+	// they remain slashable for the source validator infractions
+	if k.HasReceivingRedelegation(ctx, delegatorAddress, valAddr) {
+		return nil, types.ErrRedelegationInProgress
+	}
+
+	// the delegations of liquid staking providers are already liquid
+	if k.DelegatorIsLiquidStaker(delegatorAddress) {
+		return nil, types.ErrLiquidStakerNotAllowedForTokenizeShare
+	}
+
+	if delegation, found := k.GetDelegation(ctx, delegatorAddress, valAddr); found && delegation.ValidatorBond {
+		return nil, types.ErrValidatorBondNotAllowedForTokenizeShare
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delegatorAddress, valAddr, msg.Amount.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	// only the delegated vested coins of a vesting account can be tokenized
+	if acc, ok := k.authKeeper.GetAccount(ctx, delegatorAddress).(vestexported.VestingAccount); ok {
+		if acc.GetDelegatedFree().AmountOf(bondDenom).LT(msg.Amount.Amount) {
+			return nil, types.ErrExceedingFreeVestingDelegations
+		}
+	}
+
+	recordID := k.GetLastTokenizeShareRecordID(ctx) + 1
+	k.SetLastTokenizeShareRecordID(ctx, recordID)
+
+	record := types.NewTokenizeShareRecord(recordID, owner, valAddr)
+	if err := k.AddTokenizeShareRecord(ctx, record); err != nil {
+		return nil, err
+	}
+
+	// Move the delegation to the module account of the record. The tokens are
+	// undelegated to the delegator first to keep the accounting of vesting
+	// accounts up to date.
+	returnAmount, err := k.Unbond(ctx, delegatorAddress, valAddr, shares)
+	if err != nil {
+		return nil, err
+	}
+
+	if validator.IsBonded() {
+		k.bondedTokensToNotBonded(ctx, returnAmount)
+	}
+
+	returnCoins := sdk.NewCoins(sdk.NewCoin(bondDenom, returnAmount))
+	if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delegatorAddress, returnCoins); err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.SendCoins(ctx, delegatorAddress, record.GetModuleAddress(), returnCoins); err != nil {
+		return nil, err
+	}
+
+	validator, found = k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	newShares, err := k.Keeper.Delegate(ctx, record.GetModuleAddress(), returnAmount, types.Unbonded, validator, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if newShares.TruncateInt().IsZero() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "shares amount is too small to tokenize")
+	}
+
+	if _, err := k.SafelyIncreaseValidatorLiquidShares(ctx, valAddr, newShares); err != nil {
+		return nil, err
+	}
+	if err := k.SafelyIncreaseTotalLiquidStakedTokens(ctx, returnAmount); err != nil {
+		return nil, err
+	}
+
+	// mint the share tokens to the delegator
+	shareToken := sdk.NewCoin(record.GetShareTokenDenom(), newShares.TruncateInt())
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(shareToken)); err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delegatorAddress, sdk.NewCoins(shareToken)); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTokenizeShares,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyShareOwner, msg.TokenizedShareOwner),
+			sdk.NewAttribute(types.AttributeKeyShareRecordID, strconv.FormatUint(recordID, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, shareToken.String()),
+		),
+	)
+
+	return &types.MsgTokenizeSharesResponse{
+		Amount: shareToken,
+	}, nil
+}
+
+// RedeemTokensForShares defines a method for redeeming share tokens back into
+// a delegation
+func (k msgServer) RedeemTokensForShares(goCtx context.Context, msg *types.MsgRedeemTokensForShares) (*types.MsgRedeemTokensForSharesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	record, found := k.GetTokenizeShareRecordByDenom(ctx, msg.Amount.Denom)
+	if !found {
+		return nil, types.ErrTokenizeShareRecordNotExists
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	moduleAddr := record.GetModuleAddress()
+	delegation, found := k.GetDelegation(ctx, moduleAddr, valAddr)
+	if !found {
+		return nil, types.ErrNoDelegatorForAddress
+	}
+
+	// burn the share tokens
+	shareTokens := sdk.NewCoins(msg.Amount)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delegatorAddress, types.ModuleName, shareTokens); err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, shareTokens); err != nil {
+		return nil, err
+	}
+
+	// the last share tokens redeem the whole delegation, including the shares
+	// left over by rounding
+	shares := sdk.NewDecFromInt(msg.Amount.Amount)
+	if k.bankKeeper.GetSupply(ctx, msg.Amount.Denom).IsZero() || shares.GT(delegation.Shares) {
+		shares = delegation.Shares
+	}
+
+	tokens := validator.TokensFromShares(shares).TruncateInt()
+
+	// move the delegation from the module account of the record to the delegator
+	shares, err = k.TransferDelegation(ctx, moduleAddr, delegatorAddress, valAddr, shares)
+	if err != nil {
+		return nil, err
+	}
+
+	// the shares stay liquid when redeemed by a liquid staking provider
+	if !k.DelegatorIsLiquidStaker(delegatorAddress) {
+		if _, err := k.DecreaseValidatorLiquidShares(ctx, valAddr, shares); err != nil {
+			return nil, err
+		}
+		k.DecreaseTotalLiquidStakedTokens(ctx, tokens)
+	}
+
+	// remove the record once its delegation is fully redeemed, the rewards left
+	// in the module account are sent to the owner of the record
+	if _, found := k.GetDelegation(ctx, moduleAddr, valAddr); !found {
+		owner, err := sdk.AccAddressFromBech32(record.Owner)
+		if err != nil {
+			return nil, err
+		}
+
+		if balance := k.bankKeeper.GetAllBalances(ctx, moduleAddr); !balance.IsZero() {
+			if err := k.bankKeeper.SendCoins(ctx, moduleAddr, owner, balance); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := k.DeleteTokenizeShareRecord(ctx, record.Id); err != nil {
+			return nil, err
+		}
+	}
+
+	returnCoin := sdk.NewCoin(k.BondDenom(ctx), tokens)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRedeemShares,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyValidator, record.Validator),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, returnCoin.String()),
+		),
+	)
+
+	return &types.MsgRedeemTokensForSharesResponse{
+		Amount: returnCoin,
+	}, nil
+}
+
+// TransferTokenizeShareRecord defines a method for transferring the ownership
+// of a tokenize share record
+func (k msgServer) TransferTokenizeShareRecord(goCtx context.Context, msg *types.MsgTransferTokenizeShareRecord) (*types.MsgTransferTokenizeShareRecordResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	newOwner, err := sdk.AccAddressFromBech32(msg.NewOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.TransferTokenizeShareRecord(ctx, msg.TokenizeShareRecordId, sender, newOwner); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransferTokenizeShareRecord,
+			sdk.NewAttribute(types.AttributeKeyShareRecordID, strconv.FormatUint(msg.TokenizeShareRecordId, 10)),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyShareOwner, msg.NewOwner),
+		),
+	)
+
+	return &types.MsgTransferTokenizeShareRecordResponse{}, nil
+}
+
+// ValidatorBond defines a method for flagging a delegation as validator bond
+func (k msgServer) ValidatorBond(goCtx context.Context, msg *types.MsgValidatorBond) (*types.MsgValidatorBondResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, found := k.GetValidator(ctx, valAddr); !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	delegation, found := k.GetDelegation(ctx, delegatorAddress, valAddr)
+	if !found {
+		return nil, types.ErrNoDelegation
+	}
+
+	// liquid staking providers can't back the delegations they are capped by
+	if k.DelegatorIsLiquidStaker(delegatorAddress) {
+		return nil, types.ErrValidatorBondNotAllowedFromModuleAccount
+	}
+
+	// the shares backing a redelegation may be slashed for the source validator
+	// infractions, so they can't count towards the validator bond
+	if k.HasReceivingRedelegation(ctx, delegatorAddress, valAddr) {
+		return nil, types.ErrRedelegationInProgress
+	}
+
+	if !delegation.ValidatorBond {
+		delegation.ValidatorBond = true
+		k.SetDelegation(ctx, delegation)
+
+		if err := k.IncreaseValidatorBondShares(ctx, valAddr, delegation.Shares); err != nil {
+			return nil, err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeValidatorBondDelegation,
+				sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+				sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			),
+		)
+	}
+
+	return &types.MsgValidatorBondResponse{}, nil
+}
@@ -144,3 +144,52 @@ func TestCancelUnbondingDelegation(t *testing.T) {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgUpdateParams() {
+	authority := suite.app.StakingKeeper.GetAuthority()
+
+	params := types.DefaultParams()
+	params.MaxValidators = 50
+
+	invalidParams := types.DefaultParams()
+	invalidParams.MaxValidators = 0
+
+	testCases := []struct {
+		name      string
+		input     *types.MsgUpdateParams
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name:      "invalid authority",
+			input:     &types.MsgUpdateParams{Authority: "invalid", Params: params},
+			expErr:    true,
+			expErrMsg: "expected gov account as only signer for proposal message",
+		},
+		{
+			name:      "invalid params",
+			input:     &types.MsgUpdateParams{Authority: authority, Params: invalidParams},
+			expErr:    true,
+			expErrMsg: "max validators must be positive",
+		},
+		{
+			name:   "all good",
+			input:  &types.MsgUpdateParams{Authority: authority, Params: params},
+			expErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			_, err := suite.msgServer.UpdateParams(sdk.WrapSDKContext(suite.ctx), tc.input)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(params, suite.app.StakingKeeper.GetParams(suite.ctx))
+			}
+		})
+	}
+}
@@ -125,6 +125,13 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 		k.BeforeValidatorSlashed(ctx, operatorAddress, effectiveFraction)
 	}
 
+	// The slashed tokens backing tokenize share records don't count towards the
+	// global liquid staking cap anymore.
+	if validator.LiquidShares.IsPositive() && validator.DelegatorShares.IsPositive() {
+		slashedLiquidTokens := sdk.NewDecFromInt(tokensToBurn).Mul(validator.LiquidShares).Quo(validator.DelegatorShares)
+		k.DecreaseTotalLiquidStakedTokens(ctx, slashedLiquidTokens.TruncateInt())
+	}
+
 	// Deduct from validator's bonded tokens and update the validator.
 	// Burn the slashed tokens from the pool account and decrease the total supply.
 	validator = k.RemoveValidatorTokens(ctx, validator, tokensToBurn)
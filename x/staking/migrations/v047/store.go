@@ -0,0 +1,47 @@
+package v047
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MigrateStore performs in-place store migrations from v0.46 to v0.47. The
+// migration includes:
+//
+// - Move the params from the x/params subspace to the x/staking module store.
+// - Set the liquid staking caps and the validator bond factor to their defaults.
+// - Set the liquid shares and the validator bond shares of the validators to zero.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, legacySubspace paramtypes.Subspace, cdc codec.BinaryCodec) error {
+	var params types.Params
+	legacySubspace.GetParamSet(ctx, &params)
+
+	params.GlobalLiquidStakingCap = types.DefaultGlobalLiquidStakingCap
+	params.ValidatorLiquidStakingCap = types.DefaultValidatorLiquidStakingCap
+	params.ValidatorBondFactor = types.DefaultValidatorBondFactor
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(storeKey)
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+
+	migrateValidatorsLiquidStakingShares(store, cdc)
+
+	return nil
+}
+
+func migrateValidatorsLiquidStakingShares(store sdk.KVStore, cdc codec.BinaryCodec) {
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorsKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		validator := types.MustUnmarshalValidator(cdc, iterator.Value())
+		validator.LiquidShares = sdk.ZeroDec()
+		validator.ValidatorBondShares = sdk.ZeroDec()
+		store.Set(iterator.Key(), types.MustMarshalValidator(cdc, &validator))
+	}
+}
@@ -0,0 +1,52 @@
+package v047_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	v047staking "github.com/cosmos/cosmos-sdk/x/staking/migrations/v047"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	stakingKey := sdk.NewKVStoreKey(types.StoreKey)
+	tStakingKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(stakingKey, tStakingKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, stakingKey, tStakingKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	// Set non default params in the legacy subspace.
+	params := types.DefaultParams()
+	params.MaxValidators = 50
+	paramstore.SetParamSet(ctx, &params)
+
+	// Set a validator without liquid shares.
+	valAddr := sdk.ValAddress("val1________________")
+	validator, err := types.NewValidator(valAddr, ed25519.GenPrivKey().PubKey(), types.Description{})
+	require.NoError(t, err)
+	validator.LiquidShares = sdk.Dec{}
+	validator.ValidatorBondShares = sdk.Dec{}
+	ctx.KVStore(stakingKey).Set(types.GetValidatorKey(valAddr), types.MustMarshalValidator(encCfg.Codec, &validator))
+
+	// Run migrations.
+	err = v047staking.MigrateStore(ctx, stakingKey, paramstore, encCfg.Codec)
+	require.NoError(t, err)
+
+	// Make sure the params are now in the module store.
+	var res types.Params
+	bz := ctx.KVStore(stakingKey).Get(types.ParamsKey)
+	require.NoError(t, encCfg.Codec.Unmarshal(bz, &res))
+	require.Equal(t, params, res)
+
+	// Make sure the validator liquid shares are set.
+	validator = types.MustUnmarshalValidator(encCfg.Codec, ctx.KVStore(stakingKey).Get(types.GetValidatorKey(valAddr)))
+	require.True(t, validator.LiquidShares.IsZero())
+	require.True(t, validator.ValidatorBondShares.IsZero())
+}
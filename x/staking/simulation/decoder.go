@@ -15,7 +15,8 @@ import (
 func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 	return func(kvA, kvB kv.Pair) string {
 		switch {
-		case bytes.Equal(kvA.Key[:1], types.LastTotalPowerKey):
+		case bytes.Equal(kvA.Key[:1], types.LastTotalPowerKey),
+			bytes.Equal(kvA.Key[:1], types.TotalLiquidStakedTokensKey):
 			var powerA, powerB sdk.IntProto
 
 			cdc.MustUnmarshal(kvA.Value, &powerA)
@@ -57,6 +58,16 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &redB)
 
 			return fmt.Sprintf("%v\n%v", redA, redB)
+		case bytes.Equal(kvA.Key[:1], types.TokenizeShareRecordPrefix):
+			var recordA, recordB types.TokenizeShareRecord
+
+			cdc.MustUnmarshal(kvA.Value, &recordA)
+			cdc.MustUnmarshal(kvB.Value, &recordB)
+
+			return fmt.Sprintf("%v\n%v", recordA, recordB)
+		case bytes.Equal(kvA.Key[:1], types.TokenizeShareRecordIDByDenomPrefix),
+			bytes.Equal(kvA.Key[:1], types.LastTokenizeShareRecordIDKey):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
 		default:
 			panic(fmt.Sprintf("invalid staking key prefix %X", kvA.Key[:1]))
 		}
@@ -44,6 +44,20 @@ type GenesisState struct {
 	// redelegations defines the redelegations active at genesis.
 	Redelegations []Redelegation `protobuf:"bytes,7,rep,name=redelegations,proto3" json:"redelegations"`
 	Exported      bool           `protobuf:"varint,8,opt,name=exported,proto3" json:"exported,omitempty"`
+	// tokenize_share_records defines the tokenized delegations at genesis.
+	//
+	// Since: cosmos-sdk 0.47
+	TokenizeShareRecords []TokenizeShareRecord `protobuf:"bytes,9,rep,name=tokenize_share_records,json=tokenizeShareRecords,proto3" json:"tokenize_share_records"`
+	// last_tokenize_share_record_id is the id of the last tokenize share record
+	// created.
+	//
+	// Since: cosmos-sdk 0.47
+	LastTokenizeShareRecordId uint64 `protobuf:"varint,10,opt,name=last_tokenize_share_record_id,json=lastTokenizeShareRecordId,proto3" json:"last_tokenize_share_record_id,omitempty"`
+	// total_liquid_staked_tokens is the amount of tokens delegated by tokenize
+	// share records and liquid staking providers.
+	//
+	// Since: cosmos-sdk 0.47
+	TotalLiquidStakedTokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=total_liquid_staked_tokens,json=totalLiquidStakedTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_liquid_staked_tokens"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -128,6 +142,20 @@ func (m *GenesisState) GetExported() bool {
 	return false
 }
 
+func (m *GenesisState) GetTokenizeShareRecords() []TokenizeShareRecord {
+	if m != nil {
+		return m.TokenizeShareRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastTokenizeShareRecordId() uint64 {
+	if m != nil {
+		return m.LastTokenizeShareRecordId
+	}
+	return 0
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -179,38 +207,43 @@ func init() {
 }
 
 var fileDescriptor_9b3dec8894f2831b = []byte{
-	// 483 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x41, 0x6e, 0xd3, 0x40,
-	0x14, 0x86, 0x6d, 0x92, 0xa6, 0x61, 0x52, 0x10, 0x1a, 0x52, 0x64, 0xb2, 0x70, 0x42, 0x54, 0xa1,
-	0x08, 0xa8, 0xad, 0x86, 0x1d, 0x62, 0x43, 0x84, 0xa8, 0x40, 0x2c, 0x22, 0x17, 0x10, 0x62, 0x13,
-	0x4d, 0xea, 0xc1, 0xb5, 0xea, 0x78, 0xac, 0x79, 0x2f, 0xa5, 0xdc, 0x80, 0x25, 0x47, 0xe8, 0x21,
-	0x38, 0x44, 0x97, 0x15, 0x2b, 0xc4, 0xa2, 0x42, 0xc9, 0x86, 0x1b, 0xb0, 0x45, 0x9e, 0x99, 0x18,
-	0xa3, 0x60, 0x56, 0xc9, 0xe8, 0x7d, 0xff, 0x37, 0xbf, 0xe4, 0x37, 0x64, 0xe7, 0x50, 0xc0, 0x4c,
-	0x80, 0x0f, 0xc8, 0x8e, 0xe3, 0x34, 0xf2, 0x4f, 0xf6, 0xa6, 0x1c, 0xd9, 0x9e, 0x1f, 0xf1, 0x94,
-	0x43, 0x0c, 0x5e, 0x26, 0x05, 0x0a, 0x7a, 0x4b, 0x53, 0x9e, 0xa1, 0x3c, 0x43, 0x75, 0xda, 0x91,
-	0x88, 0x84, 0x42, 0xfc, 0xfc, 0x9f, 0xa6, 0x3b, 0x55, 0xce, 0x55, 0x5a, 0x53, 0xb7, 0x35, 0x35,
-	0xd1, 0x71, 0x73, 0x81, 0x3a, 0xf4, 0x7f, 0xd5, 0xc9, 0xd6, 0xbe, 0x2e, 0x70, 0x80, 0x0c, 0x39,
-	0x7d, 0x4c, 0x1a, 0x19, 0x93, 0x6c, 0x06, 0x8e, 0xdd, 0xb3, 0x07, 0xad, 0xa1, 0xeb, 0xfd, 0xbb,
-	0x90, 0x37, 0x56, 0xd4, 0xa8, 0x7e, 0x7e, 0xd9, 0xb5, 0x02, 0x93, 0xa1, 0x6f, 0xc9, 0x8d, 0x84,
-	0x01, 0x4e, 0x50, 0x20, 0x4b, 0x26, 0x99, 0xf8, 0xc0, 0xa5, 0x73, 0xa5, 0x67, 0x0f, 0xb6, 0x46,
-	0x5e, 0xce, 0x7d, 0xbf, 0xec, 0xde, 0x8d, 0x62, 0x3c, 0x9a, 0x4f, 0xbd, 0x43, 0x31, 0x33, 0x4d,
-	0xcc, 0xcf, 0x2e, 0x84, 0xc7, 0x3e, 0x7e, 0xcc, 0x38, 0x78, 0xcf, 0x53, 0x0c, 0xae, 0xe7, 0x9e,
-	0x57, 0xb9, 0x66, 0x9c, 0x5b, 0x68, 0x48, 0xb6, 0x95, 0xf9, 0x84, 0x25, 0x71, 0xc8, 0x50, 0x48,
-	0x6d, 0x07, 0xa7, 0xd6, 0xab, 0x0d, 0x5a, 0xc3, 0x7b, 0x55, 0x35, 0x5f, 0x32, 0xc0, 0x37, 0xab,
-	0x8c, 0x52, 0x99, 0xca, 0x37, 0x93, 0xb5, 0x09, 0xd0, 0x7d, 0x42, 0x8a, 0x0b, 0xc0, 0xa9, 0x2b,
-	0xf5, 0x9d, 0x2a, 0x75, 0x11, 0x36, 0xc6, 0x52, 0x94, 0xbe, 0x20, 0xad, 0x90, 0x27, 0x3c, 0x62,
-	0x18, 0x8b, 0x14, 0x9c, 0x0d, 0x65, 0xea, 0x57, 0x99, 0x9e, 0x16, 0xa8, 0x51, 0x95, 0xc3, 0xf4,
-	0x3d, 0xd9, 0x9e, 0xa7, 0x53, 0x91, 0x86, 0x71, 0x1a, 0x4d, 0xca, 0xd6, 0x86, 0xb2, 0xde, 0xaf,
-	0xb2, 0xbe, 0x5e, 0x85, 0xd6, 0xf4, 0xed, 0xf9, 0xfa, 0x08, 0xe8, 0x98, 0x5c, 0x93, 0xbc, 0xec,
-	0xdf, 0x54, 0xfe, 0x9d, 0x2a, 0x7f, 0x50, 0x82, 0x8d, 0xf8, 0x6f, 0x01, 0xed, 0x90, 0x26, 0x3f,
-	0xcd, 0x84, 0x44, 0x1e, 0x3a, 0xcd, 0x9e, 0x3d, 0x68, 0x06, 0xc5, 0xb9, 0x7f, 0x44, 0xe8, 0xfa,
-	0xb7, 0xa1, 0x43, 0xb2, 0xc9, 0xc2, 0x50, 0x72, 0xd0, 0xfb, 0x77, 0x75, 0xe4, 0x7c, 0xfd, 0xb2,
-	0xdb, 0x36, 0x05, 0x9e, 0xe8, 0xc9, 0x01, 0xca, 0x38, 0x8d, 0x82, 0x15, 0x48, 0xdb, 0x64, 0xe3,
-	0xcf, 0xa6, 0xd5, 0x02, 0x7d, 0x78, 0xd4, 0xfc, 0x74, 0xd6, 0xb5, 0x7e, 0x9e, 0x75, 0xad, 0xd1,
-	0xb3, 0xf3, 0x85, 0x6b, 0x5f, 0x2c, 0x5c, 0xfb, 0xc7, 0xc2, 0xb5, 0x3f, 0x2f, 0x5d, 0xeb, 0x62,
-	0xe9, 0x5a, 0xdf, 0x96, 0xae, 0xf5, 0xee, 0xc1, 0x7f, 0x97, 0xf1, 0xb4, 0x78, 0x56, 0x6a, 0x2d,
-	0xa7, 0x0d, 0xf5, 0x64, 0x1e, 0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x73, 0xbd, 0x93, 0xb3, 0xc9,
-	0x03, 0x00, 0x00,
+	// 574 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x41, 0x6f, 0x12, 0x4f,
+	0x18, 0xc6, 0x77, 0xff, 0x50, 0xa0, 0x43, 0xff, 0xc6, 0x8c, 0xb4, 0x6e, 0x49, 0x5c, 0x90, 0x34,
+	0x86, 0xa8, 0x5d, 0x52, 0xbc, 0x19, 0x0f, 0x4a, 0x8c, 0x4d, 0x4d, 0x0f, 0x64, 0xa9, 0xc6, 0x78,
+	0xd9, 0x0c, 0xcc, 0xb8, 0x4c, 0x58, 0x76, 0x70, 0x66, 0xa8, 0xd5, 0x4f, 0xe0, 0x4d, 0x3f, 0x42,
+	0x3f, 0x84, 0x1f, 0xa2, 0xc7, 0xc6, 0x93, 0xf1, 0xd0, 0x18, 0xb8, 0xf8, 0x31, 0xcc, 0xce, 0x0c,
+	0x88, 0x6e, 0xb7, 0x07, 0x4f, 0x30, 0x79, 0x9f, 0xe7, 0xf7, 0x3e, 0xef, 0xe6, 0x9d, 0x01, 0x3b,
+	0x03, 0x26, 0xc6, 0x4c, 0xb4, 0x84, 0x44, 0x23, 0x1a, 0x87, 0xad, 0xe3, 0xbd, 0x3e, 0x91, 0x68,
+	0xaf, 0x15, 0x92, 0x98, 0x08, 0x2a, 0xbc, 0x09, 0x67, 0x92, 0xc1, 0x2d, 0xad, 0xf2, 0x8c, 0xca,
+	0x33, 0xaa, 0x6a, 0x25, 0x64, 0x21, 0x53, 0x92, 0x56, 0xf2, 0x4f, 0xab, 0xab, 0x59, 0xcc, 0x85,
+	0x5b, 0xab, 0xb6, 0xb5, 0x2a, 0xd0, 0x76, 0xd3, 0x40, 0x1d, 0x1a, 0x9f, 0x8a, 0x60, 0x63, 0x5f,
+	0x07, 0xe8, 0x49, 0x24, 0x09, 0x7c, 0x04, 0x0a, 0x13, 0xc4, 0xd1, 0x58, 0x38, 0x76, 0xdd, 0x6e,
+	0x96, 0xdb, 0xae, 0x77, 0x79, 0x20, 0xaf, 0xab, 0x54, 0x9d, 0xfc, 0xd9, 0x45, 0xcd, 0xf2, 0x8d,
+	0x07, 0xbe, 0x02, 0xd7, 0x23, 0x24, 0x64, 0x20, 0x99, 0x44, 0x51, 0x30, 0x61, 0xef, 0x08, 0x77,
+	0xfe, 0xab, 0xdb, 0xcd, 0x8d, 0x8e, 0x97, 0xe8, 0xbe, 0x5f, 0xd4, 0xee, 0x84, 0x54, 0x0e, 0xa7,
+	0x7d, 0x6f, 0xc0, 0xc6, 0x26, 0x89, 0xf9, 0xd9, 0x15, 0x78, 0xd4, 0x92, 0xef, 0x27, 0x44, 0x78,
+	0x07, 0xb1, 0xf4, 0xaf, 0x25, 0x9c, 0xa3, 0x04, 0xd3, 0x4d, 0x28, 0x10, 0x83, 0x4d, 0x45, 0x3e,
+	0x46, 0x11, 0xc5, 0x48, 0x32, 0xae, 0xe9, 0xc2, 0xc9, 0xd5, 0x73, 0xcd, 0x72, 0xfb, 0x6e, 0x56,
+	0xcc, 0x43, 0x24, 0xe4, 0xcb, 0x85, 0x47, 0xa1, 0x4c, 0xe4, 0x1b, 0x51, 0xaa, 0x22, 0xe0, 0x3e,
+	0x00, 0xcb, 0x06, 0xc2, 0xc9, 0x2b, 0xf4, 0xed, 0x2c, 0xf4, 0xd2, 0x6c, 0x88, 0x2b, 0x56, 0xf8,
+	0x1c, 0x94, 0x31, 0x89, 0x48, 0x88, 0x24, 0x65, 0xb1, 0x70, 0xd6, 0x14, 0xa9, 0x91, 0x45, 0x7a,
+	0xba, 0x94, 0x1a, 0xd4, 0xaa, 0x19, 0xbe, 0x01, 0x9b, 0xd3, 0xb8, 0xcf, 0x62, 0x4c, 0xe3, 0x30,
+	0x58, 0xa5, 0x16, 0x14, 0xf5, 0x5e, 0x16, 0xf5, 0xc5, 0xc2, 0x94, 0xc2, 0x57, 0xa6, 0xe9, 0x92,
+	0x80, 0x5d, 0xf0, 0x3f, 0x27, 0xab, 0xfc, 0xa2, 0xe2, 0xef, 0x64, 0xf1, 0x7d, 0x82, 0xff, 0x06,
+	0xff, 0x09, 0x80, 0x55, 0x50, 0x22, 0x27, 0x13, 0xc6, 0x25, 0xc1, 0x4e, 0xa9, 0x6e, 0x37, 0x4b,
+	0xfe, 0xf2, 0x0c, 0x43, 0xb0, 0x25, 0xd9, 0x88, 0xc4, 0xf4, 0x03, 0x09, 0xc4, 0x10, 0x71, 0x12,
+	0x70, 0x32, 0x60, 0x1c, 0x0b, 0x67, 0xfd, 0xea, 0xb1, 0x8e, 0x8c, 0xab, 0x97, 0x98, 0x7c, 0xe5,
+	0x59, 0x8c, 0x25, 0xd3, 0x25, 0x01, 0x1f, 0x83, 0x5b, 0x66, 0x27, 0x2f, 0xe9, 0x16, 0x50, 0xec,
+	0x80, 0xba, 0xdd, 0xcc, 0xfb, 0xdb, 0x7a, 0xe1, 0x52, 0x80, 0x03, 0x0c, 0x47, 0xa0, 0xaa, 0x17,
+	0x3a, 0xa2, 0x6f, 0xa7, 0x14, 0x07, 0x49, 0x22, 0x82, 0x35, 0x50, 0x38, 0xe5, 0x7f, 0xda, 0xef,
+	0x9b, 0x8a, 0x78, 0xa8, 0x80, 0x3d, 0xc5, 0x53, 0xbd, 0x45, 0x63, 0x08, 0x60, 0x7a, 0x67, 0x61,
+	0x1b, 0x14, 0x11, 0xc6, 0x9c, 0x08, 0x7d, 0x2f, 0xd7, 0x3b, 0xce, 0xd7, 0x2f, 0xbb, 0x15, 0xf3,
+	0x85, 0x9e, 0xe8, 0x4a, 0x4f, 0x72, 0x1a, 0x87, 0xfe, 0x42, 0x08, 0x2b, 0x60, 0xed, 0xf7, 0x0d,
+	0xcc, 0xf9, 0xfa, 0xf0, 0xb0, 0xf4, 0xf1, 0xb4, 0x66, 0xfd, 0x3c, 0xad, 0x59, 0x9d, 0x67, 0x67,
+	0x33, 0xd7, 0x3e, 0x9f, 0xb9, 0xf6, 0x8f, 0x99, 0x6b, 0x7f, 0x9e, 0xbb, 0xd6, 0xf9, 0xdc, 0xb5,
+	0xbe, 0xcd, 0x5d, 0xeb, 0xf5, 0xfd, 0x2b, 0x87, 0x38, 0x59, 0x3e, 0x37, 0x6a, 0x9c, 0x7e, 0x41,
+	0x3d, 0x25, 0x0f, 0x7e, 0x0d, 0x00, 0x62, 0xf1, 0x6e, 0xe1, 0xe1, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -233,6 +266,35 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.TotalLiquidStakedTokens.Size()
+		i -= size
+		if _, err := m.TotalLiquidStakedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.LastTokenizeShareRecordId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastTokenizeShareRecordId))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for iNdEx := len(m.TokenizeShareRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenizeShareRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.Exported {
 		i--
 		if m.Exported {
@@ -425,6 +487,17 @@ func (m *GenesisState) Size() (n int) {
 	if m.Exported {
 		n += 2
 	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for _, e := range m.TokenizeShareRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastTokenizeShareRecordId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastTokenizeShareRecordId))
+	}
+	l = m.TotalLiquidStakedTokens.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -735,6 +808,92 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Exported = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeShareRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenizeShareRecords = append(m.TokenizeShareRecords, TokenizeShareRecord{})
+			if err := m.TokenizeShareRecords[len(m.TokenizeShareRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTokenizeShareRecordId", wireType)
+			}
+			m.LastTokenizeShareRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTokenizeShareRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidStakedTokens", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalLiquidStakedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -49,6 +49,13 @@ var (
 	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
 
 	HistoricalInfoKey = []byte{0x50} // prefix for the historical info
+	ParamsKey         = []byte{0x51} // prefix for parameters for module x/staking
+
+	TokenizeShareRecordPrefix          = []byte{0x61} // key for tokenize share records
+	TokenizeShareRecordIDByOwnerPrefix = []byte{0x62} // prefix for each key for a tokenize share record, by owner
+	TokenizeShareRecordIDByDenomPrefix = []byte{0x63} // key for a tokenize share record id, by share token denom
+	LastTokenizeShareRecordIDKey       = []byte{0x64} // key for the last tokenize share record id
+	TotalLiquidStakedTokensKey         = []byte{0x65} // key for the total liquid staked tokens
 )
 
 // GetValidatorKey creates the key for the validator with address
@@ -348,3 +355,26 @@ func GetREDsByDelToValDstIndexKey(delAddr sdk.AccAddress, valDstAddr sdk.ValAddr
 func GetHistoricalInfoKey(height int64) []byte {
 	return append(HistoricalInfoKey, []byte(strconv.FormatInt(height, 10))...)
 }
+
+// GetTokenizeShareRecordByIndexKey returns the key of a tokenize share record.
+func GetTokenizeShareRecordByIndexKey(id uint64) []byte {
+	return append(TokenizeShareRecordPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordIDsByOwnerPrefix returns the key prefix of the
+// tokenize share records owned by an address.
+func GetTokenizeShareRecordIDsByOwnerPrefix(owner sdk.AccAddress) []byte {
+	return append(TokenizeShareRecordIDByOwnerPrefix, address.MustLengthPrefix(owner)...)
+}
+
+// GetTokenizeShareRecordIDByOwnerAndIDKey returns the key indexing a tokenize
+// share record by its owner.
+func GetTokenizeShareRecordIDByOwnerAndIDKey(owner sdk.AccAddress, id uint64) []byte {
+	return append(GetTokenizeShareRecordIDsByOwnerPrefix(owner), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordIDByDenomKey returns the key indexing a tokenize share
+// record by its share token denom.
+func GetTokenizeShareRecordIDByDenomKey(denom string) []byte {
+	return append(TokenizeShareRecordIDByDenomPrefix, []byte(denom)...)
+}
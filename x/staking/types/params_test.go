@@ -35,4 +35,12 @@ func Test_validateParams(t *testing.T) {
 
 	params.MinCommissionRate = sdk.NewDec(2)
 	require.Error(t, params.Validate())
+
+	// validate validator bond factor
+	params = types.DefaultParams()
+	params.ValidatorBondFactor = sdk.NewDec(-2)
+	require.Error(t, params.Validate())
+
+	params.ValidatorBondFactor = sdk.ZeroDec()
+	require.NoError(t, params.Validate())
 }
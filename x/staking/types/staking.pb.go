@@ -336,6 +336,16 @@ type Validator struct {
 	//
 	// Since: cosmos-sdk 0.46
 	MinSelfDelegation github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=min_self_delegation,json=minSelfDelegation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_self_delegation"`
+	// liquid_shares defines the delegator shares of the validator held by
+	// tokenized share records and liquid staking providers.
+	//
+	// Since: cosmos-sdk 0.47
+	LiquidShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=liquid_shares,json=liquidShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquid_shares"`
+	// validator_bond_shares defines the delegator shares of the validator held
+	// by delegations flagged as validator bond.
+	//
+	// Since: cosmos-sdk 0.47
+	ValidatorBondShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=validator_bond_shares,json=validatorBondShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_bond_shares"`
 }
 
 func (m *Validator) Reset()      { *m = Validator{} }
@@ -596,6 +606,11 @@ type Delegation struct {
 	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 	// shares define the delegation shares received.
 	Shares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=shares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"shares"`
+	// validator_bond is true if the delegation counts towards the validator bond
+	// of the validator.
+	//
+	// Since: cosmos-sdk 0.47
+	ValidatorBond bool `protobuf:"varint,4,opt,name=validator_bond,json=validatorBond,proto3" json:"validator_bond,omitempty"`
 }
 
 func (m *Delegation) Reset()      { *m = Delegation{} }
@@ -848,6 +863,23 @@ type Params struct {
 	BondDenom string `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
 	// min_commission_rate is the chain-wide minimum commission rate that a validator can charge their delegators
 	MinCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_commission_rate" yaml:"min_commission_rate"`
+	// global_liquid_staking_cap is the maximum fraction of the total bonded tokens
+	// that can be held by tokenized share records and liquid staking providers.
+	//
+	// Since: cosmos-sdk 0.47
+	GlobalLiquidStakingCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=global_liquid_staking_cap,json=globalLiquidStakingCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"global_liquid_staking_cap"`
+	// validator_liquid_staking_cap is the maximum fraction of the delegator shares
+	// of a validator that can be held by tokenized share records and liquid
+	// staking providers.
+	//
+	// Since: cosmos-sdk 0.47
+	ValidatorLiquidStakingCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=validator_liquid_staking_cap,json=validatorLiquidStakingCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_liquid_staking_cap"`
+	// validator_bond_factor is the maximum multiple of the validator bond shares
+	// of a validator that can be delegated by liquid staking providers and
+	// tokenized share records. A value of -1 disables the cap.
+	//
+	// Since: cosmos-sdk 0.47
+	ValidatorBondFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=validator_bond_factor,json=validatorBondFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_bond_factor"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -1113,6 +1145,84 @@ func (m *Pool) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Pool proto.InternalMessageInfo
 
+// TokenizeShareRecord represents a delegation tokenized into transferable
+// share tokens. The delegation is held by the module account of the record
+// and its rewards belong to the owner of the record.
+//
+// Since: cosmos-sdk 0.47
+type TokenizeShareRecord struct {
+	// id is the unique identifier of the record.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address receiving the rewards of the tokenized delegation.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// module_account is the name of the module account holding the tokenized
+	// delegation.
+	ModuleAccount string `protobuf:"bytes,3,opt,name=module_account,json=moduleAccount,proto3" json:"module_account,omitempty"`
+	// validator is the operator address of the validator delegated to.
+	Validator string `protobuf:"bytes,4,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (m *TokenizeShareRecord) Reset()         { *m = TokenizeShareRecord{} }
+func (m *TokenizeShareRecord) String() string { return proto.CompactTextString(m) }
+func (*TokenizeShareRecord) ProtoMessage()    {}
+func (*TokenizeShareRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{20}
+}
+func (m *TokenizeShareRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeShareRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareRecord.Merge(m, src)
+}
+func (m *TokenizeShareRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareRecord proto.InternalMessageInfo
+
+func (m *TokenizeShareRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TokenizeShareRecord) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetModuleAccount() string {
+	if m != nil {
+		return m.ModuleAccount
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.staking.v1beta1.BondStatus", BondStatus_name, BondStatus_value)
 	proto.RegisterType((*HistoricalInfo)(nil), "cosmos.staking.v1beta1.HistoricalInfo")
@@ -1135,6 +1245,7 @@ func init() {
 	proto.RegisterType((*RedelegationEntryResponse)(nil), "cosmos.staking.v1beta1.RedelegationEntryResponse")
 	proto.RegisterType((*RedelegationResponse)(nil), "cosmos.staking.v1beta1.RedelegationResponse")
 	proto.RegisterType((*Pool)(nil), "cosmos.staking.v1beta1.Pool")
+	proto.RegisterType((*TokenizeShareRecord)(nil), "cosmos.staking.v1beta1.TokenizeShareRecord")
 }
 
 func init() {
@@ -1142,112 +1253,123 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 1669 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x4d, 0x6c, 0x1b, 0xc7,
-	0x15, 0xe6, 0x52, 0x0c, 0x45, 0x3d, 0x4a, 0xa2, 0x34, 0x56, 0x52, 0x9a, 0x68, 0x49, 0x96, 0x4d,
-	0x13, 0xa7, 0x88, 0xa9, 0x5a, 0x05, 0x02, 0x54, 0x28, 0x50, 0x98, 0x22, 0x53, 0xab, 0x4e, 0x5c,
-	0x86, 0x94, 0x55, 0xf4, 0x07, 0x5d, 0x0c, 0x77, 0x47, 0xd4, 0x54, 0xbb, 0xb3, 0xc4, 0xce, 0xd0,
-	0x15, 0x81, 0x16, 0x28, 0xd0, 0x4b, 0xea, 0x53, 0x8e, 0xb9, 0x18, 0x30, 0x90, 0x1e, 0x73, 0x0c,
-	0x7a, 0xe9, 0xa1, 0xd7, 0x34, 0x27, 0x23, 0xa7, 0xa6, 0x2d, 0xd4, 0xc2, 0xbe, 0x14, 0x3d, 0x15,
-	0xb9, 0xb7, 0x28, 0xe6, 0x67, 0x7f, 0x4c, 0x8a, 0x8a, 0x14, 0xa8, 0x40, 0x00, 0x5f, 0xec, 0x9d,
-	0x99, 0xf7, 0xbe, 0x79, 0xef, 0x7b, 0x3f, 0x7a, 0x43, 0x78, 0xd1, 0x09, 0xb8, 0x1f, 0xf0, 0x4d,
-	0x2e, 0xf0, 0x11, 0x65, 0xc3, 0xcd, 0x7b, 0x37, 0x06, 0x44, 0xe0, 0x1b, 0xd1, 0xba, 0x39, 0x0a,
-	0x03, 0x11, 0xa0, 0x17, 0xb4, 0x54, 0x33, 0xda, 0x35, 0x52, 0x95, 0x8d, 0x61, 0x30, 0x0c, 0x94,
-	0xc8, 0xa6, 0xfc, 0xd2, 0xd2, 0x95, 0xab, 0xc3, 0x20, 0x18, 0x7a, 0x64, 0x53, 0xad, 0x06, 0xe3,
-	0x83, 0x4d, 0xcc, 0x26, 0xe6, 0xa8, 0x3a, 0x7d, 0xe4, 0x8e, 0x43, 0x2c, 0x68, 0xc0, 0xcc, 0x79,
-	0x6d, 0xfa, 0x5c, 0x50, 0x9f, 0x70, 0x81, 0xfd, 0x51, 0x84, 0xad, 0x2d, 0xb1, 0xf5, 0xa5, 0xc6,
-	0x2c, 0x83, 0x6d, 0x5c, 0x19, 0x60, 0x4e, 0x62, 0x3f, 0x9c, 0x80, 0x46, 0xd8, 0x5f, 0x16, 0x84,
-	0xb9, 0x24, 0xf4, 0x29, 0x13, 0x9b, 0x62, 0x32, 0x22, 0x5c, 0xff, 0xab, 0x4f, 0x1b, 0xbf, 0xb5,
-	0x60, 0xf5, 0x16, 0xe5, 0x22, 0x08, 0xa9, 0x83, 0xbd, 0x5d, 0x76, 0x10, 0xa0, 0xd7, 0x20, 0x7f,
-	0x48, 0xb0, 0x4b, 0xc2, 0xb2, 0x55, 0xb7, 0xae, 0x15, 0xb7, 0xca, 0xcd, 0x04, 0xa1, 0xa9, 0x75,
-	0x6f, 0xa9, 0xf3, 0x56, 0xee, 0xc3, 0x93, 0x5a, 0xa6, 0x67, 0xa4, 0xd1, 0x77, 0x21, 0x7f, 0x0f,
-	0x7b, 0x9c, 0x88, 0x72, 0xb6, 0xbe, 0x70, 0xad, 0xb8, 0xf5, 0xd5, 0xe6, 0xe9, 0xf4, 0x35, 0xf7,
-	0xb1, 0x47, 0x5d, 0x2c, 0x82, 0x18, 0x40, 0xab, 0x35, 0xde, 0xcf, 0x42, 0x69, 0x27, 0xf0, 0x7d,
-	0xca, 0x39, 0x0d, 0x58, 0x0f, 0x0b, 0xc2, 0x51, 0x17, 0x72, 0x21, 0x16, 0x44, 0x99, 0xb2, 0xd4,
-	0xfa, 0x8e, 0x94, 0xff, 0xcb, 0x49, 0xed, 0xa5, 0x21, 0x15, 0x87, 0xe3, 0x41, 0xd3, 0x09, 0x7c,
-	0x43, 0x86, 0xf9, 0xef, 0x3a, 0x77, 0x8f, 0x8c, 0x7f, 0x6d, 0xe2, 0x7c, 0xfc, 0xc1, 0x75, 0x30,
-	0x36, 0xb4, 0x89, 0xd3, 0x53, 0x48, 0xe8, 0x87, 0x50, 0xf0, 0xf1, 0xb1, 0xad, 0x50, 0xb3, 0x97,
-	0x80, 0xba, 0xe8, 0xe3, 0x63, 0x69, 0x2b, 0x72, 0xa1, 0x24, 0x81, 0x9d, 0x43, 0xcc, 0x86, 0x44,
-	0xe3, 0x2f, 0x5c, 0x02, 0xfe, 0x8a, 0x8f, 0x8f, 0x77, 0x14, 0xa6, 0xbc, 0x65, 0xbb, 0xf0, 0xee,
-	0xc3, 0x5a, 0xe6, 0x9f, 0x0f, 0x6b, 0x56, 0xe3, 0x0f, 0x16, 0x40, 0x42, 0x17, 0xfa, 0x29, 0xac,
-	0x39, 0xf1, 0x4a, 0x5d, 0xcf, 0x4d, 0x00, 0x5f, 0x9e, 0x17, 0x88, 0x29, 0xb2, 0x5b, 0x05, 0x69,
-	0xe8, 0xa3, 0x93, 0x9a, 0xd5, 0x2b, 0x39, 0x53, 0x71, 0xe8, 0x40, 0x71, 0x3c, 0x72, 0xb1, 0x20,
-	0xb6, 0x4c, 0x4d, 0x45, 0x5c, 0x71, 0xab, 0xd2, 0xd4, 0x79, 0xdb, 0x8c, 0xf2, 0xb6, 0xb9, 0x17,
-	0xe5, 0xad, 0xc6, 0x7a, 0xe7, 0xef, 0x35, 0xab, 0x07, 0x5a, 0x51, 0x1e, 0xa5, 0xac, 0x7f, 0xdf,
-	0x82, 0x62, 0x9b, 0x70, 0x27, 0xa4, 0x23, 0x59, 0x08, 0xa8, 0x0c, 0x8b, 0x7e, 0xc0, 0xe8, 0x91,
-	0x49, 0xbb, 0xa5, 0x5e, 0xb4, 0x44, 0x15, 0x28, 0x50, 0x97, 0x30, 0x41, 0xc5, 0x44, 0x07, 0xac,
-	0x17, 0xaf, 0xa5, 0xd6, 0x2f, 0xc8, 0x80, 0xd3, 0x88, 0xeb, 0x5e, 0xb4, 0x44, 0xaf, 0xc0, 0x1a,
-	0x27, 0xce, 0x38, 0xa4, 0x62, 0x62, 0x3b, 0x01, 0x13, 0xd8, 0x11, 0xe5, 0x9c, 0x12, 0x29, 0x45,
-	0xfb, 0x3b, 0x7a, 0x5b, 0x82, 0xb8, 0x44, 0x60, 0xea, 0xf1, 0xf2, 0x73, 0x1a, 0xc4, 0x2c, 0x53,
-	0xe6, 0xfe, 0x29, 0x0f, 0x4b, 0x71, 0xde, 0xa2, 0x1d, 0x58, 0x0b, 0x46, 0x24, 0x94, 0xdf, 0x36,
-	0x76, 0xdd, 0x90, 0x70, 0x6e, 0x32, 0xb4, 0xfc, 0xf1, 0x07, 0xd7, 0x37, 0x0c, 0xdd, 0x37, 0xf5,
-	0x49, 0x5f, 0x84, 0x94, 0x0d, 0x7b, 0xa5, 0x48, 0xc3, 0x6c, 0xa3, 0x1f, 0xc9, 0x80, 0x31, 0x4e,
-	0x18, 0x1f, 0x73, 0x7b, 0x34, 0x1e, 0x1c, 0x91, 0x89, 0xe1, 0x75, 0x63, 0x86, 0xd7, 0x9b, 0x6c,
-	0xd2, 0x2a, 0x7f, 0x94, 0x40, 0x3b, 0xe1, 0x64, 0x24, 0x82, 0x66, 0x77, 0x3c, 0xb8, 0x4d, 0x26,
-	0x32, 0x5a, 0x06, 0xa7, 0xab, 0x60, 0xd0, 0x0b, 0x90, 0xff, 0x39, 0xa6, 0x1e, 0x71, 0x15, 0x2b,
-	0x85, 0x9e, 0x59, 0xa1, 0x6d, 0xc8, 0x73, 0x81, 0xc5, 0x98, 0x2b, 0x2a, 0x56, 0xb7, 0x1a, 0xf3,
-	0x32, 0xa3, 0x15, 0x30, 0xb7, 0xaf, 0x24, 0x7b, 0x46, 0x03, 0xed, 0x41, 0x5e, 0x04, 0x47, 0x84,
-	0x19, 0x92, 0x2e, 0x94, 0xd5, 0xbb, 0x4c, 0xa4, 0xb2, 0x7a, 0x97, 0x89, 0x9e, 0xc1, 0x42, 0x43,
-	0x58, 0x73, 0x89, 0x47, 0x86, 0x8a, 0x4a, 0x7e, 0x88, 0x43, 0xc2, 0xcb, 0xf9, 0x4b, 0xa8, 0x9a,
-	0x52, 0x8c, 0xda, 0x57, 0xa0, 0xe8, 0x36, 0x14, 0xdd, 0x24, 0xdd, 0xca, 0x8b, 0x8a, 0xe8, 0xaf,
-	0xcd, 0xf3, 0x3f, 0x95, 0x99, 0xa6, 0x49, 0xa5, 0xb5, 0x65, 0x72, 0x8d, 0xd9, 0x20, 0x60, 0x2e,
-	0x65, 0x43, 0xfb, 0x90, 0xd0, 0xe1, 0xa1, 0x28, 0x17, 0xea, 0xd6, 0xb5, 0x85, 0x5e, 0x29, 0xde,
-	0xbf, 0xa5, 0xb6, 0xd1, 0x6d, 0x58, 0x4d, 0x44, 0x55, 0xed, 0x2c, 0x5d, 0xa0, 0x76, 0x56, 0x62,
-	0x5d, 0x79, 0x8a, 0x6e, 0x01, 0x24, 0x85, 0x59, 0x06, 0x05, 0xd4, 0xf8, 0xec, 0xea, 0x36, 0x2e,
-	0xa4, 0x74, 0x91, 0x07, 0x57, 0x7c, 0xca, 0x6c, 0x4e, 0xbc, 0x03, 0xdb, 0x50, 0x25, 0x21, 0x8b,
-	0x97, 0x10, 0xda, 0x75, 0x9f, 0xb2, 0x3e, 0xf1, 0x0e, 0xda, 0x31, 0xec, 0xf6, 0xf2, 0xdb, 0x0f,
-	0x6b, 0x19, 0x53, 0x4b, 0x99, 0x46, 0x17, 0x96, 0xf7, 0xb1, 0x67, 0xca, 0x80, 0x70, 0xf4, 0x1a,
-	0x2c, 0xe1, 0x68, 0x51, 0xb6, 0xea, 0x0b, 0x67, 0x96, 0x51, 0x22, 0xaa, 0xab, 0xf3, 0xd7, 0x7f,
-	0xab, 0x5b, 0x8d, 0xdf, 0x59, 0x90, 0x6f, 0xef, 0x77, 0x31, 0x0d, 0x51, 0x07, 0xd6, 0x93, 0x84,
-	0x3a, 0x6f, 0x6d, 0x26, 0x39, 0x18, 0x15, 0x67, 0x07, 0xd6, 0xef, 0x45, 0xe5, 0x1e, 0xc3, 0x64,
-	0x3f, 0x0b, 0x26, 0x56, 0x31, 0xfb, 0x53, 0x8e, 0x77, 0x60, 0x51, 0x5b, 0xc9, 0xd1, 0x36, 0x3c,
-	0x37, 0x92, 0x1f, 0xca, 0xdf, 0xe2, 0x56, 0x75, 0x6e, 0x22, 0x2a, 0x79, 0x13, 0x40, 0xad, 0xd2,
-	0xf8, 0x8f, 0x05, 0xd0, 0xde, 0xdf, 0xdf, 0x0b, 0xe9, 0xc8, 0x23, 0xe2, 0xb2, 0x3c, 0x7e, 0x03,
-	0x9e, 0x4f, 0x3c, 0xe6, 0xa1, 0x73, 0x6e, 0xaf, 0xaf, 0xc4, 0x6a, 0xfd, 0xd0, 0x39, 0x15, 0xcd,
-	0xe5, 0x22, 0x46, 0x5b, 0x38, 0x37, 0x5a, 0x9b, 0x8b, 0xd3, 0x69, 0xec, 0x43, 0x31, 0x71, 0x9f,
-	0xa3, 0x36, 0x14, 0x84, 0xf9, 0x36, 0x6c, 0x36, 0xe6, 0xb3, 0x19, 0xa9, 0x19, 0x46, 0x63, 0xcd,
-	0xc6, 0x7f, 0x25, 0xa9, 0x71, 0xc6, 0x7e, 0xb1, 0xd2, 0x48, 0xf6, 0x5e, 0xd3, 0x1b, 0x2f, 0x63,
-	0xa2, 0x30, 0x58, 0x53, 0xac, 0xfe, 0x26, 0x0b, 0x57, 0xee, 0x46, 0xdd, 0xe6, 0x0b, 0xcb, 0x44,
-	0x17, 0x16, 0x09, 0x13, 0x21, 0x55, 0x54, 0xc8, 0x58, 0x7f, 0x73, 0x5e, 0xac, 0x4f, 0xf1, 0xa5,
-	0xc3, 0x44, 0x38, 0x31, 0x91, 0x8f, 0x60, 0xa6, 0x58, 0xf8, 0x6b, 0x16, 0xca, 0xf3, 0x34, 0xd1,
-	0xcb, 0x50, 0x72, 0x42, 0xa2, 0x36, 0xa2, 0xae, 0x6f, 0xa9, 0xae, 0xbf, 0x1a, 0x6d, 0x9b, 0xa6,
-	0xff, 0x26, 0xc8, 0x01, 0x4a, 0x26, 0x96, 0x14, 0xbd, 0xf0, 0xc4, 0xb4, 0x9a, 0x28, 0xab, 0xb6,
-	0x4f, 0xa0, 0x44, 0x19, 0x15, 0x14, 0x7b, 0xf6, 0x00, 0x7b, 0x98, 0x39, 0x9f, 0x67, 0xb2, 0x9c,
-	0x6d, 0xd4, 0xab, 0x06, 0xb4, 0xa5, 0x31, 0xd1, 0x3e, 0x2c, 0x46, 0xf0, 0xb9, 0x4b, 0x80, 0x8f,
-	0xc0, 0x52, 0x53, 0xd4, 0x27, 0x59, 0x58, 0xef, 0x11, 0xf7, 0xd9, 0xa2, 0xf5, 0x27, 0x00, 0xba,
-	0xe0, 0x64, 0x1f, 0xfc, 0x1c, 0xcc, 0xce, 0x16, 0xf0, 0x92, 0xc6, 0x6b, 0x73, 0x91, 0xe2, 0xf6,
-	0xa3, 0x2c, 0x2c, 0xa7, 0xb9, 0x7d, 0x06, 0xfe, 0x2e, 0xa0, 0xdd, 0xa4, 0x1b, 0xe4, 0x54, 0x37,
-	0x78, 0x65, 0x5e, 0x37, 0x98, 0xc9, 0xba, 0xb3, 0xdb, 0xc0, 0xa7, 0x59, 0xc8, 0x77, 0x71, 0x88,
-	0x7d, 0x8e, 0xbe, 0x3f, 0x33, 0xc0, 0xe9, 0x57, 0xd5, 0xd5, 0x99, 0x9c, 0x6b, 0x9b, 0x47, 0xbd,
-	0x4e, 0xb9, 0x77, 0x4f, 0x99, 0xdf, 0xbe, 0x0e, 0xab, 0xf2, 0x89, 0x18, 0xbb, 0xa2, 0x49, 0x5c,
-	0x51, 0x6f, 0xbc, 0xf8, 0x75, 0xc1, 0x51, 0x0d, 0x8a, 0x52, 0x2c, 0x69, 0x74, 0x52, 0x06, 0x7c,
-	0x7c, 0xdc, 0xd1, 0x3b, 0xe8, 0x3a, 0xa0, 0xc3, 0xf8, 0xd1, 0x6e, 0x27, 0x14, 0x48, 0xb9, 0xf5,
-	0xe4, 0x24, 0x12, 0xff, 0x0a, 0x80, 0xb4, 0xc2, 0x76, 0x09, 0x0b, 0x7c, 0xf3, 0xc6, 0x59, 0x92,
-	0x3b, 0x6d, 0xb9, 0x81, 0x7e, 0xa9, 0x67, 0xc1, 0xa9, 0xd7, 0xa3, 0x19, 0xc3, 0xdf, 0xb8, 0x58,
-	0xa6, 0x7e, 0x7a, 0x52, 0xab, 0x4c, 0xb0, 0xef, 0x6d, 0x37, 0x4e, 0x81, 0x6c, 0xa8, 0xd9, 0xf0,
-	0xe9, 0x57, 0x67, 0x2a, 0x83, 0xdf, 0xb3, 0x00, 0x25, 0x2d, 0xb7, 0x47, 0xf8, 0x48, 0x3e, 0x6b,
-	0xe4, 0xd0, 0x9b, 0x9a, 0x50, 0xad, 0xb3, 0x87, 0xde, 0x44, 0x3f, 0x1a, 0x7a, 0x53, 0x15, 0xf1,
-	0xed, 0xa4, 0xc1, 0x65, 0x4d, 0x0c, 0x0d, 0xcc, 0x00, 0x73, 0x92, 0x1a, 0x9c, 0x69, 0xa4, 0x3d,
-	0xd3, 0xc3, 0x32, 0x8d, 0x4f, 0x2c, 0xb8, 0x3a, 0x93, 0x4d, 0xb1, 0xb1, 0x3f, 0x03, 0x14, 0xa6,
-	0x0e, 0x55, 0x6c, 0x26, 0xc6, 0xe8, 0x0b, 0x27, 0xe7, 0x7a, 0x38, 0xd3, 0x2b, 0xff, 0x5f, 0x3d,
-	0x3a, 0xa7, 0x22, 0xf0, 0x47, 0x0b, 0x36, 0xd2, 0xc6, 0xc4, 0x6e, 0xdd, 0x81, 0xe5, 0xb4, 0x2d,
-	0xc6, 0xa1, 0x17, 0xcf, 0xe3, 0x90, 0xf1, 0xe5, 0x29, 0x7d, 0xf4, 0x56, 0x52, 0xb8, 0xfa, 0xc7,
-	0xa2, 0x1b, 0xe7, 0xe6, 0x26, 0xb2, 0x69, 0xba, 0x80, 0x73, 0xd1, 0x14, 0x93, 0xeb, 0x06, 0x81,
-	0x87, 0x7e, 0x05, 0xeb, 0x2c, 0x10, 0xb6, 0xcc, 0x72, 0xe2, 0xda, 0xe6, 0xe5, 0xaa, 0xbb, 0xdf,
-	0x5b, 0x17, 0xa3, 0xec, 0x5f, 0x27, 0xb5, 0x59, 0xa8, 0x29, 0x1e, 0x4b, 0x2c, 0x10, 0x2d, 0x75,
-	0xbe, 0xa7, 0xdf, 0xb5, 0x21, 0xac, 0x3c, 0x7d, 0xb5, 0xee, 0x96, 0x6f, 0x5e, 0xf8, 0xea, 0x95,
-	0xb3, 0xae, 0x5d, 0x1e, 0xa4, 0xee, 0xdc, 0x2e, 0xc8, 0x18, 0xfe, 0xfb, 0x61, 0xcd, 0xfa, 0xc6,
-	0xef, 0x2d, 0x80, 0xe4, 0x09, 0x8f, 0x5e, 0x85, 0x2f, 0xb5, 0x7e, 0x70, 0xa7, 0x6d, 0xf7, 0xf7,
-	0x6e, 0xee, 0xdd, 0xed, 0xdb, 0x77, 0xef, 0xf4, 0xbb, 0x9d, 0x9d, 0xdd, 0xd7, 0x77, 0x3b, 0xed,
-	0xb5, 0x4c, 0xa5, 0x74, 0xff, 0x41, 0xbd, 0x78, 0x97, 0xf1, 0x11, 0x71, 0xe8, 0x01, 0x25, 0x2e,
-	0x7a, 0x09, 0x36, 0x9e, 0x96, 0x96, 0xab, 0x4e, 0x7b, 0xcd, 0xaa, 0x2c, 0xdf, 0x7f, 0x50, 0x2f,
-	0xe8, 0xe9, 0x88, 0xb8, 0xe8, 0x1a, 0x3c, 0x3f, 0x2b, 0xb7, 0x7b, 0xe7, 0x7b, 0x6b, 0xd9, 0xca,
-	0xca, 0xfd, 0x07, 0xf5, 0xa5, 0x78, 0x8c, 0x42, 0x0d, 0x40, 0x69, 0x49, 0x83, 0xb7, 0x50, 0x81,
-	0xfb, 0x0f, 0xea, 0x79, 0x4d, 0x5b, 0x25, 0xf7, 0xf6, 0x7b, 0xd5, 0x4c, 0xeb, 0xf5, 0x0f, 0x1f,
-	0x57, 0xad, 0x47, 0x8f, 0xab, 0xd6, 0x3f, 0x1e, 0x57, 0xad, 0x77, 0x9e, 0x54, 0x33, 0x8f, 0x9e,
-	0x54, 0x33, 0x7f, 0x7e, 0x52, 0xcd, 0xfc, 0xf8, 0xd5, 0x33, 0x19, 0x3b, 0x8e, 0x7f, 0xc9, 0x55,
-	0xdc, 0x0d, 0xf2, 0xaa, 0x29, 0x7f, 0xeb, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x49, 0x0e, 0x8f,
-	0x94, 0xe8, 0x15, 0x00, 0x00,
+	// 1853 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4b, 0x6c, 0x1b, 0xc7,
+	0x19, 0xe6, 0x52, 0x34, 0x45, 0xfd, 0x14, 0x45, 0x69, 0xac, 0xb8, 0x2b, 0x22, 0x15, 0x59, 0x36,
+	0x0f, 0xa7, 0x88, 0xa9, 0x5a, 0x05, 0x02, 0x54, 0x28, 0x50, 0x98, 0xa2, 0x5c, 0xab, 0x76, 0x5c,
+	0x66, 0x29, 0xab, 0xe8, 0x03, 0x5d, 0x0c, 0x77, 0x47, 0xd4, 0x54, 0xcb, 0x5d, 0x76, 0x67, 0x68,
+	0x8b, 0x45, 0x03, 0x14, 0xe8, 0x25, 0xf5, 0x29, 0xc7, 0x5c, 0x5c, 0x18, 0x48, 0x7b, 0xcb, 0x31,
+	0xe8, 0xa1, 0x3d, 0xf4, 0x1a, 0xe4, 0x64, 0xe4, 0xd4, 0xb4, 0x81, 0x5a, 0xd8, 0x97, 0xa2, 0xa7,
+	0xa2, 0xf7, 0x02, 0xc5, 0x3c, 0xf6, 0x21, 0x52, 0x92, 0xa5, 0x82, 0x05, 0x02, 0xe4, 0x62, 0x73,
+	0x66, 0xfe, 0xff, 0x9b, 0x99, 0xef, 0x7f, 0xce, 0x0a, 0x5e, 0x72, 0x02, 0xd6, 0x0f, 0xd8, 0x1a,
+	0xe3, 0xf8, 0x80, 0xfa, 0xbd, 0xb5, 0xfb, 0xd7, 0xbb, 0x84, 0xe3, 0xeb, 0xd1, 0xb8, 0x31, 0x08,
+	0x03, 0x1e, 0xa0, 0x2b, 0x4a, 0xaa, 0x11, 0xcd, 0x6a, 0xa9, 0xca, 0x72, 0x2f, 0xe8, 0x05, 0x52,
+	0x64, 0x4d, 0xfc, 0x52, 0xd2, 0x95, 0x95, 0x5e, 0x10, 0xf4, 0x3c, 0xb2, 0x26, 0x47, 0xdd, 0xe1,
+	0xde, 0x1a, 0xf6, 0x47, 0x7a, 0x69, 0x75, 0x7c, 0xc9, 0x1d, 0x86, 0x98, 0xd3, 0xc0, 0xd7, 0xeb,
+	0xd5, 0xf1, 0x75, 0x4e, 0xfb, 0x84, 0x71, 0xdc, 0x1f, 0x44, 0xd8, 0xea, 0x24, 0xb6, 0xda, 0x54,
+	0x1f, 0x4b, 0x63, 0xeb, 0xab, 0x74, 0x31, 0x23, 0xf1, 0x3d, 0x9c, 0x80, 0x46, 0xd8, 0x2f, 0x72,
+	0xe2, 0xbb, 0x24, 0xec, 0x53, 0x9f, 0xaf, 0xf1, 0xd1, 0x80, 0x30, 0xf5, 0xaf, 0x5a, 0xad, 0xff,
+	0xda, 0x80, 0x85, 0x5b, 0x94, 0xf1, 0x20, 0xa4, 0x0e, 0xf6, 0xb6, 0xfd, 0xbd, 0x00, 0xbd, 0x01,
+	0xf9, 0x7d, 0x82, 0x5d, 0x12, 0x9a, 0x46, 0xcd, 0xb8, 0x5a, 0x5c, 0x37, 0x1b, 0x09, 0x42, 0x43,
+	0xe9, 0xde, 0x92, 0xeb, 0xcd, 0xdc, 0x47, 0x47, 0xd5, 0x8c, 0xa5, 0xa5, 0xd1, 0xb7, 0x21, 0x7f,
+	0x1f, 0x7b, 0x8c, 0x70, 0x33, 0x5b, 0x9b, 0xb9, 0x5a, 0x5c, 0xff, 0x4a, 0xe3, 0x64, 0xfa, 0x1a,
+	0xbb, 0xd8, 0xa3, 0x2e, 0xe6, 0x41, 0x0c, 0xa0, 0xd4, 0xea, 0x1f, 0x64, 0xa1, 0xbc, 0x19, 0xf4,
+	0xfb, 0x94, 0x31, 0x1a, 0xf8, 0x16, 0xe6, 0x84, 0xa1, 0x36, 0xe4, 0x42, 0xcc, 0x89, 0x3c, 0xca,
+	0x5c, 0xf3, 0x5b, 0x42, 0xfe, 0x2f, 0x47, 0xd5, 0x57, 0x7a, 0x94, 0xef, 0x0f, 0xbb, 0x0d, 0x27,
+	0xe8, 0x6b, 0x32, 0xf4, 0x7f, 0xd7, 0x98, 0x7b, 0xa0, 0xef, 0xd7, 0x22, 0xce, 0x27, 0x1f, 0x5e,
+	0x03, 0x7d, 0x86, 0x16, 0x71, 0x2c, 0x89, 0x84, 0xbe, 0x0f, 0x85, 0x3e, 0x3e, 0xb4, 0x25, 0x6a,
+	0x76, 0x0a, 0xa8, 0xb3, 0x7d, 0x7c, 0x28, 0xce, 0x8a, 0x5c, 0x28, 0x0b, 0x60, 0x67, 0x1f, 0xfb,
+	0x3d, 0xa2, 0xf0, 0x67, 0xa6, 0x80, 0x5f, 0xea, 0xe3, 0xc3, 0x4d, 0x89, 0x29, 0x76, 0xd9, 0x28,
+	0xbc, 0xf7, 0xb8, 0x9a, 0xf9, 0xc7, 0xe3, 0xaa, 0x51, 0xff, 0xa3, 0x01, 0x90, 0xd0, 0x85, 0x7e,
+	0x0c, 0x8b, 0x4e, 0x3c, 0x92, 0xdb, 0x33, 0x6d, 0xc0, 0x57, 0x4f, 0x33, 0xc4, 0x18, 0xd9, 0xcd,
+	0x82, 0x38, 0xe8, 0x93, 0xa3, 0xaa, 0x61, 0x95, 0x9d, 0x31, 0x3b, 0x6c, 0x41, 0x71, 0x38, 0x70,
+	0x31, 0x27, 0xb6, 0x70, 0x4d, 0x49, 0x5c, 0x71, 0xbd, 0xd2, 0x50, 0x7e, 0xdb, 0x88, 0xfc, 0xb6,
+	0xb1, 0x13, 0xf9, 0xad, 0xc2, 0x7a, 0xf7, 0x6f, 0x55, 0xc3, 0x02, 0xa5, 0x28, 0x96, 0x52, 0xa7,
+	0xff, 0xc0, 0x80, 0x62, 0x8b, 0x30, 0x27, 0xa4, 0x03, 0x11, 0x08, 0xc8, 0x84, 0xd9, 0x7e, 0xe0,
+	0xd3, 0x03, 0xed, 0x76, 0x73, 0x56, 0x34, 0x44, 0x15, 0x28, 0x50, 0x97, 0xf8, 0x9c, 0xf2, 0x91,
+	0x32, 0x98, 0x15, 0x8f, 0x85, 0xd6, 0x03, 0xd2, 0x65, 0x34, 0xe2, 0xda, 0x8a, 0x86, 0xe8, 0x35,
+	0x58, 0x64, 0xc4, 0x19, 0x86, 0x94, 0x8f, 0x6c, 0x27, 0xf0, 0x39, 0x76, 0xb8, 0x99, 0x93, 0x22,
+	0xe5, 0x68, 0x7e, 0x53, 0x4d, 0x0b, 0x10, 0x97, 0x70, 0x4c, 0x3d, 0x66, 0x5e, 0x52, 0x20, 0x7a,
+	0x98, 0x3a, 0xee, 0x6f, 0x0a, 0x30, 0x17, 0xfb, 0x2d, 0xda, 0x84, 0xc5, 0x60, 0x40, 0x42, 0xf1,
+	0xdb, 0xc6, 0xae, 0x1b, 0x12, 0xc6, 0xb4, 0x87, 0x9a, 0x9f, 0x7c, 0x78, 0x6d, 0x59, 0xd3, 0x7d,
+	0x43, 0xad, 0x74, 0x78, 0x48, 0xfd, 0x9e, 0x55, 0x8e, 0x34, 0xf4, 0x34, 0xfa, 0x81, 0x30, 0x98,
+	0xcf, 0x88, 0xcf, 0x86, 0xcc, 0x1e, 0x0c, 0xbb, 0x07, 0x64, 0xa4, 0x79, 0x5d, 0x9e, 0xe0, 0xf5,
+	0x86, 0x3f, 0x6a, 0x9a, 0x1f, 0x27, 0xd0, 0x4e, 0x38, 0x1a, 0xf0, 0xa0, 0xd1, 0x1e, 0x76, 0x6f,
+	0x93, 0x91, 0x55, 0x8e, 0x71, 0xda, 0x12, 0x06, 0x5d, 0x81, 0xfc, 0x4f, 0x31, 0xf5, 0x88, 0x2b,
+	0x59, 0x29, 0x58, 0x7a, 0x84, 0x36, 0x20, 0xcf, 0x38, 0xe6, 0x43, 0x26, 0xa9, 0x58, 0x58, 0xaf,
+	0x9f, 0xe6, 0x19, 0xcd, 0xc0, 0x77, 0x3b, 0x52, 0xd2, 0xd2, 0x1a, 0x68, 0x07, 0xf2, 0x3c, 0x38,
+	0x20, 0xbe, 0x26, 0xe9, 0x42, 0x5e, 0xbd, 0xed, 0xf3, 0x94, 0x57, 0x6f, 0xfb, 0xdc, 0xd2, 0x58,
+	0xa8, 0x07, 0x8b, 0x2e, 0xf1, 0x48, 0x4f, 0x52, 0xc9, 0xf6, 0x71, 0x48, 0x98, 0x99, 0x9f, 0x42,
+	0xd4, 0x94, 0x63, 0xd4, 0x8e, 0x04, 0x45, 0xb7, 0xa1, 0xe8, 0x26, 0xee, 0x66, 0xce, 0x4a, 0xa2,
+	0xbf, 0x7a, 0xda, 0xfd, 0x53, 0x9e, 0xa9, 0x93, 0x54, 0x5a, 0x5b, 0x38, 0xd7, 0xd0, 0xef, 0x06,
+	0xbe, 0x4b, 0xfd, 0x9e, 0xbd, 0x4f, 0x68, 0x6f, 0x9f, 0x9b, 0x85, 0x9a, 0x71, 0x75, 0xc6, 0x2a,
+	0xc7, 0xf3, 0xb7, 0xe4, 0x34, 0xba, 0x0d, 0x0b, 0x89, 0xa8, 0x8c, 0x9d, 0xb9, 0x0b, 0xc4, 0x4e,
+	0x29, 0xd6, 0x15, 0xab, 0xe8, 0x16, 0x40, 0x12, 0x98, 0x26, 0x48, 0xa0, 0xfa, 0xf3, 0xa3, 0x5b,
+	0x5f, 0x21, 0xa5, 0x8b, 0x3c, 0xb8, 0xdc, 0xa7, 0xbe, 0xcd, 0x88, 0xb7, 0x67, 0x6b, 0xaa, 0x04,
+	0x64, 0x71, 0x0a, 0xa6, 0x5d, 0xea, 0x53, 0xbf, 0x43, 0xbc, 0xbd, 0x56, 0x0c, 0x8b, 0x30, 0x94,
+	0x3c, 0xfa, 0xb3, 0x21, 0x75, 0x23, 0x13, 0xcf, 0x4f, 0xc1, 0xc4, 0xf3, 0x0a, 0x52, 0xdb, 0x77,
+	0x00, 0x2f, 0xdc, 0x8f, 0xe2, 0xd3, 0x16, 0x9c, 0x45, 0x5b, 0x95, 0xa6, 0xb0, 0xd5, 0xe5, 0x18,
+	0x5a, 0x06, 0x86, 0x04, 0xde, 0x98, 0x7f, 0xe7, 0x71, 0x35, 0xa3, 0x13, 0x44, 0xa6, 0xde, 0x86,
+	0xf9, 0x5d, 0xec, 0xe9, 0xd8, 0x26, 0x0c, 0xbd, 0x01, 0x73, 0x38, 0x1a, 0x98, 0x46, 0x6d, 0xe6,
+	0xcc, 0xdc, 0x90, 0x88, 0xaa, 0x94, 0xf3, 0xcb, 0xcf, 0x6a, 0x46, 0xfd, 0xb7, 0x06, 0xe4, 0x5b,
+	0xbb, 0x6d, 0x4c, 0x43, 0xb4, 0x05, 0x4b, 0x49, 0x94, 0x9c, 0x37, 0xe1, 0x24, 0x81, 0xa5, 0xe7,
+	0x05, 0x4c, 0xc2, 0x51, 0x04, 0x93, 0x7d, 0x1e, 0x4c, 0xac, 0xa2, 0xe7, 0xc7, 0x2e, 0xbe, 0x05,
+	0xb3, 0xea, 0x94, 0x0c, 0x6d, 0xc0, 0xa5, 0x81, 0xf8, 0x21, 0xef, 0x5b, 0x5c, 0x5f, 0x3d, 0x35,
+	0xba, 0xa4, 0xbc, 0xf6, 0x4a, 0xa5, 0x52, 0xff, 0x8f, 0x01, 0xd0, 0xda, 0xdd, 0xdd, 0x09, 0xe9,
+	0xc0, 0x23, 0x7c, 0x5a, 0x37, 0xbe, 0x93, 0xf6, 0x0a, 0x16, 0x3a, 0xe7, 0xbe, 0x75, 0x62, 0xf1,
+	0x4e, 0xe8, 0x9c, 0x88, 0xe6, 0x32, 0x1e, 0xa3, 0xcd, 0x9c, 0x1b, 0xad, 0xc5, 0xf8, 0xc9, 0x34,
+	0x76, 0xa0, 0x98, 0x5c, 0x9f, 0xa1, 0x16, 0x14, 0xb8, 0xfe, 0xad, 0xd9, 0xac, 0x9f, 0xce, 0x66,
+	0xa4, 0xa6, 0x19, 0x8d, 0x35, 0xeb, 0xbf, 0xcb, 0x02, 0xa4, 0xc2, 0xf0, 0x73, 0xe5, 0x46, 0xa2,
+	0xa0, 0xe8, 0x10, 0x9d, 0x46, 0x9b, 0xa4, 0xb1, 0xd0, 0xcb, 0xb0, 0x70, 0x3c, 0x0f, 0xc8, 0x52,
+	0x57, 0xb0, 0x4a, 0xc7, 0x42, 0x78, 0x8c, 0xfc, 0x5f, 0x65, 0xe1, 0xf2, 0xbd, 0x28, 0xd3, 0x7e,
+	0x6e, 0x09, 0x6b, 0xc3, 0x2c, 0xf1, 0x79, 0x48, 0x25, 0x63, 0xc2, 0x25, 0xbe, 0x7e, 0x9a, 0x4b,
+	0x9c, 0x70, 0x97, 0x2d, 0x9f, 0x87, 0x23, 0xed, 0x20, 0x11, 0xcc, 0x18, 0x0b, 0x7f, 0xcd, 0x82,
+	0x79, 0x9a, 0x26, 0x7a, 0x15, 0xca, 0x4e, 0x48, 0xe4, 0x44, 0x54, 0xf1, 0x0c, 0x59, 0xf1, 0x16,
+	0xa2, 0x69, 0x5d, 0xf0, 0xde, 0x04, 0xd1, 0x3c, 0x0a, 0xff, 0x13, 0xa2, 0x17, 0xee, 0x16, 0x17,
+	0x12, 0x65, 0xb1, 0x8c, 0x08, 0x94, 0xa9, 0x4f, 0x39, 0xc5, 0x9e, 0xdd, 0xc5, 0x1e, 0xf6, 0x9d,
+	0xff, 0xa5, 0xab, 0x9e, 0x2c, 0x52, 0x0b, 0x1a, 0xb4, 0xa9, 0x30, 0xd1, 0x2e, 0xcc, 0x46, 0xf0,
+	0xb9, 0x29, 0xc0, 0x47, 0x60, 0xa9, 0x0e, 0xf2, 0xd3, 0x2c, 0x2c, 0x59, 0xc4, 0xfd, 0x62, 0xd1,
+	0xfa, 0x23, 0x00, 0x15, 0x97, 0x22, 0x5d, 0x9a, 0xb9, 0x29, 0xc4, 0xf9, 0x9c, 0xc2, 0x6b, 0x31,
+	0x9e, 0xe2, 0xf6, 0xe3, 0x2c, 0xcc, 0xa7, 0xb9, 0xfd, 0x02, 0x94, 0x0f, 0xb4, 0x9d, 0x64, 0x83,
+	0x9c, 0xcc, 0x06, 0xaf, 0x9d, 0x96, 0x0d, 0x26, 0xbc, 0xee, 0xec, 0x34, 0xf0, 0xd9, 0x25, 0xc8,
+	0xb7, 0x71, 0x88, 0xfb, 0x0c, 0x7d, 0x77, 0xa2, 0x79, 0x55, 0x2f, 0xca, 0x95, 0x09, 0x9f, 0x6b,
+	0xe9, 0x0f, 0x1a, 0xca, 0xe5, 0xde, 0x3b, 0xa1, 0x77, 0x7d, 0x19, 0x16, 0xc4, 0xf3, 0x38, 0xbe,
+	0x8a, 0x22, 0xb1, 0x24, 0xdf, 0xb7, 0xf1, 0xcb, 0x8a, 0xa1, 0x2a, 0x14, 0x85, 0x58, 0x92, 0xe8,
+	0x84, 0x0c, 0xf4, 0xf1, 0xe1, 0x96, 0x9a, 0x41, 0xd7, 0x00, 0xed, 0xc7, 0x1f, 0x2c, 0xec, 0x84,
+	0x02, 0x21, 0xb7, 0x94, 0xac, 0x44, 0xe2, 0x5f, 0x06, 0x90, 0xdd, 0xa0, 0x4b, 0xfc, 0xa0, 0xaf,
+	0xdf, 0x77, 0x73, 0x62, 0xa6, 0x25, 0x26, 0xd0, 0x2f, 0x54, 0x1f, 0x3c, 0xf6, 0x72, 0xd6, 0x4f,
+	0x90, 0x3b, 0x17, 0xf3, 0xd4, 0x7f, 0x1f, 0x55, 0x2b, 0x23, 0xdc, 0xf7, 0x36, 0xea, 0x27, 0x40,
+	0xd6, 0x65, 0x5f, 0x7c, 0xfc, 0xc5, 0x8d, 0x1e, 0xc0, 0x4a, 0xcf, 0x0b, 0xba, 0xd8, 0xb3, 0xa3,
+	0xf6, 0x58, 0x99, 0xce, 0x76, 0xf0, 0xc0, 0x9c, 0x9d, 0x42, 0xb4, 0x5c, 0x51, 0xf0, 0x77, 0x54,
+	0xa7, 0xac, 0xc0, 0x37, 0xf1, 0x00, 0xbd, 0x0d, 0x2f, 0x26, 0xae, 0x78, 0xc2, 0xde, 0x85, 0x29,
+	0xec, 0xbd, 0x12, 0xef, 0x30, 0xb1, 0xfd, 0x64, 0xb3, 0xbe, 0x87, 0x1d, 0x1e, 0x84, 0xe6, 0xdc,
+	0x14, 0xf6, 0x3d, 0xde, 0xac, 0xdf, 0x94, 0xc0, 0xa9, 0x5c, 0xf1, 0xbe, 0x01, 0x28, 0x29, 0x6e,
+	0x16, 0x61, 0x83, 0xc0, 0x67, 0xf2, 0x69, 0x95, 0x7a, 0x07, 0x19, 0x67, 0x3f, 0xad, 0x12, 0xfd,
+	0xe8, 0x69, 0x95, 0xe8, 0xa2, 0x6f, 0x26, 0xa5, 0x24, 0xab, 0xa3, 0x45, 0xc3, 0x88, 0x4f, 0x74,
+	0xa9, 0xe7, 0x19, 0x8d, 0xb4, 0x27, 0xaa, 0x45, 0xa6, 0xfe, 0xa9, 0x01, 0x2b, 0x13, 0x71, 0x1b,
+	0x1f, 0xf6, 0x27, 0x80, 0xc2, 0xd4, 0xa2, 0x8c, 0x82, 0x91, 0x3e, 0xf4, 0x85, 0xd3, 0xc0, 0x52,
+	0x38, 0xbe, 0xf0, 0x7f, 0xab, 0x86, 0x39, 0x69, 0x81, 0x3f, 0x19, 0xb0, 0x9c, 0x3e, 0x4c, 0x7c,
+	0xad, 0xbb, 0x30, 0x9f, 0x3e, 0x8b, 0xbe, 0xd0, 0x4b, 0xe7, 0xb9, 0x90, 0xbe, 0xcb, 0x31, 0x7d,
+	0xf4, 0x56, 0x92, 0x22, 0xd5, 0x27, 0xc9, 0xeb, 0xe7, 0xe6, 0x26, 0x3a, 0xd3, 0x78, 0xaa, 0xcc,
+	0x45, 0xfd, 0x62, 0xae, 0x1d, 0x04, 0x1e, 0x7a, 0x1b, 0x96, 0xfc, 0x80, 0x4b, 0x17, 0x26, 0xae,
+	0xad, 0xbf, 0x8f, 0xa8, 0x3a, 0xf3, 0xd6, 0xc5, 0x28, 0xfb, 0xe7, 0x51, 0x75, 0x12, 0x6a, 0x8c,
+	0xc7, 0xb2, 0x1f, 0xf0, 0xa6, 0x5c, 0xdf, 0x91, 0xcb, 0x28, 0x84, 0xd2, 0xf1, 0xad, 0x55, 0x5d,
+	0x7a, 0xf3, 0xc2, 0x5b, 0x97, 0xce, 0xda, 0x76, 0xbe, 0x9b, 0xda, 0x73, 0xa3, 0x20, 0x6c, 0xf8,
+	0x2f, 0x61, 0xc7, 0x3f, 0x18, 0x70, 0x59, 0x4e, 0xd2, 0x9f, 0x13, 0xf9, 0x26, 0xb6, 0x88, 0x13,
+	0x84, 0x2e, 0x5a, 0x80, 0x2c, 0x75, 0x25, 0x0b, 0x39, 0x2b, 0x4b, 0x5d, 0xd4, 0x80, 0x4b, 0xc1,
+	0x03, 0x9f, 0x84, 0xcf, 0xad, 0x9a, 0x4a, 0x4c, 0x56, 0x8a, 0xc0, 0x1d, 0x7a, 0xc4, 0xc6, 0x8e,
+	0x13, 0x0c, 0x7d, 0xae, 0xbf, 0xed, 0x95, 0xd4, 0xec, 0x0d, 0x35, 0x29, 0x5e, 0xd8, 0x71, 0xa4,
+	0x9b, 0xb9, 0xe7, 0x40, 0x27, 0xa2, 0xca, 0x09, 0xbf, 0xf6, 0x7b, 0x03, 0x20, 0xf9, 0xca, 0x85,
+	0x5e, 0x87, 0x2f, 0x35, 0xbf, 0x77, 0xb7, 0x65, 0x77, 0x76, 0x6e, 0xec, 0xdc, 0xeb, 0xd8, 0xf7,
+	0xee, 0x76, 0xda, 0x5b, 0x9b, 0xdb, 0x37, 0xb7, 0xb7, 0x5a, 0x8b, 0x99, 0x4a, 0xf9, 0xe1, 0xa3,
+	0x5a, 0xf1, 0x9e, 0xcf, 0x06, 0xc4, 0xa1, 0x7b, 0x94, 0xb8, 0xe8, 0x15, 0x58, 0x3e, 0x2e, 0x2d,
+	0x46, 0x5b, 0xad, 0x45, 0xa3, 0x32, 0xff, 0xf0, 0x51, 0xad, 0xa0, 0x9a, 0x68, 0xe2, 0xa2, 0xab,
+	0xf0, 0xc2, 0xa4, 0xdc, 0xf6, 0xdd, 0xef, 0x2c, 0x66, 0x2b, 0xa5, 0x87, 0x8f, 0x6a, 0x73, 0x71,
+	0xb7, 0x8d, 0xea, 0x80, 0xd2, 0x92, 0x1a, 0x6f, 0xa6, 0x02, 0x0f, 0x1f, 0xd5, 0xf2, 0xca, 0xe6,
+	0x95, 0xdc, 0x3b, 0xef, 0xaf, 0x66, 0x9a, 0x37, 0x3f, 0x7a, 0xba, 0x6a, 0x3c, 0x79, 0xba, 0x6a,
+	0xfc, 0xfd, 0xe9, 0xaa, 0xf1, 0xee, 0xb3, 0xd5, 0xcc, 0x93, 0x67, 0xab, 0x99, 0x3f, 0x3f, 0x5b,
+	0xcd, 0xfc, 0xf0, 0xf5, 0x33, 0xcd, 0x7d, 0x18, 0xff, 0xb1, 0x43, 0x1a, 0xbe, 0x9b, 0x97, 0xb5,
+	0xfb, 0x1b, 0xff, 0x1d, 0x00, 0x0f, 0xbd, 0x0e, 0x50, 0x0b, 0x19, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1256,477 +1378,507 @@ func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_
 func StakingDescription() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 7521 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7c, 0x6b, 0x90, 0x24, 0xd9,
-		0x75, 0x56, 0xd7, 0xa3, 0xab, 0xab, 0x4e, 0x55, 0x57, 0x67, 0xdf, 0xee, 0x99, 0xa9, 0xe9, 0xdd,
-		0xed, 0xee, 0xad, 0x7d, 0xcc, 0xec, 0xab, 0x67, 0x77, 0x76, 0x67, 0x66, 0xa7, 0xc6, 0xd2, 0x52,
-		0xaf, 0x99, 0xe9, 0x99, 0x7e, 0xd4, 0x66, 0x75, 0xcf, 0x3e, 0x8c, 0xc9, 0xc8, 0xce, 0xba, 0x5d,
-		0x9d, 0xdb, 0x59, 0x99, 0xe9, 0xcc, 0xac, 0x99, 0xe9, 0x0d, 0x43, 0xac, 0x42, 0x3c, 0xa4, 0x21,
-		0x00, 0x19, 0x13, 0x58, 0x96, 0x35, 0x62, 0xd7, 0x32, 0xc8, 0x08, 0x01, 0xb6, 0x25, 0x04, 0xc6,
-		0x01, 0x08, 0x08, 0x40, 0xd6, 0x0f, 0x42, 0xf6, 0x0f, 0x6c, 0x83, 0x59, 0xcc, 0x4a, 0x01, 0x8b,
-		0x10, 0x58, 0x88, 0x25, 0x02, 0x42, 0x61, 0x82, 0xb8, 0xaf, 0x7c, 0xd4, 0xa3, 0xab, 0x7a, 0x98,
-		0x95, 0x1d, 0xe1, 0x5f, 0x5d, 0x79, 0xee, 0xf9, 0xbe, 0x3c, 0xf7, 0xdc, 0x73, 0xcf, 0x3d, 0xf7,
-		0x66, 0x66, 0xc3, 0x3f, 0xbf, 0x04, 0xcb, 0x6d, 0xcb, 0x6a, 0x1b, 0xf8, 0x8c, 0xed, 0x58, 0x9e,
-		0xb5, 0xd3, 0xdd, 0x3d, 0xd3, 0xc2, 0xae, 0xe6, 0xe8, 0xb6, 0x67, 0x39, 0x2b, 0x54, 0x86, 0x66,
-		0x98, 0xc6, 0x8a, 0xd0, 0x28, 0xae, 0xc3, 0xec, 0x65, 0xdd, 0xc0, 0x35, 0x5f, 0xb1, 0x89, 0x3d,
-		0xf4, 0x22, 0x24, 0x77, 0x75, 0x03, 0x17, 0x62, 0xcb, 0x89, 0xd3, 0xd9, 0xb3, 0x8f, 0xae, 0xf4,
-		0x80, 0x56, 0xa2, 0x88, 0x06, 0x11, 0xcb, 0x14, 0x51, 0xfc, 0x76, 0x12, 0xe6, 0x06, 0xb4, 0x22,
-		0x04, 0x49, 0x53, 0xed, 0x10, 0xc6, 0xd8, 0xe9, 0x8c, 0x4c, 0x7f, 0xa3, 0x02, 0x4c, 0xd9, 0xaa,
-		0xb6, 0xaf, 0xb6, 0x71, 0x21, 0x4e, 0xc5, 0xe2, 0x12, 0x2d, 0x02, 0xb4, 0xb0, 0x8d, 0xcd, 0x16,
-		0x36, 0xb5, 0x83, 0x42, 0x62, 0x39, 0x71, 0x3a, 0x23, 0x87, 0x24, 0xe8, 0x29, 0x98, 0xb5, 0xbb,
-		0x3b, 0x86, 0xae, 0x29, 0x21, 0x35, 0x58, 0x4e, 0x9c, 0x9e, 0x94, 0x25, 0xd6, 0x50, 0x0b, 0x94,
-		0x4f, 0xc1, 0xcc, 0x2d, 0xac, 0xee, 0x87, 0x55, 0xb3, 0x54, 0x35, 0x4f, 0xc4, 0x21, 0xc5, 0x2a,
-		0xe4, 0x3a, 0xd8, 0x75, 0xd5, 0x36, 0x56, 0xbc, 0x03, 0x1b, 0x17, 0x92, 0xb4, 0xf7, 0xcb, 0x7d,
-		0xbd, 0xef, 0xed, 0x79, 0x96, 0xa3, 0xb6, 0x0e, 0x6c, 0x8c, 0xca, 0x90, 0xc1, 0x66, 0xb7, 0xc3,
-		0x18, 0x26, 0x87, 0xf8, 0xaf, 0x6e, 0x76, 0x3b, 0xbd, 0x2c, 0x69, 0x02, 0xe3, 0x14, 0x53, 0x2e,
-		0x76, 0x6e, 0xea, 0x1a, 0x2e, 0xa4, 0x28, 0xc1, 0xa9, 0x3e, 0x82, 0x26, 0x6b, 0xef, 0xe5, 0x10,
-		0x38, 0x54, 0x85, 0x0c, 0xbe, 0xed, 0x61, 0xd3, 0xd5, 0x2d, 0xb3, 0x30, 0x45, 0x49, 0x1e, 0x1b,
-		0x30, 0x8a, 0xd8, 0x68, 0xf5, 0x52, 0x04, 0x38, 0x74, 0x1e, 0xa6, 0x2c, 0xdb, 0xd3, 0x2d, 0xd3,
-		0x2d, 0xa4, 0x97, 0x63, 0xa7, 0xb3, 0x67, 0x1f, 0x1c, 0x18, 0x08, 0x9b, 0x4c, 0x47, 0x16, 0xca,
-		0x68, 0x15, 0x24, 0xd7, 0xea, 0x3a, 0x1a, 0x56, 0x34, 0xab, 0x85, 0x15, 0xdd, 0xdc, 0xb5, 0x0a,
-		0x19, 0x4a, 0xb0, 0xd4, 0xdf, 0x11, 0xaa, 0x58, 0xb5, 0x5a, 0x78, 0xd5, 0xdc, 0xb5, 0xe4, 0xbc,
-		0x1b, 0xb9, 0x46, 0xc7, 0x21, 0xe5, 0x1e, 0x98, 0x9e, 0x7a, 0xbb, 0x90, 0xa3, 0x11, 0xc2, 0xaf,
-		0x8a, 0xbf, 0x92, 0x82, 0x99, 0x71, 0x42, 0xec, 0x12, 0x4c, 0xee, 0x92, 0x5e, 0x16, 0xe2, 0x47,
-		0xf1, 0x01, 0xc3, 0x44, 0x9d, 0x98, 0xba, 0x47, 0x27, 0x96, 0x21, 0x6b, 0x62, 0xd7, 0xc3, 0x2d,
-		0x16, 0x11, 0x89, 0x31, 0x63, 0x0a, 0x18, 0xa8, 0x3f, 0xa4, 0x92, 0xf7, 0x14, 0x52, 0xaf, 0xc2,
-		0x8c, 0x6f, 0x92, 0xe2, 0xa8, 0x66, 0x5b, 0xc4, 0xe6, 0x99, 0x51, 0x96, 0xac, 0xd4, 0x05, 0x4e,
-		0x26, 0x30, 0x39, 0x8f, 0x23, 0xd7, 0xa8, 0x06, 0x60, 0x99, 0xd8, 0xda, 0x55, 0x5a, 0x58, 0x33,
-		0x0a, 0xe9, 0x21, 0x5e, 0xda, 0x24, 0x2a, 0x7d, 0x5e, 0xb2, 0x98, 0x54, 0x33, 0xd0, 0xc5, 0x20,
-		0xd4, 0xa6, 0x86, 0x44, 0xca, 0x3a, 0x9b, 0x64, 0x7d, 0xd1, 0xb6, 0x0d, 0x79, 0x07, 0x93, 0xb8,
-		0xc7, 0x2d, 0xde, 0xb3, 0x0c, 0x35, 0x62, 0x65, 0x64, 0xcf, 0x64, 0x0e, 0x63, 0x1d, 0x9b, 0x76,
-		0xc2, 0x97, 0xe8, 0x11, 0xf0, 0x05, 0x0a, 0x0d, 0x2b, 0xa0, 0x59, 0x28, 0x27, 0x84, 0x1b, 0x6a,
-		0x07, 0x2f, 0xbc, 0x09, 0xf9, 0xa8, 0x7b, 0xd0, 0x3c, 0x4c, 0xba, 0x9e, 0xea, 0x78, 0x34, 0x0a,
-		0x27, 0x65, 0x76, 0x81, 0x24, 0x48, 0x60, 0xb3, 0x45, 0xb3, 0xdc, 0xa4, 0x4c, 0x7e, 0xa2, 0x3f,
-		0x16, 0x74, 0x38, 0x41, 0x3b, 0xfc, 0x78, 0xff, 0x88, 0x46, 0x98, 0x7b, 0xfb, 0xbd, 0x70, 0x01,
-		0xa6, 0x23, 0x1d, 0x18, 0xf7, 0xd6, 0xc5, 0x9f, 0x80, 0x63, 0x03, 0xa9, 0xd1, 0xab, 0x30, 0xdf,
-		0x35, 0x75, 0xd3, 0xc3, 0x8e, 0xed, 0x60, 0x12, 0xb1, 0xec, 0x56, 0x85, 0xff, 0x3c, 0x35, 0x24,
-		0xe6, 0xb6, 0xc3, 0xda, 0x8c, 0x45, 0x9e, 0xeb, 0xf6, 0x0b, 0x9f, 0xcc, 0xa4, 0xdf, 0x9f, 0x92,
-		0xde, 0x7a, 0xeb, 0xad, 0xb7, 0xe2, 0xc5, 0x7f, 0x9a, 0x82, 0xf9, 0x41, 0x73, 0x66, 0xe0, 0xf4,
-		0x3d, 0x0e, 0x29, 0xb3, 0xdb, 0xd9, 0xc1, 0x0e, 0x75, 0xd2, 0xa4, 0xcc, 0xaf, 0x50, 0x19, 0x26,
-		0x0d, 0x75, 0x07, 0x1b, 0x85, 0xe4, 0x72, 0xec, 0x74, 0xfe, 0xec, 0x53, 0x63, 0xcd, 0xca, 0x95,
-		0x35, 0x02, 0x91, 0x19, 0x12, 0x7d, 0x14, 0x92, 0x3c, 0x45, 0x13, 0x86, 0x27, 0xc7, 0x63, 0x20,
-		0x73, 0x49, 0xa6, 0x38, 0xf4, 0x00, 0x64, 0xc8, 0x5f, 0x16, 0x1b, 0x29, 0x6a, 0x73, 0x9a, 0x08,
-		0x48, 0x5c, 0xa0, 0x05, 0x48, 0xd3, 0x69, 0xd2, 0xc2, 0x62, 0x69, 0xf3, 0xaf, 0x49, 0x60, 0xb5,
-		0xf0, 0xae, 0xda, 0x35, 0x3c, 0xe5, 0xa6, 0x6a, 0x74, 0x31, 0x0d, 0xf8, 0x8c, 0x9c, 0xe3, 0xc2,
-		0x1b, 0x44, 0x86, 0x96, 0x20, 0xcb, 0x66, 0x95, 0x6e, 0xb6, 0xf0, 0x6d, 0x9a, 0x3d, 0x27, 0x65,
-		0x36, 0xd1, 0x56, 0x89, 0x84, 0xdc, 0xfe, 0x0d, 0xd7, 0x32, 0x45, 0x68, 0xd2, 0x5b, 0x10, 0x01,
-		0xbd, 0xfd, 0x85, 0xde, 0xc4, 0xfd, 0xd0, 0xe0, 0xee, 0xf5, 0xcd, 0xa5, 0x53, 0x30, 0x43, 0x35,
-		0x9e, 0xe7, 0x43, 0xaf, 0x1a, 0x85, 0xd9, 0xe5, 0xd8, 0xe9, 0xb4, 0x9c, 0x67, 0xe2, 0x4d, 0x2e,
-		0x2d, 0x7e, 0x35, 0x0e, 0x49, 0x9a, 0x58, 0x66, 0x20, 0xbb, 0xf5, 0x5a, 0xa3, 0xae, 0xd4, 0x36,
-		0xb7, 0x2b, 0x6b, 0x75, 0x29, 0x86, 0xf2, 0x00, 0x54, 0x70, 0x79, 0x6d, 0xb3, 0xbc, 0x25, 0xc5,
-		0xfd, 0xeb, 0xd5, 0x8d, 0xad, 0xf3, 0x2f, 0x48, 0x09, 0x1f, 0xb0, 0xcd, 0x04, 0xc9, 0xb0, 0xc2,
-		0xf3, 0x67, 0xa5, 0x49, 0x24, 0x41, 0x8e, 0x11, 0xac, 0xbe, 0x5a, 0xaf, 0x9d, 0x7f, 0x41, 0x4a,
-		0x45, 0x25, 0xcf, 0x9f, 0x95, 0xa6, 0xd0, 0x34, 0x64, 0xa8, 0xa4, 0xb2, 0xb9, 0xb9, 0x26, 0xa5,
-		0x7d, 0xce, 0xe6, 0x96, 0xbc, 0xba, 0x71, 0x45, 0xca, 0xf8, 0x9c, 0x57, 0xe4, 0xcd, 0xed, 0x86,
-		0x04, 0x3e, 0xc3, 0x7a, 0xbd, 0xd9, 0x2c, 0x5f, 0xa9, 0x4b, 0x59, 0x5f, 0xa3, 0xf2, 0xda, 0x56,
-		0xbd, 0x29, 0xe5, 0x22, 0x66, 0x3d, 0x7f, 0x56, 0x9a, 0xf6, 0x6f, 0x51, 0xdf, 0xd8, 0x5e, 0x97,
-		0xf2, 0x68, 0x16, 0xa6, 0xd9, 0x2d, 0x84, 0x11, 0x33, 0x3d, 0xa2, 0xf3, 0x2f, 0x48, 0x52, 0x60,
-		0x08, 0x63, 0x99, 0x8d, 0x08, 0xce, 0xbf, 0x20, 0xa1, 0x62, 0x15, 0x26, 0x69, 0x18, 0x22, 0x04,
-		0xf9, 0xb5, 0x72, 0xa5, 0xbe, 0xa6, 0x6c, 0x36, 0xb6, 0x56, 0x37, 0x37, 0xca, 0x6b, 0x52, 0x2c,
-		0x90, 0xc9, 0xf5, 0x97, 0xb7, 0x57, 0xe5, 0x7a, 0x4d, 0x8a, 0x87, 0x65, 0x8d, 0x7a, 0x79, 0xab,
-		0x5e, 0x93, 0x12, 0x45, 0x0d, 0xe6, 0x07, 0x25, 0xd4, 0x81, 0x53, 0x28, 0x14, 0x0b, 0xf1, 0x21,
-		0xb1, 0x40, 0xb9, 0x7a, 0x63, 0xa1, 0xf8, 0xad, 0x38, 0xcc, 0x0d, 0x58, 0x54, 0x06, 0xde, 0xe4,
-		0x25, 0x98, 0x64, 0xb1, 0xcc, 0x96, 0xd9, 0x27, 0x06, 0xae, 0x4e, 0x34, 0xb2, 0xfb, 0x96, 0x5a,
-		0x8a, 0x0b, 0x97, 0x1a, 0x89, 0x21, 0xa5, 0x06, 0xa1, 0xe8, 0x0b, 0xd8, 0x1f, 0xeb, 0x4b, 0xfe,
-		0x6c, 0x7d, 0x3c, 0x3f, 0xce, 0xfa, 0x48, 0x65, 0x47, 0x5b, 0x04, 0x26, 0x07, 0x2c, 0x02, 0x97,
-		0x60, 0xb6, 0x8f, 0x68, 0xec, 0x64, 0xfc, 0xf1, 0x18, 0x14, 0x86, 0x39, 0x67, 0x44, 0x4a, 0x8c,
-		0x47, 0x52, 0xe2, 0xa5, 0x5e, 0x0f, 0x3e, 0x3c, 0x7c, 0x10, 0xfa, 0xc6, 0xfa, 0x0b, 0x31, 0x38,
-		0x3e, 0xb8, 0xa4, 0x1c, 0x68, 0xc3, 0x47, 0x21, 0xd5, 0xc1, 0xde, 0x9e, 0x25, 0xca, 0xaa, 0xc7,
-		0x07, 0x2c, 0xd6, 0xa4, 0xb9, 0x77, 0xb0, 0x39, 0x2a, 0xbc, 0xda, 0x27, 0x86, 0xd5, 0x85, 0xcc,
-		0x9a, 0x3e, 0x4b, 0x3f, 0x19, 0x87, 0x63, 0x03, 0xc9, 0x07, 0x1a, 0xfa, 0x10, 0x80, 0x6e, 0xda,
-		0x5d, 0x8f, 0x95, 0x4e, 0x2c, 0x13, 0x67, 0xa8, 0x84, 0x26, 0x2f, 0x92, 0x65, 0xbb, 0x9e, 0xdf,
-		0x9e, 0xa0, 0xed, 0xc0, 0x44, 0x54, 0xe1, 0xc5, 0xc0, 0xd0, 0x24, 0x35, 0x74, 0x71, 0x48, 0x4f,
-		0xfb, 0x02, 0xf3, 0x59, 0x90, 0x34, 0x43, 0xc7, 0xa6, 0xa7, 0xb8, 0x9e, 0x83, 0xd5, 0x8e, 0x6e,
-		0xb6, 0xe9, 0x52, 0x93, 0x2e, 0x4d, 0xee, 0xaa, 0x86, 0x8b, 0xe5, 0x19, 0xd6, 0xdc, 0x14, 0xad,
-		0x04, 0x41, 0x03, 0xc8, 0x09, 0x21, 0x52, 0x11, 0x04, 0x6b, 0xf6, 0x11, 0xc5, 0x9f, 0xcc, 0x40,
-		0x36, 0x54, 0x80, 0xa3, 0x87, 0x21, 0xf7, 0x86, 0x7a, 0x53, 0x55, 0xc4, 0xa6, 0x8a, 0x79, 0x22,
-		0x4b, 0x64, 0x0d, 0xbe, 0xb1, 0x7a, 0x16, 0xe6, 0xa9, 0x8a, 0xd5, 0xf5, 0xb0, 0xa3, 0x68, 0x86,
-		0xea, 0xba, 0xd4, 0x69, 0x69, 0xaa, 0x8a, 0x48, 0xdb, 0x26, 0x69, 0xaa, 0x8a, 0x16, 0x74, 0x0e,
-		0xe6, 0x28, 0xa2, 0xd3, 0x35, 0x3c, 0xdd, 0x36, 0xb0, 0x42, 0xb6, 0x79, 0x2e, 0x5d, 0x72, 0x7c,
-		0xcb, 0x66, 0x89, 0xc6, 0x3a, 0x57, 0x20, 0x16, 0xb9, 0xa8, 0x06, 0x0f, 0x51, 0x58, 0x1b, 0x9b,
-		0xd8, 0x51, 0x3d, 0xac, 0xe0, 0x1f, 0xef, 0xaa, 0x86, 0xab, 0xa8, 0x66, 0x4b, 0xd9, 0x53, 0xdd,
-		0xbd, 0xc2, 0x3c, 0x21, 0xa8, 0xc4, 0x0b, 0x31, 0xf9, 0x24, 0x51, 0xbc, 0xc2, 0xf5, 0xea, 0x54,
-		0xad, 0x6c, 0xb6, 0xae, 0xaa, 0xee, 0x1e, 0x2a, 0xc1, 0x71, 0xca, 0xe2, 0x7a, 0x8e, 0x6e, 0xb6,
-		0x15, 0x6d, 0x0f, 0x6b, 0xfb, 0x4a, 0xd7, 0xdb, 0x7d, 0xb1, 0xf0, 0x40, 0xf8, 0xfe, 0xd4, 0xc2,
-		0x26, 0xd5, 0xa9, 0x12, 0x95, 0x6d, 0x6f, 0xf7, 0x45, 0xd4, 0x84, 0x1c, 0x19, 0x8c, 0x8e, 0xfe,
-		0x26, 0x56, 0x76, 0x2d, 0x87, 0xae, 0xa1, 0xf9, 0x01, 0xa9, 0x29, 0xe4, 0xc1, 0x95, 0x4d, 0x0e,
-		0x58, 0xb7, 0x5a, 0xb8, 0x34, 0xd9, 0x6c, 0xd4, 0xeb, 0x35, 0x39, 0x2b, 0x58, 0x2e, 0x5b, 0x0e,
-		0x09, 0xa8, 0xb6, 0xe5, 0x3b, 0x38, 0xcb, 0x02, 0xaa, 0x6d, 0x09, 0xf7, 0x9e, 0x83, 0x39, 0x4d,
-		0x63, 0x7d, 0xd6, 0x35, 0x85, 0x6f, 0xc6, 0xdc, 0x82, 0x14, 0x71, 0x96, 0xa6, 0x5d, 0x61, 0x0a,
-		0x3c, 0xc6, 0x5d, 0x74, 0x11, 0x8e, 0x05, 0xce, 0x0a, 0x03, 0x67, 0xfb, 0x7a, 0xd9, 0x0b, 0x3d,
-		0x07, 0x73, 0xf6, 0x41, 0x3f, 0x10, 0x45, 0xee, 0x68, 0x1f, 0xf4, 0xc2, 0x2e, 0xc0, 0xbc, 0xbd,
-		0x67, 0xf7, 0xe3, 0x9e, 0x0c, 0xe3, 0x90, 0xbd, 0x67, 0xf7, 0x02, 0x1f, 0xa3, 0x3b, 0x73, 0x07,
-		0x6b, 0xaa, 0x87, 0x5b, 0x85, 0x13, 0x61, 0xf5, 0x50, 0x03, 0x5a, 0x01, 0x49, 0xd3, 0x14, 0x6c,
-		0xaa, 0x3b, 0x06, 0x56, 0x54, 0x07, 0x9b, 0xaa, 0x5b, 0x58, 0xa2, 0xca, 0x49, 0xcf, 0xe9, 0x62,
-		0x39, 0xaf, 0x69, 0x75, 0xda, 0x58, 0xa6, 0x6d, 0xe8, 0x49, 0x98, 0xb5, 0x76, 0xde, 0xd0, 0x58,
-		0x44, 0x2a, 0xb6, 0x83, 0x77, 0xf5, 0xdb, 0x85, 0x47, 0xa9, 0x7b, 0x67, 0x48, 0x03, 0x8d, 0xc7,
-		0x06, 0x15, 0xa3, 0x27, 0x40, 0xd2, 0xdc, 0x3d, 0xd5, 0xb1, 0x69, 0x4a, 0x76, 0x6d, 0x55, 0xc3,
-		0x85, 0xc7, 0x98, 0x2a, 0x93, 0x6f, 0x08, 0x31, 0x99, 0x11, 0xee, 0x2d, 0x7d, 0xd7, 0x13, 0x8c,
-		0xa7, 0xd8, 0x8c, 0xa0, 0x32, 0xce, 0x76, 0x1a, 0x24, 0xe2, 0x89, 0xc8, 0x8d, 0x4f, 0x53, 0xb5,
-		0xbc, 0xbd, 0x67, 0x87, 0xef, 0xfb, 0x08, 0x4c, 0x13, 0xcd, 0xe0, 0xa6, 0x4f, 0xb0, 0xc2, 0xcd,
-		0xde, 0x0b, 0xdd, 0xf1, 0x05, 0x38, 0x4e, 0x94, 0x3a, 0xd8, 0x53, 0x5b, 0xaa, 0xa7, 0x86, 0xb4,
-		0x9f, 0xa6, 0xda, 0xc4, 0xed, 0xeb, 0xbc, 0x31, 0x62, 0xa7, 0xd3, 0xdd, 0x39, 0xf0, 0x03, 0xeb,
-		0x19, 0x66, 0x27, 0x91, 0x89, 0xd0, 0xfa, 0xd0, 0x8a, 0xf3, 0x62, 0x09, 0x72, 0xe1, 0xb8, 0x47,
-		0x19, 0x60, 0x91, 0x2f, 0xc5, 0x48, 0x11, 0x54, 0xdd, 0xac, 0x91, 0xf2, 0xe5, 0xf5, 0xba, 0x14,
-		0x27, 0x65, 0xd4, 0xda, 0xea, 0x56, 0x5d, 0x91, 0xb7, 0x37, 0xb6, 0x56, 0xd7, 0xeb, 0x52, 0x22,
-		0x54, 0xd8, 0x5f, 0x4b, 0xa6, 0x1f, 0x97, 0x4e, 0x91, 0xaa, 0x21, 0x1f, 0xdd, 0xa9, 0xa1, 0x1f,
-		0x81, 0x13, 0xe2, 0x58, 0xc5, 0xc5, 0x9e, 0x72, 0x4b, 0x77, 0xe8, 0x84, 0xec, 0xa8, 0x6c, 0x71,
-		0xf4, 0xe3, 0x67, 0x9e, 0x6b, 0x35, 0xb1, 0xf7, 0x8a, 0xee, 0x90, 0xe9, 0xd6, 0x51, 0x3d, 0xb4,
-		0x06, 0x4b, 0xa6, 0xa5, 0xb8, 0x9e, 0x6a, 0xb6, 0x54, 0xa7, 0xa5, 0x04, 0x07, 0x5a, 0x8a, 0xaa,
-		0x69, 0xd8, 0x75, 0x2d, 0xb6, 0x10, 0xfa, 0x2c, 0x0f, 0x9a, 0x56, 0x93, 0x2b, 0x07, 0x2b, 0x44,
-		0x99, 0xab, 0xf6, 0x84, 0x6f, 0x62, 0x58, 0xf8, 0x3e, 0x00, 0x99, 0x8e, 0x6a, 0x2b, 0xd8, 0xf4,
-		0x9c, 0x03, 0x5a, 0x9f, 0xa7, 0xe5, 0x74, 0x47, 0xb5, 0xeb, 0xe4, 0xfa, 0x87, 0xb2, 0x4d, 0xba,
-		0x96, 0x4c, 0x27, 0xa5, 0xc9, 0x6b, 0xc9, 0xf4, 0xa4, 0x94, 0xba, 0x96, 0x4c, 0xa7, 0xa4, 0xa9,
-		0x6b, 0xc9, 0x74, 0x5a, 0xca, 0x5c, 0x4b, 0xa6, 0x33, 0x12, 0x14, 0xdf, 0x4b, 0x40, 0x2e, 0x5c,
-		0xc1, 0x93, 0x0d, 0x91, 0x46, 0xd7, 0xb0, 0x18, 0xcd, 0x72, 0x8f, 0x1c, 0x5a, 0xef, 0xaf, 0x54,
-		0xc9, 0xe2, 0x56, 0x4a, 0xb1, 0x72, 0x59, 0x66, 0x48, 0x52, 0x58, 0x90, 0xf0, 0xc3, 0xac, 0x3c,
-		0x49, 0xcb, 0xfc, 0x0a, 0x5d, 0x81, 0xd4, 0x1b, 0x2e, 0xe5, 0x4e, 0x51, 0xee, 0x47, 0x0f, 0xe7,
-		0xbe, 0xd6, 0xa4, 0xe4, 0x99, 0x6b, 0x4d, 0x65, 0x63, 0x53, 0x5e, 0x2f, 0xaf, 0xc9, 0x1c, 0x8e,
-		0x4e, 0x42, 0xd2, 0x50, 0xdf, 0x3c, 0x88, 0x2e, 0x83, 0x54, 0x34, 0xee, 0xb0, 0x9c, 0x84, 0xe4,
-		0x2d, 0xac, 0xee, 0x47, 0x17, 0x1f, 0x2a, 0xfa, 0x10, 0xa7, 0xc7, 0x19, 0x98, 0xa4, 0xfe, 0x42,
-		0x00, 0xdc, 0x63, 0xd2, 0x04, 0x4a, 0x43, 0xb2, 0xba, 0x29, 0x93, 0x29, 0x22, 0x41, 0x8e, 0x49,
-		0x95, 0xc6, 0x6a, 0xbd, 0x5a, 0x97, 0xe2, 0xc5, 0x73, 0x90, 0x62, 0x4e, 0x20, 0xd3, 0xc7, 0x77,
-		0x83, 0x34, 0xc1, 0x2f, 0x39, 0x47, 0x4c, 0xb4, 0x6e, 0xaf, 0x57, 0xea, 0xb2, 0x14, 0xef, 0x1b,
-		0xfc, 0xa2, 0x0b, 0xb9, 0x70, 0x65, 0xfe, 0xc3, 0xd9, 0x9e, 0x7f, 0x2d, 0x06, 0xd9, 0x50, 0xa5,
-		0x4d, 0x4a, 0x24, 0xd5, 0x30, 0xac, 0x5b, 0x8a, 0x6a, 0xe8, 0xaa, 0xcb, 0x43, 0x03, 0xa8, 0xa8,
-		0x4c, 0x24, 0xe3, 0x0e, 0xdd, 0x0f, 0x69, 0xd2, 0x4c, 0x4a, 0xa9, 0xe2, 0xe7, 0x62, 0x20, 0xf5,
-		0x96, 0xba, 0x3d, 0x66, 0xc6, 0xfe, 0x20, 0xcd, 0x2c, 0x7e, 0x36, 0x06, 0xf9, 0x68, 0x7d, 0xdb,
-		0x63, 0xde, 0xc3, 0x7f, 0xa0, 0xe6, 0xfd, 0x6e, 0x1c, 0xa6, 0x23, 0x55, 0xed, 0xb8, 0xd6, 0xfd,
-		0x38, 0xcc, 0xea, 0x2d, 0xdc, 0xb1, 0x2d, 0x0f, 0x9b, 0xda, 0x81, 0x62, 0xe0, 0x9b, 0xd8, 0x28,
-		0x14, 0x69, 0xd2, 0x38, 0x73, 0x78, 0xdd, 0xbc, 0xb2, 0x1a, 0xe0, 0xd6, 0x08, 0xac, 0x34, 0xb7,
-		0x5a, 0xab, 0xaf, 0x37, 0x36, 0xb7, 0xea, 0x1b, 0xd5, 0xd7, 0x94, 0xed, 0x8d, 0xeb, 0x1b, 0x9b,
-		0xaf, 0x6c, 0xc8, 0x92, 0xde, 0xa3, 0xf6, 0x21, 0x4e, 0xfb, 0x06, 0x48, 0xbd, 0x46, 0xa1, 0x13,
-		0x30, 0xc8, 0x2c, 0x69, 0x02, 0xcd, 0xc1, 0xcc, 0xc6, 0xa6, 0xd2, 0x5c, 0xad, 0xd5, 0x95, 0xfa,
-		0xe5, 0xcb, 0xf5, 0xea, 0x56, 0x93, 0x9d, 0x84, 0xf8, 0xda, 0x5b, 0x91, 0x09, 0x5e, 0xfc, 0x4c,
-		0x02, 0xe6, 0x06, 0x58, 0x82, 0xca, 0x7c, 0x0f, 0xc3, 0xb6, 0x55, 0xcf, 0x8c, 0x63, 0xfd, 0x0a,
-		0xa9, 0x22, 0x1a, 0xaa, 0xe3, 0xf1, 0x2d, 0xcf, 0x13, 0x40, 0xbc, 0x64, 0x7a, 0xfa, 0xae, 0x8e,
-		0x1d, 0x7e, 0xc2, 0xc4, 0x36, 0x36, 0x33, 0x81, 0x9c, 0x1d, 0x32, 0x3d, 0x0d, 0xc8, 0xb6, 0x5c,
-		0xdd, 0xd3, 0x6f, 0x62, 0x45, 0x37, 0xc5, 0x71, 0x14, 0xd9, 0xe8, 0x24, 0x65, 0x49, 0xb4, 0xac,
-		0x9a, 0x9e, 0xaf, 0x6d, 0xe2, 0xb6, 0xda, 0xa3, 0x4d, 0x92, 0x79, 0x42, 0x96, 0x44, 0x8b, 0xaf,
-		0xfd, 0x30, 0xe4, 0x5a, 0x56, 0x97, 0x54, 0x7f, 0x4c, 0x8f, 0xac, 0x1d, 0x31, 0x39, 0xcb, 0x64,
-		0xbe, 0x0a, 0xaf, 0xeb, 0x83, 0x73, 0xb0, 0x9c, 0x9c, 0x65, 0x32, 0xa6, 0x72, 0x0a, 0x66, 0xd4,
-		0x76, 0xdb, 0x21, 0xe4, 0x82, 0x88, 0xed, 0x54, 0xf2, 0xbe, 0x98, 0x2a, 0x2e, 0x5c, 0x83, 0xb4,
-		0xf0, 0x03, 0x59, 0xbc, 0x89, 0x27, 0x14, 0x9b, 0x6d, 0xbf, 0xe3, 0xa7, 0x33, 0x72, 0xda, 0x14,
-		0x8d, 0x0f, 0x43, 0x4e, 0x77, 0x95, 0xe0, 0x58, 0x3f, 0xbe, 0x1c, 0x3f, 0x9d, 0x96, 0xb3, 0xba,
-		0xeb, 0x1f, 0x89, 0x16, 0xbf, 0x10, 0x87, 0x7c, 0xf4, 0xb1, 0x04, 0xaa, 0x41, 0xda, 0xb0, 0x34,
-		0x95, 0x86, 0x16, 0x7b, 0x26, 0x76, 0x7a, 0xc4, 0x93, 0x8c, 0x95, 0x35, 0xae, 0x2f, 0xfb, 0xc8,
-		0x85, 0x7f, 0x1d, 0x83, 0xb4, 0x10, 0xa3, 0xe3, 0x90, 0xb4, 0x55, 0x6f, 0x8f, 0xd2, 0x4d, 0x56,
-		0xe2, 0x52, 0x4c, 0xa6, 0xd7, 0x44, 0xee, 0xda, 0xaa, 0x49, 0x43, 0x80, 0xcb, 0xc9, 0x35, 0x19,
-		0x57, 0x03, 0xab, 0x2d, 0xba, 0x0d, 0xb2, 0x3a, 0x1d, 0x6c, 0x7a, 0xae, 0x18, 0x57, 0x2e, 0xaf,
-		0x72, 0x31, 0x7a, 0x0a, 0x66, 0x3d, 0x47, 0xd5, 0x8d, 0x88, 0x6e, 0x92, 0xea, 0x4a, 0xa2, 0xc1,
-		0x57, 0x2e, 0xc1, 0x49, 0xc1, 0xdb, 0xc2, 0x9e, 0xaa, 0xed, 0xe1, 0x56, 0x00, 0x4a, 0xd1, 0xe3,
-		0x8e, 0x13, 0x5c, 0xa1, 0xc6, 0xdb, 0x05, 0xb6, 0xf8, 0xeb, 0x31, 0x98, 0x15, 0x1b, 0xb7, 0x96,
-		0xef, 0xac, 0x75, 0x00, 0xd5, 0x34, 0x2d, 0x2f, 0xec, 0xae, 0xfe, 0x50, 0xee, 0xc3, 0xad, 0x94,
-		0x7d, 0x90, 0x1c, 0x22, 0x58, 0xe8, 0x00, 0x04, 0x2d, 0x43, 0xdd, 0xb6, 0x04, 0x59, 0xfe, 0xcc,
-		0x89, 0x3e, 0xb8, 0x64, 0x5b, 0x7d, 0x60, 0x22, 0xb2, 0xc3, 0x43, 0xf3, 0x30, 0xb9, 0x83, 0xdb,
-		0xba, 0xc9, 0x4f, 0x92, 0xd9, 0x85, 0x38, 0x90, 0x49, 0xfa, 0x07, 0x32, 0x95, 0x3f, 0x05, 0x73,
-		0x9a, 0xd5, 0xe9, 0x35, 0xb7, 0x22, 0xf5, 0x1c, 0x37, 0xb8, 0x57, 0x63, 0xaf, 0x3f, 0xc3, 0x95,
-		0xda, 0x96, 0xa1, 0x9a, 0xed, 0x15, 0xcb, 0x69, 0x07, 0x0f, 0x5e, 0x49, 0xc5, 0xe3, 0x86, 0x1e,
-		0xbf, 0xda, 0x3b, 0xff, 0x3b, 0x16, 0xfb, 0xb9, 0x78, 0xe2, 0x4a, 0xa3, 0xf2, 0xc5, 0xf8, 0xc2,
-		0x15, 0x06, 0x6c, 0x08, 0x67, 0xc8, 0x78, 0xd7, 0xc0, 0x1a, 0xe9, 0x20, 0x7c, 0xe7, 0x29, 0x98,
-		0x6f, 0x5b, 0x6d, 0x8b, 0x32, 0x9d, 0x21, 0xbf, 0xf8, 0x93, 0xdb, 0x8c, 0x2f, 0x5d, 0x18, 0xf9,
-		0x98, 0xb7, 0xb4, 0x01, 0x73, 0x5c, 0x59, 0xa1, 0x8f, 0x8e, 0xd8, 0xc6, 0x06, 0x1d, 0x7a, 0xaa,
-		0x56, 0xf8, 0xa5, 0x6f, 0xd3, 0xe5, 0x5b, 0x9e, 0xe5, 0x50, 0xd2, 0xc6, 0xf6, 0x3e, 0x25, 0x19,
-		0x8e, 0x45, 0xf8, 0xd8, 0x24, 0xc5, 0xce, 0x08, 0xc6, 0x7f, 0xc1, 0x19, 0xe7, 0x42, 0x8c, 0x4d,
-		0x0e, 0x2d, 0x55, 0x61, 0xfa, 0x28, 0x5c, 0xff, 0x92, 0x73, 0xe5, 0x70, 0x98, 0xe4, 0x0a, 0xcc,
-		0x50, 0x12, 0xad, 0xeb, 0x7a, 0x56, 0x87, 0x66, 0xc0, 0xc3, 0x69, 0xfe, 0xd5, 0xb7, 0xd9, 0xac,
-		0xc9, 0x13, 0x58, 0xd5, 0x47, 0x95, 0x4a, 0x40, 0x9f, 0x96, 0xb5, 0xb0, 0x66, 0x8c, 0x60, 0xf8,
-		0x3a, 0x37, 0xc4, 0xd7, 0x2f, 0xdd, 0x80, 0x79, 0xf2, 0x9b, 0x26, 0xa8, 0xb0, 0x25, 0xa3, 0x8f,
-		0xe0, 0x0a, 0xbf, 0xfe, 0x71, 0x36, 0x31, 0xe7, 0x7c, 0x82, 0x90, 0x4d, 0xa1, 0x51, 0x6c, 0x63,
-		0xcf, 0xc3, 0x8e, 0xab, 0xa8, 0xc6, 0x20, 0xf3, 0x42, 0x67, 0x18, 0x85, 0x9f, 0xf9, 0x6e, 0x74,
-		0x14, 0xaf, 0x30, 0x64, 0xd9, 0x30, 0x4a, 0xdb, 0x70, 0x62, 0x40, 0x54, 0x8c, 0xc1, 0xf9, 0x19,
-		0xce, 0x39, 0xdf, 0x17, 0x19, 0x84, 0xb6, 0x01, 0x42, 0xee, 0x8f, 0xe5, 0x18, 0x9c, 0x3f, 0xcb,
-		0x39, 0x11, 0xc7, 0x8a, 0x21, 0x25, 0x8c, 0xd7, 0x60, 0xf6, 0x26, 0x76, 0x76, 0x2c, 0x97, 0x9f,
-		0x1b, 0x8d, 0x41, 0xf7, 0x59, 0x4e, 0x37, 0xc3, 0x81, 0xf4, 0x20, 0x89, 0x70, 0x5d, 0x84, 0xf4,
-		0xae, 0xaa, 0xe1, 0x31, 0x28, 0xee, 0x72, 0x8a, 0x29, 0xa2, 0x4f, 0xa0, 0x65, 0xc8, 0xb5, 0x2d,
-		0xbe, 0x46, 0x8d, 0x86, 0x7f, 0x8e, 0xc3, 0xb3, 0x02, 0xc3, 0x29, 0x6c, 0xcb, 0xee, 0x1a, 0x64,
-		0x01, 0x1b, 0x4d, 0xf1, 0xd7, 0x04, 0x85, 0xc0, 0x70, 0x8a, 0x23, 0xb8, 0xf5, 0x6d, 0x41, 0xe1,
-		0x86, 0xfc, 0xf9, 0x12, 0x64, 0x2d, 0xd3, 0x38, 0xb0, 0xcc, 0x71, 0x8c, 0x78, 0x87, 0x33, 0x00,
-		0x87, 0x10, 0x82, 0x4b, 0x90, 0x19, 0x77, 0x20, 0xfe, 0xfa, 0x77, 0xc5, 0xf4, 0x10, 0x23, 0x70,
-		0x05, 0x66, 0x44, 0x82, 0xd2, 0x2d, 0x73, 0x0c, 0x8a, 0xbf, 0xc1, 0x29, 0xf2, 0x21, 0x18, 0xef,
-		0x86, 0x87, 0x5d, 0xaf, 0x8d, 0xc7, 0x21, 0xf9, 0x82, 0xe8, 0x06, 0x87, 0x70, 0x57, 0xee, 0x60,
-		0x53, 0xdb, 0x1b, 0x8f, 0xe1, 0x17, 0x84, 0x2b, 0x05, 0x86, 0x50, 0x54, 0x61, 0xba, 0xa3, 0x3a,
-		0xee, 0x9e, 0x6a, 0x8c, 0x35, 0x1c, 0x7f, 0x93, 0x73, 0xe4, 0x7c, 0x10, 0xf7, 0x48, 0xd7, 0x3c,
-		0x0a, 0xcd, 0x17, 0x85, 0x47, 0x42, 0x30, 0x3e, 0xf5, 0x5c, 0x8f, 0x1e, 0xb2, 0x1d, 0x85, 0xed,
-		0x6f, 0x89, 0xa9, 0xc7, 0xb0, 0xeb, 0x61, 0xc6, 0x4b, 0x90, 0x71, 0xf5, 0x37, 0xc7, 0xa2, 0xf9,
-		0x92, 0x18, 0x69, 0x0a, 0x20, 0xe0, 0xd7, 0xe0, 0xe4, 0xc0, 0x65, 0x62, 0x0c, 0xb2, 0xbf, 0xcd,
-		0xc9, 0x8e, 0x0f, 0x58, 0x2a, 0x78, 0x4a, 0x38, 0x2a, 0xe5, 0xdf, 0x11, 0x29, 0x01, 0xf7, 0x70,
-		0x35, 0xc8, 0xae, 0xc1, 0x55, 0x77, 0x8f, 0xe6, 0xb5, 0xbf, 0x2b, 0xbc, 0xc6, 0xb0, 0x11, 0xaf,
-		0x6d, 0xc1, 0x71, 0xce, 0x78, 0xb4, 0x71, 0xfd, 0x45, 0x91, 0x58, 0x19, 0x7a, 0x3b, 0x3a, 0xba,
-		0x3f, 0x0a, 0x0b, 0xbe, 0x3b, 0x45, 0x79, 0xea, 0x2a, 0x1d, 0xd5, 0x1e, 0x83, 0xf9, 0x97, 0x38,
-		0xb3, 0xc8, 0xf8, 0x7e, 0x7d, 0xeb, 0xae, 0xab, 0x36, 0x21, 0x7f, 0x15, 0x0a, 0x82, 0xbc, 0x6b,
-		0x3a, 0x58, 0xb3, 0xda, 0xa6, 0xfe, 0x26, 0x6e, 0x8d, 0x41, 0xfd, 0xcb, 0x3d, 0x43, 0xb5, 0x1d,
-		0x82, 0x13, 0xe6, 0x55, 0x90, 0xfc, 0x5a, 0x45, 0xd1, 0x3b, 0xb6, 0xe5, 0x78, 0x23, 0x18, 0xbf,
-		0x2c, 0x46, 0xca, 0xc7, 0xad, 0x52, 0x58, 0xa9, 0x0e, 0xec, 0xc9, 0xf3, 0xb8, 0x21, 0xf9, 0x15,
-		0x4e, 0x34, 0x1d, 0xa0, 0x78, 0xe2, 0xd0, 0xac, 0x8e, 0xad, 0x3a, 0xe3, 0xe4, 0xbf, 0xbf, 0x27,
-		0x12, 0x07, 0x87, 0xf0, 0xc4, 0x41, 0x2a, 0x3a, 0xb2, 0xda, 0x8f, 0xc1, 0xf0, 0x55, 0x91, 0x38,
-		0x04, 0x86, 0x53, 0x88, 0x82, 0x61, 0x0c, 0x8a, 0xbf, 0x2f, 0x28, 0x04, 0x86, 0x50, 0xbc, 0x1c,
-		0x2c, 0xb4, 0x0e, 0x6e, 0xeb, 0xae, 0xe7, 0xb0, 0xa2, 0xf8, 0x70, 0xaa, 0x7f, 0xf0, 0xdd, 0x68,
-		0x11, 0x26, 0x87, 0xa0, 0x24, 0x13, 0xf1, 0x63, 0x57, 0xba, 0x67, 0x1a, 0x6d, 0xd8, 0xaf, 0x88,
-		0x4c, 0x14, 0x82, 0x11, 0xdb, 0x42, 0x15, 0x22, 0x71, 0xbb, 0x46, 0x76, 0x0a, 0x63, 0xd0, 0xfd,
-		0xc3, 0x1e, 0xe3, 0x9a, 0x02, 0x4b, 0x38, 0x43, 0xf5, 0x4f, 0xd7, 0xdc, 0xc7, 0x07, 0x63, 0x45,
-		0xe7, 0xaf, 0xf6, 0xd4, 0x3f, 0xdb, 0x0c, 0xc9, 0x72, 0xc8, 0x4c, 0x4f, 0x3d, 0x85, 0x46, 0xbd,
-		0x67, 0x54, 0xf8, 0xd8, 0x07, 0xbc, 0xbf, 0xd1, 0x72, 0xaa, 0xb4, 0x46, 0x82, 0x3c, 0x5a, 0xf4,
-		0x8c, 0x26, 0xfb, 0xf8, 0x07, 0x7e, 0x9c, 0x47, 0x6a, 0x9e, 0xd2, 0x65, 0x98, 0x8e, 0x14, 0x3c,
-		0xa3, 0xa9, 0xfe, 0x34, 0xa7, 0xca, 0x85, 0xeb, 0x9d, 0xd2, 0x39, 0x48, 0x92, 0xe2, 0x65, 0x34,
-		0xfc, 0xcf, 0x70, 0x38, 0x55, 0x2f, 0x7d, 0x04, 0xd2, 0xa2, 0x68, 0x19, 0x0d, 0xfd, 0xb3, 0x1c,
-		0xea, 0x43, 0x08, 0x5c, 0x14, 0x2c, 0xa3, 0xe1, 0x7f, 0x4e, 0xc0, 0x05, 0x84, 0xc0, 0xc7, 0x77,
-		0xe1, 0xd7, 0xfe, 0x7c, 0x92, 0x2f, 0x3a, 0xc2, 0x77, 0x97, 0x60, 0x8a, 0x57, 0x2a, 0xa3, 0xd1,
-		0x9f, 0xe4, 0x37, 0x17, 0x88, 0xd2, 0x05, 0x98, 0x1c, 0xd3, 0xe1, 0x7f, 0x81, 0x43, 0x99, 0x7e,
-		0xa9, 0x0a, 0xd9, 0x50, 0x75, 0x32, 0x1a, 0xfe, 0x17, 0x39, 0x3c, 0x8c, 0x22, 0xa6, 0xf3, 0xea,
-		0x64, 0x34, 0xc1, 0x5f, 0x12, 0xa6, 0x73, 0x04, 0x71, 0x9b, 0x28, 0x4c, 0x46, 0xa3, 0x3f, 0x25,
-		0xbc, 0x2e, 0x20, 0xa5, 0x97, 0x20, 0xe3, 0x2f, 0x36, 0xa3, 0xf1, 0x3f, 0xc9, 0xf1, 0x01, 0x86,
-		0x78, 0x20, 0xb4, 0xd8, 0x8d, 0xa6, 0xf8, 0xcb, 0xc2, 0x03, 0x21, 0x14, 0x99, 0x46, 0xbd, 0x05,
-		0xcc, 0x68, 0xa6, 0x9f, 0x12, 0xd3, 0xa8, 0xa7, 0x7e, 0x21, 0xa3, 0x49, 0x73, 0xfe, 0x68, 0x8a,
-		0xbf, 0x22, 0x46, 0x93, 0xea, 0x13, 0x33, 0x7a, 0x2b, 0x82, 0xd1, 0x1c, 0x3f, 0x2d, 0xcc, 0xe8,
-		0x29, 0x08, 0x4a, 0x0d, 0x40, 0xfd, 0xd5, 0xc0, 0x68, 0xbe, 0x4f, 0x73, 0xbe, 0xd9, 0xbe, 0x62,
-		0xa0, 0xf4, 0x0a, 0x1c, 0x1f, 0x5c, 0x09, 0x8c, 0x66, 0xfd, 0x99, 0x0f, 0x7a, 0xf6, 0x6e, 0xe1,
-		0x42, 0xa0, 0xb4, 0x15, 0x2c, 0x29, 0xe1, 0x2a, 0x60, 0x34, 0xed, 0x67, 0x3e, 0x88, 0x26, 0xee,
-		0x70, 0x11, 0x50, 0x2a, 0x03, 0x04, 0x0b, 0xf0, 0x68, 0xae, 0xcf, 0x72, 0xae, 0x10, 0x88, 0x4c,
-		0x0d, 0xbe, 0xfe, 0x8e, 0xc6, 0xdf, 0x15, 0x53, 0x83, 0x23, 0xc8, 0xd4, 0x10, 0x4b, 0xef, 0x68,
-		0xf4, 0xe7, 0xc4, 0xd4, 0x10, 0x10, 0x12, 0xd9, 0xa1, 0xd5, 0x6d, 0x34, 0xc3, 0x3b, 0x22, 0xb2,
-		0x43, 0xa8, 0xd2, 0x06, 0xcc, 0xf6, 0x2d, 0x88, 0xa3, 0xa9, 0x7e, 0x8e, 0x53, 0x49, 0xbd, 0xeb,
-		0x61, 0x78, 0xf1, 0xe2, 0x8b, 0xe1, 0x68, 0xb6, 0xcf, 0xf7, 0x2c, 0x5e, 0x7c, 0x2d, 0x2c, 0x5d,
-		0x82, 0xb4, 0xd9, 0x35, 0x0c, 0x32, 0x79, 0xd0, 0xe1, 0xef, 0x06, 0x16, 0xfe, 0xcb, 0x0f, 0xb8,
-		0x77, 0x04, 0xa0, 0x74, 0x0e, 0x26, 0x71, 0x67, 0x07, 0xb7, 0x46, 0x21, 0xbf, 0xf3, 0x03, 0x91,
-		0x30, 0x89, 0x76, 0xe9, 0x25, 0x00, 0x76, 0x34, 0x42, 0x1f, 0x06, 0x8e, 0xc0, 0xfe, 0xd7, 0x1f,
-		0xf0, 0x97, 0x71, 0x02, 0x48, 0x40, 0xc0, 0x5e, 0xed, 0x39, 0x9c, 0xe0, 0xbb, 0x51, 0x02, 0x3a,
-		0x22, 0x17, 0x61, 0xea, 0x0d, 0xd7, 0x32, 0x3d, 0xb5, 0x3d, 0x0a, 0xfd, 0xdf, 0x38, 0x5a, 0xe8,
-		0x13, 0x87, 0x75, 0x2c, 0x07, 0x7b, 0x6a, 0xdb, 0x1d, 0x85, 0xfd, 0xef, 0x1c, 0xeb, 0x03, 0x08,
-		0x58, 0x53, 0x5d, 0x6f, 0x9c, 0x7e, 0xff, 0x9e, 0x00, 0x0b, 0x00, 0x31, 0x9a, 0xfc, 0xde, 0xc7,
-		0x07, 0xa3, 0xb0, 0xdf, 0x13, 0x46, 0x73, 0xfd, 0xd2, 0x47, 0x20, 0x43, 0x7e, 0xb2, 0x37, 0xec,
-		0x46, 0x80, 0xff, 0x07, 0x07, 0x07, 0x08, 0x72, 0x67, 0xd7, 0x6b, 0x79, 0xfa, 0x68, 0x67, 0x7f,
-		0x9f, 0x8f, 0xb4, 0xd0, 0x2f, 0x95, 0x21, 0xeb, 0x7a, 0xad, 0x56, 0x97, 0xd7, 0xa7, 0x23, 0xe0,
-		0xff, 0xf3, 0x07, 0xfe, 0x91, 0x85, 0x8f, 0x21, 0xa3, 0x7d, 0x6b, 0xdf, 0xb3, 0x2d, 0xfa, 0xc0,
-		0x63, 0x14, 0xc3, 0x07, 0x9c, 0x21, 0x04, 0x29, 0x55, 0x21, 0x47, 0xfa, 0xe2, 0x60, 0x1b, 0xd3,
-		0xa7, 0x53, 0x23, 0x28, 0xfe, 0x17, 0x77, 0x40, 0x04, 0x54, 0xf9, 0xb1, 0xaf, 0xbf, 0xb7, 0x18,
-		0xfb, 0xe6, 0x7b, 0x8b, 0xb1, 0xdf, 0x7d, 0x6f, 0x31, 0xf6, 0xa9, 0x6f, 0x2d, 0x4e, 0x7c, 0xf3,
-		0x5b, 0x8b, 0x13, 0xbf, 0xf5, 0xad, 0xc5, 0x89, 0xc1, 0xa7, 0xc4, 0x70, 0xc5, 0xba, 0x62, 0xb1,
-		0xf3, 0xe1, 0xd7, 0x8b, 0x6d, 0xdd, 0xdb, 0xeb, 0xee, 0xac, 0x68, 0x56, 0x87, 0x1e, 0xe3, 0x06,
-		0xa7, 0xb5, 0xfe, 0x26, 0x07, 0x3e, 0x16, 0x27, 0x1b, 0xe6, 0xe8, 0x59, 0xae, 0x6a, 0x1e, 0x0c,
-		0xf9, 0x56, 0x67, 0x61, 0xe0, 0xc1, 0x70, 0xf1, 0x2a, 0x24, 0xca, 0xe6, 0x01, 0x3a, 0xc9, 0x72,
-		0x9e, 0xd2, 0x75, 0x0c, 0xfe, 0xe6, 0xd7, 0x14, 0xb9, 0xde, 0x76, 0x0c, 0x34, 0x1f, 0xbc, 0x9e,
-		0x19, 0x3b, 0x9d, 0xe3, 0xef, 0x5c, 0x96, 0xa4, 0x4f, 0xbf, 0xbd, 0x34, 0xf1, 0x8b, 0x6f, 0x2f,
-		0x4d, 0x7c, 0xef, 0x9d, 0xa5, 0x89, 0xb7, 0x7e, 0x67, 0x79, 0xa2, 0xb2, 0xdf, 0xdb, 0xdb, 0xaf,
-		0x8d, 0xec, 0x71, 0xba, 0x6c, 0x1e, 0xd0, 0x0e, 0x37, 0x62, 0xaf, 0x4f, 0xd2, 0x43, 0x6f, 0x71,
-		0xc8, 0xbd, 0xd8, 0x7b, 0xc8, 0xfd, 0x0a, 0x36, 0x8c, 0xeb, 0xa6, 0x75, 0xcb, 0xdc, 0x22, 0x6a,
-		0x3b, 0x29, 0xf6, 0x4a, 0x31, 0xfc, 0x54, 0x1c, 0x16, 0xfb, 0xce, 0xb3, 0x79, 0x14, 0x0c, 0xfb,
-		0x68, 0xa9, 0x04, 0xe9, 0x9a, 0x08, 0xae, 0x02, 0x4c, 0xb9, 0x58, 0xb3, 0xcc, 0x96, 0x4b, 0xbb,
-		0x9d, 0x90, 0xc5, 0x25, 0xe9, 0xb6, 0xa9, 0x9a, 0x96, 0xcb, 0xdf, 0x94, 0x64, 0x17, 0x95, 0x9f,
-		0x8d, 0x1d, 0x6d, 0x4c, 0xa7, 0xc5, 0x9d, 0x44, 0x37, 0x9f, 0x1b, 0x79, 0xec, 0xbf, 0x4f, 0x7a,
-		0xe9, 0x77, 0x22, 0x72, 0xf4, 0x3f, 0xae, 0x57, 0x7e, 0x3a, 0x0e, 0x4b, 0xbd, 0x5e, 0x21, 0x53,
-		0xcb, 0xf5, 0xd4, 0x8e, 0x3d, 0xcc, 0x2d, 0x97, 0x20, 0xb3, 0x25, 0x74, 0x8e, 0xec, 0x97, 0xbb,
-		0x47, 0xf4, 0x4b, 0xde, 0xbf, 0x95, 0x70, 0xcc, 0xd9, 0x31, 0x1d, 0xe3, 0xf7, 0xe3, 0x9e, 0x3c,
-		0xf3, 0x7f, 0x52, 0x70, 0x52, 0xb3, 0xdc, 0x8e, 0xe5, 0x2a, 0x6c, 0x2a, 0xb0, 0x0b, 0xee, 0x93,
-		0x5c, 0xb8, 0x69, 0xf4, 0x83, 0x92, 0xe2, 0x75, 0x98, 0x5b, 0x25, 0xe9, 0x82, 0x6c, 0x83, 0x82,
-		0x47, 0x3c, 0x03, 0x5f, 0x26, 0x5d, 0x8e, 0x54, 0xfc, 0xfc, 0x11, 0x53, 0x58, 0x54, 0xfc, 0x58,
-		0x0c, 0xa4, 0xa6, 0xa6, 0x1a, 0xaa, 0xf3, 0xff, 0x4b, 0x85, 0x2e, 0x00, 0xd0, 0x8f, 0x90, 0x82,
-		0xaf, 0x86, 0xf2, 0x67, 0x0b, 0x2b, 0xe1, 0xce, 0xad, 0xb0, 0x3b, 0xd1, 0x4f, 0x12, 0x32, 0x54,
-		0x97, 0xfc, 0x7c, 0xf2, 0x55, 0x80, 0xa0, 0x01, 0x3d, 0x00, 0x27, 0x9a, 0xd5, 0xf2, 0x5a, 0x59,
-		0x56, 0xd8, 0xdb, 0xed, 0x1b, 0xcd, 0x46, 0xbd, 0xba, 0x7a, 0x79, 0xb5, 0x5e, 0x93, 0x26, 0xd0,
-		0x71, 0x40, 0xe1, 0x46, 0xff, 0xc5, 0x94, 0x63, 0x30, 0x1b, 0x96, 0xb3, 0x57, 0xe4, 0xe3, 0xa4,
-		0x54, 0xd4, 0x3b, 0xb6, 0x81, 0xe9, 0xb3, 0x3f, 0x45, 0x17, 0x5e, 0x1b, 0x5d, 0x85, 0xfc, 0xda,
-		0xbf, 0x61, 0xaf, 0x4d, 0xcf, 0x05, 0x70, 0xdf, 0xe7, 0xa5, 0x35, 0x98, 0x55, 0x35, 0x0d, 0xdb,
-		0x11, 0xca, 0x11, 0xb9, 0x9a, 0x10, 0xd2, 0xa7, 0x99, 0x1c, 0x19, 0xb0, 0x5d, 0x80, 0x94, 0x4b,
-		0x7b, 0x3f, 0x8a, 0xe2, 0x1b, 0x9c, 0x82, 0xab, 0x97, 0x4c, 0x98, 0x25, 0xa5, 0x9f, 0xea, 0xe0,
-		0x90, 0x19, 0x87, 0x1f, 0x34, 0xfc, 0xa3, 0x2f, 0x3f, 0x4b, 0x9f, 0x6d, 0x3e, 0x1c, 0x1d, 0x96,
-		0x01, 0xe1, 0x24, 0x4b, 0x9c, 0x3b, 0x30, 0x14, 0x43, 0x5e, 0xdc, 0x8f, 0x1b, 0x7c, 0xf8, 0xcd,
-		0xfe, 0x31, 0xbf, 0xd9, 0xe2, 0xa0, 0x18, 0x08, 0xdd, 0x69, 0x9a, 0xb3, 0xb2, 0x86, 0x4a, 0x7d,
-		0xd8, 0x9c, 0x7e, 0xfd, 0xa9, 0xd0, 0xf2, 0xc4, 0x28, 0xf9, 0x9f, 0x67, 0x28, 0xf3, 0xa5, 0xf0,
-		0x6d, 0xfc, 0xb9, 0xf7, 0x9b, 0x09, 0x58, 0xe4, 0xca, 0x3b, 0xaa, 0x8b, 0xcf, 0xdc, 0x7c, 0x6e,
-		0x07, 0x7b, 0xea, 0x73, 0x67, 0x34, 0x4b, 0x17, 0xb9, 0x7a, 0x8e, 0x4f, 0x47, 0xd2, 0xbe, 0xc2,
-		0xdb, 0x07, 0x2f, 0x5c, 0x0b, 0xc3, 0xa7, 0x71, 0x71, 0x1b, 0x92, 0x55, 0x4b, 0x37, 0x49, 0xaa,
-		0x6a, 0x61, 0xd3, 0xea, 0xf0, 0xd9, 0xc3, 0x2e, 0xd0, 0x73, 0x90, 0x52, 0x3b, 0x56, 0xd7, 0xf4,
-		0xd8, 0xcc, 0xa9, 0x9c, 0xfc, 0xfa, 0xbb, 0x4b, 0x13, 0xff, 0xf6, 0xdd, 0xa5, 0xc4, 0xaa, 0xe9,
-		0xfd, 0xc6, 0x57, 0x9e, 0x01, 0x4e, 0xb5, 0x6a, 0x7a, 0x32, 0x57, 0x2c, 0x25, 0xdf, 0x7f, 0x7b,
-		0x29, 0x56, 0x7c, 0x15, 0xa6, 0x6a, 0x58, 0xbb, 0x17, 0xe6, 0x1a, 0xd6, 0x42, 0xcc, 0x35, 0xac,
-		0xf5, 0x30, 0x5f, 0x80, 0xf4, 0xaa, 0xe9, 0xb1, 0x37, 0xd1, 0x9f, 0x82, 0x84, 0x6e, 0xb2, 0x97,
-		0x1b, 0x0f, 0xb5, 0x8d, 0x68, 0x11, 0x60, 0x0d, 0x6b, 0x3e, 0xb0, 0x85, 0xb5, 0x5e, 0x60, 0xff,
-		0xad, 0x89, 0x56, 0xa5, 0xf6, 0x5b, 0xff, 0x71, 0x71, 0xe2, 0xad, 0xf7, 0x16, 0x27, 0x86, 0x0e,
-		0x71, 0x71, 0xe8, 0x10, 0xbb, 0xad, 0x7d, 0x96, 0x91, 0xfd, 0x91, 0xfd, 0x62, 0x12, 0x1e, 0xa2,
-		0x1f, 0x28, 0x39, 0x1d, 0xdd, 0xf4, 0xce, 0x68, 0xce, 0x81, 0xed, 0xd1, 0x92, 0xc5, 0xda, 0xe5,
-		0x03, 0x3b, 0x1b, 0x34, 0xaf, 0xb0, 0xe6, 0x21, 0xf5, 0xc8, 0x2e, 0x4c, 0x36, 0x08, 0x8e, 0xb8,
-		0xd8, 0xb3, 0x3c, 0xd5, 0xe0, 0xeb, 0x0f, 0xbb, 0x20, 0x52, 0xf6, 0x51, 0x53, 0x9c, 0x49, 0x75,
-		0xf1, 0x3d, 0x93, 0x81, 0xd5, 0x5d, 0xf6, 0x6e, 0x78, 0x82, 0x96, 0x29, 0x69, 0x22, 0xa0, 0xaf,
-		0x81, 0xcf, 0xc3, 0xa4, 0xda, 0x65, 0x2f, 0x31, 0x24, 0x48, 0xfd, 0x42, 0x2f, 0x8a, 0xd7, 0x61,
-		0x8a, 0x3f, 0x4a, 0x45, 0x12, 0x24, 0xf6, 0xf1, 0x01, 0xbd, 0x4f, 0x4e, 0x26, 0x3f, 0xd1, 0x0a,
-		0x4c, 0x52, 0xe3, 0xf9, 0x47, 0x2f, 0x85, 0x95, 0x3e, 0xeb, 0x57, 0xa8, 0x91, 0x32, 0x53, 0x2b,
-		0x5e, 0x83, 0x74, 0xcd, 0xea, 0xe8, 0xa6, 0x15, 0x65, 0xcb, 0x30, 0x36, 0x6a, 0xb3, 0xdd, 0xe5,
-		0x51, 0x21, 0xb3, 0x0b, 0x74, 0x1c, 0x52, 0xec, 0x5b, 0x01, 0xfe, 0x22, 0x06, 0xbf, 0x2a, 0x56,
-		0x61, 0x8a, 0x72, 0x6f, 0xda, 0x24, 0xf9, 0xfb, 0xaf, 0x65, 0x66, 0xf8, 0x97, 0x63, 0x9c, 0x3e,
-		0x1e, 0x18, 0x8b, 0x20, 0xd9, 0x52, 0x3d, 0x95, 0xf7, 0x9b, 0xfe, 0x2e, 0x7e, 0x14, 0xd2, 0x9c,
-		0xc4, 0x45, 0x67, 0x21, 0x61, 0xd9, 0x2e, 0x7f, 0x95, 0x62, 0x61, 0x58, 0x57, 0x36, 0xed, 0x4a,
-		0x92, 0xc4, 0x8c, 0x4c, 0x94, 0x2b, 0xf2, 0xd0, 0xb0, 0x78, 0x31, 0x14, 0x16, 0xa1, 0x21, 0x0f,
-		0xfd, 0x64, 0x43, 0xda, 0x17, 0x0e, 0x7e, 0xb0, 0xbc, 0x13, 0x87, 0xc5, 0x50, 0xeb, 0x4d, 0xec,
-		0xb8, 0xba, 0x65, 0xb2, 0x88, 0xe2, 0xd1, 0x82, 0x42, 0x46, 0xf2, 0xf6, 0x21, 0xe1, 0xf2, 0x11,
-		0x48, 0x94, 0x6d, 0x1b, 0x2d, 0x40, 0x9a, 0x5e, 0x6b, 0x16, 0x8b, 0x97, 0xa4, 0xec, 0x5f, 0x93,
-		0x36, 0xd7, 0xda, 0xf5, 0x6e, 0xa9, 0x8e, 0xff, 0x39, 0x9d, 0xb8, 0x2e, 0x5e, 0x84, 0x4c, 0xd5,
-		0x32, 0x5d, 0x6c, 0xba, 0x5d, 0x5a, 0xd9, 0xec, 0x18, 0x96, 0xb6, 0xcf, 0x19, 0xd8, 0x05, 0x71,
-		0xb8, 0x6a, 0xdb, 0x14, 0x99, 0x94, 0xc9, 0x4f, 0x36, 0x67, 0x2b, 0xcd, 0xa1, 0x2e, 0xba, 0x78,
-		0x74, 0x17, 0xf1, 0x4e, 0xfa, 0x3e, 0xfa, 0xfd, 0x18, 0x3c, 0xd8, 0x3f, 0xa1, 0xf6, 0xf1, 0x81,
-		0x7b, 0xd4, 0xf9, 0xf4, 0x2a, 0x64, 0x1a, 0xf4, 0x9b, 0xf6, 0xeb, 0xf8, 0x00, 0x2d, 0xc0, 0x14,
-		0x6e, 0x9d, 0x3d, 0x77, 0xee, 0xb9, 0x8b, 0x2c, 0xda, 0xaf, 0x4e, 0xc8, 0x42, 0x80, 0x16, 0x21,
-		0xe3, 0x62, 0xcd, 0x3e, 0x7b, 0xee, 0xfc, 0xfe, 0x73, 0x2c, 0xbc, 0xae, 0x4e, 0xc8, 0x81, 0xa8,
-		0x94, 0x26, 0xbd, 0x7e, 0xff, 0x9d, 0xa5, 0x58, 0x65, 0x12, 0x12, 0x6e, 0xb7, 0xf3, 0xa1, 0xc6,
-		0xc8, 0x67, 0x26, 0x61, 0x39, 0x8c, 0xa4, 0xf5, 0xdf, 0x4d, 0xd5, 0xd0, 0x5b, 0x6a, 0xf0, 0xdf,
-		0x08, 0xa4, 0x90, 0x0f, 0xa8, 0xc6, 0x90, 0x95, 0xe2, 0x50, 0x4f, 0x16, 0x7f, 0x39, 0x06, 0xb9,
-		0x1b, 0x82, 0xb9, 0x89, 0x3d, 0x74, 0x09, 0xc0, 0xbf, 0x93, 0x98, 0x36, 0x0f, 0xac, 0xf4, 0xde,
-		0x6b, 0xc5, 0xc7, 0xc8, 0x21, 0x75, 0x74, 0x81, 0x06, 0xa2, 0x6d, 0xb9, 0xfc, 0x13, 0xab, 0x11,
-		0x50, 0x5f, 0x19, 0x3d, 0x0d, 0x88, 0x66, 0x38, 0xe5, 0xa6, 0xe5, 0xe9, 0x66, 0x5b, 0xb1, 0xad,
-		0x5b, 0xfc, 0xc3, 0xd5, 0x84, 0x2c, 0xd1, 0x96, 0x1b, 0xb4, 0xa1, 0x41, 0xe4, 0xc4, 0xe8, 0x8c,
-		0xcf, 0x42, 0x8a, 0x75, 0xb5, 0xd5, 0x72, 0xb0, 0xeb, 0xf2, 0x24, 0x26, 0x2e, 0xd1, 0x25, 0x98,
-		0xb2, 0xbb, 0x3b, 0x8a, 0xc8, 0x18, 0xd9, 0xb3, 0x0f, 0x0e, 0x9a, 0xff, 0x22, 0x3e, 0x78, 0x06,
-		0x48, 0xd9, 0xdd, 0x1d, 0x12, 0x2d, 0x0f, 0x43, 0x6e, 0x80, 0x31, 0xd9, 0x9b, 0x81, 0x1d, 0xf4,
-		0x5f, 0x29, 0xf0, 0x1e, 0x28, 0xb6, 0xa3, 0x5b, 0x8e, 0xee, 0x1d, 0xd0, 0xf7, 0xa1, 0x12, 0xb2,
-		0x24, 0x1a, 0x1a, 0x5c, 0x5e, 0xdc, 0x87, 0x99, 0x26, 0x2d, 0xe2, 0x02, 0xcb, 0xcf, 0x05, 0xf6,
-		0xc5, 0x46, 0xdb, 0x37, 0xd4, 0xb2, 0x78, 0x9f, 0x65, 0x95, 0x97, 0x87, 0x46, 0xe7, 0x85, 0xa3,
-		0x47, 0x67, 0x74, 0xb5, 0xfb, 0xbd, 0x93, 0x91, 0xc9, 0xc9, 0x82, 0x33, 0x9c, 0xbe, 0xc6, 0x0d,
-		0xcc, 0x51, 0x7b, 0xb4, 0x85, 0xc3, 0x17, 0xd5, 0x85, 0x11, 0x69, 0x74, 0x61, 0xe4, 0x14, 0x2a,
-		0x5e, 0x84, 0xe9, 0x86, 0xea, 0x78, 0x4d, 0xec, 0x5d, 0xc5, 0x6a, 0x0b, 0x3b, 0xd1, 0x55, 0x77,
-		0x5a, 0xac, 0xba, 0x08, 0x92, 0x74, 0x69, 0x65, 0xab, 0x0e, 0xfd, 0x5d, 0xdc, 0x83, 0x24, 0x7d,
-		0x27, 0xd2, 0x5f, 0x91, 0x39, 0x82, 0xad, 0xc8, 0x24, 0x97, 0x1e, 0x78, 0xd8, 0x15, 0x87, 0x06,
-		0xf4, 0x02, 0xbd, 0x20, 0xd6, 0xd5, 0xc4, 0xe1, 0xeb, 0x2a, 0x0f, 0x44, 0xbe, 0xba, 0x1a, 0x30,
-		0x55, 0x21, 0xa9, 0x78, 0xb5, 0xe6, 0x1b, 0x12, 0x0b, 0x0c, 0x41, 0xeb, 0x30, 0x63, 0xab, 0x8e,
-		0x47, 0x3f, 0x0f, 0xd9, 0xa3, 0xbd, 0xe0, 0xb1, 0xbe, 0xd4, 0x3f, 0xf3, 0x22, 0x9d, 0xe5, 0x77,
-		0x99, 0xb6, 0xc3, 0xc2, 0xe2, 0x7f, 0x4a, 0x42, 0x8a, 0x3b, 0xe3, 0x23, 0x30, 0xc5, 0xdd, 0xca,
-		0xa3, 0xf3, 0xa1, 0x95, 0xfe, 0x85, 0x69, 0xc5, 0x5f, 0x40, 0x38, 0x9f, 0xc0, 0xa0, 0xc7, 0x21,
-		0xad, 0xed, 0xa9, 0xba, 0xa9, 0xe8, 0x2d, 0x5e, 0x10, 0x66, 0xdf, 0x7b, 0x77, 0x69, 0xaa, 0x4a,
-		0x64, 0xab, 0x35, 0x79, 0x8a, 0x36, 0xae, 0xb6, 0x48, 0x25, 0xb0, 0x87, 0xf5, 0xf6, 0x9e, 0xc7,
-		0x67, 0x18, 0xbf, 0x42, 0x2f, 0x42, 0x92, 0x04, 0x04, 0xff, 0x78, 0x70, 0xa1, 0xaf, 0xc2, 0xf7,
-		0xb7, 0xd0, 0x95, 0x34, 0xb9, 0xf1, 0xa7, 0xfe, 0xc3, 0x52, 0x4c, 0xa6, 0x08, 0x54, 0x85, 0x69,
-		0x43, 0x75, 0x3d, 0x85, 0xae, 0x60, 0xe4, 0xf6, 0x93, 0x94, 0xe2, 0x64, 0xbf, 0x43, 0xb8, 0x63,
-		0xb9, 0xe9, 0x59, 0x82, 0x62, 0xa2, 0x16, 0x3a, 0x0d, 0x12, 0x25, 0xd1, 0xac, 0x4e, 0x47, 0xf7,
-		0x58, 0x6d, 0x95, 0xa2, 0x7e, 0xcf, 0x13, 0x79, 0x95, 0x8a, 0x69, 0x85, 0xf5, 0x00, 0x64, 0xe8,
-		0xe7, 0x4a, 0x54, 0x85, 0xbd, 0x88, 0x9b, 0x26, 0x02, 0xda, 0x78, 0x0a, 0x66, 0x82, 0xfc, 0xc8,
-		0x54, 0xd2, 0x8c, 0x25, 0x10, 0x53, 0xc5, 0x67, 0x61, 0xde, 0xc4, 0xb7, 0xe9, 0xab, 0xc1, 0x11,
-		0xed, 0x0c, 0xd5, 0x46, 0xa4, 0xed, 0x46, 0x14, 0xf1, 0x18, 0xe4, 0x35, 0xe1, 0x7c, 0xa6, 0x0b,
-		0x54, 0x77, 0xda, 0x97, 0x52, 0xb5, 0x93, 0x90, 0x56, 0x6d, 0x9b, 0x29, 0x64, 0x79, 0x7e, 0xb4,
-		0x6d, 0xda, 0xf4, 0x24, 0xcc, 0xd2, 0x3e, 0x3a, 0xd8, 0xed, 0x1a, 0x1e, 0x27, 0xc9, 0x51, 0x9d,
-		0x19, 0xd2, 0x20, 0x33, 0x39, 0xd5, 0x7d, 0x04, 0xa6, 0xf1, 0x4d, 0xbd, 0x85, 0x4d, 0x0d, 0x33,
-		0xbd, 0x69, 0xaa, 0x97, 0x13, 0x42, 0xaa, 0xf4, 0x04, 0xf8, 0x79, 0x4f, 0x11, 0x39, 0x39, 0xcf,
-		0xf8, 0x84, 0xbc, 0xcc, 0xc4, 0xc5, 0x02, 0x24, 0x6b, 0xaa, 0xa7, 0x92, 0x02, 0xc3, 0xbb, 0xcd,
-		0x16, 0x9a, 0x9c, 0x4c, 0x7e, 0x16, 0xdf, 0x8f, 0x43, 0xf2, 0x86, 0xe5, 0x61, 0xf4, 0x7c, 0xa8,
-		0x00, 0xcc, 0x0f, 0x8a, 0xe7, 0xa6, 0xde, 0x36, 0x71, 0x6b, 0xdd, 0x6d, 0x87, 0xfe, 0xb7, 0x40,
-		0x10, 0x4e, 0xf1, 0x48, 0x38, 0xcd, 0xc3, 0xa4, 0x63, 0x75, 0xcd, 0x96, 0x78, 0x87, 0x95, 0x5e,
-		0xa0, 0x3a, 0xa4, 0xfd, 0x28, 0x49, 0x8e, 0x8a, 0x92, 0x19, 0x12, 0x25, 0x24, 0x86, 0xb9, 0x40,
-		0x9e, 0xda, 0xe1, 0xc1, 0x52, 0x81, 0x8c, 0x9f, 0xbc, 0x78, 0xb4, 0x8d, 0x17, 0xb0, 0x01, 0x8c,
-		0x2c, 0x26, 0xfe, 0xd8, 0xfb, 0xce, 0x63, 0x11, 0x27, 0xf9, 0x0d, 0xdc, 0x7b, 0x91, 0xb0, 0xe2,
-		0xff, 0xe7, 0x60, 0x8a, 0xf6, 0x2b, 0x08, 0x2b, 0xf6, 0xbf, 0x0e, 0x1e, 0x84, 0x8c, 0xab, 0xb7,
-		0x4d, 0xd5, 0xeb, 0x3a, 0x98, 0x47, 0x5e, 0x20, 0x28, 0x7e, 0x2d, 0x06, 0x29, 0x16, 0xc9, 0x21,
-		0xbf, 0xc5, 0x06, 0xfb, 0x2d, 0x3e, 0xcc, 0x6f, 0x89, 0x7b, 0xf7, 0x5b, 0x19, 0xc0, 0x37, 0xc6,
-		0xe5, 0x9f, 0x9f, 0x0f, 0xa8, 0x18, 0x98, 0x89, 0x4d, 0xbd, 0xcd, 0x27, 0x6a, 0x08, 0x54, 0xfc,
-		0xf7, 0x31, 0x52, 0xc4, 0xf2, 0x76, 0x54, 0x86, 0x69, 0x61, 0x97, 0xb2, 0x6b, 0xa8, 0x6d, 0x1e,
-		0x3b, 0x0f, 0x0d, 0x35, 0xee, 0xb2, 0xa1, 0xb6, 0xe5, 0x2c, 0xb7, 0x87, 0x5c, 0x0c, 0x1e, 0x87,
-		0xf8, 0x90, 0x71, 0x88, 0x0c, 0x7c, 0xe2, 0xde, 0x06, 0x3e, 0x32, 0x44, 0xc9, 0xde, 0x21, 0xfa,
-		0x72, 0x9c, 0x6e, 0x66, 0x6c, 0xcb, 0x55, 0x8d, 0x1f, 0xc6, 0x8c, 0x78, 0x00, 0x32, 0xb6, 0x65,
-		0x28, 0xac, 0x85, 0xbd, 0xdb, 0x9d, 0xb6, 0x2d, 0x43, 0xee, 0x1b, 0xf6, 0xc9, 0xfb, 0x34, 0x5d,
-		0x52, 0xf7, 0xc1, 0x6b, 0x53, 0xbd, 0x5e, 0x73, 0x20, 0xc7, 0x5c, 0xc1, 0xd7, 0xb2, 0x67, 0x89,
-		0x0f, 0xe8, 0xe2, 0x18, 0xeb, 0x5f, 0x7b, 0x99, 0xd9, 0x4c, 0x53, 0xe6, 0x7a, 0x04, 0xc1, 0x52,
-		0xff, 0xa0, 0x5d, 0x70, 0x38, 0x2c, 0x65, 0xae, 0x57, 0xfc, 0xab, 0x31, 0x80, 0x35, 0xe2, 0x59,
-		0xda, 0x5f, 0xb2, 0x0a, 0xb9, 0xd4, 0x04, 0x25, 0x72, 0xe7, 0xc5, 0x61, 0x83, 0xc6, 0xef, 0x9f,
-		0x73, 0xc3, 0x76, 0x57, 0x61, 0x3a, 0x08, 0x46, 0x17, 0x0b, 0x63, 0x16, 0x0f, 0xa9, 0xaa, 0x9b,
-		0xd8, 0x93, 0x73, 0x37, 0x43, 0x57, 0xc5, 0x7f, 0x16, 0x83, 0x0c, 0xb5, 0x69, 0x1d, 0x7b, 0x6a,
-		0x64, 0x0c, 0x63, 0xf7, 0x3e, 0x86, 0x0f, 0x01, 0x30, 0x1a, 0x57, 0x7f, 0x13, 0xf3, 0xc8, 0xca,
-		0x50, 0x49, 0x53, 0x7f, 0x13, 0xa3, 0xf3, 0xbe, 0xc3, 0x13, 0x87, 0x3b, 0x5c, 0x54, 0xdd, 0xdc,
-		0xed, 0x27, 0x60, 0x8a, 0xfe, 0xbb, 0xa6, 0xdb, 0x2e, 0x2f, 0xa4, 0x53, 0x66, 0xb7, 0xb3, 0x75,
-		0xdb, 0x2d, 0xbe, 0x01, 0x53, 0x5b, 0xb7, 0xd9, 0xd9, 0xc8, 0x03, 0x90, 0x71, 0x2c, 0x8b, 0xaf,
-		0xc9, 0xac, 0x16, 0x4a, 0x13, 0x01, 0x5d, 0x82, 0xc4, 0x79, 0x40, 0x3c, 0x38, 0x0f, 0x08, 0x0e,
-		0x34, 0x12, 0x63, 0x1d, 0x68, 0x3c, 0xf9, 0x9b, 0x31, 0xc8, 0x86, 0xf2, 0x03, 0x7a, 0x0e, 0x8e,
-		0x55, 0xd6, 0x36, 0xab, 0xd7, 0x95, 0xd5, 0x9a, 0x72, 0x79, 0xad, 0x7c, 0x25, 0xf8, 0x7a, 0x69,
-		0xe1, 0xf8, 0x9d, 0xbb, 0xcb, 0x28, 0xa4, 0xbb, 0x6d, 0xd2, 0x73, 0x7a, 0x74, 0x06, 0xe6, 0xa3,
-		0x90, 0x72, 0xa5, 0x59, 0xdf, 0xd8, 0x92, 0x62, 0x0b, 0xc7, 0xee, 0xdc, 0x5d, 0x9e, 0x0d, 0x21,
-		0xca, 0x3b, 0x2e, 0x36, 0xbd, 0x7e, 0x40, 0x75, 0x73, 0x7d, 0x7d, 0x75, 0x4b, 0x8a, 0xf7, 0x01,
-		0x78, 0xc2, 0x7e, 0x02, 0x66, 0xa3, 0x80, 0x8d, 0xd5, 0x35, 0x29, 0xb1, 0x80, 0xee, 0xdc, 0x5d,
-		0xce, 0x87, 0xb4, 0x37, 0x74, 0x63, 0x21, 0xfd, 0x89, 0xcf, 0x2f, 0x4e, 0xfc, 0xc2, 0xcf, 0x2f,
-		0xc6, 0x48, 0xcf, 0xa6, 0x23, 0x39, 0x02, 0x3d, 0x0d, 0x27, 0x9a, 0xab, 0x57, 0x36, 0xea, 0x35,
-		0x65, 0xbd, 0x79, 0x45, 0x9c, 0x74, 0x8b, 0xde, 0xcd, 0xdc, 0xb9, 0xbb, 0x9c, 0xe5, 0x5d, 0x1a,
-		0xa6, 0xdd, 0x90, 0xeb, 0x37, 0x36, 0xb7, 0xea, 0x52, 0x8c, 0x69, 0x37, 0x1c, 0x7c, 0xd3, 0xf2,
-		0xd8, 0xff, 0x73, 0x7b, 0x16, 0x4e, 0x0e, 0xd0, 0xf6, 0x3b, 0x36, 0x7b, 0xe7, 0xee, 0xf2, 0x74,
-		0xc3, 0xc1, 0x6c, 0xfe, 0x50, 0xc4, 0x0a, 0x14, 0xfa, 0x11, 0x9b, 0x8d, 0xcd, 0x66, 0x79, 0x4d,
-		0x5a, 0x5e, 0x90, 0xee, 0xdc, 0x5d, 0xce, 0x89, 0x64, 0x48, 0xf4, 0x83, 0x9e, 0x7d, 0x98, 0x3b,
-		0x9e, 0xf7, 0x9f, 0x82, 0x47, 0xf9, 0x19, 0xa0, 0xeb, 0xa9, 0xfb, 0xba, 0xd9, 0xf6, 0x0f, 0x6f,
-		0xf9, 0x35, 0xdf, 0xf9, 0x1c, 0xe7, 0xe7, 0x8c, 0x42, 0x3a, 0xe2, 0x08, 0x77, 0xe8, 0xd3, 0xcb,
-		0x85, 0x11, 0x0f, 0xf5, 0x46, 0x6f, 0x9d, 0x86, 0x1f, 0x0f, 0x2f, 0x8c, 0x38, 0x84, 0x5e, 0x38,
-		0x74, 0x73, 0x57, 0xfc, 0x64, 0x0c, 0xf2, 0x57, 0x75, 0xd7, 0xb3, 0x1c, 0x5d, 0x53, 0x0d, 0xfa,
-		0xcd, 0xd2, 0xf9, 0x71, 0x73, 0x6b, 0xcf, 0x54, 0x7f, 0x09, 0x52, 0x37, 0x55, 0x83, 0x25, 0xb5,
-		0xf0, 0xb3, 0x80, 0x5e, 0xf7, 0x05, 0xa9, 0x4d, 0x10, 0x30, 0x58, 0xf1, 0x4b, 0x71, 0x98, 0xa1,
-		0x93, 0xc1, 0x65, 0xff, 0x8e, 0x8b, 0xec, 0xb1, 0x1a, 0x90, 0x74, 0x54, 0x8f, 0x1f, 0x1a, 0x56,
-		0x7e, 0x84, 0x9f, 0x03, 0x3f, 0x3e, 0xfa, 0x34, 0x77, 0xa5, 0xff, 0xa8, 0x98, 0x32, 0xa1, 0x57,
-		0x20, 0xdd, 0x51, 0x6f, 0x2b, 0x94, 0x35, 0x7e, 0x1f, 0x58, 0xa7, 0x3a, 0xea, 0x6d, 0x62, 0x2b,
-		0x6a, 0xc1, 0x0c, 0x21, 0xd6, 0xf6, 0x54, 0xb3, 0x8d, 0x19, 0x7f, 0xe2, 0x3e, 0xf0, 0x4f, 0x77,
-		0xd4, 0xdb, 0x55, 0xca, 0x49, 0xee, 0x52, 0x4a, 0x7f, 0xfa, 0xed, 0xa5, 0x09, 0x7a, 0xcc, 0xfe,
-		0xab, 0x31, 0x80, 0xc0, 0x5d, 0xe8, 0x8f, 0x83, 0xa4, 0xf9, 0x57, 0xf4, 0xf6, 0x2e, 0x1f, 0xc0,
-		0x53, 0xc3, 0x06, 0xa2, 0xc7, 0xd9, 0x6c, 0x61, 0xfe, 0xe6, 0xbb, 0x4b, 0x31, 0x79, 0x46, 0xeb,
-		0x19, 0x87, 0x3a, 0x64, 0xbb, 0x76, 0x4b, 0xf5, 0xb0, 0x42, 0x37, 0x71, 0xf1, 0x23, 0x2c, 0xf2,
-		0xc0, 0x80, 0xa4, 0x29, 0x64, 0xfd, 0x97, 0x62, 0x90, 0xad, 0x85, 0x1e, 0xf2, 0x15, 0x60, 0xaa,
-		0x63, 0x99, 0xfa, 0x3e, 0x0f, 0xbb, 0x8c, 0x2c, 0x2e, 0xd1, 0x02, 0xa4, 0xd9, 0xd7, 0x9a, 0xde,
-		0x81, 0x38, 0xf1, 0x14, 0xd7, 0x04, 0x75, 0x0b, 0xef, 0xb8, 0xba, 0xf0, 0xb5, 0x2c, 0x2e, 0xc9,
-		0xd6, 0xc5, 0xc5, 0x5a, 0xd7, 0xd1, 0xbd, 0x03, 0x45, 0xb3, 0x4c, 0x4f, 0xd5, 0x3c, 0xfe, 0xdd,
-		0xdf, 0x8c, 0x90, 0x57, 0x99, 0x98, 0x90, 0xb4, 0xb0, 0xa7, 0xea, 0x86, 0x5b, 0x60, 0x0f, 0xc2,
-		0xc4, 0x65, 0xc8, 0xdc, 0x5f, 0x4b, 0x85, 0x8f, 0xa8, 0xaa, 0x20, 0x59, 0x36, 0x76, 0x22, 0x25,
-		0x25, 0x8b, 0xd0, 0xc2, 0x6f, 0x7c, 0xe5, 0x99, 0x79, 0xee, 0x6e, 0x5e, 0x54, 0xb2, 0x17, 0x5b,
-		0xe5, 0x19, 0x81, 0x10, 0xb5, 0xe6, 0x6b, 0x64, 0xc0, 0xc4, 0x7e, 0xcf, 0xee, 0xee, 0x04, 0xc7,
-		0x5a, 0xf3, 0x7d, 0x7e, 0x2d, 0x9b, 0x07, 0x95, 0xc2, 0x37, 0x02, 0xea, 0xe0, 0x2c, 0xe9, 0x3a,
-		0x3e, 0x20, 0xa3, 0xc5, 0x79, 0x1a, 0x94, 0x86, 0x94, 0x88, 0x6f, 0xa8, 0xba, 0x21, 0x3e, 0x42,
-		0x97, 0xf9, 0x15, 0x2a, 0x41, 0xca, 0xf5, 0x54, 0xaf, 0xeb, 0xf2, 0x7f, 0x16, 0x57, 0x1c, 0x16,
-		0x19, 0x15, 0xcb, 0x6c, 0x35, 0xa9, 0xa6, 0xcc, 0x11, 0x68, 0x0b, 0x52, 0x9e, 0xb5, 0x8f, 0x4d,
-		0xee, 0xa4, 0x23, 0x45, 0xf5, 0x80, 0x67, 0x51, 0x8c, 0x0b, 0xb5, 0x41, 0x6a, 0x61, 0x03, 0xb7,
-		0x59, 0x41, 0xb4, 0xa7, 0x92, 0x7d, 0x43, 0xea, 0x3e, 0xcc, 0x9a, 0x19, 0x9f, 0xb5, 0x49, 0x49,
-		0xd1, 0xf5, 0xe8, 0x63, 0x66, 0xf6, 0x9f, 0x15, 0x1f, 0x19, 0xd6, 0xff, 0x50, 0x64, 0x8a, 0xc3,
-		0x84, 0xf0, 0x13, 0xe9, 0x27, 0x40, 0xea, 0x9a, 0x3b, 0x96, 0x49, 0x3f, 0x15, 0xe5, 0xc5, 0x78,
-		0x9a, 0x96, 0x37, 0x33, 0xbe, 0xfc, 0x2a, 0xab, 0xca, 0xaf, 0x43, 0x3e, 0x50, 0xa5, 0x73, 0x27,
-		0x73, 0x84, 0xb9, 0x33, 0xed, 0x63, 0x49, 0x2b, 0xba, 0x0a, 0x10, 0x4c, 0x4c, 0x7a, 0x3c, 0x90,
-		0x1d, 0x3e, 0x86, 0xc1, 0xec, 0x16, 0xdb, 0xac, 0x00, 0x8b, 0x0c, 0x98, 0xeb, 0xe8, 0xa6, 0xe2,
-		0x62, 0x63, 0x57, 0xe1, 0xae, 0x22, 0x94, 0xd9, 0xfb, 0x30, 0xb4, 0xb3, 0x1d, 0xdd, 0x6c, 0x62,
-		0x63, 0xb7, 0xe6, 0xd3, 0x96, 0x72, 0x9f, 0x78, 0x7b, 0x69, 0x82, 0xcf, 0xa5, 0x89, 0x62, 0x83,
-		0x1e, 0x51, 0xf3, 0x69, 0x80, 0x5d, 0x74, 0x1e, 0x32, 0xaa, 0xb8, 0xa0, 0x07, 0x07, 0x87, 0x4d,
-		0xa3, 0x40, 0x95, 0xcd, 0xce, 0xb7, 0x7e, 0x67, 0x39, 0x56, 0xfc, 0xf9, 0x18, 0xa4, 0x6a, 0x37,
-		0x1a, 0xaa, 0xee, 0xa0, 0x3a, 0xcc, 0x06, 0x01, 0x35, 0xee, 0xdc, 0x0c, 0x62, 0x50, 0x4c, 0xce,
-		0xfa, 0xb0, 0x5d, 0xe3, 0xa1, 0x34, 0xbd, 0xfb, 0xc9, 0x9e, 0x8e, 0xd7, 0x61, 0x8a, 0x59, 0xe9,
-		0xa2, 0x12, 0x4c, 0xda, 0xe4, 0x07, 0x3f, 0x91, 0x5f, 0x1c, 0x1a, 0x88, 0x54, 0xdf, 0x3f, 0x41,
-		0x24, 0x90, 0xe2, 0xef, 0xc7, 0x00, 0x6a, 0x37, 0x6e, 0x6c, 0x39, 0xba, 0x6d, 0x60, 0xef, 0x7e,
-		0xf5, 0x78, 0x0d, 0x8e, 0x85, 0xb6, 0x26, 0x8e, 0x36, 0x76, 0xaf, 0xe7, 0x82, 0xcd, 0x89, 0xa3,
-		0x0d, 0x64, 0x6b, 0xb9, 0x9e, 0xcf, 0x96, 0x18, 0x9b, 0xad, 0xe6, 0x7a, 0x83, 0xdd, 0xd8, 0x84,
-		0x6c, 0xd0, 0x7d, 0x17, 0xd5, 0x20, 0xed, 0xf1, 0xdf, 0xdc, 0x9b, 0xc5, 0xe1, 0xde, 0x14, 0x30,
-		0xee, 0x51, 0x1f, 0x59, 0xfc, 0xbf, 0xc4, 0xa9, 0x7e, 0xc4, 0xfe, 0xe1, 0x0a, 0x23, 0x92, 0x7b,
-		0x79, 0x6e, 0xbc, 0x1f, 0x15, 0x05, 0xe7, 0xea, 0xf1, 0xea, 0xc7, 0xe3, 0x30, 0xb7, 0x2d, 0xb2,
-		0xcd, 0x1f, 0x5a, 0x4f, 0x34, 0x60, 0x0a, 0x9b, 0x9e, 0xa3, 0x53, 0x57, 0x90, 0xb1, 0x7e, 0x76,
-		0xd8, 0x58, 0x0f, 0xe8, 0x0b, 0xfd, 0x9f, 0x45, 0xe2, 0x5c, 0x9b, 0xd3, 0xf4, 0x78, 0xe1, 0xdf,
-		0xc5, 0xa1, 0x30, 0x0c, 0x89, 0x4e, 0xc1, 0x8c, 0xe6, 0x60, 0x2a, 0x50, 0x22, 0x87, 0x6b, 0x79,
-		0x21, 0xe6, 0x49, 0x7f, 0x1d, 0x48, 0x01, 0x45, 0x02, 0x8b, 0xa8, 0x1e, 0xb9, 0x62, 0xca, 0x07,
-		0x60, 0x9a, 0xf6, 0x31, 0xcc, 0xe8, 0xa6, 0xee, 0xe9, 0xaa, 0xa1, 0xec, 0xa8, 0x86, 0x6a, 0x6a,
-		0xf7, 0x52, 0x59, 0xf6, 0x27, 0xea, 0x3c, 0x27, 0xad, 0x30, 0x4e, 0x74, 0x03, 0xa6, 0x04, 0x7d,
-		0xf2, 0x3e, 0xd0, 0x0b, 0xb2, 0x50, 0x15, 0xf5, 0xdb, 0x71, 0x98, 0x95, 0x71, 0xeb, 0x8f, 0x96,
-		0x5b, 0x7f, 0x14, 0x80, 0x4d, 0x38, 0x92, 0x07, 0xef, 0xc1, 0xb3, 0xfd, 0x13, 0x38, 0xc3, 0xf8,
-		0x6a, 0xae, 0x17, 0xf2, 0xed, 0x37, 0xe2, 0x90, 0x0b, 0xfb, 0xf6, 0x8f, 0xc0, 0xba, 0x80, 0x56,
-		0x83, 0x6c, 0x90, 0xe4, 0xff, 0x6d, 0x75, 0x48, 0x36, 0xe8, 0x8b, 0xba, 0xc3, 0xd3, 0xc0, 0xf7,
-		0xe3, 0x90, 0x6a, 0xa8, 0x8e, 0xda, 0x71, 0xd1, 0xb5, 0xbe, 0x02, 0x4e, 0x9c, 0xb2, 0xf5, 0xfd,
-		0x4f, 0x6d, 0xbe, 0xa9, 0x67, 0x21, 0xf7, 0xe9, 0x01, 0xf5, 0xdb, 0x63, 0x90, 0x27, 0x5b, 0xc4,
-		0xd0, 0x03, 0xf9, 0x38, 0x7d, 0xcc, 0x48, 0xf6, 0x78, 0xc1, 0xd3, 0x20, 0xb4, 0x04, 0x59, 0xa2,
-		0x16, 0x24, 0x3a, 0xa2, 0x03, 0x1d, 0xf5, 0x76, 0x9d, 0x49, 0xd0, 0x33, 0x80, 0xf6, 0xfc, 0x4d,
-		0xbb, 0x12, 0xb8, 0x80, 0xe8, 0xcd, 0x06, 0x2d, 0x42, 0xfd, 0x21, 0x00, 0x62, 0x85, 0xc2, 0x5e,
-		0xf2, 0x62, 0x7b, 0x9c, 0x0c, 0x91, 0xd4, 0xe8, 0x8b, 0x5e, 0x3f, 0xc1, 0x6a, 0xc1, 0x9e, 0xdd,
-		0x23, 0x2f, 0xc3, 0xd7, 0x8e, 0x16, 0xa9, 0xdf, 0x7f, 0x77, 0x69, 0xe1, 0x40, 0xed, 0x18, 0xa5,
-		0xe2, 0x00, 0xca, 0x22, 0xad, 0x0d, 0xa3, 0xbb, 0xce, 0x50, 0x04, 0x7f, 0x3e, 0x06, 0x28, 0x48,
-		0xb9, 0x32, 0x76, 0x6d, 0xb2, 0xad, 0x21, 0x45, 0x6f, 0xa8, 0x42, 0x8d, 0x1d, 0x5e, 0xf4, 0x06,
-		0x78, 0x51, 0xf4, 0x86, 0x66, 0xc4, 0xc5, 0x20, 0xc1, 0xc5, 0xf9, 0x18, 0x0e, 0x78, 0x43, 0x6f,
-		0xa5, 0x6a, 0xe9, 0x02, 0xdd, 0x97, 0xc3, 0x26, 0x8a, 0xbf, 0x1d, 0x83, 0x93, 0x7d, 0xd1, 0xe4,
-		0x1b, 0xfb, 0x27, 0x00, 0x39, 0xa1, 0x46, 0xfe, 0x6f, 0xf3, 0x98, 0xd1, 0x47, 0x0e, 0xce, 0x59,
-		0xa7, 0x2f, 0x57, 0x7e, 0x58, 0x39, 0x9a, 0xbd, 0xb9, 0xf7, 0x4f, 0x62, 0x30, 0x1f, 0x36, 0xc6,
-		0xef, 0xd6, 0x06, 0xe4, 0xc2, 0xb6, 0xf0, 0x0e, 0x3d, 0x3a, 0x4e, 0x87, 0x78, 0x5f, 0x22, 0x78,
-		0xf4, 0x72, 0x30, 0x71, 0xd9, 0x61, 0xd1, 0x73, 0x63, 0xfb, 0x46, 0xd8, 0xd4, 0x3b, 0x81, 0x93,
-		0xa2, 0x8a, 0x49, 0x36, 0x2c, 0xcb, 0x40, 0x7f, 0x12, 0x66, 0x4d, 0xcb, 0x53, 0x48, 0x94, 0xe3,
-		0x96, 0xc2, 0x77, 0xae, 0x2c, 0xfb, 0xbd, 0x7c, 0x34, 0x97, 0x7d, 0xe7, 0xdd, 0xa5, 0x7e, 0xaa,
-		0x1e, 0x3f, 0xce, 0x98, 0x96, 0x57, 0xa1, 0xed, 0x5b, 0x6c, 0x5f, 0xeb, 0xc0, 0x74, 0xf4, 0xd6,
-		0x2c, 0x5b, 0xae, 0x1f, 0xf9, 0xd6, 0xd3, 0x87, 0xdd, 0x36, 0xb7, 0x13, 0xba, 0x27, 0x7b, 0xa7,
-		0xe9, 0x7b, 0x6f, 0x2f, 0xc5, 0x9e, 0xfc, 0x6a, 0x0c, 0x20, 0xd8, 0xc2, 0xa3, 0xa7, 0xe1, 0x44,
-		0x65, 0x73, 0xa3, 0xa6, 0x34, 0xb7, 0xca, 0x5b, 0xdb, 0xcd, 0xe8, 0x9b, 0xcf, 0xe2, 0x4c, 0xd8,
-		0xb5, 0xb1, 0xa6, 0xef, 0xea, 0xb8, 0x85, 0x1e, 0x87, 0xf9, 0xa8, 0x36, 0xb9, 0xaa, 0xd7, 0xa4,
-		0xd8, 0x42, 0xee, 0xce, 0xdd, 0xe5, 0x34, 0xab, 0x8e, 0x70, 0x0b, 0x9d, 0x86, 0x63, 0xfd, 0x7a,
-		0xab, 0x1b, 0x57, 0xa4, 0xf8, 0xc2, 0xf4, 0x9d, 0xbb, 0xcb, 0x19, 0xbf, 0x8c, 0x42, 0x45, 0x40,
-		0x61, 0x4d, 0xce, 0x97, 0x58, 0x80, 0x3b, 0x77, 0x97, 0x53, 0xcc, 0x6d, 0x0b, 0xc9, 0x4f, 0x7c,
-		0x7e, 0x71, 0xa2, 0x72, 0x79, 0xe8, 0xa9, 0xef, 0xd3, 0x87, 0x7a, 0xec, 0xb6, 0x7f, 0x92, 0x1b,
-		0x39, 0xea, 0xfd, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x8c, 0xb0, 0x45, 0xd1, 0x08, 0x66, 0x00,
+		// 8001 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7c, 0x6b, 0x70, 0x1c, 0xd9,
+		0x75, 0x1e, 0xe6, 0x81, 0xc1, 0xcc, 0xc1, 0x60, 0xd0, 0x68, 0x80, 0xdc, 0x21, 0xb8, 0x0b, 0x60,
+		0x47, 0xfb, 0xe0, 0xbe, 0xc0, 0x5d, 0xee, 0x92, 0x5c, 0x0e, 0x2d, 0x6d, 0x06, 0x98, 0x21, 0x08,
+		0x12, 0x8f, 0xd9, 0x1e, 0x80, 0xfb, 0x70, 0x9c, 0xae, 0x46, 0xcf, 0xc5, 0xa0, 0x97, 0x3d, 0xdd,
+		0xad, 0xee, 0x1e, 0x92, 0xd8, 0xb2, 0x53, 0xeb, 0x52, 0x1e, 0x16, 0x9d, 0x38, 0x72, 0x9c, 0x8a,
+		0x65, 0x59, 0x54, 0x24, 0xcb, 0x8e, 0x1c, 0x45, 0x79, 0xd8, 0x52, 0xe4, 0xd8, 0xaa, 0x24, 0x4e,
+		0xaa, 0x92, 0x28, 0xfa, 0x91, 0x52, 0xfc, 0x23, 0xb6, 0x13, 0x67, 0x63, 0xaf, 0x5c, 0x89, 0x22,
+		0x2b, 0xb1, 0xe3, 0x6c, 0xaa, 0x92, 0x52, 0x29, 0x95, 0x3a, 0xf7, 0xd1, 0x8f, 0x79, 0x60, 0x06,
+		0x1b, 0xae, 0xa2, 0x2a, 0xff, 0x9a, 0xe9, 0x73, 0xcf, 0xf9, 0xee, 0xbd, 0xe7, 0x9e, 0x7b, 0xee,
+		0xb9, 0xe7, 0xde, 0x6e, 0xf8, 0xbd, 0x15, 0x58, 0x6a, 0xd9, 0x76, 0xcb, 0x24, 0x67, 0x1d, 0xd7,
+		0xf6, 0xed, 0xbd, 0xce, 0xfe, 0xd9, 0x26, 0xf1, 0x74, 0xd7, 0x70, 0x7c, 0xdb, 0x5d, 0xa6, 0x34,
+		0x79, 0x9a, 0x71, 0x2c, 0x0b, 0x8e, 0xd2, 0x26, 0xcc, 0x5c, 0x31, 0x4c, 0x52, 0x0d, 0x18, 0x1b,
+		0xc4, 0x97, 0x5f, 0x84, 0xf4, 0xbe, 0x61, 0x92, 0x62, 0x62, 0x29, 0x75, 0x66, 0xf2, 0xdc, 0x23,
+		0xcb, 0x5d, 0x42, 0xcb, 0x71, 0x89, 0x3a, 0x92, 0x15, 0x2a, 0x51, 0xfa, 0x3f, 0x69, 0x98, 0xed,
+		0x53, 0x2a, 0xcb, 0x90, 0xb6, 0xb4, 0x36, 0x22, 0x26, 0xce, 0xe4, 0x14, 0xfa, 0x5f, 0x2e, 0xc2,
+		0x84, 0xa3, 0xe9, 0x37, 0xb5, 0x16, 0x29, 0x26, 0x29, 0x59, 0x3c, 0xca, 0x0b, 0x00, 0x4d, 0xe2,
+		0x10, 0xab, 0x49, 0x2c, 0xfd, 0xb0, 0x98, 0x5a, 0x4a, 0x9d, 0xc9, 0x29, 0x11, 0x8a, 0xfc, 0x14,
+		0xcc, 0x38, 0x9d, 0x3d, 0xd3, 0xd0, 0xd5, 0x08, 0x1b, 0x2c, 0xa5, 0xce, 0x8c, 0x2b, 0x12, 0x2b,
+		0xa8, 0x86, 0xcc, 0x8f, 0xc3, 0xf4, 0x6d, 0xa2, 0xdd, 0x8c, 0xb2, 0x4e, 0x52, 0xd6, 0x02, 0x92,
+		0x23, 0x8c, 0xab, 0x90, 0x6f, 0x13, 0xcf, 0xd3, 0x5a, 0x44, 0xf5, 0x0f, 0x1d, 0x52, 0x4c, 0xd3,
+		0xde, 0x2f, 0xf5, 0xf4, 0xbe, 0xbb, 0xe7, 0x93, 0x5c, 0x6a, 0xe7, 0xd0, 0x21, 0x72, 0x05, 0x72,
+		0xc4, 0xea, 0xb4, 0x19, 0xc2, 0xf8, 0x00, 0xfd, 0xd5, 0xac, 0x4e, 0xbb, 0x1b, 0x25, 0x8b, 0x62,
+		0x1c, 0x62, 0xc2, 0x23, 0xee, 0x2d, 0x43, 0x27, 0xc5, 0x0c, 0x05, 0x78, 0xbc, 0x07, 0xa0, 0xc1,
+		0xca, 0xbb, 0x31, 0x84, 0x9c, 0xbc, 0x0a, 0x39, 0x72, 0xc7, 0x27, 0x96, 0x67, 0xd8, 0x56, 0x71,
+		0x82, 0x82, 0x3c, 0xda, 0x67, 0x14, 0x89, 0xd9, 0xec, 0x86, 0x08, 0xe5, 0xe4, 0x0b, 0x30, 0x61,
+		0x3b, 0xbe, 0x61, 0x5b, 0x5e, 0x31, 0xbb, 0x94, 0x38, 0x33, 0x79, 0xee, 0xc1, 0xbe, 0x86, 0xb0,
+		0xcd, 0x78, 0x14, 0xc1, 0x2c, 0xaf, 0x83, 0xe4, 0xd9, 0x1d, 0x57, 0x27, 0xaa, 0x6e, 0x37, 0x89,
+		0x6a, 0x58, 0xfb, 0x76, 0x31, 0x47, 0x01, 0x16, 0x7b, 0x3b, 0x42, 0x19, 0x57, 0xed, 0x26, 0x59,
+		0xb7, 0xf6, 0x6d, 0xa5, 0xe0, 0xc5, 0x9e, 0xe5, 0x93, 0x90, 0xf1, 0x0e, 0x2d, 0x5f, 0xbb, 0x53,
+		0xcc, 0x53, 0x0b, 0xe1, 0x4f, 0x68, 0x3a, 0xa4, 0x69, 0x60, 0x75, 0xc5, 0x29, 0x66, 0x3a, 0xfc,
+		0xb1, 0xf4, 0xab, 0x19, 0x98, 0x1e, 0xc5, 0xf8, 0x2e, 0xc3, 0xf8, 0x3e, 0xf6, 0xbf, 0x98, 0x3c,
+		0x8e, 0x76, 0x98, 0x4c, 0x5c, 0xbd, 0x99, 0xf7, 0xa8, 0xde, 0x0a, 0x4c, 0x5a, 0xc4, 0xf3, 0x49,
+		0x93, 0xd9, 0x4a, 0x6a, 0x44, 0x6b, 0x03, 0x26, 0xd4, 0x6b, 0x6c, 0xe9, 0xf7, 0x64, 0x6c, 0xaf,
+		0xc2, 0x74, 0xd0, 0x24, 0xd5, 0xd5, 0xac, 0x96, 0xb0, 0xda, 0xb3, 0xc3, 0x5a, 0xb2, 0x5c, 0x13,
+		0x72, 0x0a, 0x8a, 0x29, 0x05, 0x12, 0x7b, 0x96, 0xab, 0x00, 0xb6, 0x45, 0xec, 0x7d, 0xb5, 0x49,
+		0x74, 0xb3, 0x98, 0x1d, 0xa0, 0xa5, 0x6d, 0x64, 0xe9, 0xd1, 0x92, 0xcd, 0xa8, 0xba, 0x29, 0x5f,
+		0x0a, 0x8d, 0x70, 0x62, 0x80, 0x0d, 0x6d, 0xb2, 0xe9, 0xd7, 0x63, 0x87, 0xbb, 0x50, 0x70, 0x09,
+		0xce, 0x08, 0xd2, 0xe4, 0x3d, 0xcb, 0xd1, 0x46, 0x2c, 0x0f, 0xed, 0x99, 0xc2, 0xc5, 0x58, 0xc7,
+		0xa6, 0xdc, 0xe8, 0xa3, 0xfc, 0x01, 0x08, 0x08, 0x2a, 0x35, 0x2b, 0xa0, 0xfe, 0x29, 0x2f, 0x88,
+		0x5b, 0x5a, 0x9b, 0xcc, 0xbf, 0x09, 0x85, 0xb8, 0x7a, 0xe4, 0x39, 0x18, 0xf7, 0x7c, 0xcd, 0xf5,
+		0xa9, 0x15, 0x8e, 0x2b, 0xec, 0x41, 0x96, 0x20, 0x45, 0xac, 0x26, 0xf5, 0x7f, 0xe3, 0x0a, 0xfe,
+		0x95, 0xff, 0x54, 0xd8, 0xe1, 0x14, 0xed, 0xf0, 0x63, 0xbd, 0x23, 0x1a, 0x43, 0xee, 0xee, 0xf7,
+		0xfc, 0x45, 0x98, 0x8a, 0x75, 0x60, 0xd4, 0xaa, 0x4b, 0x3f, 0x0c, 0x27, 0xfa, 0x42, 0xcb, 0xaf,
+		0xc2, 0x5c, 0xc7, 0x32, 0x2c, 0x9f, 0xb8, 0x8e, 0x4b, 0xd0, 0x62, 0x59, 0x55, 0xc5, 0xff, 0x3c,
+		0x31, 0xc0, 0xe6, 0x76, 0xa3, 0xdc, 0x0c, 0x45, 0x99, 0xed, 0xf4, 0x12, 0x9f, 0xcc, 0x65, 0xbf,
+		0x39, 0x21, 0xbd, 0xf5, 0xd6, 0x5b, 0x6f, 0x25, 0x4b, 0xff, 0x34, 0x03, 0x73, 0xfd, 0xe6, 0x4c,
+		0xdf, 0xe9, 0x7b, 0x12, 0x32, 0x56, 0xa7, 0xbd, 0x47, 0x5c, 0xaa, 0xa4, 0x71, 0x85, 0x3f, 0xc9,
+		0x15, 0x18, 0x37, 0xb5, 0x3d, 0x62, 0x16, 0xd3, 0x4b, 0x89, 0x33, 0x85, 0x73, 0x4f, 0x8d, 0x34,
+		0x2b, 0x97, 0x37, 0x50, 0x44, 0x61, 0x92, 0xf2, 0x87, 0x20, 0xcd, 0x9d, 0x37, 0x22, 0x3c, 0x39,
+		0x1a, 0x02, 0xce, 0x25, 0x85, 0xca, 0xc9, 0xa7, 0x21, 0x87, 0xbf, 0xcc, 0x36, 0x32, 0xb4, 0xcd,
+		0x59, 0x24, 0xa0, 0x5d, 0xc8, 0xf3, 0x90, 0xa5, 0xd3, 0xa4, 0x49, 0xc4, 0xa2, 0x17, 0x3c, 0xa3,
+		0x61, 0x35, 0xc9, 0xbe, 0xd6, 0x31, 0x7d, 0xf5, 0x96, 0x66, 0x76, 0x08, 0x35, 0xf8, 0x9c, 0x92,
+		0xe7, 0xc4, 0x1b, 0x48, 0x93, 0x17, 0x61, 0x92, 0xcd, 0x2a, 0xc3, 0x6a, 0x92, 0x3b, 0xd4, 0xaf,
+		0x8e, 0x2b, 0x6c, 0xa2, 0xad, 0x23, 0x05, 0xab, 0x7f, 0xc3, 0xb3, 0x2d, 0x61, 0x9a, 0xb4, 0x0a,
+		0x24, 0xd0, 0xea, 0x2f, 0x76, 0xbb, 0xf4, 0x87, 0xfa, 0x77, 0xaf, 0x67, 0x2e, 0x3d, 0x0e, 0xd3,
+		0x94, 0xe3, 0x79, 0x3e, 0xf4, 0x9a, 0x59, 0x9c, 0x59, 0x4a, 0x9c, 0xc9, 0x2a, 0x05, 0x46, 0xde,
+		0xe6, 0xd4, 0xd2, 0x97, 0x93, 0x90, 0xa6, 0x8e, 0x65, 0x1a, 0x26, 0x77, 0x5e, 0xab, 0xd7, 0xd4,
+		0xea, 0xf6, 0xee, 0xca, 0x46, 0x4d, 0x4a, 0xc8, 0x05, 0x00, 0x4a, 0xb8, 0xb2, 0xb1, 0x5d, 0xd9,
+		0x91, 0x92, 0xc1, 0xf3, 0xfa, 0xd6, 0xce, 0x85, 0x17, 0xa4, 0x54, 0x20, 0xb0, 0xcb, 0x08, 0xe9,
+		0x28, 0xc3, 0xf3, 0xe7, 0xa4, 0x71, 0x59, 0x82, 0x3c, 0x03, 0x58, 0x7f, 0xb5, 0x56, 0xbd, 0xf0,
+		0x82, 0x94, 0x89, 0x53, 0x9e, 0x3f, 0x27, 0x4d, 0xc8, 0x53, 0x90, 0xa3, 0x94, 0x95, 0xed, 0xed,
+		0x0d, 0x29, 0x1b, 0x60, 0x36, 0x76, 0x94, 0xf5, 0xad, 0x35, 0x29, 0x17, 0x60, 0xae, 0x29, 0xdb,
+		0xbb, 0x75, 0x09, 0x02, 0x84, 0xcd, 0x5a, 0xa3, 0x51, 0x59, 0xab, 0x49, 0x93, 0x01, 0xc7, 0xca,
+		0x6b, 0x3b, 0xb5, 0x86, 0x94, 0x8f, 0x35, 0xeb, 0xf9, 0x73, 0xd2, 0x54, 0x50, 0x45, 0x6d, 0x6b,
+		0x77, 0x53, 0x2a, 0xc8, 0x33, 0x30, 0xc5, 0xaa, 0x10, 0x8d, 0x98, 0xee, 0x22, 0x5d, 0x78, 0x41,
+		0x92, 0xc2, 0x86, 0x30, 0x94, 0x99, 0x18, 0xe1, 0xc2, 0x0b, 0x92, 0x5c, 0x5a, 0x85, 0x71, 0x6a,
+		0x86, 0xb2, 0x0c, 0x85, 0x8d, 0xca, 0x4a, 0x6d, 0x43, 0xdd, 0xae, 0xef, 0xac, 0x6f, 0x6f, 0x55,
+		0x36, 0xa4, 0x44, 0x48, 0x53, 0x6a, 0x2f, 0xef, 0xae, 0x2b, 0xb5, 0xaa, 0x94, 0x8c, 0xd2, 0xea,
+		0xb5, 0xca, 0x4e, 0xad, 0x2a, 0xa5, 0x4a, 0x3a, 0xcc, 0xf5, 0x73, 0xa8, 0x7d, 0xa7, 0x50, 0xc4,
+		0x16, 0x92, 0x03, 0x6c, 0x81, 0x62, 0x75, 0xdb, 0x42, 0xe9, 0x1b, 0x49, 0x98, 0xed, 0xb3, 0xa8,
+		0xf4, 0xad, 0xe4, 0x25, 0x18, 0x67, 0xb6, 0xcc, 0x96, 0xd9, 0x27, 0xfa, 0xae, 0x4e, 0xd4, 0xb2,
+		0x7b, 0x96, 0x5a, 0x2a, 0x17, 0x0d, 0x42, 0x52, 0x03, 0x82, 0x10, 0x84, 0xe8, 0x31, 0xd8, 0x1f,
+		0xea, 0x71, 0xfe, 0x6c, 0x7d, 0xbc, 0x30, 0xca, 0xfa, 0x48, 0x69, 0xc7, 0x5b, 0x04, 0xc6, 0xfb,
+		0x2c, 0x02, 0x97, 0x61, 0xa6, 0x07, 0x68, 0x64, 0x67, 0xfc, 0x91, 0x04, 0x14, 0x07, 0x29, 0x67,
+		0x88, 0x4b, 0x4c, 0xc6, 0x5c, 0xe2, 0xe5, 0x6e, 0x0d, 0x3e, 0x3c, 0x78, 0x10, 0x7a, 0xc6, 0xfa,
+		0x73, 0x09, 0x38, 0xd9, 0x3f, 0xd8, 0xec, 0xdb, 0x86, 0x0f, 0x41, 0xa6, 0x4d, 0xfc, 0x03, 0x5b,
+		0x84, 0x55, 0x8f, 0xf5, 0x59, 0xac, 0xb1, 0xb8, 0x7b, 0xb0, 0xb9, 0x94, 0x7c, 0xa9, 0xbb, 0xad,
+		0x8b, 0x83, 0x42, 0xdf, 0x9e, 0x96, 0x7e, 0x34, 0x09, 0x27, 0xfa, 0x82, 0xf7, 0x6d, 0xe8, 0x43,
+		0x00, 0x86, 0xe5, 0x74, 0x7c, 0x16, 0x3a, 0x31, 0x4f, 0x9c, 0xa3, 0x14, 0xea, 0xbc, 0xd0, 0xcb,
+		0x76, 0xfc, 0xa0, 0x3c, 0x45, 0xcb, 0x81, 0x91, 0x28, 0xc3, 0x8b, 0x61, 0x43, 0xd3, 0xb4, 0xa1,
+		0x0b, 0x03, 0x7a, 0xda, 0x63, 0x98, 0xcf, 0x82, 0xa4, 0x9b, 0x06, 0xb1, 0x7c, 0xd5, 0xf3, 0x5d,
+		0xa2, 0xb5, 0x0d, 0xab, 0x45, 0x97, 0x9a, 0x6c, 0x79, 0x7c, 0x5f, 0x33, 0x3d, 0xa2, 0x4c, 0xb3,
+		0xe2, 0x86, 0x28, 0x45, 0x09, 0x6a, 0x40, 0x6e, 0x44, 0x22, 0x13, 0x93, 0x60, 0xc5, 0x81, 0x44,
+		0xe9, 0x27, 0x73, 0x30, 0x19, 0x09, 0xcd, 0xe5, 0x87, 0x21, 0xff, 0x86, 0x76, 0x4b, 0x53, 0xc5,
+		0x76, 0x8b, 0x69, 0x62, 0x12, 0x69, 0x75, 0x46, 0x92, 0x9f, 0x85, 0x39, 0xca, 0x62, 0x77, 0x7c,
+		0xe2, 0xaa, 0xba, 0xa9, 0x79, 0x1e, 0x55, 0x5a, 0x96, 0xb2, 0xca, 0x58, 0xb6, 0x8d, 0x45, 0xab,
+		0xa2, 0x44, 0x3e, 0x0f, 0xb3, 0x54, 0xa2, 0xdd, 0x31, 0x7d, 0xc3, 0x31, 0x89, 0x8a, 0x1b, 0x40,
+		0xaf, 0x08, 0xd1, 0x96, 0xcd, 0x20, 0xc7, 0x26, 0x67, 0xc0, 0x16, 0x79, 0x72, 0x15, 0x1e, 0xa2,
+		0x62, 0x2d, 0x62, 0x11, 0x57, 0xf3, 0x89, 0x4a, 0x3e, 0xdc, 0xd1, 0x4c, 0x4f, 0xd5, 0xac, 0xa6,
+		0x7a, 0xa0, 0x79, 0x07, 0xc5, 0x39, 0x04, 0x58, 0x49, 0x16, 0x13, 0xca, 0x29, 0x64, 0x5c, 0xe3,
+		0x7c, 0x35, 0xca, 0x56, 0xb1, 0x9a, 0x57, 0x35, 0xef, 0x40, 0x2e, 0xc3, 0x49, 0x8a, 0xe2, 0xf9,
+		0xae, 0x61, 0xb5, 0x54, 0xfd, 0x80, 0xe8, 0x37, 0xd5, 0x8e, 0xbf, 0xff, 0x62, 0xf1, 0x74, 0xb4,
+		0x7e, 0xda, 0xc2, 0x06, 0xe5, 0x59, 0x45, 0x96, 0x5d, 0x7f, 0xff, 0x45, 0xb9, 0x01, 0x79, 0x1c,
+		0x8c, 0xb6, 0xf1, 0x26, 0x51, 0xf7, 0x6d, 0x97, 0xae, 0xa1, 0x85, 0x3e, 0xae, 0x29, 0xa2, 0xc1,
+		0xe5, 0x6d, 0x2e, 0xb0, 0x69, 0x37, 0x49, 0x79, 0xbc, 0x51, 0xaf, 0xd5, 0xaa, 0xca, 0xa4, 0x40,
+		0xb9, 0x62, 0xbb, 0x68, 0x50, 0x2d, 0x3b, 0x50, 0xf0, 0x24, 0x33, 0xa8, 0x96, 0x2d, 0xd4, 0x7b,
+		0x1e, 0x66, 0x75, 0x9d, 0xf5, 0xd9, 0xd0, 0x55, 0xbe, 0x4d, 0xf3, 0x8a, 0x52, 0x4c, 0x59, 0xba,
+		0xbe, 0xc6, 0x18, 0xb8, 0x8d, 0x7b, 0xf2, 0x25, 0x38, 0x11, 0x2a, 0x2b, 0x2a, 0x38, 0xd3, 0xd3,
+		0xcb, 0x6e, 0xd1, 0xf3, 0x30, 0xeb, 0x1c, 0xf6, 0x0a, 0xca, 0xb1, 0x1a, 0x9d, 0xc3, 0x6e, 0xb1,
+		0x8b, 0x30, 0xe7, 0x1c, 0x38, 0xbd, 0x72, 0x4f, 0x46, 0xe5, 0x64, 0xe7, 0xc0, 0xe9, 0x16, 0x7c,
+		0x94, 0xee, 0xd9, 0x5d, 0xa2, 0x6b, 0x3e, 0x69, 0x16, 0x1f, 0x88, 0xb2, 0x47, 0x0a, 0xe4, 0x65,
+		0x90, 0x74, 0x5d, 0x25, 0x96, 0xb6, 0x67, 0x12, 0x55, 0x73, 0x89, 0xa5, 0x79, 0xc5, 0x45, 0xca,
+		0x9c, 0xf6, 0xdd, 0x0e, 0x51, 0x0a, 0xba, 0x5e, 0xa3, 0x85, 0x15, 0x5a, 0x26, 0x3f, 0x09, 0x33,
+		0xf6, 0xde, 0x1b, 0x3a, 0xb3, 0x48, 0xd5, 0x71, 0xc9, 0xbe, 0x71, 0xa7, 0xf8, 0x08, 0x55, 0xef,
+		0x34, 0x16, 0x50, 0x7b, 0xac, 0x53, 0xb2, 0xfc, 0x04, 0x48, 0xba, 0x77, 0xa0, 0xb9, 0x0e, 0x75,
+		0xc9, 0x9e, 0xa3, 0xe9, 0xa4, 0xf8, 0x28, 0x63, 0x65, 0xf4, 0x2d, 0x41, 0xc6, 0x19, 0xe1, 0xdd,
+		0x36, 0xf6, 0x7d, 0x81, 0xf8, 0x38, 0x9b, 0x11, 0x94, 0xc6, 0xd1, 0xce, 0x80, 0x84, 0x9a, 0x88,
+		0x55, 0x7c, 0x86, 0xb2, 0x15, 0x9c, 0x03, 0x27, 0x5a, 0xef, 0x07, 0x60, 0xca, 0x39, 0x88, 0x56,
+		0xfa, 0x04, 0x0b, 0xdc, 0x9c, 0x83, 0x48, 0x8d, 0x2f, 0xc0, 0x49, 0x64, 0x6a, 0x13, 0x5f, 0x6b,
+		0x6a, 0xbe, 0x16, 0xe1, 0x7e, 0x9a, 0x72, 0xa3, 0xda, 0x37, 0x79, 0x61, 0xac, 0x9d, 0x6e, 0x67,
+		0xef, 0x30, 0x30, 0xac, 0x67, 0x58, 0x3b, 0x91, 0x26, 0x4c, 0xeb, 0x7d, 0x0b, 0xce, 0x4b, 0x65,
+		0xc8, 0x47, 0xed, 0x5e, 0xce, 0x01, 0xb3, 0x7c, 0x29, 0x81, 0x41, 0xd0, 0xea, 0x76, 0x15, 0xc3,
+		0x97, 0xd7, 0x6b, 0x52, 0x12, 0xc3, 0xa8, 0x8d, 0xf5, 0x9d, 0x9a, 0xaa, 0xec, 0x6e, 0xed, 0xac,
+		0x6f, 0xd6, 0xa4, 0x54, 0x24, 0xb0, 0xbf, 0x96, 0xce, 0x3e, 0x26, 0x3d, 0x5e, 0xfa, 0x4a, 0x0a,
+		0x0a, 0xf1, 0x9d, 0x9a, 0xfc, 0x03, 0xf0, 0x80, 0x48, 0xb8, 0x78, 0xc4, 0x57, 0x6f, 0x1b, 0x2e,
+		0x9d, 0x90, 0x6d, 0x8d, 0x2d, 0x8e, 0x81, 0xfd, 0xcc, 0x71, 0xae, 0x06, 0xf1, 0x5f, 0x31, 0x5c,
+		0x9c, 0x6e, 0x6d, 0xcd, 0x97, 0x37, 0x60, 0xd1, 0xb2, 0x55, 0xcf, 0xd7, 0xac, 0xa6, 0xe6, 0x36,
+		0xd5, 0x30, 0xd5, 0xa5, 0x6a, 0xba, 0x4e, 0x3c, 0xcf, 0x66, 0x0b, 0x61, 0x80, 0xf2, 0xa0, 0x65,
+		0x37, 0x38, 0x73, 0xb8, 0x42, 0x54, 0x38, 0x6b, 0x97, 0xf9, 0xa6, 0x06, 0x99, 0xef, 0x69, 0xc8,
+		0xb5, 0x35, 0x47, 0x25, 0x96, 0xef, 0x1e, 0xd2, 0xf8, 0x3c, 0xab, 0x64, 0xdb, 0x9a, 0x53, 0xc3,
+		0x67, 0xf9, 0x06, 0x3c, 0x16, 0xb2, 0xaa, 0x26, 0x69, 0x69, 0xfa, 0xa1, 0x4a, 0x83, 0x71, 0x9a,
+		0x36, 0x50, 0x75, 0xdb, 0xda, 0x37, 0x0d, 0xdd, 0xf7, 0x8a, 0x93, 0x81, 0x8f, 0x2b, 0x85, 0x12,
+		0x1b, 0x54, 0xe0, 0x9a, 0x67, 0x5b, 0x34, 0x06, 0x5f, 0x15, 0xdc, 0xdf, 0x93, 0xed, 0xd7, 0xb5,
+		0x74, 0x36, 0x2d, 0x8d, 0x5f, 0x4b, 0x67, 0xc7, 0xa5, 0xcc, 0xb5, 0x74, 0x36, 0x23, 0x4d, 0x5c,
+		0x4b, 0x67, 0xb3, 0x52, 0xee, 0x5a, 0x3a, 0x9b, 0x93, 0xa0, 0xf4, 0x2b, 0x59, 0xc8, 0x47, 0x77,
+		0x06, 0xb8, 0xd1, 0xd2, 0xe9, 0xda, 0x98, 0xa0, 0xde, 0xf3, 0x03, 0x47, 0xee, 0x23, 0x96, 0x57,
+		0x71, 0xd1, 0x2c, 0x67, 0x58, 0x18, 0xae, 0x30, 0x49, 0x0c, 0x58, 0xd0, 0xac, 0x09, 0x0b, 0x7b,
+		0xb2, 0x0a, 0x7f, 0x92, 0xd7, 0x20, 0xf3, 0x86, 0x47, 0xb1, 0x33, 0x14, 0xfb, 0x91, 0xa3, 0xb1,
+		0xaf, 0x35, 0x28, 0x78, 0xee, 0x5a, 0x43, 0xdd, 0xda, 0x56, 0x36, 0x2b, 0x1b, 0x0a, 0x17, 0x97,
+		0x4f, 0x41, 0xda, 0xd4, 0xde, 0x3c, 0x8c, 0x2f, 0xaf, 0x94, 0x24, 0x2f, 0xc3, 0x74, 0xc7, 0xba,
+		0x45, 0x5c, 0x63, 0xdf, 0xc0, 0xa1, 0x42, 0xae, 0xe9, 0x28, 0x57, 0x21, 0x2c, 0xdd, 0x40, 0xfe,
+		0x11, 0xcd, 0xe3, 0x14, 0xa4, 0x31, 0xa9, 0x18, 0x5f, 0x04, 0x29, 0x49, 0x3e, 0x03, 0xf9, 0x26,
+		0xd9, 0xeb, 0xb4, 0x54, 0x97, 0x34, 0x35, 0xdd, 0x8f, 0xbb, 0xfe, 0x49, 0x5a, 0xa4, 0xd0, 0x12,
+		0xf9, 0x3a, 0xe4, 0x70, 0x8c, 0x2c, 0x3a, 0xc6, 0x33, 0x54, 0x05, 0xcf, 0x1c, 0xad, 0x02, 0x3e,
+		0xc4, 0x42, 0x48, 0x09, 0xe5, 0xe5, 0x2b, 0x90, 0xf1, 0x35, 0xb7, 0x45, 0x7c, 0xea, 0xf9, 0x0b,
+		0xe7, 0x96, 0x47, 0x41, 0xda, 0xa1, 0x12, 0x74, 0x4f, 0xcb, 0xa5, 0xdf, 0x47, 0x2f, 0x73, 0x16,
+		0xc6, 0xa9, 0x79, 0xc8, 0x00, 0xdc, 0x40, 0xa4, 0x31, 0x39, 0x0b, 0xe9, 0xd5, 0x6d, 0x05, 0x3d,
+		0x8d, 0x04, 0x79, 0x46, 0x55, 0xeb, 0xeb, 0xb5, 0xd5, 0x9a, 0x94, 0x2c, 0x9d, 0x87, 0x0c, 0x1b,
+		0x73, 0xf4, 0x42, 0xc1, 0xa8, 0x4b, 0x63, 0xfc, 0x91, 0x63, 0x24, 0x44, 0xe9, 0xee, 0xe6, 0x4a,
+		0x4d, 0x91, 0x92, 0xa5, 0x5d, 0x98, 0xee, 0xd2, 0x93, 0x7c, 0x02, 0x66, 0x94, 0xda, 0x4e, 0x6d,
+		0x0b, 0xf7, 0x59, 0xea, 0xee, 0xd6, 0xf5, 0xad, 0xed, 0x57, 0xb6, 0xa4, 0xb1, 0x38, 0x59, 0xb8,
+		0xb4, 0x84, 0x3c, 0x07, 0x52, 0x48, 0x6e, 0x6c, 0xef, 0x2a, 0xb4, 0x35, 0x7f, 0x29, 0x09, 0x52,
+		0xb7, 0xd6, 0xe4, 0x07, 0x60, 0x76, 0xa7, 0xa2, 0xac, 0xd5, 0x76, 0x54, 0xb6, 0x77, 0x0c, 0xa0,
+		0xe7, 0x40, 0x8a, 0x16, 0x5c, 0x59, 0xa7, 0x5b, 0xe3, 0x45, 0x38, 0x1d, 0xa5, 0xd6, 0x5e, 0xdd,
+		0xa9, 0x6d, 0x35, 0x68, 0xe5, 0x95, 0xad, 0x35, 0xf4, 0xaf, 0x5d, 0x78, 0x62, 0xb7, 0x9a, 0xc2,
+		0xa6, 0xc6, 0xf1, 0x6a, 0x1b, 0x55, 0x29, 0xdd, 0x4d, 0xde, 0xde, 0xaa, 0x6d, 0x5f, 0x91, 0xc6,
+		0xbb, 0x6b, 0xa7, 0x3b, 0xd8, 0x8c, 0x3c, 0x0f, 0x27, 0xbb, 0xa9, 0x6a, 0x6d, 0x6b, 0x47, 0x79,
+		0x4d, 0x9a, 0xe8, 0xae, 0xb8, 0x51, 0x53, 0x6e, 0xac, 0xaf, 0xd6, 0xa4, 0xac, 0x7c, 0x12, 0xe4,
+		0x78, 0x8b, 0x76, 0xae, 0x6e, 0x57, 0xa5, 0x5c, 0x8f, 0x47, 0x29, 0x79, 0x90, 0x8f, 0x6e, 0x23,
+		0xbf, 0x37, 0xb9, 0xa4, 0x8f, 0x27, 0x61, 0x32, 0xb2, 0x2d, 0xc4, 0x78, 0x5e, 0x33, 0x4d, 0xfb,
+		0xb6, 0xaa, 0x99, 0x86, 0xe6, 0x71, 0x7f, 0x03, 0x94, 0x54, 0x41, 0xca, 0xa8, 0xf3, 0x7b, 0x74,
+		0x0f, 0x9f, 0xf9, 0x7e, 0xf4, 0xf0, 0xe3, 0x52, 0xa6, 0xf4, 0xa9, 0x04, 0x48, 0xdd, 0xfb, 0xbd,
+		0xae, 0xee, 0x27, 0x06, 0x75, 0xff, 0x7b, 0x32, 0x76, 0x9f, 0x4c, 0x40, 0x21, 0xbe, 0xc9, 0xeb,
+		0x6a, 0xde, 0xc3, 0xff, 0x5f, 0x9b, 0xf7, 0xbb, 0x49, 0x98, 0x8a, 0x6d, 0xed, 0x46, 0x6d, 0xdd,
+		0x87, 0x61, 0xc6, 0x68, 0x92, 0xb6, 0x63, 0xfb, 0x78, 0xda, 0xa4, 0x9a, 0xe4, 0x16, 0x31, 0x8b,
+		0x25, 0xea, 0x94, 0xcf, 0x1e, 0xbd, 0x79, 0x5c, 0x5e, 0x0f, 0xe5, 0x36, 0x50, 0xac, 0x3c, 0xbb,
+		0x5e, 0xad, 0x6d, 0xd6, 0xb7, 0x77, 0x6a, 0x5b, 0xab, 0xaf, 0x09, 0xef, 0xa2, 0x48, 0x46, 0x17,
+		0xdb, 0xfb, 0xe8, 0xb4, 0xeb, 0x20, 0x75, 0x37, 0x0a, 0x7d, 0x45, 0x9f, 0x66, 0x49, 0x63, 0xf2,
+		0x2c, 0x4c, 0x6f, 0x6d, 0xab, 0x8d, 0xf5, 0x6a, 0x4d, 0xad, 0x5d, 0xb9, 0x52, 0x5b, 0xdd, 0x69,
+		0xb0, 0x74, 0x60, 0xc0, 0xbd, 0x23, 0x25, 0xa3, 0x2a, 0xfe, 0x44, 0x0a, 0x66, 0xfb, 0xb4, 0x44,
+		0xae, 0xf0, 0x8d, 0x3c, 0xcb, 0x2d, 0x3c, 0x33, 0x4a, 0xeb, 0x97, 0x31, 0x94, 0xae, 0x6b, 0xae,
+		0xcf, 0xf7, 0xfd, 0x4f, 0x00, 0x6a, 0xc9, 0xf2, 0x71, 0x65, 0x77, 0x79, 0x9a, 0x95, 0xed, 0xee,
+		0xa7, 0x43, 0x3a, 0xcb, 0xb4, 0x3e, 0x0d, 0xb2, 0x63, 0x7b, 0x86, 0x6f, 0xdc, 0xc2, 0x33, 0x2c,
+		0x91, 0x93, 0xc5, 0xdd, 0x7e, 0x5a, 0x91, 0x44, 0xc9, 0xba, 0xe5, 0x07, 0xdc, 0x16, 0x69, 0x69,
+		0x5d, 0xdc, 0x18, 0x79, 0xa4, 0x14, 0x49, 0x94, 0x04, 0xdc, 0x0f, 0x43, 0xbe, 0x69, 0x77, 0x70,
+		0x0b, 0xc4, 0xf8, 0xd0, 0x5b, 0x24, 0x94, 0x49, 0x46, 0x0b, 0x58, 0xf8, 0xe6, 0x36, 0x4c, 0x06,
+		0xe7, 0x95, 0x49, 0x46, 0x63, 0x2c, 0x8f, 0xc3, 0xb4, 0xd6, 0x6a, 0xb9, 0x08, 0x2e, 0x80, 0xd8,
+		0x76, 0xbd, 0x10, 0x90, 0x29, 0xe3, 0xfc, 0x35, 0xc8, 0x0a, 0x3d, 0x60, 0x04, 0x8b, 0x9a, 0x50,
+		0x1d, 0x96, 0x83, 0x4a, 0x62, 0x7e, 0xd8, 0x12, 0x85, 0x0f, 0x43, 0xde, 0xf0, 0xd4, 0xf0, 0x6c,
+		0x2b, 0xb9, 0x94, 0x3c, 0x93, 0x55, 0x26, 0x0d, 0x2f, 0x38, 0x17, 0x28, 0x7d, 0x2e, 0x09, 0x85,
+		0xf8, 0xa9, 0x9d, 0x5c, 0x85, 0xac, 0x69, 0xeb, 0x1a, 0x35, 0x2d, 0x76, 0x64, 0x7c, 0x66, 0xc8,
+		0x41, 0xdf, 0xf2, 0x06, 0xe7, 0x57, 0x02, 0xc9, 0xf9, 0x7f, 0x9d, 0x80, 0xac, 0x20, 0xcb, 0x27,
+		0x21, 0xed, 0x68, 0xfe, 0x01, 0x85, 0x1b, 0x5f, 0x49, 0x4a, 0x09, 0x85, 0x3e, 0x23, 0xdd, 0x73,
+		0x34, 0xab, 0x98, 0x0c, 0xe9, 0xf8, 0x8c, 0xe3, 0x6a, 0x12, 0xad, 0x49, 0x73, 0x01, 0x76, 0xbb,
+		0x4d, 0x2c, 0xdf, 0x13, 0xe3, 0xca, 0xe9, 0xab, 0x9c, 0x8c, 0x87, 0xc7, 0xbe, 0xab, 0x19, 0x66,
+		0x8c, 0x37, 0x4d, 0x79, 0x25, 0x51, 0x10, 0x30, 0x97, 0xe1, 0x94, 0xc0, 0x6d, 0x12, 0x5f, 0xd3,
+		0x0f, 0x48, 0x33, 0x14, 0xca, 0xd0, 0x9c, 0xdf, 0x03, 0x9c, 0xa1, 0xca, 0xcb, 0x85, 0x6c, 0xe9,
+		0xeb, 0x49, 0x98, 0x11, 0xd9, 0x8b, 0x66, 0xa0, 0xac, 0x4d, 0x00, 0xcd, 0xb2, 0x6c, 0x3f, 0xaa,
+		0xae, 0x5e, 0x53, 0xee, 0x91, 0x5b, 0xae, 0x04, 0x42, 0x4a, 0x04, 0x60, 0xfe, 0x0f, 0x12, 0x00,
+		0x61, 0xd1, 0x40, 0xbd, 0x2d, 0xc2, 0x24, 0x3f, 0x93, 0xa5, 0x07, 0xfb, 0x2c, 0xe1, 0x05, 0x8c,
+		0x84, 0x79, 0x0e, 0x4c, 0x4b, 0xee, 0x91, 0x96, 0x61, 0xf1, 0xf3, 0x14, 0xf6, 0x20, 0xd2, 0x92,
+		0xe9, 0xf0, 0x78, 0x4a, 0x81, 0xac, 0x47, 0xda, 0x9a, 0xe5, 0x1b, 0x3a, 0x3f, 0x21, 0xb9, 0x70,
+		0xac, 0xc6, 0x2f, 0x37, 0xb8, 0xb4, 0x12, 0xe0, 0x94, 0xce, 0x40, 0x56, 0x50, 0x31, 0xf0, 0xdb,
+		0xda, 0xde, 0xaa, 0x49, 0x63, 0xf2, 0x04, 0xa4, 0x1a, 0xb5, 0x1d, 0x29, 0x81, 0xdb, 0xce, 0xca,
+		0xc6, 0x7a, 0xa5, 0x21, 0x25, 0x57, 0xfe, 0x2c, 0xcc, 0xea, 0x76, 0xbb, 0xbb, 0xc2, 0x15, 0xa9,
+		0x2b, 0xe5, 0xe7, 0x5d, 0x4d, 0xbc, 0xfe, 0x0c, 0x67, 0x6a, 0xd9, 0xa6, 0x66, 0xb5, 0x96, 0x6d,
+		0xb7, 0x15, 0x5e, 0x8b, 0xc0, 0xdd, 0x81, 0x17, 0xb9, 0x1c, 0xe1, 0xec, 0xfd, 0xaf, 0x44, 0xe2,
+		0xe7, 0x92, 0xa9, 0xb5, 0xfa, 0xca, 0xe7, 0x93, 0xf3, 0x6b, 0x4c, 0xb0, 0x2e, 0xba, 0xa3, 0x90,
+		0x7d, 0x93, 0xe8, 0xd8, 0x78, 0xf8, 0xd6, 0x53, 0x30, 0xd7, 0xb2, 0x5b, 0x36, 0x45, 0x3a, 0x8b,
+		0xff, 0x58, 0x23, 0xe4, 0x5c, 0x40, 0x9d, 0x1f, 0x7a, 0x09, 0xa3, 0xbc, 0x05, 0xb3, 0x9c, 0x59,
+		0xa5, 0xc7, 0xb7, 0x2c, 0xb9, 0x20, 0x1f, 0x99, 0xd9, 0x2e, 0xfe, 0xd2, 0xef, 0xd3, 0xa8, 0x44,
+		0x99, 0xe1, 0xa2, 0x58, 0xc6, 0xf2, 0x0f, 0x65, 0x05, 0x4e, 0xc4, 0xf0, 0x98, 0x8f, 0x20, 0xee,
+		0x10, 0xc4, 0x7f, 0xce, 0x11, 0x67, 0x23, 0x88, 0x0d, 0x2e, 0x5a, 0x5e, 0x85, 0xa9, 0xe3, 0x60,
+		0xfd, 0x0b, 0x8e, 0x95, 0x27, 0x51, 0x90, 0x35, 0x98, 0xa6, 0x20, 0x7a, 0xc7, 0xf3, 0xed, 0x36,
+		0x75, 0xc0, 0x47, 0xc3, 0xfc, 0xcb, 0xdf, 0x67, 0x93, 0xb6, 0x80, 0x62, 0xab, 0x81, 0x54, 0xb9,
+		0x0c, 0xf4, 0xc4, 0x1a, 0x4f, 0x92, 0x87, 0x20, 0x7c, 0x95, 0x37, 0x24, 0xe0, 0x2f, 0xdf, 0x80,
+		0x39, 0xfc, 0x4f, 0xfd, 0x63, 0xb4, 0x25, 0xc3, 0xd3, 0xe0, 0xc5, 0x7f, 0xf3, 0x11, 0xe6, 0x17,
+		0x66, 0x03, 0x80, 0x48, 0x9b, 0x22, 0xa3, 0xd8, 0x22, 0xbe, 0x4f, 0x5c, 0x4f, 0xd5, 0xcc, 0x7e,
+		0xcd, 0x8b, 0xe4, 0x11, 0x8b, 0x3f, 0xf3, 0xed, 0xf8, 0x28, 0xae, 0x31, 0xc9, 0x8a, 0x69, 0x96,
+		0x77, 0xe1, 0x81, 0x3e, 0x56, 0x31, 0x02, 0xe6, 0x27, 0x38, 0xe6, 0x5c, 0x8f, 0x65, 0x20, 0x6c,
+		0x1d, 0x04, 0x3d, 0x18, 0xcb, 0x11, 0x30, 0x7f, 0x96, 0x63, 0xca, 0x5c, 0x56, 0x0c, 0x29, 0x22,
+		0x5e, 0x83, 0x99, 0x5b, 0xc4, 0xdd, 0xb3, 0x3d, 0x9e, 0xbb, 0x1d, 0x01, 0xee, 0x93, 0x1c, 0x6e,
+		0x9a, 0x0b, 0xd2, 0x64, 0x2e, 0x62, 0x5d, 0x82, 0xec, 0xbe, 0xa6, 0x93, 0x11, 0x20, 0xee, 0x71,
+		0x88, 0x09, 0xe4, 0x47, 0xd1, 0x0a, 0xe4, 0x5b, 0x36, 0x5f, 0x22, 0x87, 0x8b, 0x7f, 0x8a, 0x8b,
+		0x4f, 0x0a, 0x19, 0x0e, 0xe1, 0xd8, 0x4e, 0xc7, 0xc4, 0xf5, 0x73, 0x38, 0xc4, 0xdf, 0x10, 0x10,
+		0x42, 0x86, 0x43, 0x1c, 0x43, 0xad, 0x9f, 0x16, 0x10, 0x5e, 0x44, 0x9f, 0x2f, 0xe1, 0x91, 0xae,
+		0x79, 0x68, 0x5b, 0xa3, 0x34, 0xe2, 0x33, 0x1c, 0x01, 0xb8, 0x08, 0x02, 0x5c, 0x86, 0xdc, 0xa8,
+		0x03, 0xf1, 0x0b, 0xdf, 0x16, 0xd3, 0x43, 0x8c, 0xc0, 0x1a, 0x4c, 0x0b, 0x07, 0x85, 0x57, 0x40,
+		0x86, 0x43, 0xfc, 0x4d, 0x0e, 0x51, 0x88, 0x88, 0xf1, 0x6e, 0xf8, 0xc4, 0xf3, 0x5b, 0x64, 0x14,
+		0x90, 0xcf, 0x89, 0x6e, 0x70, 0x11, 0xae, 0xca, 0x3d, 0x62, 0xe9, 0x07, 0xa3, 0x21, 0xfc, 0xa2,
+		0x50, 0xa5, 0x90, 0x41, 0x88, 0x55, 0x98, 0x6a, 0x6b, 0xae, 0x77, 0xa0, 0x99, 0x23, 0x0d, 0xc7,
+		0xdf, 0xe2, 0x18, 0xf9, 0x40, 0x88, 0x6b, 0xa4, 0x63, 0x1d, 0x07, 0xe6, 0xf3, 0x42, 0x23, 0x1d,
+		0x2b, 0x06, 0x54, 0x87, 0x39, 0xcf, 0xa7, 0x89, 0xee, 0xe3, 0xa0, 0xfd, 0x6d, 0x31, 0xf5, 0x98,
+		0xec, 0x66, 0x14, 0xf1, 0x32, 0xe4, 0x3c, 0xe3, 0xcd, 0x91, 0x60, 0xbe, 0x20, 0x46, 0x9a, 0x0a,
+		0xa0, 0xf0, 0x6b, 0x70, 0xaa, 0xef, 0x32, 0x31, 0x02, 0xd8, 0xdf, 0xe1, 0x60, 0x27, 0xfb, 0x2c,
+		0x15, 0xdc, 0x25, 0x1c, 0x17, 0xf2, 0xef, 0x0a, 0x97, 0x40, 0xba, 0xb0, 0xea, 0xb8, 0x69, 0xf1,
+		0xb4, 0xfd, 0xe3, 0x69, 0xed, 0xef, 0x09, 0xad, 0x31, 0xd9, 0x98, 0xd6, 0x76, 0xe0, 0x24, 0x47,
+		0x3c, 0xde, 0xb8, 0xfe, 0x7d, 0xe1, 0x58, 0x99, 0xf4, 0x6e, 0x7c, 0x74, 0x7f, 0x10, 0xe6, 0x03,
+		0x75, 0x8a, 0xe8, 0xd8, 0x53, 0x31, 0x3b, 0x3c, 0x1c, 0xf9, 0x97, 0x38, 0xb2, 0xf0, 0xf8, 0x41,
+		0x78, 0xed, 0x6d, 0x6a, 0x0e, 0x82, 0xbf, 0x0a, 0x45, 0x01, 0xde, 0xb1, 0x5c, 0xa2, 0xdb, 0x2d,
+		0xcb, 0x78, 0x93, 0x34, 0x47, 0x80, 0xfe, 0xe5, 0xae, 0xa1, 0xda, 0x8d, 0x88, 0x23, 0xf2, 0x3a,
+		0x48, 0x41, 0xac, 0xa2, 0x1a, 0x6d, 0xc7, 0x76, 0xfd, 0x21, 0x88, 0x5f, 0x14, 0x23, 0x15, 0xc8,
+		0xad, 0x53, 0xb1, 0x72, 0x0d, 0xd8, 0xed, 0x8f, 0x51, 0x4d, 0xf2, 0x4b, 0x1c, 0x68, 0x2a, 0x94,
+		0xe2, 0x8e, 0x43, 0xb7, 0xdb, 0x8e, 0xe6, 0x8e, 0xe2, 0xff, 0xfe, 0x81, 0x70, 0x1c, 0x5c, 0x84,
+		0x3b, 0x0e, 0x8c, 0xe8, 0x70, 0xb5, 0x1f, 0x01, 0xe1, 0xcb, 0xc2, 0x71, 0x08, 0x19, 0x0e, 0x21,
+		0x02, 0x86, 0x11, 0x20, 0x7e, 0x45, 0x40, 0x08, 0x19, 0x84, 0x78, 0x39, 0x5c, 0x68, 0x5d, 0xd2,
+		0x32, 0x3c, 0xdf, 0x65, 0x21, 0xf9, 0xd1, 0x50, 0xff, 0xf0, 0xdb, 0xf1, 0x20, 0x4c, 0x89, 0x88,
+		0xa2, 0x27, 0xe2, 0x47, 0x1f, 0x74, 0xcb, 0x36, 0xbc, 0x61, 0xbf, 0x2a, 0x3c, 0x51, 0x44, 0x0c,
+		0xdb, 0x16, 0x89, 0x10, 0x51, 0xed, 0x3a, 0x6e, 0x54, 0x46, 0x80, 0xfb, 0xb5, 0xae, 0xc6, 0x35,
+		0x84, 0x2c, 0x62, 0x46, 0xe2, 0x9f, 0x8e, 0x75, 0x93, 0x1c, 0x8e, 0x64, 0x9d, 0x5f, 0xe9, 0x8a,
+		0x7f, 0x76, 0x99, 0x24, 0xf3, 0x21, 0xd3, 0x5d, 0xf1, 0x94, 0x3c, 0xec, 0xae, 0x5f, 0xf1, 0x47,
+		0xdf, 0xe5, 0xfd, 0x8d, 0x87, 0x53, 0xe5, 0x0d, 0x90, 0x38, 0x25, 0x0c, 0x60, 0x87, 0x82, 0x7d,
+		0xe4, 0xdd, 0xc0, 0xce, 0x63, 0x31, 0x4f, 0xf9, 0x0a, 0x4c, 0xc5, 0x02, 0x9e, 0xe1, 0x50, 0x7f,
+		0x8e, 0x43, 0xe5, 0xa3, 0xf1, 0x4e, 0xf9, 0x3c, 0xa4, 0x31, 0x78, 0x19, 0x2e, 0xfe, 0xe7, 0xb9,
+		0x38, 0x65, 0x2f, 0x7f, 0x10, 0xb2, 0x22, 0x68, 0x19, 0x2e, 0xfa, 0x17, 0xb8, 0x68, 0x20, 0x82,
+		0xe2, 0x22, 0x60, 0x19, 0x2e, 0xfe, 0x17, 0x85, 0xb8, 0x10, 0x41, 0xf1, 0xd1, 0x55, 0xf8, 0xeb,
+		0x3f, 0x9e, 0x66, 0xe2, 0x42, 0xa4, 0x8c, 0xb7, 0x4f, 0x58, 0xa4, 0x32, 0x5c, 0xfa, 0xa3, 0xbc,
+		0x72, 0x21, 0x51, 0xbe, 0x08, 0xe3, 0x23, 0x2a, 0xfc, 0x2f, 0x73, 0x51, 0xc6, 0x5f, 0x5e, 0x85,
+		0xc9, 0x48, 0x74, 0x32, 0x5c, 0xfc, 0x27, 0xb8, 0x78, 0x54, 0x0a, 0x9b, 0xce, 0xa3, 0x93, 0xe1,
+		0x00, 0x7f, 0x45, 0x34, 0x9d, 0x4b, 0xa0, 0xda, 0x44, 0x60, 0x32, 0x5c, 0xfa, 0x63, 0x42, 0xeb,
+		0x42, 0xa4, 0xfc, 0x12, 0xe4, 0x82, 0xc5, 0x66, 0xb8, 0xfc, 0x4f, 0x72, 0xf9, 0x50, 0x06, 0x35,
+		0xd0, 0xb1, 0x8e, 0x01, 0xf1, 0x57, 0x85, 0x06, 0x22, 0x52, 0x38, 0x8d, 0xba, 0x03, 0x98, 0xe1,
+		0x48, 0x3f, 0x25, 0xa6, 0x51, 0x57, 0xfc, 0x82, 0xa3, 0x49, 0x7d, 0xfe, 0x70, 0x88, 0xbf, 0x26,
+		0x46, 0x93, 0xf2, 0x63, 0x33, 0xba, 0x23, 0x82, 0xe1, 0x18, 0x3f, 0x2d, 0x9a, 0xd1, 0x15, 0x10,
+		0x94, 0xeb, 0x20, 0xf7, 0x46, 0x03, 0xc3, 0xf1, 0x3e, 0xce, 0xf1, 0x66, 0x7a, 0x82, 0x81, 0xf2,
+		0x2b, 0x70, 0xb2, 0x7f, 0x24, 0x30, 0x1c, 0xf5, 0x67, 0xde, 0xed, 0xda, 0xbb, 0x45, 0x03, 0x81,
+		0xf2, 0x0e, 0xcc, 0xf5, 0x8b, 0x02, 0x86, 0xc3, 0x7e, 0xe2, 0xdd, 0xb8, 0xe3, 0x8e, 0x06, 0x01,
+		0xe5, 0x0a, 0x40, 0xb8, 0x00, 0x0f, 0xc7, 0xfa, 0x24, 0xc7, 0x8a, 0x08, 0xe1, 0xd4, 0xe0, 0xeb,
+		0xef, 0x70, 0xf9, 0x7b, 0x62, 0x6a, 0x70, 0x09, 0x9c, 0x1a, 0x62, 0xe9, 0x1d, 0x2e, 0xfd, 0x29,
+		0x31, 0x35, 0x84, 0x08, 0x5a, 0x76, 0x64, 0x75, 0x1b, 0x8e, 0xf0, 0x19, 0x61, 0xd9, 0x11, 0xa9,
+		0xf2, 0x16, 0xcc, 0xf4, 0x2c, 0x88, 0xc3, 0xa1, 0x7e, 0x8e, 0x43, 0x49, 0xdd, 0xeb, 0x61, 0x74,
+		0xf1, 0xe2, 0x8b, 0xe1, 0x70, 0xb4, 0xcf, 0x76, 0x2d, 0x5e, 0x7c, 0x2d, 0x2c, 0x5f, 0x86, 0xac,
+		0xd5, 0x31, 0x4d, 0x9c, 0x3c, 0xf2, 0xd1, 0xf7, 0x73, 0x8b, 0xff, 0xe5, 0x3b, 0x5c, 0x3b, 0x42,
+		0xa0, 0x7c, 0x1e, 0xc6, 0x49, 0x7b, 0x8f, 0x34, 0x87, 0x49, 0x7e, 0xeb, 0x3b, 0xc2, 0x61, 0x22,
+		0x77, 0xf9, 0x25, 0x00, 0x96, 0x1a, 0xa1, 0x07, 0xe7, 0x43, 0x64, 0xff, 0xe0, 0x3b, 0xfc, 0x42,
+		0x5c, 0x28, 0x12, 0x02, 0xb0, 0xeb, 0x75, 0x47, 0x03, 0x7c, 0x3b, 0x0e, 0x40, 0x47, 0xe4, 0x12,
+		0x4c, 0xe0, 0x41, 0x9a, 0xaf, 0xb5, 0x86, 0x49, 0xff, 0x57, 0x2e, 0x2d, 0xf8, 0x51, 0x61, 0x6d,
+		0xdb, 0x25, 0xbe, 0xd6, 0xf2, 0x86, 0xc9, 0xfe, 0x37, 0x2e, 0x1b, 0x08, 0xa0, 0xb0, 0xae, 0x79,
+		0xfe, 0x28, 0xfd, 0xfe, 0x43, 0x21, 0x2c, 0x04, 0xb0, 0xd1, 0xf8, 0xff, 0x26, 0x39, 0x1c, 0x26,
+		0xfb, 0x47, 0xa2, 0xd1, 0x9c, 0xbf, 0xfc, 0x41, 0xc8, 0xe1, 0x5f, 0x76, 0xcb, 0x75, 0x88, 0xf0,
+		0x7f, 0xe7, 0xc2, 0xa1, 0x04, 0xd6, 0xec, 0xf9, 0x4d, 0xdf, 0x18, 0xae, 0xec, 0x3f, 0xe6, 0x23,
+		0x2d, 0xf8, 0xcb, 0x15, 0x98, 0xf4, 0xfc, 0x66, 0xb3, 0xc3, 0xe3, 0xd3, 0x21, 0xe2, 0xff, 0xe3,
+		0x3b, 0x41, 0xca, 0x22, 0x90, 0xc1, 0xd1, 0xbe, 0x7d, 0xd3, 0x77, 0x6c, 0x7a, 0xde, 0x32, 0x0c,
+		0xe1, 0x5d, 0x8e, 0x10, 0x11, 0x29, 0xaf, 0x42, 0x1e, 0xfb, 0xe2, 0x12, 0x87, 0xd0, 0xc3, 0xb1,
+		0x21, 0x10, 0xff, 0x93, 0x2b, 0x20, 0x26, 0xb4, 0xf2, 0x43, 0x5f, 0x7d, 0x67, 0x21, 0xf1, 0xf5,
+		0x77, 0x16, 0x12, 0xbf, 0xfb, 0xce, 0x42, 0xe2, 0x63, 0xdf, 0x58, 0x18, 0xfb, 0xfa, 0x37, 0x16,
+		0xc6, 0x7e, 0xeb, 0x1b, 0x0b, 0x63, 0xfd, 0xb3, 0xc4, 0xb0, 0x66, 0xaf, 0xd9, 0x2c, 0x3f, 0xfc,
+		0x7a, 0xa9, 0x65, 0xf8, 0x07, 0x9d, 0xbd, 0x65, 0xdd, 0x6e, 0xd3, 0x34, 0x6e, 0x98, 0xad, 0x0d,
+		0x36, 0x39, 0xf0, 0xdd, 0x04, 0x9c, 0x62, 0x18, 0x61, 0xa9, 0x66, 0x1d, 0x0e, 0x7a, 0x93, 0xee,
+		0x02, 0xa4, 0x2a, 0xd6, 0xa1, 0x7c, 0x8a, 0x79, 0x37, 0xb5, 0xe3, 0x9a, 0xfc, 0x9e, 0xe5, 0x04,
+		0x3e, 0xef, 0xba, 0x26, 0xe6, 0xd8, 0xc5, 0x65, 0x68, 0x3c, 0xcb, 0x61, 0x0f, 0x2b, 0x3f, 0x91,
+		0x38, 0x5e, 0x37, 0xb2, 0x15, 0xeb, 0x90, 0xf6, 0xa2, 0x9e, 0x78, 0xfd, 0xe9, 0xa1, 0x49, 0xee,
+		0x9b, 0x96, 0x7d, 0xdb, 0xc2, 0x66, 0x3b, 0x7b, 0x22, 0xc1, 0xbd, 0xd0, 0x9d, 0xe0, 0x7e, 0x85,
+		0x98, 0xe6, 0x75, 0xe4, 0xc3, 0xbb, 0x0b, 0xde, 0x5e, 0x86, 0x5d, 0xe9, 0x87, 0x9f, 0x4a, 0xc2,
+		0x42, 0x4f, 0x2e, 0x9b, 0x5b, 0xc0, 0x20, 0x25, 0x94, 0x21, 0x5b, 0x15, 0x86, 0x55, 0xc4, 0xf7,
+		0xd8, 0x74, 0xdb, 0x6a, 0x7a, 0x54, 0x11, 0x29, 0x45, 0x3c, 0xa2, 0x22, 0x2c, 0xcd, 0xb2, 0x3d,
+		0x7e, 0x53, 0x99, 0x3d, 0xac, 0xfc, 0xec, 0x31, 0x15, 0x31, 0x25, 0x6a, 0x12, 0xda, 0x78, 0x6e,
+		0x44, 0x6d, 0x88, 0x4e, 0xc4, 0xd2, 0xfe, 0xa3, 0x6a, 0xe5, 0xa7, 0x93, 0xb0, 0xd8, 0xad, 0x15,
+		0x9c, 0x56, 0x9e, 0xaf, 0xb5, 0x9d, 0x41, 0x6a, 0xb9, 0x0c, 0xb9, 0x1d, 0xc1, 0x73, 0x6c, 0xbd,
+		0xdc, 0x3b, 0xa6, 0x5e, 0x0a, 0x41, 0x55, 0x42, 0x31, 0xe7, 0x46, 0x54, 0x4c, 0xd0, 0x8f, 0xf7,
+		0xa4, 0x99, 0xff, 0x9d, 0x81, 0x53, 0xba, 0xed, 0xb5, 0x6d, 0x4f, 0x65, 0xe7, 0x23, 0xec, 0x81,
+		0xeb, 0x24, 0x1f, 0x2d, 0x1a, 0x7e, 0x48, 0x52, 0xba, 0x0e, 0xb3, 0xeb, 0xe8, 0x2a, 0x70, 0x0b,
+		0x14, 0x1e, 0xef, 0xf4, 0xbd, 0xcc, 0xbd, 0x14, 0x8b, 0xf6, 0xf9, 0xe1, 0x56, 0x94, 0x54, 0xfa,
+		0xd1, 0x04, 0x48, 0x0d, 0x5d, 0x33, 0x35, 0xf7, 0xff, 0x15, 0x4a, 0xbe, 0x08, 0xc0, 0xee, 0x7a,
+		0x04, 0x6f, 0xed, 0x15, 0xce, 0x15, 0x97, 0xa3, 0x9d, 0x5b, 0x66, 0x35, 0xd1, 0xeb, 0x53, 0x39,
+		0xca, 0x8b, 0x7f, 0x9f, 0x7c, 0x15, 0x20, 0x2c, 0x90, 0x4f, 0xc3, 0x03, 0x8d, 0xd5, 0xca, 0x46,
+		0x45, 0x11, 0x37, 0x84, 0x1a, 0xf5, 0xda, 0xea, 0xfa, 0x95, 0xf5, 0x5a, 0x55, 0x1a, 0xc3, 0xcb,
+		0x35, 0xd1, 0xc2, 0xe0, 0x46, 0xd3, 0x09, 0x98, 0x89, 0xd2, 0xd9, 0x2b, 0x2a, 0x49, 0x0c, 0x13,
+		0x8d, 0xb6, 0x63, 0x12, 0x7a, 0xec, 0xa8, 0x1a, 0x42, 0x6b, 0xc3, 0x23, 0x90, 0x7f, 0xf5, 0x6f,
+		0xd9, 0x6b, 0x0b, 0xb3, 0xa1, 0x78, 0xa0, 0xf3, 0xf2, 0x06, 0xcc, 0xe0, 0x45, 0x4a, 0x27, 0x06,
+		0x39, 0xc4, 0x4f, 0x23, 0x20, 0x3d, 0x48, 0xe5, 0x92, 0x21, 0xda, 0x45, 0xc8, 0x78, 0xb4, 0xf7,
+		0xc3, 0x20, 0xbe, 0xc6, 0x21, 0x38, 0x7b, 0xd9, 0x82, 0x19, 0x0c, 0xfb, 0x30, 0x3b, 0x14, 0x36,
+		0xe3, 0xe8, 0x24, 0xc3, 0x3f, 0xfa, 0xe2, 0xb3, 0xf4, 0x58, 0xf5, 0xe1, 0xf8, 0xb0, 0xf4, 0x31,
+		0x27, 0x45, 0xe2, 0xd8, 0x61, 0x43, 0x09, 0x14, 0x44, 0x7d, 0xbc, 0xc1, 0x47, 0x57, 0xf6, 0x8f,
+		0x79, 0x65, 0x0b, 0xfd, 0x6c, 0x20, 0x52, 0xd3, 0x14, 0x47, 0x65, 0x05, 0x2b, 0xb5, 0x41, 0x73,
+		0xfa, 0xf5, 0xa7, 0x22, 0x4b, 0x13, 0x83, 0xe4, 0x3f, 0xcf, 0x50, 0xe4, 0xcb, 0xd1, 0x6a, 0x82,
+		0xb9, 0xf7, 0x9b, 0x29, 0x58, 0xe0, 0xcc, 0x7b, 0x9a, 0x47, 0xce, 0xde, 0x7a, 0x6e, 0x8f, 0xf8,
+		0xda, 0x73, 0x67, 0x75, 0xdb, 0x10, 0xbe, 0x7a, 0x96, 0x4f, 0x47, 0x2c, 0x5f, 0xe6, 0xe5, 0xf3,
+		0x7d, 0x4f, 0x33, 0xe7, 0x07, 0x4f, 0xe3, 0xd2, 0x2e, 0xa4, 0x57, 0x6d, 0xc3, 0x42, 0x57, 0xd5,
+		0x24, 0x96, 0xdd, 0xe6, 0xb3, 0x87, 0x3d, 0xc8, 0xcf, 0x41, 0x46, 0x6b, 0xdb, 0x1d, 0xcb, 0x67,
+		0x33, 0x67, 0xe5, 0xd4, 0x57, 0xdf, 0x5e, 0x1c, 0xfb, 0x77, 0x6f, 0x2f, 0xa6, 0xd6, 0x2d, 0xff,
+		0x37, 0xbe, 0xf4, 0x0c, 0x70, 0xa8, 0x75, 0xcb, 0x57, 0x38, 0x63, 0x39, 0xfd, 0xcd, 0x4f, 0x2f,
+		0x26, 0x4a, 0xaf, 0xc2, 0x44, 0x95, 0xe8, 0xef, 0x05, 0xb9, 0x4a, 0xf4, 0x08, 0x72, 0x95, 0xe8,
+		0x5d, 0xc8, 0x17, 0x21, 0xbb, 0x6e, 0xf9, 0xec, 0x4d, 0x90, 0xa7, 0x20, 0x65, 0x58, 0xec, 0x72,
+		0xf1, 0x91, 0x6d, 0x43, 0x2e, 0x14, 0xac, 0x12, 0x3d, 0x10, 0x6c, 0x12, 0xbd, 0x98, 0x18, 0x56,
+		0x35, 0x72, 0xad, 0x54, 0x7f, 0xeb, 0xf7, 0x16, 0xc6, 0xde, 0x7a, 0x67, 0x61, 0x6c, 0xe0, 0x10,
+		0x97, 0x06, 0x0e, 0xb1, 0xd7, 0xbc, 0xc9, 0x3c, 0x72, 0x30, 0xb2, 0x9f, 0x4f, 0xc3, 0x43, 0xf4,
+		0x05, 0x41, 0xb7, 0x6d, 0x58, 0xfe, 0x59, 0xdd, 0x3d, 0x74, 0x7c, 0x1a, 0xae, 0xd8, 0xfb, 0x7c,
+		0x60, 0x67, 0xc2, 0xe2, 0x65, 0x56, 0xdc, 0x7f, 0x58, 0x4b, 0xfb, 0x30, 0x5e, 0x47, 0x39, 0x54,
+		0xb1, 0x6f, 0xfb, 0x9a, 0xc9, 0xd7, 0x1f, 0xf6, 0x80, 0x54, 0xf6, 0x52, 0x61, 0x92, 0x51, 0x0d,
+		0xf1, 0x3e, 0xa1, 0x49, 0xb4, 0x7d, 0xf6, 0x6e, 0x46, 0x8a, 0x06, 0x2e, 0x59, 0x24, 0xd0, 0xd7,
+		0x30, 0xe6, 0x60, 0x5c, 0xeb, 0xb0, 0xfb, 0x13, 0x29, 0x8c, 0x68, 0xe8, 0x43, 0xe9, 0x3a, 0x4c,
+		0xf0, 0x63, 0x54, 0xbc, 0x40, 0x70, 0x93, 0x1c, 0xd2, 0x7a, 0xf2, 0x0a, 0xfe, 0x95, 0x97, 0x61,
+		0x9c, 0x36, 0x9e, 0xbf, 0x74, 0x56, 0x5c, 0xee, 0x69, 0xfd, 0x32, 0x6d, 0xa4, 0xc2, 0xd8, 0x4a,
+		0xd7, 0x20, 0x5b, 0xb5, 0xdb, 0x86, 0x65, 0xc7, 0xd1, 0x72, 0x0c, 0x8d, 0xb6, 0xd9, 0xe9, 0x70,
+		0xab, 0x50, 0xd8, 0x03, 0xde, 0x2c, 0x66, 0xef, 0xea, 0xf0, 0x3b, 0x20, 0xfc, 0xa9, 0xb4, 0x0a,
+		0x13, 0x14, 0x7b, 0xdb, 0x41, 0xe7, 0x1f, 0x5c, 0x5f, 0xce, 0xf1, 0x37, 0x37, 0x39, 0x7c, 0x32,
+		0x6c, 0xac, 0x0c, 0xe9, 0xa6, 0xe6, 0x6b, 0xbc, 0xdf, 0xf4, 0x7f, 0xe9, 0x43, 0x90, 0xe5, 0x20,
+		0x9e, 0x7c, 0x0e, 0x52, 0xb6, 0xe3, 0xf1, 0x5b, 0x1c, 0xf3, 0x83, 0xba, 0xb2, 0xed, 0xac, 0xa4,
+		0xd1, 0x66, 0x14, 0x64, 0x5e, 0x51, 0x06, 0x9a, 0xc5, 0x8b, 0x11, 0xb3, 0x88, 0x0c, 0x79, 0xe4,
+		0x2f, 0x1b, 0xd2, 0x1e, 0x73, 0x08, 0x8c, 0xe5, 0x33, 0x49, 0x58, 0x88, 0x94, 0xde, 0x22, 0x2e,
+		0xe6, 0x12, 0x98, 0x45, 0x71, 0x6b, 0x91, 0x23, 0x8d, 0xe4, 0xe5, 0x03, 0xcc, 0xe5, 0x83, 0x90,
+		0xaa, 0x38, 0x0e, 0xbe, 0xb2, 0x4a, 0x9f, 0x75, 0x9b, 0xd9, 0x4b, 0x5a, 0x09, 0x9e, 0xb1, 0xcc,
+		0xb3, 0xf7, 0xfd, 0xdb, 0x9a, 0x1b, 0xbc, 0xce, 0x2a, 0x9e, 0x4b, 0x97, 0x20, 0xb7, 0x6a, 0x5b,
+		0x1e, 0xb1, 0xbc, 0x0e, 0x8d, 0x6c, 0xf6, 0x4c, 0x5b, 0xbf, 0xc9, 0x11, 0xd8, 0x03, 0x2a, 0x5c,
+		0x73, 0x1c, 0x2a, 0x99, 0x56, 0xf0, 0x2f, 0x9b, 0xb3, 0x2b, 0x8d, 0x81, 0x2a, 0xba, 0x74, 0x7c,
+		0x15, 0xf1, 0x4e, 0x06, 0x3a, 0xfa, 0x6e, 0x02, 0x1e, 0xec, 0x9d, 0x50, 0x37, 0xc9, 0xa1, 0x77,
+		0xdc, 0xf9, 0xf4, 0x2a, 0xe4, 0xea, 0xf4, 0x6b, 0x13, 0xd7, 0xc9, 0xa1, 0x3c, 0x8f, 0x9f, 0x24,
+		0x38, 0x77, 0xfe, 0xfc, 0x73, 0x97, 0x98, 0xb5, 0x5f, 0x1d, 0x53, 0x04, 0x41, 0x5e, 0x80, 0x9c,
+		0x47, 0x74, 0xe7, 0xdc, 0xf9, 0x0b, 0x37, 0x9f, 0x63, 0xe6, 0x75, 0x75, 0x4c, 0x09, 0x49, 0xe5,
+		0x2c, 0xf6, 0xfa, 0x9b, 0x9f, 0x59, 0x4c, 0xac, 0x8c, 0x43, 0xca, 0xeb, 0xb4, 0xdf, 0x57, 0x1b,
+		0xf9, 0xc4, 0x38, 0x2c, 0x45, 0x25, 0x69, 0xfc, 0x77, 0x4b, 0x33, 0x8d, 0xa6, 0x16, 0x7e, 0x27,
+		0x44, 0x8a, 0xe8, 0x80, 0x72, 0x0c, 0x58, 0x29, 0x8e, 0xd4, 0x64, 0xe9, 0x97, 0x13, 0x90, 0xbf,
+		0x21, 0x90, 0xf1, 0xc3, 0x22, 0x97, 0x01, 0x82, 0x9a, 0xc4, 0xb4, 0x39, 0xbd, 0xdc, 0x5d, 0xd7,
+		0x72, 0x20, 0xa3, 0x44, 0xd8, 0xe5, 0x8b, 0xd4, 0x10, 0x1d, 0xdb, 0xe3, 0xaf, 0x38, 0x0e, 0x11,
+		0x0d, 0x98, 0xf1, 0x6e, 0x1e, 0xf5, 0x70, 0xea, 0x2d, 0xdb, 0xc7, 0xdb, 0x02, 0x8e, 0x7d, 0x9b,
+		0xbf, 0x38, 0x9e, 0x52, 0x24, 0x5a, 0x72, 0x83, 0x16, 0xd4, 0x91, 0x8e, 0x8d, 0xce, 0x05, 0x28,
+		0x18, 0xac, 0x6b, 0xcd, 0xa6, 0x4b, 0x3c, 0x8f, 0x3b, 0x31, 0xf1, 0x88, 0xef, 0x55, 0x3a, 0x9d,
+		0x3d, 0x55, 0x78, 0x0c, 0x7c, 0x33, 0xb5, 0xcf, 0xfc, 0x17, 0xf6, 0xc1, 0x3d, 0x40, 0xc6, 0xe9,
+		0xec, 0xa1, 0xb5, 0x3c, 0x0c, 0xf9, 0x3e, 0x8d, 0x99, 0xbc, 0x15, 0xb6, 0x83, 0x7e, 0xe4, 0x84,
+		0xf7, 0x40, 0x75, 0x5c, 0xc3, 0x76, 0x0d, 0xff, 0x90, 0xde, 0xc4, 0x4a, 0x29, 0x92, 0x28, 0xa8,
+		0x73, 0x7a, 0xe9, 0x26, 0x4c, 0x37, 0x68, 0x10, 0x17, 0xb6, 0xfc, 0x7c, 0xd8, 0xbe, 0xc4, 0xf0,
+		0xf6, 0x0d, 0x6c, 0x59, 0xb2, 0xa7, 0x65, 0x2b, 0x2f, 0x0f, 0xb4, 0xce, 0x8b, 0xc7, 0xb7, 0xce,
+		0xf8, 0x6a, 0xf7, 0x87, 0xa7, 0xe0, 0xc1, 0xee, 0xc2, 0x98, 0xfb, 0x1a, 0xd5, 0x30, 0x87, 0xed,
+		0xd1, 0xe6, 0x8f, 0x5e, 0x54, 0xe7, 0x87, 0xb8, 0xd1, 0xf9, 0xa1, 0x53, 0xa8, 0x74, 0x09, 0xa6,
+		0xf0, 0x4e, 0x65, 0x83, 0xf8, 0x57, 0x89, 0xd6, 0x24, 0x6e, 0x7c, 0xd5, 0x9d, 0x12, 0xab, 0xae,
+		0x0c, 0x69, 0xba, 0xb4, 0xb2, 0x55, 0x87, 0xfe, 0x2f, 0x1d, 0x40, 0x1a, 0x45, 0xc3, 0x15, 0x99,
+		0x4b, 0xd0, 0x07, 0xa4, 0xee, 0x1d, 0xfa, 0xc4, 0x13, 0x69, 0x04, 0xfa, 0x20, 0xbf, 0x20, 0xd6,
+		0xd5, 0xd4, 0xd1, 0xeb, 0x2a, 0x37, 0x44, 0xbe, 0xba, 0x9a, 0x30, 0xb1, 0x82, 0xae, 0x78, 0xbd,
+		0x1a, 0x34, 0x24, 0x11, 0x36, 0x44, 0xde, 0x84, 0x69, 0x47, 0x73, 0x7d, 0xfa, 0x7a, 0xd6, 0x01,
+		0xed, 0x05, 0xb7, 0xf5, 0xc5, 0xde, 0x99, 0x17, 0xeb, 0x2c, 0xaf, 0x65, 0xca, 0x89, 0x12, 0x4b,
+		0xff, 0x29, 0x0d, 0x19, 0xae, 0x8c, 0x0f, 0xc2, 0x04, 0x57, 0x2b, 0xb7, 0xce, 0x87, 0x96, 0x7b,
+		0x17, 0xa6, 0xe5, 0x60, 0x01, 0xe1, 0x78, 0x42, 0x46, 0x7e, 0x0c, 0xb2, 0xfa, 0x81, 0x66, 0x58,
+		0xaa, 0xd1, 0xe4, 0x01, 0xe1, 0xe4, 0x3b, 0x6f, 0x2f, 0x4e, 0xac, 0x22, 0x6d, 0xbd, 0xaa, 0x4c,
+		0xd0, 0xc2, 0xf5, 0x26, 0x46, 0x02, 0x07, 0xc4, 0x68, 0x1d, 0xf8, 0x7c, 0x86, 0xf1, 0x27, 0xfc,
+		0xc2, 0x11, 0x1a, 0x04, 0x7f, 0x79, 0x77, 0xbe, 0x27, 0xc2, 0x0f, 0xb6, 0xd0, 0x2b, 0x59, 0xac,
+		0xf8, 0x63, 0xff, 0x71, 0x31, 0xa1, 0x50, 0x09, 0x79, 0x15, 0xa6, 0x4c, 0xcd, 0xf3, 0x55, 0xba,
+		0x82, 0x61, 0xf5, 0xe3, 0x14, 0xe2, 0x54, 0xaf, 0x42, 0xb8, 0x62, 0x79, 0xd3, 0x27, 0x51, 0x8a,
+		0x91, 0x9a, 0xf8, 0x6e, 0x21, 0x05, 0xc1, 0xab, 0xa4, 0x86, 0xcf, 0x62, 0xab, 0x0c, 0xd5, 0x7b,
+		0x01, 0xe9, 0xab, 0x94, 0x4c, 0x23, 0xac, 0xd3, 0x90, 0xa3, 0xaf, 0x0b, 0x52, 0x16, 0x76, 0x07,
+		0x38, 0x8b, 0x04, 0x5a, 0xf8, 0x38, 0x4c, 0x87, 0xfe, 0x91, 0xb1, 0x64, 0x19, 0x4a, 0x48, 0xa6,
+		0x8c, 0xcf, 0xc2, 0x9c, 0x45, 0xee, 0xf8, 0x6a, 0x48, 0x66, 0xdc, 0x39, 0xca, 0x2d, 0x63, 0xd9,
+		0x8d, 0xb8, 0xc4, 0xa3, 0x50, 0xd0, 0x85, 0xf2, 0x19, 0x2f, 0x50, 0xde, 0xa9, 0x80, 0x4a, 0xd9,
+		0x4e, 0x41, 0x56, 0x73, 0x1c, 0xc6, 0x30, 0xc9, 0xfd, 0xa3, 0xe3, 0xd0, 0xa2, 0x27, 0x61, 0x86,
+		0xf6, 0xd1, 0x25, 0x5e, 0xc7, 0xf4, 0x39, 0x48, 0x9e, 0xf2, 0x4c, 0x63, 0x81, 0xc2, 0xe8, 0x94,
+		0xf7, 0x03, 0x30, 0x45, 0x6e, 0x19, 0x4d, 0x62, 0xe9, 0x84, 0xf1, 0x4d, 0x51, 0xbe, 0xbc, 0x20,
+		0x52, 0xa6, 0x27, 0x20, 0xf0, 0x7b, 0xaa, 0xf0, 0xc9, 0x05, 0x86, 0x27, 0xe8, 0x15, 0x46, 0x2e,
+		0x15, 0x21, 0x5d, 0xd5, 0x7c, 0x0d, 0x03, 0x0c, 0xff, 0x0e, 0x5b, 0x68, 0xf2, 0x0a, 0xfe, 0x2d,
+		0x7d, 0x33, 0x09, 0xe9, 0x1b, 0xb6, 0x4f, 0xe4, 0xe7, 0x23, 0x01, 0x60, 0xa1, 0x9f, 0x3d, 0x37,
+		0x8c, 0x96, 0x45, 0x9a, 0x9b, 0x5e, 0x2b, 0xf2, 0x6d, 0x8f, 0xd0, 0x9c, 0x92, 0x31, 0x73, 0x9a,
+		0x83, 0x71, 0xd7, 0xee, 0x58, 0x4d, 0x71, 0x7b, 0x96, 0x3e, 0xc8, 0x35, 0xc8, 0x06, 0x56, 0x92,
+		0x1e, 0x66, 0x25, 0xd3, 0x68, 0x25, 0x68, 0xc3, 0x9c, 0xa0, 0x4c, 0xec, 0x71, 0x63, 0x59, 0x81,
+		0x5c, 0xe0, 0xbc, 0x8a, 0xe3, 0xc7, 0x30, 0xd8, 0x50, 0x0c, 0x17, 0x93, 0x60, 0xec, 0x03, 0xe5,
+		0x31, 0x8b, 0x93, 0x82, 0x02, 0xae, 0xbd, 0x98, 0x59, 0xf1, 0xef, 0x8c, 0x4c, 0xd0, 0x7e, 0x85,
+		0x66, 0xc5, 0xbe, 0x35, 0xf2, 0x20, 0x5e, 0x47, 0x6a, 0x59, 0x9a, 0xdf, 0x71, 0x09, 0xb7, 0xbc,
+		0x90, 0x50, 0xfa, 0xf5, 0x04, 0x64, 0x98, 0x25, 0x47, 0xf4, 0x96, 0xe8, 0xaf, 0xb7, 0xe4, 0x20,
+		0xbd, 0xa5, 0xde, 0xbb, 0xde, 0x2a, 0x00, 0x41, 0x63, 0x3c, 0xfe, 0xf9, 0x87, 0x3e, 0x11, 0x03,
+		0x6b, 0x62, 0xc3, 0x68, 0xf1, 0x89, 0x1a, 0x11, 0x2a, 0xfd, 0x87, 0x04, 0xe4, 0x82, 0x72, 0xb9,
+		0x02, 0x53, 0xa2, 0x5d, 0xea, 0xbe, 0xa9, 0xb5, 0xb8, 0xed, 0x3c, 0x34, 0xb0, 0x71, 0x57, 0x4c,
+		0xad, 0xa5, 0x4c, 0xf2, 0xf6, 0xe0, 0x43, 0xff, 0x71, 0x48, 0x0e, 0x18, 0x87, 0xd8, 0xc0, 0xa7,
+		0xde, 0xdb, 0xc0, 0xc7, 0x86, 0x28, 0xdd, 0x3d, 0x44, 0x5f, 0x4c, 0xd2, 0xcd, 0x8c, 0x63, 0x7b,
+		0x9a, 0xf9, 0xbd, 0x98, 0x11, 0xa7, 0x21, 0xe7, 0xd8, 0xa6, 0xca, 0x4a, 0xd8, 0xad, 0xf2, 0xac,
+		0x63, 0x9b, 0x4a, 0xcf, 0xb0, 0x8f, 0xdf, 0xa7, 0xe9, 0x92, 0xb9, 0x0f, 0x5a, 0x9b, 0xe8, 0xd6,
+		0x9a, 0x0b, 0x79, 0xa6, 0x0a, 0xbe, 0x96, 0x3d, 0x8b, 0x3a, 0xc0, 0x7f, 0xc5, 0x44, 0xef, 0xda,
+		0xcb, 0x9a, 0xcd, 0x38, 0x95, 0xcc, 0x41, 0x20, 0xc1, 0x5c, 0x7f, 0x31, 0x39, 0x48, 0x82, 0x99,
+		0x9d, 0xc2, 0xf9, 0x4a, 0x7f, 0x3d, 0x01, 0xb0, 0x81, 0x9a, 0xa5, 0xfd, 0xc5, 0x55, 0xc8, 0xa3,
+		0x4d, 0x50, 0x63, 0x35, 0x2f, 0x0c, 0x1a, 0x34, 0x5e, 0x7f, 0xde, 0x8b, 0xb6, 0x7b, 0x15, 0xa6,
+		0x42, 0x63, 0xf4, 0x88, 0x68, 0xcc, 0xc2, 0x11, 0x51, 0x75, 0x83, 0xf8, 0x4a, 0xfe, 0x56, 0xe4,
+		0xa9, 0xf4, 0xcf, 0x12, 0x90, 0xa3, 0x6d, 0xc2, 0x97, 0xd7, 0x63, 0x63, 0x98, 0x78, 0xef, 0x63,
+		0xf8, 0x10, 0x00, 0x83, 0xc1, 0xc3, 0x59, 0x6e, 0x59, 0x39, 0x4a, 0xc1, 0x23, 0x57, 0xf9, 0x42,
+		0xa0, 0xf0, 0xd4, 0xd1, 0x0a, 0x17, 0x51, 0x37, 0x57, 0xfb, 0x03, 0x30, 0x41, 0x3f, 0x97, 0x76,
+		0xc7, 0xe3, 0x81, 0x34, 0x7e, 0x23, 0x65, 0xe7, 0x8e, 0x57, 0x7a, 0x03, 0x26, 0x76, 0xee, 0xb0,
+		0xdc, 0xc8, 0x69, 0xc8, 0xb9, 0xb6, 0xcd, 0xd7, 0x64, 0x16, 0x0b, 0x65, 0x91, 0x40, 0x97, 0x20,
+		0x91, 0x0f, 0x48, 0x86, 0xf9, 0x80, 0x30, 0xa1, 0x91, 0x1a, 0x29, 0xa1, 0xf1, 0xe4, 0x6f, 0x26,
+		0x60, 0x32, 0xe2, 0x1f, 0xe4, 0xe7, 0xe0, 0xc4, 0xca, 0xc6, 0xf6, 0xea, 0x75, 0x75, 0xbd, 0xaa,
+		0x5e, 0xd9, 0xa8, 0xac, 0x85, 0x2f, 0x4e, 0xcd, 0x9f, 0xbc, 0x7b, 0x6f, 0x49, 0x8e, 0xf0, 0xee,
+		0x5a, 0x34, 0x4f, 0x2f, 0x9f, 0x85, 0xb9, 0xb8, 0x48, 0x65, 0xa5, 0x81, 0x6f, 0x51, 0x25, 0xe6,
+		0x4f, 0xdc, 0xbd, 0xb7, 0x34, 0x13, 0x91, 0xa8, 0xec, 0x79, 0xc4, 0xf2, 0x7b, 0x05, 0x56, 0xb7,
+		0x37, 0x37, 0xd7, 0x77, 0xa4, 0x64, 0x8f, 0x00, 0x77, 0xd8, 0x4f, 0xc0, 0x4c, 0x5c, 0x60, 0x6b,
+		0x7d, 0x43, 0x4a, 0xcd, 0xcb, 0x77, 0xef, 0x2d, 0x15, 0x22, 0xdc, 0x5b, 0x86, 0x39, 0x9f, 0xfd,
+		0xb1, 0xcf, 0x2e, 0x8c, 0xfd, 0xe2, 0xcf, 0x2f, 0x24, 0xb0, 0x67, 0x53, 0x31, 0x1f, 0x21, 0x3f,
+		0x0d, 0x0f, 0x34, 0xd6, 0xd7, 0xb6, 0x6a, 0x55, 0x75, 0xb3, 0xb1, 0xd6, 0xf5, 0x2e, 0xec, 0xfc,
+		0xf4, 0xdd, 0x7b, 0x4b, 0x93, 0xbc, 0x4b, 0x83, 0xb8, 0xeb, 0x4a, 0xed, 0xc6, 0xf6, 0x4e, 0x4d,
+		0x4a, 0x30, 0xee, 0xba, 0x4b, 0x6e, 0xd9, 0x3e, 0xfb, 0xd2, 0xe2, 0xb3, 0x70, 0xaa, 0x0f, 0x77,
+		0xd0, 0xb1, 0x99, 0xbb, 0xf7, 0x96, 0xa6, 0xea, 0x2e, 0x61, 0xf3, 0x87, 0x4a, 0x2c, 0x43, 0xb1,
+		0x57, 0x62, 0xbb, 0xbe, 0xdd, 0xa8, 0x6c, 0x48, 0x4b, 0xf3, 0xd2, 0xdd, 0x7b, 0x4b, 0x79, 0xe1,
+		0x0c, 0x91, 0x3f, 0xec, 0xd9, 0xfb, 0xb9, 0xe3, 0xf9, 0xf1, 0x73, 0xf0, 0x08, 0xcf, 0x01, 0x7a,
+		0xbe, 0x76, 0xd3, 0xb0, 0x5a, 0x41, 0xf2, 0x96, 0x3f, 0xf3, 0x9d, 0xcf, 0x49, 0xc6, 0xb5, 0x2c,
+		0xa8, 0x43, 0x52, 0xb8, 0x03, 0x4f, 0x2e, 0xe7, 0x87, 0x1c, 0xea, 0x0d, 0xdf, 0x3a, 0x0d, 0x4e,
+		0x0f, 0xcf, 0x0f, 0x49, 0x42, 0xcf, 0x1f, 0xb9, 0xb9, 0x2b, 0x7d, 0x34, 0x01, 0x85, 0xab, 0x86,
+		0xe7, 0xdb, 0xae, 0xa1, 0x6b, 0x26, 0x7d, 0x5d, 0xea, 0xc2, 0xa8, 0xbe, 0xb5, 0x6b, 0xaa, 0xbf,
+		0x04, 0x99, 0x5b, 0x9a, 0xc9, 0x9c, 0x5a, 0xf4, 0x2c, 0xa0, 0x5b, 0x7d, 0xa1, 0x6b, 0x13, 0x00,
+		0x4c, 0xac, 0xf4, 0x85, 0x24, 0x4c, 0xd3, 0xc9, 0xe0, 0xb1, 0xcf, 0xe1, 0xe1, 0x1e, 0xab, 0x0e,
+		0x69, 0x57, 0xf3, 0x79, 0xd2, 0x70, 0xe5, 0x07, 0x78, 0x1e, 0xf8, 0xb1, 0xe1, 0xd9, 0xdc, 0xe5,
+		0xde, 0x54, 0x31, 0x45, 0x92, 0x5f, 0x81, 0x6c, 0x5b, 0xbb, 0xa3, 0x52, 0xd4, 0xe4, 0x7d, 0x40,
+		0x9d, 0x68, 0x6b, 0x77, 0xb0, 0xad, 0x72, 0x13, 0xa6, 0x11, 0x58, 0x3f, 0xd0, 0xac, 0x16, 0x61,
+		0xf8, 0xa9, 0xfb, 0x80, 0x3f, 0xd5, 0xd6, 0xee, 0xac, 0x52, 0x4c, 0xac, 0xa5, 0x9c, 0xfd, 0xf8,
+		0xa7, 0x17, 0xc7, 0x68, 0x9a, 0xfd, 0x2b, 0x09, 0x80, 0x50, 0x5d, 0xf2, 0x9f, 0x06, 0x49, 0x0f,
+		0x9e, 0x68, 0xf5, 0x1e, 0x1f, 0xc0, 0xc7, 0x07, 0x0d, 0x44, 0x97, 0xb2, 0xd9, 0xc2, 0xfc, 0xf5,
+		0xb7, 0x17, 0x13, 0xca, 0xb4, 0xde, 0x35, 0x0e, 0x35, 0x98, 0xec, 0x38, 0x4d, 0xcd, 0x27, 0x2a,
+		0xdd, 0xc4, 0x25, 0x8f, 0xb1, 0xc8, 0x03, 0x13, 0xc4, 0xa2, 0x48, 0xeb, 0xbf, 0x90, 0x80, 0xc9,
+		0x6a, 0xe4, 0x90, 0xaf, 0x08, 0x13, 0x6d, 0xdb, 0x32, 0x6e, 0x72, 0xb3, 0xcb, 0x29, 0xe2, 0x11,
+		0x33, 0x9e, 0xec, 0x45, 0x51, 0xff, 0x50, 0x64, 0x3c, 0xc5, 0x33, 0x4a, 0xdd, 0x26, 0x7b, 0x9e,
+		0x21, 0x74, 0xad, 0x88, 0x47, 0xdc, 0xba, 0x78, 0x44, 0xef, 0x60, 0xaa, 0x06, 0xdf, 0x11, 0xf7,
+		0xf1, 0x03, 0x10, 0xec, 0xd5, 0xa2, 0x69, 0x41, 0x5f, 0x65, 0x64, 0x04, 0x69, 0x12, 0x5f, 0x33,
+		0x4c, 0xaf, 0xc8, 0x0e, 0xc2, 0xc4, 0x63, 0xa4, 0xb9, 0x9f, 0xca, 0x46, 0x53, 0x54, 0xab, 0x20,
+		0xd9, 0x0e, 0x71, 0x63, 0x21, 0x25, 0xb3, 0xd0, 0xe2, 0x6f, 0x7c, 0xe9, 0x99, 0x39, 0xae, 0x6e,
+		0x1e, 0x54, 0xb2, 0x4b, 0xad, 0xca, 0xb4, 0x90, 0xe0, 0x64, 0xf9, 0x35, 0x90, 0x82, 0x9d, 0x9d,
+		0xea, 0x74, 0xf6, 0xc2, 0xb4, 0xd6, 0x5c, 0x8f, 0x5e, 0x2b, 0xd6, 0xe1, 0x4a, 0xf1, 0x6b, 0x21,
+		0x74, 0x98, 0x4b, 0xc2, 0x44, 0xd2, 0x74, 0x80, 0x53, 0xa7, 0x30, 0x18, 0x22, 0xbe, 0xa1, 0x19,
+		0xa6, 0x78, 0xaf, 0x5e, 0xe1, 0x4f, 0x72, 0x19, 0x32, 0x9e, 0xaf, 0xf9, 0x1d, 0x8f, 0x7f, 0xac,
+		0xb1, 0x34, 0xc8, 0x32, 0x56, 0x6c, 0xab, 0xd9, 0xa0, 0x9c, 0x0a, 0x97, 0x90, 0x77, 0x20, 0xe3,
+		0xdb, 0x37, 0x89, 0xc5, 0x95, 0x74, 0x2c, 0xab, 0xee, 0x73, 0x16, 0xc5, 0xb0, 0xe4, 0x16, 0x48,
+		0x4d, 0x62, 0x92, 0x16, 0x0b, 0x88, 0x0e, 0x34, 0xdc, 0x37, 0x64, 0xee, 0xc3, 0xac, 0x99, 0x0e,
+		0x50, 0x1b, 0x14, 0x54, 0xbe, 0x1e, 0x3f, 0x66, 0x66, 0x5f, 0x36, 0xfd, 0xc0, 0xa0, 0xfe, 0x47,
+		0x2c, 0x53, 0x24, 0x13, 0x22, 0xd2, 0x68, 0x5c, 0x1d, 0x6b, 0xcf, 0xb6, 0xe8, 0x5b, 0xaa, 0x3c,
+		0x18, 0xcf, 0xd2, 0xf0, 0x66, 0x3a, 0xa0, 0x5f, 0xa5, 0x64, 0xf9, 0x3a, 0x14, 0x42, 0x56, 0x3a,
+		0x77, 0x72, 0xc7, 0x98, 0x3b, 0x53, 0x81, 0x2c, 0x96, 0xca, 0x57, 0x01, 0xc2, 0x89, 0x49, 0xd3,
+		0x03, 0x93, 0xe7, 0x4a, 0xc3, 0x67, 0xb7, 0xd8, 0x66, 0x85, 0xb2, 0xb2, 0x09, 0xb3, 0x6d, 0xc3,
+		0x52, 0x3d, 0x62, 0xee, 0xab, 0x5c, 0x55, 0x08, 0x39, 0x79, 0x1f, 0x86, 0x76, 0xa6, 0x6d, 0x58,
+		0x0d, 0x62, 0xee, 0x57, 0x03, 0x58, 0x59, 0x83, 0x29, 0xd3, 0xf8, 0x70, 0xc7, 0x68, 0x8a, 0x21,
+		0xce, 0xdf, 0x87, 0x21, 0xce, 0x33, 0x48, 0x3e, 0xbe, 0x0e, 0x9c, 0x08, 0x23, 0x6b, 0xd4, 0x99,
+		0xa8, 0x6a, 0xea, 0x3e, 0x54, 0x35, 0x1b, 0x40, 0xd3, 0x89, 0x41, 0x81, 0xcb, 0xf9, 0x1f, 0xfb,
+		0xf4, 0xe2, 0x18, 0x77, 0x10, 0x63, 0xa5, 0x3a, 0xcd, 0xbb, 0xf3, 0xb9, 0x4d, 0x3c, 0xf9, 0x02,
+		0xe4, 0x34, 0xf1, 0x40, 0xb3, 0x21, 0x47, 0xf9, 0x86, 0x90, 0x95, 0xb9, 0x9c, 0xb7, 0x7e, 0x67,
+		0x29, 0x51, 0xfa, 0xf9, 0x04, 0x64, 0xaa, 0x37, 0xea, 0x9a, 0xe1, 0xca, 0x35, 0x3c, 0x91, 0x17,
+		0xb3, 0x64, 0x54, 0x87, 0x13, 0x4e, 0x2c, 0x4e, 0x47, 0x98, 0xfe, 0x5b, 0xe1, 0x23, 0x61, 0xba,
+		0x37, 0xc9, 0x5d, 0x1d, 0xaf, 0xc1, 0x04, 0x6b, 0x25, 0xbe, 0xba, 0x3d, 0xee, 0xe0, 0x9f, 0x62,
+		0x22, 0x76, 0x3e, 0xdf, 0x3b, 0xbb, 0x28, 0x7f, 0x90, 0x16, 0x45, 0x91, 0xd2, 0x77, 0x13, 0x00,
+		0xd5, 0x1b, 0x37, 0x76, 0x5c, 0xc3, 0x31, 0x89, 0x7f, 0xbf, 0x7a, 0xbc, 0x11, 0xb5, 0x0a, 0xcf,
+		0xd5, 0x47, 0xee, 0x75, 0x38, 0xe2, 0x0d, 0x57, 0xef, 0x8b, 0xd6, 0xf4, 0xfc, 0x00, 0x2d, 0x35,
+		0x32, 0x5a, 0xd5, 0xf3, 0xfb, 0xab, 0xb1, 0x01, 0x93, 0x61, 0xf7, 0xf1, 0x9b, 0x7d, 0x59, 0x9f,
+		0xff, 0xe7, 0xda, 0x2c, 0x0d, 0xd6, 0xa6, 0x10, 0xe3, 0x1a, 0x0d, 0x24, 0x4b, 0xbf, 0x90, 0x04,
+		0x88, 0x4c, 0xc3, 0xef, 0x2b, 0x33, 0xc2, 0x05, 0x85, 0x4f, 0xd1, 0xfb, 0x11, 0x26, 0x71, 0x2c,
+		0xcc, 0xa2, 0xc6, 0xfd, 0x40, 0x91, 0xbd, 0x1f, 0x31, 0x15, 0x9b, 0xc2, 0x5d, 0xca, 0xff, 0x48,
+		0x12, 0x3f, 0x7f, 0xc1, 0x3d, 0xed, 0xf7, 0xad, 0xc2, 0xea, 0x30, 0x41, 0x2c, 0xdf, 0x35, 0xa8,
+		0xc6, 0xd0, 0x24, 0x9e, 0x1d, 0x64, 0x12, 0x7d, 0xfa, 0x42, 0xbf, 0x97, 0x26, 0x72, 0xfa, 0x1c,
+		0xa6, 0x4b, 0x0b, 0xff, 0x3e, 0x09, 0xc5, 0x41, 0x92, 0x98, 0xa1, 0xd4, 0x5d, 0x42, 0x09, 0x6a,
+		0x2c, 0xb1, 0x58, 0x10, 0x64, 0xbe, 0xe0, 0x6d, 0x02, 0x06, 0x8f, 0x68, 0x7f, 0xc8, 0x7a, 0xec,
+		0x68, 0xb1, 0x10, 0x0a, 0x63, 0xb1, 0x4c, 0x60, 0xda, 0xb0, 0x0c, 0xdf, 0xd0, 0x4c, 0x75, 0x4f,
+		0x33, 0x35, 0x4b, 0x7f, 0x2f, 0x51, 0x75, 0xef, 0x22, 0x55, 0xe0, 0xa0, 0x2b, 0x0c, 0x53, 0xbe,
+		0x01, 0x13, 0x02, 0x3e, 0x7d, 0x1f, 0xe0, 0x05, 0x58, 0x24, 0x82, 0xfc, 0xed, 0x24, 0xcc, 0x28,
+		0xa4, 0xf9, 0x27, 0x4b, 0xad, 0x3f, 0x08, 0xc0, 0xe6, 0x25, 0xba, 0xcb, 0x62, 0xfa, 0x3e, 0xcc,
+		0xf3, 0x1c, 0xc3, 0xab, 0x7a, 0x7e, 0x44, 0xb7, 0x5f, 0x4b, 0x42, 0x3e, 0xaa, 0xdb, 0x3f, 0x01,
+		0xcb, 0x87, 0xbc, 0x1e, 0x7a, 0x83, 0x34, 0xff, 0xd2, 0xf3, 0x00, 0x6f, 0xd0, 0x63, 0x75, 0x47,
+		0xbb, 0x81, 0xdf, 0x19, 0x87, 0x4c, 0x5d, 0x73, 0xb5, 0xb6, 0x27, 0x5f, 0xeb, 0x09, 0x5e, 0x45,
+		0x86, 0xb1, 0xe7, 0x7b, 0xfe, 0x3c, 0xa1, 0xc1, 0x4c, 0xee, 0xe3, 0x7d, 0x62, 0xd7, 0x47, 0xa1,
+		0x80, 0xdb, 0xe3, 0xc8, 0x65, 0x84, 0x24, 0x3d, 0x62, 0xc5, 0xfd, 0x6d, 0x78, 0x12, 0x86, 0x9f,
+		0x4d, 0x41, 0xb6, 0xd0, 0xd1, 0x21, 0x0f, 0xb4, 0xb5, 0x3b, 0x35, 0x46, 0x91, 0x9f, 0x01, 0xf9,
+		0x20, 0x48, 0x58, 0xa8, 0xa1, 0x0a, 0x90, 0x6f, 0x26, 0x2c, 0x11, 0xec, 0x98, 0xd7, 0xc4, 0x68,
+		0x90, 0x5d, 0x70, 0x63, 0xfb, 0xbb, 0x1c, 0x52, 0xaa, 0x48, 0x90, 0x7f, 0x98, 0xc5, 0xc1, 0x5d,
+		0x3b, 0x67, 0xbe, 0x05, 0xd9, 0x38, 0x9e, 0xa5, 0xfe, 0xf1, 0xdb, 0x8b, 0xf3, 0x87, 0x5a, 0xdb,
+		0x2c, 0x97, 0xfa, 0x40, 0x96, 0x68, 0x5c, 0x1c, 0xdf, 0x71, 0xcb, 0xb7, 0xe1, 0x54, 0xcb, 0xb4,
+		0xf7, 0x34, 0x53, 0x15, 0xe1, 0x31, 0x1b, 0x3a, 0x55, 0xd7, 0x9c, 0xe2, 0xc4, 0x7d, 0x98, 0x2d,
+		0x27, 0x19, 0xfc, 0x06, 0x8b, 0x94, 0x19, 0xf8, 0xaa, 0xe6, 0xc8, 0x3f, 0x02, 0x0f, 0x86, 0xa6,
+		0xd8, 0xa7, 0xee, 0xec, 0x7d, 0xa8, 0xfb, 0x54, 0x50, 0x43, 0x4f, 0xf5, 0xbd, 0xc1, 0xfa, 0xbe,
+		0xa6, 0xfb, 0xfc, 0xc3, 0xc0, 0xf7, 0x37, 0x58, 0xbf, 0x42, 0x81, 0x23, 0xbe, 0xe2, 0xb3, 0x09,
+		0x90, 0xc3, 0xc5, 0x4d, 0x21, 0x9e, 0x83, 0x9b, 0x67, 0xdc, 0x5a, 0x45, 0xf6, 0x41, 0x89, 0xa3,
+		0xb7, 0x56, 0xa1, 0xbc, 0xd8, 0x5a, 0x85, 0xb2, 0xf8, 0x45, 0x6d, 0xe1, 0x52, 0x93, 0x7c, 0xb6,
+		0xf4, 0xb9, 0x07, 0xba, 0x8c, 0x37, 0x2f, 0xc5, 0x44, 0xec, 0x5e, 0x2d, 0xc6, 0x4a, 0xbf, 0x9d,
+		0x80, 0x53, 0x3d, 0xf3, 0x36, 0x68, 0xec, 0x9f, 0x01, 0xd9, 0x8d, 0x14, 0xf2, 0x8f, 0xa3, 0xb2,
+		0x46, 0x1f, 0xdb, 0x0d, 0xcc, 0xb8, 0xdd, 0x05, 0xef, 0xdb, 0x6a, 0xc8, 0xee, 0x87, 0xfe, 0x93,
+		0x04, 0xcc, 0x45, 0x1b, 0x13, 0x74, 0x6b, 0x0b, 0xf2, 0xd1, 0xb6, 0xf0, 0x0e, 0x3d, 0x32, 0x4a,
+		0x87, 0x78, 0x5f, 0x62, 0xf2, 0xf2, 0xcb, 0xa1, 0x8b, 0x64, 0x29, 0xc9, 0xe7, 0x46, 0xd6, 0x8d,
+		0x68, 0x53, 0xb7, 0xab, 0x4c, 0x8b, 0x78, 0x31, 0x5d, 0xb7, 0x6d, 0x53, 0xfe, 0x11, 0x98, 0xb1,
+		0x6c, 0x9f, 0x9a, 0x30, 0x69, 0xaa, 0x3c, 0x3f, 0xc2, 0xd6, 0x99, 0x97, 0x8f, 0xa7, 0xb2, 0x6f,
+		0xbd, 0xbd, 0xd8, 0x0b, 0xd5, 0xa5, 0xc7, 0x69, 0xcb, 0xf6, 0x57, 0x68, 0xf9, 0x0e, 0x2d, 0x96,
+		0x5d, 0x98, 0x8a, 0x57, 0xcd, 0xd6, 0xa5, 0xcd, 0x63, 0x57, 0x3d, 0x75, 0x54, 0xb5, 0xf9, 0xbd,
+		0x48, 0x9d, 0xec, 0xe6, 0xdc, 0x1f, 0xe1, 0x38, 0xfe, 0x5a, 0x02, 0x66, 0x29, 0xd1, 0x78, 0x93,
+		0xd0, 0x3d, 0xb1, 0x42, 0x74, 0xdb, 0x6d, 0xca, 0x05, 0x48, 0xf2, 0xb3, 0xa8, 0xb4, 0x92, 0x34,
+		0xf0, 0x03, 0xd4, 0xe3, 0xf6, 0x6d, 0x8b, 0x5f, 0x64, 0x39, 0x6a, 0x9d, 0x63, 0x6c, 0x74, 0xa5,
+		0xb0, 0x9b, 0x1d, 0x93, 0xe0, 0x67, 0x85, 0xe9, 0x05, 0x64, 0x96, 0xdb, 0x9b, 0x62, 0xd4, 0x0a,
+		0x23, 0xe2, 0x0e, 0x3b, 0x98, 0xe9, 0xc5, 0xf4, 0x10, 0xe8, 0x90, 0x95, 0x19, 0xe1, 0x93, 0x5f,
+		0x4e, 0x00, 0x84, 0x59, 0x2e, 0x3c, 0x08, 0x59, 0xd9, 0xde, 0xaa, 0xaa, 0x8d, 0x9d, 0xca, 0xce,
+		0x6e, 0x23, 0xfe, 0x72, 0x80, 0x38, 0x36, 0xf1, 0x1c, 0xa2, 0xd3, 0xef, 0xd3, 0xca, 0x8f, 0xc1,
+		0x5c, 0x9c, 0x1b, 0x9f, 0xf0, 0x2b, 0xcd, 0xf3, 0xf9, 0xbb, 0xf7, 0x96, 0xb2, 0x2c, 0x88, 0x26,
+		0x78, 0xe9, 0xe4, 0x44, 0x2f, 0x1f, 0xbe, 0x58, 0x90, 0x9c, 0x9f, 0xba, 0x7b, 0x6f, 0x29, 0x17,
+		0x44, 0xdb, 0x72, 0x09, 0xe4, 0x28, 0x27, 0xc7, 0x4b, 0xcd, 0xc3, 0xdd, 0x7b, 0x4b, 0x19, 0x36,
+		0xe6, 0xf3, 0x69, 0x3c, 0x1c, 0x59, 0xb9, 0x32, 0xf0, 0x60, 0xe4, 0xe9, 0x23, 0x87, 0xfb, 0x4e,
+		0x70, 0xd8, 0x11, 0x3b, 0x0d, 0xf9, 0xbf, 0x03, 0x00, 0x5a, 0x0a, 0x02, 0xac, 0xc5, 0x6c, 0x00,
 		0x00,
 	}
 	r := bytes.NewReader(gzipped)
@@ -1939,6 +2091,15 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
 		return false
 	}
+	if !this.GlobalLiquidStakingCap.Equal(that1.GlobalLiquidStakingCap) {
+		return false
+	}
+	if !this.ValidatorLiquidStakingCap.Equal(that1.ValidatorLiquidStakingCap) {
+		return false
+	}
+	if !this.ValidatorBondFactor.Equal(that1.ValidatorBondFactor) {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -1995,6 +2156,39 @@ func (this *Pool) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *TokenizeShareRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenizeShareRecord)
+	if !ok {
+		that2, ok := that.(TokenizeShareRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	if this.ModuleAccount != that1.ModuleAccount {
+		return false
+	}
+	if this.Validator != that1.Validator {
+		return false
+	}
+	return true
+}
 func (m *HistoricalInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2214,6 +2408,26 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.ValidatorBondShares.Size()
+		i -= size
+		if _, err := m.ValidatorBondShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.LiquidShares.Size()
+		i -= size
+		if _, err := m.LiquidShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
 	{
 		size := m.MinSelfDelegation.Size()
 		i -= size
@@ -2521,6 +2735,16 @@ func (m *Delegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ValidatorBond {
+		i--
+		if m.ValidatorBond {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	{
 		size := m.Shares.Size()
 		i -= size
@@ -2789,6 +3013,36 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.ValidatorBondFactor.Size()
+		i -= size
+		if _, err := m.ValidatorBondFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.ValidatorLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.ValidatorLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.GlobalLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.GlobalLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	{
 		size := m.MinCommissionRate.Size()
 		i -= size
@@ -3008,6 +3262,55 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TokenizeShareRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenizeShareRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ModuleAccount) > 0 {
+		i -= len(m.ModuleAccount)
+		copy(dAtA[i:], m.ModuleAccount)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ModuleAccount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStaking(v)
 	base := offset
@@ -3128,6 +3431,10 @@ func (m *Validator) Size() (n int) {
 	n += 1 + l + sovStaking(uint64(l))
 	l = m.MinSelfDelegation.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	l = m.LiquidShares.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorBondShares.Size()
+	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
@@ -3230,6 +3537,9 @@ func (m *Delegation) Size() (n int) {
 	}
 	l = m.Shares.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	if m.ValidatorBond {
+		n += 2
+	}
 	return n
 }
 
@@ -3342,6 +3652,12 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MinCommissionRate.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	l = m.GlobalLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorBondFactor.Size()
+	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
@@ -3401,6 +3717,30 @@ func (m *Pool) Size() (n int) {
 	return n
 }
 
+func (m *TokenizeShareRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStaking(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ModuleAccount)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
 func sovStaking(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4376,6 +4716,74 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorBondShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -5034,6 +5442,26 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBond", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ValidatorBond = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -5908,6 +6336,108 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorBondFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -6397,6 +6927,171 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenizeShareRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenizeShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipStaking(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0